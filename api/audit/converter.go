@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"encoding/json"
+
+	"dillmann.com.br/nginx-ignition/core/audit"
+)
+
+func toResponseDTO(event *audit.Event) *eventResponseDTO {
+	return &eventResponseDTO{
+		ID:         event.ID,
+		CreatedAt:  event.CreatedAt,
+		UserID:     event.UserID,
+		SourceIP:   event.SourceIP,
+		EntityType: event.EntityType,
+		EntityID:   event.EntityID,
+		Action:     event.Action,
+		Before:     toRawJSON(event.Before),
+		After:      toRawJSON(event.After),
+	}
+}
+
+func toRawJSON(value *string) json.RawMessage {
+	if value == nil {
+		return json.RawMessage("null")
+	}
+
+	return json.RawMessage(*value)
+}
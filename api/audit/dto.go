@@ -0,0 +1,22 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/audit"
+)
+
+type eventResponseDTO struct {
+	CreatedAt  time.Time        `json:"createdAt"`
+	UserID     *uuid.UUID       `json:"userId"`
+	SourceIP   *string          `json:"sourceIp"`
+	EntityID   *uuid.UUID       `json:"entityId"`
+	EntityType audit.EntityType `json:"entityType"`
+	Action     audit.Action     `json:"action"`
+	Before     json.RawMessage  `json:"before"`
+	After      json.RawMessage  `json:"after"`
+	ID         uuid.UUID        `json:"id"`
+}
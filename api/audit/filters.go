@@ -0,0 +1,79 @@
+package audit
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/audit"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+)
+
+func extractFilters(ctx *gin.Context) (*audit.Filters, error) {
+	filters := &audit.Filters{}
+
+	var err error
+	if filters.UserID, err = parseUUID(ctx, "userId"); err != nil {
+		return nil, err
+	}
+
+	if filters.EntityID, err = parseUUID(ctx, "entityId"); err != nil {
+		return nil, err
+	}
+
+	if filters.From, err = parseTime(ctx, "from"); err != nil {
+		return nil, err
+	}
+
+	if filters.To, err = parseTime(ctx, "to"); err != nil {
+		return nil, err
+	}
+
+	if value := ctx.Query("entityType"); value != "" {
+		filters.EntityType = new(audit.EntityType(value))
+	}
+
+	if value := ctx.Query("action"); value != "" {
+		filters.Action = new(audit.Action(value))
+	}
+
+	return filters, nil
+}
+
+func parseUUID(ctx *gin.Context, name string) (*uuid.UUID, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return nil, invalidFilterError(ctx, name)
+	}
+
+	return &parsed, nil
+}
+
+func parseTime(ctx *gin.Context, name string) (*time.Time, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, invalidFilterError(ctx, name)
+	}
+
+	return &parsed, nil
+}
+
+func invalidFilterError(ctx *gin.Context, name string) error {
+	return apierror.New(
+		http.StatusBadRequest,
+		i18n.M(ctx.Request.Context(), i18n.K.ApiAuditInvalidFilter).V("name", name),
+	)
+}
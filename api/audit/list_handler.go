@@ -0,0 +1,34 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/audit"
+)
+
+type listHandler struct {
+	commands audit.Commands
+}
+
+func (h listHandler) handle(ctx *gin.Context) {
+	pageSize, pageNumber, _, err := pagination.ExtractPaginationParameters(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	filters, err := extractFilters(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	page, err := h.commands.List(ctx.Request.Context(), pageSize, pageNumber, filters)
+	if err != nil {
+		panic(err)
+	}
+
+	response := pagination.Convert(page, toResponseDTO)
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,148 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/audit"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_listHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with paginated results", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			event := audit.Event{
+				ID:         uuid.New(),
+				EntityType: audit.HostEntityType,
+				Action:     audit.UpdateAction,
+				After:      new(`{"enabled":true}`),
+			}
+			commands := audit.NewMockedCommands(controller)
+			commands.EXPECT().
+				List(gomock.Any(), 10, 1, gomock.Any()).
+				Return(pagination.Of([]audit.Event{event}), nil)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"GET",
+				"/api/audit?pageSize=10&pageNumber=1",
+				nil,
+			)
+
+			handler := listHandler{
+				commands: commands,
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response pagination.Page[eventResponseDTO]
+			err := json.Unmarshal(recorder.Body.Bytes(), &response)
+			require.NoError(t, err)
+			require.Len(t, response.Contents, 1)
+			assert.Equal(t, event.ID, response.Contents[0].ID)
+			assert.JSONEq(t, `{"enabled":true}`, string(response.Contents[0].After))
+		})
+
+		t.Run("passes filters to command", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			userID := uuid.New()
+			commands := audit.NewMockedCommands(controller)
+			commands.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(
+					_ any,
+					_, _ int,
+					filters *audit.Filters,
+				) (*pagination.Page[audit.Event], error) {
+					assert.Equal(t, &userID, filters.UserID)
+					assert.Equal(t, audit.CacheEntityType, *filters.EntityType)
+					assert.Equal(t, audit.DeleteAction, *filters.Action)
+					assert.Equal(t, 2025, filters.From.Year())
+					assert.Nil(t, filters.To)
+					assert.Nil(t, filters.EntityID)
+					return pagination.Of([]audit.Event{}), nil
+				})
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"GET",
+				"/api/audit?userId="+userID.String()+
+					"&entityType=CACHE&action=DELETE&from=2025-01-01T00:00:00Z",
+				nil,
+			)
+
+			handler := listHandler{
+				commands: commands,
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+
+		t.Run("panics with bad request on invalid filter", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := audit.NewMockedCommands(controller)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", "/api/audit?userId=invalid", nil)
+
+			handler := listHandler{
+				commands: commands,
+			}
+
+			defer func() {
+				panicked := recover()
+				apiErr, ok := panicked.(*apierror.APIError)
+				require.True(t, ok)
+				assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+			}()
+			handler.handle(ginContext)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			expectedErr := errors.New("command error")
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := audit.NewMockedCommands(controller)
+			commands.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, expectedErr)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", "/api/audit", nil)
+
+			handler := listHandler{
+				commands: commands,
+			}
+			assert.PanicsWithValue(t, expectedErr, func() {
+				handler.handle(ginContext)
+			})
+		})
+	})
+}
@@ -0,0 +1,19 @@
+package audit
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/audit"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func Install(router *gin.Engine, commands audit.Commands, authorizer *authorization.ABAC) {
+	basePath := authorizer.ConfigureGroup(
+		router,
+		"/api/audit",
+		func(permissions user.Permissions) user.AccessLevel { return permissions.Audit },
+	)
+
+	basePath.GET("", listHandler{commands}.handle)
+}
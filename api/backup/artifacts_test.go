@@ -1,6 +1,14 @@
 package backup
 
-import "dillmann.com.br/nginx-ignition/core/backup"
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/backup"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
 
 func newBackup() *backup.Backup {
 	return &backup.Backup{
@@ -9,3 +17,19 @@ func newBackup() *backup.Backup {
 		Contents:    []byte("backup data"),
 	}
 }
+
+func newRun() *backup.Run {
+	return &backup.Run{
+		ID:          uuid.New(),
+		StartedAt:   time.Now(),
+		FinishedAt:  time.Now(),
+		Status:      backup.SucceededRunStatus,
+		Destination: settings.LocalBackupDestination,
+		FileName:    new("nginx-ignition-backup-20261018T120000Z.db"),
+		SizeBytes:   1024,
+	}
+}
+
+func newRunPage() *pagination.Page[backup.Run] {
+	return pagination.Of([]backup.Run{*newRun()})
+}
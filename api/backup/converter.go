@@ -0,0 +1,18 @@
+package backup
+
+import (
+	"dillmann.com.br/nginx-ignition/core/backup"
+)
+
+func toRunResponseDTO(run *backup.Run) *runResponseDTO {
+	return &runResponseDTO{
+		ID:           run.ID,
+		StartedAt:    run.StartedAt,
+		FinishedAt:   run.FinishedAt,
+		Status:       run.Status,
+		Destination:  run.Destination,
+		FileName:     run.FileName,
+		SizeBytes:    run.SizeBytes,
+		ErrorMessage: run.ErrorMessage,
+	}
+}
@@ -0,0 +1,21 @@
+package backup
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/backup"
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+type runResponseDTO struct {
+	StartedAt    time.Time                  `json:"startedAt"`
+	FinishedAt   time.Time                  `json:"finishedAt"`
+	FileName     *string                    `json:"fileName"`
+	ErrorMessage *string                    `json:"errorMessage"`
+	Status       backup.RunStatus           `json:"status"`
+	Destination  settings.BackupDestination `json:"destination"`
+	SizeBytes    int64                      `json:"sizeBytes"`
+	ID           uuid.UUID                  `json:"id"`
+}
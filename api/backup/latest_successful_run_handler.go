@@ -0,0 +1,27 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/core/backup"
+)
+
+type latestSuccessfulRunHandler struct {
+	commands backup.Commands
+}
+
+func (h latestSuccessfulRunHandler) handle(ctx *gin.Context) {
+	run, err := h.commands.GetLatestSuccessfulRun(ctx.Request.Context())
+	if err != nil {
+		panic(err)
+	}
+
+	if run == nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toRunResponseDTO(run))
+}
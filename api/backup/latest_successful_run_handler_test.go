@@ -0,0 +1,100 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/backup"
+)
+
+func Test_latestSuccessfulRunHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with the run when found", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			run := newRun()
+			commands := backup.NewMockedCommands(controller)
+			commands.EXPECT().
+				GetLatestSuccessfulRun(gomock.Any()).
+				Return(run, nil)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"GET",
+				"/api/backup/runs/latest-successful",
+				nil,
+			)
+
+			handler := latestSuccessfulRunHandler{
+				commands: commands,
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response runResponseDTO
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Equal(t, run.ID, response.ID)
+			assert.Equal(t, run.FileName, response.FileName)
+		})
+
+		t.Run("returns 404 Not Found when there is no successful run", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := backup.NewMockedCommands(controller)
+			commands.EXPECT().
+				GetLatestSuccessfulRun(gomock.Any()).
+				Return(nil, nil)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"GET",
+				"/api/backup/runs/latest-successful",
+				nil,
+			)
+
+			handler := latestSuccessfulRunHandler{
+				commands: commands,
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusNotFound, ginContext.Writer.Status())
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			expectedErr := errors.New("run error")
+			commands := backup.NewMockedCommands(controller)
+			commands.EXPECT().
+				GetLatestSuccessfulRun(gomock.Any()).
+				Return(nil, expectedErr)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"GET",
+				"/api/backup/runs/latest-successful",
+				nil,
+			)
+
+			handler := latestSuccessfulRunHandler{
+				commands: commands,
+			}
+			assert.PanicsWithValue(t, expectedErr, func() {
+				handler.handle(ginContext)
+			})
+		})
+	})
+}
@@ -0,0 +1,28 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/backup"
+)
+
+type listRunsHandler struct {
+	commands backup.Commands
+}
+
+func (h listRunsHandler) handle(ctx *gin.Context) {
+	pageSize, pageNumber, _, err := pagination.ExtractPaginationParameters(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	page, err := h.commands.ListRuns(ctx.Request.Context(), pageSize, pageNumber)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.JSON(http.StatusOK, pagination.Convert(page, toRunResponseDTO))
+}
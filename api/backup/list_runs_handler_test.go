@@ -0,0 +1,70 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/backup"
+)
+
+func Test_listRunsHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with the runs on success", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			page := newRunPage()
+			commands := backup.NewMockedCommands(controller)
+			commands.EXPECT().
+				ListRuns(gomock.Any(), 10, 1).
+				Return(page, nil)
+
+			handler := listRunsHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/backup/runs", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/backup/runs?pageSize=10&pageNumber=1", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response pagination.PageDTO[runResponseDTO]
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Len(t, response.Contents, 1)
+			assert.Equal(t, page.Contents[0].ID, response.Contents[0].ID)
+			assert.Equal(t, backup.SucceededRunStatus, response.Contents[0].Status)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			expectedErr := errors.New("list error")
+			commands := backup.NewMockedCommands(controller)
+			commands.EXPECT().
+				ListRuns(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, expectedErr)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", "/api/backup/runs", nil)
+
+			handler := listRunsHandler{
+				commands: commands,
+			}
+			assert.PanicsWithValue(t, expectedErr, func() {
+				handler.handle(ginContext)
+			})
+		})
+	})
+}
@@ -0,0 +1,48 @@
+package backup
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/backup"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+)
+
+type restoreHandler struct {
+	commands      backup.Commands
+	configuration *configuration.Configuration
+}
+
+func (h restoreHandler) handle(ctx *gin.Context) {
+	maximumSizeMB, err := h.configuration.GetInt("nginx-ignition.backup.restore-maximum-size-mb")
+	if err != nil {
+		panic(err)
+	}
+
+	contents, err := io.ReadAll(
+		http.MaxBytesReader(ctx.Writer, ctx.Request.Body, int64(maximumSizeMB)*1024*1024),
+	)
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		panic(apierror.New(
+			http.StatusRequestEntityTooLarge,
+			i18n.M(ctx.Request.Context(), i18n.K.ApiBackupFileTooLarge).V("size", maximumSizeMB),
+		))
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	if err = h.commands.Restore(ctx.Request.Context(), contents); err != nil {
+		panic(err)
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
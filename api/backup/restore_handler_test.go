@@ -0,0 +1,104 @@
+package backup
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/backup"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+)
+
+func Test_restoreHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 204 No Content when the backup is restored", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			contents := []byte("backup contents")
+			commands := backup.NewMockedCommands(controller)
+			commands.EXPECT().
+				Restore(gomock.Any(), contents).
+				Return(nil)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"POST",
+				"/api/backup/restore",
+				bytes.NewReader(contents),
+			)
+
+			handler := restoreHandler{
+				commands:      commands,
+				configuration: configuration.New(),
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusNoContent, ginContext.Writer.Status())
+		})
+
+		t.Run("panics with 413 when the backup exceeds the maximum size", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			t.Setenv("NGINX_IGNITION_BACKUP_RESTORE_MAXIMUM_SIZE_MB", "1")
+			commands := backup.NewMockedCommands(controller)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"POST",
+				"/api/backup/restore",
+				bytes.NewReader(make([]byte, 1024*1024+1)),
+			)
+
+			handler := restoreHandler{
+				commands:      commands,
+				configuration: configuration.New(),
+			}
+
+			defer func() {
+				err, ok := recover().(*apierror.APIError)
+				assert.True(t, ok)
+				assert.Equal(t, http.StatusRequestEntityTooLarge, err.StatusCode)
+			}()
+
+			handler.handle(ginContext)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			expectedErr := errors.New("restore error")
+			commands := backup.NewMockedCommands(controller)
+			commands.EXPECT().
+				Restore(gomock.Any(), gomock.Any()).
+				Return(expectedErr)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"POST",
+				"/api/backup/restore",
+				bytes.NewReader([]byte("backup contents")),
+			)
+
+			handler := restoreHandler{
+				commands:      commands,
+				configuration: configuration.New(),
+			}
+			assert.PanicsWithValue(t, expectedErr, func() {
+				handler.handle(ginContext)
+			})
+		})
+	})
+}
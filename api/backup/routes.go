@@ -5,15 +5,18 @@ import (
 
 	"dillmann.com.br/nginx-ignition/api/common/authorization"
 	"dillmann.com.br/nginx-ignition/core/backup"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
 const (
-	apiPath = "/api/backup"
+	apiPath     = "/api/backup"
+	restorePath = apiPath + "/restore"
 )
 
 func Install(
 	router *gin.Engine,
+	cfg *configuration.Configuration,
 	authorizer *authorization.ABAC,
 	commands backup.Commands,
 ) {
@@ -23,4 +26,15 @@ func Install(
 		func(permissions user.Permissions) user.AccessLevel { return permissions.ExportData },
 	)
 	basePath.GET("", getHandler{commands}.handle)
+	basePath.GET("/runs", listRunsHandler{commands}.handle)
+	basePath.GET("/runs/latest-successful", latestSuccessfulRunHandler{commands}.handle)
+
+	// Restoring replaces the whole configuration, so it requires write access to the settings
+	// instead of the export permission, which is limited to read-only
+	restoreGroup := authorizer.ConfigureGroup(
+		router,
+		restorePath,
+		func(permissions user.Permissions) user.AccessLevel { return permissions.Settings },
+	)
+	restoreGroup.POST("", restoreHandler{commands, cfg}.handle)
 }
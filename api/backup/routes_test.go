@@ -0,0 +1,93 @@
+package backup
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/backup"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func Test_Install(t *testing.T) {
+	setup := func(
+		t *testing.T,
+		permissions user.Permissions,
+	) (*backup.MockedCommands, *gin.Engine) {
+		controller := gomock.NewController(t)
+		apiTokenCommands := apitoken.NewMockedCommands(controller)
+		apiTokenCommands.EXPECT().
+			Authenticate(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(
+				&apitoken.APIToken{ID: uuid.New()},
+				&user.User{ID: uuid.New(), Permissions: permissions},
+				nil,
+			)
+
+		authorizer, err := authorization.New(configuration.New(), nil, apiTokenCommands, nil)
+		assert.NoError(t, err)
+
+		commands := backup.NewMockedCommands(controller)
+		engine := gin.New()
+		engine.Use(gin.CustomRecoveryWithWriter(nil, apierror.Handler))
+		engine.Use(authorizer.HandleRequest)
+		Install(engine, configuration.New(), authorizer, commands)
+
+		return commands, engine
+	}
+
+	restore := func(engine *gin.Engine) int {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(
+			http.MethodPost,
+			"/api/backup/restore",
+			bytes.NewReader([]byte("backup contents")),
+		)
+		request.Header.Set("Authorization", "Bearer "+apitoken.SecretPrefix+"secret")
+		engine.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	t.Run("allows the restore with write access to the settings", func(t *testing.T) {
+		commands, engine := setup(t, user.Permissions{
+			Settings:   user.ReadWriteAccessLevel,
+			ExportData: user.NoAccessAccessLevel,
+		})
+		commands.EXPECT().Restore(gomock.Any(), []byte("backup contents")).Return(nil)
+
+		assert.Equal(t, http.StatusNoContent, restore(engine))
+	})
+
+	t.Run("denies the restore with read-only access to the settings", func(t *testing.T) {
+		_, engine := setup(t, user.Permissions{
+			Settings:   user.ReadOnlyAccessLevel,
+			ExportData: user.ReadOnlyAccessLevel,
+		})
+
+		assert.Equal(t, http.StatusForbidden, restore(engine))
+	})
+
+	t.Run("keeps the export permission for the other endpoints", func(t *testing.T) {
+		_, engine := setup(t, user.Permissions{
+			Settings:   user.ReadWriteAccessLevel,
+			ExportData: user.NoAccessAccessLevel,
+		})
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/api/backup/runs", nil)
+		request.Header.Set("Authorization", "Bearer "+apitoken.SecretPrefix+"secret")
+		engine.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
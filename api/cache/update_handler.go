@@ -27,7 +27,7 @@ func (h updateHandler) handle(ctx *gin.Context) {
 	}
 
 	domain := converter.Wrap2(ctx.Request.Context(), toDomain, id, &dto)
-	if err := h.commands.Save(ctx, domain); err != nil {
+	if err := h.commands.Save(ctx.Request.Context(), domain); err != nil {
 		panic(err)
 	}
 
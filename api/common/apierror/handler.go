@@ -7,11 +7,13 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
 	"dillmann.com.br/nginx-ignition/core/common/coreerror"
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 	"dillmann.com.br/nginx-ignition/core/common/log"
 	"dillmann.com.br/nginx-ignition/core/common/validation"
+	"dillmann.com.br/nginx-ignition/core/nginx/cfgfiles"
 )
 
 type problemDetail struct {
@@ -19,6 +21,15 @@ type problemDetail struct {
 	FieldPath string        `json:"fieldPath"`
 }
 
+type syntaxProblemDetail struct {
+	HostID     *uuid.UUID `json:"hostId,omitempty"`
+	RouteID    *uuid.UUID `json:"routeId,omitempty"`
+	StreamID   *uuid.UUID `json:"streamId,omitempty"`
+	FileName   string     `json:"fileName,omitempty"`
+	Message    string     `json:"message"`
+	LineNumber int        `json:"lineNumber,omitempty"`
+}
+
 func Handler(ctx *gin.Context, outcome any) {
 	err, isErr := outcome.(error)
 	if !isErr {
@@ -28,12 +39,15 @@ func Handler(ctx *gin.Context, outcome any) {
 	httpError := &APIError{}
 	consistencyError := &validation.ConsistencyError{}
 	coreError := &coreerror.CoreError{}
+	syntaxError := &cfgfiles.SyntaxError{}
 
 	switch {
 	case errors.As(err, &httpError):
 		handleHTTPError(ctx, httpError)
 	case errors.As(err, &consistencyError):
 		handleConsistencyError(ctx, consistencyError)
+	case errors.As(err, &syntaxError):
+		handleSyntaxError(ctx, syntaxError)
 	case errors.As(err, &coreError):
 		handleCoreError(ctx, coreError)
 	case errors.Is(err, jwt.ErrSignatureInvalid):
@@ -51,10 +65,12 @@ func CanHandle(err error) bool {
 	httpError := &APIError{}
 	consistencyError := &validation.ConsistencyError{}
 	coreError := &coreerror.CoreError{}
+	syntaxError := &cfgfiles.SyntaxError{}
 
 	return errors.As(err, &httpError) ||
 		errors.As(err, &consistencyError) ||
 		errors.As(err, &coreError) ||
+		errors.As(err, &syntaxError) ||
 		errors.Is(err, jwt.ErrSignatureInvalid)
 }
 
@@ -92,6 +108,26 @@ func handleConsistencyError(ctx *gin.Context, err *validation.ConsistencyError)
 	sendError(ctx, details)
 }
 
+func handleSyntaxError(ctx *gin.Context, err *cfgfiles.SyntaxError) {
+	details := make([]syntaxProblemDetail, len(err.Problems))
+	for index, problem := range err.Problems {
+		details[index] = syntaxProblemDetail{
+			HostID:     problem.HostID,
+			RouteID:    problem.RouteID,
+			StreamID:   problem.StreamID,
+			FileName:   problem.FileName,
+			Message:    problem.Message,
+			LineNumber: problem.LineNumber,
+		}
+	}
+
+	ctx.JSON(http.StatusBadRequest, gin.H{
+		"message":        err.Message,
+		"syntaxProblems": details,
+		"output":         err.Output,
+	})
+}
+
 func sendError(ctx *gin.Context, details []problemDetail) {
 	ctx.JSON(http.StatusBadRequest, gin.H{
 		"message":             i18n.M(ctx, i18n.K.ApiCommonApierrorConsistencyProblems),
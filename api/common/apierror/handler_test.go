@@ -13,6 +13,7 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/coreerror"
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 	"dillmann.com.br/nginx-ignition/core/common/validation"
+	"dillmann.com.br/nginx-ignition/core/nginx/cfgfiles"
 )
 
 func init() {
@@ -63,6 +64,22 @@ func Test_handler(t *testing.T) {
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   "",
 		},
+		{
+			name: "SyntaxError",
+			err: &cfgfiles.SyntaxError{
+				Message: i18n.Static("Syntax error"),
+				Output:  "nginx: [emerg] unknown directive",
+				Problems: []cfgfiles.SyntaxProblem{
+					{
+						FileName:   "nginx.conf",
+						Message:    "unknown directive",
+						LineNumber: 10,
+					},
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"message":"Syntax error","output":"nginx: [emerg] unknown directive","syntaxProblems":[{"fileName":"nginx.conf","lineNumber":10,"message":"unknown directive"}]}`,
+		},
 		{
 			name:           "JWT Invalid Signature",
 			err:            jwt.ErrSignatureInvalid,
@@ -132,6 +149,11 @@ func Test_canHandle(t *testing.T) {
 			err:      &coreerror.CoreError{},
 			expected: true,
 		},
+		{
+			name:     "SyntaxError",
+			err:      &cfgfiles.SyntaxError{},
+			expected: true,
+		},
 		{
 			name:     "JWT Invalid Signature",
 			err:      jwt.ErrSignatureInvalid,
@@ -5,7 +5,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"dillmann.com.br/nginx-ignition/core/apitoken"
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
@@ -15,12 +17,18 @@ type ABAC struct {
 	configuration           *configuration.Configuration
 	pathPermissionResolvers map[string]PermissionResolver
 	jwt                     *Jwt
+	apiTokenCommands        apitoken.Commands
 	anonymousPaths          []string
 	allowedForAllUsers      []string
 }
 
-func New(cfg *configuration.Configuration, commands user.Commands) (*ABAC, error) {
-	jwt, err := newJwt(cfg, commands)
+func New(
+	cfg *configuration.Configuration,
+	commands user.Commands,
+	apiTokenCommands apitoken.Commands,
+	sessionCommands session.Commands,
+) (*ABAC, error) {
+	jwt, err := newJwt(cfg, commands, sessionCommands)
 	if err != nil {
 		return nil, err
 	}
@@ -30,6 +38,7 @@ func New(cfg *configuration.Configuration, commands user.Commands) (*ABAC, error
 		anonymousPaths:          []string{},
 		pathPermissionResolvers: map[string]PermissionResolver{},
 		jwt:                     jwt,
+		apiTokenCommands:        apiTokenCommands,
 	}, nil
 }
 
@@ -75,11 +84,13 @@ func (m *ABAC) isAllowedForAllUsers(method, path string) bool {
 }
 
 func (m *ABAC) isAccessGranted(method, path string, permissions *user.Permissions) bool {
+	// The most specific group wins, allowing a nested path to require another permission
 	currentAccessLevel := user.NoAccessAccessLevel
+	matchedPath := ""
 	for basePath, resolver := range m.pathPermissionResolvers {
-		if strings.HasPrefix(path, basePath) {
+		if strings.HasPrefix(path, basePath) && len(basePath) > len(matchedPath) {
 			currentAccessLevel = resolver(*permissions)
-			break
+			matchedPath = basePath
 		}
 	}
 
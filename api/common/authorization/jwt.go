@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 
@@ -15,6 +16,7 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
@@ -25,13 +27,17 @@ const (
 )
 
 type Jwt struct {
-	configuration *configuration.Configuration
-	commands      user.Commands
-	revokedIDs    []string
-	secretKey     []byte
+	configuration   *configuration.Configuration
+	commands        user.Commands
+	sessionCommands session.Commands
+	secretKey       []byte
 }
 
-func newJwt(cfg *configuration.Configuration, commands user.Commands) (*Jwt, error) {
+func newJwt(
+	cfg *configuration.Configuration,
+	commands user.Commands,
+	sessionCommands session.Commands,
+) (*Jwt, error) {
 	prefixedConfiguration := cfg.WithPrefix("nginx-ignition.security.jwt")
 
 	secretKey, err := initializeSecret(prefixedConfiguration)
@@ -40,18 +46,23 @@ func newJwt(cfg *configuration.Configuration, commands user.Commands) (*Jwt, err
 	}
 
 	return &Jwt{
-		configuration: prefixedConfiguration,
-		commands:      commands,
-		secretKey:     secretKey,
-		revokedIDs:    []string{},
+		configuration:   prefixedConfiguration,
+		commands:        commands,
+		sessionCommands: sessionCommands,
+		secretKey:       secretKey,
 	}, nil
 }
 
-func (j *Jwt) RevokeToken(tokenID string) {
-	j.revokedIDs = append(j.revokedIDs, tokenID)
+func (j *Jwt) RevokeToken(ctx context.Context, subject *Subject) error {
+	sessionID, err := uuid.Parse(subject.TokenID)
+	if err != nil {
+		return err
+	}
+
+	return j.sessionCommands.Revoke(ctx, subject.User.ID, sessionID)
 }
 
-func (j *Jwt) GenerateToken(usr *user.User) (*string, error) {
+func (j *Jwt) GenerateToken(ctx *gin.Context, usr *user.User) (*string, error) {
 	ttlSeconds, err := j.configuration.GetInt("ttl-seconds")
 	if err != nil {
 		return nil, err
@@ -62,19 +73,32 @@ func (j *Jwt) GenerateToken(usr *user.User) (*string, error) {
 		return nil, err
 	}
 
-	notBefore := time.Now().Add(time.Second * time.Duration(clockSkewSeconds) * -1).Unix()
-	expiresAt := time.Now().
+	now := time.Now()
+	notBefore := now.Add(time.Second * time.Duration(clockSkewSeconds) * -1).Unix()
+	expiresAt := now.
 		Add(time.Second * time.Duration(ttlSeconds)).
-		Add(time.Second * time.Duration(clockSkewSeconds)).
-		Unix()
+		Add(time.Second * time.Duration(clockSkewSeconds))
+
+	sessionData := &session.Session{
+		ID:            uuid.New(),
+		UserID:        usr.ID,
+		UserAgent:     ctx.Request.UserAgent(),
+		SourceAddress: ctx.ClientIP(),
+		CreatedAt:     now,
+		ExpiresAt:     expiresAt,
+	}
+
+	if err = j.sessionCommands.Create(ctx.Request.Context(), sessionData); err != nil {
+		return nil, err
+	}
 
 	claims := jwt.MapClaims{
 		"aud": uniqueIdentifier,
 		"iss": uniqueIdentifier,
 		"nbf": notBefore,
-		"iat": time.Now().Unix(),
-		"exp": expiresAt,
-		"jti": uuid.New().String(),
+		"iat": now.Unix(),
+		"exp": expiresAt.Unix(),
+		"jti": sessionData.ID.String(),
 		"sub": usr.ID.String(),
 	}
 
@@ -108,16 +132,24 @@ func (j *Jwt) ValidateToken(ctx context.Context, tokenString string) (*Subject,
 			return nil, err
 		}
 
-		tokenID := claims["jti"].(string)
 		if !usr.Enabled {
-			j.RevokeToken(tokenID)
+			if err = j.sessionCommands.RevokeAll(ctx, usr.ID, nil); err != nil {
+				return nil, err
+			}
+
 			return nil, apierror.New(
 				http.StatusUnauthorized,
 				i18n.M(ctx, i18n.K.ApiCommonAuthorizationInvalidAccessToken),
 			)
 		}
 
-		if j.isRevoked(tokenID) {
+		tokenID, _ := claims["jti"].(string)
+		active, err := j.isSessionActive(ctx, tokenID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !active {
 			return nil, apierror.New(
 				http.StatusUnauthorized,
 				i18n.M(ctx, i18n.K.ApiCommonAuthorizationInvalidAccessToken),
@@ -137,7 +169,7 @@ func (j *Jwt) ValidateToken(ctx context.Context, tokenString string) (*Subject,
 	)
 }
 
-func (j *Jwt) RefreshToken(subject *Subject) (*string, error) {
+func (j *Jwt) RefreshToken(ctx context.Context, subject *Subject) (*string, error) {
 	windowSize, err := j.configuration.GetInt("refresh-window-seconds")
 	if err != nil {
 		return nil, err
@@ -154,25 +186,33 @@ func (j *Jwt) RefreshToken(subject *Subject) (*string, error) {
 	}
 
 	if time.Now().Add(time.Second * time.Duration(windowSize)).After(expiration.Time) {
-		newClaims := *subject.claims
-		newClaims["exp"] = time.Now().
+		sessionID, err := uuid.Parse(subject.TokenID)
+		if err != nil {
+			return nil, err
+		}
+
+		expiresAt := time.Now().
 			Add(time.Second * time.Duration(windowSize)).
-			Add(time.Second * time.Duration(clockSkewSeconds)).
-			Unix()
+			Add(time.Second * time.Duration(clockSkewSeconds))
+		if err = j.sessionCommands.Extend(ctx, sessionID, expiresAt); err != nil {
+			return nil, err
+		}
+
+		newClaims := *subject.claims
+		newClaims["exp"] = expiresAt.Unix()
 		return j.sign(&newClaims)
 	}
 
 	return nil, nil
 }
 
-func (j *Jwt) isRevoked(tokenID string) bool {
-	for _, id := range j.revokedIDs {
-		if id == tokenID {
-			return true
-		}
+func (j *Jwt) isSessionActive(ctx context.Context, tokenID string) (bool, error) {
+	sessionID, err := uuid.Parse(tokenID)
+	if err != nil {
+		return false, nil
 	}
 
-	return false
+	return j.sessionCommands.IsActive(ctx, sessionID)
 }
 
 func (j *Jwt) sign(claims *jwt.MapClaims) (*string, error) {
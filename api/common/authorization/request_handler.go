@@ -7,6 +7,8 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/audit"
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 )
 
@@ -35,8 +37,8 @@ func (m *ABAC) HandleRequest(ctx *gin.Context) {
 		))
 	}
 
-	subject, err := m.jwt.ValidateToken(ctx.Request.Context(), accessToken)
-	if err != nil {
+	subject, err := m.resolveSubject(ctx, accessToken)
+	if err != nil || subject == nil {
 		ctx.Abort()
 		panic(apierror.New(
 			http.StatusUnauthorized,
@@ -44,7 +46,15 @@ func (m *ABAC) HandleRequest(ctx *gin.Context) {
 		))
 	}
 
-	if !m.isAllowedForAllUsers(ctx.Request.Method, path) {
+	if m.isAllowedForAllUsers(ctx.Request.Method, path) {
+		if subject.IsAPIToken() && ctx.Request.Method != http.MethodGet {
+			ctx.Abort()
+			panic(apierror.New(
+				http.StatusForbidden,
+				i18n.M(ctx.Request.Context(), i18n.K.ApiCommonAuthorizationAccessDenied),
+			))
+		}
+	} else {
 		accessGranted := m.isAccessGranted(ctx.Request.Method, path, &subject.User.Permissions)
 		if !accessGranted {
 			ctx.Abort()
@@ -55,11 +65,38 @@ func (m *ABAC) HandleRequest(ctx *gin.Context) {
 		}
 	}
 
-	refreshedToken, _ := m.jwt.RefreshToken(subject)
-	if refreshedToken != nil {
-		ctx.Header("Authorization", "Bearer "+*refreshedToken)
+	if !subject.IsAPIToken() {
+		refreshedToken, _ := m.jwt.RefreshToken(ctx.Request.Context(), subject)
+		if refreshedToken != nil {
+			ctx.Header("Authorization", "Bearer "+*refreshedToken)
+		}
 	}
 
 	ctx.Set(RequestSubject, subject)
+	ctx.Request = ctx.Request.WithContext(audit.WithActor(ctx.Request.Context(), &audit.Actor{
+		UserID:   subject.User.ID,
+		SourceIP: ctx.ClientIP(),
+	}))
 	ctx.Next()
 }
+
+func (m *ABAC) resolveSubject(ctx *gin.Context, accessToken string) (*Subject, error) {
+	if !apitoken.IsSecret(accessToken) {
+		return m.jwt.ValidateToken(ctx.Request.Context(), accessToken)
+	}
+
+	token, usr, err := m.apiTokenCommands.Authenticate(
+		ctx.Request.Context(),
+		accessToken,
+		ctx.ClientIP(),
+	)
+	if err != nil || token == nil || usr == nil {
+		return nil, err
+	}
+
+	return &Subject{
+		User:       usr,
+		APITokenID: &token.ID,
+		TokenID:    token.ID.String(),
+	}, nil
+}
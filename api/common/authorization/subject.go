@@ -3,14 +3,33 @@ package authorization
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
 type Subject struct {
-	User    *user.User
-	claims  *jwt.MapClaims
-	TokenID string
+	User       *user.User
+	APITokenID *uuid.UUID
+	claims     *jwt.MapClaims
+	TokenID    string
+}
+
+func (s *Subject) IsAPIToken() bool {
+	return s.APITokenID != nil
+}
+
+func (s *Subject) SessionID() *uuid.UUID {
+	if s.IsAPIToken() {
+		return nil
+	}
+
+	sessionID, err := uuid.Parse(s.TokenID)
+	if err != nil {
+		return nil
+	}
+
+	return &sessionID
 }
 
 func CurrentSubject(ctx *gin.Context) *Subject {
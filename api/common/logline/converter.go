@@ -23,8 +23,8 @@ func ToResponseDTO(logLine logline.LogLine) ResponseDTO {
 	}
 
 	return ResponseDTO{
-		LineNumber: logLine.LineNumber,
-		Contents:   logLine.Contents,
-		Highlight:  highlight,
+		Contents:  logLine.Contents,
+		Offset:    logLine.Offset,
+		Highlight: highlight,
 	}
 }
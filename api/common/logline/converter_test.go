@@ -11,22 +11,22 @@ import (
 func TestToResponseDTO(t *testing.T) {
 	t.Run("converts LogLine without highlight correctly", func(t *testing.T) {
 		input := logline.LogLine{
-			LineNumber: 10,
-			Contents:   "some log message",
-			Highlight:  nil,
+			Offset:    10,
+			Contents:  "some log message",
+			Highlight: nil,
 		}
 
 		result := ToResponseDTO(input)
 
-		assert.Equal(t, 10, result.LineNumber)
+		assert.Equal(t, int64(10), result.Offset)
 		assert.Equal(t, "some log message", result.Contents)
 		assert.Nil(t, result.Highlight)
 	})
 
 	t.Run("converts LogLine with highlight correctly", func(t *testing.T) {
 		input := logline.LogLine{
-			LineNumber: 20,
-			Contents:   "highlighted message",
+			Offset:   20,
+			Contents: "highlighted message",
 			Highlight: &logline.Highlight{
 				Start: 5,
 				End:   15,
@@ -35,7 +35,7 @@ func TestToResponseDTO(t *testing.T) {
 
 		result := ToResponseDTO(input)
 
-		assert.Equal(t, 20, result.LineNumber)
+		assert.Equal(t, int64(20), result.Offset)
 		assert.Equal(t, "highlighted message", result.Contents)
 		assert.NotNil(t, result.Highlight)
 		assert.Equal(t, 5, result.Highlight.Start)
@@ -46,16 +46,16 @@ func TestToResponseDTO(t *testing.T) {
 func TestToResponseDTOs(t *testing.T) {
 	t.Run("converts multiple LogLines correctly", func(t *testing.T) {
 		input := []logline.LogLine{
-			{LineNumber: 1, Contents: "line 1"},
-			{LineNumber: 2, Contents: "line 2"},
+			{Offset: 1, Contents: "line 1"},
+			{Offset: 2, Contents: "line 2"},
 		}
 
 		result := ToResponseDTOs(input)
 
 		assert.Len(t, result, 2)
-		assert.Equal(t, 1, result[0].LineNumber)
+		assert.Equal(t, int64(1), result[0].Offset)
 		assert.Equal(t, "line 1", result[0].Contents)
-		assert.Equal(t, 2, result[1].LineNumber)
+		assert.Equal(t, int64(2), result[1].Offset)
 		assert.Equal(t, "line 2", result[1].Contents)
 	})
 
@@ -1,9 +1,9 @@
 package logline
 
 type ResponseDTO struct {
-	Highlight  *HighlightResponseDTO `json:"highlight,omitempty"`
-	Contents   string                `json:"contents"`
-	LineNumber int                   `json:"lineNumber"`
+	Highlight *HighlightResponseDTO `json:"highlight,omitempty"`
+	Contents  string                `json:"contents"`
+	Offset    int64                 `json:"offset"`
 }
 
 type HighlightResponseDTO struct {
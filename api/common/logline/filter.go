@@ -0,0 +1,124 @@
+package logline
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/core/common/accesslog"
+	"dillmann.com.br/nginx-ignition/core/common/valuerange"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+var statusCodeRange = valuerange.New(100, 599)
+
+func ExtractAccessLogFilter(ctx *gin.Context) (*accesslog.Filter, bool) {
+	filter := &accesslog.Filter{}
+
+	statusRange, valid := extractStatusRange(ctx)
+	if !valid {
+		return nil, false
+	}
+
+	filter.StatusRange = statusRange
+
+	if value := strings.TrimSpace(ctx.Query("clientAddress")); value != "" {
+		network, valid := parseClientNetwork(value)
+		if !valid {
+			return nil, false
+		}
+
+		filter.ClientNetwork = network
+	}
+
+	if value := strings.TrimSpace(ctx.Query("path")); value != "" {
+		filter.Path = &value
+	}
+
+	if value := strings.TrimSpace(ctx.Query("method")); value != "" {
+		filter.Method = new(strings.ToUpper(value))
+	}
+
+	if value := ctx.Query("minimumLatencyMs"); value != "" {
+		milliseconds, err := strconv.Atoi(value)
+		if err != nil || milliseconds < 0 {
+			return nil, false
+		}
+
+		filter.MinimumLatency = new(time.Duration(milliseconds) * time.Millisecond)
+	}
+
+	if filter.IsEmpty() {
+		return nil, true
+	}
+
+	return filter, true
+}
+
+func WithAccessLogFilter(search *nginx.LogSearch, filter *accesslog.Filter) *nginx.LogSearch {
+	if filter == nil {
+		return search
+	}
+
+	if search == nil {
+		search = &nginx.LogSearch{}
+	}
+
+	search.Filter = filter
+	return search
+}
+
+func extractStatusRange(ctx *gin.Context) (*valuerange.ValueRange, bool) {
+	fromValue := ctx.Query("statusFrom")
+	toValue := ctx.Query("statusTo")
+	if fromValue == "" && toValue == "" {
+		return nil, true
+	}
+
+	output := valuerange.New(statusCodeRange.Min, statusCodeRange.Max)
+	if fromValue != "" {
+		value, err := strconv.Atoi(fromValue)
+		if err != nil || !statusCodeRange.Contains(value) {
+			return nil, false
+		}
+
+		output.Min = value
+	}
+
+	if toValue != "" {
+		value, err := strconv.Atoi(toValue)
+		if err != nil || !statusCodeRange.Contains(value) {
+			return nil, false
+		}
+
+		output.Max = value
+	}
+
+	if output.Min > output.Max {
+		return nil, false
+	}
+
+	return output, true
+}
+
+func parseClientNetwork(value string) (*netip.Prefix, bool) {
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, false
+		}
+
+		return new(prefix.Masked()), true
+	}
+
+	address, err := netip.ParseAddr(value)
+	if err != nil {
+		return nil, false
+	}
+
+	address = address.Unmap()
+	return new(netip.PrefixFrom(address, address.BitLen())), true
+}
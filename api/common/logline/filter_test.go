@@ -0,0 +1,97 @@
+package logline
+
+import (
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/common/accesslog"
+	"dillmann.com.br/nginx-ignition/core/common/valuerange"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+func Test_ExtractAccessLogFilter(t *testing.T) {
+	extract := func(target string) (*accesslog.Filter, bool) {
+		recorder := httptest.NewRecorder()
+		ginContext, _ := gin.CreateTestContext(recorder)
+		ginContext.Request = httptest.NewRequest("GET", target, nil)
+		return ExtractAccessLogFilter(ginContext)
+	}
+
+	t.Run("returns nil when no filter is provided", func(t *testing.T) {
+		result, valid := extract("/?searchTerms=error")
+
+		assert.True(t, valid)
+		assert.Nil(t, result)
+	})
+
+	t.Run("extracts all the filter criteria", func(t *testing.T) {
+		result, valid := extract(
+			"/?statusFrom=500&statusTo=504&clientAddress=10.0.0.0/8&path=/api&method=post&minimumLatencyMs=250",
+		)
+
+		require.True(t, valid)
+		assert.Equal(t, valuerange.New(500, 504), result.StatusRange)
+		assert.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), *result.ClientNetwork)
+		assert.Equal(t, "/api", *result.Path)
+		assert.Equal(t, "POST", *result.Method)
+		assert.Equal(t, 250*time.Millisecond, *result.MinimumLatency)
+	})
+
+	t.Run("uses the default bounds for partial status ranges", func(t *testing.T) {
+		result, valid := extract("/?statusFrom=400")
+
+		require.True(t, valid)
+		assert.Equal(t, valuerange.New(400, 599), result.StatusRange)
+	})
+
+	t.Run("accepts a single client address", func(t *testing.T) {
+		result, valid := extract("/?clientAddress=192.168.0.10")
+
+		require.True(t, valid)
+		assert.Equal(t, netip.MustParsePrefix("192.168.0.10/32"), *result.ClientNetwork)
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		for _, target := range []string{
+			"/?statusFrom=abc",
+			"/?statusTo=600",
+			"/?statusFrom=500&statusTo=400",
+			"/?clientAddress=not-an-address",
+			"/?clientAddress=10.0.0.0/99",
+			"/?minimumLatencyMs=-1",
+		} {
+			_, valid := extract(target)
+			assert.False(t, valid, target)
+		}
+	})
+}
+
+func Test_WithAccessLogFilter(t *testing.T) {
+	filter := &accesslog.Filter{Method: new("GET")}
+
+	t.Run("keeps the search when there is no filter", func(t *testing.T) {
+		search := &nginx.LogSearch{Query: "error"}
+
+		assert.Same(t, search, WithAccessLogFilter(search, nil))
+		assert.Nil(t, WithAccessLogFilter(nil, nil))
+	})
+
+	t.Run("attaches the filter to the search", func(t *testing.T) {
+		result := WithAccessLogFilter(&nginx.LogSearch{Query: "error"}, filter)
+
+		assert.Equal(t, "error", result.Query)
+		assert.Same(t, filter, result.Filter)
+	})
+
+	t.Run("creates a search when missing", func(t *testing.T) {
+		result := WithAccessLogFilter(nil, filter)
+
+		assert.Same(t, filter, result.Filter)
+	})
+}
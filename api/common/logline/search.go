@@ -30,3 +30,17 @@ func ExtractSearchParams(ctx *gin.Context) *nginx.LogSearch {
 
 	return output
 }
+
+func ExtractBeforeOffset(ctx *gin.Context) (*int64, bool) {
+	value := ctx.Query("before")
+	if value == "" {
+		return nil, true
+	}
+
+	offset, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || offset < 0 {
+		return nil, false
+	}
+
+	return &offset, true
+}
@@ -92,3 +92,34 @@ func Test_ExtractSearchParams(t *testing.T) {
 		assert.Equal(t, 10, result.SurroundingLines)
 	})
 }
+
+func Test_ExtractBeforeOffset(t *testing.T) {
+	extract := func(target string) (*int64, bool) {
+		recorder := httptest.NewRecorder()
+		ginContext, _ := gin.CreateTestContext(recorder)
+		ginContext.Request = httptest.NewRequest("GET", target, nil)
+		return ExtractBeforeOffset(ginContext)
+	}
+
+	t.Run("returns nil when the offset is missing", func(t *testing.T) {
+		result, valid := extract("/")
+
+		assert.True(t, valid)
+		assert.Nil(t, result)
+	})
+
+	t.Run("extracts the offset when provided", func(t *testing.T) {
+		result, valid := extract("/?before=1024")
+
+		assert.True(t, valid)
+		assert.Equal(t, int64(1024), *result)
+	})
+
+	t.Run("rejects invalid offsets", func(t *testing.T) {
+		_, valid := extract("/?before=abc")
+		assert.False(t, valid)
+
+		_, valid = extract("/?before=-1")
+		assert.False(t, valid)
+	})
+}
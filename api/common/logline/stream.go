@@ -0,0 +1,54 @@
+package logline
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+)
+
+const (
+	streamEventName         = "lines"
+	streamKeepAliveInterval = 15 * time.Second
+)
+
+func Stream(ctx *gin.Context, channel <-chan []logline.LogLine) {
+	controller := http.NewResponseController(ctx.Writer)
+	err := controller.SetWriteDeadline(time.Time{})
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Warnf("Unable to disable the write deadline of the log stream: %v", err)
+	}
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case <-keepAlive.C:
+			if _, err := ctx.Writer.WriteString(": keep-alive\n\n"); err != nil {
+				return
+			}
+		case lines, open := <-channel:
+			if !open {
+				return
+			}
+
+			ctx.SSEvent(streamEventName, ToResponseDTOs(lines))
+		}
+
+		ctx.Writer.Flush()
+	}
+}
@@ -0,0 +1,46 @@
+package logline
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+)
+
+func Test_Stream(t *testing.T) {
+	t.Run("writes each batch of lines as an event", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ginContext, _ := gin.CreateTestContext(recorder)
+		ginContext.Request = httptest.NewRequest("GET", "/", nil)
+
+		channel := make(chan []logline.LogLine, 1)
+		channel <- []logline.LogLine{{Contents: "hello"}}
+		close(channel)
+
+		Stream(ginContext, channel)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Header().Get("Content-Type"), "text/event-stream")
+		assert.Contains(t, recorder.Body.String(), "event:lines\n")
+		assert.Contains(t, recorder.Body.String(), `"contents":"hello"`)
+	})
+
+	t.Run("stops when the request is cancelled", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ginContext, _ := gin.CreateTestContext(recorder)
+
+		requestContext, cancel := context.WithCancel(t.Context())
+		ginContext.Request = httptest.NewRequest("GET", "/", nil).WithContext(requestContext)
+		cancel()
+
+		Stream(ginContext, make(chan []logline.LogLine))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Empty(t, recorder.Body.String())
+	})
+}
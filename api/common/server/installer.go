@@ -1,13 +1,17 @@
 package server
 
 import (
+	"strings"
+
 	"github.com/gin-gonic/gin"
 
 	"dillmann.com.br/nginx-ignition/api/common/apierror"
 	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/apitoken"
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
 	"dillmann.com.br/nginx-ignition/core/common/container"
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
@@ -22,6 +26,8 @@ func Install() error {
 func build(
 	cfg *configuration.Configuration,
 	userCommands user.Commands,
+	apiTokenCommands apitoken.Commands,
+	sessionCommands session.Commands,
 	i18nCommands i18n.Commands,
 ) (
 	*gin.Engine,
@@ -32,10 +38,19 @@ func build(
 	gin.SetMode(gin.ReleaseMode)
 
 	engine := gin.New()
+	if err := engine.SetTrustedProxies(trustedProxies(cfg)); err != nil {
+		return nil, nil, nil, err
+	}
+
 	engine.Use(i18nMiddleware(i18nCommands))
 	engine.Use(gin.CustomRecoveryWithWriter(nil, apierror.Handler))
 
-	authorizer, err := authorization.New(cfg, userCommands)
+	authorizer, err := authorization.New(
+		cfg,
+		userCommands,
+		apiTokenCommands,
+		sessionCommands,
+	)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -44,3 +59,23 @@ func build(
 
 	return engine, newState(engine), authorizer, nil
 }
+
+// The client IP is read from the forwarded headers only when the request comes from one of the
+// trusted proxies. Otherwise, anyone could pick the address used by the API token allowlists, the
+// login protection and the audit events.
+func trustedProxies(cfg *configuration.Configuration) []string {
+	value, _ := cfg.Get("nginx-ignition.server.trusted-proxies")
+
+	output := make([]string, 0)
+	for _, proxy := range strings.Split(value, ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			output = append(output, proxy)
+		}
+	}
+
+	if len(output) == 0 {
+		return nil
+	}
+
+	return output
+}
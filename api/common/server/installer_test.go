@@ -0,0 +1,88 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func Test_build(t *testing.T) {
+	setup := func(t *testing.T, expectedAddress string) *gin.Engine {
+		ctrl := gomock.NewController(t)
+
+		i18nCommands := i18n.NewMockedCommands(ctrl)
+		i18nCommands.EXPECT().DefaultLanguage().Return(language.AmericanEnglish).AnyTimes()
+		i18nCommands.EXPECT().Supports(gomock.Any()).Return(true).AnyTimes()
+
+		apiTokenCommands := apitoken.NewMockedCommands(ctrl)
+		apiTokenCommands.EXPECT().
+			Authenticate(gomock.Any(), gomock.Any(), expectedAddress).
+			Return(
+				&apitoken.APIToken{ID: uuid.New()},
+				&user.User{
+					ID:          uuid.New(),
+					Permissions: user.Permissions{Hosts: user.ReadOnlyAccessLevel},
+				},
+				nil,
+			)
+
+		engine, _, authorizer, err := build(
+			configuration.New(),
+			user.NewMockedCommands(ctrl),
+			apiTokenCommands,
+			nil,
+			i18nCommands,
+		)
+		require.NoError(t, err)
+
+		authorizer.ConfigureGroup(
+			engine,
+			"/api/test",
+			func(permissions user.Permissions) user.AccessLevel { return permissions.Hosts },
+		)
+		engine.GET("/api/test", func(ctx *gin.Context) {
+			ctx.String(http.StatusOK, ctx.ClientIP())
+		})
+
+		return engine
+	}
+
+	request := func(engine *gin.Engine) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/test", nil)
+		req.RemoteAddr = "203.0.113.5:4321"
+		req.Header.Set("X-Forwarded-For", "10.0.0.1")
+		req.Header.Set("Authorization", "Bearer "+apitoken.SecretPrefix+"secret")
+		engine.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	t.Run("ignores forged forwarded headers when no proxy is trusted", func(t *testing.T) {
+		engine := setup(t, "203.0.113.5")
+
+		recorder := request(engine)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "203.0.113.5", recorder.Body.String())
+	})
+
+	t.Run("reads the forwarded headers sent by a trusted proxy", func(t *testing.T) {
+		t.Setenv("NGINX_IGNITION_SERVER_TRUSTED_PROXIES", "192.0.2.1, 203.0.113.0/24")
+		engine := setup(t, "10.0.0.1")
+
+		recorder := request(engine)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "10.0.0.1", recorder.Body.String())
+	})
+}
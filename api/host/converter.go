@@ -33,6 +33,9 @@ func toDTO(input *host.Host, globalSettings *settings.Settings) *hostResponseDTO
 		FeatureSet:        toFeatureSetDTO(&input.FeatureSet),
 		AccessListID:      input.AccessListID,
 		CacheID:           input.CacheID,
+		RateLimitID:       input.RateLimitID,
+		SecurityHeadersID: input.SecurityHeadersID,
+		HeaderRules:       toHeaderRuleDTOSlice(input.HeaderRules),
 	}
 }
 
@@ -57,6 +60,9 @@ func toDomain(input *hostRequestDTO) *host.Host {
 		FeatureSet:        featureSet,
 		AccessListID:      input.AccessListID,
 		CacheID:           input.CacheID,
+		RateLimitID:       input.RateLimitID,
+		SecurityHeadersID: input.SecurityHeadersID,
+		HeaderRules:       toHeaderRuleSlice(input.HeaderRules),
 	}
 }
 
@@ -74,6 +80,7 @@ func toRouteDTO(route *host.Route) routeDTO {
 		Priority:     &route.Priority,
 		Enabled:      &route.Enabled,
 		Type:         &route.Type,
+		MatchType:    &route.MatchType,
 		SourcePath:   &route.SourcePath,
 		Settings:     toRouteSettingsDTO(&route.Settings),
 		TargetURI:    route.TargetURI,
@@ -82,10 +89,42 @@ func toRouteDTO(route *host.Route) routeDTO {
 		Integration:  toIntegrationConfigDTO(route.Integration),
 		AccessListID: route.AccessListID,
 		CacheID:      route.CacheID,
+		RateLimitID:  route.RateLimitID,
+		UpstreamID:   route.UpstreamID,
 		SourceCode:   toRouteSourceCodeDTO(route.SourceCode),
+		HeaderRules:  toHeaderRuleDTOSlice(route.HeaderRules),
+		RewriteRules: toRewriteRuleDTOSlice(route.RewriteRules),
 	}
 }
 
+func toHeaderRuleDTOSlice(rules []host.HeaderRule) []headerRuleDTO {
+	result := make([]headerRuleDTO, len(rules))
+	for index, rule := range rules {
+		result[index] = headerRuleDTO{
+			Name:   &rule.Name,
+			Value:  rule.Value,
+			Target: &rule.Target,
+			Action: &rule.Action,
+			Always: &rule.Always,
+		}
+	}
+
+	return result
+}
+
+func toRewriteRuleDTOSlice(rules []host.RewriteRule) []rewriteRuleDTO {
+	result := make([]rewriteRuleDTO, len(rules))
+	for index, rule := range rules {
+		result[index] = rewriteRuleDTO{
+			Pattern:     &rule.Pattern,
+			Replacement: &rule.Replacement,
+			Flag:        rule.Flag,
+		}
+	}
+
+	return result
+}
+
 func toBindingDTOSlice(bindings []binding.Binding) []bindingDTO {
 	result := make([]bindingDTO, len(bindings))
 	for index, b := range bindings {
@@ -94,6 +133,7 @@ func toBindingDTOSlice(bindings []binding.Binding) []bindingDTO {
 			IP:            &b.IP,
 			Port:          &b.Port,
 			CertificateID: b.CertificateID,
+			TLSProfileID:  b.TLSProfileID,
 		}
 	}
 
@@ -140,6 +180,7 @@ func toRouteSettingsDTO(set *host.RouteSettings) *routeSettingsDTO {
 		KeepOriginalDomainName:  &set.KeepOriginalDomainName,
 		DirectoryListingEnabled: &set.DirectoryListingEnabled,
 		IndexFile:               dropBlankValues(set.IndexFile),
+		PreserveQueryString:     &set.PreserveQueryString,
 		Custom:                  set.Custom,
 	}
 }
@@ -194,6 +235,7 @@ func toRouteSlice(routes []routeDTO) []host.Route {
 			Priority:     getIntValue(route.Priority),
 			Enabled:      getBoolValue(route.Enabled),
 			Type:         *route.Type,
+			MatchType:    getRouteMatchTypeValue(route.MatchType),
 			SourcePath:   getStringValue(route.SourcePath),
 			Settings:     toRouteSettings(route.Settings),
 			TargetURI:    route.TargetURI,
@@ -202,7 +244,39 @@ func toRouteSlice(routes []routeDTO) []host.Route {
 			Integration:  toRouteIntegrationConfig(route.Integration),
 			AccessListID: route.AccessListID,
 			CacheID:      route.CacheID,
+			RateLimitID:  route.RateLimitID,
+			UpstreamID:   route.UpstreamID,
 			SourceCode:   toRouteSourceCode(route.SourceCode),
+			HeaderRules:  toHeaderRuleSlice(route.HeaderRules),
+			RewriteRules: toRewriteRuleSlice(route.RewriteRules),
+		}
+	}
+
+	return result
+}
+
+func toHeaderRuleSlice(rules []headerRuleDTO) []host.HeaderRule {
+	result := make([]host.HeaderRule, len(rules))
+	for index, rule := range rules {
+		result[index] = host.HeaderRule{
+			Name:   getStringValue(rule.Name),
+			Value:  rule.Value,
+			Target: getHeaderRuleTargetValue(rule.Target),
+			Action: getHeaderRuleActionValue(rule.Action),
+			Always: getBoolValue(rule.Always),
+		}
+	}
+
+	return result
+}
+
+func toRewriteRuleSlice(rules []rewriteRuleDTO) []host.RewriteRule {
+	result := make([]host.RewriteRule, len(rules))
+	for index, rule := range rules {
+		result[index] = host.RewriteRule{
+			Pattern:     getStringValue(rule.Pattern),
+			Replacement: getStringValue(rule.Replacement),
+			Flag:        rule.Flag,
 		}
 	}
 
@@ -217,6 +291,7 @@ func toBindingSlice(bindings []bindingDTO) []binding.Binding {
 			IP:            getStringValue(b.IP),
 			Port:          getIntValue(b.Port),
 			CertificateID: b.CertificateID,
+			TLSProfileID:  b.TLSProfileID,
 		}
 	}
 
@@ -246,6 +321,7 @@ func toRouteSettings(input *routeSettingsDTO) host.RouteSettings {
 		KeepOriginalDomainName:  getBoolValue(input.KeepOriginalDomainName),
 		DirectoryListingEnabled: getBoolValue(input.DirectoryListingEnabled),
 		IndexFile:               dropBlankValues(input.IndexFile),
+		PreserveQueryString:     getBoolValue(input.PreserveQueryString),
 		Custom:                  input.Custom,
 	}
 }
@@ -313,6 +389,30 @@ func getStringValue(value *string) string {
 	return *value
 }
 
+func getRouteMatchTypeValue(value *host.RouteMatchType) host.RouteMatchType {
+	if value == nil {
+		return host.PrefixRouteMatchType
+	}
+
+	return *value
+}
+
+func getHeaderRuleTargetValue(value *host.HeaderRuleTarget) host.HeaderRuleTarget {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func getHeaderRuleActionValue(value *host.HeaderRuleAction) host.HeaderRuleAction {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
 func getUUIDValue(value *uuid.UUID) uuid.UUID {
 	if value == nil {
 		return uuid.Nil
@@ -8,22 +8,26 @@ import (
 )
 
 type hostRequestDTO struct {
-	Enabled           *bool          `json:"enabled"`
-	DefaultServer     *bool          `json:"defaultServer"`
-	UseGlobalBindings *bool          `json:"useGlobalBindings"`
-	FeatureSet        *featureSetDTO `json:"featureSet"`
-	AccessListID      *uuid.UUID     `json:"accessListId"`
-	CacheID           *uuid.UUID     `json:"cacheId"`
-	DomainNames       []string       `json:"domainNames"`
-	Routes            []routeDTO     `json:"routes"`
-	Bindings          []bindingDTO   `json:"bindings"`
-	VPNs              []vpnDTO       `json:"vpns"`
+	Enabled           *bool           `json:"enabled"`
+	DefaultServer     *bool           `json:"defaultServer"`
+	UseGlobalBindings *bool           `json:"useGlobalBindings"`
+	FeatureSet        *featureSetDTO  `json:"featureSet"`
+	AccessListID      *uuid.UUID      `json:"accessListId"`
+	CacheID           *uuid.UUID      `json:"cacheId"`
+	RateLimitID       *uuid.UUID      `json:"rateLimitId"`
+	SecurityHeadersID *uuid.UUID      `json:"securityHeadersId"`
+	DomainNames       []string        `json:"domainNames"`
+	HeaderRules       []headerRuleDTO `json:"headerRules"`
+	Routes            []routeDTO      `json:"routes"`
+	Bindings          []bindingDTO    `json:"bindings"`
+	VPNs              []vpnDTO        `json:"vpns"`
 }
 
 type routeDTO struct {
 	Priority     *int                  `json:"priority"`
 	Enabled      *bool                 `json:"enabled"`
 	Type         *host.RouteType       `json:"type"`
+	MatchType    *host.RouteMatchType  `json:"matchType"`
 	SourcePath   *string               `json:"sourcePath"`
 	Settings     *routeSettingsDTO     `json:"settings"`
 	TargetURI    *string               `json:"targetUri"`
@@ -32,7 +36,11 @@ type routeDTO struct {
 	Integration  *integrationConfigDTO `json:"integration"`
 	AccessListID *uuid.UUID            `json:"accessListId"`
 	CacheID      *uuid.UUID            `json:"cacheId"`
+	RateLimitID  *uuid.UUID            `json:"rateLimitId"`
+	UpstreamID   *uuid.UUID            `json:"upstreamId"`
 	SourceCode   *routeSourceCodeDTO   `json:"sourceCode"`
+	HeaderRules  []headerRuleDTO       `json:"headerRules"`
+	RewriteRules []rewriteRuleDTO      `json:"rewriteRules"`
 }
 
 type routeSourceCodeDTO struct {
@@ -48,6 +56,7 @@ type routeSettingsDTO struct {
 	KeepOriginalDomainName  *bool   `json:"keepOriginalDomainName"`
 	DirectoryListingEnabled *bool   `json:"directoryListingEnabled"`
 	IndexFile               *string `json:"indexFile"`
+	PreserveQueryString     *bool   `json:"preserveQueryString"`
 	Custom                  *string `json:"custom"`
 }
 
@@ -57,6 +66,20 @@ type integrationConfigDTO struct {
 	UseHTTPS      *bool      `json:"useHttps"`
 }
 
+type headerRuleDTO struct {
+	Name   *string                `json:"name"`
+	Value  *string                `json:"value"`
+	Target *host.HeaderRuleTarget `json:"target"`
+	Action *host.HeaderRuleAction `json:"action"`
+	Always *bool                  `json:"always"`
+}
+
+type rewriteRuleDTO struct {
+	Pattern     *string               `json:"pattern"`
+	Replacement *string               `json:"replacement"`
+	Flag        *host.RewriteRuleFlag `json:"flag"`
+}
+
 type staticResponseDTO struct {
 	StatusCode *int               `json:"statusCode"`
 	Payload    *string            `json:"payload"`
@@ -75,6 +98,7 @@ type bindingDTO struct {
 	IP            *string       `json:"ip"`
 	Port          *int          `json:"port"`
 	CertificateID *uuid.UUID    `json:"certificateId"`
+	TLSProfileID  *uuid.UUID    `json:"tlsProfileId"`
 }
 
 type vpnDTO struct {
@@ -86,16 +110,19 @@ type vpnDTO struct {
 }
 
 type hostResponseDTO struct {
-	ID                *uuid.UUID     `json:"id"`
-	Enabled           *bool          `json:"enabled"`
-	DefaultServer     *bool          `json:"defaultServer"`
-	UseGlobalBindings *bool          `json:"useGlobalBindings"`
-	FeatureSet        *featureSetDTO `json:"featureSet"`
-	AccessListID      *uuid.UUID     `json:"accessListId"`
-	CacheID           *uuid.UUID     `json:"cacheId"`
-	DomainNames       []string       `json:"domainNames"`
-	Routes            []routeDTO     `json:"routes"`
-	Bindings          []bindingDTO   `json:"bindings,omitempty"`
-	GlobalBindings    []bindingDTO   `json:"globalBindings,omitempty"`
-	VPNs              []vpnDTO       `json:"vpns"`
+	ID                *uuid.UUID      `json:"id"`
+	Enabled           *bool           `json:"enabled"`
+	DefaultServer     *bool           `json:"defaultServer"`
+	UseGlobalBindings *bool           `json:"useGlobalBindings"`
+	FeatureSet        *featureSetDTO  `json:"featureSet"`
+	AccessListID      *uuid.UUID      `json:"accessListId"`
+	CacheID           *uuid.UUID      `json:"cacheId"`
+	RateLimitID       *uuid.UUID      `json:"rateLimitId"`
+	SecurityHeadersID *uuid.UUID      `json:"securityHeadersId"`
+	DomainNames       []string        `json:"domainNames"`
+	HeaderRules       []headerRuleDTO `json:"headerRules"`
+	Routes            []routeDTO      `json:"routes"`
+	Bindings          []bindingDTO    `json:"bindings,omitempty"`
+	GlobalBindings    []bindingDTO    `json:"globalBindings,omitempty"`
+	VPNs              []vpnDTO        `json:"vpns"`
 }
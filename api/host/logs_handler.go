@@ -18,14 +18,15 @@ type logsHandler struct {
 }
 
 const (
-	defaultLineCount = 50
+	defaultLineCount   = 50
+	accessLogQualifier = "access"
 )
 
 var (
 	lineCountRange    = valuerange.New(1, 99_999)
 	allowedQualifiers = map[string]bool{
-		"access": true,
-		"error":  true,
+		accessLogQualifier: true,
+		"error":            true,
 	}
 )
 
@@ -61,9 +62,32 @@ func (h logsHandler) handle(ctx *gin.Context) {
 		return
 	}
 
-	search := logline.ExtractSearchParams(ctx)
+	before, valid := logline.ExtractBeforeOffset(ctx)
+	if !valid {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"message": "Before offset should be a non-negative integer",
+		})
+		return
+	}
+
+	filter, valid := logline.ExtractAccessLogFilter(ctx)
+	if !valid || (filter != nil && qualifier != accessLogQualifier) {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"message": "Invalid access log filter",
+		})
+		return
+	}
+
+	search := logline.WithAccessLogFilter(logline.ExtractSearchParams(ctx), filter)
 
-	logs, err := h.commands.GetHostLogs(ctx.Request.Context(), id, qualifier, lineCount, search)
+	logs, err := h.commands.GetHostLogs(
+		ctx.Request.Context(),
+		id,
+		qualifier,
+		lineCount,
+		before,
+		search,
+	)
 	if err != nil {
 		panic(err)
 	}
@@ -1,6 +1,7 @@
 package host
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -13,6 +14,7 @@ import (
 
 	apilogline "dillmann.com.br/nginx-ignition/api/common/logline"
 	"dillmann.com.br/nginx-ignition/core/common/logline"
+	"dillmann.com.br/nginx-ignition/core/common/valuerange"
 	"dillmann.com.br/nginx-ignition/core/nginx"
 )
 
@@ -28,12 +30,12 @@ func Test_logsHandler(t *testing.T) {
 
 			id := uuid.New()
 			logs := []logline.LogLine{
-				{LineNumber: 0, Contents: "log line 1"},
-				{LineNumber: 1, Contents: "log line 2"},
+				{Contents: "log line 1"},
+				{Contents: "log line 2"},
 			}
 			commands := nginx.NewMockedCommands(controller)
 			commands.EXPECT().
-				GetHostLogs(gomock.Any(), id, "access", 50, nil).
+				GetHostLogs(gomock.Any(), id, "access", 50, nil, nil).
 				Return(logs, nil)
 
 			handler := logsHandler{
@@ -51,8 +53,8 @@ func Test_logsHandler(t *testing.T) {
 			json.Unmarshal(recorder.Body.Bytes(), &response)
 
 			expectedResponse := []apilogline.ResponseDTO{
-				{LineNumber: 0, Contents: "log line 1"},
-				{LineNumber: 1, Contents: "log line 2"},
+				{Contents: "log line 1"},
+				{Contents: "log line 2"},
 			}
 			assert.Equal(t, expectedResponse, response)
 		})
@@ -75,6 +77,80 @@ func Test_logsHandler(t *testing.T) {
 			assert.Equal(t, http.StatusBadRequest, recorder.Code)
 		})
 
+		t.Run("forwards the access log filter", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			commands := nginx.NewMockedCommands(controller)
+			commands.EXPECT().
+				GetHostLogs(gomock.Any(), id, "access", 50, nil, gomock.Any()).
+				DoAndReturn(func(
+					_ context.Context,
+					_ uuid.UUID,
+					_ string,
+					_ int,
+					_ *int64,
+					search *nginx.LogSearch,
+				) ([]logline.LogLine, error) {
+					assert.Equal(t, valuerange.New(500, 599), search.Filter.StatusRange)
+					assert.Equal(t, "GET", *search.Filter.Method)
+					return []logline.LogLine{}, nil
+				})
+
+			handler := logsHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/hosts/:id/logs/:qualifier", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"GET",
+				"/api/hosts/"+id.String()+"/logs/access?statusFrom=500&method=get",
+				nil,
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+
+		t.Run("returns 400 Bad Request on access log filter for error logs", func(t *testing.T) {
+			handler := logsHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.GET("/api/hosts/:id/logs/:qualifier", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"GET",
+				"/api/hosts/"+uuid.New().String()+"/logs/error?statusFrom=500",
+				nil,
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("returns 400 Bad Request on invalid access log filter", func(t *testing.T) {
+			handler := logsHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.GET("/api/hosts/:id/logs/:qualifier", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"GET",
+				"/api/hosts/"+uuid.New().String()+"/logs/access?clientAddress=invalid",
+				nil,
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
 		t.Run("returns 404 Not Found on invalid qualifier", func(t *testing.T) {
 			handler := logsHandler{
 				commands: nil,
@@ -0,0 +1,46 @@
+package host
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/api/common/logline"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+type logsStreamHandler struct {
+	commands nginx.Commands
+}
+
+func (h logsStreamHandler) handle(ctx *gin.Context) {
+	qualifier := ctx.Param("qualifier")
+	if !allowedQualifiers[qualifier] {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	filter, valid := logline.ExtractAccessLogFilter(ctx)
+	if !valid || (filter != nil && qualifier != accessLogQualifier) {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"message": "Invalid access log filter",
+		})
+		return
+	}
+
+	search := logline.WithAccessLogFilter(logline.ExtractSearchParams(ctx), filter)
+
+	channel, err := h.commands.TailHostLogs(ctx.Request.Context(), id, qualifier, search)
+	if err != nil {
+		panic(err)
+	}
+
+	logline.Stream(ctx, channel)
+}
@@ -0,0 +1,138 @@
+package host
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+func Test_logsStreamHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("streams the new log lines as events", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			channel := make(chan []logline.LogLine, 1)
+			channel <- []logline.LogLine{{Contents: "new log line"}}
+			close(channel)
+
+			commands := nginx.NewMockedCommands(controller)
+			commands.EXPECT().
+				TailHostLogs(gomock.Any(), id, "error", &nginx.LogSearch{Query: "new"}).
+				Return((<-chan []logline.LogLine)(channel), nil)
+
+			handler := logsStreamHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/hosts/:id/logs/:qualifier/stream", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"GET",
+				"/api/hosts/"+id.String()+"/logs/error/stream?searchTerms=new",
+				nil,
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), `"contents":"new log line"`)
+		})
+
+		t.Run("returns 400 Bad Request on invalid access log filter", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			handler := logsStreamHandler{
+				commands: nginx.NewMockedCommands(controller),
+			}
+			engine := gin.New()
+			engine.GET("/api/hosts/:id/logs/:qualifier/stream", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"GET",
+				"/api/hosts/"+uuid.NewString()+"/logs/access/stream?minimumLatencyMs=abc",
+				nil,
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("returns 404 Not Found on invalid qualifier", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			handler := logsStreamHandler{
+				commands: nginx.NewMockedCommands(controller),
+			}
+			engine := gin.New()
+			engine.GET("/api/hosts/:id/logs/:qualifier/stream", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"GET",
+				"/api/hosts/"+uuid.NewString()+"/logs/invalid/stream",
+				nil,
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+
+		t.Run("returns 404 Not Found on invalid ID", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			handler := logsStreamHandler{
+				commands: nginx.NewMockedCommands(controller),
+			}
+			engine := gin.New()
+			engine.GET("/api/hosts/:id/logs/:qualifier/stream", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/hosts/invalid/logs/access/stream", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			commands := nginx.NewMockedCommands(controller)
+			commands.EXPECT().
+				TailHostLogs(gomock.Any(), id, "access", nil).
+				Return(nil, assert.AnError)
+
+			handler := logsStreamHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/hosts/:id/logs/:qualifier/stream", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"GET",
+				"/api/hosts/"+id.String()+"/logs/access/stream",
+				nil,
+			)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
@@ -37,4 +37,5 @@ func Install(
 		func(permissions user.Permissions) user.AccessLevel { return permissions.Logs },
 	)
 	logsPath.GET("/:qualifier", logsHandler{nginxCommands}.handle)
+	logsPath.GET("/:qualifier/stream", logsStreamHandler{nginxCommands}.handle)
 }
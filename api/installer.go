@@ -3,6 +3,7 @@ package api
 
 import (
 	"dillmann.com.br/nginx-ignition/api/accesslist"
+	"dillmann.com.br/nginx-ignition/api/audit"
 	"dillmann.com.br/nginx-ignition/api/backup"
 	"dillmann.com.br/nginx-ignition/api/cache"
 	"dillmann.com.br/nginx-ignition/api/certificate"
@@ -12,9 +13,16 @@ import (
 	"dillmann.com.br/nginx-ignition/api/host"
 	"dillmann.com.br/nginx-ignition/api/i18n"
 	"dillmann.com.br/nginx-ignition/api/integration"
+	"dillmann.com.br/nginx-ignition/api/metrics"
 	"dillmann.com.br/nginx-ignition/api/nginx"
+	"dillmann.com.br/nginx-ignition/api/ratelimit"
+	"dillmann.com.br/nginx-ignition/api/revision"
+	"dillmann.com.br/nginx-ignition/api/securityheaders"
 	"dillmann.com.br/nginx-ignition/api/settings"
+	"dillmann.com.br/nginx-ignition/api/state"
 	"dillmann.com.br/nginx-ignition/api/stream"
+	"dillmann.com.br/nginx-ignition/api/tlsprofile"
+	"dillmann.com.br/nginx-ignition/api/upstream"
 	"dillmann.com.br/nginx-ignition/api/user"
 	"dillmann.com.br/nginx-ignition/api/vpn"
 	"dillmann.com.br/nginx-ignition/core/common/container"
@@ -26,15 +34,23 @@ func Install() error {
 		healthcheck.Install,
 		settings.Install,
 		accesslist.Install,
+		audit.Install,
 		cache.Install,
+		ratelimit.Install,
+		securityheaders.Install,
 		certificate.Install,
+		tlsprofile.Install,
 		user.Install,
 		host.Install,
 		i18n.Install,
 		integration.Install,
+		metrics.Install,
 		nginx.Install,
+		revision.Install,
 		stream.Install,
+		upstream.Install,
 		backup.Install,
+		state.Install,
 		vpn.Install,
 		frontend.Install,
 	)
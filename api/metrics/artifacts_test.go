@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+func newTrafficStats() *nginx.Stats {
+	return &nginx.Stats{
+		HostName: "test-host",
+		Connections: nginx.StatsConnections{
+			Active:   10,
+			Reading:  2,
+			Writing:  3,
+			Waiting:  5,
+			Accepted: 100,
+			Handled:  99,
+			Requests: 150,
+		},
+		ServerZones: map[string]nginx.StatsZoneData{
+			"example.com": {
+				RequestCounter: 50,
+				InBytes:        1024,
+				OutBytes:       2048,
+				RequestMsec:    250,
+				Responses: nginx.StatsResponses{
+					Status2xx: 45,
+					Status5xx: 5,
+					Hit:       12,
+				},
+			},
+		},
+		FilterZones: map[string]map[string]nginx.StatsZoneData{
+			"hosts": {
+				"6f1ee3ee-5e4b-4a51-a7a4-2d4b0c4f7a10": {
+					RequestCounter: 30,
+				},
+			},
+			"countryCode@host:6f1ee3ee-5e4b-4a51-a7a4-2d4b0c4f7a10": {
+				"BR": {RequestCounter: 20},
+				"DE": {RequestCounter: 10},
+			},
+			"countryCode@domain:example.com": {
+				"BR": {RequestCounter: 40},
+			},
+		},
+		UpstreamZones: map[string][]nginx.StatsUpstreamZoneData{
+			"backend": {
+				{
+					Server:         "10.0.0.1:8080",
+					RequestCounter: 25,
+					ResponseMsec:   1500,
+					Down:           true,
+					Responses: nginx.StatsUpstreamResponses{
+						Status2xx: 25,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newCertificate() certificate.Certificate {
+	return certificate.Certificate{
+		ID:          uuid.MustParse("0b9d2c1e-3a46-4bd9-8e0f-3b9a8a3c1d55"),
+		DomainNames: []string{"example.com", "www.example.com"},
+		ValidUntil:  time.Unix(1893456000, 0),
+	}
+}
@@ -0,0 +1,329 @@
+package metrics
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/healthcheck"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+const (
+	metricPrefix            = "nginx_ignition_"
+	certificatesPageSize    = 100
+	hostsFilterZone         = "hosts"
+	hostCountryZonePrefix   = "countryCode@host:"
+	domainCountryZonePrefix = "countryCode@domain:"
+)
+
+type collector struct {
+	nginxCommands       nginx.Commands
+	certificateCommands certificate.Commands
+	healthCheck         *healthcheck.HealthCheck
+}
+
+func (c *collector) collect(ctx context.Context) (*metricsWriter, error) {
+	writer := newMetricsWriter()
+
+	c.collectNginxStatus(ctx, writer)
+	c.collectTrafficStats(ctx, writer)
+	c.collectVPNEndpoints(ctx, writer)
+	c.collectHealthCheck(ctx, writer)
+
+	if err := c.collectCertificates(ctx, writer); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+func (c *collector) collectNginxStatus(ctx context.Context, writer *metricsWriter) {
+	writer.gauge(
+		metricPrefix+"nginx_up",
+		"Whether the nginx server is running",
+		boolValue(c.nginxCommands.GetStatus(ctx)),
+	)
+
+	lastReload := c.nginxCommands.GetLastReload(ctx)
+	if lastReload == nil {
+		return
+	}
+
+	writer.gauge(
+		metricPrefix+"nginx_last_reload_success",
+		"Whether the last configuration reload of nginx succeeded",
+		boolValue(lastReload.Error == nil),
+	)
+	writer.gauge(
+		metricPrefix+"nginx_last_reload_timestamp_seconds",
+		"Unix timestamp of the last configuration reload attempt of nginx",
+		float64(lastReload.Timestamp.Unix()),
+	)
+}
+
+func (c *collector) collectTrafficStats(ctx context.Context, writer *metricsWriter) {
+	stats, err := c.nginxCommands.GetTrafficStats(ctx)
+	writer.gauge(
+		metricPrefix+"traffic_stats_available",
+		"Whether the traffic stats could be retrieved from nginx",
+		boolValue(err == nil && stats != nil),
+	)
+
+	if err != nil || stats == nil {
+		return
+	}
+
+	writeConnections(writer, &stats.Connections)
+
+	for _, domain := range sortedKeys(stats.ServerZones) {
+		writeZone(writer, "server_", stats.ServerZones[domain], label{"domain", domain})
+	}
+
+	hostZones := stats.FilterZones[hostsFilterZone]
+	for _, hostID := range sortedKeys(hostZones) {
+		writeZone(writer, "host_", hostZones[hostID], label{"host_id", hostID})
+	}
+
+	for _, zoneName := range sortedKeys(stats.FilterZones) {
+		if hostID, found := strings.CutPrefix(zoneName, hostCountryZonePrefix); found {
+			writeCountries(writer, "host_", stats.FilterZones[zoneName], label{"host_id", hostID})
+		}
+
+		if domain, found := strings.CutPrefix(zoneName, domainCountryZonePrefix); found {
+			writeCountries(writer, "server_", stats.FilterZones[zoneName], label{"domain", domain})
+		}
+	}
+
+	for _, upstream := range sortedKeys(stats.UpstreamZones) {
+		for _, server := range stats.UpstreamZones[upstream] {
+			writeUpstreamServer(writer, upstream, &server)
+		}
+	}
+}
+
+func (c *collector) collectVPNEndpoints(ctx context.Context, writer *metricsWriter) {
+	for _, status := range c.nginxCommands.GetVPNEndpointStatuses(ctx) {
+		writer.gauge(
+			metricPrefix+"vpn_endpoint_up",
+			"Whether the VPN endpoint is active",
+			boolValue(status.Active),
+			label{"vpn_id", status.VPNID.String()},
+			label{"name", status.Name},
+		)
+	}
+}
+
+func (c *collector) collectHealthCheck(ctx context.Context, writer *metricsWriter) {
+	status := c.healthCheck.Status(ctx)
+	writer.gauge(
+		metricPrefix+"healthy",
+		"Whether all the health checks of the application are passing",
+		boolValue(status.Healthy),
+	)
+
+	for _, detail := range status.Details {
+		writer.gauge(
+			metricPrefix+"health_check_up",
+			"Whether the health check is passing",
+			boolValue(detail.Error == nil),
+			label{"check", detail.ID},
+		)
+	}
+}
+
+func (c *collector) collectCertificates(ctx context.Context, writer *metricsWriter) error {
+	for pageNumber := 0; ; pageNumber++ {
+		page, err := c.certificateCommands.List(ctx, certificatesPageSize, pageNumber, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.Contents {
+			writer.gauge(
+				metricPrefix+"certificate_expiry_timestamp_seconds",
+				"Unix timestamp of when the SSL certificate expires",
+				float64(item.ValidUntil.Unix()),
+				label{"certificate_id", item.ID.String()},
+				label{"domains", strings.Join(item.DomainNames, ",")},
+			)
+		}
+
+		fetched := pageNumber*certificatesPageSize + len(page.Contents)
+		if len(page.Contents) < certificatesPageSize || fetched >= page.TotalItems {
+			return nil
+		}
+	}
+}
+
+func writeConnections(writer *metricsWriter, connections *nginx.StatsConnections) {
+	states := []struct {
+		name  string
+		value uint64
+	}{
+		{"active", connections.Active},
+		{"reading", connections.Reading},
+		{"writing", connections.Writing},
+		{"waiting", connections.Waiting},
+	}
+
+	for _, state := range states {
+		writer.gauge(
+			metricPrefix+"nginx_connections",
+			"Current amount of client connections by state",
+			float64(state.value),
+			label{"state", state.name},
+		)
+	}
+
+	writer.counter(
+		metricPrefix+"nginx_connections_accepted",
+		"Amount of accepted client connections",
+		float64(connections.Accepted),
+	)
+	writer.counter(
+		metricPrefix+"nginx_connections_handled",
+		"Amount of handled client connections",
+		float64(connections.Handled),
+	)
+	writer.counter(
+		metricPrefix+"nginx_requests",
+		"Amount of client requests",
+		float64(connections.Requests),
+	)
+}
+
+func writeZone(writer *metricsWriter, prefix string, zone nginx.StatsZoneData, zoneLabel label) {
+	name := metricPrefix + prefix
+
+	writer.counter(name+"requests", "Amount of requests", float64(zone.RequestCounter), zoneLabel)
+	writer.counter(
+		name+"received_bytes",
+		"Amount of bytes received",
+		float64(zone.InBytes),
+		zoneLabel,
+	)
+	writer.counter(name+"sent_bytes", "Amount of bytes sent", float64(zone.OutBytes), zoneLabel)
+	writer.gauge(
+		name+"request_duration_seconds",
+		"Average duration of the requests",
+		float64(zone.RequestMsec)/1000,
+		zoneLabel,
+	)
+
+	responses := []struct {
+		code  string
+		value uint64
+	}{
+		{"1xx", zone.Responses.Status1xx},
+		{"2xx", zone.Responses.Status2xx},
+		{"3xx", zone.Responses.Status3xx},
+		{"4xx", zone.Responses.Status4xx},
+		{"5xx", zone.Responses.Status5xx},
+	}
+
+	for _, response := range responses {
+		writer.counter(
+			name+"responses",
+			"Amount of responses by status code class",
+			float64(response.value),
+			zoneLabel,
+			label{"code", response.code},
+		)
+	}
+
+	cacheStatuses := []struct {
+		status string
+		value  uint64
+	}{
+		{"miss", zone.Responses.Miss},
+		{"bypass", zone.Responses.Bypass},
+		{"expired", zone.Responses.Expired},
+		{"stale", zone.Responses.Stale},
+		{"updating", zone.Responses.Updating},
+		{"revalidated", zone.Responses.Revalidated},
+		{"hit", zone.Responses.Hit},
+		{"scarce", zone.Responses.Scarce},
+	}
+
+	for _, cacheStatus := range cacheStatuses {
+		writer.counter(
+			name+"cache_responses",
+			"Amount of responses by cache status",
+			float64(cacheStatus.value),
+			zoneLabel,
+			label{"cache_status", cacheStatus.status},
+		)
+	}
+}
+
+func writeCountries(
+	writer *metricsWriter,
+	prefix string,
+	zones map[string]nginx.StatsZoneData,
+	zoneLabel label,
+) {
+	for _, country := range sortedKeys(zones) {
+		writer.counter(
+			metricPrefix+prefix+"country_requests",
+			"Amount of requests by country of origin",
+			float64(zones[country].RequestCounter),
+			zoneLabel,
+			label{"country", country},
+		)
+	}
+}
+
+func writeUpstreamServer(
+	writer *metricsWriter,
+	upstream string,
+	server *nginx.StatsUpstreamZoneData,
+) {
+	name := metricPrefix + "upstream_"
+	labels := []label{{"upstream", upstream}, {"server", server.Server}}
+
+	writer.counter(name+"requests", "Amount of requests", float64(server.RequestCounter), labels...)
+	writer.counter(
+		name+"received_bytes",
+		"Amount of bytes received",
+		float64(server.InBytes),
+		labels...)
+	writer.counter(name+"sent_bytes", "Amount of bytes sent", float64(server.OutBytes), labels...)
+	writer.gauge(
+		name+"response_duration_seconds",
+		"Average duration of the upstream responses",
+		float64(server.ResponseMsec)/1000,
+		labels...,
+	)
+	writer.gauge(
+		name+"down",
+		"Whether the upstream server is marked as down",
+		boolValue(server.Down),
+		labels...)
+
+	responses := []struct {
+		code  string
+		value uint64
+	}{
+		{"1xx", server.Responses.Status1xx},
+		{"2xx", server.Responses.Status2xx},
+		{"3xx", server.Responses.Status3xx},
+		{"4xx", server.Responses.Status4xx},
+		{"5xx", server.Responses.Status5xx},
+	}
+
+	for _, response := range responses {
+		writer.counter(
+			name+"responses",
+			"Amount of responses by status code class",
+			float64(response.value),
+			append(slices.Clone(labels), label{"code", response.code})...,
+		)
+	}
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	return slices.Sorted(maps.Keys(values))
+}
@@ -0,0 +1,160 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/healthcheck"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+func Test_collector(t *testing.T) {
+	t.Run("collect", func(t *testing.T) {
+		t.Run(
+			"exports traffic stats, certificates, VPN endpoints and health checks",
+			func(t *testing.T) {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				vpnID := uuid.MustParse("5d1c1c4a-8f0e-4f52-9b0e-0d8c61b3c0aa")
+				nginxCommands := nginx.NewMockedCommands(controller)
+				nginxCommands.EXPECT().GetStatus(gomock.Any()).Return(true)
+				nginxCommands.EXPECT().GetLastReload(gomock.Any()).Return(&nginx.ReloadResult{
+					Timestamp: time.Unix(1700000000, 0),
+					Error:     assert.AnError,
+				})
+				nginxCommands.EXPECT().GetTrafficStats(gomock.Any()).Return(newTrafficStats(), nil)
+				nginxCommands.EXPECT().
+					GetVPNEndpointStatuses(gomock.Any()).
+					Return([]nginx.VPNEndpointStatus{
+						{VPNID: vpnID, Name: "office", Active: true},
+					})
+
+				certificateCommands := certificate.NewMockedCommands(controller)
+				certificateCommands.EXPECT().
+					List(gomock.Any(), certificatesPageSize, 0, nil).
+					Return(pagination.Of([]certificate.Certificate{newCertificate()}), nil)
+
+				provider := healthcheck.NewMockedProvider(controller)
+				provider.EXPECT().ID().Return("database").AnyTimes()
+				provider.EXPECT().Check(gomock.Any()).Return(nil)
+				healthCheck := healthcheck.New()
+				healthCheck.Register(provider)
+
+				instance := &collector{
+					nginxCommands:       nginxCommands,
+					certificateCommands: certificateCommands,
+					healthCheck:         healthCheck,
+				}
+
+				writer, err := instance.collect(t.Context())
+				require.NoError(t, err)
+
+				var output strings.Builder
+				require.NoError(t, writer.writeTo(&output))
+				contents := output.String()
+
+				expectedLines := []string{
+					"nginx_ignition_nginx_up 1",
+					"nginx_ignition_nginx_last_reload_success 0",
+					"nginx_ignition_nginx_last_reload_timestamp_seconds 1700000000",
+					"nginx_ignition_traffic_stats_available 1",
+					`nginx_ignition_nginx_connections{state="active"} 10`,
+					"nginx_ignition_nginx_connections_accepted_total 100",
+					`nginx_ignition_server_requests_total{domain="example.com"} 50`,
+					`nginx_ignition_server_request_duration_seconds{domain="example.com"} 0.25`,
+					`nginx_ignition_server_responses_total{domain="example.com",code="5xx"} 5`,
+					`nginx_ignition_server_cache_responses_total{domain="example.com",cache_status="hit"} 12`,
+					`nginx_ignition_server_country_requests_total{domain="example.com",country="BR"} 40`,
+					`nginx_ignition_host_requests_total{host_id="6f1ee3ee-5e4b-4a51-a7a4-2d4b0c4f7a10"} 30`,
+					`nginx_ignition_host_country_requests_total{host_id="6f1ee3ee-5e4b-4a51-a7a4-2d4b0c4f7a10",country="DE"} 10`,
+					`nginx_ignition_upstream_requests_total{upstream="backend",server="10.0.0.1:8080"} 25`,
+					`nginx_ignition_upstream_response_duration_seconds{upstream="backend",server="10.0.0.1:8080"} 1.5`,
+					`nginx_ignition_upstream_down{upstream="backend",server="10.0.0.1:8080"} 1`,
+					`nginx_ignition_vpn_endpoint_up{vpn_id="5d1c1c4a-8f0e-4f52-9b0e-0d8c61b3c0aa",name="office"} 1`,
+					"nginx_ignition_healthy 1",
+					`nginx_ignition_health_check_up{check="database"} 1`,
+					`nginx_ignition_certificate_expiry_timestamp_seconds{` +
+						`certificate_id="0b9d2c1e-3a46-4bd9-8e0f-3b9a8a3c1d55",` +
+						`domains="example.com,www.example.com"} 1893456000`,
+				}
+
+				for _, line := range expectedLines {
+					assert.Contains(t, contents, line+"\n")
+				}
+			},
+		)
+
+		t.Run(
+			"reports traffic stats as unavailable when they cannot be retrieved",
+			func(t *testing.T) {
+				controller := gomock.NewController(t)
+				defer controller.Finish()
+
+				nginxCommands := nginx.NewMockedCommands(controller)
+				nginxCommands.EXPECT().GetStatus(gomock.Any()).Return(false)
+				nginxCommands.EXPECT().GetLastReload(gomock.Any()).Return(nil)
+				nginxCommands.EXPECT().GetTrafficStats(gomock.Any()).Return(nil, assert.AnError)
+				nginxCommands.EXPECT().GetVPNEndpointStatuses(gomock.Any()).Return(nil)
+
+				certificateCommands := certificate.NewMockedCommands(controller)
+				certificateCommands.EXPECT().
+					List(gomock.Any(), certificatesPageSize, 0, nil).
+					Return(pagination.Of([]certificate.Certificate{}), nil)
+
+				instance := &collector{
+					nginxCommands:       nginxCommands,
+					certificateCommands: certificateCommands,
+					healthCheck:         healthcheck.New(),
+				}
+
+				writer, err := instance.collect(t.Context())
+				require.NoError(t, err)
+
+				var output strings.Builder
+				require.NoError(t, writer.writeTo(&output))
+				contents := output.String()
+
+				assert.Contains(t, contents, "nginx_ignition_nginx_up 0\n")
+				assert.Contains(t, contents, "nginx_ignition_traffic_stats_available 0\n")
+				assert.NotContains(t, contents, "nginx_ignition_nginx_last_reload_success")
+				assert.NotContains(t, contents, "nginx_ignition_server_requests")
+			},
+		)
+
+		t.Run("returns error when certificates cannot be listed", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			nginxCommands := nginx.NewMockedCommands(controller)
+			nginxCommands.EXPECT().GetStatus(gomock.Any()).Return(true)
+			nginxCommands.EXPECT().GetLastReload(gomock.Any()).Return(nil)
+			nginxCommands.EXPECT().GetTrafficStats(gomock.Any()).Return(nil, assert.AnError)
+			nginxCommands.EXPECT().GetVPNEndpointStatuses(gomock.Any()).Return(nil)
+
+			certificateCommands := certificate.NewMockedCommands(controller)
+			certificateCommands.EXPECT().
+				List(gomock.Any(), certificatesPageSize, 0, nil).
+				Return(nil, assert.AnError)
+
+			instance := &collector{
+				nginxCommands:       nginxCommands,
+				certificateCommands: certificateCommands,
+				healthCheck:         healthcheck.New(),
+			}
+
+			writer, err := instance.collect(t.Context())
+
+			assert.Nil(t, writer)
+			assert.Equal(t, assert.AnError, err)
+		})
+	})
+}
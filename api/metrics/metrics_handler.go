@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+)
+
+type metricsHandler struct {
+	collector *collector
+	token     string
+}
+
+func (h metricsHandler) handle(ctx *gin.Context) {
+	if !h.isAuthorized(ctx) {
+		ctx.Header("WWW-Authenticate", "Bearer")
+		panic(apierror.New(
+			http.StatusUnauthorized,
+			i18n.M(ctx.Request.Context(), i18n.K.ApiCommonAuthorizationInvalidAccessToken),
+		))
+	}
+
+	writer, err := h.collector.collect(ctx.Request.Context())
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.Header("Content-Type", contentType)
+	ctx.Status(http.StatusOK)
+	if err = writer.writeTo(ctx.Writer); err != nil {
+		panic(err)
+	}
+}
+
+func (h metricsHandler) isAuthorized(ctx *gin.Context) bool {
+	if h.token == "" {
+		return true
+	}
+
+	token, found := strings.CutPrefix(ctx.GetHeader("Authorization"), "Bearer ")
+	if !found {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(token)), []byte(h.token)) == 1
+}
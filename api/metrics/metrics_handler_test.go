@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/healthcheck"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_metricsHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		newCollector := func(controller *gomock.Controller) *collector {
+			nginxCommands := nginx.NewMockedCommands(controller)
+			nginxCommands.EXPECT().GetStatus(gomock.Any()).Return(true)
+			nginxCommands.EXPECT().GetLastReload(gomock.Any()).Return(nil)
+			nginxCommands.EXPECT().GetTrafficStats(gomock.Any()).Return(nil, assert.AnError)
+			nginxCommands.EXPECT().GetVPNEndpointStatuses(gomock.Any()).Return(nil)
+
+			certificateCommands := certificate.NewMockedCommands(controller)
+			certificateCommands.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(pagination.Of([]certificate.Certificate{}), nil)
+
+			return &collector{
+				nginxCommands:       nginxCommands,
+				certificateCommands: certificateCommands,
+				healthCheck:         healthcheck.New(),
+			}
+		}
+
+		t.Run("returns 200 OK with the metrics when no token is configured", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", "/metrics", nil)
+
+			handler := metricsHandler{
+				collector: newCollector(controller),
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, contentType, recorder.Header().Get("Content-Type"))
+			assert.Contains(t, recorder.Body.String(), "nginx_ignition_nginx_up 1\n")
+			assert.Contains(t, recorder.Body.String(), "# EOF\n")
+		})
+
+		t.Run("returns 200 OK when the bearer token matches", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", "/metrics", nil)
+			ginContext.Request.Header.Set("Authorization", "Bearer secret-token")
+
+			handler := metricsHandler{
+				collector: newCollector(controller),
+				token:     "secret-token",
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+
+		t.Run("panics with unauthorized when the bearer token does not match", func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", "/metrics", nil)
+			ginContext.Request.Header.Set("Authorization", "Bearer wrong-token")
+
+			handler := metricsHandler{
+				token: "secret-token",
+			}
+
+			defer func() {
+				panicked := recover()
+				apiErr, ok := panicked.(*apierror.APIError)
+				require.True(t, ok)
+				assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+				assert.Equal(t, "Bearer", recorder.Header().Get("WWW-Authenticate"))
+			}()
+			handler.handle(ginContext)
+		})
+
+		t.Run("panics with unauthorized when the bearer token is missing", func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", "/metrics", nil)
+
+			handler := metricsHandler{
+				token: "secret-token",
+			}
+
+			defer func() {
+				panicked := recover()
+				apiErr, ok := panicked.(*apierror.APIError)
+				require.True(t, ok)
+				assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+			}()
+			handler.handle(ginContext)
+		})
+	})
+}
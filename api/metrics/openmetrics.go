@@ -0,0 +1,126 @@
+package metrics
+
+import (
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	contentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+type metricType string
+
+const (
+	gaugeMetricType   metricType = "gauge"
+	counterMetricType metricType = "counter"
+)
+
+type label struct {
+	name  string
+	value string
+}
+
+type metricSample struct {
+	labels []label
+	value  float64
+}
+
+type metricFamily struct {
+	name    string
+	help    string
+	kind    metricType
+	samples []metricSample
+}
+
+type metricsWriter struct {
+	families []*metricFamily
+	index    map[string]*metricFamily
+}
+
+func newMetricsWriter() *metricsWriter {
+	return &metricsWriter{
+		families: make([]*metricFamily, 0),
+		index:    make(map[string]*metricFamily),
+	}
+}
+
+func (w *metricsWriter) gauge(name, help string, value float64, labels ...label) {
+	w.add(name, help, gaugeMetricType, value, labels)
+}
+
+func (w *metricsWriter) counter(name, help string, value float64, labels ...label) {
+	w.add(name, help, counterMetricType, value, labels)
+}
+
+func (w *metricsWriter) add(name, help string, kind metricType, value float64, labels []label) {
+	family, exists := w.index[name]
+	if !exists {
+		family = &metricFamily{
+			name: name,
+			help: help,
+			kind: kind,
+		}
+
+		w.index[name] = family
+		w.families = append(w.families, family)
+	}
+
+	family.samples = append(family.samples, metricSample{
+		labels: labels,
+		value:  value,
+	})
+}
+
+func (w *metricsWriter) writeTo(output io.Writer) error {
+	var builder strings.Builder
+	for _, family := range w.families {
+		builder.WriteString("# TYPE " + family.name + " " + string(family.kind) + "\n")
+		builder.WriteString("# HELP " + family.name + " " + escapeValue(family.help) + "\n")
+
+		sampleName := family.name
+		if family.kind == counterMetricType {
+			sampleName += "_total"
+		}
+
+		for _, sample := range family.samples {
+			builder.WriteString(sampleName)
+			writeLabels(&builder, sample.labels)
+			builder.WriteString(" " + strconv.FormatFloat(sample.value, 'f', -1, 64) + "\n")
+		}
+	}
+
+	builder.WriteString("# EOF\n")
+
+	_, err := io.WriteString(output, builder.String())
+	return err
+}
+
+func writeLabels(builder *strings.Builder, labels []label) {
+	if len(labels) == 0 {
+		return
+	}
+
+	builder.WriteString("{")
+	for index, item := range labels {
+		if index > 0 {
+			builder.WriteString(",")
+		}
+
+		builder.WriteString(item.name + "=\"" + escapeValue(item.value) + "\"")
+	}
+	builder.WriteString("}")
+}
+
+func escapeValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+func boolValue(value bool) float64 {
+	if value {
+		return 1
+	}
+
+	return 0
+}
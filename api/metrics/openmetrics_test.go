@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_metricsWriter(t *testing.T) {
+	t.Run("writeTo", func(t *testing.T) {
+		t.Run("groups samples by family and terminates with EOF", func(t *testing.T) {
+			writer := newMetricsWriter()
+			writer.counter("test_requests", "Amount of requests", 10, label{"domain", "a.com"})
+			writer.gauge("test_up", "Whether it is up", 1)
+			writer.counter("test_requests", "Amount of requests", 2.5, label{"domain", "b.com"})
+
+			var output strings.Builder
+			err := writer.writeTo(&output)
+
+			assert.NoError(t, err)
+			assert.Equal(
+				t,
+				"# TYPE test_requests counter\n"+
+					"# HELP test_requests Amount of requests\n"+
+					"test_requests_total{domain=\"a.com\"} 10\n"+
+					"test_requests_total{domain=\"b.com\"} 2.5\n"+
+					"# TYPE test_up gauge\n"+
+					"# HELP test_up Whether it is up\n"+
+					"test_up 1\n"+
+					"# EOF\n",
+				output.String(),
+			)
+		})
+
+		t.Run("escapes label values", func(t *testing.T) {
+			writer := newMetricsWriter()
+			writer.gauge("test_up", "Whether it is up", 0, label{"name", "a \"b\"\\c\nd"})
+
+			var output strings.Builder
+			err := writer.writeTo(&output)
+
+			assert.NoError(t, err)
+			assert.Contains(t, output.String(), `test_up{name="a \"b\"\\c\nd"} 0`)
+		})
+
+		t.Run("writes only the EOF marker when empty", func(t *testing.T) {
+			var output strings.Builder
+			err := newMetricsWriter().writeTo(&output)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "# EOF\n", output.String())
+		})
+	})
+}
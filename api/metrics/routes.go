@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/healthcheck"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+const (
+	metricsPath = "/metrics"
+)
+
+func Install(
+	router *gin.Engine,
+	cfg *configuration.Configuration,
+	nginxCommands nginx.Commands,
+	certificateCommands certificate.Commands,
+	healthCheck *healthcheck.HealthCheck,
+) {
+	prefixedConfiguration := cfg.WithPrefix("nginx-ignition.metrics")
+
+	enabled, err := prefixedConfiguration.GetBoolean("enabled")
+	if err != nil {
+		log.Warnf(
+			"Unable to check if the metrics endpoint should be enabled (%v). Keeping it disabled as a fallback.",
+			err,
+		)
+		return
+	}
+
+	if !enabled {
+		return
+	}
+
+	token, err := prefixedConfiguration.Get("token")
+	if err != nil {
+		token = ""
+	}
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		log.Warnf(
+			"Metrics endpoint enabled without a token. Anyone able to reach the server can read it.",
+		)
+	}
+
+	handler := metricsHandler{
+		collector: &collector{
+			nginxCommands:       nginxCommands,
+			certificateCommands: certificateCommands,
+			healthCheck:         healthCheck,
+		},
+		token: token,
+	}
+
+	router.GET(metricsPath, handler.handle)
+}
@@ -1,8 +1,11 @@
 package nginx
 
 import (
+	"time"
+
 	"dillmann.com.br/nginx-ignition/core/nginx"
 	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/trafficstats"
 )
 
 func newMetadata() *nginx.Metadata {
@@ -47,3 +50,39 @@ func newSettings() *settings.Settings {
 		},
 	}
 }
+
+func newHistorySamples() []trafficstats.Sample {
+	timestamp := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	counters := trafficstats.Counters{
+		Requests:     4,
+		InBytes:      100,
+		OutBytes:     200,
+		RequestMsecs: 40,
+		Status2xx:    3,
+		Status5xx:    1,
+	}
+
+	return []trafficstats.Sample{
+		{
+			Timestamp:  timestamp,
+			Resolution: trafficstats.MinuteResolution,
+			Scope:      trafficstats.HostScope,
+			Key:        "host-1",
+			Counters:   counters,
+		},
+		{
+			Timestamp:  timestamp.Add(time.Minute),
+			Resolution: trafficstats.MinuteResolution,
+			Scope:      trafficstats.HostScope,
+			Key:        "host-1",
+			Counters:   counters,
+		},
+		{
+			Timestamp:  timestamp,
+			Resolution: trafficstats.MinuteResolution,
+			Scope:      trafficstats.HostScope,
+			Key:        "host-2",
+			Counters:   counters,
+		},
+	}
+}
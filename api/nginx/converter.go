@@ -1,6 +1,9 @@
 package nginx
 
-import "dillmann.com.br/nginx-ignition/core/nginx"
+import (
+	"dillmann.com.br/nginx-ignition/core/nginx"
+	"dillmann.com.br/nginx-ignition/core/trafficstats"
+)
 
 func toTrafficStatsResponseDTO(stats *nginx.Stats) trafficStatsResponseDTO {
 	if stats == nil {
@@ -155,3 +158,51 @@ func toTimeSeriesDTO(timeSeries nginx.StatsTimeSeries) trafficStatsTimeSeriesDTO
 		Msecs: timeSeries.Msecs,
 	}
 }
+
+func toTrafficStatsHistoryResponseDTO(
+	query *trafficstats.HistoryQuery,
+	samples []trafficstats.Sample,
+) trafficStatsHistoryResponseDTO {
+	series := make([]trafficStatsHistorySeriesDTO, 0)
+	for _, sample := range samples {
+		if len(series) == 0 || series[len(series)-1].Key != sample.Key {
+			series = append(series, trafficStatsHistorySeriesDTO{
+				Key:    sample.Key,
+				Points: make([]trafficStatsHistoryPointDTO, 0),
+			})
+		}
+
+		current := &series[len(series)-1]
+		current.Points = append(current.Points, toTrafficStatsHistoryPointDTO(&sample))
+	}
+
+	return trafficStatsHistoryResponseDTO{
+		Resolution: string(query.Resolution),
+		Scope:      string(query.Scope),
+		Series:     series,
+	}
+}
+
+func toTrafficStatsHistoryPointDTO(sample *trafficstats.Sample) trafficStatsHistoryPointDTO {
+	counters := sample.Counters
+
+	var averageRequestMsecs float64
+	if counters.Requests > 0 {
+		averageRequestMsecs = float64(counters.RequestMsecs) / float64(counters.Requests)
+	}
+
+	return trafficStatsHistoryPointDTO{
+		Timestamp:           sample.Timestamp,
+		Requests:            counters.Requests,
+		InBytes:             counters.InBytes,
+		OutBytes:            counters.OutBytes,
+		AverageRequestMsecs: averageRequestMsecs,
+		Responses: trafficStatsUpstreamResponsesDTO{
+			Status1xx: counters.Status1xx,
+			Status2xx: counters.Status2xx,
+			Status3xx: counters.Status3xx,
+			Status4xx: counters.Status4xx,
+			Status5xx: counters.Status5xx,
+		},
+	}
+}
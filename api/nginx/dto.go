@@ -1,5 +1,7 @@
 package nginx
 
+import "time"
+
 type trafficStatsResponseDTO struct {
 	ServerZones   map[string]trafficStatsZoneDataDTO            `json:"serverZones"`
 	FilterZones   map[string]map[string]trafficStatsZoneDataDTO `json:"filterZones"`
@@ -75,3 +77,23 @@ type trafficStatsUpstreamResponsesDTO struct {
 	Status4xx uint64 `json:"4xx"`
 	Status5xx uint64 `json:"5xx"`
 }
+
+type trafficStatsHistoryResponseDTO struct {
+	Resolution string                         `json:"resolution"`
+	Scope      string                         `json:"scope"`
+	Series     []trafficStatsHistorySeriesDTO `json:"series"`
+}
+
+type trafficStatsHistorySeriesDTO struct {
+	Key    string                        `json:"key"`
+	Points []trafficStatsHistoryPointDTO `json:"points"`
+}
+
+type trafficStatsHistoryPointDTO struct {
+	Timestamp           time.Time                        `json:"timestamp"`
+	Responses           trafficStatsUpstreamResponsesDTO `json:"responses"`
+	Requests            uint64                           `json:"requests"`
+	InBytes             uint64                           `json:"inBytes"`
+	OutBytes            uint64                           `json:"outBytes"`
+	AverageRequestMsecs float64                          `json:"averageRequestMsecs"`
+}
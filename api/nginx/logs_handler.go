@@ -42,9 +42,17 @@ func (h logsHandler) handle(ctx *gin.Context) {
 		}
 	}
 
+	before, valid := logline.ExtractBeforeOffset(ctx)
+	if !valid {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"message": "Before offset should be a non-negative integer",
+		})
+		return
+	}
+
 	search := logline.ExtractSearchParams(ctx)
 
-	logs, err := h.commands.GetMainLogs(ctx.Request.Context(), lineCount, search)
+	logs, err := h.commands.GetMainLogs(ctx.Request.Context(), lineCount, before, search)
 	if err != nil {
 		panic(err)
 	}
@@ -26,12 +26,12 @@ func Test_logsHandler(t *testing.T) {
 			defer controller.Finish()
 
 			logs := []logline.LogLine{
-				{LineNumber: 0, Contents: "log line 1"},
-				{LineNumber: 1, Contents: "log line 2"},
+				{Contents: "log line 1"},
+				{Contents: "log line 2"},
 			}
 			commands := nginx.NewMockedCommands(controller)
 			commands.EXPECT().
-				GetMainLogs(gomock.Any(), 50, nil).
+				GetMainLogs(gomock.Any(), 50, nil, nil).
 				Return(logs, nil)
 
 			handler := logsHandler{
@@ -49,8 +49,8 @@ func Test_logsHandler(t *testing.T) {
 			json.Unmarshal(recorder.Body.Bytes(), &response)
 
 			expectedResponse := []apilogline.ResponseDTO{
-				{LineNumber: 0, Contents: "log line 1"},
-				{LineNumber: 1, Contents: "log line 2"},
+				{Contents: "log line 1"},
+				{Contents: "log line 2"},
 			}
 			assert.Equal(t, expectedResponse, response)
 		})
@@ -69,6 +69,42 @@ func Test_logsHandler(t *testing.T) {
 			assert.Equal(t, http.StatusBadRequest, recorder.Code)
 		})
 
+		t.Run("forwards the before offset", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := nginx.NewMockedCommands(controller)
+			commands.EXPECT().
+				GetMainLogs(gomock.Any(), 50, new(int64(2048)), nil).
+				Return([]logline.LogLine{}, nil)
+
+			handler := logsHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/nginx/logs", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/nginx/logs?before=2048", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+
+		t.Run("returns 400 Bad Request on invalid before offset", func(t *testing.T) {
+			handler := logsHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.GET("/api/nginx/logs", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/nginx/logs?before=-1", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
 		t.Run("panics when command returns error", func(t *testing.T) {
 			controller := gomock.NewController(t)
 			defer controller.Finish()
@@ -76,7 +112,7 @@ func Test_logsHandler(t *testing.T) {
 			expectedErr := assert.AnError
 			commands := nginx.NewMockedCommands(controller)
 			commands.EXPECT().
-				GetMainLogs(gomock.Any(), 50, nil).
+				GetMainLogs(gomock.Any(), 50, nil, nil).
 				Return(nil, expectedErr)
 
 			handler := logsHandler{
@@ -0,0 +1,23 @@
+package nginx
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/logline"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+type logsStreamHandler struct {
+	commands nginx.Commands
+}
+
+func (h logsStreamHandler) handle(ctx *gin.Context) {
+	search := logline.ExtractSearchParams(ctx)
+
+	channel, err := h.commands.TailMainLogs(ctx.Request.Context(), search)
+	if err != nil {
+		panic(err)
+	}
+
+	logline.Stream(ctx, channel)
+}
@@ -0,0 +1,69 @@
+package nginx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+func Test_logsStreamHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("streams the new log lines as events", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			channel := make(chan []logline.LogLine, 1)
+			channel <- []logline.LogLine{{Contents: "new log line"}}
+			close(channel)
+
+			commands := nginx.NewMockedCommands(controller)
+			commands.EXPECT().
+				TailMainLogs(gomock.Any(), nil).
+				Return((<-chan []logline.LogLine)(channel), nil)
+
+			handler := logsStreamHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/nginx/logs/stream", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/nginx/logs/stream", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), "event:lines\n")
+			assert.Contains(t, recorder.Body.String(), `"contents":"new log line"`)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := nginx.NewMockedCommands(controller)
+			commands.EXPECT().
+				TailMainLogs(gomock.Any(), nil).
+				Return(nil, assert.AnError)
+
+			handler := logsStreamHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/nginx/logs/stream", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/nginx/logs/stream", nil)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
 	"dillmann.com.br/nginx-ignition/core/nginx"
+	"dillmann.com.br/nginx-ignition/core/nginx/cfgfiles"
 )
 
 func init() {
@@ -66,5 +68,29 @@ func Test_reloadHandler(t *testing.T) {
 			json.Unmarshal(recorder.Body.Bytes(), &response)
 			assert.Equal(t, expectedErr.Error(), response["message"])
 		})
+
+		t.Run("panics on syntax check errors", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			expectedErr := &cfgfiles.SyntaxError{Message: i18n.Static("invalid")}
+			commands := nginx.NewMockedCommands(controller)
+			commands.EXPECT().
+				Reload(gomock.Any(), gomock.Any()).
+				Return(expectedErr)
+
+			handler := reloadHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.POST("/api/nginx/reload", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("POST", "/api/nginx/reload", nil)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
 	})
 }
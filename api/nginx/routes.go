@@ -6,6 +6,7 @@ import (
 	"dillmann.com.br/nginx-ignition/api/common/authorization"
 	"dillmann.com.br/nginx-ignition/core/nginx"
 	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/trafficstats"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
@@ -13,6 +14,7 @@ func Install(
 	router *gin.Engine,
 	nginxCommands nginx.Commands,
 	settingsCommands settings.Commands,
+	trafficStatsCommands trafficstats.Commands,
 	authorizer *authorization.ABAC,
 ) {
 	basePath := authorizer.ConfigureGroup(
@@ -32,6 +34,7 @@ func Install(
 		func(permissions user.Permissions) user.AccessLevel { return permissions.Logs },
 	)
 	logsPath.GET("", logsHandler{nginxCommands}.handle)
+	logsPath.GET("/stream", logsStreamHandler{nginxCommands}.handle)
 
 	exportPath := authorizer.ConfigureGroup(
 		router,
@@ -46,4 +49,5 @@ func Install(
 		func(permissions user.Permissions) user.AccessLevel { return permissions.TrafficStats },
 	)
 	trafficStatsPath.GET("", trafficStatsHandler{nginxCommands}.handle)
+	trafficStatsPath.GET("/history", trafficStatsHistoryHandler{trafficStatsCommands}.handle)
 }
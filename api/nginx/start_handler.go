@@ -1,12 +1,14 @@
 package nginx
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	"dillmann.com.br/nginx-ignition/core/common/log"
 	"dillmann.com.br/nginx-ignition/core/nginx"
+	"dillmann.com.br/nginx-ignition/core/nginx/cfgfiles"
 )
 
 type startHandler struct {
@@ -14,7 +16,12 @@ type startHandler struct {
 }
 
 func (h startHandler) handle(ctx *gin.Context) {
-	if err := h.commands.Start(ctx.Request.Context()); err != nil {
+	err := h.commands.Start(ctx.Request.Context())
+	if errors.As(err, new(*cfgfiles.SyntaxError)) {
+		panic(err)
+	}
+
+	if err != nil {
 		log.Warnf("Failed to start Nginx: %s", err.Error())
 		ctx.JSON(http.StatusFailedDependency, gin.H{"message": err.Error()})
 		return
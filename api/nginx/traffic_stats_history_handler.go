@@ -0,0 +1,48 @@
+package nginx
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/trafficstats"
+)
+
+type trafficStatsHistoryHandler struct {
+	commands trafficstats.Commands
+}
+
+func (h trafficStatsHistoryHandler) handle(ctx *gin.Context) {
+	query := &trafficstats.HistoryQuery{
+		Resolution: trafficstats.Resolution(ctx.Query("resolution")),
+		Scope:      trafficstats.Scope(ctx.Query("scope")),
+		From:       parseHistoryTime(ctx, "from"),
+		To:         parseHistoryTime(ctx, "to"),
+	}
+
+	if value, exists := ctx.GetQuery("key"); exists {
+		query.Key = &value
+	}
+
+	samples, err := h.commands.GetHistory(ctx.Request.Context(), query)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.JSON(http.StatusOK, toTrafficStatsHistoryResponseDTO(query, samples))
+}
+
+func parseHistoryTime(ctx *gin.Context, name string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, ctx.Query(name))
+	if err != nil {
+		panic(apierror.New(
+			http.StatusBadRequest,
+			i18n.M(ctx.Request.Context(), i18n.K.ApiNginxInvalidHistoryParameter).V("name", name),
+		))
+	}
+
+	return parsed
+}
@@ -0,0 +1,136 @@
+package nginx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/trafficstats"
+)
+
+const historyPath = "/api/nginx/traffic-stats/history?resolution=MINUTE&scope=HOST" +
+	"&from=2026-01-01T10:00:00Z&to=2026-01-01T11:00:00Z"
+
+func Test_trafficStatsHistoryHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with the history grouped by key", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := trafficstats.NewMockedCommands(controller)
+			commands.EXPECT().
+				GetHistory(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, query *trafficstats.HistoryQuery) ([]trafficstats.Sample, error) {
+					assert.Equal(t, trafficstats.MinuteResolution, query.Resolution)
+					assert.Equal(t, trafficstats.HostScope, query.Scope)
+					assert.Nil(t, query.Key)
+					assert.Equal(t, time.Hour, query.To.Sub(query.From))
+					return newHistorySamples(), nil
+				})
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", historyPath, nil)
+
+			handler := trafficStatsHistoryHandler{
+				commands: commands,
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response trafficStatsHistoryResponseDTO
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, "MINUTE", response.Resolution)
+			require.Len(t, response.Series, 2)
+			assert.Equal(t, "host-1", response.Series[0].Key)
+			require.Len(t, response.Series[0].Points, 2)
+			assert.Equal(t, uint64(4), response.Series[0].Points[0].Requests)
+			assert.InDelta(t, 10.0, response.Series[0].Points[0].AverageRequestMsecs, 0.001)
+			assert.Equal(t, uint64(1), response.Series[0].Points[0].Responses.Status5xx)
+			assert.Equal(t, "host-2", response.Series[1].Key)
+		})
+
+		t.Run("forwards the key when provided", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := trafficstats.NewMockedCommands(controller)
+			commands.EXPECT().
+				GetHistory(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, query *trafficstats.HistoryQuery) ([]trafficstats.Sample, error) {
+					require.NotNil(t, query.Key)
+					assert.Equal(t, "host-1", *query.Key)
+					return nil, nil
+				})
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", historyPath+"&key=host-1", nil)
+
+			handler := trafficStatsHistoryHandler{
+				commands: commands,
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+
+		t.Run("panics with bad request on invalid time", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := trafficstats.NewMockedCommands(controller)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"GET",
+				"/api/nginx/traffic-stats/history?resolution=MINUTE&scope=HOST&from=invalid",
+				nil,
+			)
+
+			handler := trafficStatsHistoryHandler{
+				commands: commands,
+			}
+
+			defer func() {
+				panicked := recover()
+				apiErr, ok := panicked.(*apierror.APIError)
+				require.True(t, ok)
+				assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+			}()
+			handler.handle(ginContext)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			expectedErr := assert.AnError
+			commands := trafficstats.NewMockedCommands(controller)
+			commands.EXPECT().
+				GetHistory(gomock.Any(), gomock.Any()).
+				Return(nil, expectedErr)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", historyPath, nil)
+
+			handler := trafficStatsHistoryHandler{
+				commands: commands,
+			}
+			assert.PanicsWithValue(t, expectedErr, func() {
+				handler.handle(ginContext)
+			})
+		})
+	})
+}
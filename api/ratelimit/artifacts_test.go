@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+)
+
+func newRateLimitRequestDTO() rateLimitRequestDTO {
+	return rateLimitRequestDTO{
+		Name:               "API clients",
+		KeyType:            ratelimit.ClientIPKeyType,
+		Rate:               10,
+		RateUnit:           ratelimit.PerSecondRateUnit,
+		Burst:              20,
+		NoDelay:            true,
+		ResponseStatusCode: 429,
+		ConnectionLimit: connectionLimitDTO{
+			Enabled:            true,
+			MaximumConnections: new(50),
+		},
+	}
+}
+
+func newRateLimit() *ratelimit.RateLimit {
+	return &ratelimit.RateLimit{
+		ID:                 uuid.New(),
+		Name:               "API clients",
+		KeyType:            ratelimit.ClientIPKeyType,
+		Rate:               10,
+		RateUnit:           ratelimit.PerSecondRateUnit,
+		Burst:              20,
+		NoDelay:            true,
+		ResponseStatusCode: 429,
+		ConnectionLimit: ratelimit.ConnectionLimit{
+			Enabled:            true,
+			MaximumConnections: new(50),
+		},
+	}
+}
+
+func newRateLimitPage() *pagination.Page[ratelimit.RateLimit] {
+	return pagination.Of([]ratelimit.RateLimit{
+		*newRateLimit(),
+	})
+}
@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+)
+
+func toDomain(id uuid.UUID, dto *rateLimitRequestDTO) *ratelimit.RateLimit {
+	return &ratelimit.RateLimit{
+		ID:                 id,
+		Name:               dto.Name,
+		KeyType:            dto.KeyType,
+		KeyName:            dto.KeyName,
+		Rate:               dto.Rate,
+		RateUnit:           dto.RateUnit,
+		Burst:              dto.Burst,
+		NoDelay:            dto.NoDelay,
+		ResponseStatusCode: dto.ResponseStatusCode,
+		ConnectionLimit: ratelimit.ConnectionLimit{
+			Enabled:            dto.ConnectionLimit.Enabled,
+			MaximumConnections: dto.ConnectionLimit.MaximumConnections,
+		},
+	}
+}
+
+func toResponseDTO(domain *ratelimit.RateLimit) rateLimitResponseDTO {
+	return rateLimitResponseDTO{
+		ID:                 domain.ID,
+		Name:               domain.Name,
+		KeyType:            domain.KeyType,
+		KeyName:            domain.KeyName,
+		Rate:               domain.Rate,
+		RateUnit:           domain.RateUnit,
+		Burst:              domain.Burst,
+		NoDelay:            domain.NoDelay,
+		ResponseStatusCode: domain.ResponseStatusCode,
+		ConnectionLimit: connectionLimitDTO{
+			Enabled:            domain.ConnectionLimit.Enabled,
+			MaximumConnections: domain.ConnectionLimit.MaximumConnections,
+		},
+	}
+}
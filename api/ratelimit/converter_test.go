@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toDomain(t *testing.T) {
+	t.Run("converts DTO to domain object", func(t *testing.T) {
+		id := uuid.New()
+		payload := newRateLimitRequestDTO()
+		result := toDomain(id, &payload)
+
+		assert.NotNil(t, result)
+		assert.Equal(t, id, result.ID)
+		assert.Equal(t, payload.Name, result.Name)
+		assert.Equal(t, payload.KeyType, result.KeyType)
+		assert.Equal(t, payload.Rate, result.Rate)
+		assert.Equal(t, payload.RateUnit, result.RateUnit)
+		assert.Equal(
+			t,
+			payload.ConnectionLimit.MaximumConnections,
+			result.ConnectionLimit.MaximumConnections,
+		)
+	})
+}
+
+func Test_toResponseDTO(t *testing.T) {
+	t.Run("converts domain object to response DTO", func(t *testing.T) {
+		subject := newRateLimit()
+		result := toResponseDTO(subject)
+
+		assert.Equal(t, subject.ID, result.ID)
+		assert.Equal(t, subject.Name, result.Name)
+		assert.Equal(t, subject.Burst, result.Burst)
+		assert.Equal(t, subject.NoDelay, result.NoDelay)
+		assert.Equal(t, subject.ConnectionLimit.Enabled, result.ConnectionLimit.Enabled)
+	})
+}
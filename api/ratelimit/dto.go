@@ -0,0 +1,37 @@
+package ratelimit
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+)
+
+type rateLimitRequestDTO struct {
+	KeyName            *string            `json:"keyName"`
+	Name               string             `json:"name"`
+	KeyType            ratelimit.KeyType  `json:"keyType"`
+	RateUnit           ratelimit.RateUnit `json:"rateUnit"`
+	ConnectionLimit    connectionLimitDTO `json:"connectionLimit"`
+	Rate               int                `json:"rate"`
+	Burst              int                `json:"burst"`
+	ResponseStatusCode int                `json:"responseStatusCode"`
+	NoDelay            bool               `json:"noDelay"`
+}
+
+type rateLimitResponseDTO struct {
+	KeyName            *string            `json:"keyName"`
+	Name               string             `json:"name"`
+	KeyType            ratelimit.KeyType  `json:"keyType"`
+	RateUnit           ratelimit.RateUnit `json:"rateUnit"`
+	ConnectionLimit    connectionLimitDTO `json:"connectionLimit"`
+	Rate               int                `json:"rate"`
+	Burst              int                `json:"burst"`
+	ResponseStatusCode int                `json:"responseStatusCode"`
+	ID                 uuid.UUID          `json:"id"`
+	NoDelay            bool               `json:"noDelay"`
+}
+
+type connectionLimitDTO struct {
+	MaximumConnections *int `json:"maximumConnections"`
+	Enabled            bool `json:"enabled"`
+}
@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_getHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with rate limit data on success", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			rateLimit := newRateLimit()
+			commands := ratelimit.NewMockedCommands(controller)
+			commands.EXPECT().
+				Get(gomock.Any(), rateLimit.ID).
+				Return(rateLimit, nil)
+
+			handler := getHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/rate-limits/:id", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/rate-limits/"+rateLimit.ID.String(), nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response rateLimitResponseDTO
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Equal(t, rateLimit.ID, response.ID)
+		})
+
+		t.Run("returns 404 Not Found on invalid ID", func(t *testing.T) {
+			handler := getHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.GET("/api/rate-limits/:id", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/rate-limits/invalid", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			expectedErr := errors.New("get error")
+			commands := ratelimit.NewMockedCommands(controller)
+			commands.EXPECT().
+				Get(gomock.Any(), id).
+				Return(nil, expectedErr)
+
+			handler := getHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/rate-limits/:id", func(ginContext *gin.Context) {
+				defer func() {
+					if r := recover(); r != nil {
+						assert.Equal(t, expectedErr, r)
+						panic(r)
+					}
+				}()
+				handler.handle(ginContext)
+			})
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/rate-limits/"+id.String(), nil)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_listHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with rate limit list on success", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			page := newRateLimitPage()
+			commands := ratelimit.NewMockedCommands(controller)
+			commands.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(page, nil)
+
+			handler := listHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/rate-limits", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/rate-limits?pageSize=10&pageNumber=1", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response pagination.PageDTO[rateLimitResponseDTO]
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Len(t, response.Contents, 1)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			expectedErr := errors.New("list error")
+			commands := ratelimit.NewMockedCommands(controller)
+			commands.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, expectedErr)
+
+			handler := listHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/rate-limits", func(ginContext *gin.Context) {
+				defer func() {
+					if r := recover(); r != nil {
+						assert.Equal(t, expectedErr, r)
+						panic(r)
+					}
+				}()
+				handler.handle(ginContext)
+			})
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/rate-limits", nil)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
@@ -0,0 +1,25 @@
+package ratelimit
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func Install(router *gin.Engine, commands ratelimit.Commands, authorizer *authorization.ABAC) {
+	basePath := authorizer.ConfigureGroup(
+		router,
+		"/api/rate-limits",
+		func(permissions user.Permissions) user.AccessLevel { return permissions.RateLimits },
+	)
+
+	basePath.GET("", listHandler{commands}.handle)
+	basePath.POST("", createHandler{commands}.handle)
+
+	byIDPath := basePath.Group("/:id")
+	byIDPath.GET("", getHandler{commands}.handle)
+	byIDPath.PUT("", updateHandler{commands}.handle)
+	byIDPath.DELETE("", deleteHandler{commands}.handle)
+}
@@ -0,0 +1,34 @@
+package revision
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+func newRevision() *revision.Revision {
+	return &revision.Revision{
+		ID:        uuid.New(),
+		CreatedAt: time.Now(),
+		Checksum:  "checksum",
+		Files: []revision.File{
+			{
+				Name:     "nginx.conf",
+				Contents: "events {}",
+			},
+		},
+	}
+}
+
+func newRevisionPage() *pagination.Page[revision.Summary] {
+	return pagination.Of([]revision.Summary{
+		{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+			Checksum:  "checksum",
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package revision
+
+import (
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+func toSummaryResponseDTO(summary *revision.Summary) *revisionSummaryResponseDTO {
+	return &revisionSummaryResponseDTO{
+		ID:        summary.ID,
+		CreatedAt: summary.CreatedAt,
+		Checksum:  summary.Checksum,
+	}
+}
+
+func toResponseDTO(domain *revision.Revision) *revisionResponseDTO {
+	files := make([]fileDTO, len(domain.Files))
+	for index, file := range domain.Files {
+		files[index] = fileDTO{
+			Name:     file.Name,
+			Contents: file.Contents,
+		}
+	}
+
+	return &revisionResponseDTO{
+		ID:        domain.ID,
+		CreatedAt: domain.CreatedAt,
+		Checksum:  domain.Checksum,
+		Files:     files,
+	}
+}
+
+func toDiffResponseDTO(diff *revision.Diff) *diffResponseDTO {
+	files := make([]fileDiffDTO, len(diff.Files))
+	for index, file := range diff.Files {
+		files[index] = fileDiffDTO{
+			Name:        file.Name,
+			ChangeType:  file.ChangeType,
+			UnifiedDiff: file.UnifiedDiff,
+		}
+	}
+
+	return &diffResponseDTO{
+		FromID: diff.FromID,
+		ToID:   diff.ToID,
+		Files:  files,
+	}
+}
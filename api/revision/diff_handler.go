@@ -0,0 +1,35 @@
+package revision
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+type diffHandler struct {
+	commands revision.Commands
+}
+
+func (h diffHandler) handle(ctx *gin.Context) {
+	fromID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	toID, err := uuid.Parse(ctx.Query("to"))
+	if err != nil {
+		ctx.Status(http.StatusBadRequest)
+		return
+	}
+
+	diff, err := h.commands.Diff(ctx.Request.Context(), fromID, toID)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.JSON(http.StatusOK, toDiffResponseDTO(diff))
+}
@@ -0,0 +1,81 @@
+package revision
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_diffHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with the differences on success", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			fromID := uuid.New()
+			toID := uuid.New()
+			commands := revision.NewMockedCommands(controller)
+			commands.EXPECT().
+				Diff(gomock.Any(), fromID, toID).
+				Return(&revision.Diff{
+					FromID: fromID,
+					ToID:   toID,
+					Files: []revision.FileDiff{
+						{
+							Name:        "nginx.conf",
+							ChangeType:  revision.ModifiedFileChangeType,
+							UnifiedDiff: "-a\n+b\n",
+						},
+					},
+				}, nil)
+
+			handler := diffHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/revisions/:id/diff", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"GET",
+				"/api/revisions/"+fromID.String()+"/diff?to="+toID.String(),
+				nil,
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response diffResponseDTO
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Equal(t, fromID, response.FromID)
+			assert.Equal(t, toID, response.ToID)
+			assert.Len(t, response.Files, 1)
+			assert.Equal(t, revision.ModifiedFileChangeType, response.Files[0].ChangeType)
+		})
+
+		t.Run("returns 400 Bad Request when the target revision is missing", func(t *testing.T) {
+			handler := diffHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.GET("/api/revisions/:id/diff", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/revisions/"+uuid.NewString()+"/diff", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+	})
+}
@@ -0,0 +1,39 @@
+package revision
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+type revisionSummaryResponseDTO struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Checksum  string    `json:"checksum"`
+	ID        uuid.UUID `json:"id"`
+}
+
+type revisionResponseDTO struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Checksum  string    `json:"checksum"`
+	Files     []fileDTO `json:"files"`
+	ID        uuid.UUID `json:"id"`
+}
+
+type fileDTO struct {
+	Name     string `json:"name"`
+	Contents string `json:"contents"`
+}
+
+type diffResponseDTO struct {
+	Files  []fileDiffDTO `json:"files"`
+	FromID uuid.UUID     `json:"fromId"`
+	ToID   uuid.UUID     `json:"toId"`
+}
+
+type fileDiffDTO struct {
+	Name        string                  `json:"name"`
+	ChangeType  revision.FileChangeType `json:"changeType"`
+	UnifiedDiff string                  `json:"unifiedDiff"`
+}
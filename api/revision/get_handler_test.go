@@ -0,0 +1,87 @@
+package revision
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_getHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with the revision files on success", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			revisionData := newRevision()
+			commands := revision.NewMockedCommands(controller)
+			commands.EXPECT().
+				Get(gomock.Any(), revisionData.ID).
+				Return(revisionData, nil)
+
+			handler := getHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/revisions/:id", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/revisions/"+revisionData.ID.String(), nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response revisionResponseDTO
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Equal(t, revisionData.ID, response.ID)
+			assert.Equal(t, []fileDTO{{Name: "nginx.conf", Contents: "events {}"}}, response.Files)
+		})
+
+		t.Run("returns 404 Not Found when the revision does not exist", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			commands := revision.NewMockedCommands(controller)
+			commands.EXPECT().
+				Get(gomock.Any(), id).
+				Return(nil, nil)
+
+			handler := getHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/revisions/:id", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/revisions/"+id.String(), nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+
+		t.Run("returns 404 Not Found on invalid ID", func(t *testing.T) {
+			handler := getHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.GET("/api/revisions/:id", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/revisions/invalid", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+	})
+}
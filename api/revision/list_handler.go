@@ -0,0 +1,29 @@
+package revision
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+type listHandler struct {
+	commands revision.Commands
+}
+
+func (h listHandler) handle(ctx *gin.Context) {
+	pageSize, pageNumber, _, err := pagination.ExtractPaginationParameters(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	page, err := h.commands.List(ctx.Request.Context(), pageSize, pageNumber)
+	if err != nil {
+		panic(err)
+	}
+
+	response := pagination.Convert(page, toSummaryResponseDTO)
+	ctx.JSON(http.StatusOK, response)
+}
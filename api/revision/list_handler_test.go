@@ -0,0 +1,83 @@
+package revision
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_listHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with revision list on success", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			page := newRevisionPage()
+			commands := revision.NewMockedCommands(controller)
+			commands.EXPECT().
+				List(gomock.Any(), 10, 1).
+				Return(page, nil)
+
+			handler := listHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/revisions", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/revisions?pageSize=10&pageNumber=1", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response pagination.PageDTO[revisionSummaryResponseDTO]
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Len(t, response.Contents, 1)
+			assert.Equal(t, page.Contents[0].ID, response.Contents[0].ID)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			expectedErr := errors.New("list error")
+			commands := revision.NewMockedCommands(controller)
+			commands.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, expectedErr)
+
+			handler := listHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/revisions", func(ginContext *gin.Context) {
+				defer func() {
+					if r := recover(); r != nil {
+						assert.Equal(t, expectedErr, r)
+						panic(r)
+					}
+				}()
+				handler.handle(ginContext)
+			})
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/revisions", nil)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
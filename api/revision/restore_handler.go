@@ -0,0 +1,42 @@
+package revision
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/nginx/cfgfiles"
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+type restoreHandler struct {
+	commands revision.Commands
+}
+
+func (h restoreHandler) handle(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	err = h.commands.Restore(ctx.Request.Context(), id)
+	if errors.As(err, new(*revision.ReloadError)) &&
+		!errors.As(err, new(*cfgfiles.SyntaxError)) {
+		log.Warnf(
+			"Failed to reload Nginx after restoring a revision, changes were rolled back: %s",
+			err.Error(),
+		)
+		ctx.JSON(http.StatusFailedDependency, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
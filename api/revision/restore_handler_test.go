@@ -0,0 +1,108 @@
+package revision
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/nginx/cfgfiles"
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_restoreHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 204 No Content after restoring", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			commands := revision.NewMockedCommands(controller)
+			commands.EXPECT().Restore(gomock.Any(), id).Return(nil)
+
+			handler := restoreHandler{commands}
+			engine := gin.New()
+			engine.POST("/api/revisions/:id/restore", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("POST", "/api/revisions/"+id.String()+"/restore", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+		})
+
+		t.Run("returns 424 Failed Dependency when the reload fails", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			commands := revision.NewMockedCommands(controller)
+			commands.EXPECT().
+				Restore(gomock.Any(), id).
+				Return(&revision.ReloadError{Cause: assert.AnError})
+
+			handler := restoreHandler{commands}
+			engine := gin.New()
+			engine.POST("/api/revisions/:id/restore", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("POST", "/api/revisions/"+id.String()+"/restore", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusFailedDependency, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), assert.AnError.Error())
+		})
+
+		t.Run("panics with the syntax error when the reload is rejected", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			reloadErr := &revision.ReloadError{
+				Cause: &cfgfiles.SyntaxError{Message: i18n.Static("invalid configuration")},
+			}
+			commands := revision.NewMockedCommands(controller)
+			commands.EXPECT().Restore(gomock.Any(), id).Return(reloadErr)
+
+			handler := restoreHandler{commands}
+			engine := gin.New()
+			engine.POST("/api/revisions/:id/restore", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("POST", "/api/revisions/"+id.String()+"/restore", nil)
+
+			assert.PanicsWithValue(t, reloadErr, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+
+		t.Run("panics when the restore fails", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			commands := revision.NewMockedCommands(controller)
+			commands.EXPECT().Restore(gomock.Any(), id).Return(assert.AnError)
+
+			handler := restoreHandler{commands}
+			engine := gin.New()
+			engine.POST("/api/revisions/:id/restore", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("POST", "/api/revisions/"+id.String()+"/restore", nil)
+
+			assert.PanicsWithValue(t, assert.AnError, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
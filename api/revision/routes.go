@@ -0,0 +1,28 @@
+package revision
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/revision"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func Install(
+	router *gin.Engine,
+	commands revision.Commands,
+	authorizer *authorization.ABAC,
+) {
+	basePath := authorizer.ConfigureGroup(
+		router,
+		"/api/revisions",
+		func(permissions user.Permissions) user.AccessLevel { return permissions.NginxServer },
+	)
+
+	basePath.GET("", listHandler{commands}.handle)
+
+	byIDPath := basePath.Group("/:id")
+	byIDPath.GET("", getHandler{commands}.handle)
+	byIDPath.GET("/diff", diffHandler{commands}.handle)
+	byIDPath.POST("/restore", restoreHandler{commands}.handle)
+}
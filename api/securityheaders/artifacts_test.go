@@ -0,0 +1,62 @@
+package securityheaders
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+func newSecurityHeadersRequestDTO() securityHeadersRequestDTO {
+	return securityHeadersRequestDTO{
+		Name:               "Strict",
+		FrameOptions:       new(securityheaders.DenyFrameOptions),
+		ReferrerPolicy:     new(securityheaders.NoReferrerPolicy),
+		ContentTypeNoSniff: true,
+		ContentSecurityPolicy: contentSecurityPolicyDTO{
+			Enabled:        true,
+			CollectReports: true,
+			Directives: []directiveDTO{
+				{Name: "default-src", Sources: []string{"'self'"}},
+			},
+		},
+	}
+}
+
+func newSecurityHeaders() *securityheaders.SecurityHeaders {
+	return &securityheaders.SecurityHeaders{
+		ID:                 uuid.New(),
+		Name:               "Strict",
+		FrameOptions:       new(securityheaders.DenyFrameOptions),
+		ReferrerPolicy:     new(securityheaders.NoReferrerPolicy),
+		ContentTypeNoSniff: true,
+		ContentSecurityPolicy: securityheaders.ContentSecurityPolicy{
+			Enabled:        true,
+			CollectReports: true,
+			Directives: []securityheaders.Directive{
+				{Name: "default-src", Sources: []string{"'self'"}},
+			},
+		},
+	}
+}
+
+func newSecurityHeadersPage() *pagination.Page[securityheaders.SecurityHeaders] {
+	return pagination.Of([]securityheaders.SecurityHeaders{
+		*newSecurityHeaders(),
+	})
+}
+
+func newReportPage(securityHeadersID uuid.UUID) *pagination.Page[securityheaders.Report] {
+	return pagination.Of([]securityheaders.Report{
+		{
+			ID:                 uuid.New(),
+			SecurityHeadersID:  securityHeadersID,
+			CreatedAt:          time.Now(),
+			DocumentURI:        new("https://example.com/"),
+			BlockedURI:         new("inline"),
+			EffectiveDirective: new("script-src-elem"),
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package securityheaders
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+const maximumReportBodySize = 64 * 1024
+
+type collectReportsHandler struct {
+	commands securityheaders.Commands
+}
+
+func (h collectReportsHandler) handle(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	body, err := io.ReadAll(
+		http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maximumReportBodySize),
+	)
+	if err != nil {
+		ctx.Status(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	reports, err := parseReports(body, h.userAgent(ctx))
+	if err != nil {
+		ctx.Status(http.StatusBadRequest)
+		return
+	}
+
+	if len(reports) > 0 {
+		if err := h.commands.SaveReports(ctx.Request.Context(), id, reports); err != nil {
+			panic(err)
+		}
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h collectReportsHandler) userAgent(ctx *gin.Context) *string {
+	userAgent := ctx.Request.UserAgent()
+	if userAgent == "" {
+		return nil
+	}
+
+	return &userAgent
+}
+
+func parseReports(body []byte, userAgent *string) ([]securityheaders.Report, error) {
+	body = bytes.TrimSpace(body)
+	if bytes.HasPrefix(body, []byte("[")) {
+		var dtos []reportingAPIRequestDTO
+		if err := json.Unmarshal(body, &dtos); err != nil {
+			return nil, err
+		}
+
+		return fromReportingAPIReports(dtos, userAgent), nil
+	}
+
+	var dto legacyReportRequestDTO
+	if err := json.Unmarshal(body, &dto); err != nil {
+		return nil, err
+	}
+
+	if dto.Report == nil {
+		return nil, nil
+	}
+
+	return []securityheaders.Report{fromLegacyReport(dto.Report, userAgent)}, nil
+}
@@ -0,0 +1,143 @@
+package securityheaders
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_collectReportsHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 204 No Content on success", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			commands := securityheaders.NewMockedCommands(controller)
+			commands.EXPECT().
+				SaveReports(gomock.Any(), id, gomock.Len(1)).
+				Return(nil)
+
+			handler := collectReportsHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.POST("/api/security-headers/:id/reports", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"POST",
+				"/api/security-headers/"+id.String()+"/reports",
+				bytes.NewBufferString(`{"csp-report":{"blocked-uri":"inline"}}`),
+			)
+			request.Header.Set("Content-Type", "application/csp-report")
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+		})
+
+		t.Run("returns 404 Not Found on invalid ID", func(t *testing.T) {
+			handler := collectReportsHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.POST("/api/security-headers/:id/reports", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"POST",
+				"/api/security-headers/invalid/reports",
+				bytes.NewBufferString("{}"),
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+
+		t.Run("returns 400 Bad Request on invalid JSON", func(t *testing.T) {
+			handler := collectReportsHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.POST("/api/security-headers/:id/reports", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"POST",
+				"/api/security-headers/"+uuid.New().String()+"/reports",
+				bytes.NewBufferString("invalid json"),
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("returns 413 Request Entity Too Large on oversized bodies", func(t *testing.T) {
+			handler := collectReportsHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.POST("/api/security-headers/:id/reports", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"POST",
+				"/api/security-headers/"+uuid.New().String()+"/reports",
+				strings.NewReader(strings.Repeat("a", maximumReportBodySize+1)),
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			expectedErr := assert.AnError
+			commands := securityheaders.NewMockedCommands(controller)
+			commands.EXPECT().
+				SaveReports(gomock.Any(), id, gomock.Any()).
+				Return(expectedErr)
+
+			handler := collectReportsHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.POST("/api/security-headers/:id/reports", func(ginContext *gin.Context) {
+				defer func() {
+					if r := recover(); r != nil {
+						assert.Equal(t, expectedErr, r)
+						panic(r)
+					}
+				}()
+				handler.handle(ginContext)
+			})
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"POST",
+				"/api/security-headers/"+id.String()+"/reports",
+				bytes.NewBufferString(`{"csp-report":{"blocked-uri":"inline"}}`),
+			)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
@@ -0,0 +1,137 @@
+package securityheaders
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+const cspViolationReportType = "csp-violation"
+
+func toDomain(id uuid.UUID, dto *securityHeadersRequestDTO) *securityheaders.SecurityHeaders {
+	return &securityheaders.SecurityHeaders{
+		ID:                    id,
+		Name:                  dto.Name,
+		FrameOptions:          dto.FrameOptions,
+		ReferrerPolicy:        dto.ReferrerPolicy,
+		PermissionsPolicy:     dto.PermissionsPolicy,
+		ContentTypeNoSniff:    dto.ContentTypeNoSniff,
+		ContentSecurityPolicy: toContentSecurityPolicy(&dto.ContentSecurityPolicy),
+	}
+}
+
+func toContentSecurityPolicy(dto *contentSecurityPolicyDTO) securityheaders.ContentSecurityPolicy {
+	directives := make([]securityheaders.Directive, len(dto.Directives))
+	for index, directive := range dto.Directives {
+		directives[index] = securityheaders.Directive{
+			Name:    directive.Name,
+			Sources: directive.Sources,
+		}
+	}
+
+	return securityheaders.ContentSecurityPolicy{
+		Enabled:        dto.Enabled,
+		ReportOnly:     dto.ReportOnly,
+		CollectReports: dto.CollectReports,
+		ReportURI:      dto.ReportURI,
+		ReportTo:       dto.ReportTo,
+		Directives:     directives,
+	}
+}
+
+func toResponseDTO(domain *securityheaders.SecurityHeaders) securityHeadersResponseDTO {
+	return securityHeadersResponseDTO{
+		ID:                    domain.ID,
+		Name:                  domain.Name,
+		FrameOptions:          domain.FrameOptions,
+		ReferrerPolicy:        domain.ReferrerPolicy,
+		PermissionsPolicy:     domain.PermissionsPolicy,
+		ContentTypeNoSniff:    domain.ContentTypeNoSniff,
+		ContentSecurityPolicy: toContentSecurityPolicyDTO(&domain.ContentSecurityPolicy),
+	}
+}
+
+func toContentSecurityPolicyDTO(
+	domain *securityheaders.ContentSecurityPolicy,
+) contentSecurityPolicyDTO {
+	directives := make([]directiveDTO, len(domain.Directives))
+	for index, directive := range domain.Directives {
+		directives[index] = directiveDTO{
+			Name:    directive.Name,
+			Sources: directive.Sources,
+		}
+	}
+
+	return contentSecurityPolicyDTO{
+		Enabled:        domain.Enabled,
+		ReportOnly:     domain.ReportOnly,
+		CollectReports: domain.CollectReports,
+		ReportURI:      domain.ReportURI,
+		ReportTo:       domain.ReportTo,
+		Directives:     directives,
+	}
+}
+
+func toReportResponseDTO(domain *securityheaders.Report) reportResponseDTO {
+	return reportResponseDTO{
+		ID:                 domain.ID,
+		CreatedAt:          domain.CreatedAt,
+		DocumentURI:        domain.DocumentURI,
+		BlockedURI:         domain.BlockedURI,
+		EffectiveDirective: domain.EffectiveDirective,
+		OriginalPolicy:     domain.OriginalPolicy,
+		Disposition:        domain.Disposition,
+		SourceFile:         domain.SourceFile,
+		LineNumber:         domain.LineNumber,
+		UserAgent:          domain.UserAgent,
+	}
+}
+
+func fromLegacyReport(dto *legacyReportBodyDTO, userAgent *string) securityheaders.Report {
+	effectiveDirective := dto.EffectiveDirective
+	if effectiveDirective == nil {
+		effectiveDirective = dto.ViolatedDirective
+	}
+
+	return securityheaders.Report{
+		ID:                 uuid.New(),
+		CreatedAt:          time.Now(),
+		DocumentURI:        dto.DocumentURI,
+		BlockedURI:         dto.BlockedURI,
+		EffectiveDirective: effectiveDirective,
+		OriginalPolicy:     dto.OriginalPolicy,
+		Disposition:        dto.Disposition,
+		SourceFile:         dto.SourceFile,
+		LineNumber:         dto.LineNumber,
+		UserAgent:          userAgent,
+	}
+}
+
+func fromReportingAPIReports(
+	dtos []reportingAPIRequestDTO,
+	userAgent *string,
+) []securityheaders.Report {
+	reports := make([]securityheaders.Report, 0, len(dtos))
+	for _, dto := range dtos {
+		if dto.Type != cspViolationReportType || dto.Body == nil {
+			continue
+		}
+
+		reports = append(reports, securityheaders.Report{
+			ID:                 uuid.New(),
+			CreatedAt:          time.Now(),
+			DocumentURI:        dto.Body.DocumentURL,
+			BlockedURI:         dto.Body.BlockedURL,
+			EffectiveDirective: dto.Body.EffectiveDirective,
+			OriginalPolicy:     dto.Body.OriginalPolicy,
+			Disposition:        dto.Body.Disposition,
+			SourceFile:         dto.Body.SourceFile,
+			LineNumber:         dto.Body.LineNumber,
+			UserAgent:          userAgent,
+		})
+	}
+
+	return reports
+}
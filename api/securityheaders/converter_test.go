@@ -0,0 +1,74 @@
+package securityheaders
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toDomain(t *testing.T) {
+	t.Run("converts DTO to domain object", func(t *testing.T) {
+		id := uuid.New()
+		payload := newSecurityHeadersRequestDTO()
+		result := toDomain(id, &payload)
+
+		assert.NotNil(t, result)
+		assert.Equal(t, id, result.ID)
+		assert.Equal(t, payload.Name, result.Name)
+		assert.Equal(t, payload.FrameOptions, result.FrameOptions)
+		assert.Equal(t, payload.ReferrerPolicy, result.ReferrerPolicy)
+		assert.True(t, result.ContentSecurityPolicy.CollectReports)
+		assert.Equal(t, "default-src", result.ContentSecurityPolicy.Directives[0].Name)
+		assert.Equal(t, []string{"'self'"}, result.ContentSecurityPolicy.Directives[0].Sources)
+	})
+}
+
+func Test_toResponseDTO(t *testing.T) {
+	t.Run("converts domain object to response DTO", func(t *testing.T) {
+		subject := newSecurityHeaders()
+		result := toResponseDTO(subject)
+
+		assert.Equal(t, subject.ID, result.ID)
+		assert.Equal(t, subject.Name, result.Name)
+		assert.Equal(t, subject.ContentTypeNoSniff, result.ContentTypeNoSniff)
+		assert.Equal(t, subject.ContentSecurityPolicy.Enabled, result.ContentSecurityPolicy.Enabled)
+		assert.Len(t, result.ContentSecurityPolicy.Directives, 1)
+	})
+}
+
+func Test_parseReports(t *testing.T) {
+	t.Run("parses the legacy report format", func(t *testing.T) {
+		body := `{"csp-report":{"document-uri":"https://example.com/","blocked-uri":"inline",` +
+			`"violated-directive":"script-src-elem","line-number":12}}`
+
+		reports, err := parseReports([]byte(body), new("Browser"))
+
+		assert.NoError(t, err)
+		assert.Len(t, reports, 1)
+		assert.Equal(t, "https://example.com/", *reports[0].DocumentURI)
+		assert.Equal(t, "script-src-elem", *reports[0].EffectiveDirective)
+		assert.Equal(t, 12, *reports[0].LineNumber)
+		assert.Equal(t, "Browser", *reports[0].UserAgent)
+		assert.NotEqual(t, uuid.Nil, reports[0].ID)
+	})
+
+	t.Run("parses the reporting API format ignoring other report types", func(t *testing.T) {
+		body := `[{"type":"csp-violation","body":{"documentURL":"https://example.com/",` +
+			`"blockedURL":"https://cdn.example.com/app.js","effectiveDirective":"script-src"}},` +
+			`{"type":"deprecation","body":{}}]`
+
+		reports, err := parseReports([]byte(body), nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, reports, 1)
+		assert.Equal(t, "https://cdn.example.com/app.js", *reports[0].BlockedURI)
+		assert.Equal(t, "script-src", *reports[0].EffectiveDirective)
+		assert.Nil(t, reports[0].UserAgent)
+	})
+
+	t.Run("returns an error on invalid JSON", func(t *testing.T) {
+		_, err := parseReports([]byte("invalid"), nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,28 @@
+package securityheaders
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+type deleteReportsHandler struct {
+	commands securityheaders.Commands
+}
+
+func (h deleteReportsHandler) handle(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	if err := h.commands.DeleteReports(ctx.Request.Context(), id); err != nil {
+		panic(err)
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
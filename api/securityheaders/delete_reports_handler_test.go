@@ -0,0 +1,101 @@
+package securityheaders
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_deleteReportsHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 204 No Content on success", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			commands := securityheaders.NewMockedCommands(controller)
+			commands.EXPECT().
+				DeleteReports(gomock.Any(), id).
+				Return(nil)
+
+			handler := deleteReportsHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.DELETE("/api/security-headers/:id/reports", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"DELETE",
+				"/api/security-headers/"+id.String()+"/reports",
+				nil,
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+		})
+
+		t.Run("returns 404 Not Found on invalid ID", func(t *testing.T) {
+			handler := deleteReportsHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.DELETE("/api/security-headers/:id/reports", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("DELETE", "/api/security-headers/invalid/reports", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			expectedErr := errors.New("delete reports error")
+			commands := securityheaders.NewMockedCommands(controller)
+			commands.EXPECT().
+				DeleteReports(gomock.Any(), id).
+				Return(expectedErr)
+
+			handler := deleteReportsHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.DELETE("/api/security-headers/:id/reports", func(ginContext *gin.Context) {
+				defer func() {
+					if r := recover(); r != nil {
+						assert.Equal(t, expectedErr, r)
+						panic(r)
+					}
+				}()
+				handler.handle(ginContext)
+			})
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"DELETE",
+				"/api/security-headers/"+id.String()+"/reports",
+				nil,
+			)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
@@ -0,0 +1,85 @@
+package securityheaders
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+type securityHeadersRequestDTO struct {
+	FrameOptions          *securityheaders.FrameOptions   `json:"frameOptions"`
+	ReferrerPolicy        *securityheaders.ReferrerPolicy `json:"referrerPolicy"`
+	PermissionsPolicy     *string                         `json:"permissionsPolicy"`
+	Name                  string                          `json:"name"`
+	ContentSecurityPolicy contentSecurityPolicyDTO        `json:"contentSecurityPolicy"`
+	ContentTypeNoSniff    bool                            `json:"contentTypeNoSniff"`
+}
+
+type securityHeadersResponseDTO struct {
+	FrameOptions          *securityheaders.FrameOptions   `json:"frameOptions"`
+	ReferrerPolicy        *securityheaders.ReferrerPolicy `json:"referrerPolicy"`
+	PermissionsPolicy     *string                         `json:"permissionsPolicy"`
+	Name                  string                          `json:"name"`
+	ContentSecurityPolicy contentSecurityPolicyDTO        `json:"contentSecurityPolicy"`
+	ID                    uuid.UUID                       `json:"id"`
+	ContentTypeNoSniff    bool                            `json:"contentTypeNoSniff"`
+}
+
+type contentSecurityPolicyDTO struct {
+	ReportURI      *string        `json:"reportUri"`
+	ReportTo       *string        `json:"reportTo"`
+	Directives     []directiveDTO `json:"directives"`
+	Enabled        bool           `json:"enabled"`
+	ReportOnly     bool           `json:"reportOnly"`
+	CollectReports bool           `json:"collectReports"`
+}
+
+type directiveDTO struct {
+	Name    string   `json:"name"`
+	Sources []string `json:"sources"`
+}
+
+type reportResponseDTO struct {
+	CreatedAt          time.Time `json:"createdAt"`
+	DocumentURI        *string   `json:"documentUri"`
+	BlockedURI         *string   `json:"blockedUri"`
+	EffectiveDirective *string   `json:"effectiveDirective"`
+	OriginalPolicy     *string   `json:"originalPolicy"`
+	Disposition        *string   `json:"disposition"`
+	SourceFile         *string   `json:"sourceFile"`
+	LineNumber         *int      `json:"lineNumber"`
+	UserAgent          *string   `json:"userAgent"`
+	ID                 uuid.UUID `json:"id"`
+}
+
+type legacyReportRequestDTO struct {
+	Report *legacyReportBodyDTO `json:"csp-report"`
+}
+
+type legacyReportBodyDTO struct {
+	DocumentURI        *string `json:"document-uri"`
+	BlockedURI         *string `json:"blocked-uri"`
+	EffectiveDirective *string `json:"effective-directive"`
+	ViolatedDirective  *string `json:"violated-directive"`
+	OriginalPolicy     *string `json:"original-policy"`
+	Disposition        *string `json:"disposition"`
+	SourceFile         *string `json:"source-file"`
+	LineNumber         *int    `json:"line-number"`
+}
+
+type reportingAPIRequestDTO struct {
+	Body *reportingAPIBodyDTO `json:"body"`
+	Type string               `json:"type"`
+}
+
+type reportingAPIBodyDTO struct {
+	DocumentURL        *string `json:"documentURL"`
+	BlockedURL         *string `json:"blockedURL"`
+	EffectiveDirective *string `json:"effectiveDirective"`
+	OriginalPolicy     *string `json:"originalPolicy"`
+	Disposition        *string `json:"disposition"`
+	SourceFile         *string `json:"sourceFile"`
+	LineNumber         *int    `json:"lineNumber"`
+}
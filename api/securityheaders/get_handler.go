@@ -0,0 +1,34 @@
+package securityheaders
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+type getHandler struct {
+	commands securityheaders.Commands
+}
+
+func (h getHandler) handle(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	domain, err := h.commands.Get(ctx.Request.Context(), id)
+	if err != nil {
+		panic(err)
+	}
+
+	if domain == nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toResponseDTO(domain))
+}
@@ -0,0 +1,36 @@
+package securityheaders
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/api/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+type listReportsHandler struct {
+	commands securityheaders.Commands
+}
+
+func (h listReportsHandler) handle(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	pageSize, pageNumber, _, err := pagination.ExtractPaginationParameters(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	page, err := h.commands.ListReports(ctx.Request.Context(), id, pageSize, pageNumber)
+	if err != nil {
+		panic(err)
+	}
+
+	response := pagination.Convert(page, toReportResponseDTO)
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,107 @@
+package securityheaders
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_listReportsHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with report list on success", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			commands := securityheaders.NewMockedCommands(controller)
+			commands.EXPECT().
+				ListReports(gomock.Any(), id, 10, 1).
+				Return(newReportPage(id), nil)
+
+			handler := listReportsHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/security-headers/:id/reports", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"GET",
+				"/api/security-headers/"+id.String()+"/reports?pageSize=10&pageNumber=1",
+				nil,
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response pagination.PageDTO[reportResponseDTO]
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Len(t, response.Contents, 1)
+			assert.Equal(t, "inline", *response.Contents[0].BlockedURI)
+		})
+
+		t.Run("returns 404 Not Found on invalid ID", func(t *testing.T) {
+			handler := listReportsHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.GET("/api/security-headers/:id/reports", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/security-headers/invalid/reports", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			expectedErr := errors.New("list error")
+			commands := securityheaders.NewMockedCommands(controller)
+			commands.EXPECT().
+				ListReports(gomock.Any(), id, gomock.Any(), gomock.Any()).
+				Return(nil, expectedErr)
+
+			handler := listReportsHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/security-headers/:id/reports", func(ginContext *gin.Context) {
+				defer func() {
+					if r := recover(); r != nil {
+						assert.Equal(t, expectedErr, r)
+						panic(r)
+					}
+				}()
+				handler.handle(ginContext)
+			})
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"GET",
+				"/api/security-headers/"+id.String()+"/reports",
+				nil,
+			)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
@@ -0,0 +1,38 @@
+package securityheaders
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func Install(
+	router *gin.Engine,
+	commands securityheaders.Commands,
+	authorizer *authorization.ABAC,
+) {
+	basePath := authorizer.ConfigureGroup(
+		router,
+		"/api/security-headers",
+		func(permissions user.Permissions) user.AccessLevel { return permissions.SecurityHeaders },
+	)
+
+	basePath.GET("", listHandler{commands}.handle)
+	basePath.POST("", createHandler{commands}.handle)
+
+	byIDPath := basePath.Group("/:id")
+	byIDPath.GET("", getHandler{commands}.handle)
+	byIDPath.PUT("", updateHandler{commands}.handle)
+	byIDPath.DELETE("", deleteHandler{commands}.handle)
+
+	reportsPath := byIDPath.Group("/reports")
+	reportsPath.GET("", listReportsHandler{commands}.handle)
+	reportsPath.POST("", collectReportsHandler{commands}.handle)
+	reportsPath.DELETE("", deleteReportsHandler{commands}.handle)
+
+	authorizer.AllowAnonymous(http.MethodPost, "/api/security-headers/:id/reports")
+}
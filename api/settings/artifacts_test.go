@@ -1,6 +1,8 @@
 package settings
 
 import (
+	"github.com/google/uuid"
+
 	"dillmann.com.br/nginx-ignition/core/settings"
 )
 
@@ -28,6 +30,18 @@ func newSettings() *settings.Settings {
 				AccessLogsEnabled: true,
 				ErrorLogsEnabled:  true,
 				ErrorLogsLevel:    settings.ErrorLogLevel,
+				AccessLogsFormat:  settings.CombinedAccessLogFormat,
+				Destinations: []settings.LogDestination{
+					{
+						HostID:     new(uuid.New()),
+						Tag:        new("nginx"),
+						Type:       settings.SyslogLogDestinationType,
+						Protocol:   settings.UDPLogDestinationProtocol,
+						Address:    "127.0.0.1:514",
+						Facility:   "local7",
+						AccessLogs: true,
+					},
+				},
 			},
 			Timeouts: &settings.NginxTimeoutsSettings{
 				Read:       60,
@@ -60,6 +74,20 @@ func newSettings() *settings.Settings {
 			IntervalUnit:      settings.DaysTimeUnit,
 			IntervalUnitCount: 30,
 		},
+		Backup: &settings.BackupSettings{
+			Enabled:           true,
+			IntervalUnit:      settings.DaysTimeUnit,
+			IntervalUnitCount: 1,
+			MaximumCount:      7,
+			Destination:       settings.S3BackupDestination,
+			S3: &settings.BackupS3Settings{
+				Endpoint:  "http://localhost:9000",
+				Region:    "us-east-1",
+				Bucket:    "backups",
+				AccessKey: "access-key",
+				SecretKey: new("secret-key"),
+			},
+		},
 	}
 }
 
@@ -87,6 +115,18 @@ func newSettingsDTO() *settingsDTO {
 				AccessLogsEnabled: new(true),
 				ErrorLogsEnabled:  new(true),
 				ErrorLogsLevel:    new(settings.ErrorLogLevel),
+				AccessLogsFormat:  new(settings.CombinedAccessLogFormat),
+				Destinations: []logDestinationDTO{
+					{
+						Tag:        new(" "),
+						Type:       new(settings.OTLPLogDestinationType),
+						Protocol:   new(settings.HTTPLogDestinationProtocol),
+						Address:    new(" http://localhost:4318/v1/logs "),
+						Facility:   new(""),
+						AccessLogs: new(true),
+						ErrorLogs:  new(true),
+					},
+				},
 			},
 			Timeouts: &nginxTimeoutsSettingsDTO{
 				Read:       new(60),
@@ -119,5 +159,20 @@ func newSettingsDTO() *settingsDTO {
 			IntervalUnit:      new(settings.DaysTimeUnit),
 			IntervalUnitCount: new(30),
 		},
+		Backup: &backupSettingsDTO{
+			Enabled:           new(true),
+			IntervalUnit:      new(settings.DaysTimeUnit),
+			IntervalUnitCount: new(1),
+			MaximumCount:      new(7),
+			MaximumAgeDays:    new(0),
+			Destination:       new(settings.S3BackupDestination),
+			S3: &backupS3SettingsDTO{
+				Endpoint:  new("http://localhost:9000"),
+				Region:    new("us-east-1"),
+				Bucket:    new("backups"),
+				AccessKey: new("access-key"),
+				SecretKey: new("secret-key"),
+			},
+		},
 	}
 }
@@ -21,6 +21,8 @@ func toDTO(set *settings.Settings) *settingsDTO {
 			AccessLogsEnabled: &set.Nginx.Logs.AccessLogsEnabled,
 			ErrorLogsEnabled:  &set.Nginx.Logs.ErrorLogsEnabled,
 			ErrorLogsLevel:    &set.Nginx.Logs.ErrorLogsLevel,
+			AccessLogsFormat:  &set.Nginx.Logs.AccessLogsFormat,
+			Destinations:      toLogDestinationDTOs(set.Nginx.Logs.Destinations),
 		},
 		Timeouts: &nginxTimeoutsSettingsDTO{
 			Read:       &set.Nginx.Timeouts.Read,
@@ -73,6 +75,26 @@ func toDTO(set *settings.Settings) *settingsDTO {
 		IntervalUnitCount: &set.CertificateAutoRenew.IntervalUnitCount,
 	}
 
+	backupModel := &backupSettingsDTO{
+		Enabled:           &set.Backup.Enabled,
+		IntervalUnit:      &set.Backup.IntervalUnit,
+		IntervalUnitCount: &set.Backup.IntervalUnitCount,
+		MaximumCount:      &set.Backup.MaximumCount,
+		MaximumAgeDays:    &set.Backup.MaximumAgeDays,
+		Destination:       &set.Backup.Destination,
+		LocalPath:         set.Backup.LocalPath,
+	}
+
+	if s3 := set.Backup.S3; s3 != nil {
+		backupModel.S3 = &backupS3SettingsDTO{
+			Endpoint:  &s3.Endpoint,
+			Region:    &s3.Region,
+			Bucket:    &s3.Bucket,
+			Prefix:    s3.Prefix,
+			AccessKey: &s3.AccessKey,
+		}
+	}
+
 	bindingsModel := make([]bindingDTO, 0)
 	for _, b := range set.GlobalBindings {
 		bindingsModel = append(bindingsModel, bindingDTO{
@@ -80,6 +102,7 @@ func toDTO(set *settings.Settings) *settingsDTO {
 			IP:            &b.IP,
 			Port:          &b.Port,
 			CertificateID: b.CertificateID,
+			TLSProfileID:  b.TLSProfileID,
 		})
 	}
 
@@ -87,6 +110,7 @@ func toDTO(set *settings.Settings) *settingsDTO {
 		Nginx:                nginxModel,
 		LogRotation:          logRotationModel,
 		CertificateAutoRenew: certificateModel,
+		Backup:               backupModel,
 		GlobalBindings:       bindingsModel,
 	}
 }
@@ -95,17 +119,13 @@ func toDomain(input *settingsDTO) *settings.Settings {
 	nginx := input.Nginx
 	logRotation := input.LogRotation
 	certificate := input.CertificateAutoRenew
+	backup := input.Backup
 	bindings := input.GlobalBindings
 
-	if nginx == nil || logRotation == nil || certificate == nil {
+	if nginx == nil || logRotation == nil || certificate == nil || backup == nil {
 		return nil
 	}
 
-	databaseLocation := nginx.Stats.DatabaseLocation
-	if databaseLocation != nil && strings.TrimSpace(*databaseLocation) == "" {
-		databaseLocation = nil
-	}
-
 	nginxSettings := &settings.NginxSettings{
 		Logs: &settings.NginxLogsSettings{
 			ServerLogsEnabled: *nginx.Logs.ServerLogsEnabled,
@@ -113,6 +133,8 @@ func toDomain(input *settingsDTO) *settings.Settings {
 			AccessLogsEnabled: *nginx.Logs.AccessLogsEnabled,
 			ErrorLogsEnabled:  *nginx.Logs.ErrorLogsEnabled,
 			ErrorLogsLevel:    *nginx.Logs.ErrorLogsLevel,
+			AccessLogsFormat:  *nginx.Logs.AccessLogsFormat,
+			Destinations:      toLogDestinations(nginx.Logs.Destinations),
 		},
 		Timeouts: &settings.NginxTimeoutsSettings{
 			Read:       *nginx.Timeouts.Read,
@@ -138,7 +160,7 @@ func toDomain(input *settingsDTO) *settings.Settings {
 			Persistent:       *nginx.Stats.Persistent,
 			AllHosts:         *nginx.Stats.AllHosts,
 			MaximumSizeMB:    *nginx.Stats.MaximumSizeMB,
-			DatabaseLocation: databaseLocation,
+			DatabaseLocation: nilIfBlank(nginx.Stats.DatabaseLocation),
 		},
 		WorkerProcesses:     *nginx.WorkerProcesses,
 		WorkerConnections:   *nginx.WorkerConnections,
@@ -165,6 +187,27 @@ func toDomain(input *settingsDTO) *settings.Settings {
 		IntervalUnitCount: *certificate.IntervalUnitCount,
 	}
 
+	backupSettings := &settings.BackupSettings{
+		Enabled:           *backup.Enabled,
+		IntervalUnit:      *backup.IntervalUnit,
+		IntervalUnitCount: *backup.IntervalUnitCount,
+		MaximumCount:      *backup.MaximumCount,
+		MaximumAgeDays:    *backup.MaximumAgeDays,
+		Destination:       *backup.Destination,
+		LocalPath:         nilIfBlank(backup.LocalPath),
+	}
+
+	if s3 := backup.S3; s3 != nil {
+		backupSettings.S3 = &settings.BackupS3Settings{
+			Endpoint:  valueOrEmpty(s3.Endpoint),
+			Region:    valueOrEmpty(s3.Region),
+			Bucket:    valueOrEmpty(s3.Bucket),
+			Prefix:    nilIfBlank(s3.Prefix),
+			AccessKey: valueOrEmpty(s3.AccessKey),
+			SecretKey: nilIfBlank(s3.SecretKey),
+		}
+	}
+
 	globalBindings := make([]binding.Binding, 0)
 	for _, b := range bindings {
 		globalBindings = append(globalBindings, binding.Binding{
@@ -173,6 +216,7 @@ func toDomain(input *settingsDTO) *settings.Settings {
 			IP:            *b.IP,
 			Port:          *b.Port,
 			CertificateID: b.CertificateID,
+			TLSProfileID:  b.TLSProfileID,
 		})
 	}
 
@@ -180,6 +224,59 @@ func toDomain(input *settingsDTO) *settings.Settings {
 		Nginx:                nginxSettings,
 		LogRotation:          logRotationSettings,
 		CertificateAutoRenew: certificateSettings,
+		Backup:               backupSettings,
 		GlobalBindings:       globalBindings,
 	}
 }
+
+func toLogDestinationDTOs(destinations []settings.LogDestination) []logDestinationDTO {
+	output := make([]logDestinationDTO, 0, len(destinations))
+	for _, d := range destinations {
+		output = append(output, logDestinationDTO{
+			HostID:     d.HostID,
+			Tag:        d.Tag,
+			Type:       &d.Type,
+			Protocol:   &d.Protocol,
+			Address:    &d.Address,
+			Facility:   &d.Facility,
+			AccessLogs: &d.AccessLogs,
+			ErrorLogs:  &d.ErrorLogs,
+		})
+	}
+
+	return output
+}
+
+func toLogDestinations(destinations []logDestinationDTO) []settings.LogDestination {
+	output := make([]settings.LogDestination, 0, len(destinations))
+	for _, d := range destinations {
+		output = append(output, settings.LogDestination{
+			HostID:     d.HostID,
+			Tag:        nilIfBlank(d.Tag),
+			Type:       *d.Type,
+			Protocol:   *d.Protocol,
+			Address:    valueOrEmpty(d.Address),
+			Facility:   valueOrEmpty(d.Facility),
+			AccessLogs: *d.AccessLogs,
+			ErrorLogs:  *d.ErrorLogs,
+		})
+	}
+
+	return output
+}
+
+func nilIfBlank(value *string) *string {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil
+	}
+
+	return value
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(*value)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"dillmann.com.br/nginx-ignition/core/binding"
+	"dillmann.com.br/nginx-ignition/core/settings"
 )
 
 func Test_toDTO(t *testing.T) {
@@ -55,6 +56,17 @@ func Test_toDTO(t *testing.T) {
 		assert.Equal(t, logsSubject.AccessLogsEnabled, *logsResult.AccessLogsEnabled)
 		assert.Equal(t, logsSubject.ErrorLogsEnabled, *logsResult.ErrorLogsEnabled)
 		assert.Equal(t, logsSubject.ErrorLogsLevel, *logsResult.ErrorLogsLevel)
+		assert.Len(t, logsResult.Destinations, 1)
+		destinationSubject := logsSubject.Destinations[0]
+		destinationResult := logsResult.Destinations[0]
+		assert.Equal(t, destinationSubject.HostID, destinationResult.HostID)
+		assert.Equal(t, destinationSubject.Tag, destinationResult.Tag)
+		assert.Equal(t, destinationSubject.Type, *destinationResult.Type)
+		assert.Equal(t, destinationSubject.Protocol, *destinationResult.Protocol)
+		assert.Equal(t, destinationSubject.Address, *destinationResult.Address)
+		assert.Equal(t, destinationSubject.Facility, *destinationResult.Facility)
+		assert.Equal(t, destinationSubject.AccessLogs, *destinationResult.AccessLogs)
+		assert.Equal(t, destinationSubject.ErrorLogs, *destinationResult.ErrorLogs)
 
 		// Nginx Timeouts
 		timeoutsSubject := nginxSubject.Timeouts
@@ -112,6 +124,17 @@ func Test_toDTO(t *testing.T) {
 		assert.Equal(t, certSubject.IntervalUnit, *certResult.IntervalUnit)
 		assert.Equal(t, certSubject.IntervalUnitCount, *certResult.IntervalUnitCount)
 
+		// Backup
+		backupSubject := subject.Backup
+		backupResult := result.Backup
+
+		assert.Equal(t, backupSubject.Enabled, *backupResult.Enabled)
+		assert.Equal(t, backupSubject.Destination, *backupResult.Destination)
+		assert.Equal(t, backupSubject.MaximumCount, *backupResult.MaximumCount)
+		assert.Equal(t, backupSubject.S3.Bucket, *backupResult.S3.Bucket)
+		assert.Equal(t, backupSubject.S3.AccessKey, *backupResult.S3.AccessKey)
+		assert.Nil(t, backupResult.S3.SecretKey)
+
 		// Global Bindings
 		assert.Len(t, result.GlobalBindings, 1)
 		bindingSubject := subject.GlobalBindings[0]
@@ -133,6 +156,10 @@ func Test_toDomain(t *testing.T) {
 			t,
 			toDomain(&settingsDTO{CertificateAutoRenew: &certificateAutoRenewSettingsDTO{}}),
 		)
+
+		payload := newSettingsDTO()
+		payload.Backup = nil
+		assert.Nil(t, toDomain(payload))
 	})
 
 	t.Run("converts DTO to domain object", func(t *testing.T) {
@@ -175,6 +202,16 @@ func Test_toDomain(t *testing.T) {
 		assert.Equal(t, *logsPayload.AccessLogsEnabled, logsResult.AccessLogsEnabled)
 		assert.Equal(t, *logsPayload.ErrorLogsEnabled, logsResult.ErrorLogsEnabled)
 		assert.Equal(t, *logsPayload.ErrorLogsLevel, logsResult.ErrorLogsLevel)
+		assert.Len(t, logsResult.Destinations, 1)
+		destinationResult := logsResult.Destinations[0]
+		assert.Nil(t, destinationResult.HostID)
+		assert.Nil(t, destinationResult.Tag)
+		assert.Equal(t, settings.OTLPLogDestinationType, destinationResult.Type)
+		assert.Equal(t, settings.HTTPLogDestinationProtocol, destinationResult.Protocol)
+		assert.Equal(t, "http://localhost:4318/v1/logs", destinationResult.Address)
+		assert.Empty(t, destinationResult.Facility)
+		assert.True(t, destinationResult.AccessLogs)
+		assert.True(t, destinationResult.ErrorLogs)
 
 		// Nginx Timeouts
 		timeoutsPayload := nginxPayload.Timeouts
@@ -252,4 +289,25 @@ func Test_toDomain(t *testing.T) {
 		assert.NotNil(t, result)
 		assert.Nil(t, result.Nginx.Stats.DatabaseLocation)
 	})
+
+	t.Run("converts the backup settings", func(t *testing.T) {
+		payload := newSettingsDTO()
+		result := toDomain(payload)
+
+		assert.NotNil(t, result)
+		assert.Equal(t, *payload.Backup.Destination, result.Backup.Destination)
+		assert.Equal(t, *payload.Backup.IntervalUnitCount, result.Backup.IntervalUnitCount)
+		assert.Equal(t, *payload.Backup.S3.Endpoint, result.Backup.S3.Endpoint)
+		assert.Equal(t, payload.Backup.S3.SecretKey, result.Backup.S3.SecretKey)
+		assert.Nil(t, result.Backup.S3.Prefix)
+	})
+
+	t.Run("converts empty backup secret key to nil", func(t *testing.T) {
+		payload := newSettingsDTO()
+		payload.Backup.S3.SecretKey = new("")
+		result := toDomain(payload)
+
+		assert.NotNil(t, result)
+		assert.Nil(t, result.Backup.S3.SecretKey)
+	})
 }
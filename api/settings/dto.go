@@ -11,6 +11,7 @@ type settingsDTO struct {
 	Nginx                *nginxSettingsDTO                `json:"nginx"`
 	LogRotation          *logRotationSettingsDTO          `json:"logRotation"`
 	CertificateAutoRenew *certificateAutoRenewSettingsDTO `json:"certificateAutoRenew"`
+	Backup               *backupSettingsDTO               `json:"backup"`
 	GlobalBindings       []bindingDTO                     `json:"globalBindings"`
 }
 
@@ -44,6 +45,26 @@ type certificateAutoRenewSettingsDTO struct {
 	IntervalUnitCount *int               `json:"intervalUnitCount"`
 }
 
+type backupSettingsDTO struct {
+	Enabled           *bool                       `json:"enabled"`
+	IntervalUnit      *settings.TimeUnit          `json:"intervalUnit"`
+	IntervalUnitCount *int                        `json:"intervalUnitCount"`
+	MaximumCount      *int                        `json:"maximumCount"`
+	MaximumAgeDays    *int                        `json:"maximumAgeDays"`
+	Destination       *settings.BackupDestination `json:"destination"`
+	LocalPath         *string                     `json:"localPath"`
+	S3                *backupS3SettingsDTO        `json:"s3"`
+}
+
+type backupS3SettingsDTO struct {
+	Endpoint  *string `json:"endpoint"`
+	Region    *string `json:"region"`
+	Bucket    *string `json:"bucket"`
+	Prefix    *string `json:"prefix"`
+	AccessKey *string `json:"accessKey"`
+	SecretKey *string `json:"secretKey"`
+}
+
 type nginxTimeoutsSettingsDTO struct {
 	Read       *int `json:"read"`
 	Connect    *int `json:"connect"`
@@ -65,11 +86,24 @@ type nginxBufferSizeDTO struct {
 }
 
 type nginxLogsSettingsDTO struct {
-	ServerLogsEnabled *bool              `json:"serverLogsEnabled"`
-	ServerLogsLevel   *settings.LogLevel `json:"serverLogsLevel"`
-	AccessLogsEnabled *bool              `json:"accessLogsEnabled"`
-	ErrorLogsEnabled  *bool              `json:"errorLogsEnabled"`
-	ErrorLogsLevel    *settings.LogLevel `json:"errorLogsLevel"`
+	ServerLogsEnabled *bool                     `json:"serverLogsEnabled"`
+	ServerLogsLevel   *settings.LogLevel        `json:"serverLogsLevel"`
+	AccessLogsEnabled *bool                     `json:"accessLogsEnabled"`
+	ErrorLogsEnabled  *bool                     `json:"errorLogsEnabled"`
+	ErrorLogsLevel    *settings.LogLevel        `json:"errorLogsLevel"`
+	AccessLogsFormat  *settings.AccessLogFormat `json:"accessLogsFormat"`
+	Destinations      []logDestinationDTO       `json:"destinations"`
+}
+
+type logDestinationDTO struct {
+	HostID     *uuid.UUID                       `json:"hostId"`
+	Tag        *string                          `json:"tag"`
+	Type       *settings.LogDestinationType     `json:"type"`
+	Protocol   *settings.LogDestinationProtocol `json:"protocol"`
+	Address    *string                          `json:"address"`
+	Facility   *string                          `json:"facility"`
+	AccessLogs *bool                            `json:"accessLogs"`
+	ErrorLogs  *bool                            `json:"errorLogs"`
 }
 
 type nginxStatsSettingsDTO struct {
@@ -85,4 +119,5 @@ type bindingDTO struct {
 	IP            *string       `json:"ip"`
 	Port          *int          `json:"port"`
 	CertificateID *uuid.UUID    `json:"certificateId"`
+	TLSProfileID  *uuid.UUID    `json:"tlsProfileId"`
 }
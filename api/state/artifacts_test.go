@@ -0,0 +1,158 @@
+package state
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/binding"
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/state"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+func newDocument() *state.Document {
+	securityHeadersID := uuid.New()
+
+	return &state.Document{
+		Version: state.CurrentVersion,
+		Settings: &settings.Settings{
+			Nginx: &settings.NginxSettings{
+				Timeouts: &settings.NginxTimeoutsSettings{Read: 30},
+				Buffers: &settings.NginxBuffersSettings{
+					Output: &settings.NginxBufferSize{SizeKb: 32, Amount: 4},
+				},
+				Logs: &settings.NginxLogsSettings{
+					AccessLogsFormat: settings.JSONAccessLogFormat,
+					Destinations: []settings.LogDestination{
+						{
+							Type:       settings.OTLPLogDestinationType,
+							Protocol:   settings.HTTPLogDestinationProtocol,
+							Address:    "http://localhost:4318/v1/logs",
+							AccessLogs: true,
+						},
+					},
+					AccessLogsEnabled: true,
+				},
+				RuntimeUser:     "nginx",
+				WorkerProcesses: 2,
+			},
+			Backup: &settings.BackupSettings{
+				Destination:       settings.S3BackupDestination,
+				IntervalUnit:      settings.DaysTimeUnit,
+				IntervalUnitCount: 1,
+				S3: &settings.BackupS3Settings{
+					Endpoint: "http://localhost:9000",
+					Bucket:   "backups",
+				},
+			},
+			GlobalBindings: []binding.Binding{},
+		},
+		Certificates: []certificate.Certificate{
+			{
+				ID:          uuid.New(),
+				ProviderID:  "CUSTOM",
+				DomainNames: []string{"example.com"},
+				PrivateKey:  "private",
+				PublicKey:   "public",
+			},
+		},
+		Upstreams: []upstream.Upstream{
+			{
+				ID:        uuid.New(),
+				Name:      "Backend Pool",
+				Protocol:  upstream.HTTPProtocol,
+				Method:    upstream.RoundRobinBalancingMethod,
+				Keepalive: &upstream.Keepalive{Connections: 16, TimeoutSeconds: 60},
+				Servers: []upstream.Server{
+					{
+						Address:        "10.0.0.1",
+						Port:           8080,
+						Weight:         new(2),
+						CircuitBreaker: &upstream.CircuitBreaker{MaxFailures: 3, OpenSeconds: 30},
+					},
+				},
+			},
+		},
+		SecurityHeaders: []securityheaders.SecurityHeaders{
+			{
+				ID:                 securityHeadersID,
+				Name:               "Strict",
+				FrameOptions:       new(securityheaders.DenyFrameOptions),
+				ContentTypeNoSniff: true,
+				ContentSecurityPolicy: securityheaders.ContentSecurityPolicy{
+					Enabled:        true,
+					CollectReports: true,
+					Directives: []securityheaders.Directive{
+						{Name: "default-src", Sources: []string{"'self'"}},
+						{Name: "upgrade-insecure-requests"},
+					},
+				},
+			},
+		},
+		Hosts: []host.Host{
+			{
+				ID:                uuid.New(),
+				SecurityHeadersID: &securityHeadersID,
+				DomainNames:       []string{"example.com"},
+				Routes: []host.Route{
+					{
+						ID:         uuid.New(),
+						Type:       host.StaticResponseRouteType,
+						MatchType:  host.PrefixRouteMatchType,
+						SourcePath: "/",
+						Response: &host.RouteStaticResponse{
+							Headers:    map[string]string{"X-Custom": "value"},
+							StatusCode: 200,
+						},
+						RewriteRules: []host.RewriteRule{
+							{
+								Pattern:     "^/old/(.*)$",
+								Replacement: "/new/$1",
+								Flag:        new(host.LastRewriteRuleFlag),
+							},
+						},
+						HeaderRules: []host.HeaderRule{
+							{
+								Name:   "Cookie",
+								Target: host.RequestHeaderRuleTarget,
+								Action: host.RemoveHeaderRuleAction,
+							},
+						},
+						Enabled: true,
+					},
+				},
+				HeaderRules: []host.HeaderRule{
+					{
+						Name:   "X-Frame-Options",
+						Value:  new("DENY"),
+						Target: host.ResponseHeaderRuleTarget,
+						Action: host.SetHeaderRuleAction,
+						Always: true,
+					},
+				},
+				Bindings:   []binding.Binding{},
+				VPNs:       []host.VPN{},
+				FeatureSet: host.FeatureSet{WebsocketSupport: true},
+				Enabled:    true,
+			},
+		},
+		Streams: []stream.Stream{
+			{
+				ID:     uuid.New(),
+				Name:   "Stream",
+				Type:   stream.SimpleType,
+				Routes: []stream.Route{},
+				DefaultBackend: stream.Backend{
+					Address: stream.Address{
+						Protocol: stream.TCPProtocol,
+						Address:  "127.0.0.1",
+						Port:     new(8080),
+					},
+				},
+			},
+		},
+	}
+}
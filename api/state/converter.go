@@ -0,0 +1,1091 @@
+package state
+
+import (
+	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/binding"
+	"dillmann.com.br/nginx-ignition/core/cache"
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/state"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+	"dillmann.com.br/nginx-ignition/core/vpn"
+)
+
+func toDocumentDTO(document *state.Document) *documentDTO {
+	return &documentDTO{
+		Version:         document.Version,
+		Settings:        toSettingsDTO(document.Settings),
+		Integrations:    mapSlice(document.Integrations, toIntegrationDTO),
+		VPNs:            mapSlice(document.VPNs, toVPNDTO),
+		AccessLists:     mapSlice(document.AccessLists, toAccessListDTO),
+		Caches:          mapSlice(document.Caches, toCacheDTO),
+		RateLimits:      mapSlice(document.RateLimits, toRateLimitDTO),
+		SecurityHeaders: mapSlice(document.SecurityHeaders, toSecurityHeadersDTO),
+		Upstreams:       mapSlice(document.Upstreams, toUpstreamDTO),
+		Certificates:    mapSlice(document.Certificates, toCertificateDTO),
+		TLSProfiles:     mapSlice(document.TLSProfiles, toTLSProfileDTO),
+		Hosts:           mapSlice(document.Hosts, toHostDTO),
+		Streams:         mapSlice(document.Streams, toStreamDTO),
+	}
+}
+
+func toDocument(dto *documentDTO) *state.Document {
+	return &state.Document{
+		Version:         dto.Version,
+		Settings:        toSettings(dto.Settings),
+		Integrations:    mapSlice(dto.Integrations, toIntegration),
+		VPNs:            mapSlice(dto.VPNs, toVPN),
+		AccessLists:     mapSlice(dto.AccessLists, toAccessList),
+		Caches:          mapSlice(dto.Caches, toCache),
+		RateLimits:      mapSlice(dto.RateLimits, toRateLimit),
+		SecurityHeaders: mapSlice(dto.SecurityHeaders, toSecurityHeaders),
+		Upstreams:       mapSlice(dto.Upstreams, toUpstream),
+		Certificates:    mapSlice(dto.Certificates, toCertificate),
+		TLSProfiles:     mapSlice(dto.TLSProfiles, toTLSProfile),
+		Hosts:           mapSlice(dto.Hosts, toHost),
+		Streams:         mapSlice(dto.Streams, toStream),
+	}
+}
+
+func toImportResponseDTO(changes []state.Change, dryRun bool) *importResponseDTO {
+	return &importResponseDTO{
+		DryRun: dryRun,
+		Changes: mapSlice(changes, func(change *state.Change) changeDTO {
+			return changeDTO{
+				EntityID:   change.EntityID,
+				EntityType: change.EntityType,
+				Action:     change.Action,
+				Name:       change.Name,
+			}
+		}),
+	}
+}
+
+func mapSlice[I, O any](input []I, converter func(*I) O) []O {
+	output := make([]O, 0, len(input))
+	for index := range input {
+		output = append(output, converter(&input[index]))
+	}
+
+	return output
+}
+
+func toSettingsDTO(input *settings.Settings) *settingsDTO {
+	if input == nil {
+		return nil
+	}
+
+	output := &settingsDTO{
+		GlobalBindings: mapSlice(input.GlobalBindings, toBindingDTO),
+	}
+
+	if input.Nginx != nil {
+		output.Nginx = &nginxSettingsDTO{
+			Custom:              input.Nginx.Custom,
+			RuntimeUser:         input.Nginx.RuntimeUser,
+			DefaultContentType:  input.Nginx.DefaultContentType,
+			WorkerProcesses:     input.Nginx.WorkerProcesses,
+			WorkerConnections:   input.Nginx.WorkerConnections,
+			MaximumBodySizeMb:   input.Nginx.MaximumBodySizeMb,
+			ServerTokensEnabled: input.Nginx.ServerTokensEnabled,
+			TCPNoDelayEnabled:   input.Nginx.TCPNoDelayEnabled,
+			GzipEnabled:         input.Nginx.GzipEnabled,
+			SendfileEnabled:     input.Nginx.SendfileEnabled,
+		}
+
+		if timeouts := input.Nginx.Timeouts; timeouts != nil {
+			output.Nginx.Timeouts = &nginxTimeoutsSettingsDTO{
+				Read:       timeouts.Read,
+				Connect:    timeouts.Connect,
+				Send:       timeouts.Send,
+				Keepalive:  timeouts.Keepalive,
+				ClientBody: timeouts.ClientBody,
+			}
+		}
+
+		if buffers := input.Nginx.Buffers; buffers != nil {
+			output.Nginx.Buffers = &nginxBuffersSettingsDTO{
+				LargeClientHeader: toBufferSizeDTO(buffers.LargeClientHeader),
+				Output:            toBufferSizeDTO(buffers.Output),
+				ClientBodyKb:      buffers.ClientBodyKb,
+				ClientHeaderKb:    buffers.ClientHeaderKb,
+			}
+		}
+
+		if logs := input.Nginx.Logs; logs != nil {
+			output.Nginx.Logs = &nginxLogsSettingsDTO{
+				ServerLogsLevel:   logs.ServerLogsLevel,
+				ErrorLogsLevel:    logs.ErrorLogsLevel,
+				AccessLogsFormat:  logs.AccessLogsFormat,
+				Destinations:      mapSlice(logs.Destinations, toLogDestinationDTO),
+				ServerLogsEnabled: logs.ServerLogsEnabled,
+				AccessLogsEnabled: logs.AccessLogsEnabled,
+				ErrorLogsEnabled:  logs.ErrorLogsEnabled,
+			}
+		}
+
+		if stats := input.Nginx.Stats; stats != nil {
+			output.Nginx.Stats = &nginxStatsSettingsDTO{
+				DatabaseLocation: stats.DatabaseLocation,
+				MaximumSizeMB:    stats.MaximumSizeMB,
+				Enabled:          stats.Enabled,
+				Persistent:       stats.Persistent,
+				AllHosts:         stats.AllHosts,
+			}
+		}
+	}
+
+	if input.LogRotation != nil {
+		output.LogRotation = &logRotationSettingsDTO{
+			IntervalUnit:      input.LogRotation.IntervalUnit,
+			MaximumLines:      input.LogRotation.MaximumLines,
+			IntervalUnitCount: input.LogRotation.IntervalUnitCount,
+			Enabled:           input.LogRotation.Enabled,
+		}
+	}
+
+	if input.CertificateAutoRenew != nil {
+		output.CertificateAutoRenew = &certificateAutoRenewSettingsDTO{
+			IntervalUnit:      input.CertificateAutoRenew.IntervalUnit,
+			IntervalUnitCount: input.CertificateAutoRenew.IntervalUnitCount,
+			Enabled:           input.CertificateAutoRenew.Enabled,
+		}
+	}
+
+	if backup := input.Backup; backup != nil {
+		output.Backup = &backupSettingsDTO{
+			LocalPath:         backup.LocalPath,
+			Destination:       backup.Destination,
+			IntervalUnit:      backup.IntervalUnit,
+			IntervalUnitCount: backup.IntervalUnitCount,
+			MaximumCount:      backup.MaximumCount,
+			MaximumAgeDays:    backup.MaximumAgeDays,
+			Enabled:           backup.Enabled,
+		}
+
+		if s3 := backup.S3; s3 != nil {
+			output.Backup.S3 = &backupS3SettingsDTO{
+				Prefix:    s3.Prefix,
+				SecretKey: s3.SecretKey,
+				Endpoint:  s3.Endpoint,
+				Region:    s3.Region,
+				Bucket:    s3.Bucket,
+				AccessKey: s3.AccessKey,
+			}
+		}
+	}
+
+	return output
+}
+
+func toSettings(input *settingsDTO) *settings.Settings {
+	if input == nil {
+		return nil
+	}
+
+	output := &settings.Settings{
+		GlobalBindings: mapSlice(input.GlobalBindings, toBinding),
+	}
+
+	if input.Nginx != nil {
+		output.Nginx = &settings.NginxSettings{
+			Custom:              input.Nginx.Custom,
+			RuntimeUser:         input.Nginx.RuntimeUser,
+			DefaultContentType:  input.Nginx.DefaultContentType,
+			WorkerProcesses:     input.Nginx.WorkerProcesses,
+			WorkerConnections:   input.Nginx.WorkerConnections,
+			MaximumBodySizeMb:   input.Nginx.MaximumBodySizeMb,
+			ServerTokensEnabled: input.Nginx.ServerTokensEnabled,
+			TCPNoDelayEnabled:   input.Nginx.TCPNoDelayEnabled,
+			GzipEnabled:         input.Nginx.GzipEnabled,
+			SendfileEnabled:     input.Nginx.SendfileEnabled,
+		}
+
+		if timeouts := input.Nginx.Timeouts; timeouts != nil {
+			output.Nginx.Timeouts = &settings.NginxTimeoutsSettings{
+				Read:       timeouts.Read,
+				Connect:    timeouts.Connect,
+				Send:       timeouts.Send,
+				Keepalive:  timeouts.Keepalive,
+				ClientBody: timeouts.ClientBody,
+			}
+		}
+
+		if buffers := input.Nginx.Buffers; buffers != nil {
+			output.Nginx.Buffers = &settings.NginxBuffersSettings{
+				LargeClientHeader: toBufferSize(buffers.LargeClientHeader),
+				Output:            toBufferSize(buffers.Output),
+				ClientBodyKb:      buffers.ClientBodyKb,
+				ClientHeaderKb:    buffers.ClientHeaderKb,
+			}
+		}
+
+		if logs := input.Nginx.Logs; logs != nil {
+			accessLogsFormat := logs.AccessLogsFormat
+			if accessLogsFormat == "" {
+				accessLogsFormat = settings.CombinedAccessLogFormat
+			}
+
+			output.Nginx.Logs = &settings.NginxLogsSettings{
+				ServerLogsLevel:   logs.ServerLogsLevel,
+				ErrorLogsLevel:    logs.ErrorLogsLevel,
+				AccessLogsFormat:  accessLogsFormat,
+				Destinations:      mapSlice(logs.Destinations, toLogDestination),
+				ServerLogsEnabled: logs.ServerLogsEnabled,
+				AccessLogsEnabled: logs.AccessLogsEnabled,
+				ErrorLogsEnabled:  logs.ErrorLogsEnabled,
+			}
+		}
+
+		if stats := input.Nginx.Stats; stats != nil {
+			output.Nginx.Stats = &settings.NginxStatsSettings{
+				DatabaseLocation: stats.DatabaseLocation,
+				MaximumSizeMB:    stats.MaximumSizeMB,
+				Enabled:          stats.Enabled,
+				Persistent:       stats.Persistent,
+				AllHosts:         stats.AllHosts,
+			}
+		}
+	}
+
+	if input.LogRotation != nil {
+		output.LogRotation = &settings.LogRotationSettings{
+			IntervalUnit:      input.LogRotation.IntervalUnit,
+			MaximumLines:      input.LogRotation.MaximumLines,
+			IntervalUnitCount: input.LogRotation.IntervalUnitCount,
+			Enabled:           input.LogRotation.Enabled,
+		}
+	}
+
+	if input.CertificateAutoRenew != nil {
+		output.CertificateAutoRenew = &settings.CertificateAutoRenewSettings{
+			IntervalUnit:      input.CertificateAutoRenew.IntervalUnit,
+			IntervalUnitCount: input.CertificateAutoRenew.IntervalUnitCount,
+			Enabled:           input.CertificateAutoRenew.Enabled,
+		}
+	}
+
+	if backup := input.Backup; backup != nil {
+		output.Backup = &settings.BackupSettings{
+			LocalPath:         backup.LocalPath,
+			Destination:       backup.Destination,
+			IntervalUnit:      backup.IntervalUnit,
+			IntervalUnitCount: backup.IntervalUnitCount,
+			MaximumCount:      backup.MaximumCount,
+			MaximumAgeDays:    backup.MaximumAgeDays,
+			Enabled:           backup.Enabled,
+		}
+
+		if s3 := backup.S3; s3 != nil {
+			output.Backup.S3 = &settings.BackupS3Settings{
+				Prefix:    s3.Prefix,
+				SecretKey: s3.SecretKey,
+				Endpoint:  s3.Endpoint,
+				Region:    s3.Region,
+				Bucket:    s3.Bucket,
+				AccessKey: s3.AccessKey,
+			}
+		}
+	}
+
+	return output
+}
+
+func toBufferSizeDTO(input *settings.NginxBufferSize) *nginxBufferSizeDTO {
+	if input == nil {
+		return nil
+	}
+
+	return &nginxBufferSizeDTO{
+		SizeKb: input.SizeKb,
+		Amount: input.Amount,
+	}
+}
+
+func toBufferSize(input *nginxBufferSizeDTO) *settings.NginxBufferSize {
+	if input == nil {
+		return nil
+	}
+
+	return &settings.NginxBufferSize{
+		SizeKb: input.SizeKb,
+		Amount: input.Amount,
+	}
+}
+
+func toBindingDTO(input *binding.Binding) bindingDTO {
+	return bindingDTO{
+		CertificateID: input.CertificateID,
+		TLSProfileID:  input.TLSProfileID,
+		Type:          input.Type,
+		IP:            input.IP,
+		Port:          input.Port,
+		ID:            input.ID,
+	}
+}
+
+func toBinding(input *bindingDTO) binding.Binding {
+	return binding.Binding{
+		CertificateID: input.CertificateID,
+		TLSProfileID:  input.TLSProfileID,
+		Type:          input.Type,
+		IP:            input.IP,
+		Port:          input.Port,
+		ID:            input.ID,
+	}
+}
+
+func toLogDestinationDTO(input *settings.LogDestination) logDestinationDTO {
+	return logDestinationDTO{
+		HostID:     input.HostID,
+		Tag:        input.Tag,
+		Type:       input.Type,
+		Protocol:   input.Protocol,
+		Address:    input.Address,
+		Facility:   input.Facility,
+		AccessLogs: input.AccessLogs,
+		ErrorLogs:  input.ErrorLogs,
+	}
+}
+
+func toLogDestination(input *logDestinationDTO) settings.LogDestination {
+	return settings.LogDestination{
+		HostID:     input.HostID,
+		Tag:        input.Tag,
+		Type:       input.Type,
+		Protocol:   input.Protocol,
+		Address:    input.Address,
+		Facility:   input.Facility,
+		AccessLogs: input.AccessLogs,
+		ErrorLogs:  input.ErrorLogs,
+	}
+}
+
+func toIntegrationDTO(input *integration.Integration) integrationDTO {
+	return integrationDTO{
+		Parameters: input.Parameters,
+		Driver:     input.Driver,
+		Name:       input.Name,
+		ID:         input.ID,
+		Enabled:    input.Enabled,
+	}
+}
+
+func toIntegration(input *integrationDTO) integration.Integration {
+	return integration.Integration{
+		Parameters: input.Parameters,
+		Driver:     input.Driver,
+		Name:       input.Name,
+		ID:         input.ID,
+		Enabled:    input.Enabled,
+	}
+}
+
+func toVPNDTO(input *vpn.VPN) vpnDTO {
+	return vpnDTO{
+		Parameters: input.Parameters,
+		Driver:     input.Driver,
+		Name:       input.Name,
+		ID:         input.ID,
+		Enabled:    input.Enabled,
+	}
+}
+
+func toVPN(input *vpnDTO) vpn.VPN {
+	return vpn.VPN{
+		Parameters: input.Parameters,
+		Driver:     input.Driver,
+		Name:       input.Name,
+		ID:         input.ID,
+		Enabled:    input.Enabled,
+	}
+}
+
+func toAccessListDTO(input *accesslist.AccessList) accessListDTO {
+	return accessListDTO{
+		Name:           input.Name,
+		Realm:          input.Realm,
+		DefaultOutcome: input.DefaultOutcome,
+		Entries: mapSlice(input.Entries, func(entry *accesslist.Entry) entrySetDTO {
+			return entrySetDTO{
+				Outcome:         entry.Outcome,
+				SourceAddresses: entry.SourceAddress,
+				Priority:        entry.Priority,
+			}
+		}),
+		Credentials: mapSlice(
+			input.Credentials,
+			func(credentials *accesslist.Credentials) credentialsDTO {
+				return credentialsDTO{
+					Username: credentials.Username,
+					Password: credentials.Password,
+				}
+			},
+		),
+		ID:                          input.ID,
+		SatisfyAll:                  input.SatisfyAll,
+		ForwardAuthenticationHeader: input.ForwardAuthenticationHeader,
+	}
+}
+
+func toAccessList(input *accessListDTO) accesslist.AccessList {
+	return accesslist.AccessList{
+		Name:           input.Name,
+		Realm:          input.Realm,
+		DefaultOutcome: input.DefaultOutcome,
+		Entries: mapSlice(input.Entries, func(entry *entrySetDTO) accesslist.Entry {
+			return accesslist.Entry{
+				Outcome:       entry.Outcome,
+				SourceAddress: entry.SourceAddresses,
+				Priority:      entry.Priority,
+			}
+		}),
+		Credentials: mapSlice(
+			input.Credentials,
+			func(credentials *credentialsDTO) accesslist.Credentials {
+				return accesslist.Credentials{
+					Username: credentials.Username,
+					Password: credentials.Password,
+				}
+			},
+		),
+		ID:                          input.ID,
+		SatisfyAll:                  input.SatisfyAll,
+		ForwardAuthenticationHeader: input.ForwardAuthenticationHeader,
+	}
+}
+
+func toCacheDTO(input *cache.Cache) cacheDTO {
+	return cacheDTO{
+		InactiveSeconds: input.InactiveSeconds,
+		StoragePath:     input.StoragePath,
+		MaximumSizeMB:   input.MaximumSizeMB,
+		ConcurrencyLock: concurrencyLockDTO{
+			TimeoutSeconds: input.ConcurrencyLock.TimeoutSeconds,
+			AgeSeconds:     input.ConcurrencyLock.AgeSeconds,
+			Enabled:        input.ConcurrencyLock.Enabled,
+		},
+		Name:           input.Name,
+		UseStale:       input.UseStale,
+		AllowedMethods: input.AllowedMethods,
+		BypassRules:    input.BypassRules,
+		NoCacheRules:   input.NoCacheRules,
+		FileExtensions: input.FileExtensions,
+		Durations: mapSlice(input.Durations, func(duration *cache.Duration) durationDTO {
+			return durationDTO{
+				StatusCodes:      duration.StatusCodes,
+				ValidTimeSeconds: duration.ValidTimeSeconds,
+			}
+		}),
+		MinimumUsesBeforeCaching:         input.MinimumUsesBeforeCaching,
+		ID:                               input.ID,
+		Revalidate:                       input.Revalidate,
+		BackgroundUpdate:                 input.BackgroundUpdate,
+		IgnoreUpstreamCacheHeaders:       input.IgnoreUpstreamCacheHeaders,
+		CacheStatusResponseHeaderEnabled: input.CacheStatusResponseHeaderEnabled,
+	}
+}
+
+func toCache(input *cacheDTO) cache.Cache {
+	return cache.Cache{
+		InactiveSeconds: input.InactiveSeconds,
+		StoragePath:     input.StoragePath,
+		MaximumSizeMB:   input.MaximumSizeMB,
+		ConcurrencyLock: cache.ConcurrencyLock{
+			TimeoutSeconds: input.ConcurrencyLock.TimeoutSeconds,
+			AgeSeconds:     input.ConcurrencyLock.AgeSeconds,
+			Enabled:        input.ConcurrencyLock.Enabled,
+		},
+		Name:           input.Name,
+		UseStale:       input.UseStale,
+		AllowedMethods: input.AllowedMethods,
+		BypassRules:    input.BypassRules,
+		NoCacheRules:   input.NoCacheRules,
+		FileExtensions: input.FileExtensions,
+		Durations: mapSlice(input.Durations, func(duration *durationDTO) cache.Duration {
+			return cache.Duration{
+				StatusCodes:      duration.StatusCodes,
+				ValidTimeSeconds: duration.ValidTimeSeconds,
+			}
+		}),
+		MinimumUsesBeforeCaching:         input.MinimumUsesBeforeCaching,
+		ID:                               input.ID,
+		Revalidate:                       input.Revalidate,
+		BackgroundUpdate:                 input.BackgroundUpdate,
+		IgnoreUpstreamCacheHeaders:       input.IgnoreUpstreamCacheHeaders,
+		CacheStatusResponseHeaderEnabled: input.CacheStatusResponseHeaderEnabled,
+	}
+}
+
+func toRateLimitDTO(input *ratelimit.RateLimit) rateLimitDTO {
+	return rateLimitDTO{
+		KeyName:            input.KeyName,
+		Name:               input.Name,
+		KeyType:            input.KeyType,
+		RateUnit:           input.RateUnit,
+		Rate:               input.Rate,
+		Burst:              input.Burst,
+		ResponseStatusCode: input.ResponseStatusCode,
+		ID:                 input.ID,
+		NoDelay:            input.NoDelay,
+		ConnectionLimit: rateLimitConnectionLimitDTO{
+			MaximumConnections: input.ConnectionLimit.MaximumConnections,
+			Enabled:            input.ConnectionLimit.Enabled,
+		},
+	}
+}
+
+func toRateLimit(input *rateLimitDTO) ratelimit.RateLimit {
+	return ratelimit.RateLimit{
+		KeyName:            input.KeyName,
+		Name:               input.Name,
+		KeyType:            input.KeyType,
+		RateUnit:           input.RateUnit,
+		Rate:               input.Rate,
+		Burst:              input.Burst,
+		ResponseStatusCode: input.ResponseStatusCode,
+		ID:                 input.ID,
+		NoDelay:            input.NoDelay,
+		ConnectionLimit: ratelimit.ConnectionLimit{
+			MaximumConnections: input.ConnectionLimit.MaximumConnections,
+			Enabled:            input.ConnectionLimit.Enabled,
+		},
+	}
+}
+
+func toSecurityHeadersDTO(input *securityheaders.SecurityHeaders) securityHeadersDTO {
+	csp := &input.ContentSecurityPolicy
+	return securityHeadersDTO{
+		FrameOptions:       input.FrameOptions,
+		ReferrerPolicy:     input.ReferrerPolicy,
+		PermissionsPolicy:  input.PermissionsPolicy,
+		Name:               input.Name,
+		ID:                 input.ID,
+		ContentTypeNoSniff: input.ContentTypeNoSniff,
+		ContentSecurityPolicy: contentSecurityPolicyDTO{
+			ReportURI:      csp.ReportURI,
+			ReportTo:       csp.ReportTo,
+			Enabled:        csp.Enabled,
+			ReportOnly:     csp.ReportOnly,
+			CollectReports: csp.CollectReports,
+			Directives:     mapSlice(csp.Directives, toCSPDirectiveDTO),
+		},
+	}
+}
+
+func toSecurityHeaders(input *securityHeadersDTO) securityheaders.SecurityHeaders {
+	csp := &input.ContentSecurityPolicy
+	return securityheaders.SecurityHeaders{
+		FrameOptions:       input.FrameOptions,
+		ReferrerPolicy:     input.ReferrerPolicy,
+		PermissionsPolicy:  input.PermissionsPolicy,
+		Name:               input.Name,
+		ID:                 input.ID,
+		ContentTypeNoSniff: input.ContentTypeNoSniff,
+		ContentSecurityPolicy: securityheaders.ContentSecurityPolicy{
+			ReportURI:      csp.ReportURI,
+			ReportTo:       csp.ReportTo,
+			Enabled:        csp.Enabled,
+			ReportOnly:     csp.ReportOnly,
+			CollectReports: csp.CollectReports,
+			Directives:     mapSlice(csp.Directives, toCSPDirective),
+		},
+	}
+}
+
+func toCSPDirectiveDTO(input *securityheaders.Directive) cspDirectiveDTO {
+	return cspDirectiveDTO{
+		Name:    input.Name,
+		Sources: input.Sources,
+	}
+}
+
+func toCSPDirective(input *cspDirectiveDTO) securityheaders.Directive {
+	return securityheaders.Directive{
+		Name:    input.Name,
+		Sources: input.Sources,
+	}
+}
+
+func toTLSProfileDTO(input *tlsprofile.TLSProfile) tlsProfileDTO {
+	return tlsProfileDTO{
+		Ciphers:             input.Ciphers,
+		Name:                input.Name,
+		Preset:              input.Preset,
+		Protocols:           input.Protocols,
+		ID:                  input.ID,
+		PreferServerCiphers: input.PreferServerCiphers,
+		OCSPStapling:        input.OCSPStapling,
+		HSTS: tlsHSTSDTO{
+			MaxAgeSeconds:     input.HSTS.MaxAgeSeconds,
+			Enabled:           input.HSTS.Enabled,
+			IncludeSubdomains: input.HSTS.IncludeSubdomains,
+			Preload:           input.HSTS.Preload,
+		},
+		Session: tlsSessionDTO{
+			CacheSizeMB:    input.Session.CacheSizeMB,
+			TimeoutMinutes: input.Session.TimeoutMinutes,
+			CacheEnabled:   input.Session.CacheEnabled,
+			TicketsEnabled: input.Session.TicketsEnabled,
+		},
+		ClientVerification: tlsClientVerificationDTO{
+			CertificateAuthorities: input.ClientVerification.CertificateAuthorities,
+			Mode:                   input.ClientVerification.Mode,
+			Depth:                  input.ClientVerification.Depth,
+			Enabled:                input.ClientVerification.Enabled,
+		},
+	}
+}
+
+func toTLSProfile(input *tlsProfileDTO) tlsprofile.TLSProfile {
+	return tlsprofile.TLSProfile{
+		Ciphers:             input.Ciphers,
+		Name:                input.Name,
+		Preset:              input.Preset,
+		Protocols:           input.Protocols,
+		ID:                  input.ID,
+		PreferServerCiphers: input.PreferServerCiphers,
+		OCSPStapling:        input.OCSPStapling,
+		HSTS: tlsprofile.HSTS{
+			MaxAgeSeconds:     input.HSTS.MaxAgeSeconds,
+			Enabled:           input.HSTS.Enabled,
+			IncludeSubdomains: input.HSTS.IncludeSubdomains,
+			Preload:           input.HSTS.Preload,
+		},
+		Session: tlsprofile.Session{
+			CacheSizeMB:    input.Session.CacheSizeMB,
+			TimeoutMinutes: input.Session.TimeoutMinutes,
+			CacheEnabled:   input.Session.CacheEnabled,
+			TicketsEnabled: input.Session.TicketsEnabled,
+		},
+		ClientVerification: tlsprofile.ClientVerification{
+			CertificateAuthorities: input.ClientVerification.CertificateAuthorities,
+			Mode:                   input.ClientVerification.Mode,
+			Depth:                  input.ClientVerification.Depth,
+			Enabled:                input.ClientVerification.Enabled,
+		},
+	}
+}
+
+func toCertificateDTO(input *certificate.Certificate) certificateDTO {
+	return certificateDTO{
+		IssuedAt:           input.IssuedAt,
+		ValidUntil:         input.ValidUntil,
+		ValidFrom:          input.ValidFrom,
+		RenewAfter:         input.RenewAfter,
+		Parameters:         input.Parameters,
+		Metadata:           input.Metadata,
+		ProviderID:         input.ProviderID,
+		PrivateKey:         input.PrivateKey,
+		PublicKey:          input.PublicKey,
+		DomainNames:        input.DomainNames,
+		CertificationChain: input.CertificationChain,
+		ID:                 input.ID,
+	}
+}
+
+func toCertificate(input *certificateDTO) certificate.Certificate {
+	return certificate.Certificate{
+		IssuedAt:           input.IssuedAt,
+		ValidUntil:         input.ValidUntil,
+		ValidFrom:          input.ValidFrom,
+		RenewAfter:         input.RenewAfter,
+		Parameters:         input.Parameters,
+		Metadata:           input.Metadata,
+		ProviderID:         input.ProviderID,
+		PrivateKey:         input.PrivateKey,
+		PublicKey:          input.PublicKey,
+		DomainNames:        input.DomainNames,
+		CertificationChain: input.CertificationChain,
+		ID:                 input.ID,
+	}
+}
+
+func toUpstreamDTO(input *upstream.Upstream) upstreamDTO {
+	output := upstreamDTO{
+		HashKey:  input.HashKey,
+		Name:     input.Name,
+		Method:   input.Method,
+		Protocol: input.Protocol,
+		Servers: mapSlice(input.Servers, func(server *upstream.Server) upstreamServerDTO {
+			serverDTO := upstreamServerDTO{
+				Weight:  server.Weight,
+				Address: server.Address,
+				Port:    server.Port,
+				Backup:  server.Backup,
+			}
+
+			if server.CircuitBreaker != nil {
+				serverDTO.CircuitBreaker = &upstreamCircuitBreakerDTO{
+					MaxFailures: server.CircuitBreaker.MaxFailures,
+					OpenSeconds: server.CircuitBreaker.OpenSeconds,
+				}
+			}
+
+			return serverDTO
+		}),
+		ID: input.ID,
+	}
+
+	if input.Keepalive != nil {
+		output.Keepalive = &upstreamKeepaliveDTO{
+			Connections:    input.Keepalive.Connections,
+			TimeoutSeconds: input.Keepalive.TimeoutSeconds,
+		}
+	}
+
+	return output
+}
+
+func toUpstream(input *upstreamDTO) upstream.Upstream {
+	output := upstream.Upstream{
+		HashKey:  input.HashKey,
+		Name:     input.Name,
+		Method:   input.Method,
+		Protocol: input.Protocol,
+		Servers: mapSlice(input.Servers, func(server *upstreamServerDTO) upstream.Server {
+			domain := upstream.Server{
+				Weight:  server.Weight,
+				Address: server.Address,
+				Port:    server.Port,
+				Backup:  server.Backup,
+			}
+
+			if server.CircuitBreaker != nil {
+				domain.CircuitBreaker = &upstream.CircuitBreaker{
+					MaxFailures: server.CircuitBreaker.MaxFailures,
+					OpenSeconds: server.CircuitBreaker.OpenSeconds,
+				}
+			}
+
+			return domain
+		}),
+		ID: input.ID,
+	}
+
+	if input.Keepalive != nil {
+		output.Keepalive = &upstream.Keepalive{
+			Connections:    input.Keepalive.Connections,
+			TimeoutSeconds: input.Keepalive.TimeoutSeconds,
+		}
+	}
+
+	return output
+}
+
+func toHostDTO(input *host.Host) hostDTO {
+	return hostDTO{
+		AccessListID:      input.AccessListID,
+		CacheID:           input.CacheID,
+		RateLimitID:       input.RateLimitID,
+		SecurityHeadersID: input.SecurityHeadersID,
+		DomainNames:       input.DomainNames,
+		HeaderRules:       mapSlice(input.HeaderRules, toHeaderRuleDTO),
+		Routes:            mapSlice(input.Routes, toRouteDTO),
+		Bindings:          mapSlice(input.Bindings, toBindingDTO),
+		VPNs: mapSlice(input.VPNs, func(vpn *host.VPN) hostVPNDTO {
+			return hostVPNDTO{
+				Host:          vpn.Host,
+				CertificateID: vpn.CertificateID,
+				Name:          vpn.Name,
+				VPNID:         vpn.VPNID,
+				EnableHTTPS:   vpn.EnableHTTPS,
+			}
+		}),
+		FeatureSet: featureSetDTO{
+			WebsocketsSupport:   input.FeatureSet.WebsocketSupport,
+			HTTP2Support:        input.FeatureSet.HTTP2Support,
+			RedirectHTTPToHTTPS: input.FeatureSet.RedirectHTTPToHTTPS,
+			StatsEnabled:        input.FeatureSet.StatsEnabled,
+		},
+		ID:                input.ID,
+		Enabled:           input.Enabled,
+		DefaultServer:     input.DefaultServer,
+		UseGlobalBindings: input.UseGlobalBindings,
+	}
+}
+
+func toHost(input *hostDTO) host.Host {
+	return host.Host{
+		AccessListID:      input.AccessListID,
+		CacheID:           input.CacheID,
+		RateLimitID:       input.RateLimitID,
+		SecurityHeadersID: input.SecurityHeadersID,
+		DomainNames:       input.DomainNames,
+		HeaderRules:       mapSlice(input.HeaderRules, toHeaderRule),
+		Routes:            mapSlice(input.Routes, toRoute),
+		Bindings:          mapSlice(input.Bindings, toBinding),
+		VPNs: mapSlice(input.VPNs, func(vpn *hostVPNDTO) host.VPN {
+			return host.VPN{
+				Host:          vpn.Host,
+				CertificateID: vpn.CertificateID,
+				Name:          vpn.Name,
+				VPNID:         vpn.VPNID,
+				EnableHTTPS:   vpn.EnableHTTPS,
+			}
+		}),
+		FeatureSet: host.FeatureSet{
+			WebsocketSupport:    input.FeatureSet.WebsocketsSupport,
+			HTTP2Support:        input.FeatureSet.HTTP2Support,
+			RedirectHTTPToHTTPS: input.FeatureSet.RedirectHTTPToHTTPS,
+			StatsEnabled:        input.FeatureSet.StatsEnabled,
+		},
+		ID:                input.ID,
+		Enabled:           input.Enabled,
+		DefaultServer:     input.DefaultServer,
+		UseGlobalBindings: input.UseGlobalBindings,
+	}
+}
+
+func toHeaderRuleDTO(input *host.HeaderRule) headerRuleDTO {
+	return headerRuleDTO{
+		Value:  input.Value,
+		Name:   input.Name,
+		Target: input.Target,
+		Action: input.Action,
+		Always: input.Always,
+	}
+}
+
+func toHeaderRule(input *headerRuleDTO) host.HeaderRule {
+	return host.HeaderRule{
+		Value:  input.Value,
+		Name:   input.Name,
+		Target: input.Target,
+		Action: input.Action,
+		Always: input.Always,
+	}
+}
+
+func toRewriteRuleDTO(input *host.RewriteRule) rewriteRuleDTO {
+	return rewriteRuleDTO{
+		Flag:        input.Flag,
+		Pattern:     input.Pattern,
+		Replacement: input.Replacement,
+	}
+}
+
+func toRewriteRule(input *rewriteRuleDTO) host.RewriteRule {
+	return host.RewriteRule{
+		Flag:        input.Flag,
+		Pattern:     input.Pattern,
+		Replacement: input.Replacement,
+	}
+}
+
+func toRouteDTO(input *host.Route) routeDTO {
+	output := routeDTO{
+		RedirectCode: input.RedirectCode,
+		TargetURI:    input.TargetURI,
+		AccessListID: input.AccessListID,
+		CacheID:      input.CacheID,
+		RateLimitID:  input.RateLimitID,
+		UpstreamID:   input.UpstreamID,
+		HeaderRules:  mapSlice(input.HeaderRules, toHeaderRuleDTO),
+		RewriteRules: mapSlice(input.RewriteRules, toRewriteRuleDTO),
+		Settings: routeSettingsDTO{
+			Custom:                  input.Settings.Custom,
+			IndexFile:               input.Settings.IndexFile,
+			IncludeForwardHeaders:   input.Settings.IncludeForwardHeaders,
+			ProxySslServerName:      input.Settings.ProxySSLServerName,
+			IgnoreSSLErrors:         input.Settings.IgnoreSSLErrors,
+			KeepOriginalDomainName:  input.Settings.KeepOriginalDomainName,
+			DirectoryListingEnabled: input.Settings.DirectoryListingEnabled,
+			PreserveQueryString:     input.Settings.PreserveQueryString,
+		},
+		Type:       input.Type,
+		MatchType:  input.MatchType,
+		SourcePath: input.SourcePath,
+		Priority:   input.Priority,
+		ID:         input.ID,
+		Enabled:    input.Enabled,
+	}
+
+	if input.Response != nil {
+		output.Response = &staticResponseDTO{
+			Headers:    input.Response.Headers,
+			Payload:    input.Response.Payload,
+			StatusCode: input.Response.StatusCode,
+		}
+	}
+
+	if input.Integration != nil {
+		output.Integration = &integrationConfigDTO{
+			OptionID:      input.Integration.OptionID,
+			IntegrationID: input.Integration.IntegrationID,
+			UseHTTPS:      input.Integration.UseHTTPS,
+		}
+	}
+
+	if input.SourceCode != nil {
+		output.SourceCode = &routeSourceCodeDTO{
+			MainFunction: input.SourceCode.MainFunction,
+			Language:     input.SourceCode.Language,
+			Code:         input.SourceCode.Contents,
+		}
+	}
+
+	return output
+}
+
+func toRoute(input *routeDTO) host.Route {
+	matchType := input.MatchType
+	if matchType == "" {
+		matchType = host.PrefixRouteMatchType
+	}
+
+	output := host.Route{
+		RedirectCode: input.RedirectCode,
+		TargetURI:    input.TargetURI,
+		AccessListID: input.AccessListID,
+		CacheID:      input.CacheID,
+		RateLimitID:  input.RateLimitID,
+		UpstreamID:   input.UpstreamID,
+		HeaderRules:  mapSlice(input.HeaderRules, toHeaderRule),
+		RewriteRules: mapSlice(input.RewriteRules, toRewriteRule),
+		Settings: host.RouteSettings{
+			Custom:                  input.Settings.Custom,
+			IndexFile:               input.Settings.IndexFile,
+			IncludeForwardHeaders:   input.Settings.IncludeForwardHeaders,
+			ProxySSLServerName:      input.Settings.ProxySslServerName,
+			IgnoreSSLErrors:         input.Settings.IgnoreSSLErrors,
+			KeepOriginalDomainName:  input.Settings.KeepOriginalDomainName,
+			DirectoryListingEnabled: input.Settings.DirectoryListingEnabled,
+			PreserveQueryString:     input.Settings.PreserveQueryString,
+		},
+		Type:       input.Type,
+		MatchType:  matchType,
+		SourcePath: input.SourcePath,
+		Priority:   input.Priority,
+		ID:         input.ID,
+		Enabled:    input.Enabled,
+	}
+
+	if input.Response != nil {
+		output.Response = &host.RouteStaticResponse{
+			Headers:    input.Response.Headers,
+			Payload:    input.Response.Payload,
+			StatusCode: input.Response.StatusCode,
+		}
+	}
+
+	if input.Integration != nil {
+		output.Integration = &host.RouteIntegrationConfig{
+			OptionID:      input.Integration.OptionID,
+			IntegrationID: input.Integration.IntegrationID,
+			UseHTTPS:      input.Integration.UseHTTPS,
+		}
+	}
+
+	if input.SourceCode != nil {
+		output.SourceCode = &host.RouteSourceCode{
+			MainFunction: input.SourceCode.MainFunction,
+			Language:     input.SourceCode.Language,
+			Contents:     input.SourceCode.Code,
+		}
+	}
+
+	return output
+}
+
+func toStreamDTO(input *stream.Stream) streamDTO {
+	return streamDTO{
+		DefaultBackend: toBackendDTO(&input.DefaultBackend),
+		Binding:        toAddressDTO(&input.Binding),
+		Name:           input.Name,
+		Type:           input.Type,
+		Routes: mapSlice(input.Routes, func(route *stream.Route) streamRouteDTO {
+			return streamRouteDTO{
+				DomainNames: route.DomainNames,
+				Backends:    mapSlice(route.Backends, toBackendDTO),
+			}
+		}),
+		ID: input.ID,
+		FeatureSet: streamFeatureSetDTO{
+			UseProxyProtocol: input.FeatureSet.UseProxyProtocol,
+			SocketKeepAlive:  input.FeatureSet.SocketKeepAlive,
+			TCPKeepAlive:     input.FeatureSet.TCPKeepAlive,
+			TCPNoDelay:       input.FeatureSet.TCPNoDelay,
+			TCPDeferred:      input.FeatureSet.TCPDeferred,
+		},
+		Enabled: input.Enabled,
+	}
+}
+
+func toStream(input *streamDTO) stream.Stream {
+	return stream.Stream{
+		DefaultBackend: toBackend(&input.DefaultBackend),
+		Binding:        toAddress(&input.Binding),
+		Name:           input.Name,
+		Type:           input.Type,
+		Routes: mapSlice(input.Routes, func(route *streamRouteDTO) stream.Route {
+			return stream.Route{
+				DomainNames: route.DomainNames,
+				Backends:    mapSlice(route.Backends, toBackend),
+			}
+		}),
+		ID: input.ID,
+		FeatureSet: stream.FeatureSet{
+			UseProxyProtocol: input.FeatureSet.UseProxyProtocol,
+			SocketKeepAlive:  input.FeatureSet.SocketKeepAlive,
+			TCPKeepAlive:     input.FeatureSet.TCPKeepAlive,
+			TCPNoDelay:       input.FeatureSet.TCPNoDelay,
+			TCPDeferred:      input.FeatureSet.TCPDeferred,
+		},
+		Enabled: input.Enabled,
+	}
+}
+
+func toBackendDTO(input *stream.Backend) backendDTO {
+	output := backendDTO{
+		Weight: input.Weight,
+		Target: toAddressDTO(&input.Address),
+	}
+
+	if input.CircuitBreaker != nil {
+		output.CircuitBreaker = &circuitBreakerDTO{
+			MaxFailures: input.CircuitBreaker.MaxFailures,
+			OpenSeconds: input.CircuitBreaker.OpenSeconds,
+		}
+	}
+
+	return output
+}
+
+func toBackend(input *backendDTO) stream.Backend {
+	output := stream.Backend{
+		Weight:  input.Weight,
+		Address: toAddress(&input.Target),
+	}
+
+	if input.CircuitBreaker != nil {
+		output.CircuitBreaker = &stream.CircuitBreaker{
+			MaxFailures: input.CircuitBreaker.MaxFailures,
+			OpenSeconds: input.CircuitBreaker.OpenSeconds,
+		}
+	}
+
+	return output
+}
+
+func toAddressDTO(input *stream.Address) addressDTO {
+	return addressDTO{
+		Port:     input.Port,
+		Protocol: input.Protocol,
+		Address:  input.Address,
+	}
+}
+
+func toAddress(input *addressDTO) stream.Address {
+	return stream.Address{
+		Port:     input.Port,
+		Protocol: input.Protocol,
+		Address:  input.Address,
+	}
+}
@@ -0,0 +1,37 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dillmann.com.br/nginx-ignition/core/host"
+)
+
+func Test_toDocument(t *testing.T) {
+	t.Run("converts back the exported document", func(t *testing.T) {
+		document := newDocument()
+
+		result := toDocument(toDocumentDTO(document))
+
+		assert.Equal(t, document.Version, result.Version)
+		assert.Equal(t, document.Settings, result.Settings)
+		assert.Equal(t, document.Certificates, result.Certificates)
+		assert.Equal(t, document.Upstreams, result.Upstreams)
+		assert.Equal(t, document.Hosts, result.Hosts)
+		assert.Equal(t, document.Streams, result.Streams)
+		assert.Empty(t, result.Integrations)
+	})
+
+	t.Run("keeps the settings empty when not provided", func(t *testing.T) {
+		result := toDocument(&documentDTO{})
+
+		assert.Nil(t, result.Settings)
+	})
+
+	t.Run("uses the prefix match type when not provided", func(t *testing.T) {
+		result := toRoute(&routeDTO{SourcePath: "/"})
+
+		assert.Equal(t, host.PrefixRouteMatchType, result.MatchType)
+	})
+}
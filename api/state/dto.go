@@ -0,0 +1,488 @@
+package state
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/binding"
+	"dillmann.com.br/nginx-ignition/core/cache"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/state"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+type documentDTO struct {
+	Settings        *settingsDTO         `json:"settings,omitempty"`
+	Integrations    []integrationDTO     `json:"integrations"`
+	VPNs            []vpnDTO             `json:"vpns"`
+	AccessLists     []accessListDTO      `json:"accessLists"`
+	Caches          []cacheDTO           `json:"caches"`
+	RateLimits      []rateLimitDTO       `json:"rateLimits"`
+	SecurityHeaders []securityHeadersDTO `json:"securityHeaders"`
+	Upstreams       []upstreamDTO        `json:"upstreams"`
+	Certificates    []certificateDTO     `json:"certificates"`
+	TLSProfiles     []tlsProfileDTO      `json:"tlsProfiles"`
+	Hosts           []hostDTO            `json:"hosts"`
+	Streams         []streamDTO          `json:"streams"`
+	Version         int                  `json:"version"`
+}
+
+type settingsDTO struct {
+	Nginx                *nginxSettingsDTO                `json:"nginx,omitempty"`
+	LogRotation          *logRotationSettingsDTO          `json:"logRotation,omitempty"`
+	CertificateAutoRenew *certificateAutoRenewSettingsDTO `json:"certificateAutoRenew,omitempty"`
+	Backup               *backupSettingsDTO               `json:"backup,omitempty"`
+	GlobalBindings       []bindingDTO                     `json:"globalBindings"`
+}
+
+type nginxSettingsDTO struct {
+	Timeouts            *nginxTimeoutsSettingsDTO `json:"timeouts,omitempty"`
+	Buffers             *nginxBuffersSettingsDTO  `json:"buffers,omitempty"`
+	Logs                *nginxLogsSettingsDTO     `json:"logs,omitempty"`
+	Stats               *nginxStatsSettingsDTO    `json:"stats,omitempty"`
+	Custom              *string                   `json:"custom,omitempty"`
+	RuntimeUser         string                    `json:"runtimeUser"`
+	DefaultContentType  string                    `json:"defaultContentType"`
+	WorkerProcesses     int                       `json:"workerProcesses"`
+	WorkerConnections   int                       `json:"workerConnections"`
+	MaximumBodySizeMb   int                       `json:"maximumBodySizeMb"`
+	ServerTokensEnabled bool                      `json:"serverTokensEnabled"`
+	TCPNoDelayEnabled   bool                      `json:"tcpNoDelayEnabled"`
+	GzipEnabled         bool                      `json:"gzipEnabled"`
+	SendfileEnabled     bool                      `json:"sendfileEnabled"`
+}
+
+type nginxTimeoutsSettingsDTO struct {
+	Read       int `json:"read"`
+	Connect    int `json:"connect"`
+	Send       int `json:"send"`
+	Keepalive  int `json:"keepalive"`
+	ClientBody int `json:"clientBody"`
+}
+
+type nginxBuffersSettingsDTO struct {
+	LargeClientHeader *nginxBufferSizeDTO `json:"largeClientHeader,omitempty"`
+	Output            *nginxBufferSizeDTO `json:"output,omitempty"`
+	ClientBodyKb      int                 `json:"clientBodyKb"`
+	ClientHeaderKb    int                 `json:"clientHeaderKb"`
+}
+
+type nginxBufferSizeDTO struct {
+	SizeKb int `json:"sizeKb"`
+	Amount int `json:"amount"`
+}
+
+type nginxLogsSettingsDTO struct {
+	ServerLogsLevel   settings.LogLevel        `json:"serverLogsLevel"`
+	ErrorLogsLevel    settings.LogLevel        `json:"errorLogsLevel"`
+	AccessLogsFormat  settings.AccessLogFormat `json:"accessLogsFormat,omitempty"`
+	Destinations      []logDestinationDTO      `json:"destinations,omitempty"`
+	ServerLogsEnabled bool                     `json:"serverLogsEnabled"`
+	AccessLogsEnabled bool                     `json:"accessLogsEnabled"`
+	ErrorLogsEnabled  bool                     `json:"errorLogsEnabled"`
+}
+
+type logDestinationDTO struct {
+	HostID     *uuid.UUID                      `json:"hostId,omitempty"`
+	Tag        *string                         `json:"tag,omitempty"`
+	Type       settings.LogDestinationType     `json:"type"`
+	Protocol   settings.LogDestinationProtocol `json:"protocol"`
+	Address    string                          `json:"address"`
+	Facility   string                          `json:"facility,omitempty"`
+	AccessLogs bool                            `json:"accessLogs"`
+	ErrorLogs  bool                            `json:"errorLogs"`
+}
+
+type nginxStatsSettingsDTO struct {
+	DatabaseLocation *string `json:"databaseLocation,omitempty"`
+	MaximumSizeMB    int     `json:"maximumSizeMb"`
+	Enabled          bool    `json:"enabled"`
+	Persistent       bool    `json:"persistent"`
+	AllHosts         bool    `json:"allHosts"`
+}
+
+type logRotationSettingsDTO struct {
+	IntervalUnit      settings.TimeUnit `json:"intervalUnit"`
+	MaximumLines      int               `json:"maximumLines"`
+	IntervalUnitCount int               `json:"intervalUnitCount"`
+	Enabled           bool              `json:"enabled"`
+}
+
+type certificateAutoRenewSettingsDTO struct {
+	IntervalUnit      settings.TimeUnit `json:"intervalUnit"`
+	IntervalUnitCount int               `json:"intervalUnitCount"`
+	Enabled           bool              `json:"enabled"`
+}
+
+type backupSettingsDTO struct {
+	LocalPath         *string                    `json:"localPath,omitempty"`
+	S3                *backupS3SettingsDTO       `json:"s3,omitempty"`
+	Destination       settings.BackupDestination `json:"destination"`
+	IntervalUnit      settings.TimeUnit          `json:"intervalUnit"`
+	IntervalUnitCount int                        `json:"intervalUnitCount"`
+	MaximumCount      int                        `json:"maximumCount"`
+	MaximumAgeDays    int                        `json:"maximumAgeDays"`
+	Enabled           bool                       `json:"enabled"`
+}
+
+type backupS3SettingsDTO struct {
+	Prefix    *string `json:"prefix,omitempty"`
+	SecretKey *string `json:"secretKey,omitempty"`
+	Endpoint  string  `json:"endpoint"`
+	Region    string  `json:"region"`
+	Bucket    string  `json:"bucket"`
+	AccessKey string  `json:"accessKey"`
+}
+
+type bindingDTO struct {
+	CertificateID *uuid.UUID   `json:"certificateId,omitempty"`
+	TLSProfileID  *uuid.UUID   `json:"tlsProfileId,omitempty"`
+	Type          binding.Type `json:"type"`
+	IP            string       `json:"ip"`
+	Port          int          `json:"port"`
+	ID            uuid.UUID    `json:"id"`
+}
+
+type integrationDTO struct {
+	Parameters map[string]any `json:"parameters"`
+	Driver     string         `json:"driver"`
+	Name       string         `json:"name"`
+	ID         uuid.UUID      `json:"id"`
+	Enabled    bool           `json:"enabled"`
+}
+
+type vpnDTO struct {
+	Parameters map[string]any `json:"parameters"`
+	Driver     string         `json:"driver"`
+	Name       string         `json:"name"`
+	ID         uuid.UUID      `json:"id"`
+	Enabled    bool           `json:"enabled"`
+}
+
+type accessListDTO struct {
+	Name                        string             `json:"name"`
+	Realm                       string             `json:"realm"`
+	DefaultOutcome              accesslist.Outcome `json:"defaultOutcome"`
+	Entries                     []entrySetDTO      `json:"entries"`
+	Credentials                 []credentialsDTO   `json:"credentials"`
+	ID                          uuid.UUID          `json:"id"`
+	SatisfyAll                  bool               `json:"satisfyAll"`
+	ForwardAuthenticationHeader bool               `json:"forwardAuthenticationHeader"`
+}
+
+type entrySetDTO struct {
+	Outcome         accesslist.Outcome `json:"outcome"`
+	SourceAddresses []string           `json:"sourceAddresses"`
+	Priority        int                `json:"priority"`
+}
+
+type credentialsDTO struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type cacheDTO struct {
+	InactiveSeconds                  *int                   `json:"inactiveSeconds,omitempty"`
+	StoragePath                      *string                `json:"storagePath,omitempty"`
+	MaximumSizeMB                    *int                   `json:"maximumSizeMb,omitempty"`
+	ConcurrencyLock                  concurrencyLockDTO     `json:"concurrencyLock"`
+	Name                             string                 `json:"name"`
+	UseStale                         []cache.UseStaleOption `json:"useStale"`
+	AllowedMethods                   []cache.Method         `json:"allowedMethods"`
+	BypassRules                      []string               `json:"bypassRules"`
+	NoCacheRules                     []string               `json:"noCacheRules"`
+	FileExtensions                   []string               `json:"fileExtensions"`
+	Durations                        []durationDTO          `json:"durations"`
+	MinimumUsesBeforeCaching         int                    `json:"minimumUsesBeforeCaching"`
+	ID                               uuid.UUID              `json:"id"`
+	Revalidate                       bool                   `json:"revalidate"`
+	BackgroundUpdate                 bool                   `json:"backgroundUpdate"`
+	IgnoreUpstreamCacheHeaders       bool                   `json:"ignoreUpstreamCacheHeaders"`
+	CacheStatusResponseHeaderEnabled bool                   `json:"cacheStatusResponseHeaderEnabled"`
+}
+
+type rateLimitDTO struct {
+	KeyName            *string                     `json:"keyName,omitempty"`
+	Name               string                      `json:"name"`
+	KeyType            ratelimit.KeyType           `json:"keyType"`
+	RateUnit           ratelimit.RateUnit          `json:"rateUnit"`
+	ConnectionLimit    rateLimitConnectionLimitDTO `json:"connectionLimit"`
+	Rate               int                         `json:"rate"`
+	Burst              int                         `json:"burst"`
+	ResponseStatusCode int                         `json:"responseStatusCode"`
+	ID                 uuid.UUID                   `json:"id"`
+	NoDelay            bool                        `json:"noDelay"`
+}
+
+type rateLimitConnectionLimitDTO struct {
+	MaximumConnections *int `json:"maximumConnections,omitempty"`
+	Enabled            bool `json:"enabled"`
+}
+
+type securityHeadersDTO struct {
+	FrameOptions          *securityheaders.FrameOptions   `json:"frameOptions,omitempty"`
+	ReferrerPolicy        *securityheaders.ReferrerPolicy `json:"referrerPolicy,omitempty"`
+	PermissionsPolicy     *string                         `json:"permissionsPolicy,omitempty"`
+	Name                  string                          `json:"name"`
+	ContentSecurityPolicy contentSecurityPolicyDTO        `json:"contentSecurityPolicy"`
+	ID                    uuid.UUID                       `json:"id"`
+	ContentTypeNoSniff    bool                            `json:"contentTypeNoSniff"`
+}
+
+type contentSecurityPolicyDTO struct {
+	ReportURI      *string           `json:"reportUri,omitempty"`
+	ReportTo       *string           `json:"reportTo,omitempty"`
+	Directives     []cspDirectiveDTO `json:"directives,omitempty"`
+	Enabled        bool              `json:"enabled"`
+	ReportOnly     bool              `json:"reportOnly"`
+	CollectReports bool              `json:"collectReports"`
+}
+
+type cspDirectiveDTO struct {
+	Name    string   `json:"name"`
+	Sources []string `json:"sources,omitempty"`
+}
+
+type tlsProfileDTO struct {
+	Ciphers             *string                  `json:"ciphers,omitempty"`
+	Name                string                   `json:"name"`
+	Preset              tlsprofile.Preset        `json:"preset"`
+	Protocols           []tlsprofile.Protocol    `json:"protocols"`
+	ClientVerification  tlsClientVerificationDTO `json:"clientVerification"`
+	HSTS                tlsHSTSDTO               `json:"hsts"`
+	Session             tlsSessionDTO            `json:"session"`
+	ID                  uuid.UUID                `json:"id"`
+	PreferServerCiphers bool                     `json:"preferServerCiphers"`
+	OCSPStapling        bool                     `json:"ocspStapling"`
+}
+
+type tlsHSTSDTO struct {
+	MaxAgeSeconds     int  `json:"maxAgeSeconds"`
+	Enabled           bool `json:"enabled"`
+	IncludeSubdomains bool `json:"includeSubdomains"`
+	Preload           bool `json:"preload"`
+}
+
+type tlsSessionDTO struct {
+	CacheSizeMB    int  `json:"cacheSizeMb"`
+	TimeoutMinutes int  `json:"timeoutMinutes"`
+	CacheEnabled   bool `json:"cacheEnabled"`
+	TicketsEnabled bool `json:"ticketsEnabled"`
+}
+
+type tlsClientVerificationDTO struct {
+	CertificateAuthorities *string                           `json:"certificateAuthorities,omitempty"`
+	Mode                   tlsprofile.ClientVerificationMode `json:"mode,omitempty"`
+	Depth                  int                               `json:"depth"`
+	Enabled                bool                              `json:"enabled"`
+}
+
+type concurrencyLockDTO struct {
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+	AgeSeconds     *int `json:"ageSeconds,omitempty"`
+	Enabled        bool `json:"enabled"`
+}
+
+type durationDTO struct {
+	StatusCodes      []string `json:"statusCodes"`
+	ValidTimeSeconds int      `json:"validTimeSeconds"`
+}
+
+type upstreamDTO struct {
+	HashKey   *string                  `json:"hashKey,omitempty"`
+	Keepalive *upstreamKeepaliveDTO    `json:"keepalive,omitempty"`
+	Name      string                   `json:"name"`
+	Method    upstream.BalancingMethod `json:"method"`
+	Protocol  upstream.Protocol        `json:"protocol"`
+	Servers   []upstreamServerDTO      `json:"servers"`
+	ID        uuid.UUID                `json:"id"`
+}
+
+type upstreamServerDTO struct {
+	Weight         *int                       `json:"weight,omitempty"`
+	CircuitBreaker *upstreamCircuitBreakerDTO `json:"circuitBreaker,omitempty"`
+	Address        string                     `json:"address"`
+	Port           int                        `json:"port"`
+	Backup         bool                       `json:"backup"`
+}
+
+type upstreamCircuitBreakerDTO struct {
+	MaxFailures int `json:"maxFailures"`
+	OpenSeconds int `json:"openSeconds"`
+}
+
+type upstreamKeepaliveDTO struct {
+	Connections    int `json:"connections"`
+	TimeoutSeconds int `json:"timeoutSeconds"`
+}
+
+type certificateDTO struct {
+	IssuedAt           time.Time      `json:"issuedAt"`
+	ValidUntil         time.Time      `json:"validUntil"`
+	ValidFrom          time.Time      `json:"validFrom"`
+	RenewAfter         *time.Time     `json:"renewAfter,omitempty"`
+	Parameters         map[string]any `json:"parameters"`
+	Metadata           *string        `json:"metadata,omitempty"`
+	ProviderID         string         `json:"providerId"`
+	PrivateKey         string         `json:"privateKey,omitempty"`
+	PublicKey          string         `json:"publicKey,omitempty"`
+	DomainNames        []string       `json:"domainNames"`
+	CertificationChain []string       `json:"certificationChain,omitempty"`
+	ID                 uuid.UUID      `json:"id"`
+}
+
+type hostDTO struct {
+	AccessListID      *uuid.UUID      `json:"accessListId,omitempty"`
+	CacheID           *uuid.UUID      `json:"cacheId,omitempty"`
+	RateLimitID       *uuid.UUID      `json:"rateLimitId,omitempty"`
+	SecurityHeadersID *uuid.UUID      `json:"securityHeadersId,omitempty"`
+	DomainNames       []string        `json:"domainNames"`
+	HeaderRules       []headerRuleDTO `json:"headerRules,omitempty"`
+	Routes            []routeDTO      `json:"routes"`
+	Bindings          []bindingDTO    `json:"bindings"`
+	VPNs              []hostVPNDTO    `json:"vpns"`
+	FeatureSet        featureSetDTO   `json:"featureSet"`
+	ID                uuid.UUID       `json:"id"`
+	Enabled           bool            `json:"enabled"`
+	DefaultServer     bool            `json:"defaultServer"`
+	UseGlobalBindings bool            `json:"useGlobalBindings"`
+}
+
+type featureSetDTO struct {
+	WebsocketsSupport   bool `json:"websocketsSupport"`
+	HTTP2Support        bool `json:"http2Support"`
+	RedirectHTTPToHTTPS bool `json:"redirectHttpToHttps"`
+	StatsEnabled        bool `json:"statsEnabled"`
+}
+
+type routeDTO struct {
+	RedirectCode *int                  `json:"redirectCode,omitempty"`
+	TargetURI    *string               `json:"targetUri,omitempty"`
+	AccessListID *uuid.UUID            `json:"accessListId,omitempty"`
+	CacheID      *uuid.UUID            `json:"cacheId,omitempty"`
+	RateLimitID  *uuid.UUID            `json:"rateLimitId,omitempty"`
+	UpstreamID   *uuid.UUID            `json:"upstreamId,omitempty"`
+	Response     *staticResponseDTO    `json:"response,omitempty"`
+	Integration  *integrationConfigDTO `json:"integration,omitempty"`
+	SourceCode   *routeSourceCodeDTO   `json:"sourceCode,omitempty"`
+	HeaderRules  []headerRuleDTO       `json:"headerRules,omitempty"`
+	RewriteRules []rewriteRuleDTO      `json:"rewriteRules,omitempty"`
+	Settings     routeSettingsDTO      `json:"settings"`
+	Type         host.RouteType        `json:"type"`
+	MatchType    host.RouteMatchType   `json:"matchType,omitempty"`
+	SourcePath   string                `json:"sourcePath"`
+	Priority     int                   `json:"priority"`
+	ID           uuid.UUID             `json:"id"`
+	Enabled      bool                  `json:"enabled"`
+}
+
+type routeSettingsDTO struct {
+	Custom                  *string `json:"custom,omitempty"`
+	IndexFile               *string `json:"indexFile,omitempty"`
+	IncludeForwardHeaders   bool    `json:"includeForwardHeaders"`
+	ProxySslServerName      bool    `json:"proxySslServerName"`
+	IgnoreSSLErrors         bool    `json:"ignoreSslErrors"`
+	KeepOriginalDomainName  bool    `json:"keepOriginalDomainName"`
+	DirectoryListingEnabled bool    `json:"directoryListingEnabled"`
+	PreserveQueryString     bool    `json:"preserveQueryString"`
+}
+
+type headerRuleDTO struct {
+	Value  *string               `json:"value,omitempty"`
+	Name   string                `json:"name"`
+	Target host.HeaderRuleTarget `json:"target"`
+	Action host.HeaderRuleAction `json:"action"`
+	Always bool                  `json:"always"`
+}
+
+type rewriteRuleDTO struct {
+	Flag        *host.RewriteRuleFlag `json:"flag,omitempty"`
+	Pattern     string                `json:"pattern"`
+	Replacement string                `json:"replacement"`
+}
+
+type staticResponseDTO struct {
+	Headers    map[string]string `json:"headers"`
+	Payload    *string           `json:"payload,omitempty"`
+	StatusCode int               `json:"statusCode"`
+}
+
+type integrationConfigDTO struct {
+	OptionID      string    `json:"optionId"`
+	IntegrationID uuid.UUID `json:"integrationId"`
+	UseHTTPS      bool      `json:"useHttps"`
+}
+
+type routeSourceCodeDTO struct {
+	MainFunction *string           `json:"mainFunction,omitempty"`
+	Language     host.CodeLanguage `json:"language"`
+	Code         string            `json:"code"`
+}
+
+type hostVPNDTO struct {
+	Host          *string    `json:"host,omitempty"`
+	CertificateID *uuid.UUID `json:"certificateId,omitempty"`
+	Name          string     `json:"name"`
+	VPNID         uuid.UUID  `json:"vpnId"`
+	EnableHTTPS   bool       `json:"enableHttps"`
+}
+
+type streamDTO struct {
+	DefaultBackend backendDTO          `json:"defaultBackend"`
+	Binding        addressDTO          `json:"binding"`
+	Name           string              `json:"name"`
+	Type           stream.Type         `json:"type"`
+	Routes         []streamRouteDTO    `json:"routes"`
+	ID             uuid.UUID           `json:"id"`
+	FeatureSet     streamFeatureSetDTO `json:"featureSet"`
+	Enabled        bool                `json:"enabled"`
+}
+
+type streamRouteDTO struct {
+	DomainNames []string     `json:"domainNames"`
+	Backends    []backendDTO `json:"backends"`
+}
+
+type backendDTO struct {
+	Weight         *int               `json:"weight,omitempty"`
+	CircuitBreaker *circuitBreakerDTO `json:"circuitBreaker,omitempty"`
+	Target         addressDTO         `json:"target"`
+}
+
+type circuitBreakerDTO struct {
+	MaxFailures int `json:"maxFailures"`
+	OpenSeconds int `json:"openSeconds"`
+}
+
+type addressDTO struct {
+	Port     *int            `json:"port,omitempty"`
+	Protocol stream.Protocol `json:"protocol"`
+	Address  string          `json:"address"`
+}
+
+type streamFeatureSetDTO struct {
+	UseProxyProtocol bool `json:"useProxyProtocol"`
+	SocketKeepAlive  bool `json:"socketKeepAlive"`
+	TCPKeepAlive     bool `json:"tcpKeepAlive"`
+	TCPNoDelay       bool `json:"tcpNoDelay"`
+	TCPDeferred      bool `json:"tcpDeferred"`
+}
+
+type changeDTO struct {
+	EntityID   *uuid.UUID       `json:"entityId"`
+	EntityType state.EntityType `json:"entityType"`
+	Action     state.Action     `json:"action"`
+	Name       string           `json:"name"`
+}
+
+type importResponseDTO struct {
+	Changes []changeDTO `json:"changes"`
+	DryRun  bool        `json:"dryRun"`
+}
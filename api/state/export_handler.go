@@ -0,0 +1,58 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-yaml"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/state"
+)
+
+const (
+	yamlFormat = "yaml"
+	jsonFormat = "json"
+)
+
+type exportHandler struct {
+	commands state.Commands
+}
+
+func (h exportHandler) handle(ctx *gin.Context) {
+	format := ctx.DefaultQuery("format", yamlFormat)
+	if format != yamlFormat && format != jsonFormat {
+		panic(apierror.New(
+			http.StatusBadRequest,
+			i18n.M(ctx.Request.Context(), i18n.K.ApiStateInvalidFormat).V("format", format),
+		))
+	}
+
+	includeCertificateKeys := ctx.Query("includeCertificateKeys") == "true"
+	document, err := h.commands.Export(ctx.Request.Context(), includeCertificateKeys)
+	if err != nil {
+		panic(err)
+	}
+
+	contents, err := json.MarshalIndent(toDocumentDTO(document), "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	contentType := "application/json"
+	if format == yamlFormat {
+		contentType = "application/yaml"
+		if contents, err = yaml.JSONToYAML(contents); err != nil {
+			panic(err)
+		}
+	}
+
+	ctx.Header(
+		"Content-Disposition",
+		fmt.Sprintf("attachment; filename=nginx-ignition.%s", format),
+	)
+	ctx.Data(http.StatusOK, contentType, contents)
+}
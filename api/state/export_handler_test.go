@@ -0,0 +1,125 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/state"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_exportHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns the document as YAML by default", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := state.NewMockedCommands(controller)
+			commands.EXPECT().
+				Export(gomock.Any(), false).
+				Return(newDocument(), nil)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", "/api/state/export", nil)
+
+			handler := exportHandler{
+				commands: commands,
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, "application/yaml", recorder.Header().Get("Content-Type"))
+			assert.Equal(
+				t,
+				"attachment; filename=nginx-ignition.yaml",
+				recorder.Header().Get("Content-Disposition"),
+			)
+			assert.Contains(t, recorder.Body.String(), "version: 1")
+			assert.Contains(t, recorder.Body.String(), "runtimeUser: nginx")
+		})
+
+		t.Run("returns the document as JSON with the certificate keys", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := state.NewMockedCommands(controller)
+			commands.EXPECT().
+				Export(gomock.Any(), true).
+				Return(newDocument(), nil)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"GET",
+				"/api/state/export?format=json&includeCertificateKeys=true",
+				nil,
+			)
+
+			handler := exportHandler{
+				commands: commands,
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response documentDTO
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, state.CurrentVersion, response.Version)
+			assert.Equal(t, "private", response.Certificates[0].PrivateKey)
+		})
+
+		t.Run("panics with bad request on unknown formats", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", "/api/state/export?format=xml", nil)
+
+			handler := exportHandler{
+				commands: state.NewMockedCommands(controller),
+			}
+
+			defer func() {
+				apiErr, ok := recover().(*apierror.APIError)
+				require.True(t, ok)
+				assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+			}()
+			handler.handle(ginContext)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			expectedErr := errors.New("command error")
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := state.NewMockedCommands(controller)
+			commands.EXPECT().
+				Export(gomock.Any(), gomock.Any()).
+				Return(nil, expectedErr)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest("GET", "/api/state/export", nil)
+
+			handler := exportHandler{
+				commands: commands,
+			}
+			assert.PanicsWithValue(t, expectedErr, func() {
+				handler.handle(ginContext)
+			})
+		})
+	})
+}
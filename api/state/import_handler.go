@@ -0,0 +1,59 @@
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-yaml"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/state"
+)
+
+type importHandler struct {
+	commands state.Commands
+}
+
+func (h importHandler) handle(ctx *gin.Context) {
+	document, err := h.readDocument(ctx)
+	if err != nil {
+		panic(apierror.New(
+			http.StatusBadRequest,
+			i18n.M(ctx.Request.Context(), i18n.K.ApiStateInvalidDocument).V("details", err.Error()),
+		))
+	}
+
+	dryRun := ctx.Query("dryRun") == "true"
+	changes, err := h.commands.Import(ctx.Request.Context(), toDocument(document), dryRun)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.JSON(http.StatusOK, toImportResponseDTO(changes, dryRun))
+}
+
+func (h importHandler) readDocument(ctx *gin.Context) (*documentDTO, error) {
+	contents, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err = yaml.YAMLToJSON(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.DisallowUnknownFields()
+
+	var document documentDTO
+	if err = decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	return &document, nil
+}
@@ -0,0 +1,153 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/apierror"
+	"dillmann.com.br/nginx-ignition/core/state"
+)
+
+func Test_importHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns the plan of a YAML document on dry-run", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			hostID := uuid.New()
+			commands := state.NewMockedCommands(controller)
+			commands.EXPECT().
+				Import(gomock.Any(), gomock.Any(), true).
+				DoAndReturn(func(_ any, document *state.Document, _ bool) ([]state.Change, error) {
+					assert.Equal(t, 1, document.Version)
+					require.Len(t, document.Hosts, 1)
+					assert.Equal(t, hostID, document.Hosts[0].ID)
+					assert.Equal(t, []string{"example.com"}, document.Hosts[0].DomainNames)
+
+					return []state.Change{
+						{
+							EntityID:   &hostID,
+							EntityType: state.HostEntityType,
+							Action:     state.CreateAction,
+							Name:       "example.com",
+						},
+					}, nil
+				})
+
+			body := "version: 1\n" +
+				"hosts:\n" +
+				"  - id: " + hostID.String() + "\n" +
+				"    enabled: true\n" +
+				"    domainNames: [example.com]\n"
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"POST",
+				"/api/state/import?dryRun=true",
+				strings.NewReader(body),
+			)
+
+			handler := importHandler{
+				commands: commands,
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response importResponseDTO
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.True(t, response.DryRun)
+			require.Len(t, response.Changes, 1)
+			assert.Equal(t, state.CreateAction, response.Changes[0].Action)
+		})
+
+		t.Run("applies a JSON document", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := state.NewMockedCommands(controller)
+			commands.EXPECT().
+				Import(gomock.Any(), gomock.Any(), false).
+				Return([]state.Change{}, nil)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"POST",
+				"/api/state/import",
+				strings.NewReader(`{"version": 1, "streams": []}`),
+			)
+
+			handler := importHandler{
+				commands: commands,
+			}
+			handler.handle(ginContext)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response importResponseDTO
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.False(t, response.DryRun)
+			assert.Empty(t, response.Changes)
+		})
+
+		t.Run("panics with bad request on unknown fields", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"POST",
+				"/api/state/import",
+				strings.NewReader("version: 1\nunknown: true\n"),
+			)
+
+			handler := importHandler{
+				commands: state.NewMockedCommands(controller),
+			}
+
+			defer func() {
+				apiErr, ok := recover().(*apierror.APIError)
+				require.True(t, ok)
+				assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+			}()
+			handler.handle(ginContext)
+		})
+
+		t.Run("panics when command returns error", func(t *testing.T) {
+			expectedErr := errors.New("command error")
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			commands := state.NewMockedCommands(controller)
+			commands.EXPECT().
+				Import(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, expectedErr)
+
+			recorder := httptest.NewRecorder()
+			ginContext, _ := gin.CreateTestContext(recorder)
+			ginContext.Request = httptest.NewRequest(
+				"POST",
+				"/api/state/import",
+				strings.NewReader("version: 1"),
+			)
+
+			handler := importHandler{
+				commands: commands,
+			}
+			assert.PanicsWithValue(t, expectedErr, func() {
+				handler.handle(ginContext)
+			})
+		})
+	})
+}
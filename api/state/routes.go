@@ -0,0 +1,55 @@
+package state
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/state"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+const (
+	exportPath = "/api/state/export"
+	importPath = "/api/state/import"
+)
+
+func Install(
+	router *gin.Engine,
+	authorizer *authorization.ABAC,
+	commands state.Commands,
+) {
+	exportGroup := authorizer.ConfigureGroup(
+		router,
+		exportPath,
+		func(permissions user.Permissions) user.AccessLevel { return permissions.ExportData },
+	)
+	exportGroup.GET("", exportHandler{commands}.handle)
+
+	importGroup := authorizer.ConfigureGroup(router, importPath, importAccessLevel)
+	importGroup.POST("", importHandler{commands}.handle)
+}
+
+func importAccessLevel(permissions user.Permissions) user.AccessLevel {
+	levels := []user.AccessLevel{
+		permissions.Settings,
+		permissions.Integrations,
+		permissions.VPNs,
+		permissions.AccessLists,
+		permissions.Caches,
+		permissions.Upstreams,
+		permissions.TLSProfiles,
+		permissions.RateLimits,
+		permissions.SecurityHeaders,
+		permissions.Certificates,
+		permissions.Hosts,
+		permissions.Streams,
+	}
+
+	for _, level := range levels {
+		if level != user.ReadWriteAccessLevel {
+			return user.NoAccessAccessLevel
+		}
+	}
+
+	return user.ReadWriteAccessLevel
+}
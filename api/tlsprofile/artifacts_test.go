@@ -0,0 +1,51 @@
+package tlsprofile
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+)
+
+func newTLSProfileRequestDTO() tlsProfileRequestDTO {
+	return tlsProfileRequestDTO{
+		Name:   "Intermediate",
+		Preset: tlsprofile.IntermediatePreset,
+		HSTS: hstsDTO{
+			Enabled:           true,
+			MaxAgeSeconds:     31536000,
+			IncludeSubdomains: true,
+		},
+		Session: sessionDTO{
+			CacheEnabled:   true,
+			CacheSizeMB:    10,
+			TimeoutMinutes: 60,
+		},
+		OCSPStapling: true,
+	}
+}
+
+func newTLSProfile() *tlsprofile.TLSProfile {
+	return &tlsprofile.TLSProfile{
+		ID:     uuid.New(),
+		Name:   "Intermediate",
+		Preset: tlsprofile.IntermediatePreset,
+		HSTS: tlsprofile.HSTS{
+			Enabled:           true,
+			MaxAgeSeconds:     31536000,
+			IncludeSubdomains: true,
+		},
+		Session: tlsprofile.Session{
+			CacheEnabled:   true,
+			CacheSizeMB:    10,
+			TimeoutMinutes: 60,
+		},
+		OCSPStapling: true,
+	}
+}
+
+func newTLSProfilePage() *pagination.Page[tlsprofile.TLSProfile] {
+	return pagination.Of([]tlsprofile.TLSProfile{
+		*newTLSProfile(),
+	})
+}
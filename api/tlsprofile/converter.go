@@ -0,0 +1,67 @@
+package tlsprofile
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+)
+
+func toDomain(id uuid.UUID, dto *tlsProfileRequestDTO) *tlsprofile.TLSProfile {
+	return &tlsprofile.TLSProfile{
+		ID:                  id,
+		Name:                dto.Name,
+		Preset:              dto.Preset,
+		Protocols:           dto.Protocols,
+		Ciphers:             dto.Ciphers,
+		PreferServerCiphers: dto.PreferServerCiphers,
+		OCSPStapling:        dto.OCSPStapling,
+		HSTS: tlsprofile.HSTS{
+			Enabled:           dto.HSTS.Enabled,
+			MaxAgeSeconds:     dto.HSTS.MaxAgeSeconds,
+			IncludeSubdomains: dto.HSTS.IncludeSubdomains,
+			Preload:           dto.HSTS.Preload,
+		},
+		Session: tlsprofile.Session{
+			CacheEnabled:   dto.Session.CacheEnabled,
+			CacheSizeMB:    dto.Session.CacheSizeMB,
+			TimeoutMinutes: dto.Session.TimeoutMinutes,
+			TicketsEnabled: dto.Session.TicketsEnabled,
+		},
+		ClientVerification: tlsprofile.ClientVerification{
+			Enabled:                dto.ClientVerification.Enabled,
+			Mode:                   dto.ClientVerification.Mode,
+			Depth:                  dto.ClientVerification.Depth,
+			CertificateAuthorities: dto.ClientVerification.CertificateAuthorities,
+		},
+	}
+}
+
+func toResponseDTO(domain *tlsprofile.TLSProfile) tlsProfileResponseDTO {
+	return tlsProfileResponseDTO{
+		ID:                  domain.ID,
+		Name:                domain.Name,
+		Preset:              domain.Preset,
+		Protocols:           domain.Protocols,
+		Ciphers:             domain.Ciphers,
+		PreferServerCiphers: domain.PreferServerCiphers,
+		OCSPStapling:        domain.OCSPStapling,
+		HSTS: hstsDTO{
+			Enabled:           domain.HSTS.Enabled,
+			MaxAgeSeconds:     domain.HSTS.MaxAgeSeconds,
+			IncludeSubdomains: domain.HSTS.IncludeSubdomains,
+			Preload:           domain.HSTS.Preload,
+		},
+		Session: sessionDTO{
+			CacheEnabled:   domain.Session.CacheEnabled,
+			CacheSizeMB:    domain.Session.CacheSizeMB,
+			TimeoutMinutes: domain.Session.TimeoutMinutes,
+			TicketsEnabled: domain.Session.TicketsEnabled,
+		},
+		ClientVerification: clientVerificationDTO{
+			Enabled:                domain.ClientVerification.Enabled,
+			Mode:                   domain.ClientVerification.Mode,
+			Depth:                  domain.ClientVerification.Depth,
+			CertificateAuthorities: domain.ClientVerification.CertificateAuthorities,
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package tlsprofile
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toDomain(t *testing.T) {
+	t.Run("converts DTO to domain object", func(t *testing.T) {
+		id := uuid.New()
+		payload := newTLSProfileRequestDTO()
+		result := toDomain(id, &payload)
+
+		assert.NotNil(t, result)
+		assert.Equal(t, id, result.ID)
+		assert.Equal(t, payload.Name, result.Name)
+		assert.Equal(t, payload.Preset, result.Preset)
+		assert.Equal(t, payload.HSTS.MaxAgeSeconds, result.HSTS.MaxAgeSeconds)
+		assert.Equal(t, payload.Session.CacheSizeMB, result.Session.CacheSizeMB)
+		assert.Equal(t, payload.OCSPStapling, result.OCSPStapling)
+	})
+}
+
+func Test_toResponseDTO(t *testing.T) {
+	t.Run("converts domain object to response DTO", func(t *testing.T) {
+		subject := newTLSProfile()
+		result := toResponseDTO(subject)
+
+		assert.Equal(t, subject.ID, result.ID)
+		assert.Equal(t, subject.Name, result.Name)
+		assert.Equal(t, subject.Preset, result.Preset)
+		assert.Equal(t, subject.HSTS.IncludeSubdomains, result.HSTS.IncludeSubdomains)
+		assert.Equal(t, subject.Session.TimeoutMinutes, result.Session.TimeoutMinutes)
+	})
+}
@@ -0,0 +1,53 @@
+package tlsprofile
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+)
+
+type tlsProfileRequestDTO struct {
+	Ciphers             *string               `json:"ciphers"`
+	Name                string                `json:"name"`
+	Preset              tlsprofile.Preset     `json:"preset"`
+	Protocols           []tlsprofile.Protocol `json:"protocols"`
+	ClientVerification  clientVerificationDTO `json:"clientVerification"`
+	HSTS                hstsDTO               `json:"hsts"`
+	Session             sessionDTO            `json:"session"`
+	PreferServerCiphers bool                  `json:"preferServerCiphers"`
+	OCSPStapling        bool                  `json:"ocspStapling"`
+}
+
+type tlsProfileResponseDTO struct {
+	Ciphers             *string               `json:"ciphers"`
+	Name                string                `json:"name"`
+	Preset              tlsprofile.Preset     `json:"preset"`
+	Protocols           []tlsprofile.Protocol `json:"protocols"`
+	ClientVerification  clientVerificationDTO `json:"clientVerification"`
+	HSTS                hstsDTO               `json:"hsts"`
+	Session             sessionDTO            `json:"session"`
+	ID                  uuid.UUID             `json:"id"`
+	PreferServerCiphers bool                  `json:"preferServerCiphers"`
+	OCSPStapling        bool                  `json:"ocspStapling"`
+}
+
+type hstsDTO struct {
+	MaxAgeSeconds     int  `json:"maxAgeSeconds"`
+	Enabled           bool `json:"enabled"`
+	IncludeSubdomains bool `json:"includeSubdomains"`
+	Preload           bool `json:"preload"`
+}
+
+type sessionDTO struct {
+	CacheSizeMB    int  `json:"cacheSizeMb"`
+	TimeoutMinutes int  `json:"timeoutMinutes"`
+	CacheEnabled   bool `json:"cacheEnabled"`
+	TicketsEnabled bool `json:"ticketsEnabled"`
+}
+
+type clientVerificationDTO struct {
+	CertificateAuthorities *string                           `json:"certificateAuthorities"`
+	Mode                   tlsprofile.ClientVerificationMode `json:"mode"`
+	Depth                  int                               `json:"depth"`
+	Enabled                bool                              `json:"enabled"`
+}
@@ -0,0 +1,25 @@
+package tlsprofile
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func Install(router *gin.Engine, commands tlsprofile.Commands, authorizer *authorization.ABAC) {
+	basePath := authorizer.ConfigureGroup(
+		router,
+		"/api/tls-profiles",
+		func(permissions user.Permissions) user.AccessLevel { return permissions.TLSProfiles },
+	)
+
+	basePath.GET("", listHandler{commands}.handle)
+	basePath.POST("", createHandler{commands}.handle)
+
+	byIDPath := basePath.Group("/:id")
+	byIDPath.GET("", getHandler{commands}.handle)
+	byIDPath.PUT("", updateHandler{commands}.handle)
+	byIDPath.DELETE("", deleteHandler{commands}.handle)
+}
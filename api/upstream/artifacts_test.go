@@ -0,0 +1,71 @@
+package upstream
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+func newUpstreamRequestDTO() upstreamRequestDTO {
+	return upstreamRequestDTO{
+		Name:     "Backend Pool",
+		Protocol: upstream.HTTPProtocol,
+		Method:   upstream.LeastConnectionsBalancingMethod,
+		Keepalive: &keepaliveDTO{
+			Connections:    16,
+			TimeoutSeconds: 60,
+		},
+		Servers: []serverDTO{
+			{
+				Address: "10.0.0.1",
+				Port:    8080,
+				Weight:  new(2),
+				CircuitBreaker: &circuitBreakerDTO{
+					MaxFailures: 3,
+					OpenSeconds: 30,
+				},
+			},
+			{
+				Address: "10.0.0.2",
+				Port:    8080,
+				Backup:  true,
+			},
+		},
+	}
+}
+
+func newUpstream() *upstream.Upstream {
+	return &upstream.Upstream{
+		ID:       uuid.New(),
+		Name:     "Backend Pool",
+		Protocol: upstream.HTTPProtocol,
+		Method:   upstream.LeastConnectionsBalancingMethod,
+		Keepalive: &upstream.Keepalive{
+			Connections:    16,
+			TimeoutSeconds: 60,
+		},
+		Servers: []upstream.Server{
+			{
+				Address: "10.0.0.1",
+				Port:    8080,
+				Weight:  new(2),
+				CircuitBreaker: &upstream.CircuitBreaker{
+					MaxFailures: 3,
+					OpenSeconds: 30,
+				},
+			},
+			{
+				Address: "10.0.0.2",
+				Port:    8080,
+				Backup:  true,
+			},
+		},
+	}
+}
+
+func newUpstreamPage() *pagination.Page[upstream.Upstream] {
+	return pagination.Of([]upstream.Upstream{
+		*newUpstream(),
+	})
+}
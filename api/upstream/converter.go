@@ -0,0 +1,81 @@
+package upstream
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+func toDomain(id uuid.UUID, dto *upstreamRequestDTO) *upstream.Upstream {
+	servers := make([]upstream.Server, len(dto.Servers))
+	for index, server := range dto.Servers {
+		servers[index] = upstream.Server{
+			Address: server.Address,
+			Port:    server.Port,
+			Weight:  server.Weight,
+			Backup:  server.Backup,
+		}
+
+		if server.CircuitBreaker != nil {
+			servers[index].CircuitBreaker = &upstream.CircuitBreaker{
+				MaxFailures: server.CircuitBreaker.MaxFailures,
+				OpenSeconds: server.CircuitBreaker.OpenSeconds,
+			}
+		}
+	}
+
+	var keepalive *upstream.Keepalive
+	if dto.Keepalive != nil {
+		keepalive = &upstream.Keepalive{
+			Connections:    dto.Keepalive.Connections,
+			TimeoutSeconds: dto.Keepalive.TimeoutSeconds,
+		}
+	}
+
+	return &upstream.Upstream{
+		ID:        id,
+		Name:      dto.Name,
+		Protocol:  dto.Protocol,
+		Method:    dto.Method,
+		HashKey:   dto.HashKey,
+		Keepalive: keepalive,
+		Servers:   servers,
+	}
+}
+
+func toResponseDTO(domain *upstream.Upstream) upstreamResponseDTO {
+	servers := make([]serverDTO, len(domain.Servers))
+	for index, server := range domain.Servers {
+		servers[index] = serverDTO{
+			Address: server.Address,
+			Port:    server.Port,
+			Weight:  server.Weight,
+			Backup:  server.Backup,
+		}
+
+		if server.CircuitBreaker != nil {
+			servers[index].CircuitBreaker = &circuitBreakerDTO{
+				MaxFailures: server.CircuitBreaker.MaxFailures,
+				OpenSeconds: server.CircuitBreaker.OpenSeconds,
+			}
+		}
+	}
+
+	var keepalive *keepaliveDTO
+	if domain.Keepalive != nil {
+		keepalive = &keepaliveDTO{
+			Connections:    domain.Keepalive.Connections,
+			TimeoutSeconds: domain.Keepalive.TimeoutSeconds,
+		}
+	}
+
+	return upstreamResponseDTO{
+		ID:        domain.ID,
+		Name:      domain.Name,
+		Protocol:  domain.Protocol,
+		Method:    domain.Method,
+		HashKey:   domain.HashKey,
+		Keepalive: keepalive,
+		Servers:   servers,
+	}
+}
@@ -0,0 +1,59 @@
+package upstream
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toDomain(t *testing.T) {
+	t.Run("converts DTO to domain object", func(t *testing.T) {
+		id := uuid.New()
+		payload := newUpstreamRequestDTO()
+		result := toDomain(id, &payload)
+
+		assert.NotNil(t, result)
+		assert.Equal(t, id, result.ID)
+		assert.Equal(t, payload.Name, result.Name)
+		assert.Equal(t, payload.Protocol, result.Protocol)
+		assert.Equal(t, payload.Method, result.Method)
+		assert.Equal(t, payload.Keepalive.Connections, result.Keepalive.Connections)
+		assert.Len(t, result.Servers, 2)
+		assert.Equal(t, payload.Servers[0].Address, result.Servers[0].Address)
+		assert.Equal(
+			t,
+			payload.Servers[0].CircuitBreaker.MaxFailures,
+			result.Servers[0].CircuitBreaker.MaxFailures,
+		)
+		assert.Nil(t, result.Servers[1].CircuitBreaker)
+		assert.True(t, result.Servers[1].Backup)
+	})
+
+	t.Run("keeps keepalive empty when not provided", func(t *testing.T) {
+		payload := newUpstreamRequestDTO()
+		payload.Keepalive = nil
+		result := toDomain(uuid.New(), &payload)
+
+		assert.Nil(t, result.Keepalive)
+	})
+}
+
+func Test_toResponseDTO(t *testing.T) {
+	t.Run("converts domain object to response DTO", func(t *testing.T) {
+		subject := newUpstream()
+		result := toResponseDTO(subject)
+
+		assert.Equal(t, subject.ID, result.ID)
+		assert.Equal(t, subject.Name, result.Name)
+		assert.Equal(t, subject.Method, result.Method)
+		assert.Equal(t, subject.Keepalive.TimeoutSeconds, result.Keepalive.TimeoutSeconds)
+		assert.Len(t, result.Servers, 2)
+		assert.Equal(t, subject.Servers[0].Weight, result.Servers[0].Weight)
+		assert.Equal(
+			t,
+			subject.Servers[0].CircuitBreaker.OpenSeconds,
+			result.Servers[0].CircuitBreaker.OpenSeconds,
+		)
+	})
+}
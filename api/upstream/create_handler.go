@@ -0,0 +1,30 @@
+package upstream
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/api/common/converter"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+type createHandler struct {
+	commands upstream.Commands
+}
+
+func (h createHandler) handle(ctx *gin.Context) {
+	var dto upstreamRequestDTO
+	if err := ctx.BindJSON(&dto); err != nil {
+		panic(err)
+	}
+
+	id := uuid.New()
+	domain := converter.Wrap2(ctx.Request.Context(), toDomain, id, &dto)
+	if err := h.commands.Save(ctx.Request.Context(), domain); err != nil {
+		panic(err)
+	}
+
+	ctx.JSON(http.StatusCreated, toResponseDTO(domain))
+}
@@ -0,0 +1,28 @@
+package upstream
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+type deleteHandler struct {
+	commands upstream.Commands
+}
+
+func (h deleteHandler) handle(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	if err := h.commands.Delete(ctx.Request.Context(), id); err != nil {
+		panic(err)
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
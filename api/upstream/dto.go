@@ -0,0 +1,44 @@
+package upstream
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+type upstreamRequestDTO struct {
+	HashKey   *string                  `json:"hashKey"`
+	Keepalive *keepaliveDTO            `json:"keepalive"`
+	Name      string                   `json:"name"`
+	Method    upstream.BalancingMethod `json:"method"`
+	Protocol  upstream.Protocol        `json:"protocol"`
+	Servers   []serverDTO              `json:"servers"`
+}
+
+type upstreamResponseDTO struct {
+	HashKey   *string                  `json:"hashKey"`
+	Keepalive *keepaliveDTO            `json:"keepalive"`
+	Name      string                   `json:"name"`
+	Method    upstream.BalancingMethod `json:"method"`
+	Protocol  upstream.Protocol        `json:"protocol"`
+	Servers   []serverDTO              `json:"servers"`
+	ID        uuid.UUID                `json:"id"`
+}
+
+type serverDTO struct {
+	Weight         *int               `json:"weight"`
+	CircuitBreaker *circuitBreakerDTO `json:"circuitBreaker"`
+	Address        string             `json:"address"`
+	Port           int                `json:"port"`
+	Backup         bool               `json:"backup"`
+}
+
+type circuitBreakerDTO struct {
+	MaxFailures int `json:"maxFailures"`
+	OpenSeconds int `json:"openSeconds"`
+}
+
+type keepaliveDTO struct {
+	Connections    int `json:"connections"`
+	TimeoutSeconds int `json:"timeoutSeconds"`
+}
@@ -0,0 +1,29 @@
+package upstream
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+type listHandler struct {
+	commands upstream.Commands
+}
+
+func (h listHandler) handle(ctx *gin.Context) {
+	pageSize, pageNumber, searchTerms, err := pagination.ExtractPaginationParameters(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	page, err := h.commands.List(ctx, pageSize, pageNumber, searchTerms)
+	if err != nil {
+		panic(err)
+	}
+
+	response := pagination.Convert(page, toResponseDTO)
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,38 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/api/common/converter"
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+)
+
+type apiTokenCreateHandler struct {
+	commands apitoken.Commands
+}
+
+func (h apiTokenCreateHandler) handle(ctx *gin.Context) {
+	payload := &apiTokenRequestDTO{}
+	if err := ctx.BindJSON(payload); err != nil {
+		panic(err)
+	}
+
+	domainModel := converter.Wrap(ctx.Request.Context(), toAPITokenDomain, payload)
+	domainModel.UserID = authorization.CurrentSubject(ctx).User.ID
+
+	secret, err := h.commands.Create(ctx.Request.Context(), domainModel)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.JSON(
+		http.StatusCreated,
+		apiTokenCreateResponseDTO{
+			ID:    domainModel.ID,
+			Token: secret,
+		},
+	)
+}
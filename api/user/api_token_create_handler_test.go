@@ -0,0 +1,114 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_apiTokenCreateHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 201 Created with the token secret", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			userID := uuid.New()
+			tokenID := uuid.New()
+			commands := apitoken.NewMockedCommands(controller)
+			commands.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, token *apitoken.APIToken) (string, error) {
+					assert.Equal(t, userID, token.UserID)
+					assert.Equal(t, "Automation", token.Name)
+					assert.Equal(t, user.ReadOnlyAccessLevel, token.Permissions.Hosts)
+					token.ID = tokenID
+					return "nig_secret", nil
+				})
+
+			handler := apiTokenCreateHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.Use(func(ginContext *gin.Context) {
+				ginContext.Set("ABAC:Subject", &authorization.Subject{User: &user.User{ID: userID}})
+				ginContext.Next()
+			})
+			engine.POST("/api/users/current/api-tokens", handler.handle)
+
+			body, _ := json.Marshal(newAPITokenRequest())
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"POST",
+				"/api/users/current/api-tokens",
+				bytes.NewBuffer(body),
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusCreated, recorder.Code)
+			var response apiTokenCreateResponseDTO
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Equal(t, tokenID, response.ID)
+			assert.Equal(t, "nig_secret", response.Token)
+		})
+
+		t.Run("panics on command error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			expectedErr := assert.AnError
+			commands := apitoken.NewMockedCommands(controller)
+			commands.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return("", expectedErr)
+
+			handler := apiTokenCreateHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.Use(func(ginContext *gin.Context) {
+				ginContext.Set(
+					"ABAC:Subject",
+					&authorization.Subject{User: &user.User{ID: uuid.New()}},
+				)
+				ginContext.Next()
+			})
+			engine.POST("/api/users/current/api-tokens", func(ginContext *gin.Context) {
+				defer func() {
+					if r := recover(); r != nil {
+						assert.Equal(t, expectedErr, r)
+						panic(r)
+					}
+				}()
+				handler.handle(ginContext)
+			})
+
+			body, _ := json.Marshal(newAPITokenRequest())
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"POST",
+				"/api/users/current/api-tokens",
+				bytes.NewBuffer(body),
+			)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+)
+
+type apiTokenDeleteHandler struct {
+	commands apitoken.Commands
+}
+
+func (h apiTokenDeleteHandler) handle(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	currentUserID := authorization.CurrentSubject(ctx).User.ID
+	if err = h.commands.Delete(ctx.Request.Context(), currentUserID, id); err != nil {
+		panic(err)
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
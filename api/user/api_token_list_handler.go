@@ -0,0 +1,30 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+)
+
+type apiTokenListHandler struct {
+	commands apitoken.Commands
+}
+
+func (h apiTokenListHandler) handle(ctx *gin.Context) {
+	currentUserID := authorization.CurrentSubject(ctx).User.ID
+
+	tokens, err := h.commands.ListByUser(ctx.Request.Context(), currentUserID)
+	if err != nil {
+		panic(err)
+	}
+
+	output := make([]apiTokenResponseDTO, len(tokens))
+	for index := range tokens {
+		output[index] = toAPITokenDTO(&tokens[index])
+	}
+
+	ctx.JSON(http.StatusOK, output)
+}
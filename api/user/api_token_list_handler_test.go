@@ -0,0 +1,99 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_apiTokenListHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with the tokens of the current user", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			userID := uuid.New()
+			token := newAPIToken(userID)
+			commands := apitoken.NewMockedCommands(controller)
+			commands.EXPECT().
+				ListByUser(gomock.Any(), userID).
+				Return([]apitoken.APIToken{*token}, nil)
+
+			handler := apiTokenListHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.Use(func(ginContext *gin.Context) {
+				ginContext.Set("ABAC:Subject", &authorization.Subject{User: &user.User{ID: userID}})
+				ginContext.Next()
+			})
+			engine.GET("/api/users/current/api-tokens", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/users/current/api-tokens", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response []apiTokenResponseDTO
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Len(t, response, 1)
+			assert.Equal(t, token.ID, response[0].ID)
+			assert.Equal(t, token.TokenPrefix, response[0].TokenPrefix)
+			assert.Equal(t, string(user.ReadOnlyAccessLevel), response[0].Permissions.Hosts)
+			assert.NotContains(t, recorder.Body.String(), "tokenHash")
+		})
+
+		t.Run("panics on command error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			expectedErr := assert.AnError
+			commands := apitoken.NewMockedCommands(controller)
+			commands.EXPECT().
+				ListByUser(gomock.Any(), gomock.Any()).
+				Return(nil, expectedErr)
+
+			handler := apiTokenListHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.Use(func(ginContext *gin.Context) {
+				ginContext.Set(
+					"ABAC:Subject",
+					&authorization.Subject{User: &user.User{ID: uuid.New()}},
+				)
+				ginContext.Next()
+			})
+			engine.GET("/api/users/current/api-tokens", func(ginContext *gin.Context) {
+				defer func() {
+					if r := recover(); r != nil {
+						assert.Equal(t, expectedErr, r)
+						panic(r)
+					}
+				}()
+				handler.handle(ginContext)
+			})
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/users/current/api-tokens", nil)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
@@ -1,9 +1,23 @@
 package user
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
 	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
@@ -14,18 +28,22 @@ func newUser() *user.User {
 		Username: "testuser",
 		Enabled:  true,
 		Permissions: user.Permissions{
-			Hosts:        user.ReadWriteAccessLevel,
-			Streams:      user.ReadWriteAccessLevel,
-			Certificates: user.ReadWriteAccessLevel,
-			Logs:         user.ReadWriteAccessLevel,
-			Integrations: user.ReadWriteAccessLevel,
-			AccessLists:  user.ReadWriteAccessLevel,
-			Settings:     user.ReadWriteAccessLevel,
-			Users:        user.ReadWriteAccessLevel,
-			NginxServer:  user.ReadWriteAccessLevel,
-			ExportData:   user.ReadWriteAccessLevel,
-			VPNs:         user.ReadWriteAccessLevel,
-			Caches:       user.ReadWriteAccessLevel,
+			Hosts:           user.ReadWriteAccessLevel,
+			Streams:         user.ReadWriteAccessLevel,
+			Certificates:    user.ReadWriteAccessLevel,
+			Logs:            user.ReadWriteAccessLevel,
+			Integrations:    user.ReadWriteAccessLevel,
+			AccessLists:     user.ReadWriteAccessLevel,
+			Settings:        user.ReadWriteAccessLevel,
+			Users:           user.ReadWriteAccessLevel,
+			NginxServer:     user.ReadWriteAccessLevel,
+			ExportData:      user.ReadWriteAccessLevel,
+			VPNs:            user.ReadWriteAccessLevel,
+			Caches:          user.ReadWriteAccessLevel,
+			Upstreams:       user.ReadWriteAccessLevel,
+			TLSProfiles:     user.ReadWriteAccessLevel,
+			RateLimits:      user.ReadWriteAccessLevel,
+			SecurityHeaders: user.ReadWriteAccessLevel,
 		},
 	}
 }
@@ -38,18 +56,22 @@ func newUserRequest() userRequestDTO {
 		Enabled:    new(true),
 		RemoveTOTP: new(false),
 		Permissions: userPermissionsDTO{
-			Hosts:        string(user.ReadWriteAccessLevel),
-			Streams:      string(user.ReadWriteAccessLevel),
-			Certificates: string(user.ReadWriteAccessLevel),
-			Logs:         string(user.ReadWriteAccessLevel),
-			Integrations: string(user.ReadWriteAccessLevel),
-			AccessLists:  string(user.ReadWriteAccessLevel),
-			Settings:     string(user.ReadWriteAccessLevel),
-			Users:        string(user.ReadWriteAccessLevel),
-			NginxServer:  string(user.ReadWriteAccessLevel),
-			ExportData:   string(user.ReadWriteAccessLevel),
-			VPNs:         string(user.ReadWriteAccessLevel),
-			Caches:       string(user.ReadWriteAccessLevel),
+			Hosts:           string(user.ReadWriteAccessLevel),
+			Streams:         string(user.ReadWriteAccessLevel),
+			Certificates:    string(user.ReadWriteAccessLevel),
+			Logs:            string(user.ReadWriteAccessLevel),
+			Integrations:    string(user.ReadWriteAccessLevel),
+			AccessLists:     string(user.ReadWriteAccessLevel),
+			Settings:        string(user.ReadWriteAccessLevel),
+			Users:           string(user.ReadWriteAccessLevel),
+			NginxServer:     string(user.ReadWriteAccessLevel),
+			ExportData:      string(user.ReadWriteAccessLevel),
+			VPNs:            string(user.ReadWriteAccessLevel),
+			Caches:          string(user.ReadWriteAccessLevel),
+			Upstreams:       string(user.ReadWriteAccessLevel),
+			TLSProfiles:     string(user.ReadWriteAccessLevel),
+			RateLimits:      string(user.ReadWriteAccessLevel),
+			SecurityHeaders: string(user.ReadWriteAccessLevel),
 		},
 	}
 }
@@ -59,3 +81,146 @@ func newUserPage() *pagination.Page[user.User] {
 		*newUser(),
 	})
 }
+
+func newAPIToken(userID uuid.UUID) *apitoken.APIToken {
+	return &apitoken.APIToken{
+		ID:                     uuid.New(),
+		UserID:                 userID,
+		Name:                   "Automation",
+		TokenPrefix:            "nig_abcdefgh",
+		AllowedSourceAddresses: []string{"10.0.0.0/8"},
+		CreatedAt:              time.Now(),
+		Permissions: user.Permissions{
+			Hosts: user.ReadOnlyAccessLevel,
+		},
+	}
+}
+
+func newAPITokenRequest() apiTokenRequestDTO {
+	return apiTokenRequestDTO{
+		Name:                   new("Automation"),
+		AllowedSourceAddresses: []string{"10.0.0.0/8"},
+		Permissions: userPermissionsDTO{
+			Hosts: string(user.ReadOnlyAccessLevel),
+		},
+	}
+}
+
+func newSession(userID uuid.UUID) *session.Session {
+	return &session.Session{
+		ID:            uuid.New(),
+		UserID:        userID,
+		UserAgent:     "Mozilla/5.0",
+		SourceAddress: "192.168.0.10",
+		CreatedAt:     time.Now(),
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+}
+
+const (
+	oidcTestClientID = "nginx-ignition"
+	oidcTestKeyID    = "test-key"
+)
+
+type fakeOIDCProvider struct {
+	server       *httptest.Server
+	key          *rsa.PrivateKey
+	claims       map[string]any
+	nonce        string
+	codeVerifier string
+}
+
+func newFakeOIDCProvider(t *testing.T) *fakeOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider := &fakeOIDCProvider{
+		key: key,
+		claims: map[string]any{
+			"sub":                "external-subject",
+			"preferred_username": "johndoe",
+			"name":               "John Doe",
+			"email":              "johndoe@example.com",
+			"email_verified":     true,
+			"groups":             []string{"admins"},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", provider.handleDiscovery)
+	mux.HandleFunc("/keys", provider.handleKeys)
+	mux.HandleFunc("/token", provider.handleToken)
+
+	provider.server = httptest.NewServer(mux)
+	t.Cleanup(provider.server.Close)
+	return provider
+}
+
+func (p *fakeOIDCProvider) configuration() *configuration.Configuration {
+	return configuration.NewWithOverrides(map[string]string{
+		"nginx-ignition.security.oidc.enabled":       "true",
+		"nginx-ignition.security.oidc.issuer-url":    p.server.URL,
+		"nginx-ignition.security.oidc.client-id":     oidcTestClientID,
+		"nginx-ignition.security.oidc.client-secret": "secret",
+	})
+}
+
+func (p *fakeOIDCProvider) handleDiscovery(writer http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(writer).Encode(map[string]any{
+		"issuer":                                p.server.URL,
+		"authorization_endpoint":                p.server.URL + "/authorize",
+		"token_endpoint":                        p.server.URL + "/token",
+		"jwks_uri":                              p.server.URL + "/keys",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (p *fakeOIDCProvider) handleKeys(writer http.ResponseWriter, _ *http.Request) {
+	exponent := big.NewInt(int64(p.key.E)).Bytes()
+	_ = json.NewEncoder(writer).Encode(map[string]any{
+		"keys": []map[string]any{
+			{
+				"kty": "RSA",
+				"alg": "RS256",
+				"use": "sig",
+				"kid": oidcTestKeyID,
+				"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(exponent),
+			},
+		},
+	})
+}
+
+func (p *fakeOIDCProvider) handleToken(writer http.ResponseWriter, request *http.Request) {
+	_ = request.ParseForm()
+	p.codeVerifier = request.PostForm.Get("code_verifier")
+
+	claims := jwt.MapClaims{
+		"iss":   p.server.URL,
+		"aud":   oidcTestClientID,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": p.nonce,
+	}
+	for key, value := range p.claims {
+		claims[key] = value
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = oidcTestKeyID
+	idToken, err := token.SignedString(p.key)
+	if err != nil {
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(writer).Encode(map[string]any{
+		"access_token": "access-token",
+		"token_type":   "Bearer",
+		"expires_in":   60,
+		"id_token":     idToken,
+	})
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/google/uuid"
 
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
@@ -14,27 +16,13 @@ func toDomain(dto *userRequestDTO) *user.SaveRequest {
 	}
 
 	return &user.SaveRequest{
-		ID:         uuid.New(),
-		Enabled:    getBoolValue(dto.Enabled),
-		RemoveTOTP: getBoolValue(dto.RemoveTOTP),
-		Name:       getStringValue(dto.Name),
-		Username:   getStringValue(dto.Username),
-		Password:   dto.Password,
-		Permissions: user.Permissions{
-			Hosts:        user.AccessLevel(dto.Permissions.Hosts),
-			Streams:      user.AccessLevel(dto.Permissions.Streams),
-			Certificates: user.AccessLevel(dto.Permissions.Certificates),
-			Logs:         user.AccessLevel(dto.Permissions.Logs),
-			Integrations: user.AccessLevel(dto.Permissions.Integrations),
-			AccessLists:  user.AccessLevel(dto.Permissions.AccessLists),
-			Settings:     user.AccessLevel(dto.Permissions.Settings),
-			Users:        user.AccessLevel(dto.Permissions.Users),
-			NginxServer:  user.AccessLevel(dto.Permissions.NginxServer),
-			ExportData:   user.AccessLevel(dto.Permissions.ExportData),
-			VPNs:         user.AccessLevel(dto.Permissions.VPNs),
-			Caches:       user.AccessLevel(dto.Permissions.Caches),
-			TrafficStats: user.AccessLevel(dto.Permissions.TrafficStats),
-		},
+		ID:          uuid.New(),
+		Enabled:     getBoolValue(dto.Enabled),
+		RemoveTOTP:  getBoolValue(dto.RemoveTOTP),
+		Name:        getStringValue(dto.Name),
+		Username:    getStringValue(dto.Username),
+		Password:    dto.Password,
+		Permissions: toPermissionsDomain(dto.Permissions),
 	}
 }
 
@@ -56,21 +44,7 @@ func toDTO(domain *user.User) *userResponseDTO {
 		TOTPEnabled: totpEnabled,
 		Name:        domain.Name,
 		Username:    domain.Username,
-		Permissions: userPermissionsDTO{
-			Hosts:        string(domain.Permissions.Hosts),
-			Streams:      string(domain.Permissions.Streams),
-			Certificates: string(domain.Permissions.Certificates),
-			Logs:         string(domain.Permissions.Logs),
-			Integrations: string(domain.Permissions.Integrations),
-			AccessLists:  string(domain.Permissions.AccessLists),
-			Settings:     string(domain.Permissions.Settings),
-			Users:        string(domain.Permissions.Users),
-			NginxServer:  string(domain.Permissions.NginxServer),
-			ExportData:   string(domain.Permissions.ExportData),
-			VPNs:         string(domain.Permissions.VPNs),
-			Caches:       string(domain.Permissions.Caches),
-			TrafficStats: string(domain.Permissions.TrafficStats),
-		},
+		Permissions: toPermissionsDTO(domain.Permissions),
 	}
 }
 
@@ -89,3 +63,91 @@ func getStringValue(value *string) string {
 
 	return *value
 }
+
+func toPermissionsDomain(dto userPermissionsDTO) user.Permissions {
+	return user.Permissions{
+		Hosts:           user.AccessLevel(dto.Hosts),
+		Streams:         user.AccessLevel(dto.Streams),
+		Certificates:    user.AccessLevel(dto.Certificates),
+		Logs:            user.AccessLevel(dto.Logs),
+		Integrations:    user.AccessLevel(dto.Integrations),
+		AccessLists:     user.AccessLevel(dto.AccessLists),
+		Settings:        user.AccessLevel(dto.Settings),
+		Users:           user.AccessLevel(dto.Users),
+		NginxServer:     user.AccessLevel(dto.NginxServer),
+		ExportData:      user.AccessLevel(dto.ExportData),
+		VPNs:            user.AccessLevel(dto.VPNs),
+		Caches:          user.AccessLevel(dto.Caches),
+		Upstreams:       user.AccessLevel(dto.Upstreams),
+		TLSProfiles:     user.AccessLevel(dto.TLSProfiles),
+		RateLimits:      user.AccessLevel(dto.RateLimits),
+		SecurityHeaders: user.AccessLevel(dto.SecurityHeaders),
+		TrafficStats:    user.AccessLevel(dto.TrafficStats),
+		Audit:           user.AccessLevel(dto.Audit),
+	}
+}
+
+func toPermissionsDTO(domain user.Permissions) userPermissionsDTO {
+	return userPermissionsDTO{
+		Hosts:           string(domain.Hosts),
+		Streams:         string(domain.Streams),
+		Certificates:    string(domain.Certificates),
+		Logs:            string(domain.Logs),
+		Integrations:    string(domain.Integrations),
+		AccessLists:     string(domain.AccessLists),
+		Settings:        string(domain.Settings),
+		Users:           string(domain.Users),
+		NginxServer:     string(domain.NginxServer),
+		ExportData:      string(domain.ExportData),
+		VPNs:            string(domain.VPNs),
+		Caches:          string(domain.Caches),
+		Upstreams:       string(domain.Upstreams),
+		TLSProfiles:     string(domain.TLSProfiles),
+		RateLimits:      string(domain.RateLimits),
+		SecurityHeaders: string(domain.SecurityHeaders),
+		TrafficStats:    string(domain.TrafficStats),
+		Audit:           string(domain.Audit),
+	}
+}
+
+func toAPITokenDomain(dto *apiTokenRequestDTO) *apitoken.APIToken {
+	if dto == nil {
+		return nil
+	}
+
+	return &apitoken.APIToken{
+		Name:                   getStringValue(dto.Name),
+		ExpiresAt:              dto.ExpiresAt,
+		AllowedSourceAddresses: dto.AllowedSourceAddresses,
+		Permissions:            toPermissionsDomain(dto.Permissions),
+	}
+}
+
+func toAPITokenDTO(domain *apitoken.APIToken) apiTokenResponseDTO {
+	allowedSourceAddresses := domain.AllowedSourceAddresses
+	if allowedSourceAddresses == nil {
+		allowedSourceAddresses = make([]string, 0)
+	}
+
+	return apiTokenResponseDTO{
+		ID:                     domain.ID,
+		Name:                   domain.Name,
+		TokenPrefix:            domain.TokenPrefix,
+		ExpiresAt:              domain.ExpiresAt,
+		LastUsedAt:             domain.LastUsedAt,
+		CreatedAt:              domain.CreatedAt,
+		AllowedSourceAddresses: allowedSourceAddresses,
+		Permissions:            toPermissionsDTO(domain.Permissions),
+	}
+}
+
+func toSessionDTO(domain *session.Session, currentSessionID *uuid.UUID) sessionResponseDTO {
+	return sessionResponseDTO{
+		ID:            domain.ID,
+		UserAgent:     domain.UserAgent,
+		SourceAddress: domain.SourceAddress,
+		CreatedAt:     domain.CreatedAt,
+		ExpiresAt:     domain.ExpiresAt,
+		Current:       currentSessionID != nil && *currentSessionID == domain.ID,
+	}
+}
@@ -1,6 +1,8 @@
 package user
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -14,6 +16,11 @@ type userLoginResponseDTO struct {
 	Token string `json:"token"`
 }
 
+type userOIDCStatusResponseDTO struct {
+	Enabled           bool `json:"enabled"`
+	LocalLoginEnabled bool `json:"localLoginEnabled"`
+}
+
 type userOnboardingStatusResponseDTO struct {
 	Finished bool `json:"finished"`
 }
@@ -42,19 +49,24 @@ type userResponseDTO struct {
 }
 
 type userPermissionsDTO struct {
-	Hosts        string `json:"hosts"`
-	Streams      string `json:"streams"`
-	Certificates string `json:"certificates"`
-	Logs         string `json:"logs"`
-	Integrations string `json:"integrations"`
-	AccessLists  string `json:"accessLists"`
-	Settings     string `json:"settings"`
-	Users        string `json:"users"`
-	NginxServer  string `json:"nginxServer"`
-	ExportData   string `json:"exportData"`
-	VPNs         string `json:"vpns"`
-	Caches       string `json:"caches"`
-	TrafficStats string `json:"trafficStats"`
+	Hosts           string `json:"hosts"`
+	Streams         string `json:"streams"`
+	Certificates    string `json:"certificates"`
+	Logs            string `json:"logs"`
+	Integrations    string `json:"integrations"`
+	AccessLists     string `json:"accessLists"`
+	Settings        string `json:"settings"`
+	Users           string `json:"users"`
+	NginxServer     string `json:"nginxServer"`
+	ExportData      string `json:"exportData"`
+	VPNs            string `json:"vpns"`
+	Caches          string `json:"caches"`
+	Upstreams       string `json:"upstreams"`
+	TLSProfiles     string `json:"tlsProfiles"`
+	RateLimits      string `json:"rateLimits"`
+	SecurityHeaders string `json:"securityHeaders"`
+	TrafficStats    string `json:"trafficStats"`
+	Audit           string `json:"audit"`
 }
 
 type totpStatusResponseDTO struct {
@@ -68,3 +80,40 @@ type totpEnableResponseDTO struct {
 type totpActivateRequestDTO struct {
 	Code *string `json:"code"`
 }
+
+type apiTokenRequestDTO struct {
+	Name                   *string            `json:"name"`
+	ExpiresAt              *time.Time         `json:"expiresAt"`
+	AllowedSourceAddresses []string           `json:"allowedSourceAddresses"`
+	Permissions            userPermissionsDTO `json:"permissions"`
+}
+
+type apiTokenResponseDTO struct {
+	ExpiresAt              *time.Time         `json:"expiresAt"`
+	LastUsedAt             *time.Time         `json:"lastUsedAt"`
+	CreatedAt              time.Time          `json:"createdAt"`
+	Permissions            userPermissionsDTO `json:"permissions"`
+	Name                   string             `json:"name"`
+	TokenPrefix            string             `json:"tokenPrefix"`
+	AllowedSourceAddresses []string           `json:"allowedSourceAddresses"`
+	ID                     uuid.UUID          `json:"id"`
+}
+
+type apiTokenCreateResponseDTO struct {
+	Token string    `json:"token"`
+	ID    uuid.UUID `json:"id"`
+}
+
+type lockoutResponseDTO struct {
+	LockedUntil *time.Time `json:"lockedUntil,omitempty"`
+	Locked      bool       `json:"locked"`
+}
+
+type sessionResponseDTO struct {
+	CreatedAt     time.Time `json:"createdAt"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+	UserAgent     string    `json:"userAgent"`
+	SourceAddress string    `json:"sourceAddress"`
+	ID            uuid.UUID `json:"id"`
+	Current       bool      `json:"current"`
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_lockoutDeleteHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 204 No Content on success", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			commands := user.NewMockedCommands(controller)
+			commands.EXPECT().
+				Unlock(gomock.Any(), id).
+				Return(nil)
+
+			handler := lockoutDeleteHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.DELETE("/api/users/:id/lockout", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("DELETE", "/api/users/"+id.String()+"/lockout", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+		})
+
+		t.Run("returns 404 Not Found on invalid ID", func(t *testing.T) {
+			handler := lockoutDeleteHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.DELETE("/api/users/:id/lockout", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("DELETE", "/api/users/invalid/lockout", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+	})
+}
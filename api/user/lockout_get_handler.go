@@ -0,0 +1,32 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+type lockoutGetHandler struct {
+	commands user.Commands
+}
+
+func (h lockoutGetHandler) handle(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	lockedUntil, err := h.commands.GetLockedUntil(ctx.Request.Context(), id)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.JSON(http.StatusOK, &lockoutResponseDTO{
+		Locked:      lockedUntil != nil,
+		LockedUntil: lockedUntil,
+	})
+}
@@ -0,0 +1,93 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_lockoutGetHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 200 OK with the lockout of a locked user", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			lockedUntil := time.Now().Add(time.Minute)
+			commands := user.NewMockedCommands(controller)
+			commands.EXPECT().
+				GetLockedUntil(gomock.Any(), id).
+				Return(&lockedUntil, nil)
+
+			handler := lockoutGetHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/users/:id/lockout", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/users/"+id.String()+"/lockout", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response lockoutResponseDTO
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.True(t, response.Locked)
+			assert.NotNil(t, response.LockedUntil)
+		})
+
+		t.Run("returns 200 OK when the user is not locked", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			id := uuid.New()
+			commands := user.NewMockedCommands(controller)
+			commands.EXPECT().
+				GetLockedUntil(gomock.Any(), id).
+				Return(nil, nil)
+
+			handler := lockoutGetHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.GET("/api/users/:id/lockout", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/users/"+id.String()+"/lockout", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response lockoutResponseDTO
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.False(t, response.Locked)
+			assert.Nil(t, response.LockedUntil)
+		})
+
+		t.Run("returns 404 Not Found on invalid ID", func(t *testing.T) {
+			handler := lockoutGetHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.GET("/api/users/:id/lockout", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/users/invalid/lockout", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+	})
+}
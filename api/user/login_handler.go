@@ -35,11 +35,20 @@ func (h loginHandler) handle(ctx *gin.Context) {
 		*requestPayload.Username,
 		*requestPayload.Password,
 		*totp,
+		ctx.ClientIP(),
 	)
 	if err != nil {
 		panic(err)
 	}
 
+	if outcome == user.AuthenticationLocked {
+		ctx.JSON(http.StatusTooManyRequests, gin.H{
+			"reason": outcome,
+		})
+
+		return
+	}
+
 	if outcome != user.AuthenticationSuccessful || usr == nil {
 		ctx.JSON(http.StatusUnauthorized, gin.H{
 			"reason": outcome,
@@ -48,7 +57,7 @@ func (h loginHandler) handle(ctx *gin.Context) {
 		return
 	}
 
-	token, err := h.authorizer.Jwt().GenerateToken(usr)
+	token, err := h.authorizer.Jwt().GenerateToken(ctx, usr)
 	if err != nil {
 		panic(err)
 	}
@@ -13,6 +13,7 @@ import (
 
 	"dillmann.com.br/nginx-ignition/api/common/authorization"
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
@@ -24,7 +25,9 @@ func Test_loginHandler(t *testing.T) {
 	setup := func(t *testing.T) (*user.MockedCommands, *gin.Engine) {
 		controller := gomock.NewController(t)
 		commands := user.NewMockedCommands(controller)
-		authorizer, _ := authorization.New(configuration.New(), commands)
+		sessionCommands := session.NewMockedCommands(controller)
+		sessionCommands.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		authorizer, _ := authorization.New(configuration.New(), commands, nil, sessionCommands)
 		handler := loginHandler{
 			commands:   commands,
 			authorizer: authorizer,
@@ -54,7 +57,7 @@ func Test_loginHandler(t *testing.T) {
 			subject.Username = "johndoe"
 			commands, engine := setup(t)
 			commands.EXPECT().
-				Authenticate(gomock.Any(), *payload.Username, *payload.Password, gomock.Any()).
+				Authenticate(gomock.Any(), *payload.Username, *payload.Password, gomock.Any(), gomock.Any()).
 				Return(user.AuthenticationSuccessful, subject, nil)
 
 			recorder := performRequest(engine, payload)
@@ -73,7 +76,7 @@ func Test_loginHandler(t *testing.T) {
 
 			commands, engine := setup(t)
 			commands.EXPECT().
-				Authenticate(gomock.Any(), *payload.Username, *payload.Password, gomock.Any()).
+				Authenticate(gomock.Any(), *payload.Username, *payload.Password, gomock.Any(), gomock.Any()).
 				Return(user.AuthenticationFailed, nil, nil)
 
 			recorder := performRequest(engine, payload)
@@ -92,7 +95,7 @@ func Test_loginHandler(t *testing.T) {
 			subject.Username = "johndoe"
 			commands, engine := setup(t)
 			commands.EXPECT().
-				Authenticate(gomock.Any(), *payload.Username, *payload.Password, "123456").
+				Authenticate(gomock.Any(), *payload.Username, *payload.Password, "123456", gomock.Any()).
 				Return(user.AuthenticationSuccessful, subject, nil)
 
 			recorder := performRequest(engine, payload)
@@ -111,7 +114,7 @@ func Test_loginHandler(t *testing.T) {
 
 			commands, engine := setup(t)
 			commands.EXPECT().
-				Authenticate(gomock.Any(), *payload.Username, *payload.Password, gomock.Any()).
+				Authenticate(gomock.Any(), *payload.Username, *payload.Password, gomock.Any(), gomock.Any()).
 				Return(user.AuthenticationMissingTOTP, nil, nil)
 
 			recorder := performRequest(engine, payload)
@@ -121,5 +124,24 @@ func Test_loginHandler(t *testing.T) {
 			json.Unmarshal(recorder.Body.Bytes(), &response)
 			assert.Equal(t, string(user.AuthenticationMissingTOTP), response["reason"])
 		})
+
+		t.Run("returns 429 Too Many Requests when the login is locked", func(t *testing.T) {
+			payload := userLoginRequestDTO{
+				Username: new("johndoe"),
+				Password: new("password"),
+			}
+
+			commands, engine := setup(t)
+			commands.EXPECT().
+				Authenticate(gomock.Any(), *payload.Username, *payload.Password, gomock.Any(), gomock.Any()).
+				Return(user.AuthenticationLocked, nil, nil)
+
+			recorder := performRequest(engine, payload)
+
+			assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+			var response map[string]any
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Equal(t, string(user.AuthenticationLocked), response["reason"])
+		})
 	})
 }
@@ -14,6 +14,9 @@ type logoutHandler struct {
 
 func (h logoutHandler) handle(ctx *gin.Context) {
 	subject := authorization.CurrentSubject(ctx)
-	h.authorizer.Jwt().RevokeToken(subject.TokenID)
+	if err := h.authorizer.Jwt().RevokeToken(ctx.Request.Context(), subject); err != nil {
+		panic(err)
+	}
+
 	ctx.Status(http.StatusNoContent)
 }
@@ -6,11 +6,13 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	"dillmann.com.br/nginx-ignition/api/common/authorization"
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
@@ -27,15 +29,23 @@ func Test_logoutHandler(t *testing.T) {
 			cfg := configuration.NewWithOverrides(map[string]string{
 				"nginx-ignition.security.jwt.secret": "1234567890123456789012345678901234567890123456789012345678901234",
 			})
+			subject := &authorization.Subject{
+				TokenID: uuid.NewString(),
+				User:    &user.User{ID: uuid.New()},
+			}
 			commands := user.NewMockedCommands(controller)
-			authorizer, _ := authorization.New(cfg, commands)
+			sessionCommands := session.NewMockedCommands(controller)
+			sessionCommands.EXPECT().
+				Revoke(gomock.Any(), subject.User.ID, uuid.MustParse(subject.TokenID)).
+				Return(nil)
+			authorizer, _ := authorization.New(cfg, commands, nil, sessionCommands)
 
 			handler := logoutHandler{
 				authorizer: authorizer,
 			}
 			engine := gin.New()
 			engine.Use(func(ginContext *gin.Context) {
-				ginContext.Set("ABAC:Subject", &authorization.Subject{TokenID: "token-id"})
+				ginContext.Set("ABAC:Subject", subject)
 				ginContext.Next()
 			})
 			engine.POST("/api/users/logout", handler.handle)
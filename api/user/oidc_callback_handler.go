@@ -0,0 +1,81 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+type oidcCallbackHandler struct {
+	commands   user.Commands
+	authorizer *authorization.ABAC
+	client     *oidcClient
+}
+
+func (h oidcCallbackHandler) handle(ctx *gin.Context) {
+	if !h.client.enabled() {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	state, err := readOIDCState(ctx)
+	clearOIDCState(ctx)
+
+	if err != nil || state.State == "" || state.State != ctx.Query("state") {
+		redirectWithOIDCError(ctx, i18n.K.ApiUserOidcInvalidState)
+		return
+	}
+
+	if providerError := ctx.Query("error"); providerError != "" {
+		log.Warnf(
+			"OpenID Connect login rejected by the identity provider: %s (%s)",
+			providerError,
+			ctx.Query("error_description"),
+		)
+		redirectWithOIDCError(ctx, i18n.K.ApiUserOidcAuthenticationFailed)
+		return
+	}
+
+	requestCtx := ctx.Request.Context()
+	identity, err := h.client.exchange(requestCtx, ctx.Request, ctx.Query("code"), state)
+	if err != nil {
+		log.Warnf("Unable to complete the OpenID Connect login: %s", err)
+		redirectWithOIDCError(ctx, i18n.K.ApiUserOidcAuthenticationFailed)
+		return
+	}
+
+	usr, err := h.commands.AuthenticateExternal(requestCtx, identity)
+	if err != nil {
+		var coreErr *coreerror.CoreError
+		if errors.As(err, &coreErr) {
+			redirectWithOIDCError(ctx, coreErr.Message.Key)
+			return
+		}
+
+		panic(err)
+	}
+
+	token, err := h.authorizer.Jwt().GenerateToken(ctx, usr)
+	if err != nil {
+		panic(err)
+	}
+
+	target := "/login"
+	if state.ReturnTo != "" {
+		target += "?returnTo=" + url.QueryEscape(state.ReturnTo)
+	}
+
+	ctx.Redirect(http.StatusFound, target+"#oidcToken="+url.QueryEscape(*token))
+}
+
+func redirectWithOIDCError(ctx *gin.Context, messageKey string) {
+	ctx.Redirect(http.StatusFound, "/login?oidcError="+url.QueryEscape(messageKey))
+}
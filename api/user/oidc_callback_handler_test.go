@@ -0,0 +1,162 @@
+package user
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/session"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func Test_oidcCallbackHandler(t *testing.T) {
+	setup := func(t *testing.T) (*user.MockedCommands, *fakeOIDCProvider, *gin.Engine) {
+		controller := gomock.NewController(t)
+		commands := user.NewMockedCommands(controller)
+		provider := newFakeOIDCProvider(t)
+		cfg := provider.configuration()
+		sessionCommands := session.NewMockedCommands(controller)
+		sessionCommands.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		authorizer, _ := authorization.New(cfg, commands, nil, sessionCommands)
+		handler := oidcCallbackHandler{
+			commands:   commands,
+			authorizer: authorizer,
+			client:     newOIDCClient(cfg),
+		}
+
+		engine := gin.New()
+		engine.GET("/api/users/oidc/callback", handler.handle)
+		return commands, provider, engine
+	}
+
+	performRequest := func(
+		engine *gin.Engine,
+		state *oidcState,
+		query string,
+	) *httptest.ResponseRecorder {
+		request := httptest.NewRequest("GET", "/api/users/oidc/callback?"+query, nil)
+		if state != nil {
+			payload, _ := json.Marshal(state)
+			request.AddCookie(&http.Cookie{
+				Name:  oidcStateCookieName,
+				Value: base64.RawURLEncoding.EncodeToString(payload),
+			})
+		}
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	errorLocation := func(key string) string {
+		return "/login?oidcError=" + url.QueryEscape(key)
+	}
+
+	t.Run("handle", func(t *testing.T) {
+		t.Run("redirects with a token on success", func(t *testing.T) {
+			commands, provider, engine := setup(t)
+			state := newOIDCState("/hosts")
+			provider.nonce = state.Nonce
+
+			subject := newUser()
+			commands.EXPECT().
+				AuthenticateExternal(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, identity *user.ExternalIdentity) (*user.User, error) {
+					assert.Equal(t, "external-subject", identity.Subject)
+					assert.Equal(t, "johndoe", identity.Username)
+					assert.Equal(t, []string{"admins"}, identity.Groups)
+					return subject, nil
+				})
+
+			recorder := performRequest(engine, state, "code=abc&state="+state.State)
+
+			require.Equal(t, http.StatusFound, recorder.Code)
+			location := recorder.Header().Get("Location")
+			assert.True(t, strings.HasPrefix(location, "/login?returnTo=%2Fhosts#oidcToken="))
+			assert.Equal(t, state.Verifier, provider.codeVerifier)
+		})
+
+		t.Run("rejects a state that does not match the cookie", func(t *testing.T) {
+			_, _, engine := setup(t)
+			state := newOIDCState("")
+
+			recorder := performRequest(engine, state, "code=abc&state=other")
+
+			assert.Equal(t, http.StatusFound, recorder.Code)
+			assert.Equal(
+				t,
+				errorLocation(i18n.K.ApiUserOidcInvalidState),
+				recorder.Header().Get("Location"),
+			)
+		})
+
+		t.Run("rejects a request without the state cookie", func(t *testing.T) {
+			_, _, engine := setup(t)
+
+			recorder := performRequest(engine, nil, "code=abc&state=abc")
+
+			assert.Equal(
+				t,
+				errorLocation(i18n.K.ApiUserOidcInvalidState),
+				recorder.Header().Get("Location"),
+			)
+		})
+
+		t.Run("rejects an ID token with an unexpected nonce", func(t *testing.T) {
+			_, provider, engine := setup(t)
+			state := newOIDCState("")
+			provider.nonce = "other"
+
+			recorder := performRequest(engine, state, "code=abc&state="+state.State)
+
+			assert.Equal(
+				t,
+				errorLocation(i18n.K.ApiUserOidcAuthenticationFailed),
+				recorder.Header().Get("Location"),
+			)
+		})
+
+		t.Run("redirects when the identity provider returns an error", func(t *testing.T) {
+			_, _, engine := setup(t)
+			state := newOIDCState("")
+
+			recorder := performRequest(engine, state, "error=access_denied&state="+state.State)
+
+			assert.Equal(
+				t,
+				errorLocation(i18n.K.ApiUserOidcAuthenticationFailed),
+				recorder.Header().Get("Location"),
+			)
+		})
+
+		t.Run("redirects with the reason when the user is rejected", func(t *testing.T) {
+			commands, provider, engine := setup(t)
+			state := newOIDCState("")
+			provider.nonce = state.Nonce
+
+			commands.EXPECT().
+				AuthenticateExternal(gomock.Any(), gomock.Any()).
+				Return(nil, coreerror.New(i18n.Static(i18n.K.CoreUserExternalNoGroupMatched), true))
+
+			recorder := performRequest(engine, state, "code=abc&state="+state.State)
+
+			assert.Equal(
+				t,
+				errorLocation(i18n.K.CoreUserExternalNoGroupMatched),
+				recorder.Header().Get("Location"),
+			)
+		})
+	})
+}
@@ -0,0 +1,336 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/oauth2"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+const (
+	oidcBasePath        = "/api/users/oidc"
+	oidcCallbackPath    = oidcBasePath + "/callback"
+	oidcStateCookieName = "nginx-ignition-oidc"
+	oidcStateTTL        = 10 * time.Minute
+)
+
+type oidcSettings struct {
+	issuerURL     string
+	clientID      string
+	clientSecret  string
+	redirectURL   string
+	usernameClaim string
+	nameClaim     string
+	emailClaim    string
+	groupsClaim   string
+	scopes        []string
+}
+
+type oidcState struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	ReturnTo string `json:"returnTo"`
+}
+
+type oidcClient struct {
+	configuration *configuration.Configuration
+	provider      *oidc.Provider
+	issuerURL     string
+	mutex         sync.Mutex
+}
+
+func newOIDCClient(cfg *configuration.Configuration) *oidcClient {
+	return &oidcClient{
+		configuration: cfg.WithPrefix("nginx-ignition.security.oidc"),
+	}
+}
+
+func (c *oidcClient) enabled() bool {
+	enabled, err := c.configuration.GetBoolean("enabled")
+	return err == nil && enabled
+}
+
+func (c *oidcClient) localLoginEnabled() bool {
+	if !c.enabled() {
+		return true
+	}
+
+	enabled, err := c.configuration.GetBoolean("local-login-enabled")
+	return err != nil || enabled
+}
+
+func (c *oidcClient) settings(request *http.Request) (*oidcSettings, error) {
+	output := &oidcSettings{}
+	requiredValues := map[string]*string{
+		"issuer-url":     &output.issuerURL,
+		"client-id":      &output.clientID,
+		"client-secret":  &output.clientSecret,
+		"username-claim": &output.usernameClaim,
+		"name-claim":     &output.nameClaim,
+		"email-claim":    &output.emailClaim,
+		"groups-claim":   &output.groupsClaim,
+	}
+
+	for key, target := range requiredValues {
+		value, err := c.configuration.Get(key)
+		if err != nil {
+			return nil, err
+		}
+
+		*target = value
+	}
+
+	scopes, err := c.configuration.Get("scopes")
+	if err != nil {
+		return nil, err
+	}
+
+	output.scopes = strings.Fields(scopes)
+	if redirectURL, _ := c.configuration.Get("redirect-url"); redirectURL != "" {
+		output.redirectURL = redirectURL
+	} else {
+		output.redirectURL = resolveRequestScheme(request) + "://" + request.Host + oidcCallbackPath
+	}
+
+	return output, nil
+}
+
+func (c *oidcClient) resolveProvider(
+	ctx context.Context,
+	settings *oidcSettings,
+) (*oidc.Provider, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.provider != nil && c.issuerURL == settings.issuerURL {
+		return c.provider, nil
+	}
+
+	provider, err := oidc.NewProvider(context.WithoutCancel(ctx), settings.issuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover the OpenID provider: %w", err)
+	}
+
+	c.provider = provider
+	c.issuerURL = settings.issuerURL
+	return provider, nil
+}
+
+func (c *oidcClient) oauth2Config(
+	ctx context.Context,
+	request *http.Request,
+) (*oauth2.Config, *oidcSettings, *oidc.Provider, error) {
+	settings, err := c.settings(request)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	provider, err := c.resolveProvider(ctx, settings)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	config := &oauth2.Config{
+		ClientID:     settings.clientID,
+		ClientSecret: settings.clientSecret,
+		RedirectURL:  settings.redirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       settings.scopes,
+	}
+
+	return config, settings, provider, nil
+}
+
+func (c *oidcClient) authCodeURL(
+	ctx context.Context,
+	request *http.Request,
+	state *oidcState,
+) (string, error) {
+	config, _, _, err := c.oauth2Config(ctx, request)
+	if err != nil {
+		return "", err
+	}
+
+	return config.AuthCodeURL(
+		state.State,
+		oidc.Nonce(state.Nonce),
+		oauth2.S256ChallengeOption(state.Verifier),
+	), nil
+}
+
+func (c *oidcClient) exchange(
+	ctx context.Context,
+	request *http.Request,
+	code string,
+	state *oidcState,
+) (*user.ExternalIdentity, error) {
+	config, settings, provider, err := c.oauth2Config(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.Exchange(ctx, code, oauth2.VerifierOption(state.Verifier))
+	if err != nil {
+		return nil, fmt.Errorf("unable to exchange the authorization code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("identity provider did not return an ID token")
+	}
+
+	idToken, err := provider.
+		Verifier(&oidc.Config{ClientID: settings.clientID}).
+		Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify the ID token: %w", err)
+	}
+
+	if idToken.Nonce != state.Nonce {
+		return nil, errors.New("ID token nonce does not match the login request")
+	}
+
+	claims := make(map[string]any)
+	if err = idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+
+	return buildExternalIdentity(idToken.Subject, claims, settings), nil
+}
+
+func buildExternalIdentity(
+	subject string,
+	claims map[string]any,
+	settings *oidcSettings,
+) *user.ExternalIdentity {
+	return &user.ExternalIdentity{
+		Subject:       subject,
+		Username:      stringClaim(claims, settings.usernameClaim),
+		Name:          stringClaim(claims, settings.nameClaim),
+		Email:         stringClaim(claims, settings.emailClaim),
+		EmailVerified: boolClaim(claims, "email_verified"),
+		Groups:        stringSliceClaim(claims, settings.groupsClaim),
+	}
+}
+
+func stringClaim(claims map[string]any, name string) string {
+	value, _ := claims[name].(string)
+	return strings.TrimSpace(value)
+}
+
+func boolClaim(claims map[string]any, name string) bool {
+	switch value := claims[name].(type) {
+	case bool:
+		return value
+	case string:
+		return strings.EqualFold(value, "true")
+	default:
+		return false
+	}
+}
+
+func stringSliceClaim(claims map[string]any, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' })
+	case []any:
+		output := make([]string, 0, len(value))
+		for _, item := range value {
+			if text, ok := item.(string); ok {
+				output = append(output, text)
+			}
+		}
+
+		return output
+	default:
+		return nil
+	}
+}
+
+func newOIDCState(returnTo string) *oidcState {
+	return &oidcState{
+		State:    oauth2.GenerateVerifier(),
+		Nonce:    oauth2.GenerateVerifier(),
+		Verifier: oauth2.GenerateVerifier(),
+		ReturnTo: sanitizeReturnTo(returnTo),
+	}
+}
+
+func writeOIDCState(ctx *gin.Context, state *oidcState) error {
+	payload, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(
+		oidcStateCookieName,
+		base64.RawURLEncoding.EncodeToString(payload),
+		int(oidcStateTTL.Seconds()),
+		oidcBasePath,
+		"",
+		resolveRequestScheme(ctx.Request) == "https",
+		true,
+	)
+
+	return nil
+}
+
+func readOIDCState(ctx *gin.Context) (*oidcState, error) {
+	value, err := ctx.Cookie(oidcStateCookieName)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &oidcState{}
+	if err = json.Unmarshal(payload, output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func clearOIDCState(ctx *gin.Context) {
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(oidcStateCookieName, "", -1, oidcBasePath, "", false, true)
+}
+
+func sanitizeReturnTo(value string) string {
+	if !strings.HasPrefix(value, "/") ||
+		strings.HasPrefix(value, "//") ||
+		strings.HasPrefix(value, "/\\") {
+		return ""
+	}
+
+	return value
+}
+
+func resolveRequestScheme(request *http.Request) string {
+	if proto := request.Header.Get("X-Forwarded-Proto"); proto != "" {
+		return strings.ToLower(strings.TrimSpace(strings.Split(proto, ",")[0]))
+	}
+
+	if request.TLS != nil {
+		return "https"
+	}
+
+	return "http"
+}
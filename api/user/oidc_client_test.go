@@ -0,0 +1,115 @@
+package user
+
+import (
+	"crypto/tls"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+)
+
+func Test_oidcClient(t *testing.T) {
+	t.Run("enabled", func(t *testing.T) {
+		t.Run("returns false by default", func(t *testing.T) {
+			client := newOIDCClient(configuration.New())
+			assert.False(t, client.enabled())
+			assert.True(t, client.localLoginEnabled())
+		})
+
+		t.Run("returns true when enabled by the configuration", func(t *testing.T) {
+			client := newOIDCClient(configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.security.oidc.enabled":             "true",
+				"nginx-ignition.security.oidc.local-login-enabled": "false",
+			}))
+
+			assert.True(t, client.enabled())
+			assert.False(t, client.localLoginEnabled())
+		})
+	})
+
+	t.Run("settings", func(t *testing.T) {
+		t.Run("derives the redirect URL from the request", func(t *testing.T) {
+			provider := newFakeOIDCProvider(t)
+			client := newOIDCClient(provider.configuration())
+			request := httptest.NewRequest("GET", "/api/users/oidc/login", nil)
+			request.Host = "ignition.example.com"
+			request.Header.Set("X-Forwarded-Proto", "https")
+
+			settings, err := client.settings(request)
+
+			require.NoError(t, err)
+			assert.Equal(
+				t,
+				"https://ignition.example.com/api/users/oidc/callback",
+				settings.redirectURL,
+			)
+			assert.Equal(t, []string{"openid", "profile", "email"}, settings.scopes)
+		})
+
+		t.Run("returns error when the issuer is not configured", func(t *testing.T) {
+			client := newOIDCClient(configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.security.oidc.enabled": "true",
+			}))
+
+			_, err := client.settings(httptest.NewRequest("GET", "/", nil))
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("buildExternalIdentity", func(t *testing.T) {
+		settings := &oidcSettings{
+			usernameClaim: "preferred_username",
+			nameClaim:     "name",
+			emailClaim:    "email",
+			groupsClaim:   "groups",
+		}
+
+		t.Run("extracts the configured claims", func(t *testing.T) {
+			result := buildExternalIdentity("subject", map[string]any{
+				"preferred_username": " johndoe ",
+				"name":               "John Doe",
+				"email":              "johndoe@example.com",
+				"email_verified":     true,
+				"groups":             []any{"admins", 42, "viewers"},
+			}, settings)
+
+			assert.Equal(t, "subject", result.Subject)
+			assert.Equal(t, "johndoe", result.Username)
+			assert.Equal(t, "John Doe", result.Name)
+			assert.Equal(t, "johndoe@example.com", result.Email)
+			assert.True(t, result.EmailVerified)
+			assert.Equal(t, []string{"admins", "viewers"}, result.Groups)
+		})
+
+		t.Run("accepts groups and e-mail verification as strings", func(t *testing.T) {
+			result := buildExternalIdentity("subject", map[string]any{
+				"email_verified": "TRUE",
+				"groups":         "admins,viewers",
+			}, settings)
+
+			assert.True(t, result.EmailVerified)
+			assert.Equal(t, []string{"admins", "viewers"}, result.Groups)
+		})
+	})
+
+	t.Run("sanitizeReturnTo", func(t *testing.T) {
+		assert.Equal(t, "/hosts?page=1", sanitizeReturnTo("/hosts?page=1"))
+		assert.Empty(t, sanitizeReturnTo("https://example.com"))
+		assert.Empty(t, sanitizeReturnTo("//example.com"))
+		assert.Empty(t, sanitizeReturnTo("/\\example.com"))
+	})
+
+	t.Run("resolveRequestScheme", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "/", nil)
+		assert.Equal(t, "http", resolveRequestScheme(request))
+
+		request.TLS = &tls.ConnectionState{}
+		assert.Equal(t, "https", resolveRequestScheme(request))
+
+		request.Header.Set("X-Forwarded-Proto", "HTTP, https")
+		assert.Equal(t, "http", resolveRequestScheme(request))
+	})
+}
@@ -0,0 +1,35 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+)
+
+type oidcLoginHandler struct {
+	client *oidcClient
+}
+
+func (h oidcLoginHandler) handle(ctx *gin.Context) {
+	if !h.client.enabled() {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	state := newOIDCState(ctx.Query("returnTo"))
+	redirectURL, err := h.client.authCodeURL(ctx.Request.Context(), ctx.Request, state)
+	if err != nil {
+		log.Warnf("Unable to start the OpenID Connect login: %s", err)
+		redirectWithOIDCError(ctx, i18n.K.ApiUserOidcAuthenticationFailed)
+		return
+	}
+
+	if err = writeOIDCState(ctx, state); err != nil {
+		panic(err)
+	}
+
+	ctx.Redirect(http.StatusFound, redirectURL)
+}
@@ -0,0 +1,97 @@
+package user
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+)
+
+func Test_oidcLoginHandler(t *testing.T) {
+	performRequest := func(
+		cfg *configuration.Configuration,
+		path string,
+	) *httptest.ResponseRecorder {
+		handler := oidcLoginHandler{newOIDCClient(cfg)}
+		engine := gin.New()
+		engine.GET("/api/users/oidc/login", handler.handle)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, httptest.NewRequest("GET", path, nil))
+		return recorder
+	}
+
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 404 Not Found when disabled", func(t *testing.T) {
+			recorder := performRequest(configuration.New(), "/api/users/oidc/login")
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+
+		t.Run("redirects to the identity provider", func(t *testing.T) {
+			provider := newFakeOIDCProvider(t)
+			recorder := performRequest(
+				provider.configuration(),
+				"/api/users/oidc/login?returnTo=%2Fhosts",
+			)
+
+			require.Equal(t, http.StatusFound, recorder.Code)
+			location, err := url.Parse(recorder.Header().Get("Location"))
+			require.NoError(t, err)
+			assert.Equal(
+				t,
+				provider.server.URL+"/authorize",
+				location.Scheme+"://"+location.Host+location.Path,
+			)
+
+			query := location.Query()
+			assert.Equal(t, oidcTestClientID, query.Get("client_id"))
+			assert.Equal(t, "code", query.Get("response_type"))
+			assert.Equal(t, "S256", query.Get("code_challenge_method"))
+			assert.NotEmpty(t, query.Get("code_challenge"))
+			assert.NotEmpty(t, query.Get("nonce"))
+
+			cookies := recorder.Result().Cookies()
+			require.Len(t, cookies, 1)
+			assert.Equal(t, oidcStateCookieName, cookies[0].Name)
+			assert.True(t, cookies[0].HttpOnly)
+
+			engine := gin.New()
+			engine.GET("/", func(ctx *gin.Context) {
+				state, err := readOIDCState(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, query.Get("state"), state.State)
+				assert.Equal(t, query.Get("nonce"), state.Nonce)
+				assert.Equal(t, "/hosts", state.ReturnTo)
+			})
+
+			request := httptest.NewRequest("GET", "/", nil)
+			request.AddCookie(cookies[0])
+			engine.ServeHTTP(httptest.NewRecorder(), request)
+		})
+
+		t.Run("redirects to the login page when the provider is unreachable", func(t *testing.T) {
+			cfg := configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.security.oidc.enabled":       "true",
+				"nginx-ignition.security.oidc.issuer-url":    "http://127.0.0.1:1",
+				"nginx-ignition.security.oidc.client-id":     oidcTestClientID,
+				"nginx-ignition.security.oidc.client-secret": "secret",
+			})
+
+			recorder := performRequest(cfg, "/api/users/oidc/login")
+
+			assert.Equal(t, http.StatusFound, recorder.Code)
+			assert.Equal(
+				t,
+				"/login?oidcError="+url.QueryEscape(i18n.K.ApiUserOidcAuthenticationFailed),
+				recorder.Header().Get("Location"),
+			)
+		})
+	})
+}
@@ -0,0 +1,20 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type oidcStatusHandler struct {
+	client *oidcClient
+}
+
+func (h oidcStatusHandler) handle(ctx *gin.Context) {
+	payload := &userOIDCStatusResponseDTO{
+		Enabled:           h.client.enabled(),
+		LocalLoginEnabled: h.client.localLoginEnabled(),
+	}
+
+	ctx.JSON(http.StatusOK, payload)
+}
@@ -0,0 +1,48 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+)
+
+func Test_oidcStatusHandler(t *testing.T) {
+	performRequest := func(cfg *configuration.Configuration) userOIDCStatusResponseDTO {
+		handler := oidcStatusHandler{newOIDCClient(cfg)}
+		engine := gin.New()
+		engine.GET("/api/users/oidc", handler.handle)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, httptest.NewRequest("GET", "/api/users/oidc", nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		var response userOIDCStatusResponseDTO
+		json.Unmarshal(recorder.Body.Bytes(), &response)
+		return response
+	}
+
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns disabled with local login by default", func(t *testing.T) {
+			response := performRequest(configuration.New())
+
+			assert.False(t, response.Enabled)
+			assert.True(t, response.LocalLoginEnabled)
+		})
+
+		t.Run("returns enabled without local login when configured", func(t *testing.T) {
+			response := performRequest(configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.security.oidc.enabled":             "true",
+				"nginx-ignition.security.oidc.local-login-enabled": "false",
+			}))
+
+			assert.True(t, response.Enabled)
+			assert.False(t, response.LocalLoginEnabled)
+		})
+	})
+}
@@ -36,19 +36,24 @@ func (h onboardingFinishHandler) handle(ctx *gin.Context) {
 	domainModel.ID = uuid.New()
 	domainModel.Enabled = true
 	domainModel.Permissions = user.Permissions{
-		Hosts:        user.ReadWriteAccessLevel,
-		Streams:      user.ReadWriteAccessLevel,
-		Certificates: user.ReadWriteAccessLevel,
-		Logs:         user.ReadOnlyAccessLevel,
-		Integrations: user.ReadWriteAccessLevel,
-		AccessLists:  user.ReadWriteAccessLevel,
-		Settings:     user.ReadWriteAccessLevel,
-		Users:        user.ReadWriteAccessLevel,
-		NginxServer:  user.ReadWriteAccessLevel,
-		ExportData:   user.ReadOnlyAccessLevel,
-		VPNs:         user.ReadWriteAccessLevel,
-		Caches:       user.ReadWriteAccessLevel,
-		TrafficStats: user.ReadOnlyAccessLevel,
+		Hosts:           user.ReadWriteAccessLevel,
+		Streams:         user.ReadWriteAccessLevel,
+		Certificates:    user.ReadWriteAccessLevel,
+		Logs:            user.ReadOnlyAccessLevel,
+		Integrations:    user.ReadWriteAccessLevel,
+		AccessLists:     user.ReadWriteAccessLevel,
+		Settings:        user.ReadWriteAccessLevel,
+		Users:           user.ReadWriteAccessLevel,
+		NginxServer:     user.ReadWriteAccessLevel,
+		ExportData:      user.ReadOnlyAccessLevel,
+		VPNs:            user.ReadWriteAccessLevel,
+		Caches:          user.ReadWriteAccessLevel,
+		Upstreams:       user.ReadWriteAccessLevel,
+		TLSProfiles:     user.ReadWriteAccessLevel,
+		RateLimits:      user.ReadWriteAccessLevel,
+		SecurityHeaders: user.ReadWriteAccessLevel,
+		TrafficStats:    user.ReadOnlyAccessLevel,
+		Audit:           user.ReadOnlyAccessLevel,
 	}
 
 	if err = h.commands.Save(ctx.Request.Context(), domainModel, nil); err != nil {
@@ -60,6 +65,7 @@ func (h onboardingFinishHandler) handle(ctx *gin.Context) {
 		domainModel.Username,
 		*domainModel.Password,
 		"",
+		ctx.ClientIP(),
 	)
 	if err != nil {
 		panic(err)
@@ -70,7 +76,7 @@ func (h onboardingFinishHandler) handle(ctx *gin.Context) {
 		return
 	}
 
-	token, err := h.authorizer.Jwt().GenerateToken(usr)
+	token, err := h.authorizer.Jwt().GenerateToken(ctx, usr)
 	if err != nil {
 		panic(err)
 	}
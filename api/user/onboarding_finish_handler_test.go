@@ -14,6 +14,7 @@ import (
 
 	"dillmann.com.br/nginx-ignition/api/common/authorization"
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
@@ -36,7 +37,7 @@ func Test_onboardingFinishHandler(t *testing.T) {
 				Save(gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil)
 			commands.EXPECT().
-				Authenticate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Authenticate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(user.AuthenticationSuccessful, &user.User{
 					ID:       uuid.New(),
 					Username: "admin",
@@ -45,7 +46,9 @@ func Test_onboardingFinishHandler(t *testing.T) {
 			cfg := configuration.NewWithOverrides(map[string]string{
 				"nginx-ignition.security.jwt.secret": "1234567890123456789012345678901234567890123456789012345678901234",
 			})
-			authorizer, _ := authorization.New(cfg, commands)
+			sessionCommands := session.NewMockedCommands(controller)
+			sessionCommands.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			authorizer, _ := authorization.New(cfg, commands, nil, sessionCommands)
 			handler := onboardingFinishHandler{
 				commands:   commands,
 				authorizer: authorizer,
@@ -83,7 +86,9 @@ func Test_onboardingFinishHandler(t *testing.T) {
 			cfg := configuration.NewWithOverrides(map[string]string{
 				"nginx-ignition.security.jwt.secret": "1234567890123456789012345678901234567890123456789012345678901234",
 			})
-			authorizer, _ := authorization.New(cfg, commands)
+			sessionCommands := session.NewMockedCommands(controller)
+			sessionCommands.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			authorizer, _ := authorization.New(cfg, commands, nil, sessionCommands)
 			handler := onboardingFinishHandler{
 				commands:   commands,
 				authorizer: authorizer,
@@ -6,14 +6,22 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
 func Install(
 	router *gin.Engine,
+	cfg *configuration.Configuration,
 	authorizer *authorization.ABAC,
 	commands user.Commands,
+	apiTokenCommands apitoken.Commands,
+	sessionCommands session.Commands,
 ) {
+	oidcInstance := newOIDCClient(cfg)
+
 	basePath := authorizer.ConfigureGroup(
 		router,
 		"/api/users",
@@ -24,8 +32,13 @@ func Install(
 
 	byIDPath := basePath.Group("/:id")
 	byIDPath.GET("", getHandler{commands}.handle)
-	byIDPath.PUT("", updateHandler{commands}.handle)
+	byIDPath.PUT("", updateHandler{commands, sessionCommands}.handle)
 	byIDPath.DELETE("", deleteHandler{commands}.handle)
+	byIDPath.GET("/lockout", lockoutGetHandler{commands}.handle)
+	byIDPath.DELETE("/lockout", lockoutDeleteHandler{commands}.handle)
+	byIDPath.GET("/sessions", sessionListHandler{sessionCommands}.handle)
+	byIDPath.DELETE("/sessions", sessionDeleteAllHandler{sessionCommands}.handle)
+	byIDPath.DELETE("/sessions/:sessionId", sessionDeleteHandler{sessionCommands}.handle)
 
 	onboardingPath := basePath.Group("/onboarding")
 	onboardingPath.GET("/status", onboardingStatusHandler{commands}.handle)
@@ -34,9 +47,19 @@ func Install(
 	basePath.POST("/logout", logoutHandler{authorizer}.handle)
 	basePath.POST("/login", loginHandler{commands, authorizer}.handle)
 
+	oidcPath := basePath.Group("/oidc")
+	oidcPath.GET("", oidcStatusHandler{oidcInstance}.handle)
+	oidcPath.GET("/login", oidcLoginHandler{oidcInstance}.handle)
+	oidcPath.GET("/callback", oidcCallbackHandler{commands, authorizer, oidcInstance}.handle)
+
 	currentPath := basePath.Group("/current")
 	currentPath.GET("", currentHandler{}.handle)
-	currentPath.POST("/update-password", updatePasswordHandler{commands}.handle)
+	currentPath.POST("/update-password", updatePasswordHandler{commands, sessionCommands}.handle)
+
+	currentSessionsPath := currentPath.Group("/sessions")
+	currentSessionsPath.GET("", sessionListHandler{sessionCommands}.handle)
+	currentSessionsPath.DELETE("", sessionDeleteAllHandler{sessionCommands}.handle)
+	currentSessionsPath.DELETE("/:sessionId", sessionDeleteHandler{sessionCommands}.handle)
 
 	totpPath := currentPath.Group("/totp")
 	totpPath.GET("", totpStatusHandler{commands}.handle)
@@ -44,9 +67,17 @@ func Install(
 	totpPath.POST("/activate", totpActivateHandler{commands}.handle)
 	totpPath.DELETE("", totpDisableHandler{commands}.handle)
 
+	apiTokensPath := currentPath.Group("/api-tokens")
+	apiTokensPath.GET("", apiTokenListHandler{apiTokenCommands}.handle)
+	apiTokensPath.POST("", apiTokenCreateHandler{apiTokenCommands}.handle)
+	apiTokensPath.DELETE("/:id", apiTokenDeleteHandler{apiTokenCommands}.handle)
+
 	authorizer.AllowAnonymous(http.MethodGet, "/api/users/onboarding/status")
 	authorizer.AllowAnonymous(http.MethodPost, "/api/users/onboarding/finish")
 	authorizer.AllowAnonymous(http.MethodPost, "/api/users/login")
+	authorizer.AllowAnonymous(http.MethodGet, "/api/users/oidc")
+	authorizer.AllowAnonymous(http.MethodGet, "/api/users/oidc/login")
+	authorizer.AllowAnonymous(http.MethodGet, "/api/users/oidc/callback")
 	authorizer.AllowAllUsers(http.MethodPost, "/api/users/logout")
 	authorizer.AllowAllUsers(http.MethodGet, "/api/users/current")
 	authorizer.AllowAllUsers(http.MethodPost, "/api/users/current/update-password")
@@ -54,4 +85,10 @@ func Install(
 	authorizer.AllowAllUsers(http.MethodPost, "/api/users/current/totp")
 	authorizer.AllowAllUsers(http.MethodPost, "/api/users/current/totp/activate")
 	authorizer.AllowAllUsers(http.MethodDelete, "/api/users/current/totp")
+	authorizer.AllowAllUsers(http.MethodGet, "/api/users/current/sessions")
+	authorizer.AllowAllUsers(http.MethodDelete, "/api/users/current/sessions")
+	authorizer.AllowAllUsers(http.MethodDelete, "/api/users/current/sessions/:sessionId")
+	authorizer.AllowAllUsers(http.MethodGet, "/api/users/current/api-tokens")
+	authorizer.AllowAllUsers(http.MethodPost, "/api/users/current/api-tokens")
+	authorizer.AllowAllUsers(http.MethodDelete, "/api/users/current/api-tokens/:id")
 }
@@ -0,0 +1,35 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/session"
+)
+
+type sessionDeleteAllHandler struct {
+	commands session.Commands
+}
+
+func (h sessionDeleteAllHandler) handle(ctx *gin.Context) {
+	userID, ok := resolveSessionOwner(ctx)
+	if !ok {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	var exceptSessionID *uuid.UUID
+	subject := authorization.CurrentSubject(ctx)
+	if subject.User.ID == userID {
+		exceptSessionID = subject.SessionID()
+	}
+
+	if err := h.commands.RevokeAll(ctx.Request.Context(), userID, exceptSessionID); err != nil {
+		panic(err)
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
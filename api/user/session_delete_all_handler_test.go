@@ -0,0 +1,85 @@
+package user
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/session"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_sessionDeleteAllHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("keeps the current session when signing out the current user", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			userID := uuid.New()
+			currentSessionID := uuid.New()
+			commands := session.NewMockedCommands(controller)
+			commands.EXPECT().
+				RevokeAll(gomock.Any(), userID, &currentSessionID).
+				Return(nil)
+
+			handler := sessionDeleteAllHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.Use(func(ginContext *gin.Context) {
+				ginContext.Set("ABAC:Subject", &authorization.Subject{
+					User:    &user.User{ID: userID},
+					TokenID: currentSessionID.String(),
+				})
+				ginContext.Next()
+			})
+			engine.DELETE("/api/users/current/sessions", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("DELETE", "/api/users/current/sessions", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+		})
+
+		t.Run("revokes all the sessions of another user", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			userID := uuid.New()
+			commands := session.NewMockedCommands(controller)
+			commands.EXPECT().
+				RevokeAll(gomock.Any(), userID, nil).
+				Return(nil)
+
+			handler := sessionDeleteAllHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.Use(func(ginContext *gin.Context) {
+				ginContext.Set("ABAC:Subject", &authorization.Subject{
+					User:    &user.User{ID: uuid.New()},
+					TokenID: uuid.NewString(),
+				})
+				ginContext.Next()
+			})
+			engine.DELETE("/api/users/:id/sessions", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("DELETE", "/api/users/"+userID.String()+"/sessions", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+		})
+	})
+}
@@ -0,0 +1,34 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/session"
+)
+
+type sessionDeleteHandler struct {
+	commands session.Commands
+}
+
+func (h sessionDeleteHandler) handle(ctx *gin.Context) {
+	userID, ok := resolveSessionOwner(ctx)
+	if !ok {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	sessionID, err := uuid.Parse(ctx.Param("sessionId"))
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	if err = h.commands.Revoke(ctx.Request.Context(), userID, sessionID); err != nil {
+		panic(err)
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
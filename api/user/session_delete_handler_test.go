@@ -0,0 +1,117 @@
+package user
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/session"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_sessionDeleteHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns 204 No Content on success", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			userID := uuid.New()
+			sessionID := uuid.New()
+			commands := session.NewMockedCommands(controller)
+			commands.EXPECT().
+				Revoke(gomock.Any(), userID, sessionID).
+				Return(nil)
+
+			handler := sessionDeleteHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.Use(func(ginContext *gin.Context) {
+				ginContext.Set("ABAC:Subject", &authorization.Subject{User: &user.User{ID: userID}})
+				ginContext.Next()
+			})
+			engine.DELETE("/api/users/current/sessions/:sessionId", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"DELETE",
+				"/api/users/current/sessions/"+sessionID.String(),
+				nil,
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+		})
+
+		t.Run("returns 404 Not Found on invalid session ID", func(t *testing.T) {
+			handler := sessionDeleteHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.DELETE("/api/users/:id/sessions/:sessionId", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"DELETE",
+				"/api/users/"+uuid.NewString()+"/sessions/invalid",
+				nil,
+			)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+
+		t.Run("panics on command error", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			expectedErr := assert.AnError
+			commands := session.NewMockedCommands(controller)
+			commands.EXPECT().
+				Revoke(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(expectedErr)
+
+			handler := sessionDeleteHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.Use(func(ginContext *gin.Context) {
+				ginContext.Set(
+					"ABAC:Subject",
+					&authorization.Subject{User: &user.User{ID: uuid.New()}},
+				)
+				ginContext.Next()
+			})
+			engine.DELETE("/api/users/current/sessions/:sessionId", func(ginContext *gin.Context) {
+				defer func() {
+					if r := recover(); r != nil {
+						assert.Equal(t, expectedErr, r)
+						panic(r)
+					}
+				}()
+				handler.handle(ginContext)
+			})
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(
+				"DELETE",
+				"/api/users/current/sessions/"+uuid.NewString(),
+				nil,
+			)
+
+			assert.Panics(t, func() {
+				engine.ServeHTTP(recorder, request)
+			})
+		})
+	})
+}
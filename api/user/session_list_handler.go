@@ -0,0 +1,35 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/session"
+)
+
+type sessionListHandler struct {
+	commands session.Commands
+}
+
+func (h sessionListHandler) handle(ctx *gin.Context) {
+	userID, ok := resolveSessionOwner(ctx)
+	if !ok {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	sessions, err := h.commands.ListByUser(ctx.Request.Context(), userID)
+	if err != nil {
+		panic(err)
+	}
+
+	currentSessionID := authorization.CurrentSubject(ctx).SessionID()
+	output := make([]sessionResponseDTO, len(sessions))
+	for index := range sessions {
+		output[index] = toSessionDTO(&sessions[index], currentSessionID)
+	}
+
+	ctx.JSON(http.StatusOK, output)
+}
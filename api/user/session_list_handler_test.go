@@ -0,0 +1,107 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/session"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func Test_sessionListHandler(t *testing.T) {
+	t.Run("handle", func(t *testing.T) {
+		t.Run("returns the sessions of the current user", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			userID := uuid.New()
+			currentSession := newSession(userID)
+			otherSession := newSession(userID)
+			commands := session.NewMockedCommands(controller)
+			commands.EXPECT().
+				ListByUser(gomock.Any(), userID).
+				Return([]session.Session{*currentSession, *otherSession}, nil)
+
+			handler := sessionListHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.Use(func(ginContext *gin.Context) {
+				ginContext.Set("ABAC:Subject", &authorization.Subject{
+					User:    &user.User{ID: userID},
+					TokenID: currentSession.ID.String(),
+				})
+				ginContext.Next()
+			})
+			engine.GET("/api/users/current/sessions", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/users/current/sessions", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response []sessionResponseDTO
+			json.Unmarshal(recorder.Body.Bytes(), &response)
+			assert.Len(t, response, 2)
+			assert.True(t, response[0].Current)
+			assert.False(t, response[1].Current)
+			assert.Equal(t, currentSession.UserAgent, response[0].UserAgent)
+		})
+
+		t.Run("returns the sessions of the informed user", func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			userID := uuid.New()
+			commands := session.NewMockedCommands(controller)
+			commands.EXPECT().
+				ListByUser(gomock.Any(), userID).
+				Return([]session.Session{*newSession(userID)}, nil)
+
+			handler := sessionListHandler{
+				commands: commands,
+			}
+			engine := gin.New()
+			engine.Use(func(ginContext *gin.Context) {
+				ginContext.Set(
+					"ABAC:Subject",
+					&authorization.Subject{User: &user.User{ID: uuid.New()}},
+				)
+				ginContext.Next()
+			})
+			engine.GET("/api/users/:id/sessions", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/users/"+userID.String()+"/sessions", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+
+		t.Run("returns 404 Not Found on invalid user ID", func(t *testing.T) {
+			handler := sessionListHandler{
+				commands: nil,
+			}
+			engine := gin.New()
+			engine.GET("/api/users/:id/sessions", handler.handle)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("GET", "/api/users/invalid/sessions", nil)
+			engine.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+	})
+}
@@ -0,0 +1,22 @@
+package user
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/api/common/authorization"
+)
+
+func resolveSessionOwner(ctx *gin.Context) (uuid.UUID, bool) {
+	userIDParam := ctx.Param("id")
+	if userIDParam == "" {
+		return authorization.CurrentSubject(ctx).User.ID, true
+	}
+
+	userID, err := uuid.Parse(userIDParam)
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
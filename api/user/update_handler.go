@@ -8,11 +8,13 @@ import (
 
 	"dillmann.com.br/nginx-ignition/api/common/authorization"
 	"dillmann.com.br/nginx-ignition/api/common/converter"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
 type updateHandler struct {
-	commands user.Commands
+	commands        user.Commands
+	sessionCommands session.Commands
 }
 
 func (h updateHandler) handle(ctx *gin.Context) {
@@ -29,11 +31,23 @@ func (h updateHandler) handle(ctx *gin.Context) {
 
 	domainModel := converter.Wrap(ctx.Request.Context(), toDomain, payload)
 	domainModel.ID = id
-	currentUserID := authorization.CurrentSubject(ctx).User.ID
+	subject := authorization.CurrentSubject(ctx)
 
-	if err := h.commands.Save(ctx.Request.Context(), domainModel, &currentUserID); err != nil {
+	if err = h.commands.Save(ctx.Request.Context(), domainModel, &subject.User.ID); err != nil {
 		panic(err)
 	}
 
+	if domainModel.Password != nil || !domainModel.Enabled {
+		var exceptSessionID *uuid.UUID
+		if id == subject.User.ID {
+			exceptSessionID = subject.SessionID()
+		}
+
+		err = h.sessionCommands.RevokeAll(ctx.Request.Context(), id, exceptSessionID)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	ctx.Status(http.StatusNoContent)
 }
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
@@ -32,9 +33,14 @@ func Test_updateHandler(t *testing.T) {
 			commands.EXPECT().
 				Save(gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil)
+			sessionCommands := session.NewMockedCommands(controller)
+			sessionCommands.EXPECT().
+				RevokeAll(gomock.Any(), id, nil).
+				Return(nil)
 
 			handler := updateHandler{
-				commands: commands,
+				commands:        commands,
+				sessionCommands: sessionCommands,
 			}
 			engine := gin.New()
 			engine.Use(func(ginContext *gin.Context) {
@@ -6,11 +6,13 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
 type updatePasswordHandler struct {
-	commands user.Commands
+	commands        user.Commands
+	sessionCommands session.Commands
 }
 
 func (h updatePasswordHandler) handle(ctx *gin.Context) {
@@ -19,16 +21,21 @@ func (h updatePasswordHandler) handle(ctx *gin.Context) {
 		panic(err)
 	}
 
-	currentUserID := authorization.CurrentSubject(ctx).User.ID
+	subject := authorization.CurrentSubject(ctx)
 
 	if err := h.commands.UpdatePassword(
 		ctx.Request.Context(),
-		currentUserID,
+		subject.User.ID,
 		*payload.CurrentPassword,
 		*payload.NewPassword,
 	); err != nil {
 		panic(err)
 	}
 
+	err := h.sessionCommands.RevokeAll(ctx.Request.Context(), subject.User.ID, subject.SessionID())
+	if err != nil {
+		panic(err)
+	}
+
 	ctx.Status(http.StatusNoContent)
 }
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"dillmann.com.br/nginx-ignition/api/common/authorization"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/user"
 )
 
@@ -36,9 +37,14 @@ func Test_updatePasswordHandler(t *testing.T) {
 			commands.EXPECT().
 				UpdatePassword(gomock.Any(), id, *payload.CurrentPassword, *payload.NewPassword).
 				Return(nil)
+			sessionCommands := session.NewMockedCommands(controller)
+			sessionCommands.EXPECT().
+				RevokeAll(gomock.Any(), id, nil).
+				Return(nil)
 
 			handler := updatePasswordHandler{
-				commands: commands,
+				commands:        commands,
+				sessionCommands: sessionCommands,
 			}
 			engine := gin.New()
 			engine.Use(func(ginContext *gin.Context) {
@@ -53,11 +53,13 @@ func startContainer(ctx context.Context) error {
 }
 
 func installCertificateDriverAggregation(
-	acmeCertificateProvider *letsencrypt.Provider,
+	letsEncryptCertificateProvider *letsencrypt.Provider,
+	acmeCertificateProvider *letsencrypt.ACMEProvider,
 	customCertificateProvider *custom.Provider,
 	selfSignedCertificateProvider *selfsigned.Provider,
 ) error {
 	return container.Singleton([]certificate.Provider{
+		letsEncryptCertificateProvider,
 		acmeCertificateProvider,
 		customCertificateProvider,
 		selfSignedCertificateProvider,
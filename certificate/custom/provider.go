@@ -36,7 +36,7 @@ func (p *Provider) DynamicFields(ctx context.Context) []dynamicfields.DynamicFie
 }
 
 func (p *Provider) Priority() int {
-	return 2
+	return 3
 }
 
 func (p *Provider) Issue(
@@ -5,13 +5,17 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
+	"errors"
+	"os"
 	"strings"
 	"time"
 
+	"github.com/go-acme/lego/v4/acme/api"
 	"github.com/go-acme/lego/v4/certcrypto"
 	acmecertificate "github.com/go-acme/lego/v4/certificate"
 	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/lego"
+	"github.com/go-acme/lego/v4/providers/http/webroot"
 	"github.com/go-acme/lego/v4/registration"
 	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
@@ -21,46 +25,59 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 )
 
+type issueOptions struct {
+	providerID            string
+	directoryURL          string
+	keyType               certcrypto.KeyType
+	preferredChain        string
+	eabKeyID              string
+	eabHMACKey            string
+	challengePath         string
+	productionEnvironment bool
+}
+
+func letsEncryptIssueOptions(productionEnvironment bool, challengePath string) *issueOptions {
+	directoryURL := lego.LEDirectoryProduction
+	if !productionEnvironment {
+		directoryURL = lego.LEDirectoryStaging
+	}
+
+	return &issueOptions{
+		providerID:            letsEncryptProviderID,
+		directoryURL:          directoryURL,
+		keyType:               certcrypto.RSA2048,
+		challengePath:         challengePath,
+		productionEnvironment: productionEnvironment,
+	}
+}
+
 func issueCertificate(
 	ctx context.Context,
 	user userDetails,
 	domainNames []string,
 	parameters map[string]any,
-	productionEnvironment bool,
+	options *issueOptions,
 ) (*certificate.Certificate, error) {
-	caURL := lego.LEDirectoryProduction
-	if !productionEnvironment {
-		caURL = lego.LEDirectoryStaging
-	}
-
 	config := lego.NewConfig(&user)
-	config.CADirURL = caURL
-	config.Certificate.KeyType = certcrypto.RSA2048
+	config.CADirURL = options.directoryURL
+	config.Certificate.KeyType = options.keyType
 
 	client, err := lego.NewClient(config)
 	if err != nil {
 		return nil, err
 	}
 
-	dnsChallenge, err := resolveProviderChallenge(ctx, domainNames, parameters)
-	if err != nil {
-		return nil, err
-	}
-
-	client.Challenge.Remove(challenge.TLSALPN01)
-	client.Challenge.Remove(challenge.HTTP01)
-	err = client.Challenge.SetDNS01Provider(dnsChallenge)
+	err = configureChallenge(ctx, client, domainNames, parameters, options.challengePath)
 	if err != nil {
 		return nil, err
 	}
 
-	registerOptions := registration.RegisterOptions{TermsOfServiceAgreed: true}
 	if user.newAccount {
-		user.registration, err = client.Registration.Register(registerOptions)
+		user.registration, err = register(client, options)
 	} else {
 		user.registration, err = client.Registration.ResolveAccountByKey()
 		if err != nil {
-			user.registration, err = client.Registration.Register(registerOptions)
+			user.registration, err = register(client, options)
 		}
 	}
 
@@ -69,8 +86,9 @@ func issueCertificate(
 	}
 
 	request := acmecertificate.ObtainRequest{
-		Domains: domainNames,
-		Bundle:  true,
+		Domains:        domainNames,
+		Bundle:         true,
+		PreferredChain: options.preferredChain,
 	}
 
 	cert, err := client.Certificate.Obtain(request)
@@ -85,11 +103,59 @@ func issueCertificate(
 		parameters,
 		cert,
 		user,
-		productionEnvironment,
+		options,
 		client,
 	)
 }
 
+func register(client *lego.Client, options *issueOptions) (*registration.Resource, error) {
+	if options.eabKeyID == "" {
+		return client.Registration.Register(registration.RegisterOptions{
+			TermsOfServiceAgreed: true,
+		})
+	}
+
+	return client.Registration.RegisterWithExternalAccountBinding(registration.RegisterEABOptions{
+		TermsOfServiceAgreed: true,
+		Kid:                  options.eabKeyID,
+		HmacEncoded:          options.eabHMACKey,
+	})
+}
+
+func configureChallenge(
+	ctx context.Context,
+	client *lego.Client,
+	domainNames []string,
+	parameters map[string]any,
+	challengePath string,
+) error {
+	client.Challenge.Remove(challenge.TLSALPN01)
+
+	if resolveChallengeType(parameters) == httpChallengeType {
+		client.Challenge.Remove(challenge.DNS01)
+
+		if err := os.MkdirAll(challengePath, os.ModePerm); err != nil {
+			return err
+		}
+
+		httpChallenge, err := webroot.NewHTTPProvider(challengePath)
+		if err != nil {
+			return err
+		}
+
+		return client.Challenge.SetHTTP01Provider(httpChallenge)
+	}
+
+	client.Challenge.Remove(challenge.HTTP01)
+
+	dnsChallenge, err := resolveProviderChallenge(ctx, domainNames, parameters)
+	if err != nil {
+		return err
+	}
+
+	return client.Challenge.SetDNS01Provider(dnsChallenge)
+}
+
 func parseResult(
 	ctx context.Context,
 	id uuid.UUID,
@@ -97,7 +163,7 @@ func parseResult(
 	parameters map[string]any,
 	result *acmecertificate.Resource,
 	usr userDetails,
-	productionEnvironment bool,
+	options *issueOptions,
 	client *lego.Client,
 ) (*certificate.Certificate, error) {
 	mainCert := strings.Replace(string(result.Certificate), string(result.IssuerCertificate), "", 1)
@@ -117,7 +183,7 @@ func parseResult(
 		UserPublicKey: base64.StdEncoding.EncodeToString(
 			x509.MarshalPKCS1PublicKey(&usr.privateKey.PublicKey),
 		),
-		ProductionEnvironment: productionEnvironment,
+		ProductionEnvironment: options.productionEnvironment,
 	}
 
 	metadataJSON, err := jsoniter.MarshalToString(metadata)
@@ -125,19 +191,14 @@ func parseResult(
 		return nil, err
 	}
 
-	privateKeyBlock, _ := pem.Decode(result.PrivateKey)
-	if privateKeyBlock == nil || privateKeyBlock.Type != "RSA PRIVATE KEY" {
+	privateKey, err := certcrypto.ParsePEMPrivateKey(result.PrivateKey)
+	if err != nil {
 		return nil, coreerror.New(
 			i18n.M(ctx, i18n.K.CommonUnableToParsePem).V("type", "private key"),
 			false,
 		)
 	}
 
-	privateKey, err := x509.ParsePKCS1PrivateKey(privateKeyBlock.Bytes)
-	if err != nil {
-		return nil, err
-	}
-
 	encodedPrivateKey, err := x509.MarshalPKCS8PrivateKey(privateKey)
 	if err != nil {
 		return nil, err
@@ -155,7 +216,7 @@ func parseResult(
 
 	output := certificate.Certificate{
 		ID:                 id,
-		ProviderID:         certificateProviderID,
+		ProviderID:         options.providerID,
 		DomainNames:        domainNames,
 		IssuedAt:           time.Now(),
 		ValidUntil:         *notAfter,
@@ -194,14 +255,21 @@ func fetchCertDates(pemBlock pem.Block, client *lego.Client) (
 		return nil, nil, nil, err
 	}
 
+	notAfter = &certDetails.NotAfter
+	notBefore = &certDetails.NotBefore
+
 	infoRequest := acmecertificate.RenewalInfoRequest{Cert: certDetails}
 	renewalInfo, err := client.Certificate.GetRenewalInfo(infoRequest)
+	if errors.Is(err, api.ErrNoARI) {
+		lifetime := certDetails.NotAfter.Sub(certDetails.NotBefore)
+		renewAt = new(certDetails.NotAfter.Add(-lifetime / 3))
+		return notAfter, notBefore, renewAt, nil
+	}
+
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	notAfter = &certDetails.NotAfter
-	notBefore = &certDetails.NotBefore
 	renewAt = &renewalInfo.SuggestedWindow.Start
 
 	return notAfter, notBefore, renewAt, nil
@@ -0,0 +1,123 @@
+package letsencrypt
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+
+	"dillmann.com.br/nginx-ignition/certificate/commons"
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/dynamicfields"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+)
+
+const (
+	acmeProviderID = "ACME"
+)
+
+type ACMEProvider struct {
+	configuration *configuration.Configuration
+}
+
+func NewACMEProvider(cfg *configuration.Configuration) *ACMEProvider {
+	return &ACMEProvider{
+		configuration: cfg,
+	}
+}
+
+func (p *ACMEProvider) ID() string {
+	return acmeProviderID
+}
+
+func (p *ACMEProvider) Name(ctx context.Context) *i18n.Message {
+	return i18n.M(ctx, i18n.K.CertificateAcmeName)
+}
+
+func (p *ACMEProvider) DynamicFields(ctx context.Context) []dynamicfields.DynamicField {
+	return resolveACMEDynamicFields(ctx)
+}
+
+func (p *ACMEProvider) Priority() int {
+	return 2
+}
+
+func (p *ACMEProvider) Issue(
+	ctx context.Context,
+	request *certificate.IssueRequest,
+) (*certificate.Certificate, error) {
+	rules := validationRules{
+		termsOfServiceRequired: i18n.M(ctx, i18n.K.CertificateAcmeTosRequired),
+		dynamicFields:          p.DynamicFields(ctx),
+	}
+
+	if err := commons.Validate(ctx, request, rules); err != nil {
+		return nil, err
+	}
+
+	user, err := newUserDetails(ctx, request.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := p.issueOptions(request.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	return issueCertificate(ctx, *user, request.DomainNames, request.Parameters, options)
+}
+
+func (p *ACMEProvider) Renew(
+	ctx context.Context,
+	cert *certificate.Certificate,
+) (*certificate.Certificate, error) {
+	user, _, err := restoreUserDetails(ctx, cert)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := p.issueOptions(cert.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	return issueCertificate(ctx, *user, cert.DomainNames, cert.Parameters, options)
+}
+
+func (p *ACMEProvider) issueOptions(parameters map[string]any) (*issueOptions, error) {
+	challengePath, err := resolveChallengePath(p.configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	directoryURL, _ := parameters[directoryURLFieldID].(string)
+	keyType, _ := parameters[keyTypeFieldID].(string)
+	preferredChain, _ := parameters[preferredChainFieldID].(string)
+	eabKeyID, _ := parameters[eabKeyIDFieldID].(string)
+	eabHMACKey, _ := parameters[eabHMACKeyFieldID].(string)
+
+	return &issueOptions{
+		providerID:     acmeProviderID,
+		directoryURL:   strings.TrimSpace(directoryURL),
+		keyType:        toKeyType(keyType),
+		preferredChain: strings.TrimSpace(preferredChain),
+		eabKeyID:       strings.TrimSpace(eabKeyID),
+		eabHMACKey:     strings.TrimSpace(eabHMACKey),
+		challengePath:  challengePath,
+	}, nil
+}
+
+func toKeyType(value string) certcrypto.KeyType {
+	switch value {
+	case rsa4096KeyType:
+		return certcrypto.RSA4096
+	case ec256KeyType:
+		return certcrypto.EC256
+	case ec384KeyType:
+		return certcrypto.EC384
+	default:
+		return certcrypto.RSA2048
+	}
+}
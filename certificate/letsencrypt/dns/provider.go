@@ -24,15 +24,22 @@ func LinkedToProvider(id string, fields []dynamicfields.DynamicField) []dynamicf
 	output := make([]dynamicfields.DynamicField, 0, len(fields))
 
 	for index, field := range fields {
-		field.Priority = index + 2
+		field.Priority = index + 3
 		if field.Conditions == nil {
-			field.Conditions = make([]dynamicfields.Condition, 0, 1)
+			field.Conditions = make([]dynamicfields.Condition, 0, 2)
 		}
 
-		field.Conditions = append(field.Conditions, dynamicfields.Condition{
-			ParentField: "challengeDnsProvider",
-			Value:       id,
-		})
+		field.Conditions = append(
+			field.Conditions,
+			dynamicfields.Condition{
+				ParentField: "challengeType",
+				Value:       "DNS_01",
+			},
+			dynamicfields.Condition{
+				ParentField: "challengeDnsProvider",
+				Value:       id,
+			},
+		)
 
 		output = append(output, field)
 	}
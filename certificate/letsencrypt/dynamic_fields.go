@@ -13,15 +13,59 @@ const (
 	termsOfServiceFieldID = "acceptTheTermsOfService"
 	emailAddressFieldID   = "emailAddress"
 	dnsProviderFieldID    = "challengeDnsProvider"
+	challengeTypeFieldID  = "challengeType"
+)
+
+const (
+	directoryURLFieldID   = "directoryUrl"
+	eabKeyIDFieldID       = "eabKeyId"
+	eabHMACKeyFieldID     = "eabHmacKey"
+	keyTypeFieldID        = "keyType"
+	preferredChainFieldID = "preferredChain"
+)
+
+const (
+	dnsChallengeType  = "DNS_01"
+	httpChallengeType = "HTTP_01"
+)
+
+const (
+	rsa2048KeyType = "RSA2048"
+	rsa4096KeyType = "RSA4096"
+	ec256KeyType   = "EC256"
+	ec384KeyType   = "EC384"
 )
 
 func mainDynamicFields(ctx context.Context) ([]dynamicfields.DynamicField, int) {
 	dnsField := dynamicfields.DynamicField{
 		ID:          dnsProviderFieldID,
-		Priority:    1,
+		Priority:    2,
 		Description: i18n.M(ctx, i18n.K.CertificateLetsencryptDnsProvider),
 		Required:    true,
 		Type:        dynamicfields.EnumType,
+		Conditions: []dynamicfields.Condition{
+			{ParentField: challengeTypeFieldID, Value: dnsChallengeType},
+		},
+	}
+
+	challengeTypeField := dynamicfields.DynamicField{
+		ID:           challengeTypeFieldID,
+		Priority:     1,
+		Description:  i18n.M(ctx, i18n.K.CertificateLetsencryptChallengeType),
+		HelpText:     i18n.M(ctx, i18n.K.CertificateLetsencryptChallengeTypeHelp),
+		Required:     true,
+		DefaultValue: dnsChallengeType,
+		Type:         dynamicfields.EnumType,
+		EnumOptions: []dynamicfields.EnumOption{
+			{
+				ID:          dnsChallengeType,
+				Description: i18n.M(ctx, i18n.K.CertificateLetsencryptChallengeTypeDns),
+			},
+			{
+				ID:          httpChallengeType,
+				Description: i18n.M(ctx, i18n.K.CertificateLetsencryptChallengeTypeHttp),
+			},
+		},
 	}
 
 	tosField := dynamicfields.DynamicField{
@@ -42,12 +86,21 @@ func mainDynamicFields(ctx context.Context) ([]dynamicfields.DynamicField, int)
 		Type:        dynamicfields.EmailType,
 	}
 
-	return []dynamicfields.DynamicField{dnsField, tosField, emailField}, 0
+	fields := []dynamicfields.DynamicField{dnsField, challengeTypeField, tosField, emailField}
+	return fields, 0
+}
+
+func resolveChallengeType(parameters map[string]any) string {
+	if challengeType, casted := parameters[challengeTypeFieldID].(string); casted {
+		return challengeType
+	}
+
+	return dnsChallengeType
 }
 
 func resolveDynamicFields(ctx context.Context) []dynamicfields.DynamicField {
 	mainFields, dnsProviderField := mainDynamicFields(ctx)
-	output := make([]dynamicfields.DynamicField, 0, 3+len(providers))
+	output := make([]dynamicfields.DynamicField, 0, len(mainFields)+len(providers))
 	output = append(output, mainFields...)
 	providerOptions := make([]dynamicfields.EnumOption, 0, len(providers))
 
@@ -71,3 +124,71 @@ func resolveDynamicFields(ctx context.Context) []dynamicfields.DynamicField {
 
 	return output
 }
+
+func resolveACMEDynamicFields(ctx context.Context) []dynamicfields.DynamicField {
+	directoryURLField := dynamicfields.DynamicField{
+		ID:          directoryURLFieldID,
+		Priority:    -5,
+		Description: i18n.M(ctx, i18n.K.CertificateAcmeDirectoryUrl),
+		HelpText:    i18n.M(ctx, i18n.K.CertificateAcmeDirectoryUrlHelp),
+		Required:    true,
+		Type:        dynamicfields.URLType,
+	}
+
+	keyTypeField := dynamicfields.DynamicField{
+		ID:           keyTypeFieldID,
+		Priority:     -4,
+		Description:  i18n.M(ctx, i18n.K.CertificateAcmeKeyType),
+		Required:     true,
+		DefaultValue: rsa2048KeyType,
+		Type:         dynamicfields.EnumType,
+		EnumOptions: []dynamicfields.EnumOption{
+			{ID: rsa2048KeyType, Description: i18n.M(ctx, i18n.K.CertificateAcmeKeyTypeRsa2048)},
+			{ID: rsa4096KeyType, Description: i18n.M(ctx, i18n.K.CertificateAcmeKeyTypeRsa4096)},
+			{ID: ec256KeyType, Description: i18n.M(ctx, i18n.K.CertificateAcmeKeyTypeEc256)},
+			{ID: ec384KeyType, Description: i18n.M(ctx, i18n.K.CertificateAcmeKeyTypeEc384)},
+		},
+	}
+
+	preferredChainField := dynamicfields.DynamicField{
+		ID:          preferredChainFieldID,
+		Priority:    -3,
+		Description: i18n.M(ctx, i18n.K.CertificateAcmePreferredChain),
+		HelpText:    i18n.M(ctx, i18n.K.CertificateAcmePreferredChainHelp),
+		Type:        dynamicfields.SingleLineTextType,
+	}
+
+	eabKeyIDField := dynamicfields.DynamicField{
+		ID:          eabKeyIDFieldID,
+		Priority:    -2,
+		Description: i18n.M(ctx, i18n.K.CertificateAcmeEabKeyId),
+		HelpText:    i18n.M(ctx, i18n.K.CertificateAcmeEabHelp),
+		Type:        dynamicfields.SingleLineTextType,
+	}
+
+	eabHMACKeyField := dynamicfields.DynamicField{
+		ID:          eabHMACKeyFieldID,
+		Priority:    -1,
+		Description: i18n.M(ctx, i18n.K.CertificateAcmeEabHmacKey),
+		Sensitive:   true,
+		Type:        dynamicfields.SingleLineTextType,
+	}
+
+	output := []dynamicfields.DynamicField{
+		directoryURLField,
+		keyTypeField,
+		preferredChainField,
+		eabKeyIDField,
+		eabHMACKeyField,
+	}
+
+	for _, field := range resolveDynamicFields(ctx) {
+		if field.ID == termsOfServiceFieldID {
+			field.HelpText = i18n.M(ctx, i18n.K.CertificateAcmeTosHelp)
+		}
+
+		output = append(output, field)
+	}
+
+	return output
+}
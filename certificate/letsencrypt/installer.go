@@ -5,5 +5,5 @@ import (
 )
 
 func Install() error {
-	return container.Provide(New)
+	return container.Provide(New, NewACMEProvider)
 }
@@ -2,25 +2,18 @@ package letsencrypt
 
 import (
 	"context"
-	"crypto/rand"
-	"crypto/rsa"
-	"crypto/x509"
-	"encoding/base64"
-	"encoding/json"
 
 	acmelog "github.com/go-acme/lego/v4/log"
 
 	"dillmann.com.br/nginx-ignition/certificate/commons"
 	"dillmann.com.br/nginx-ignition/core/certificate"
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
-	"dillmann.com.br/nginx-ignition/core/common/coreerror"
 	"dillmann.com.br/nginx-ignition/core/common/dynamicfields"
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 )
 
 const (
-	certificateProviderID = "LETS_ENCRYPT"
-	privateKeySize        = 2048
+	letsEncryptProviderID = "LETS_ENCRYPT"
 )
 
 type Provider struct {
@@ -36,7 +29,7 @@ func New(cfg *configuration.Configuration) *Provider {
 }
 
 func (p *Provider) ID() string {
-	return certificateProviderID
+	return letsEncryptProviderID
 }
 
 func (p *Provider) Name(ctx context.Context) *i18n.Message {
@@ -55,7 +48,12 @@ func (p *Provider) Issue(
 	ctx context.Context,
 	request *certificate.IssueRequest,
 ) (*certificate.Certificate, error) {
-	if err := commons.Validate(ctx, request, validationRules{p.DynamicFields(ctx)}); err != nil {
+	rules := validationRules{
+		termsOfServiceRequired: i18n.M(ctx, i18n.K.CertificateLetsencryptTosRequired),
+		dynamicFields:          p.DynamicFields(ctx),
+	}
+
+	if err := commons.Validate(ctx, request, rules); err != nil {
 		return nil, err
 	}
 
@@ -64,28 +62,22 @@ func (p *Provider) Issue(
 		return nil, err
 	}
 
-	email, _ := request.Parameters[emailAddressFieldID].(string)
-
-	usrKey, err := rsa.GenerateKey(rand.Reader, privateKeySize)
+	user, err := newUserDetails(ctx, request.Parameters)
 	if err != nil {
-		return nil, coreerror.New(
-			i18n.M(ctx, i18n.K.CertificateLetsencryptGeneratePrivateKey),
-			false,
-		)
+		return nil, err
 	}
 
-	user := userDetails{
-		email:      email,
-		privateKey: usrKey,
-		newAccount: true,
+	challengePath, err := resolveChallengePath(p.configuration)
+	if err != nil {
+		return nil, err
 	}
 
 	return issueCertificate(
 		ctx,
-		user,
+		*user,
 		request.DomainNames,
 		request.Parameters,
-		productionEnvironment,
+		letsEncryptIssueOptions(productionEnvironment, challengePath),
 	)
 }
 
@@ -93,33 +85,22 @@ func (p *Provider) Renew(
 	ctx context.Context,
 	cert *certificate.Certificate,
 ) (*certificate.Certificate, error) {
-	var metadata *certificateMetadata
-	if err := json.Unmarshal([]byte(*cert.Metadata), &metadata); err != nil {
-		return nil, coreerror.New(i18n.M(ctx, i18n.K.CertificateLetsencryptParseMetadata), false)
-	}
-
-	encodedPrivKey, err := base64.StdEncoding.DecodeString(metadata.UserPrivateKey)
+	user, metadata, err := restoreUserDetails(ctx, cert)
 	if err != nil {
-		return nil, coreerror.New(i18n.M(ctx, i18n.K.CertificateLetsencryptDecodePrivateKey), false)
+		return nil, err
 	}
 
-	privKey, err := x509.ParsePKCS1PrivateKey(encodedPrivKey)
+	challengePath, err := resolveChallengePath(p.configuration)
 	if err != nil {
-		return nil, coreerror.New(i18n.M(ctx, i18n.K.CertificateLetsencryptParsePrivateKey), false)
-	}
-
-	user := userDetails{
-		email:      metadata.UserMail,
-		privateKey: privKey,
-		newAccount: false,
+		return nil, err
 	}
 
 	return issueCertificate(
 		ctx,
-		user,
+		*user,
 		cert.DomainNames,
 		cert.Parameters,
-		metadata.ProductionEnvironment,
+		letsEncryptIssueOptions(metadata.ProductionEnvironment, challengePath),
 	)
 }
 
@@ -1,10 +1,25 @@
 package letsencrypt
 
 import (
+	"context"
 	"crypto"
+	"crypto/rand"
 	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"path/filepath"
 
 	"github.com/go-acme/lego/v4/registration"
+
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+)
+
+const (
+	privateKeySize = 2048
 )
 
 type userDetails struct {
@@ -25,3 +40,67 @@ func (u *userDetails) GetRegistration() *registration.Resource {
 func (u *userDetails) GetPrivateKey() crypto.PrivateKey {
 	return u.privateKey
 }
+
+func newUserDetails(ctx context.Context, parameters map[string]any) (*userDetails, error) {
+	email, _ := parameters[emailAddressFieldID].(string)
+
+	usrKey, err := rsa.GenerateKey(rand.Reader, privateKeySize)
+	if err != nil {
+		return nil, coreerror.New(
+			i18n.M(ctx, i18n.K.CertificateLetsencryptGeneratePrivateKey),
+			false,
+		)
+	}
+
+	return &userDetails{
+		email:      email,
+		privateKey: usrKey,
+		newAccount: true,
+	}, nil
+}
+
+func restoreUserDetails(
+	ctx context.Context,
+	cert *certificate.Certificate,
+) (*userDetails, *certificateMetadata, error) {
+	var metadata *certificateMetadata
+	if err := json.Unmarshal([]byte(*cert.Metadata), &metadata); err != nil {
+		return nil, nil, coreerror.New(
+			i18n.M(ctx, i18n.K.CertificateLetsencryptParseMetadata),
+			false,
+		)
+	}
+
+	encodedPrivKey, err := base64.StdEncoding.DecodeString(metadata.UserPrivateKey)
+	if err != nil {
+		return nil, nil, coreerror.New(
+			i18n.M(ctx, i18n.K.CertificateLetsencryptDecodePrivateKey),
+			false,
+		)
+	}
+
+	privKey, err := x509.ParsePKCS1PrivateKey(encodedPrivKey)
+	if err != nil {
+		return nil, nil, coreerror.New(
+			i18n.M(ctx, i18n.K.CertificateLetsencryptParsePrivateKey),
+			false,
+		)
+	}
+
+	user := &userDetails{
+		email:      metadata.UserMail,
+		privateKey: privKey,
+		newAccount: false,
+	}
+
+	return user, metadata, nil
+}
+
+func resolveChallengePath(cfg *configuration.Configuration) (string, error) {
+	configPath, err := cfg.Get("nginx-ignition.nginx.config-path")
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(filepath.Clean(configPath), "acme-challenge"), nil
+}
@@ -3,6 +3,7 @@ package letsencrypt
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"dillmann.com.br/nginx-ignition/core/certificate"
 	"dillmann.com.br/nginx-ignition/core/common/dynamicfields"
@@ -11,7 +12,8 @@ import (
 )
 
 type validationRules struct {
-	dynamicFields []dynamicfields.DynamicField
+	termsOfServiceRequired *i18n.Message
+	dynamicFields          []dynamicfields.DynamicField
 }
 
 func (r validationRules) DynamicFields() []dynamicfields.DynamicField {
@@ -28,7 +30,16 @@ func (r validationRules) Validate(
 	if !casted || !termsOfServiceAccepted {
 		output = append(output, validation.ConsistencyViolation{
 			Path:    fmt.Sprintf("parameters.%s", termsOfServiceFieldID),
-			Message: i18n.M(ctx, i18n.K.CertificateLetsencryptTosRequired),
+			Message: r.termsOfServiceRequired,
+		})
+	}
+
+	eabKeyID, _ := request.Parameters[eabKeyIDFieldID].(string)
+	eabHMACKey, _ := request.Parameters[eabHMACKeyFieldID].(string)
+	if strings.TrimSpace(eabKeyID) != "" && strings.TrimSpace(eabHMACKey) == "" {
+		output = append(output, validation.ConsistencyViolation{
+			Path:    fmt.Sprintf("parameters.%s", eabHMACKeyFieldID),
+			Message: i18n.M(ctx, i18n.K.CertificateAcmeEabHmacKeyRequired),
 		})
 	}
 
@@ -0,0 +1,53 @@
+package apitoken
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func newAPIToken() *APIToken {
+	return &APIToken{
+		ID:                     uuid.New(),
+		UserID:                 uuid.New(),
+		Name:                   "CI pipeline",
+		ExpiresAt:              new(time.Now().Add(24 * time.Hour)),
+		AllowedSourceAddresses: []string{"10.0.0.0/8", "192.168.0.10"},
+		Permissions:            newPermissions(user.ReadOnlyAccessLevel),
+	}
+}
+
+func newPermissions(level user.AccessLevel) user.Permissions {
+	return user.Permissions{
+		Hosts:           level,
+		Streams:         level,
+		Certificates:    level,
+		Logs:            level,
+		Integrations:    level,
+		AccessLists:     level,
+		Settings:        level,
+		Users:           level,
+		NginxServer:     level,
+		ExportData:      level,
+		VPNs:            level,
+		Caches:          level,
+		Upstreams:       level,
+		TLSProfiles:     level,
+		RateLimits:      level,
+		SecurityHeaders: level,
+		TrafficStats:    level,
+		Audit:           level,
+	}
+}
+
+func newOwner(id uuid.UUID) *user.User {
+	return &user.User{
+		ID:          id,
+		Name:        "John Doe",
+		Username:    "johndoe",
+		Enabled:     true,
+		Permissions: newPermissions(user.ReadWriteAccessLevel),
+	}
+}
@@ -0,0 +1,16 @@
+package apitoken
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+type Commands interface {
+	Create(ctx context.Context, token *APIToken) (string, error)
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]APIToken, error)
+	Authenticate(ctx context.Context, secret, sourceAddress string) (*APIToken, *user.User, error)
+}
@@ -0,0 +1,9 @@
+package apitoken
+
+import (
+	"dillmann.com.br/nginx-ignition/core/common/container"
+)
+
+func Install() error {
+	return container.Provide(newCommands)
+}
@@ -0,0 +1,22 @@
+package apitoken
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+type APIToken struct {
+	ExpiresAt              *time.Time
+	LastUsedAt             *time.Time
+	CreatedAt              time.Time
+	Name                   string
+	TokenHash              string
+	TokenPrefix            string
+	AllowedSourceAddresses []string
+	Permissions            user.Permissions
+	ID                     uuid.UUID
+	UserID                 uuid.UUID
+}
@@ -0,0 +1,17 @@
+package apitoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*APIToken, error)
+	FindByTokenHash(ctx context.Context, tokenHash string) (*APIToken, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]APIToken, error)
+	DeleteByID(ctx context.Context, id uuid.UUID) error
+	UpdateLastUsedAt(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error
+	Save(ctx context.Context, token *APIToken) error
+}
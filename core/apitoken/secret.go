@@ -0,0 +1,33 @@
+package apitoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	SecretPrefix      = "nig_"
+	secretSizeBytes   = 32
+	visiblePrefixSize = 12
+)
+
+func IsSecret(value string) bool {
+	return strings.HasPrefix(value, SecretPrefix)
+}
+
+func generateSecret() (string, error) {
+	secretBytes := make([]byte, secretSizeBytes)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", err
+	}
+
+	return SecretPrefix + base64.RawURLEncoding.EncodeToString(secretBytes), nil
+}
+
+func hashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
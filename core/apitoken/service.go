@@ -0,0 +1,136 @@
+package apitoken
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+const (
+	lastUsedUpdateInterval = time.Minute
+)
+
+type service struct {
+	repository   Repository
+	userCommands user.Commands
+}
+
+func newCommands(repository Repository, userCommands user.Commands) Commands {
+	return &service{
+		repository:   repository,
+		userCommands: userCommands,
+	}
+}
+
+func (s *service) Create(ctx context.Context, token *APIToken) (string, error) {
+	if err := newValidator().validate(ctx, token); err != nil {
+		return "", err
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return "", err
+	}
+
+	token.ID = uuid.New()
+	token.Name = strings.TrimSpace(token.Name)
+	token.TokenHash = hashSecret(secret)
+	token.TokenPrefix = secret[:visiblePrefixSize]
+	token.CreatedAt = time.Now()
+	token.LastUsedAt = nil
+
+	if err = s.repository.Save(ctx, token); err != nil {
+		return "", err
+	}
+
+	return secret, nil
+}
+
+func (s *service) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	token, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if token == nil || token.UserID != userID {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreApitokenNotFound), true)
+	}
+
+	return s.repository.DeleteByID(ctx, id)
+}
+
+func (s *service) ListByUser(ctx context.Context, userID uuid.UUID) ([]APIToken, error) {
+	return s.repository.FindByUserID(ctx, userID)
+}
+
+func (s *service) Authenticate(
+	ctx context.Context,
+	secret, sourceAddress string,
+) (*APIToken, *user.User, error) {
+	if !IsSecret(secret) {
+		return nil, nil, nil
+	}
+
+	token, err := s.repository.FindByTokenHash(ctx, hashSecret(secret))
+	if err != nil || token == nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	if token.ExpiresAt != nil && !token.ExpiresAt.After(now) {
+		return nil, nil, nil
+	}
+
+	if !isSourceAddressAllowed(token.AllowedSourceAddresses, sourceAddress) {
+		return nil, nil, nil
+	}
+
+	owner, err := s.userCommands.Get(ctx, token.UserID)
+	if err != nil || owner == nil || !owner.Enabled {
+		return nil, nil, err
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastUsedUpdateInterval {
+		if err = s.repository.UpdateLastUsedAt(ctx, token.ID, now); err != nil {
+			return nil, nil, err
+		}
+
+		token.LastUsedAt = &now
+	}
+
+	owner.Permissions = owner.Permissions.Intersect(token.Permissions)
+	return token, owner, nil
+}
+
+func isSourceAddressAllowed(allowedAddresses []string, sourceAddress string) bool {
+	if len(allowedAddresses) == 0 {
+		return true
+	}
+
+	address, err := netip.ParseAddr(sourceAddress)
+	if err != nil {
+		return false
+	}
+
+	address = address.Unmap()
+	for _, allowed := range allowedAddresses {
+		allowed = strings.TrimSpace(allowed)
+		if prefix, err := netip.ParsePrefix(allowed); err == nil && prefix.Contains(address) {
+			return true
+		}
+
+		allowedAddress, err := netip.ParseAddr(allowed)
+		if err == nil && allowedAddress.Unmap() == address {
+			return true
+		}
+	}
+
+	return false
+}
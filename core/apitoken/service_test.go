@@ -0,0 +1,184 @@
+package apitoken
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func Test_service(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("stores only the hash of the generated secret", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			token := newAPIToken()
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Save(t.Context(), token).Return(nil)
+
+			secret, err := newCommands(repository, nil).Create(t.Context(), token)
+
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(secret, SecretPrefix))
+			assert.Equal(t, hashSecret(secret), token.TokenHash)
+			assert.NotContains(t, token.TokenHash, secret)
+			assert.Equal(t, secret[:visiblePrefixSize], token.TokenPrefix)
+			assert.False(t, token.CreatedAt.IsZero())
+		})
+
+		t.Run("invalid token returns validation error", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			token := newAPIToken()
+			token.Name = ""
+
+			_, err := newCommands(NewMockedRepository(ctrl), nil).Create(t.Context(), token)
+
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("deletes a token owned by the user", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			token := newAPIToken()
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), token.ID).Return(token, nil)
+			repository.EXPECT().DeleteByID(t.Context(), token.ID).Return(nil)
+
+			err := newCommands(repository, nil).Delete(t.Context(), token.UserID, token.ID)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("returns error when the token belongs to another user", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			token := newAPIToken()
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), token.ID).Return(token, nil)
+
+			err := newCommands(repository, nil).Delete(t.Context(), uuid.New(), token.ID)
+
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+			assert.Equal(t, i18n.K.CoreApitokenNotFound, coreErr.Message.Key)
+		})
+	})
+
+	t.Run("Authenticate", func(t *testing.T) {
+		setup := func(
+			t *testing.T,
+			token *APIToken,
+		) (*MockedRepository, *user.MockedCommands, Commands, string) {
+			ctrl := gomock.NewController(t)
+			secret, _ := generateSecret()
+			token.TokenHash = hashSecret(secret)
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByTokenHash(t.Context(), token.TokenHash).Return(token, nil)
+			userCommands := user.NewMockedCommands(ctrl)
+
+			return repository, userCommands, newCommands(repository, userCommands), secret
+		}
+
+		t.Run("returns the owner limited to the token permissions", func(t *testing.T) {
+			token := newAPIToken()
+			repository, userCommands, service, secret := setup(t, token)
+			userCommands.EXPECT().Get(t.Context(), token.UserID).Return(newOwner(token.UserID), nil)
+			repository.EXPECT().UpdateLastUsedAt(t.Context(), token.ID, gomock.Any()).Return(nil)
+
+			result, owner, err := service.Authenticate(t.Context(), secret, "10.1.2.3")
+
+			require.NoError(t, err)
+			require.NotNil(t, owner)
+			assert.Equal(t, token.ID, result.ID)
+			assert.NotNil(t, result.LastUsedAt)
+			assert.Equal(t, user.ReadOnlyAccessLevel, owner.Permissions.Hosts)
+		})
+
+		t.Run("does not record the usage again within the update interval", func(t *testing.T) {
+			token := newAPIToken()
+			token.LastUsedAt = new(time.Now().Add(-10 * time.Second))
+			_, userCommands, service, secret := setup(t, token)
+			userCommands.EXPECT().Get(t.Context(), token.UserID).Return(newOwner(token.UserID), nil)
+
+			_, owner, err := service.Authenticate(t.Context(), secret, "192.168.0.10")
+
+			require.NoError(t, err)
+			assert.NotNil(t, owner)
+		})
+
+		t.Run("rejects expired tokens", func(t *testing.T) {
+			token := newAPIToken()
+			token.ExpiresAt = new(time.Now().Add(-time.Second))
+			_, _, service, secret := setup(t, token)
+
+			result, owner, err := service.Authenticate(t.Context(), secret, "10.1.2.3")
+
+			assert.NoError(t, err)
+			assert.Nil(t, result)
+			assert.Nil(t, owner)
+		})
+
+		t.Run("rejects source addresses outside of the allowlist", func(t *testing.T) {
+			token := newAPIToken()
+			_, _, service, secret := setup(t, token)
+
+			result, owner, err := service.Authenticate(t.Context(), secret, "172.16.0.1")
+
+			assert.NoError(t, err)
+			assert.Nil(t, result)
+			assert.Nil(t, owner)
+		})
+
+		t.Run("rejects tokens of disabled users", func(t *testing.T) {
+			token := newAPIToken()
+			_, userCommands, service, secret := setup(t, token)
+			owner := newOwner(token.UserID)
+			owner.Enabled = false
+			userCommands.EXPECT().Get(t.Context(), token.UserID).Return(owner, nil)
+
+			result, usr, err := service.Authenticate(t.Context(), secret, "10.1.2.3")
+
+			assert.NoError(t, err)
+			assert.Nil(t, result)
+			assert.Nil(t, usr)
+		})
+
+		t.Run("ignores values that are not API tokens", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := newCommands(NewMockedRepository(ctrl), nil)
+
+			result, owner, err := service.Authenticate(t.Context(), "eyJhbGciOi", "10.1.2.3")
+
+			assert.NoError(t, err)
+			assert.Nil(t, result)
+			assert.Nil(t, owner)
+		})
+	})
+
+	t.Run("isSourceAddressAllowed", func(t *testing.T) {
+		allowed := []string{"10.0.0.0/8", "2001:db8::/32", "192.168.0.10"}
+
+		assert.True(t, isSourceAddressAllowed(nil, "1.2.3.4"))
+		assert.True(t, isSourceAddressAllowed(allowed, "10.20.30.40"))
+		assert.True(t, isSourceAddressAllowed(allowed, "::ffff:192.168.0.10"))
+		assert.True(t, isSourceAddressAllowed(allowed, "2001:db8::1"))
+		assert.False(t, isSourceAddressAllowed(allowed, "192.168.0.11"))
+		assert.False(t, isSourceAddressAllowed(allowed, "invalid"))
+	})
+}
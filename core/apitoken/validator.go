@@ -0,0 +1,75 @@
+package apitoken
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/validation"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+const (
+	maximumNameLength = 256
+)
+
+type validator struct {
+	delegate *validation.ConsistencyValidator
+}
+
+func newValidator() *validator {
+	return &validator{
+		delegate: validation.NewValidator(),
+	}
+}
+
+func (v *validator) validate(ctx context.Context, token *APIToken) error {
+	name := strings.TrimSpace(token.Name)
+	if name == "" {
+		v.delegate.Add("name", i18n.M(ctx, i18n.K.CommonValueMissing))
+	} else if len(name) > maximumNameLength {
+		v.delegate.Add(
+			"name",
+			i18n.M(ctx, i18n.K.CommonValueTooLong).V("max", maximumNameLength),
+		)
+	}
+
+	if token.ExpiresAt != nil && !token.ExpiresAt.After(time.Now()) {
+		v.delegate.Add("expiresAt", i18n.M(ctx, i18n.K.CoreApitokenExpirationInThePast))
+	}
+
+	for index, address := range token.AllowedSourceAddresses {
+		if !isValidSourceAddress(address) {
+			v.delegate.Add(
+				fmt.Sprintf("allowedSourceAddresses[%d]", index),
+				i18n.M(ctx, i18n.K.CoreApitokenInvalidSourceAddress),
+			)
+		}
+	}
+
+	for name, level := range token.Permissions.Levels() {
+		switch level {
+		case user.NoAccessAccessLevel, user.ReadOnlyAccessLevel, user.ReadWriteAccessLevel:
+		default:
+			v.delegate.Add(
+				fmt.Sprintf("permissions.%s", name),
+				i18n.M(ctx, i18n.K.CoreUserInvalidAccessLevel),
+			)
+		}
+	}
+
+	return v.delegate.Result()
+}
+
+func isValidSourceAddress(value string) bool {
+	value = strings.TrimSpace(value)
+	if _, err := netip.ParsePrefix(value); err == nil {
+		return true
+	}
+
+	_, err := netip.ParseAddr(value)
+	return err == nil
+}
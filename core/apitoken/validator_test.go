@@ -0,0 +1,58 @@
+package apitoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validator(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		t.Run("valid token passes", func(t *testing.T) {
+			err := newValidator().validate(t.Context(), newAPIToken())
+			assert.NoError(t, err)
+		})
+
+		t.Run("token without expiration and source addresses passes", func(t *testing.T) {
+			token := newAPIToken()
+			token.ExpiresAt = nil
+			token.AllowedSourceAddresses = nil
+
+			err := newValidator().validate(t.Context(), token)
+			assert.NoError(t, err)
+		})
+
+		t.Run("empty name fails", func(t *testing.T) {
+			token := newAPIToken()
+			token.Name = " "
+
+			err := newValidator().validate(t.Context(), token)
+			assert.Error(t, err)
+		})
+
+		t.Run("expiration in the past fails", func(t *testing.T) {
+			token := newAPIToken()
+			token.ExpiresAt = new(time.Now().Add(-time.Minute))
+
+			err := newValidator().validate(t.Context(), token)
+			assert.Error(t, err)
+		})
+
+		t.Run("invalid source address fails", func(t *testing.T) {
+			token := newAPIToken()
+			token.AllowedSourceAddresses = []string{"10.0.0.0/33"}
+
+			err := newValidator().validate(t.Context(), token)
+			assert.Error(t, err)
+		})
+
+		t.Run("invalid access level fails", func(t *testing.T) {
+			token := newAPIToken()
+			token.Permissions.Hosts = "EVERYTHING"
+
+			err := newValidator().validate(t.Context(), token)
+			assert.Error(t, err)
+		})
+	})
+}
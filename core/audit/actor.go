@@ -0,0 +1,16 @@
+package audit
+
+import (
+	"context"
+)
+
+type actorContextKey struct{}
+
+func WithActor(ctx context.Context, actor *Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+func actorFromContext(ctx context.Context) *Actor {
+	actor, _ := ctx.Value(actorContextKey{}).(*Actor)
+	return actor
+}
@@ -0,0 +1,15 @@
+package audit
+
+import (
+	"context"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type Commands interface {
+	List(
+		ctx context.Context,
+		pageSize, pageNumber int,
+		filters *Filters,
+	) (*pagination.Page[Event], error)
+}
@@ -0,0 +1,462 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/cache"
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/dynamicfields"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+	"dillmann.com.br/nginx-ignition/core/user"
+	"dillmann.com.br/nginx-ignition/core/vpn"
+)
+
+func recordChange[T any](
+	ctx context.Context,
+	r *recorder,
+	entityType EntityType,
+	id uuid.UUID,
+	get func(context.Context, uuid.UUID) (*T, error),
+	change func() error,
+) error {
+	before, err := get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err = change(); err != nil {
+		return err
+	}
+
+	after, err := get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return r.record(ctx, entityType, &id, before, after)
+}
+
+type hostCommands struct {
+	host.Commands
+	recorder *recorder
+}
+
+func decorateHostCommands(commands host.Commands, r *recorder) host.Commands {
+	return &hostCommands{commands, r}
+}
+
+func (c *hostCommands) Save(ctx context.Context, input *host.Host) error {
+	return recordChange(ctx, c.recorder, HostEntityType, input.ID, c.Get, func() error {
+		return c.Commands.Save(ctx, input)
+	})
+}
+
+func (c *hostCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, HostEntityType, id, c.Get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
+
+type streamCommands struct {
+	stream.Commands
+	recorder *recorder
+}
+
+func decorateStreamCommands(commands stream.Commands, r *recorder) stream.Commands {
+	return &streamCommands{commands, r}
+}
+
+func (c *streamCommands) Save(ctx context.Context, input *stream.Stream) error {
+	return recordChange(ctx, c.recorder, StreamEntityType, input.ID, c.Get, func() error {
+		return c.Commands.Save(ctx, input)
+	})
+}
+
+func (c *streamCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, StreamEntityType, id, c.Get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
+
+type certificateCommands struct {
+	certificate.Commands
+	recorder *recorder
+}
+
+func decorateCertificateCommands(
+	commands certificate.Commands,
+	r *recorder,
+) certificate.Commands {
+	return &certificateCommands{commands, r}
+}
+
+func (c *certificateCommands) Issue(
+	ctx context.Context,
+	request *certificate.IssueRequest,
+) (*certificate.Certificate, error) {
+	cert, err := c.Commands.Issue(ctx, request)
+	if err != nil || cert == nil {
+		return cert, err
+	}
+
+	after, err := c.get(ctx, cert.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cert, c.recorder.record(ctx, CertificateEntityType, &cert.ID, nil, after)
+}
+
+func (c *certificateCommands) Save(ctx context.Context, cert *certificate.Certificate) error {
+	return recordChange(ctx, c.recorder, CertificateEntityType, cert.ID, c.get, func() error {
+		return c.Commands.Save(ctx, cert)
+	})
+}
+
+func (c *certificateCommands) Renew(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, CertificateEntityType, id, c.get, func() error {
+		return c.Commands.Renew(ctx, id)
+	})
+}
+
+func (c *certificateCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, CertificateEntityType, id, c.get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
+
+func (c *certificateCommands) get(
+	ctx context.Context,
+	id uuid.UUID,
+) (*certificate.Certificate, error) {
+	cert, err := c.Get(ctx, id)
+	if err != nil || cert == nil {
+		return cert, err
+	}
+
+	providers, err := c.AvailableProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []dynamicfields.DynamicField
+	for _, provider := range providers {
+		if provider.ID() == cert.ProviderID {
+			fields = provider.DynamicFields(ctx)
+		}
+	}
+
+	output := *cert
+	output.Parameters = redactParameters(cert.Parameters, fields)
+	return &output, nil
+}
+
+type userCommands struct {
+	user.Commands
+	recorder *recorder
+}
+
+func decorateUserCommands(commands user.Commands, r *recorder) user.Commands {
+	return &userCommands{commands, r}
+}
+
+func (c *userCommands) Save(
+	ctx context.Context,
+	request *user.SaveRequest,
+	currentUserID *uuid.UUID,
+) error {
+	return recordChange(ctx, c.recorder, UserEntityType, request.ID, c.Get, func() error {
+		return c.Commands.Save(ctx, request, currentUserID)
+	})
+}
+
+func (c *userCommands) UpdatePassword(
+	ctx context.Context,
+	id uuid.UUID,
+	oldPassword, newPassword string,
+) error {
+	return recordChange(ctx, c.recorder, UserEntityType, id, c.Get, func() error {
+		return c.Commands.UpdatePassword(ctx, id, oldPassword, newPassword)
+	})
+}
+
+func (c *userCommands) DisableTOTP(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, UserEntityType, id, c.Get, func() error {
+		return c.Commands.DisableTOTP(ctx, id)
+	})
+}
+
+func (c *userCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, UserEntityType, id, c.Get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
+
+type accessListCommands struct {
+	accesslist.Commands
+	recorder *recorder
+}
+
+func decorateAccessListCommands(
+	commands accesslist.Commands,
+	r *recorder,
+) accesslist.Commands {
+	return &accessListCommands{commands, r}
+}
+
+func (c *accessListCommands) Save(ctx context.Context, input *accesslist.AccessList) error {
+	return recordChange(ctx, c.recorder, AccessListEntityType, input.ID, c.Get, func() error {
+		return c.Commands.Save(ctx, input)
+	})
+}
+
+func (c *accessListCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, AccessListEntityType, id, c.Get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
+
+type cacheCommands struct {
+	cache.Commands
+	recorder *recorder
+}
+
+func decorateCacheCommands(commands cache.Commands, r *recorder) cache.Commands {
+	return &cacheCommands{commands, r}
+}
+
+func (c *cacheCommands) Save(ctx context.Context, input *cache.Cache) error {
+	return recordChange(ctx, c.recorder, CacheEntityType, input.ID, c.Get, func() error {
+		return c.Commands.Save(ctx, input)
+	})
+}
+
+func (c *cacheCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, CacheEntityType, id, c.Get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
+
+type upstreamCommands struct {
+	upstream.Commands
+	recorder *recorder
+}
+
+func decorateUpstreamCommands(commands upstream.Commands, r *recorder) upstream.Commands {
+	return &upstreamCommands{commands, r}
+}
+
+func (c *upstreamCommands) Save(ctx context.Context, input *upstream.Upstream) error {
+	return recordChange(ctx, c.recorder, UpstreamEntityType, input.ID, c.Get, func() error {
+		return c.Commands.Save(ctx, input)
+	})
+}
+
+func (c *upstreamCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, UpstreamEntityType, id, c.Get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
+
+type integrationCommands struct {
+	integration.Commands
+	recorder *recorder
+}
+
+func decorateIntegrationCommands(
+	commands integration.Commands,
+	r *recorder,
+) integration.Commands {
+	return &integrationCommands{commands, r}
+}
+
+func (c *integrationCommands) Save(ctx context.Context, input *integration.Integration) error {
+	return recordChange(ctx, c.recorder, IntegrationEntityType, input.ID, c.get, func() error {
+		return c.Commands.Save(ctx, input)
+	})
+}
+
+func (c *integrationCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, IntegrationEntityType, id, c.get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
+
+func (c *integrationCommands) get(
+	ctx context.Context,
+	id uuid.UUID,
+) (*integration.Integration, error) {
+	data, err := c.Get(ctx, id)
+	if err != nil || data == nil {
+		return data, err
+	}
+
+	drivers, err := c.GetAvailableDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []dynamicfields.DynamicField
+	for _, driver := range drivers {
+		if driver.ID == data.Driver {
+			fields = driver.ConfigurationFields
+		}
+	}
+
+	output := *data
+	output.Parameters = redactParameters(data.Parameters, fields)
+	return &output, nil
+}
+
+type vpnCommands struct {
+	vpn.Commands
+	recorder *recorder
+}
+
+func decorateVPNCommands(commands vpn.Commands, r *recorder) vpn.Commands {
+	return &vpnCommands{commands, r}
+}
+
+func (c *vpnCommands) Save(ctx context.Context, input *vpn.VPN) error {
+	return recordChange(ctx, c.recorder, VPNEntityType, input.ID, c.get, func() error {
+		return c.Commands.Save(ctx, input)
+	})
+}
+
+func (c *vpnCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, VPNEntityType, id, c.get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
+
+func (c *vpnCommands) get(ctx context.Context, id uuid.UUID) (*vpn.VPN, error) {
+	data, err := c.Get(ctx, id)
+	if err != nil || data == nil {
+		return data, err
+	}
+
+	drivers, err := c.GetAvailableDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []dynamicfields.DynamicField
+	for _, driver := range drivers {
+		if driver.ID == data.Driver {
+			fields = driver.ConfigurationFields
+		}
+	}
+
+	output := *data
+	output.Parameters = redactParameters(data.Parameters, fields)
+	return &output, nil
+}
+
+type settingsCommands struct {
+	settings.Commands
+	recorder *recorder
+}
+
+func decorateSettingsCommands(commands settings.Commands, r *recorder) settings.Commands {
+	return &settingsCommands{commands, r}
+}
+
+func (c *settingsCommands) Save(ctx context.Context, input *settings.Settings) error {
+	before, err := c.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = c.Commands.Save(ctx, input); err != nil {
+		return err
+	}
+
+	after, err := c.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.recorder.record(ctx, SettingsEntityType, nil, before, after)
+}
+
+type tlsProfileCommands struct {
+	tlsprofile.Commands
+	recorder *recorder
+}
+
+func decorateTLSProfileCommands(
+	commands tlsprofile.Commands,
+	r *recorder,
+) tlsprofile.Commands {
+	return &tlsProfileCommands{commands, r}
+}
+
+func (c *tlsProfileCommands) Save(ctx context.Context, input *tlsprofile.TLSProfile) error {
+	return recordChange(ctx, c.recorder, TLSProfileEntityType, input.ID, c.Get, func() error {
+		return c.Commands.Save(ctx, input)
+	})
+}
+
+func (c *tlsProfileCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, TLSProfileEntityType, id, c.Get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
+
+type rateLimitCommands struct {
+	ratelimit.Commands
+	recorder *recorder
+}
+
+func decorateRateLimitCommands(commands ratelimit.Commands, r *recorder) ratelimit.Commands {
+	return &rateLimitCommands{commands, r}
+}
+
+func (c *rateLimitCommands) Save(ctx context.Context, input *ratelimit.RateLimit) error {
+	return recordChange(ctx, c.recorder, RateLimitEntityType, input.ID, c.Get, func() error {
+		return c.Commands.Save(ctx, input)
+	})
+}
+
+func (c *rateLimitCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, RateLimitEntityType, id, c.Get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
+
+type securityHeadersCommands struct {
+	securityheaders.Commands
+	recorder *recorder
+}
+
+func decorateSecurityHeadersCommands(
+	commands securityheaders.Commands,
+	r *recorder,
+) securityheaders.Commands {
+	return &securityHeadersCommands{commands, r}
+}
+
+func (c *securityHeadersCommands) Save(
+	ctx context.Context,
+	input *securityheaders.SecurityHeaders,
+) error {
+	return recordChange(ctx, c.recorder, SecurityHeadersEntityType, input.ID, c.Get, func() error {
+		return c.Commands.Save(ctx, input)
+	})
+}
+
+func (c *securityHeadersCommands) Delete(ctx context.Context, id uuid.UUID) error {
+	return recordChange(ctx, c.recorder, SecurityHeadersEntityType, id, c.Get, func() error {
+		return c.Commands.Delete(ctx, id)
+	})
+}
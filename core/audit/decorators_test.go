@@ -0,0 +1,408 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/dynamicfields"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/user"
+	"dillmann.com.br/nginx-ignition/core/vpn"
+)
+
+func Test_hostCommands(t *testing.T) {
+	t.Run("Save", func(t *testing.T) {
+		t.Run("records the creation of the host", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			input := &host.Host{ID: uuid.New(), Enabled: true}
+			delegate := host.NewMockedCommands(ctrl)
+			gomock.InOrder(
+				delegate.EXPECT().Get(t.Context(), input.ID).Return(nil, nil),
+				delegate.EXPECT().Save(t.Context(), input).Return(nil),
+				delegate.EXPECT().Get(t.Context(), input.ID).Return(input, nil),
+			)
+
+			var saved *Event
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				Save(t.Context(), gomock.Any()).
+				DoAndReturn(func(_ any, event *Event) error {
+					saved = event
+					return nil
+				})
+
+			err := decorateHostCommands(delegate, newRecorder(repository)).Save(t.Context(), input)
+
+			require.NoError(t, err)
+			assert.Equal(t, CreateAction, saved.Action)
+			assert.Equal(t, &input.ID, saved.EntityID)
+			assert.Nil(t, saved.Before)
+		})
+
+		t.Run("does not record anything when the save fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			input := &host.Host{ID: uuid.New()}
+			delegate := host.NewMockedCommands(ctrl)
+			delegate.EXPECT().Get(t.Context(), input.ID).Return(nil, nil)
+			delegate.EXPECT().Save(t.Context(), input).Return(assert.AnError)
+
+			repository := NewMockedRepository(ctrl)
+			err := decorateHostCommands(delegate, newRecorder(repository)).Save(t.Context(), input)
+
+			assert.Equal(t, assert.AnError, err)
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("records the deletion of the host", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			existing := &host.Host{ID: uuid.New()}
+			delegate := host.NewMockedCommands(ctrl)
+			gomock.InOrder(
+				delegate.EXPECT().Get(t.Context(), existing.ID).Return(existing, nil),
+				delegate.EXPECT().Delete(t.Context(), existing.ID).Return(nil),
+				delegate.EXPECT().Get(t.Context(), existing.ID).Return(nil, nil),
+			)
+
+			var saved *Event
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				Save(t.Context(), gomock.Any()).
+				DoAndReturn(func(_ any, event *Event) error {
+					saved = event
+					return nil
+				})
+
+			err := decorateHostCommands(
+				delegate,
+				newRecorder(repository),
+			).Delete(t.Context(), existing.ID)
+
+			require.NoError(t, err)
+			assert.Equal(t, DeleteAction, saved.Action)
+			assert.Nil(t, saved.After)
+		})
+	})
+}
+
+func Test_userCommands(t *testing.T) {
+	t.Run("Save", func(t *testing.T) {
+		t.Run("redacts the password fields", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			request := &user.SaveRequest{ID: uuid.New(), Password: new("password")}
+			stored := &user.User{ID: request.ID, PasswordHash: "hash", PasswordSalt: "salt"}
+			delegate := user.NewMockedCommands(ctrl)
+			delegate.EXPECT().Get(t.Context(), request.ID).Return(nil, nil)
+			delegate.EXPECT().Save(t.Context(), request, nil).Return(nil)
+			delegate.EXPECT().Get(t.Context(), request.ID).Return(stored, nil)
+
+			var saved *Event
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				Save(t.Context(), gomock.Any()).
+				DoAndReturn(func(_ any, event *Event) error {
+					saved = event
+					return nil
+				})
+
+			err := decorateUserCommands(
+				delegate,
+				newRecorder(repository),
+			).Save(t.Context(), request, nil)
+
+			require.NoError(t, err)
+			assert.NotContains(t, *saved.After, "hash")
+			assert.NotContains(t, *saved.After, `"salt"`)
+		})
+	})
+}
+
+func Test_certificateCommands(t *testing.T) {
+	t.Run("Save", func(t *testing.T) {
+		t.Run("redacts the sensitive ACME parameters", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			input := &certificate.Certificate{
+				ID:         uuid.New(),
+				ProviderID: "LETS_ENCRYPT",
+				Parameters: map[string]any{
+					"eabKeyId":   "key-id",
+					"eabHmacKey": "hmac-key-value",
+				},
+			}
+			provider := certificate.NewMockedProvider(ctrl)
+			provider.EXPECT().ID().Return("LETS_ENCRYPT").AnyTimes()
+			provider.EXPECT().
+				DynamicFields(t.Context()).
+				Return([]dynamicfields.DynamicField{
+					{ID: "eabKeyId"},
+					{ID: "eabHmacKey", Sensitive: true},
+				}).
+				AnyTimes()
+			delegate := certificate.NewMockedCommands(ctrl)
+			delegate.EXPECT().
+				AvailableProviders(t.Context()).
+				Return([]certificate.AvailableProvider{certificate.NewAvailableProvider(provider)}, nil)
+			delegate.EXPECT().Get(t.Context(), input.ID).Return(nil, nil)
+			delegate.EXPECT().Save(t.Context(), input).Return(nil)
+			delegate.EXPECT().Get(t.Context(), input.ID).Return(input, nil)
+
+			saved := expectSavedEvent(t, ctrl)
+			err := decorateCertificateCommands(delegate, saved.recorder).Save(t.Context(), input)
+
+			require.NoError(t, err)
+			assert.Contains(t, *saved.event.After, "key-id")
+			assert.NotContains(t, *saved.event.After, "hmac-key-value")
+			assert.Equal(t, "hmac-key-value", input.Parameters["eabHmacKey"])
+		})
+	})
+}
+
+func Test_vpnCommands(t *testing.T) {
+	drivers := []vpn.AvailableDriver{
+		{
+			ID: "TAILSCALE",
+			ConfigurationFields: []dynamicfields.DynamicField{
+				{ID: "authKey", Sensitive: true},
+				{ID: "coordinatorUrl"},
+			},
+		},
+		{
+			ID: "NETBIRD",
+			ConfigurationFields: []dynamicfields.DynamicField{
+				{ID: "setupKey", Sensitive: true},
+				{ID: "managementUrl"},
+			},
+		},
+	}
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("redacts the Tailscale auth key", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			input := &vpn.VPN{
+				ID:     uuid.New(),
+				Driver: "TAILSCALE",
+				Parameters: map[string]any{
+					"authKey":        "tskey-auth-value",
+					"coordinatorUrl": "https://login.example.com",
+				},
+			}
+			delegate := vpn.NewMockedCommands(ctrl)
+			delegate.EXPECT().GetAvailableDrivers(t.Context()).Return(drivers, nil)
+			delegate.EXPECT().Get(t.Context(), input.ID).Return(nil, nil)
+			delegate.EXPECT().Save(t.Context(), input).Return(nil)
+			delegate.EXPECT().Get(t.Context(), input.ID).Return(input, nil)
+
+			saved := expectSavedEvent(t, ctrl)
+			err := decorateVPNCommands(delegate, saved.recorder).Save(t.Context(), input)
+
+			require.NoError(t, err)
+			assert.Contains(t, *saved.event.After, "https://login.example.com")
+			assert.NotContains(t, *saved.event.After, "tskey-auth-value")
+		})
+
+		t.Run("redacts the NetBird setup key", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			input := &vpn.VPN{
+				ID:     uuid.New(),
+				Driver: "NETBIRD",
+				Parameters: map[string]any{
+					"setupKey":      "netbird-setup-value",
+					"managementUrl": "https://netbird.example.com",
+				},
+			}
+			delegate := vpn.NewMockedCommands(ctrl)
+			delegate.EXPECT().GetAvailableDrivers(t.Context()).Return(drivers, nil)
+			delegate.EXPECT().Get(t.Context(), input.ID).Return(nil, nil)
+			delegate.EXPECT().Save(t.Context(), input).Return(nil)
+			delegate.EXPECT().Get(t.Context(), input.ID).Return(input, nil)
+
+			saved := expectSavedEvent(t, ctrl)
+			err := decorateVPNCommands(delegate, saved.recorder).Save(t.Context(), input)
+
+			require.NoError(t, err)
+			assert.Contains(t, *saved.event.After, "https://netbird.example.com")
+			assert.NotContains(t, *saved.event.After, "netbird-setup-value")
+		})
+
+		t.Run("redacts every parameter when the driver is unknown", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			input := &vpn.VPN{
+				ID:         uuid.New(),
+				Driver:     "UNKNOWN",
+				Parameters: map[string]any{"credential": "unknown-value"},
+			}
+			delegate := vpn.NewMockedCommands(ctrl)
+			delegate.EXPECT().GetAvailableDrivers(t.Context()).Return(drivers, nil)
+			delegate.EXPECT().Get(t.Context(), input.ID).Return(nil, nil)
+			delegate.EXPECT().Save(t.Context(), input).Return(nil)
+			delegate.EXPECT().Get(t.Context(), input.ID).Return(input, nil)
+
+			saved := expectSavedEvent(t, ctrl)
+			err := decorateVPNCommands(delegate, saved.recorder).Save(t.Context(), input)
+
+			require.NoError(t, err)
+			assert.NotContains(t, *saved.event.After, "unknown-value")
+		})
+	})
+}
+
+type savedEvent struct {
+	recorder *recorder
+	event    *Event
+}
+
+func expectSavedEvent(t *testing.T, ctrl *gomock.Controller) *savedEvent {
+	t.Helper()
+
+	output := &savedEvent{}
+	repository := NewMockedRepository(ctrl)
+	repository.EXPECT().
+		Save(t.Context(), gomock.Any()).
+		DoAndReturn(func(_ any, event *Event) error {
+			output.event = event
+			return nil
+		})
+
+	output.recorder = newRecorder(repository)
+	return output
+}
+
+func Test_settingsCommands(t *testing.T) {
+	t.Run("Save", func(t *testing.T) {
+		t.Run("records the update of the settings", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			input := &settings.Settings{}
+			delegate := settings.NewMockedCommands(ctrl)
+			delegate.EXPECT().Get(t.Context()).Return(&settings.Settings{}, nil).Times(2)
+			delegate.EXPECT().Save(t.Context(), input).Return(nil)
+
+			var saved *Event
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				Save(t.Context(), gomock.Any()).
+				DoAndReturn(func(_ any, event *Event) error {
+					saved = event
+					return nil
+				})
+
+			err := decorateSettingsCommands(
+				delegate,
+				newRecorder(repository),
+			).Save(t.Context(), input)
+
+			require.NoError(t, err)
+			assert.Equal(t, UpdateAction, saved.Action)
+			assert.Equal(t, SettingsEntityType, saved.EntityType)
+			assert.Nil(t, saved.EntityID)
+		})
+	})
+}
+
+func Test_tlsProfileCommands(t *testing.T) {
+	t.Run("Save", func(t *testing.T) {
+		t.Run("records the update of the TLS profile", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			existing := &tlsprofile.TLSProfile{ID: uuid.New(), Name: "Intermediate"}
+			input := &tlsprofile.TLSProfile{ID: existing.ID, Name: "Modern"}
+			delegate := tlsprofile.NewMockedCommands(ctrl)
+			gomock.InOrder(
+				delegate.EXPECT().Get(t.Context(), input.ID).Return(existing, nil),
+				delegate.EXPECT().Save(t.Context(), input).Return(nil),
+				delegate.EXPECT().Get(t.Context(), input.ID).Return(input, nil),
+			)
+
+			saved := expectSavedEvent(t, ctrl)
+			err := decorateTLSProfileCommands(delegate, saved.recorder).Save(t.Context(), input)
+
+			require.NoError(t, err)
+			assert.Equal(t, UpdateAction, saved.event.Action)
+			assert.Equal(t, TLSProfileEntityType, saved.event.EntityType)
+			assert.Equal(t, &input.ID, saved.event.EntityID)
+		})
+	})
+}
+
+func Test_rateLimitCommands(t *testing.T) {
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("records the deletion of the rate limit", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			existing := &ratelimit.RateLimit{ID: uuid.New(), Name: "API"}
+			delegate := ratelimit.NewMockedCommands(ctrl)
+			gomock.InOrder(
+				delegate.EXPECT().Get(t.Context(), existing.ID).Return(existing, nil),
+				delegate.EXPECT().Delete(t.Context(), existing.ID).Return(nil),
+				delegate.EXPECT().Get(t.Context(), existing.ID).Return(nil, nil),
+			)
+
+			saved := expectSavedEvent(t, ctrl)
+			err := decorateRateLimitCommands(
+				delegate,
+				saved.recorder,
+			).Delete(t.Context(), existing.ID)
+
+			require.NoError(t, err)
+			assert.Equal(t, DeleteAction, saved.event.Action)
+			assert.Equal(t, RateLimitEntityType, saved.event.EntityType)
+			assert.Nil(t, saved.event.After)
+		})
+	})
+}
+
+func Test_securityHeadersCommands(t *testing.T) {
+	t.Run("Save", func(t *testing.T) {
+		t.Run("records the creation of the security headers profile", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			input := &securityheaders.SecurityHeaders{ID: uuid.New(), Name: "Strict"}
+			delegate := securityheaders.NewMockedCommands(ctrl)
+			gomock.InOrder(
+				delegate.EXPECT().Get(t.Context(), input.ID).Return(nil, nil),
+				delegate.EXPECT().Save(t.Context(), input).Return(nil),
+				delegate.EXPECT().Get(t.Context(), input.ID).Return(input, nil),
+			)
+
+			saved := expectSavedEvent(t, ctrl)
+			err := decorateSecurityHeadersCommands(
+				delegate,
+				saved.recorder,
+			).Save(t.Context(), input)
+
+			require.NoError(t, err)
+			assert.Equal(t, CreateAction, saved.event.Action)
+			assert.Equal(t, SecurityHeadersEntityType, saved.event.EntityType)
+			assert.Nil(t, saved.event.Before)
+		})
+	})
+}
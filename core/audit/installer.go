@@ -0,0 +1,27 @@
+package audit
+
+import (
+	"dillmann.com.br/nginx-ignition/core/common/container"
+)
+
+func Install() error {
+	if err := container.Provide(newCommands, newRecorder); err != nil {
+		return err
+	}
+
+	return container.Decorate(
+		decorateHostCommands,
+		decorateStreamCommands,
+		decorateCertificateCommands,
+		decorateUserCommands,
+		decorateAccessListCommands,
+		decorateCacheCommands,
+		decorateUpstreamCommands,
+		decorateIntegrationCommands,
+		decorateVPNCommands,
+		decorateSettingsCommands,
+		decorateTLSProfileCommands,
+		decorateRateLimitCommands,
+		decorateSecurityHeadersCommands,
+	)
+}
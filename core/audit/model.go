@@ -0,0 +1,59 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EntityType string
+
+const (
+	HostEntityType            EntityType = "HOST"
+	StreamEntityType          EntityType = "STREAM"
+	CertificateEntityType     EntityType = "CERTIFICATE"
+	UserEntityType            EntityType = "USER"
+	AccessListEntityType      EntityType = "ACCESS_LIST"
+	CacheEntityType           EntityType = "CACHE"
+	UpstreamEntityType        EntityType = "UPSTREAM"
+	IntegrationEntityType     EntityType = "INTEGRATION"
+	VPNEntityType             EntityType = "VPN"
+	SettingsEntityType        EntityType = "SETTINGS"
+	TLSProfileEntityType      EntityType = "TLS_PROFILE"
+	RateLimitEntityType       EntityType = "RATE_LIMIT"
+	SecurityHeadersEntityType EntityType = "SECURITY_HEADERS"
+)
+
+type Action string
+
+const (
+	CreateAction Action = "CREATE"
+	UpdateAction Action = "UPDATE"
+	DeleteAction Action = "DELETE"
+)
+
+type Event struct {
+	CreatedAt  time.Time
+	UserID     *uuid.UUID
+	SourceIP   *string
+	EntityID   *uuid.UUID
+	Before     *string
+	After      *string
+	EntityType EntityType
+	Action     Action
+	ID         uuid.UUID
+}
+
+type Filters struct {
+	UserID     *uuid.UUID
+	EntityType *EntityType
+	EntityID   *uuid.UUID
+	Action     *Action
+	From       *time.Time
+	To         *time.Time
+}
+
+type Actor struct {
+	SourceIP string
+	UserID   uuid.UUID
+}
@@ -0,0 +1,170 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/dynamicfields"
+)
+
+const redactedValue = "**REDACTED**"
+
+// sensitiveKeyFragments flags the fixed entity fields that hold secrets. The dynamic parameters of
+// certificates, integrations and VPNs are redacted by redactParameters instead.
+var sensitiveKeyFragments = []string{
+	"password",
+	"secret",
+	"token",
+	"salt",
+	"privatekey",
+	"apikey",
+	"accesskey",
+}
+
+type recorder struct {
+	repository Repository
+}
+
+func newRecorder(repository Repository) *recorder {
+	return &recorder{
+		repository: repository,
+	}
+}
+
+func (r *recorder) record(
+	ctx context.Context,
+	entityType EntityType,
+	entityID *uuid.UUID,
+	before, after any,
+) error {
+	beforeState, err := redact(before)
+	if err != nil {
+		return err
+	}
+
+	afterState, err := redact(after)
+	if err != nil {
+		return err
+	}
+
+	if beforeState == nil && afterState == nil {
+		return nil
+	}
+
+	var action Action
+	switch {
+	case beforeState == nil:
+		action = CreateAction
+	case afterState == nil:
+		action = DeleteAction
+	default:
+		action = UpdateAction
+	}
+
+	event := &Event{
+		ID:         uuid.New(),
+		CreatedAt:  time.Now(),
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Before:     beforeState,
+		After:      afterState,
+	}
+
+	if actor := actorFromContext(ctx); actor != nil {
+		event.UserID = &actor.UserID
+		event.SourceIP = &actor.SourceIP
+	}
+
+	return r.repository.Save(ctx, event)
+}
+
+func redact(value any) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() == reflect.Pointer && reflected.IsNil() {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded any
+	if err = json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, err
+	}
+
+	encoded, err = json.Marshal(redactValue(decoded))
+	if err != nil {
+		return nil, err
+	}
+
+	return new(string(encoded)), nil
+}
+
+func redactValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, item := range typed {
+			if isSensitiveKey(key) && item != nil {
+				typed[key] = redactedValue
+			} else {
+				typed[key] = redactValue(item)
+			}
+		}
+	case []any:
+		for index, item := range typed {
+			typed[index] = redactValue(item)
+		}
+	}
+
+	return value
+}
+
+// redactParameters returns a copy of the dynamic parameters with the values of the fields flagged
+// as sensitive redacted. When the field definitions are unknown (nil), every value is redacted.
+func redactParameters(
+	parameters map[string]any,
+	fields []dynamicfields.DynamicField,
+) map[string]any {
+	if parameters == nil {
+		return nil
+	}
+
+	sensitive := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		sensitive[field.ID] = field.Sensitive
+	}
+
+	output := make(map[string]any, len(parameters))
+	for key, value := range parameters {
+		if value != nil && (fields == nil || sensitive[key]) {
+			output[key] = redactedValue
+		} else {
+			output[key] = value
+		}
+	}
+
+	return output
+}
+
+func isSensitiveKey(key string) bool {
+	normalizedKey := strings.ToLower(key)
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(normalizedKey, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
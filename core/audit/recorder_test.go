@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/host"
+)
+
+func Test_recorder(t *testing.T) {
+	t.Run("record", func(t *testing.T) {
+		t.Run("saves an update with the actor from the context", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			entityID := uuid.New()
+			actor := &Actor{UserID: uuid.New(), SourceIP: "10.0.0.1"}
+			ctx := WithActor(t.Context(), actor)
+
+			var saved *Event
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				Save(ctx, gomock.Any()).
+				DoAndReturn(func(_ any, event *Event) error {
+					saved = event
+					return nil
+				})
+
+			err := newRecorder(repository).record(
+				ctx,
+				HostEntityType,
+				&entityID,
+				&host.Host{Enabled: false},
+				&host.Host{Enabled: true},
+			)
+
+			require.NoError(t, err)
+			assert.Equal(t, UpdateAction, saved.Action)
+			assert.Equal(t, HostEntityType, saved.EntityType)
+			assert.Equal(t, &entityID, saved.EntityID)
+			assert.Equal(t, &actor.UserID, saved.UserID)
+			assert.Equal(t, &actor.SourceIP, saved.SourceIP)
+			assert.Contains(t, *saved.Before, `"Enabled":false`)
+			assert.Contains(t, *saved.After, `"Enabled":true`)
+		})
+
+		t.Run("resolves the action from the states", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			actions := make([]Action, 0)
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				Save(t.Context(), gomock.Any()).
+				DoAndReturn(func(_ any, event *Event) error {
+					actions = append(actions, event.Action)
+					return nil
+				}).
+				Times(2)
+
+			r := newRecorder(repository)
+			require.NoError(
+				t,
+				r.record(t.Context(), HostEntityType, nil, (*host.Host)(nil), &host.Host{}),
+			)
+			require.NoError(
+				t,
+				r.record(t.Context(), HostEntityType, nil, &host.Host{}, (*host.Host)(nil)),
+			)
+
+			assert.Equal(t, []Action{CreateAction, DeleteAction}, actions)
+		})
+
+		t.Run("skips the recording when there is no state at all", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := NewMockedRepository(ctrl)
+			err := newRecorder(repository).record(t.Context(), HostEntityType, nil, nil, nil)
+
+			assert.NoError(t, err)
+		})
+	})
+}
+
+func Test_redact(t *testing.T) {
+	t.Run("replaces the sensitive values", func(t *testing.T) {
+		value := map[string]any{
+			"Name":         "example",
+			"PasswordHash": "hash",
+			"Parameters": map[string]any{
+				"apiToken": "token",
+				"zone":     "example.com",
+			},
+			"Credentials": []any{
+				map[string]any{"Username": "user", "Password": "password"},
+			},
+		}
+
+		result, err := redact(value)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"Name": "example",
+			"PasswordHash": "**REDACTED**",
+			"Parameters": {"apiToken": "**REDACTED**", "zone": "example.com"},
+			"Credentials": [{"Username": "user", "Password": "**REDACTED**"}]
+		}`, *result)
+	})
+
+	t.Run("returns nil for nil values", func(t *testing.T) {
+		result, err := redact((*host.Host)(nil))
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,16 @@
+package audit
+
+import (
+	"context"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type Repository interface {
+	Save(ctx context.Context, event *Event) error
+	FindPage(
+		ctx context.Context,
+		pageNumber, pageSize int,
+		filters *Filters,
+	) (*pagination.Page[Event], error)
+}
@@ -0,0 +1,26 @@
+package audit
+
+import (
+	"context"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type service struct {
+	repository Repository
+}
+
+func newCommands(repository Repository) Commands {
+	return &service{
+		repository: repository,
+	}
+}
+
+func (s *service) List(
+	ctx context.Context,
+	pageSize,
+	pageNumber int,
+	filters *Filters,
+) (*pagination.Page[Event], error) {
+	return s.repository.FindPage(ctx, pageNumber, pageSize, filters)
+}
@@ -0,0 +1,30 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+func Test_service(t *testing.T) {
+	t.Run("List", func(t *testing.T) {
+		t.Run("returns the page from the repository", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			filters := &Filters{Action: new(DeleteAction)}
+			expected := pagination.Of([]Event{{Action: DeleteAction}})
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindPage(t.Context(), 2, 10, filters).Return(expected, nil)
+
+			result, err := newCommands(repository).List(t.Context(), 10, 2, filters)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+	})
+}
@@ -1,5 +1,13 @@
 package backup
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
 func newBackup() *Backup {
 	return &Backup{
 		FileName:    "backup.db",
@@ -7,3 +15,25 @@ func newBackup() *Backup {
 		Contents:    []byte("test content"),
 	}
 }
+
+func newRun() *Run {
+	return &Run{
+		ID:          uuid.New(),
+		StartedAt:   time.Now().UTC(),
+		FinishedAt:  time.Now().UTC(),
+		Status:      SucceededRunStatus,
+		Destination: settings.LocalBackupDestination,
+		FileName:    new("nginx-ignition-backup-20260101T000000Z.db"),
+		SizeBytes:   12,
+	}
+}
+
+func newBackupSettings(localPath string) *settings.BackupSettings {
+	return &settings.BackupSettings{
+		Enabled:           true,
+		Destination:       settings.LocalBackupDestination,
+		LocalPath:         &localPath,
+		IntervalUnit:      settings.DaysTimeUnit,
+		IntervalUnitCount: 1,
+	}
+}
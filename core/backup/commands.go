@@ -1,7 +1,14 @@
 package backup
 
-import "context"
+import (
+	"context"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
 
 type Commands interface {
 	Get(ctx context.Context) (*Backup, error)
+	ListRuns(ctx context.Context, pageSize, pageNumber int) (*pagination.Page[Run], error)
+	GetLatestSuccessfulRun(ctx context.Context) (*Run, error)
+	Restore(ctx context.Context, contents []byte) error
 }
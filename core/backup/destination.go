@@ -0,0 +1,40 @@
+package backup
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+const (
+	destinationTimeout = 10 * time.Minute
+)
+
+type destination interface {
+	write(ctx context.Context, fileName string, contents []byte) error
+	list(ctx context.Context) ([]string, error)
+	delete(ctx context.Context, fileName string) error
+}
+
+func newDestination(ctx context.Context, cfg *settings.BackupSettings) (destination, error) {
+	switch cfg.Destination {
+	case settings.LocalBackupDestination:
+		if cfg.LocalPath == nil {
+			break
+		}
+
+		return &localDestination{*cfg.LocalPath}, nil
+	case settings.S3BackupDestination:
+		if cfg.S3 == nil {
+			break
+		}
+
+		return newS3Destination(cfg.S3, &http.Client{Timeout: destinationTimeout}), nil
+	}
+
+	return nil, coreerror.New(i18n.M(ctx, i18n.K.CoreBackupInvalidDestination), false)
+}
@@ -2,8 +2,25 @@ package backup
 
 import (
 	"dillmann.com.br/nginx-ignition/core/common/container"
+	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+	"dillmann.com.br/nginx-ignition/core/settings"
 )
 
 func Install() error {
-	return container.Provide(newCommands)
+	if err := container.Provide(newCommands); err != nil {
+		return err
+	}
+
+	return container.Run(registerScheduledTask)
+}
+
+func newCommands(
+	repository Repository,
+	settingsCommands settings.Commands,
+	nginxCommands nginx.Commands,
+	sched *scheduler.Scheduler,
+) (Commands, *service) {
+	serviceInstance := newService(repository, settingsCommands, nginxCommands, sched)
+	return serviceInstance, serviceInstance
 }
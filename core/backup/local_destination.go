@@ -0,0 +1,46 @@
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+)
+
+type localDestination struct {
+	path string
+}
+
+func (d *localDestination) write(_ context.Context, fileName string, contents []byte) error {
+	tempPath := filepath.Join(d.path, "."+fileName)
+	if err := os.WriteFile(tempPath, contents, 0o600); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tempPath, filepath.Join(d.path, fileName)); err != nil {
+		//nolint:errcheck
+		os.Remove(tempPath)
+		return err
+	}
+
+	return nil
+}
+
+func (d *localDestination) list(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(d.path)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
+
+func (d *localDestination) delete(_ context.Context, fileName string) error {
+	return os.Remove(filepath.Join(d.path, fileName))
+}
@@ -1,7 +1,33 @@
 package backup
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
 type Backup struct {
 	FileName    string
 	ContentType string
 	Contents    []byte
 }
+
+type Run struct {
+	StartedAt    time.Time
+	FinishedAt   time.Time
+	FileName     *string
+	ErrorMessage *string
+	Status       RunStatus
+	Destination  settings.BackupDestination
+	SizeBytes    int64
+	ID           uuid.UUID
+}
+
+type RunStatus string
+
+const (
+	SucceededRunStatus RunStatus = "SUCCEEDED"
+	FailedRunStatus    RunStatus = "FAILED"
+)
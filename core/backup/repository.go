@@ -1,7 +1,16 @@
 package backup
 
-import "context"
+import (
+	"context"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
 
 type Repository interface {
 	Get(ctx context.Context) (*Backup, error)
+	SaveRun(ctx context.Context, run *Run) error
+	FindRunsPage(ctx context.Context, pageNumber, pageSize int) (*pagination.Page[Run], error)
+	FindLatestSuccessfulRun(ctx context.Context) (*Run, error)
+	DeleteOldestRuns(ctx context.Context, amountToKeep int) error
+	Restore(ctx context.Context, contents []byte) error
 }
@@ -0,0 +1,68 @@
+package backup
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+const (
+	fileNamePrefix          = "nginx-ignition-backup-"
+	fileNameTimestampLayout = "20060102T150405Z"
+)
+
+type storedFile struct {
+	createdAt time.Time
+	name      string
+}
+
+func buildFileName(backup *Backup, createdAt time.Time) string {
+	return fileNamePrefix +
+		createdAt.UTC().Format(fileNameTimestampLayout) +
+		filepath.Ext(backup.FileName)
+}
+
+func parseFileName(name string) (*storedFile, bool) {
+	suffix, found := strings.CutPrefix(name, fileNamePrefix)
+	if !found {
+		return nil, false
+	}
+
+	timestamp, _, _ := strings.Cut(suffix, ".")
+	createdAt, err := time.Parse(fileNameTimestampLayout, timestamp)
+	if err != nil {
+		return nil, false
+	}
+
+	return &storedFile{createdAt, name}, true
+}
+
+func expiredFiles(names []string, cfg *settings.BackupSettings, now time.Time) []string {
+	files := make([]storedFile, 0, len(names))
+	for _, name := range names {
+		if file, valid := parseFileName(name); valid {
+			files = append(files, *file)
+		}
+	}
+
+	slices.SortFunc(files, func(left, right storedFile) int {
+		return right.createdAt.Compare(left.createdAt)
+	})
+
+	maximumAge := time.Duration(cfg.MaximumAgeDays) * 24 * time.Hour
+	expired := make([]string, 0)
+
+	for index, file := range files {
+		exceedsCount := cfg.MaximumCount > 0 && index >= cfg.MaximumCount
+		exceedsAge := cfg.MaximumAgeDays > 0 && now.Sub(file.createdAt) > maximumAge
+
+		if exceedsCount || exceedsAge {
+			expired = append(expired, file.name)
+		}
+	}
+
+	return expired
+}
@@ -0,0 +1,79 @@
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_retention(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	t.Run("buildFileName", func(t *testing.T) {
+		t.Run("uses the timestamp and the original extension", func(t *testing.T) {
+			result := buildFileName(newBackup(), now)
+
+			assert.Equal(t, "nginx-ignition-backup-20261018T120000Z.db", result)
+		})
+	})
+
+	t.Run("parseFileName", func(t *testing.T) {
+		t.Run("parses the backup timestamp", func(t *testing.T) {
+			result, valid := parseFileName("nginx-ignition-backup-20261018T120000Z.sql")
+
+			assert.True(t, valid)
+			assert.Equal(t, now, result.createdAt)
+		})
+
+		t.Run("ignores files from other sources", func(t *testing.T) {
+			for _, name := range []string{
+				"notes.txt",
+				"nginx-ignition-backup-latest.db",
+				".nginx-ignition-backup-20261018T120000Z.db",
+			} {
+				_, valid := parseFileName(name)
+				assert.False(t, valid, name)
+			}
+		})
+	})
+
+	t.Run("expiredFiles", func(t *testing.T) {
+		names := []string{
+			"nginx-ignition-backup-20261016T120000Z.db",
+			"nginx-ignition-backup-20261018T120000Z.db",
+			"notes.txt",
+			"nginx-ignition-backup-20261010T120000Z.db",
+			"nginx-ignition-backup-20261017T120000Z.db",
+		}
+
+		t.Run("keeps everything when retention is unlimited", func(t *testing.T) {
+			cfg := newBackupSettings(t.TempDir())
+
+			result := expiredFiles(names, cfg, now)
+
+			assert.Empty(t, result)
+		})
+
+		t.Run("removes the oldest files beyond the maximum count", func(t *testing.T) {
+			cfg := newBackupSettings(t.TempDir())
+			cfg.MaximumCount = 2
+
+			result := expiredFiles(names, cfg, now)
+
+			assert.Equal(t, []string{
+				"nginx-ignition-backup-20261016T120000Z.db",
+				"nginx-ignition-backup-20261010T120000Z.db",
+			}, result)
+		})
+
+		t.Run("removes the files older than the maximum age", func(t *testing.T) {
+			cfg := newBackupSettings(t.TempDir())
+			cfg.MaximumAgeDays = 3
+
+			result := expiredFiles(names, cfg, now)
+
+			assert.Equal(t, []string{"nginx-ignition-backup-20261010T120000Z.db"}, result)
+		})
+	})
+}
@@ -0,0 +1,179 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+const (
+	s3ErrorBodyLimit = 4096
+)
+
+type s3Destination struct {
+	client *http.Client
+	signer *s3Signer
+	prefix string
+	bucket string
+	host   string
+	scheme string
+}
+
+type s3ListResult struct {
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated bool `xml:"IsTruncated"`
+}
+
+type s3ErrorResponse struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func newS3Destination(cfg *settings.BackupS3Settings, client *http.Client) *s3Destination {
+	endpoint, _ := url.Parse(cfg.Endpoint)
+
+	prefix := ""
+	if cfg.Prefix != nil {
+		prefix = strings.Trim(*cfg.Prefix, "/")
+		if prefix != "" {
+			prefix += "/"
+		}
+	}
+
+	secretKey := ""
+	if cfg.SecretKey != nil {
+		secretKey = *cfg.SecretKey
+	}
+
+	return &s3Destination{
+		client: client,
+		signer: &s3Signer{
+			accessKey: cfg.AccessKey,
+			secretKey: secretKey,
+			region:    cfg.Region,
+		},
+		prefix: prefix,
+		bucket: cfg.Bucket,
+		host:   endpoint.Host,
+		scheme: endpoint.Scheme,
+	}
+}
+
+func (d *s3Destination) write(ctx context.Context, fileName string, contents []byte) error {
+	_, err := d.execute(ctx, http.MethodPut, d.objectPath(fileName), nil, contents)
+	return err
+}
+
+func (d *s3Destination) list(ctx context.Context) ([]string, error) {
+	names := make([]string, 0)
+	continuationToken := ""
+
+	for {
+		query := url.Values{
+			"list-type": {"2"},
+			"prefix":    {d.prefix},
+		}
+
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		body, err := d.execute(ctx, http.MethodGet, "/"+d.bucket, query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result s3ListResult
+		if err = xml.Unmarshal(body, &result); err != nil {
+			return nil, err
+		}
+
+		for _, object := range result.Contents {
+			name := strings.TrimPrefix(object.Key, d.prefix)
+			if !strings.Contains(name, "/") {
+				names = append(names, name)
+			}
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return names, nil
+		}
+
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+func (d *s3Destination) delete(ctx context.Context, fileName string) error {
+	_, err := d.execute(ctx, http.MethodDelete, d.objectPath(fileName), nil, nil)
+	return err
+}
+
+func (d *s3Destination) objectPath(fileName string) string {
+	return "/" + d.bucket + "/" + d.prefix + fileName
+}
+
+func (d *s3Destination) execute(
+	ctx context.Context,
+	method, path string,
+	query url.Values,
+	payload []byte,
+) ([]byte, error) {
+	target := &url.URL{
+		Scheme:   d.scheme,
+		Host:     d.host,
+		Path:     path,
+		RawPath:  escapePath(path),
+		RawQuery: canonicalQuery(query),
+	}
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		method,
+		target.String(),
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	d.signer.sign(request, payload)
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:errcheck
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, s3ErrorBodyLimit))
+		return nil, buildS3Error(response.StatusCode, body)
+	}
+
+	return io.ReadAll(response.Body)
+}
+
+func buildS3Error(statusCode int, body []byte) error {
+	var details s3ErrorResponse
+	if err := xml.Unmarshal(body, &details); err != nil || details.Code == "" {
+		return fmt.Errorf("S3 request failed with status %d", statusCode)
+	}
+
+	return fmt.Errorf(
+		"S3 request failed with status %d: %s (%s)",
+		statusCode,
+		details.Message,
+		details.Code,
+	)
+}
@@ -0,0 +1,182 @@
+package backup
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+type s3StandIn struct {
+	objects map[string][]byte
+	bucket  string
+	mutex   sync.Mutex
+}
+
+func newS3StandIn(t *testing.T) (*s3StandIn, *settings.BackupS3Settings) {
+	standIn := &s3StandIn{
+		objects: make(map[string][]byte),
+		bucket:  "backups",
+	}
+
+	server := httptest.NewServer(standIn)
+	t.Cleanup(server.Close)
+
+	return standIn, &settings.BackupS3Settings{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		Bucket:    "backups",
+		Prefix:    new("/nginx-ignition/"),
+		AccessKey: "access-key",
+		SecretKey: new("secret-key"),
+	}
+}
+
+func (s *s3StandIn) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	authorization := request.Header.Get("Authorization")
+	if !strings.Contains(authorization, "Credential=access-key/") ||
+		!strings.Contains(authorization, "/us-east-1/s3/aws4_request") ||
+		request.Header.Get("X-Amz-Date") == "" {
+		s.fail(writer, http.StatusForbidden, "AccessDenied")
+		return
+	}
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(request.URL.Path, "/"), "/")
+	if bucket != s.bucket {
+		s.fail(writer, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch request.Method {
+	case http.MethodPut:
+		contents, _ := io.ReadAll(request.Body)
+		s.objects[key] = contents
+	case http.MethodDelete:
+		delete(s.objects, key)
+		writer.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		s.list(writer, request)
+	}
+}
+
+func (s *s3StandIn) list(writer http.ResponseWriter, request *http.Request) {
+	keys := make([]string, 0)
+	for key := range s.objects {
+		if strings.HasPrefix(key, request.URL.Query().Get("prefix")) {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	offset, _ := strconv.Atoi(request.URL.Query().Get("continuation-token"))
+	end := min(offset+1, len(keys))
+
+	var result struct {
+		XMLName               xml.Name `xml:"ListBucketResult"`
+		NextContinuationToken string   `xml:"NextContinuationToken,omitempty"`
+		Contents              []struct {
+			Key string `xml:"Key"`
+		} `xml:"Contents"`
+		IsTruncated bool `xml:"IsTruncated"`
+	}
+
+	for _, key := range keys[offset:end] {
+		result.Contents = append(result.Contents, struct {
+			Key string `xml:"Key"`
+		}{key})
+	}
+
+	if end < len(keys) {
+		result.IsTruncated = true
+		result.NextContinuationToken = strconv.Itoa(end)
+	}
+
+	body, _ := xml.Marshal(result)
+	_, _ = writer.Write(body)
+}
+
+func (s *s3StandIn) fail(writer http.ResponseWriter, status int, code string) {
+	writer.WriteHeader(status)
+	_, _ = writer.Write([]byte(
+		"<Error><Code>" + code + "</Code><Message>Request failed</Message></Error>",
+	))
+}
+
+func Test_s3Destination(t *testing.T) {
+	t.Run("write", func(t *testing.T) {
+		t.Run("uploads the object under the prefix", func(t *testing.T) {
+			standIn, cfg := newS3StandIn(t)
+			target := newS3Destination(cfg, http.DefaultClient)
+
+			err := target.write(t.Context(), "backup.db", []byte("contents"))
+
+			require.NoError(t, err)
+			assert.Equal(t, []byte("contents"), standIn.objects["nginx-ignition/backup.db"])
+		})
+
+		t.Run("returns the S3 error details", func(t *testing.T) {
+			_, cfg := newS3StandIn(t)
+			cfg.Bucket = "unknown"
+			target := newS3Destination(cfg, http.DefaultClient)
+
+			err := target.write(t.Context(), "backup.db", []byte("contents"))
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "NoSuchBucket")
+			assert.Contains(t, err.Error(), "404")
+		})
+
+		t.Run("fails when the request is not signed with the credentials", func(t *testing.T) {
+			_, cfg := newS3StandIn(t)
+			cfg.AccessKey = "other"
+			target := newS3Destination(cfg, http.DefaultClient)
+
+			err := target.write(t.Context(), "backup.db", []byte("contents"))
+
+			assert.ErrorContains(t, err, "AccessDenied")
+		})
+	})
+
+	t.Run("list", func(t *testing.T) {
+		t.Run("returns the objects of every page inside the prefix", func(t *testing.T) {
+			standIn, cfg := newS3StandIn(t)
+			standIn.objects["nginx-ignition/first.db"] = nil
+			standIn.objects["nginx-ignition/second.db"] = nil
+			standIn.objects["nginx-ignition/nested/third.db"] = nil
+			standIn.objects["other/fourth.db"] = nil
+			target := newS3Destination(cfg, http.DefaultClient)
+
+			result, err := target.list(t.Context())
+
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{"first.db", "second.db"}, result)
+		})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Run("removes the object", func(t *testing.T) {
+			standIn, cfg := newS3StandIn(t)
+			standIn.objects["nginx-ignition/backup.db"] = []byte("contents")
+			target := newS3Destination(cfg, http.DefaultClient)
+
+			err := target.delete(t.Context(), "backup.db")
+
+			require.NoError(t, err)
+			assert.Empty(t, standIn.objects)
+		})
+	})
+}
@@ -0,0 +1,119 @@
+package backup
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+	s3ServiceName      = "s3"
+	s3DateLayout       = "20060102"
+	s3TimestampLayout  = "20060102T150405Z"
+	s3SignedHeaders    = "host;x-amz-content-sha256;x-amz-date"
+)
+
+type s3Signer struct {
+	accessKey string
+	secretKey string
+	region    string
+}
+
+func (s *s3Signer) sign(request *http.Request, payload []byte) {
+	timestamp := time.Now().UTC()
+	payloadHash := sha256Hex(payload)
+	amzDate := timestamp.Format(s3TimestampLayout)
+
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		"host:" + request.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		s3SignedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{
+		timestamp.Format(s3DateLayout),
+		s.region,
+		s3ServiceName,
+		"aws4_request",
+	}, "/")
+
+	stringToSign := strings.Join([]string{
+		s3SigningAlgorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), timestamp.Format(s3DateLayout))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s3ServiceName)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	request.Header.Set(
+		"Authorization",
+		s3SigningAlgorithm+
+			" Credential="+s.accessKey+"/"+scope+
+			", SignedHeaders="+s3SignedHeaders+
+			", Signature="+signature,
+	)
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		for _, value := range query[key] {
+			parts = append(parts, escapeValue(key)+"="+escapeValue(value))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for index, segment := range segments {
+		segments[index] = escapeValue(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func escapeValue(value string) string {
+	escaped := url.QueryEscape(value)
+	escaped = strings.ReplaceAll(escaped, "+", "%20")
+	return strings.ReplaceAll(escaped, "%7E", "~")
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,47 @@
+package backup
+
+import (
+	"encoding/hex"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_s3Signer(t *testing.T) {
+	t.Run("canonicalQuery", func(t *testing.T) {
+		t.Run("sorts and escapes the parameters", func(t *testing.T) {
+			query := url.Values{
+				"prefix":    {"my backups/"},
+				"list-type": {"2"},
+			}
+
+			result := canonicalQuery(query)
+
+			assert.Equal(t, "list-type=2&prefix=my%20backups%2F", result)
+		})
+	})
+
+	t.Run("escapePath", func(t *testing.T) {
+		t.Run("escapes each segment and keeps the separators", func(t *testing.T) {
+			result := escapePath("/bucket/my backups/file~1.db")
+
+			assert.Equal(t, "/bucket/my%20backups/file~1.db", result)
+		})
+	})
+
+	t.Run("hmacSHA256", func(t *testing.T) {
+		t.Run("derives the AWS documented signing key", func(t *testing.T) {
+			key := hmacSHA256([]byte("AWS4wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"), "20120215")
+			key = hmacSHA256(key, "us-east-1")
+			key = hmacSHA256(key, "iam")
+			key = hmacSHA256(key, "aws4_request")
+
+			assert.Equal(
+				t,
+				"f4780e2d9f65fa895f9c67b32ce1baf0b0d8a43505a000a1a9e090d414db404d",
+				hex.EncodeToString(key),
+			)
+		})
+	})
+}
@@ -0,0 +1,69 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+type scheduledTask struct {
+	service          *service
+	settingsCommands settings.Commands
+}
+
+func registerScheduledTask(
+	ctx context.Context,
+	service *service,
+	settingsCommands settings.Commands,
+	sched *scheduler.Scheduler,
+) error {
+	task := scheduledTask{service, settingsCommands}
+	return sched.Register(ctx, &task)
+}
+
+func (t scheduledTask) Run(ctx context.Context) error {
+	return t.service.createScheduledBackup(ctx)
+}
+
+func (t scheduledTask) Schedule(ctx context.Context) (*scheduler.Schedule, error) {
+	cfg, err := t.settingsCommands.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var interval time.Duration
+
+	backupCfg := cfg.Backup
+	switch backupCfg.IntervalUnit {
+	case settings.MinutesTimeUnit:
+		interval = time.Minute * time.Duration(backupCfg.IntervalUnitCount)
+	case settings.HoursTimeUnit:
+		interval = time.Hour * time.Duration(backupCfg.IntervalUnitCount)
+	case settings.DaysTimeUnit:
+		interval = time.Hour * 24 * time.Duration(backupCfg.IntervalUnitCount)
+	default:
+		return nil, coreerror.New(i18n.M(ctx, i18n.K.CommonInvalidIntervalUnit), false)
+	}
+
+	return &scheduler.Schedule{
+		Enabled:  backupCfg.Enabled,
+		Interval: interval,
+	}, nil
+}
+
+func (t scheduledTask) OnScheduleStarted(ctx context.Context) {
+	schedule, err := t.Schedule(ctx)
+	if err != nil {
+		return
+	}
+
+	log.Infof(
+		"Backup task scheduled to run every %v minutes",
+		schedule.Interval.Minutes(),
+	)
+}
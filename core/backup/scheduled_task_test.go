@@ -0,0 +1,61 @@
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+func Test_scheduledTask(t *testing.T) {
+	t.Run("Schedule", func(t *testing.T) {
+		t.Run("converts the interval to duration correctly", func(t *testing.T) {
+			scenarios := map[settings.TimeUnit]time.Duration{
+				settings.MinutesTimeUnit: 3 * time.Minute,
+				settings.HoursTimeUnit:   3 * time.Hour,
+				settings.DaysTimeUnit:    3 * 24 * time.Hour,
+			}
+
+			for unit, expected := range scenarios {
+				ctrl := gomock.NewController(t)
+
+				cfg := newBackupSettings(t.TempDir())
+				cfg.IntervalUnit = unit
+				cfg.IntervalUnitCount = 3
+
+				settingsCommands := settings.NewMockedCommands(ctrl)
+				settingsCommands.EXPECT().
+					Get(t.Context()).
+					Return(&settings.Settings{Backup: cfg}, nil)
+
+				task := &scheduledTask{settingsCommands: settingsCommands}
+				schedule, err := task.Schedule(t.Context())
+
+				assert.NoError(t, err)
+				assert.True(t, schedule.Enabled)
+				assert.Equal(t, expected, schedule.Interval)
+				ctrl.Finish()
+			}
+		})
+
+		t.Run("returns error for invalid interval unit", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfg := newBackupSettings(t.TempDir())
+			cfg.IntervalUnit = "WEEKS"
+
+			settingsCommands := settings.NewMockedCommands(ctrl)
+			settingsCommands.EXPECT().Get(t.Context()).Return(&settings.Settings{Backup: cfg}, nil)
+
+			task := &scheduledTask{settingsCommands: settingsCommands}
+			schedule, err := task.Schedule(t.Context())
+
+			assert.Error(t, err)
+			assert.Nil(t, schedule)
+		})
+	})
+}
@@ -2,18 +2,151 @@ package backup
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+const (
+	maximumRunRecords = 100
 )
 
 type service struct {
-	repository Repository
+	repository       Repository
+	settingsCommands settings.Commands
+	nginxCommands    nginx.Commands
+	scheduler        *scheduler.Scheduler
 }
 
-func newCommands(repository Repository) Commands {
+func newService(
+	repository Repository,
+	settingsCommands settings.Commands,
+	nginxCommands nginx.Commands,
+	sched *scheduler.Scheduler,
+) *service {
 	return &service{
-		repository: repository,
+		repository:       repository,
+		settingsCommands: settingsCommands,
+		nginxCommands:    nginxCommands,
+		scheduler:        sched,
 	}
 }
 
 func (s *service) Get(ctx context.Context) (*Backup, error) {
 	return s.repository.Get(ctx)
 }
+
+func (s *service) ListRuns(
+	ctx context.Context,
+	pageSize, pageNumber int,
+) (*pagination.Page[Run], error) {
+	return s.repository.FindRunsPage(ctx, pageNumber, pageSize)
+}
+
+func (s *service) GetLatestSuccessfulRun(ctx context.Context) (*Run, error) {
+	return s.repository.FindLatestSuccessfulRun(ctx)
+}
+
+func (s *service) Restore(ctx context.Context, contents []byte) error {
+	if len(contents) == 0 {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreBackupEmptyFile), true)
+	}
+
+	if err := s.repository.Restore(ctx, contents); err != nil {
+		return err
+	}
+
+	log.Infof("Database restored from a backup file, reloading the scheduled tasks and nginx")
+
+	if err := s.scheduler.Reload(ctx); err != nil {
+		return err
+	}
+
+	return s.nginxCommands.Reload(ctx, false)
+}
+
+func (s *service) createScheduledBackup(ctx context.Context) error {
+	cfg, err := s.settingsCommands.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	run := &Run{
+		ID:          uuid.New(),
+		StartedAt:   time.Now().UTC(),
+		Destination: cfg.Backup.Destination,
+	}
+
+	fileName, size, runErr := s.storeBackup(ctx, cfg.Backup, run.StartedAt)
+	run.FinishedAt = time.Now().UTC()
+
+	if runErr != nil {
+		run.Status = FailedRunStatus
+		run.ErrorMessage = new(runErr.Error())
+	} else {
+		run.Status = SucceededRunStatus
+		run.FileName = &fileName
+		run.SizeBytes = size
+	}
+
+	if err = s.repository.SaveRun(ctx, run); err != nil {
+		return err
+	}
+
+	if err = s.repository.DeleteOldestRuns(ctx, maximumRunRecords); err != nil {
+		return err
+	}
+
+	return runErr
+}
+
+func (s *service) storeBackup(
+	ctx context.Context,
+	cfg *settings.BackupSettings,
+	createdAt time.Time,
+) (string, int64, error) {
+	target, err := newDestination(ctx, cfg)
+	if err != nil {
+		return "", 0, err
+	}
+
+	backup, err := s.repository.Get(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	fileName := buildFileName(backup, createdAt)
+	if err = target.write(ctx, fileName, backup.Contents); err != nil {
+		return "", 0, err
+	}
+
+	s.applyRetention(ctx, target, cfg, createdAt)
+	return fileName, int64(len(backup.Contents)), nil
+}
+
+func (s *service) applyRetention(
+	ctx context.Context,
+	target destination,
+	cfg *settings.BackupSettings,
+	now time.Time,
+) {
+	names, err := target.list(ctx)
+	if err != nil {
+		log.Warnf("Unable to list the existing backups to apply the retention policy: %s", err)
+		return
+	}
+
+	for _, name := range expiredFiles(names, cfg, now) {
+		if err = target.delete(ctx, name); err != nil {
+			log.Warnf("Unable to delete the expired backup %s: %s", name, err)
+		}
+	}
+}
@@ -2,10 +2,18 @@ package backup
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+	"dillmann.com.br/nginx-ignition/core/settings"
 )
 
 func Test_service(t *testing.T) {
@@ -19,7 +27,7 @@ func Test_service(t *testing.T) {
 			repository := NewMockedRepository(ctrl)
 			repository.EXPECT().Get(t.Context()).Return(expected, nil)
 
-			backupService := newCommands(repository)
+			backupService := newService(repository, nil, nil, nil)
 			result, err := backupService.Get(t.Context())
 
 			assert.NoError(t, err)
@@ -35,7 +43,7 @@ func Test_service(t *testing.T) {
 			repository := NewMockedRepository(ctrl)
 			repository.EXPECT().Get(t.Context()).Return(nil, expectedErr)
 
-			backupService := newCommands(repository)
+			backupService := newService(repository, nil, nil, nil)
 			result, err := backupService.Get(t.Context())
 
 			assert.Error(t, err)
@@ -43,4 +51,220 @@ func Test_service(t *testing.T) {
 			assert.Equal(t, expectedErr, err)
 		})
 	})
+
+	t.Run("ListRuns", func(t *testing.T) {
+		t.Run("returns the page from the repository", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := pagination.Of([]Run{*newRun()})
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindRunsPage(t.Context(), 2, 10).Return(expected, nil)
+
+			backupService := newService(repository, nil, nil, nil)
+			result, err := backupService.ListRuns(t.Context(), 10, 2)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+	})
+
+	t.Run("GetLatestSuccessfulRun", func(t *testing.T) {
+		t.Run("returns the run from the repository", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := newRun()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindLatestSuccessfulRun(t.Context()).Return(expected, nil)
+
+			backupService := newService(repository, nil, nil, nil)
+			result, err := backupService.GetLatestSuccessfulRun(t.Context())
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		t.Run("restores the contents and reloads nginx", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			contents := []byte("backup contents")
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Restore(t.Context(), contents).Return(nil)
+
+			nginxCommands := nginx.NewMockedCommands(ctrl)
+			nginxCommands.EXPECT().Reload(t.Context(), false).Return(nil)
+
+			backupService := newService(repository, nil, nginxCommands, &scheduler.Scheduler{})
+			err := backupService.Restore(t.Context(), contents)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("returns error when the file is empty", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := NewMockedRepository(ctrl)
+
+			backupService := newService(repository, nil, nil, nil)
+			err := backupService.Restore(t.Context(), nil)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("does not reload nginx when the restore fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expectedErr := errors.New("restore error")
+			contents := []byte("backup contents")
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Restore(t.Context(), contents).Return(expectedErr)
+
+			nginxCommands := nginx.NewMockedCommands(ctrl)
+
+			backupService := newService(repository, nil, nginxCommands, &scheduler.Scheduler{})
+			err := backupService.Restore(t.Context(), contents)
+
+			assert.Equal(t, expectedErr, err)
+		})
+	})
+
+	t.Run("createScheduledBackup", func(t *testing.T) {
+		t.Run("writes the backup, applies retention and records the run", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			folder := t.TempDir()
+			expiredFile := filepath.Join(folder, "nginx-ignition-backup-20200101T000000Z.db")
+			unrelatedFile := filepath.Join(folder, "notes.txt")
+			require.NoError(t, os.WriteFile(expiredFile, nil, 0o600))
+			require.NoError(t, os.WriteFile(unrelatedFile, nil, 0o600))
+
+			cfg := newBackupSettings(folder)
+			cfg.MaximumCount = 1
+
+			var recorded *Run
+
+			settingsCommands := settings.NewMockedCommands(ctrl)
+			settingsCommands.EXPECT().Get(t.Context()).Return(&settings.Settings{Backup: cfg}, nil)
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Get(t.Context()).Return(newBackup(), nil)
+			repository.EXPECT().SaveRun(t.Context(), gomock.Any()).DoAndReturn(
+				func(_ any, run *Run) error {
+					recorded = run
+					return nil
+				},
+			)
+			repository.EXPECT().DeleteOldestRuns(t.Context(), maximumRunRecords).Return(nil)
+
+			backupService := newService(repository, settingsCommands, nil, nil)
+			err := backupService.createScheduledBackup(t.Context())
+
+			require.NoError(t, err)
+			require.NotNil(t, recorded)
+			assert.Equal(t, SucceededRunStatus, recorded.Status)
+			assert.Equal(t, settings.LocalBackupDestination, recorded.Destination)
+			assert.Equal(t, int64(len(newBackup().Contents)), recorded.SizeBytes)
+			assert.Nil(t, recorded.ErrorMessage)
+
+			contents, err := os.ReadFile(filepath.Join(folder, *recorded.FileName))
+			require.NoError(t, err)
+			assert.Equal(t, newBackup().Contents, contents)
+			assert.NoFileExists(t, expiredFile)
+			assert.FileExists(t, unrelatedFile)
+		})
+
+		t.Run("records the failure when the backup can't be generated", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expectedErr := errors.New("repository error")
+			cfg := newBackupSettings(t.TempDir())
+
+			var recorded *Run
+
+			settingsCommands := settings.NewMockedCommands(ctrl)
+			settingsCommands.EXPECT().Get(t.Context()).Return(&settings.Settings{Backup: cfg}, nil)
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Get(t.Context()).Return(nil, expectedErr)
+			repository.EXPECT().SaveRun(t.Context(), gomock.Any()).DoAndReturn(
+				func(_ any, run *Run) error {
+					recorded = run
+					return nil
+				},
+			)
+			repository.EXPECT().DeleteOldestRuns(t.Context(), maximumRunRecords).Return(nil)
+
+			backupService := newService(repository, settingsCommands, nil, nil)
+			err := backupService.createScheduledBackup(t.Context())
+
+			assert.Equal(t, expectedErr, err)
+			require.NotNil(t, recorded)
+			assert.Equal(t, FailedRunStatus, recorded.Status)
+			assert.Equal(t, expectedErr.Error(), *recorded.ErrorMessage)
+			assert.Nil(t, recorded.FileName)
+		})
+
+		t.Run("records the failure when the destination is incomplete", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfg := newBackupSettings(t.TempDir())
+			cfg.Destination = settings.S3BackupDestination
+
+			settingsCommands := settings.NewMockedCommands(ctrl)
+			settingsCommands.EXPECT().Get(t.Context()).Return(&settings.Settings{Backup: cfg}, nil)
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().SaveRun(t.Context(), gomock.Any()).Return(nil)
+			repository.EXPECT().DeleteOldestRuns(t.Context(), maximumRunRecords).Return(nil)
+
+			backupService := newService(repository, settingsCommands, nil, nil)
+			err := backupService.createScheduledBackup(t.Context())
+
+			assert.Error(t, err)
+		})
+
+		t.Run("writes the backup to an S3 compatible destination", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			standIn, s3Settings := newS3StandIn(t)
+			standIn.objects["nginx-ignition/nginx-ignition-backup-20200101T000000Z.db"] = nil
+
+			cfg := newBackupSettings(t.TempDir())
+			cfg.Destination = settings.S3BackupDestination
+			cfg.S3 = s3Settings
+			cfg.MaximumAgeDays = 30
+
+			settingsCommands := settings.NewMockedCommands(ctrl)
+			settingsCommands.EXPECT().Get(t.Context()).Return(&settings.Settings{Backup: cfg}, nil)
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Get(t.Context()).Return(newBackup(), nil)
+			repository.EXPECT().SaveRun(t.Context(), gomock.Any()).Return(nil)
+			repository.EXPECT().DeleteOldestRuns(t.Context(), maximumRunRecords).Return(nil)
+
+			backupService := newService(repository, settingsCommands, nil, nil)
+			err := backupService.createScheduledBackup(t.Context())
+
+			require.NoError(t, err)
+			assert.Len(t, standIn.objects, 1)
+			for key, contents := range standIn.objects {
+				assert.Contains(t, key, "nginx-ignition/nginx-ignition-backup-")
+				assert.Equal(t, newBackup().Contents, contents)
+			}
+		})
+	})
 }
@@ -13,6 +13,7 @@ const (
 
 type Binding struct {
 	CertificateID *uuid.UUID
+	TLSProfileID  *uuid.UUID
 	Type          Type
 	IP            string
 	Port          int
@@ -5,14 +5,19 @@ import (
 
 	"dillmann.com.br/nginx-ignition/core/certificate"
 	"dillmann.com.br/nginx-ignition/core/common/validation"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
 )
 
 type service struct {
 	certificateCommands certificate.Commands
+	tlsProfileCommands  tlsprofile.Commands
 }
 
-func newCommands(certificateCommands certificate.Commands) Commands {
-	return &service{certificateCommands}
+func newCommands(
+	certificateCommands certificate.Commands,
+	tlsProfileCommands tlsprofile.Commands,
+) Commands {
+	return &service{certificateCommands, tlsProfileCommands}
 }
 
 func (s *service) Validate(
@@ -22,5 +27,6 @@ func (s *service) Validate(
 	binding *Binding,
 	validationCtx *validation.ConsistencyValidator,
 ) error {
-	return newValidator(validationCtx, s.certificateCommands).validate(ctx, path, binding, index)
+	return newValidator(validationCtx, s.certificateCommands, s.tlsProfileCommands).
+		validate(ctx, path, binding, index)
 }
@@ -9,6 +9,7 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 	"dillmann.com.br/nginx-ignition/core/common/validation"
 	"dillmann.com.br/nginx-ignition/core/common/valuerange"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
 )
 
 var portRange = valuerange.New(1, 65535)
@@ -16,15 +17,18 @@ var portRange = valuerange.New(1, 65535)
 type validator struct {
 	delegate            *validation.ConsistencyValidator
 	certificateCommands certificate.Commands
+	tlsProfileCommands  tlsprofile.Commands
 }
 
 func newValidator(
 	validationCtx *validation.ConsistencyValidator,
 	certificateCommands certificate.Commands,
+	tlsProfileCommands tlsprofile.Commands,
 ) *validator {
 	return &validator{
 		delegate:            validationCtx,
 		certificateCommands: certificateCommands,
+		tlsProfileCommands:  tlsProfileCommands,
 	}
 }
 
@@ -50,6 +54,10 @@ func (v *validator) validate(
 		)
 	}
 
+	if err := v.validateTLSProfile(ctx, pathPrefix, binding, index); err != nil {
+		return err
+	}
+
 	certificateIDField := fmt.Sprintf("%s[%d].certificateId", pathPrefix, index)
 
 	switch {
@@ -86,3 +94,31 @@ func (v *validator) validate(
 
 	return nil
 }
+
+func (v *validator) validateTLSProfile(
+	ctx context.Context,
+	pathPrefix string,
+	binding *Binding,
+	index int,
+) error {
+	if binding.TLSProfileID == nil {
+		return nil
+	}
+
+	tlsProfileIDField := fmt.Sprintf("%s[%d].tlsProfileId", pathPrefix, index)
+	if binding.Type != HTTPSBindingType {
+		v.delegate.Add(tlsProfileIDField, i18n.M(ctx, i18n.K.CoreBindingTlsProfileNotAllowed))
+		return nil
+	}
+
+	exists, err := v.tlsProfileCommands.Exists(ctx, *binding.TLSProfileID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		v.delegate.Add(tlsProfileIDField, i18n.M(ctx, i18n.K.CoreBindingTlsProfileNotFound))
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"dillmann.com.br/nginx-ignition/core/certificate"
 	"dillmann.com.br/nginx-ignition/core/common/validation"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
 )
 
 func Test_validator(t *testing.T) {
@@ -19,7 +20,7 @@ func Test_validator(t *testing.T) {
 
 			binding := newHTTPBinding()
 			certificateCommands := certificate.NewMockedCommands(ctrl)
-			bindingValidator := newValidator(validation.NewValidator(), certificateCommands)
+			bindingValidator := newValidator(validation.NewValidator(), certificateCommands, nil)
 
 			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
 
@@ -32,7 +33,7 @@ func Test_validator(t *testing.T) {
 
 			binding := newHTTPSBinding()
 			certificateCommands := certCommandsExists(ctrl, *binding.CertificateID)
-			bindingValidator := newValidator(validation.NewValidator(), certificateCommands)
+			bindingValidator := newValidator(validation.NewValidator(), certificateCommands, nil)
 
 			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
 
@@ -46,7 +47,7 @@ func Test_validator(t *testing.T) {
 			binding := newHTTPBinding()
 			binding.IP = "2001:0db8:85a3:0000:0000:8a2e:0370:7334"
 			certificateCommands := certificate.NewMockedCommands(ctrl)
-			bindingValidator := newValidator(validation.NewValidator(), certificateCommands)
+			bindingValidator := newValidator(validation.NewValidator(), certificateCommands, nil)
 
 			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
 
@@ -61,7 +62,7 @@ func Test_validator(t *testing.T) {
 			binding.IP = "invalid.ip"
 			certificateCommands := certificate.NewMockedCommands(ctrl)
 			delegate := validation.NewValidator()
-			bindingValidator := newValidator(delegate, certificateCommands)
+			bindingValidator := newValidator(delegate, certificateCommands, nil)
 
 			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
 
@@ -77,7 +78,7 @@ func Test_validator(t *testing.T) {
 			binding.Port = 0
 			certificateCommands := certificate.NewMockedCommands(ctrl)
 			delegate := validation.NewValidator()
-			bindingValidator := newValidator(delegate, certificateCommands)
+			bindingValidator := newValidator(delegate, certificateCommands, nil)
 
 			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
 
@@ -93,7 +94,7 @@ func Test_validator(t *testing.T) {
 			binding.Port = 65536
 			certificateCommands := certificate.NewMockedCommands(ctrl)
 			delegate := validation.NewValidator()
-			bindingValidator := newValidator(delegate, certificateCommands)
+			bindingValidator := newValidator(delegate, certificateCommands, nil)
 
 			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
 
@@ -109,7 +110,7 @@ func Test_validator(t *testing.T) {
 			binding.CertificateID = new(uuid.New())
 			certificateCommands := certificate.NewMockedCommands(ctrl)
 			delegate := validation.NewValidator()
-			bindingValidator := newValidator(delegate, certificateCommands)
+			bindingValidator := newValidator(delegate, certificateCommands, nil)
 
 			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
 
@@ -125,7 +126,7 @@ func Test_validator(t *testing.T) {
 			binding.CertificateID = nil
 			certificateCommands := certificate.NewMockedCommands(ctrl)
 			delegate := validation.NewValidator()
-			bindingValidator := newValidator(delegate, certificateCommands)
+			bindingValidator := newValidator(delegate, certificateCommands, nil)
 
 			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
 
@@ -140,7 +141,7 @@ func Test_validator(t *testing.T) {
 			binding := newHTTPSBinding()
 			certificateCommands := certCommandsNotExists(ctrl)
 			delegate := validation.NewValidator()
-			bindingValidator := newValidator(delegate, certificateCommands)
+			bindingValidator := newValidator(delegate, certificateCommands, nil)
 
 			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
 
@@ -156,7 +157,64 @@ func Test_validator(t *testing.T) {
 			binding.Type = "INVALID"
 			certificateCommands := certificate.NewMockedCommands(ctrl)
 			delegate := validation.NewValidator()
-			bindingValidator := newValidator(delegate, certificateCommands)
+			bindingValidator := newValidator(delegate, certificateCommands, nil)
+
+			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
+
+			assert.NoError(t, err)
+			assert.Error(t, delegate.Result())
+		})
+
+		t.Run("HTTPS binding with existing TLS profile passes", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			binding := newHTTPSBinding()
+			binding.TLSProfileID = new(uuid.New())
+			certificateCommands := certCommandsExists(ctrl, *binding.CertificateID)
+			tlsProfileCommands := tlsprofile.NewMockedCommands(ctrl)
+			tlsProfileCommands.EXPECT().
+				Exists(gomock.Any(), *binding.TLSProfileID).
+				Return(true, nil)
+			delegate := validation.NewValidator()
+			bindingValidator := newValidator(delegate, certificateCommands, tlsProfileCommands)
+
+			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
+
+			assert.NoError(t, err)
+			assert.NoError(t, delegate.Result())
+		})
+
+		t.Run("HTTPS binding with non-existent TLS profile fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			binding := newHTTPSBinding()
+			binding.TLSProfileID = new(uuid.New())
+			certificateCommands := certCommandsExists(ctrl, *binding.CertificateID)
+			tlsProfileCommands := tlsprofile.NewMockedCommands(ctrl)
+			tlsProfileCommands.EXPECT().
+				Exists(gomock.Any(), *binding.TLSProfileID).
+				Return(false, nil)
+			delegate := validation.NewValidator()
+			bindingValidator := newValidator(delegate, certificateCommands, tlsProfileCommands)
+
+			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
+
+			assert.NoError(t, err)
+			assert.Error(t, delegate.Result())
+		})
+
+		t.Run("HTTP binding with TLS profile fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			binding := newHTTPBinding()
+			binding.TLSProfileID = new(uuid.New())
+			certificateCommands := certificate.NewMockedCommands(ctrl)
+			tlsProfileCommands := tlsprofile.NewMockedCommands(ctrl)
+			delegate := validation.NewValidator()
+			bindingValidator := newValidator(delegate, certificateCommands, tlsProfileCommands)
 
 			err := bindingValidator.validate(t.Context(), "bindings", binding, 0)
 
@@ -20,4 +20,5 @@ type Commands interface {
 	) (*pagination.Page[Certificate], error)
 	Issue(ctx context.Context, request *IssueRequest) (*Certificate, error)
 	Renew(ctx context.Context, id uuid.UUID) error
+	Save(ctx context.Context, certificate *Certificate) error
 }
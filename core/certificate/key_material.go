@@ -0,0 +1,88 @@
+package certificate
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+)
+
+// validateKeyMaterial checks that the stored keys of the certificate can be parsed and that the
+// private key belongs to its public key. The values are base64-encoded, either DER or PEM.
+func validateKeyMaterial(ctx context.Context, cert *Certificate) error {
+	publicKey, err := parseX509Certificate(cert.PublicKey)
+	if err != nil {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreCertificateInvalidPublicKey), true)
+	}
+
+	privateKey, err := parsePrivateKey(cert.PrivateKey)
+	if err != nil {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreCertificateInvalidPrivateKey), true)
+	}
+
+	matcher, ok := publicKey.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !matcher.Equal(privateKey.Public()) {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreCertificateKeyMismatch), true)
+	}
+
+	for _, chainElement := range cert.CertificationChain {
+		if _, err = parseX509Certificate(chainElement); err != nil {
+			return coreerror.New(
+				i18n.M(ctx, i18n.K.CoreCertificateInvalidCertificationChain),
+				true,
+			)
+		}
+	}
+
+	return nil
+}
+
+func parseX509Certificate(value string) (*x509.Certificate, error) {
+	contents, err := decodeKeyMaterial(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParseCertificate(contents)
+}
+
+func parsePrivateKey(value string) (crypto.Signer, error) {
+	contents, err := decodeKeyMaterial(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(contents); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(contents); err == nil {
+		return key, nil
+	}
+
+	if key, err := x509.ParseECPrivateKey(contents); err == nil {
+		return key, nil
+	}
+
+	return nil, errors.New("unsupported private key format")
+}
+
+func decodeKeyMaterial(value string) ([]byte, error) {
+	contents, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if block, _ := pem.Decode(contents); block != nil {
+		return block.Bytes, nil
+	}
+
+	return contents, nil
+}
@@ -0,0 +1,110 @@
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+)
+
+func Test_validateKeyMaterial(t *testing.T) {
+	t.Run("accepts DER-encoded keys that match", func(t *testing.T) {
+		publicKey, privateKey := newKeyPair(t)
+
+		err := validateKeyMaterial(t.Context(), &Certificate{
+			PublicKey:  base64.StdEncoding.EncodeToString(publicKey),
+			PrivateKey: base64.StdEncoding.EncodeToString(privateKey),
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("accepts PEM-encoded keys and chain that match", func(t *testing.T) {
+		publicKey, privateKey := newKeyPair(t)
+		encodedPublicKey := base64.StdEncoding.EncodeToString(
+			pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: publicKey}),
+		)
+
+		err := validateKeyMaterial(t.Context(), &Certificate{
+			PublicKey: encodedPublicKey,
+			PrivateKey: base64.StdEncoding.EncodeToString(
+				pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey}),
+			),
+			CertificationChain: []string{encodedPublicKey},
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects an invalid public key", func(t *testing.T) {
+		_, privateKey := newKeyPair(t)
+
+		err := validateKeyMaterial(t.Context(), &Certificate{
+			PublicKey:  base64.StdEncoding.EncodeToString([]byte("public")),
+			PrivateKey: base64.StdEncoding.EncodeToString(privateKey),
+		})
+
+		var coreErr *coreerror.CoreError
+		require.ErrorAs(t, err, &coreErr)
+		assert.True(t, coreErr.UserRelated)
+	})
+
+	t.Run("rejects a private key of another certificate", func(t *testing.T) {
+		publicKey, _ := newKeyPair(t)
+		_, otherPrivateKey := newKeyPair(t)
+
+		err := validateKeyMaterial(t.Context(), &Certificate{
+			PublicKey:  base64.StdEncoding.EncodeToString(publicKey),
+			PrivateKey: base64.StdEncoding.EncodeToString(otherPrivateKey),
+		})
+
+		var coreErr *coreerror.CoreError
+		require.ErrorAs(t, err, &coreErr)
+	})
+
+	t.Run("rejects an invalid certification chain", func(t *testing.T) {
+		publicKey, privateKey := newKeyPair(t)
+
+		err := validateKeyMaterial(t.Context(), &Certificate{
+			PublicKey:          base64.StdEncoding.EncodeToString(publicKey),
+			PrivateKey:         base64.StdEncoding.EncodeToString(privateKey),
+			CertificationChain: []string{"not base64"},
+		})
+
+		var coreErr *coreerror.CoreError
+		require.ErrorAs(t, err, &coreErr)
+	})
+}
+
+func newKeyPair(t *testing.T) (publicKey, privateKey []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	publicKey, err = x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	privateKey, err = x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return publicKey, privateKey
+}
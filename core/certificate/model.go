@@ -41,6 +41,12 @@ type AvailableProvider struct {
 	provider Provider
 }
 
+func NewAvailableProvider(provider Provider) AvailableProvider {
+	return AvailableProvider{
+		provider: provider,
+	}
+}
+
 func (a *AvailableProvider) ID() string {
 	return a.provider.ID()
 }
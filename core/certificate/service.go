@@ -90,9 +90,7 @@ func (s *service) List(
 func (s *service) AvailableProviders(_ context.Context) ([]AvailableProvider, error) {
 	availableProviders := make([]AvailableProvider, 0, len(s.providers()))
 	for _, provider := range s.providers() {
-		availableProviders = append(availableProviders, AvailableProvider{
-			provider: provider,
-		})
+		availableProviders = append(availableProviders, NewAvailableProvider(provider))
 	}
 
 	return availableProviders, nil
@@ -176,6 +174,37 @@ func (s *service) Issue(ctx context.Context, request *IssueRequest) (*Certificat
 	return certificate, nil
 }
 
+func (s *service) Save(ctx context.Context, certificate *Certificate) error {
+	providers, err := s.AvailableProviders(ctx)
+	if err != nil {
+		return err
+	}
+
+	provider := providerByID(providers, certificate.ProviderID)
+	if provider == nil {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreCertificateProviderNotFound), true)
+	}
+
+	if err = validateKeyMaterial(ctx, certificate); err != nil {
+		return err
+	}
+
+	current, err := s.repository.FindByID(ctx, certificate.ID)
+	if err != nil {
+		return err
+	}
+
+	if current != nil {
+		dynamicfields.RestoreSensitiveFields(
+			&certificate.Parameters,
+			current.Parameters,
+			provider.DynamicFields(ctx),
+		)
+	}
+
+	return s.repository.Save(ctx, certificate)
+}
+
 func (s *service) autoRenewSettings(ctx context.Context) (*AutoRenewSettings, error) {
 	return s.repository.GetAutoRenewSettings(ctx)
 }
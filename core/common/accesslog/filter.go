@@ -0,0 +1,48 @@
+package accesslog
+
+import (
+	"strings"
+)
+
+func (f *Filter) IsEmpty() bool {
+	return f == nil ||
+		f.StatusRange == nil &&
+			f.ClientNetwork == nil &&
+			f.Path == nil &&
+			f.Method == nil &&
+			f.MinimumLatency == nil
+}
+
+func (f *Filter) Matches(record *Record) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	if record == nil {
+		return false
+	}
+
+	if f.StatusRange != nil && !f.StatusRange.Contains(record.Status) {
+		return false
+	}
+
+	if f.ClientNetwork != nil &&
+		(!record.ClientIP.IsValid() || !f.ClientNetwork.Contains(record.ClientIP)) {
+		return false
+	}
+
+	if f.Path != nil && !strings.HasPrefix(record.Path, *f.Path) {
+		return false
+	}
+
+	if f.Method != nil && !strings.EqualFold(record.Method, *f.Method) {
+		return false
+	}
+
+	if f.MinimumLatency != nil &&
+		(record.RequestTime == nil || *record.RequestTime < *f.MinimumLatency) {
+		return false
+	}
+
+	return true
+}
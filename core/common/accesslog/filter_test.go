@@ -0,0 +1,93 @@
+package accesslog
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dillmann.com.br/nginx-ignition/core/common/valuerange"
+)
+
+func Test_Filter(t *testing.T) {
+	record := &Record{
+		ClientIP:    netip.MustParseAddr("192.168.0.10"),
+		Method:      "GET",
+		Path:        "/api/items",
+		Status:      503,
+		RequestTime: new(1500 * time.Millisecond),
+	}
+
+	t.Run("IsEmpty", func(t *testing.T) {
+		t.Run("returns true for nil or blank filters", func(t *testing.T) {
+			var filter *Filter
+
+			assert.True(t, filter.IsEmpty())
+			assert.True(t, (&Filter{}).IsEmpty())
+		})
+
+		t.Run("returns false when any criteria is set", func(t *testing.T) {
+			assert.False(t, (&Filter{Method: new("GET")}).IsEmpty())
+		})
+	})
+
+	t.Run("Matches", func(t *testing.T) {
+		t.Run("matches when all criteria are met", func(t *testing.T) {
+			filter := &Filter{
+				StatusRange:    valuerange.New(500, 599),
+				ClientNetwork:  new(netip.MustParsePrefix("192.168.0.0/24")),
+				Path:           new("/api"),
+				Method:         new("get"),
+				MinimumLatency: new(time.Second),
+			}
+
+			assert.True(t, filter.Matches(record))
+		})
+
+		t.Run("rejects records outside the status range", func(t *testing.T) {
+			filter := &Filter{StatusRange: valuerange.New(200, 299)}
+
+			assert.False(t, filter.Matches(record))
+		})
+
+		t.Run("rejects records from other networks", func(t *testing.T) {
+			filter := &Filter{ClientNetwork: new(netip.MustParsePrefix("10.0.0.0/8"))}
+
+			assert.False(t, filter.Matches(record))
+		})
+
+		t.Run("rejects records with other paths", func(t *testing.T) {
+			filter := &Filter{Path: new("/static")}
+
+			assert.False(t, filter.Matches(record))
+		})
+
+		t.Run("rejects records with other methods", func(t *testing.T) {
+			filter := &Filter{Method: new("POST")}
+
+			assert.False(t, filter.Matches(record))
+		})
+
+		t.Run("rejects records faster than the latency threshold", func(t *testing.T) {
+			filter := &Filter{MinimumLatency: new(2 * time.Second)}
+
+			assert.False(t, filter.Matches(record))
+		})
+
+		t.Run(
+			"rejects records without request time when a latency threshold is set",
+			func(t *testing.T) {
+				filter := &Filter{MinimumLatency: new(time.Millisecond)}
+
+				assert.False(t, filter.Matches(&Record{Status: 200}))
+			},
+		)
+
+		t.Run("rejects missing records", func(t *testing.T) {
+			filter := &Filter{Method: new("GET")}
+
+			assert.False(t, filter.Matches(nil))
+		})
+	})
+}
@@ -0,0 +1,35 @@
+package accesslog
+
+import (
+	"net/netip"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/valuerange"
+)
+
+type Record struct {
+	Time         time.Time
+	RequestTime  *time.Duration
+	UpstreamTime *time.Duration
+	ClientIP     netip.Addr
+	HostID       string
+	RouteID      string
+	Method       string
+	Path         string
+	Query        string
+	Protocol     string
+	CacheStatus  string
+	Referer      string
+	UserAgent    string
+	CountryCode  string
+	BytesSent    int64
+	Status       int
+}
+
+type Filter struct {
+	StatusRange    *valuerange.ValueRange
+	ClientNetwork  *netip.Prefix
+	Path           *string
+	Method         *string
+	MinimumLatency *time.Duration
+}
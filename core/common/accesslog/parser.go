@@ -0,0 +1,154 @@
+package accesslog
+
+import (
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const combinedTimeLayout = "02/Jan/2006:15:04:05 -0700"
+
+var (
+	ErrUnrecognizedFormat = errors.New("access log line is in an unrecognized format")
+
+	combinedPattern = regexp.MustCompile(
+		`^(\S+) \S+ \S+ \[([^]]+)] "(\S+) (\S+)(?: (\S+))?" (\d{3}) (\d+|-) "([^"]*)" "([^"]*)"`,
+	)
+)
+
+type jsonRecord struct {
+	Time         string  `json:"time"`
+	HostID       string  `json:"hostId"`
+	RouteID      string  `json:"routeId"`
+	ClientIP     string  `json:"clientIp"`
+	Method       string  `json:"method"`
+	RequestURI   string  `json:"requestUri"`
+	Protocol     string  `json:"protocol"`
+	UpstreamTime string  `json:"upstreamTime"`
+	CacheStatus  string  `json:"cacheStatus"`
+	Referer      string  `json:"referer"`
+	UserAgent    string  `json:"userAgent"`
+	CountryCode  string  `json:"countryCode"`
+	RequestTime  float64 `json:"requestTime"`
+	BytesSent    int64   `json:"bytesSent"`
+	Status       int     `json:"status"`
+}
+
+func Parse(contents string) (*Record, error) {
+	contents = strings.TrimSpace(contents)
+	if strings.HasPrefix(contents, "{") {
+		return parseJSON(contents)
+	}
+
+	return parseCombined(contents)
+}
+
+func parseJSON(contents string) (*Record, error) {
+	var input jsonRecord
+	if err := json.Unmarshal([]byte(contents), &input); err != nil {
+		return nil, err
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, input.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	path, query, _ := strings.Cut(input.RequestURI, "?")
+
+	return &Record{
+		Time:         timestamp,
+		RequestTime:  new(secondsToDuration(input.RequestTime)),
+		UpstreamTime: parseUpstreamTime(input.UpstreamTime),
+		ClientIP:     parseAddress(input.ClientIP),
+		HostID:       input.HostID,
+		RouteID:      input.RouteID,
+		Method:       input.Method,
+		Path:         path,
+		Query:        query,
+		Protocol:     input.Protocol,
+		CacheStatus:  input.CacheStatus,
+		Referer:      input.Referer,
+		UserAgent:    input.UserAgent,
+		CountryCode:  input.CountryCode,
+		BytesSent:    input.BytesSent,
+		Status:       input.Status,
+	}, nil
+}
+
+func parseCombined(contents string) (*Record, error) {
+	groups := combinedPattern.FindStringSubmatch(contents)
+	if groups == nil {
+		return nil, ErrUnrecognizedFormat
+	}
+
+	timestamp, err := time.Parse(combinedTimeLayout, groups[2])
+	if err != nil {
+		return nil, err
+	}
+
+	status, _ := strconv.Atoi(groups[6])
+	bytesSent, _ := strconv.ParseInt(groups[7], 10, 64)
+	path, query, _ := strings.Cut(groups[4], "?")
+
+	return &Record{
+		Time:      timestamp,
+		ClientIP:  parseAddress(groups[1]),
+		Method:    groups[3],
+		Path:      path,
+		Query:     query,
+		Protocol:  groups[5],
+		Status:    status,
+		BytesSent: bytesSent,
+		Referer:   emptyIfDash(groups[8]),
+		UserAgent: emptyIfDash(groups[9]),
+	}, nil
+}
+
+func parseAddress(value string) netip.Addr {
+	address, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}
+	}
+
+	return address.Unmap()
+}
+
+func parseUpstreamTime(value string) *time.Duration {
+	var total time.Duration
+	found := false
+
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ':' || r == ' '
+	}) {
+		seconds, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			continue
+		}
+
+		total += secondsToDuration(seconds)
+		found = true
+	}
+
+	if !found {
+		return nil
+	}
+
+	return &total
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+func emptyIfDash(value string) string {
+	if value == "-" {
+		return ""
+	}
+
+	return value
+}
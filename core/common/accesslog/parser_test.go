@@ -0,0 +1,82 @@
+package accesslog
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse(t *testing.T) {
+	t.Run("parses JSON lines", func(t *testing.T) {
+		record, err := Parse(
+			`{"time":"2026-03-01T10:15:30+00:00","hostId":"host-1","routeId":"route-1",` +
+				`"clientIp":"192.168.0.10","method":"POST","requestUri":"/api/items?page=2",` +
+				`"protocol":"HTTP/1.1","status":201,"bytesSent":512,"requestTime":0.250,` +
+				`"upstreamTime":"0.120, 0.080","cacheStatus":"MISS","referer":"https://example.com",` +
+				`"userAgent":"curl/8.0","countryCode":"BR"}`,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 3, 1, 10, 15, 30, 0, time.UTC), record.Time.UTC())
+		assert.Equal(t, "host-1", record.HostID)
+		assert.Equal(t, "route-1", record.RouteID)
+		assert.Equal(t, netip.MustParseAddr("192.168.0.10"), record.ClientIP)
+		assert.Equal(t, "POST", record.Method)
+		assert.Equal(t, "/api/items", record.Path)
+		assert.Equal(t, "page=2", record.Query)
+		assert.Equal(t, "HTTP/1.1", record.Protocol)
+		assert.Equal(t, 201, record.Status)
+		assert.Equal(t, int64(512), record.BytesSent)
+		assert.Equal(t, 250*time.Millisecond, *record.RequestTime)
+		assert.Equal(t, 200*time.Millisecond, *record.UpstreamTime)
+		assert.Equal(t, "MISS", record.CacheStatus)
+		assert.Equal(t, "https://example.com", record.Referer)
+		assert.Equal(t, "curl/8.0", record.UserAgent)
+		assert.Equal(t, "BR", record.CountryCode)
+	})
+
+	t.Run("leaves upstream time empty when not proxied", func(t *testing.T) {
+		record, err := Parse(
+			`{"time":"2026-03-01T10:15:30+00:00","status":200,"requestTime":0.000,"upstreamTime":""}`,
+		)
+
+		require.NoError(t, err)
+		assert.Nil(t, record.UpstreamTime)
+		assert.Equal(t, time.Duration(0), *record.RequestTime)
+	})
+
+	t.Run("parses combined lines", func(t *testing.T) {
+		record, err := Parse(
+			`10.0.0.1 - - [01/Mar/2026:10:15:30 +0000] "GET /index.html?lang=en HTTP/2.0" 404 153 ` +
+				`"-" "Mozilla/5.0"`,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 3, 1, 10, 15, 30, 0, time.UTC), record.Time.UTC())
+		assert.Equal(t, netip.MustParseAddr("10.0.0.1"), record.ClientIP)
+		assert.Equal(t, "GET", record.Method)
+		assert.Equal(t, "/index.html", record.Path)
+		assert.Equal(t, "lang=en", record.Query)
+		assert.Equal(t, "HTTP/2.0", record.Protocol)
+		assert.Equal(t, 404, record.Status)
+		assert.Equal(t, int64(153), record.BytesSent)
+		assert.Empty(t, record.Referer)
+		assert.Equal(t, "Mozilla/5.0", record.UserAgent)
+		assert.Nil(t, record.RequestTime)
+	})
+
+	t.Run("returns an error for unrecognized lines", func(t *testing.T) {
+		_, err := Parse("2026/03/01 10:15:30 [error] 12#12: something went wrong")
+
+		assert.ErrorIs(t, err, ErrUnrecognizedFormat)
+	})
+
+	t.Run("returns an error for malformed JSON lines", func(t *testing.T) {
+		_, err := Parse(`{"time":`)
+
+		assert.Error(t, err)
+	})
+}
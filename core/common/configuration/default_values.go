@@ -1,22 +1,56 @@
 package configuration
 
 var defaultValues = map[string]string{
-	"nginx-ignition.server.port":                                   "8090",
-	"nginx-ignition.server.address":                                "0.0.0.0",
-	"nginx-ignition.health-check.enabled":                          "true",
-	"nginx-ignition.nginx.binary-path":                             "nginx",
-	"nginx-ignition.nginx.config-path":                             "/tmp/nginx-ignition/nginx",
-	"nginx-ignition.vpn.config-path":                               "/tmp/nginx-ignition/vpn",
-	"nginx-ignition.database.driver":                               "sqlite",
-	"nginx-ignition.database.data-path":                            "/tmp/nginx-ignition/data",
-	"nginx-ignition.security.user-password-hashing.algorithm":      "SHA-512",
-	"nginx-ignition.security.user-password-hashing.salt-size":      "64",
-	"nginx-ignition.security.user-password-hashing.iterations":     "1024",
-	"nginx-ignition.security.jwt.secret":                           "",
-	"nginx-ignition.security.jwt.ttl-seconds":                      "3600",
-	"nginx-ignition.security.jwt.clock-skew-seconds":               "60",
-	"nginx-ignition.security.jwt.renew-window-seconds":             "900",
-	"nginx-ignition.certificate.lets-encrypt.production":           "true",
-	"nginx-ignition.integration.truenas.api-cache-timeout-seconds": "15",
-	"nginx-ignition.password-reset.username":                       "",
+	"nginx-ignition.server.port":                                         "8090",
+	"nginx-ignition.server.address":                                      "0.0.0.0",
+	"nginx-ignition.server.trusted-proxies":                              "",
+	"nginx-ignition.health-check.enabled":                                "true",
+	"nginx-ignition.metrics.enabled":                                     "false",
+	"nginx-ignition.metrics.token":                                       "",
+	"nginx-ignition.nginx.binary-path":                                   "nginx",
+	"nginx-ignition.nginx.config-path":                                   "/tmp/nginx-ignition/nginx",
+	"nginx-ignition.nginx.ocsp-resolvers":                                "",
+	"nginx-ignition.vpn.config-path":                                     "/tmp/nginx-ignition/vpn",
+	"nginx-ignition.database.driver":                                     "sqlite",
+	"nginx-ignition.database.data-path":                                  "/tmp/nginx-ignition/data",
+	"nginx-ignition.security.user-password-hashing.algorithm":            "ARGON2ID",
+	"nginx-ignition.security.user-password-hashing.salt-size":            "64",
+	"nginx-ignition.security.user-password-hashing.iterations":           "1024",
+	"nginx-ignition.security.user-password-hashing.argon2id.memory-kib":  "65536",
+	"nginx-ignition.security.user-password-hashing.argon2id.iterations":  "3",
+	"nginx-ignition.security.user-password-hashing.argon2id.parallelism": "4",
+	"nginx-ignition.security.user-password-hashing.argon2id.key-size":    "32",
+	"nginx-ignition.security.user-password-hashing.bcrypt.cost":          "12",
+	"nginx-ignition.security.jwt.secret":                                 "",
+	"nginx-ignition.security.jwt.ttl-seconds":                            "3600",
+	"nginx-ignition.security.jwt.clock-skew-seconds":                     "60",
+	"nginx-ignition.security.jwt.renew-window-seconds":                   "900",
+	"nginx-ignition.security.login-protection.enabled":                   "true",
+	"nginx-ignition.security.login-protection.max-username-failures":     "5",
+	"nginx-ignition.security.login-protection.max-address-failures":      "20",
+	"nginx-ignition.security.login-protection.lockout-seconds":           "60",
+	"nginx-ignition.security.login-protection.max-lockout-seconds":       "3600",
+	"nginx-ignition.security.login-protection.failure-window-seconds":    "900",
+	"nginx-ignition.security.oidc.enabled":                               "false",
+	"nginx-ignition.security.oidc.scopes":                                "openid profile email",
+	"nginx-ignition.security.oidc.username-claim":                        "preferred_username",
+	"nginx-ignition.security.oidc.name-claim":                            "name",
+	"nginx-ignition.security.oidc.email-claim":                           "email",
+	"nginx-ignition.security.oidc.groups-claim":                          "groups",
+	"nginx-ignition.security.oidc.group-mappings":                        "",
+	"nginx-ignition.security.oidc.auto-provisioning":                     "true",
+	"nginx-ignition.security.oidc.link-by-email":                         "true",
+	"nginx-ignition.security.oidc.local-login-enabled":                   "true",
+	"nginx-ignition.certificate.lets-encrypt.production":                 "true",
+	"nginx-ignition.integration.truenas.api-cache-timeout-seconds":       "15",
+	"nginx-ignition.password-reset.username":                             "",
+	"nginx-ignition.revision.maximum-amount":                             "100",
+	"nginx-ignition.backup.restore-maximum-size-mb":                      "512",
+	"nginx-ignition.traffic-stats.history.enabled":                       "true",
+	"nginx-ignition.traffic-stats.history.sample-interval-seconds":       "60",
+	"nginx-ignition.traffic-stats.history.minute-retention-hours":        "24",
+	"nginx-ignition.traffic-stats.history.hour-retention-days":           "30",
+	"nginx-ignition.traffic-stats.history.day-retention-days":            "365",
+	"nginx-ignition.log-shipping.reconcile-interval-seconds":             "15",
+	"nginx-ignition.log-shipping.request-timeout-seconds":                "10",
 }
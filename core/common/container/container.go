@@ -24,6 +24,16 @@ func Provide(providers ...any) error {
 	return nil
 }
 
+func Decorate(decorators ...any) error {
+	for _, decorator := range decorators {
+		if err := delegate.Decorate(decorator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func Singleton[T any](value T) error {
 	return Provide(func() T {
 		return value
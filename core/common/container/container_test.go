@@ -37,6 +37,36 @@ func Test_Provide(t *testing.T) {
 	})
 }
 
+func Test_Decorate(t *testing.T) {
+	t.Run("decorates provided value", func(t *testing.T) {
+		Init(t.Context())
+
+		_ = Provide(func() string {
+			return "value"
+		})
+
+		err := Decorate(func(value string) string {
+			return "decorated-" + value
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "decorated-value", Get[string]())
+	})
+
+	t.Run("returns error when the type is already decorated", func(t *testing.T) {
+		Init(t.Context())
+
+		decorator := func(value string) string {
+			return value
+		}
+		_ = Decorate(decorator)
+
+		err := Decorate(decorator)
+
+		assert.Error(t, err)
+	})
+}
+
 func Test_Singleton(t *testing.T) {
 	t.Run("provides singleton value", func(t *testing.T) {
 		Init(t.Context())
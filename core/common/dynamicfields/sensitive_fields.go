@@ -7,3 +7,26 @@ func RemoveSensitiveFields(values *map[string]any, dynamicFields []DynamicField)
 		}
 	}
 }
+
+func RestoreSensitiveFields(
+	values *map[string]any,
+	current map[string]any,
+	dynamicFields []DynamicField,
+) {
+	for _, field := range dynamicFields {
+		if !field.Sensitive {
+			continue
+		}
+
+		currentValue, found := current[field.ID]
+		if _, informed := (*values)[field.ID]; informed || !found {
+			continue
+		}
+
+		if *values == nil {
+			*values = make(map[string]any)
+		}
+
+		(*values)[field.ID] = currentValue
+	}
+}
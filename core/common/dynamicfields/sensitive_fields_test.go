@@ -112,3 +112,49 @@ func Test_RemoveSensitiveFields(t *testing.T) {
 		assert.Contains(t, values, "field1")
 	})
 }
+
+func Test_RestoreSensitiveFields(t *testing.T) {
+	t.Run("restores the sensitive fields absent from the values", func(t *testing.T) {
+		values := map[string]any{
+			"field1": "new-value1",
+		}
+		current := map[string]any{
+			"field1": "value1",
+			"field2": "value2",
+			"field3": "value3",
+		}
+
+		dynamicField1 := newDynamicField(t.Context())
+		dynamicField1.ID = "field1"
+		dynamicField1.Sensitive = true
+
+		dynamicField2 := newDynamicField(t.Context())
+		dynamicField2.ID = "field2"
+		dynamicField2.Sensitive = true
+
+		dynamicField3 := newDynamicField(t.Context())
+		dynamicField3.ID = "field3"
+
+		dynamicFields := []DynamicField{*dynamicField1, *dynamicField2, *dynamicField3}
+
+		RestoreSensitiveFields(&values, current, dynamicFields)
+
+		assert.Equal(t, map[string]any{"field1": "new-value1", "field2": "value2"}, values)
+	})
+
+	t.Run("handles nil values", func(t *testing.T) {
+		var values map[string]any
+
+		dynamicField := newDynamicField(t.Context())
+		dynamicField.ID = "field1"
+		dynamicField.Sensitive = true
+
+		RestoreSensitiveFields(
+			&values,
+			map[string]any{"field1": "value1"},
+			[]DynamicField{*dynamicField},
+		)
+
+		assert.Equal(t, map[string]any{"field1": "value1"}, values)
+	})
+}
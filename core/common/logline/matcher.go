@@ -0,0 +1,30 @@
+package logline
+
+import (
+	"regexp"
+)
+
+type Matcher struct {
+	re *regexp.Regexp
+}
+
+func NewMatcher(query string) (*Matcher, error) {
+	re, err := compileRegex(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Matcher{re}, nil
+}
+
+func (m *Matcher) Match(contents string) (*Highlight, bool) {
+	loc := m.re.FindStringIndex(contents)
+	if loc == nil {
+		return nil, false
+	}
+
+	return &Highlight{
+		Start: loc[0],
+		End:   loc[1],
+	}, true
+}
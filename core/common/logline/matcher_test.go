@@ -0,0 +1,32 @@
+package logline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Matcher(t *testing.T) {
+	t.Run("Match", func(t *testing.T) {
+		t.Run("returns the highlight of a matching line", func(t *testing.T) {
+			matcher, err := NewMatcher("GET api")
+			require.NoError(t, err)
+
+			highlight, matched := matcher.Match("127.0.0.1 get /api/hosts")
+
+			assert.True(t, matched)
+			assert.Equal(t, &Highlight{Start: 10, End: 18}, highlight)
+		})
+
+		t.Run("returns false when the line does not match", func(t *testing.T) {
+			matcher, err := NewMatcher("error")
+			require.NoError(t, err)
+
+			highlight, matched := matcher.Match("everything is fine")
+
+			assert.False(t, matched)
+			assert.Nil(t, highlight)
+		})
+	})
+}
@@ -1,9 +1,9 @@
 package logline
 
 type LogLine struct {
-	Highlight  *Highlight
-	Contents   string
-	LineNumber int
+	Highlight *Highlight
+	Contents  string
+	Offset    int64
 }
 
 type Highlight struct {
@@ -5,24 +5,30 @@ import (
 	"strings"
 )
 
+type MatchFunc func(contents string) (*Highlight, bool)
+
 func Search(logLines []LogLine, query string, surroundingLines int) ([]LogLine, error) {
 	if query == "" {
 		return logLines, nil
 	}
 
-	re, err := compileRegex(query)
+	matcher, err := NewMatcher(query)
 	if err != nil {
 		return nil, err
 	}
 
-	surroundingLines = clampSurroundingLines(surroundingLines)
-	matchedIndices := findMatchedIndices(logLines, re)
-	if len(matchedIndices) == 0 {
-		return []LogLine{}, nil
+	return SearchWith(logLines, matcher.Match, surroundingLines), nil
+}
+
+func SearchWith(logLines []LogLine, match MatchFunc, surroundingLines int) []LogLine {
+	surroundingLines = ClampSurroundingLines(surroundingLines)
+	highlights := findMatches(logLines, match)
+	if len(highlights) == 0 {
+		return []LogLine{}
 	}
 
-	includeMap := calculateIncludedIndices(matchedIndices, surroundingLines, len(logLines))
-	return buildResult(logLines, includeMap, re), nil
+	includeMap := calculateIncludedIndices(highlights, surroundingLines, len(logLines))
+	return buildResult(logLines, includeMap, highlights)
 }
 
 func compileRegex(query string) (*regexp.Regexp, error) {
@@ -35,7 +41,7 @@ func compileRegex(query string) (*regexp.Regexp, error) {
 	return regexp.Compile(regexStr)
 }
 
-func clampSurroundingLines(lines int) int {
+func ClampSurroundingLines(lines int) int {
 	if lines < 0 {
 		return 0
 	}
@@ -47,20 +53,23 @@ func clampSurroundingLines(lines int) int {
 	return lines
 }
 
-func findMatchedIndices(logLines []LogLine, re *regexp.Regexp) []int {
-	var matchedIndices []int
+func findMatches(logLines []LogLine, match MatchFunc) map[int]*Highlight {
+	highlights := make(map[int]*Highlight)
 	for index, line := range logLines {
-		if re.MatchString(line.Contents) {
-			matchedIndices = append(matchedIndices, index)
+		if highlight, matched := match(line.Contents); matched {
+			highlights[index] = highlight
 		}
 	}
 
-	return matchedIndices
+	return highlights
 }
 
-func calculateIncludedIndices(matchedIndices []int, surroundingLines, totalLines int) map[int]bool {
+func calculateIncludedIndices(
+	highlights map[int]*Highlight,
+	surroundingLines, totalLines int,
+) map[int]bool {
 	includeMap := make(map[int]bool)
-	for _, index := range matchedIndices {
+	for index := range highlights {
 		start := index - surroundingLines
 		if start < 0 {
 			start = 0
@@ -79,19 +88,18 @@ func calculateIncludedIndices(matchedIndices []int, surroundingLines, totalLines
 	return includeMap
 }
 
-func buildResult(logLines []LogLine, includeMap map[int]bool, re *regexp.Regexp) []LogLine {
+func buildResult(
+	logLines []LogLine,
+	includeMap map[int]bool,
+	highlights map[int]*Highlight,
+) []LogLine {
 	var result []LogLine
 
 	for index := 0; index < len(logLines); index++ {
 		if includeMap[index] {
 			line := logLines[index]
-			loc := re.FindStringIndex(line.Contents)
-
-			if loc != nil {
-				line.Highlight = &Highlight{
-					Start: loc[0],
-					End:   loc[1],
-				}
+			if highlight, matched := highlights[index]; matched && highlight != nil {
+				line.Highlight = highlight
 			}
 
 			result = append(result, line)
@@ -9,8 +9,8 @@ import (
 func Test_Search(t *testing.T) {
 	t.Run("returns all lines when query is empty", func(t *testing.T) {
 		lines := []LogLine{
-			{Contents: "line 1", LineNumber: 1},
-			{Contents: "line 2", LineNumber: 2},
+			{Contents: "line 1", Offset: 1},
+			{Contents: "line 2", Offset: 2},
 		}
 
 		result, err := Search(lines, "", 0)
@@ -21,7 +21,7 @@ func Test_Search(t *testing.T) {
 
 	t.Run("returns empty slice when no match is found", func(t *testing.T) {
 		lines := []LogLine{
-			{Contents: "line 1", LineNumber: 1},
+			{Contents: "line 1", Offset: 1},
 		}
 
 		result, err := Search(lines, "non-existent", 0)
@@ -32,7 +32,7 @@ func Test_Search(t *testing.T) {
 
 	t.Run("performs case-insensitive search", func(t *testing.T) {
 		lines := []LogLine{
-			{Contents: "Some LOG Content", LineNumber: 1},
+			{Contents: "Some LOG Content", Offset: 1},
 		}
 
 		result, err := Search(lines, "log", 0)
@@ -44,7 +44,7 @@ func Test_Search(t *testing.T) {
 
 	t.Run("replaces spaces with catch-all statement", func(t *testing.T) {
 		lines := []LogLine{
-			{Contents: "starting middleware ending", LineNumber: 1},
+			{Contents: "starting middleware ending", Offset: 1},
 		}
 
 		result, err := Search(lines, "starting ending", 0)
@@ -55,7 +55,7 @@ func Test_Search(t *testing.T) {
 
 	t.Run("fills highlight attribute when a match is found", func(t *testing.T) {
 		lines := []LogLine{
-			{Contents: "prefix MATCH suffix", LineNumber: 1},
+			{Contents: "prefix MATCH suffix", Offset: 1},
 		}
 
 		result, err := Search(lines, "match", 0)
@@ -69,8 +69,8 @@ func Test_Search(t *testing.T) {
 
 	t.Run("does not fill highlight for surrounding lines that do not match", func(t *testing.T) {
 		lines := []LogLine{
-			{Contents: "context", LineNumber: 1},
-			{Contents: "match", LineNumber: 2},
+			{Contents: "context", Offset: 1},
+			{Contents: "match", Offset: 2},
 		}
 
 		result, err := Search(lines, "match", 1)
@@ -83,11 +83,11 @@ func Test_Search(t *testing.T) {
 
 	t.Run("surroundingLines", func(t *testing.T) {
 		lines := []LogLine{
-			{Contents: "line 1", LineNumber: 1},
-			{Contents: "line 2", LineNumber: 2},
-			{Contents: "match", LineNumber: 3},
-			{Contents: "line 4", LineNumber: 4},
-			{Contents: "line 5", LineNumber: 5},
+			{Contents: "line 1", Offset: 1},
+			{Contents: "line 2", Offset: 2},
+			{Contents: "match", Offset: 3},
+			{Contents: "line 4", Offset: 4},
+			{Contents: "line 5", Offset: 5},
 		}
 
 		t.Run("includes specified number of surrounding lines", func(t *testing.T) {
@@ -95,15 +95,15 @@ func Test_Search(t *testing.T) {
 
 			assert.NoError(t, err)
 			assert.Len(t, result, 3)
-			assert.Equal(t, 2, result[0].LineNumber)
-			assert.Equal(t, 3, result[1].LineNumber)
-			assert.Equal(t, 4, result[2].LineNumber)
+			assert.Equal(t, int64(2), result[0].Offset)
+			assert.Equal(t, int64(3), result[1].Offset)
+			assert.Equal(t, int64(4), result[2].Offset)
 		})
 
 		t.Run("limits surrounding lines to maximum of 10", func(t *testing.T) {
 			manyLines := make([]LogLine, 25)
 			for i := range manyLines {
-				manyLines[i] = LogLine{Contents: "line", LineNumber: i + 1}
+				manyLines[i] = LogLine{Contents: "line", Offset: int64(i + 1)}
 			}
 			manyLines[12].Contents = "match"
 
@@ -111,15 +111,15 @@ func Test_Search(t *testing.T) {
 
 			assert.NoError(t, err)
 			assert.Len(t, result, 21)
-			assert.Equal(t, 3, result[0].LineNumber)
-			assert.Equal(t, 23, result[len(result)-1].LineNumber)
+			assert.Equal(t, int64(3), result[0].Offset)
+			assert.Equal(t, int64(23), result[len(result)-1].Offset)
 		})
 
 		t.Run("merges overlapping surrounding ranges", func(t *testing.T) {
 			lines := []LogLine{
-				{Contents: "match 1", LineNumber: 1},
-				{Contents: "line 2", LineNumber: 2},
-				{Contents: "match 2", LineNumber: 3},
+				{Contents: "match 1", Offset: 1},
+				{Contents: "line 2", Offset: 2},
+				{Contents: "match 2", Offset: 3},
 			}
 
 			result, err := Search(lines, "match", 1)
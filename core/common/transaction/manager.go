@@ -0,0 +1,11 @@
+package transaction
+
+import (
+	"context"
+)
+
+// Manager runs a set of operations atomically. Every repository called with the context given to the action takes
+// part in the same transaction, which is committed only when the action completes without errors.
+type Manager interface {
+	Run(ctx context.Context, action func(ctx context.Context) error) error
+}
@@ -15,17 +15,23 @@ import (
 	"dillmann.com.br/nginx-ignition/core/certificate"
 	"dillmann.com.br/nginx-ignition/core/common/validation"
 	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 	"dillmann.com.br/nginx-ignition/core/vpn"
 )
 
 type validatorMocks struct {
-	repository  *MockedRepository
-	integration *integration.MockedCommands
-	vpn         *vpn.MockedCommands
-	accessList  *accesslist.MockedCommands
-	cache       *cache.MockedCommands
-	binding     *binding.MockedCommands
-	certificate *certificate.MockedCommands
+	repository      *MockedRepository
+	integration     *integration.MockedCommands
+	vpn             *vpn.MockedCommands
+	accessList      *accesslist.MockedCommands
+	cache           *cache.MockedCommands
+	rateLimit       *ratelimit.MockedCommands
+	securityHeaders *securityheaders.MockedCommands
+	upstream        *upstream.MockedCommands
+	binding         *binding.MockedCommands
+	certificate     *certificate.MockedCommands
 }
 
 func (m *validatorMocks) newValidator() *validator {
@@ -35,6 +41,9 @@ func (m *validatorMocks) newValidator() *validator {
 		m.vpn,
 		m.accessList,
 		m.cache,
+		m.rateLimit,
+		m.securityHeaders,
+		m.upstream,
 		m.binding,
 		m.certificate,
 	)
@@ -58,6 +67,7 @@ func newHost() *Host {
 				Enabled:    true,
 				Priority:   0,
 				SourcePath: "/",
+				MatchType:  PrefixRouteMatchType,
 				Type:       StaticResponseRouteType,
 				Response: &RouteStaticResponse{
 					StatusCode: 200,
@@ -78,17 +88,23 @@ func setupValidator(t *testing.T) (*validator, *validatorMocks) {
 	vpnCmds := vpn.NewMockedCommands(ctrl)
 	aclCmds := accesslist.NewMockedCommands(ctrl)
 	cacheCmds := cache.NewMockedCommands(ctrl)
+	rateLimitCmds := ratelimit.NewMockedCommands(ctrl)
+	securityHeadersCmds := securityheaders.NewMockedCommands(ctrl)
+	upstreamCmds := upstream.NewMockedCommands(ctrl)
 	bindingCmds := binding.NewMockedCommands(ctrl)
 	certCmds := certificate.NewMockedCommands(ctrl)
 
 	mocks := &validatorMocks{
-		repository:  repo,
-		integration: integrationCmds,
-		vpn:         vpnCmds,
-		accessList:  aclCmds,
-		cache:       cacheCmds,
-		binding:     bindingCmds,
-		certificate: certCmds,
+		repository:      repo,
+		integration:     integrationCmds,
+		vpn:             vpnCmds,
+		accessList:      aclCmds,
+		cache:           cacheCmds,
+		rateLimit:       rateLimitCmds,
+		securityHeaders: securityHeadersCmds,
+		upstream:        upstreamCmds,
+		binding:         bindingCmds,
+		certificate:     certCmds,
 	}
 
 	return mocks.newValidator(), mocks
@@ -24,10 +24,50 @@ const (
 	StaticFilesRouteType    RouteType = "STATIC_FILES"
 )
 
+type RouteMatchType string
+
+const (
+	PrefixRouteMatchType               RouteMatchType = "PREFIX"
+	ExactRouteMatchType                RouteMatchType = "EXACT"
+	RegexRouteMatchType                RouteMatchType = "REGEX"
+	CaseInsensitiveRegexRouteMatchType RouteMatchType = "CASE_INSENSITIVE_REGEX"
+)
+
+func (t RouteMatchType) IsRegex() bool {
+	return t == RegexRouteMatchType || t == CaseInsensitiveRegexRouteMatchType
+}
+
+type RewriteRuleFlag string
+
+const (
+	LastRewriteRuleFlag      RewriteRuleFlag = "LAST"
+	BreakRewriteRuleFlag     RewriteRuleFlag = "BREAK"
+	RedirectRewriteRuleFlag  RewriteRuleFlag = "REDIRECT"
+	PermanentRewriteRuleFlag RewriteRuleFlag = "PERMANENT"
+)
+
+type HeaderRuleTarget string
+
+const (
+	RequestHeaderRuleTarget  HeaderRuleTarget = "REQUEST"
+	ResponseHeaderRuleTarget HeaderRuleTarget = "RESPONSE"
+)
+
+type HeaderRuleAction string
+
+const (
+	SetHeaderRuleAction    HeaderRuleAction = "SET"
+	AppendHeaderRuleAction HeaderRuleAction = "APPEND"
+	RemoveHeaderRuleAction HeaderRuleAction = "REMOVE"
+)
+
 type Host struct {
 	AccessListID      *uuid.UUID
 	CacheID           *uuid.UUID
+	RateLimitID       *uuid.UUID
+	SecurityHeadersID *uuid.UUID
 	DomainNames       []string
+	HeaderRules       []HeaderRule
 	Routes            []Route
 	Bindings          []binding.Binding
 	VPNs              []VPN
@@ -51,16 +91,35 @@ type Route struct {
 	TargetURI    *string
 	AccessListID *uuid.UUID
 	CacheID      *uuid.UUID
+	RateLimitID  *uuid.UUID
+	UpstreamID   *uuid.UUID
 	Response     *RouteStaticResponse
 	Integration  *RouteIntegrationConfig
 	SourceCode   *RouteSourceCode
+	HeaderRules  []HeaderRule
+	RewriteRules []RewriteRule
 	Type         RouteType
+	MatchType    RouteMatchType
 	SourcePath   string
 	Priority     int
 	ID           uuid.UUID
 	Enabled      bool
 }
 
+type HeaderRule struct {
+	Value  *string
+	Name   string
+	Target HeaderRuleTarget
+	Action HeaderRuleAction
+	Always bool
+}
+
+type RewriteRule struct {
+	Flag        *RewriteRuleFlag
+	Pattern     string
+	Replacement string
+}
+
 type RouteSourceCode struct {
 	MainFunction *string
 	Language     CodeLanguage
@@ -75,6 +134,7 @@ type RouteSettings struct {
 	IgnoreSSLErrors         bool
 	KeepOriginalDomainName  bool
 	DirectoryListingEnabled bool
+	PreserveQueryString     bool
 }
 
 type RouteStaticResponse struct {
@@ -11,17 +11,23 @@ import (
 	"dillmann.com.br/nginx-ignition/core/certificate"
 	"dillmann.com.br/nginx-ignition/core/common/pagination"
 	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 	"dillmann.com.br/nginx-ignition/core/vpn"
 )
 
 type service struct {
-	repository          Repository
-	integrationCommands integration.Commands
-	vpnCommands         vpn.Commands
-	accessListCommands  accesslist.Commands
-	cacheCommands       cache.Commands
-	bindingCommands     binding.Commands
-	certificateCommands certificate.Commands
+	repository              Repository
+	integrationCommands     integration.Commands
+	vpnCommands             vpn.Commands
+	accessListCommands      accesslist.Commands
+	cacheCommands           cache.Commands
+	rateLimitCommands       ratelimit.Commands
+	securityHeadersCommands securityheaders.Commands
+	upstreamCommands        upstream.Commands
+	bindingCommands         binding.Commands
+	certificateCommands     certificate.Commands
 }
 
 func newCommands(
@@ -30,17 +36,23 @@ func newCommands(
 	vpnCommands vpn.Commands,
 	accessListCommands accesslist.Commands,
 	cacheCommands cache.Commands,
+	rateLimitCommands ratelimit.Commands,
+	securityHeadersCommands securityheaders.Commands,
+	upstreamCommands upstream.Commands,
 	bindingCommands binding.Commands,
 	certificateCommands certificate.Commands,
 ) Commands {
 	return &service{
-		repository:          repository,
-		integrationCommands: integrationCommands,
-		vpnCommands:         vpnCommands,
-		accessListCommands:  accessListCommands,
-		cacheCommands:       cacheCommands,
-		bindingCommands:     bindingCommands,
-		certificateCommands: certificateCommands,
+		repository:              repository,
+		integrationCommands:     integrationCommands,
+		vpnCommands:             vpnCommands,
+		accessListCommands:      accessListCommands,
+		cacheCommands:           cacheCommands,
+		rateLimitCommands:       rateLimitCommands,
+		securityHeadersCommands: securityHeadersCommands,
+		upstreamCommands:        upstreamCommands,
+		bindingCommands:         bindingCommands,
+		certificateCommands:     certificateCommands,
 	}
 }
 
@@ -51,6 +63,9 @@ func (s *service) Save(ctx context.Context, input *Host) error {
 		s.vpnCommands,
 		s.accessListCommands,
 		s.cacheCommands,
+		s.rateLimitCommands,
+		s.securityHeadersCommands,
+		s.upstreamCommands,
 		s.bindingCommands,
 		s.certificateCommands,
 	)
@@ -13,6 +13,9 @@ import (
 	"dillmann.com.br/nginx-ignition/core/certificate"
 	"dillmann.com.br/nginx-ignition/core/common/pagination"
 	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 	"dillmann.com.br/nginx-ignition/core/vpn"
 )
 
@@ -27,6 +30,9 @@ func Test_service(t *testing.T) {
 			vpnCmds := vpn.NewMockedCommands(ctrl)
 			aclCmds := accesslist.NewMockedCommands(ctrl)
 			cacheCmds := cache.NewMockedCommands(ctrl)
+			rateLimitCmds := ratelimit.NewMockedCommands(ctrl)
+			securityHeadersCmds := securityheaders.NewMockedCommands(ctrl)
+			upstreamCmds := upstream.NewMockedCommands(ctrl)
 			bindingCmds := binding.NewMockedCommands(ctrl)
 			certCmds := certificate.NewMockedCommands(ctrl)
 			hostService := newCommands(
@@ -35,6 +41,9 @@ func Test_service(t *testing.T) {
 				vpnCmds,
 				aclCmds,
 				cacheCmds,
+				rateLimitCmds,
+				securityHeadersCmds,
+				upstreamCmds,
 				bindingCmds,
 				certCmds,
 			)
@@ -61,6 +70,9 @@ func Test_service(t *testing.T) {
 			vpnCmds := vpn.NewMockedCommands(ctrl)
 			aclCmds := accesslist.NewMockedCommands(ctrl)
 			cacheCmds := cache.NewMockedCommands(ctrl)
+			rateLimitCmds := ratelimit.NewMockedCommands(ctrl)
+			securityHeadersCmds := securityheaders.NewMockedCommands(ctrl)
+			upstreamCmds := upstream.NewMockedCommands(ctrl)
 			bindingCmds := binding.NewMockedCommands(ctrl)
 			certCmds := certificate.NewMockedCommands(ctrl)
 			hostService := newCommands(
@@ -69,6 +81,9 @@ func Test_service(t *testing.T) {
 				vpnCmds,
 				aclCmds,
 				cacheCmds,
+				rateLimitCmds,
+				securityHeadersCmds,
+				upstreamCmds,
 				bindingCmds,
 				certCmds,
 			)
@@ -93,7 +108,7 @@ func Test_service(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := NewMockedRepository(ctrl)
-			hostService := newCommands(repo, nil, nil, nil, nil, nil, nil)
+			hostService := newCommands(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			id := uuid.New()
 
 			repo.EXPECT().DeleteByID(t.Context(), id).Return(nil)
@@ -109,7 +124,7 @@ func Test_service(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := NewMockedRepository(ctrl)
-			hostService := newCommands(repo, nil, nil, nil, nil, nil, nil)
+			hostService := newCommands(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			pageSize := 10
 			pageNumber := 1
 			search := new("term")
@@ -131,7 +146,7 @@ func Test_service(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := NewMockedRepository(ctrl)
-			hostService := newCommands(repo, nil, nil, nil, nil, nil, nil)
+			hostService := newCommands(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			id := uuid.New()
 
 			expectedHost := newHost()
@@ -149,7 +164,7 @@ func Test_service(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := NewMockedRepository(ctrl)
-			hostService := newCommands(repo, nil, nil, nil, nil, nil, nil)
+			hostService := newCommands(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			expectedHosts := []Host{*newHost()}
 			repo.EXPECT().FindAllEnabled(t.Context()).Return(expectedHosts, nil)
@@ -166,7 +181,7 @@ func Test_service(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := NewMockedRepository(ctrl)
-			hostService := newCommands(repo, nil, nil, nil, nil, nil, nil)
+			hostService := newCommands(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			id := uuid.New()
 
 			repo.EXPECT().ExistsByID(t.Context(), id).Return(true, nil)
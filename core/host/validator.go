@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/google/uuid"
@@ -17,18 +18,24 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/validation"
 	"dillmann.com.br/nginx-ignition/core/common/valuerange"
 	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 	"dillmann.com.br/nginx-ignition/core/vpn"
 )
 
 type validator struct {
-	hostRepository      Repository
-	integrationCommands integration.Commands
-	vpnCommands         vpn.Commands
-	accessListCommands  accesslist.Commands
-	cacheCommands       cache.Commands
-	bindingCommands     binding.Commands
-	certificateCommands certificate.Commands
-	delegate            *validation.ConsistencyValidator
+	hostRepository          Repository
+	integrationCommands     integration.Commands
+	vpnCommands             vpn.Commands
+	accessListCommands      accesslist.Commands
+	cacheCommands           cache.Commands
+	rateLimitCommands       ratelimit.Commands
+	securityHeadersCommands securityheaders.Commands
+	upstreamCommands        upstream.Commands
+	bindingCommands         binding.Commands
+	certificateCommands     certificate.Commands
+	delegate                *validation.ConsistencyValidator
 }
 
 func newValidator(
@@ -37,18 +44,24 @@ func newValidator(
 	vpnCommands vpn.Commands,
 	accessListCommands accesslist.Commands,
 	cacheCommands cache.Commands,
+	rateLimitCommands ratelimit.Commands,
+	securityHeadersCommands securityheaders.Commands,
+	upstreamCommands upstream.Commands,
 	bindingCommands binding.Commands,
 	certificateCommands certificate.Commands,
 ) *validator {
 	return &validator{
-		hostRepository:      hostRepository,
-		integrationCommands: integrationCommands,
-		vpnCommands:         vpnCommands,
-		accessListCommands:  accessListCommands,
-		cacheCommands:       cacheCommands,
-		bindingCommands:     bindingCommands,
-		certificateCommands: certificateCommands,
-		delegate:            validation.NewValidator(),
+		hostRepository:          hostRepository,
+		integrationCommands:     integrationCommands,
+		vpnCommands:             vpnCommands,
+		accessListCommands:      accessListCommands,
+		cacheCommands:           cacheCommands,
+		rateLimitCommands:       rateLimitCommands,
+		securityHeadersCommands: securityHeadersCommands,
+		upstreamCommands:        upstreamCommands,
+		bindingCommands:         bindingCommands,
+		certificateCommands:     certificateCommands,
+		delegate:                validation.NewValidator(),
 	}
 }
 
@@ -59,6 +72,8 @@ const (
 var (
 	redirectStatusCodeRange = valuerange.New(300, 399)
 	statusCodeRange         = valuerange.New(100, 599)
+	headerNamePattern       = regexp.MustCompile(`^[A-Za-z0-9!#%&'*+.^_|~-]+$`)
+	captureReferencePattern = regexp.MustCompile(`\$\{?([0-9])`)
 )
 
 func (v *validator) validate(ctx context.Context, host *Host) error {
@@ -87,6 +102,15 @@ func (v *validator) validate(ctx context.Context, host *Host) error {
 		return err
 	}
 
+	if err := v.validateRateLimit(ctx, host.RateLimitID, "rateLimitId"); err != nil {
+		return err
+	}
+
+	if err := v.validateSecurityHeaders(ctx, host.SecurityHeadersID); err != nil {
+		return err
+	}
+
+	v.validateHeaderRules(ctx, host.HeaderRules, "headerRules")
 	return v.delegate.Result()
 }
 
@@ -193,15 +217,19 @@ func (v *validator) validateRoute(
 	index int,
 	distinctPaths *map[string]bool,
 ) error {
-	if (*distinctPaths)[route.SourcePath] {
+	pathKey := string(route.MatchType) + ":" + route.SourcePath
+	if (*distinctPaths)[pathKey] {
 		v.delegate.Add(
 			buildIndexedRoutePath(index, "sourcePath"),
 			i18n.M(ctx, i18n.K.CoreHostDuplicatedSourcePath),
 		)
 	} else {
-		(*distinctPaths)[route.SourcePath] = true
+		(*distinctPaths)[pathKey] = true
 	}
 
+	v.validateMatchType(ctx, route, index)
+	v.validateRewriteRules(ctx, route.RewriteRules, index)
+
 	if err := v.validateAccessList(
 		ctx,
 		route.AccessListID,
@@ -218,9 +246,19 @@ func (v *validator) validateRoute(
 		return err
 	}
 
+	if err := v.validateRateLimit(
+		ctx,
+		route.RateLimitID,
+		buildIndexedRoutePath(index, "rateLimitId"),
+	); err != nil {
+		return err
+	}
+
+	v.validateHeaderRules(ctx, route.HeaderRules, buildIndexedRoutePath(index, "headerRules"))
+
 	switch route.Type {
 	case ProxyRouteType:
-		v.validateProxyRoute(ctx, route, index)
+		return v.validateProxyRoute(ctx, route, index)
 	case RedirectRouteType:
 		v.validateRedirectRoute(ctx, route, index)
 	case StaticResponseRouteType:
@@ -254,20 +292,57 @@ func (v *validator) validateStaticFilesRoute(ctx context.Context, route *Route,
 	if !strings.HasPrefix(*route.TargetURI, "/") {
 		v.delegate.Add(targetURIField, i18n.M(ctx, i18n.K.CommonStartsWithSlashRequired))
 	}
+
+	if route.MatchType != PrefixRouteMatchType {
+		v.delegate.Add(
+			buildIndexedRoutePath(index, "matchType"),
+			i18n.M(ctx, i18n.K.CoreHostPrefixMatchRequired).V("type", "directory"),
+		)
+	}
 }
 
-func (v *validator) validateProxyRoute(ctx context.Context, route *Route, index int) {
+func (v *validator) validateProxyRoute(ctx context.Context, route *Route, index int) error {
 	targetURIField := buildIndexedRoutePath(index, "targetUri")
+
+	if route.UpstreamID != nil {
+		exists, err := v.upstreamCommands.Exists(ctx, *route.UpstreamID)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			v.delegate.Add(
+				buildIndexedRoutePath(index, "upstreamId"),
+				i18n.M(ctx, i18n.K.CoreHostUpstreamNotFound),
+			)
+		}
+
+		if route.TargetURI != nil && strings.TrimSpace(*route.TargetURI) != "" {
+			if !strings.HasPrefix(*route.TargetURI, "/") {
+				v.delegate.Add(targetURIField, i18n.M(ctx, i18n.K.CommonStartsWithSlashRequired))
+			}
+
+			v.validateRegexTargetPath(ctx, route, *route.TargetURI, targetURIField)
+		}
+
+		return nil
+	}
+
 	if route.TargetURI == nil || strings.TrimSpace(*route.TargetURI) == "" {
 		v.delegate.Add(
 			targetURIField,
 			i18n.M(ctx, i18n.K.CoreHostTargetUriRequired).V("type", "proxy"),
 		)
 	} else {
-		if _, err := url.Parse(*route.TargetURI); err != nil {
+		parsedURL, err := url.Parse(*route.TargetURI)
+		if err != nil {
 			v.delegate.Add(targetURIField, i18n.M(ctx, i18n.K.CommonInvalidUrl))
+		} else {
+			v.validateRegexTargetPath(ctx, route, parsedURL.Path, targetURIField)
 		}
 	}
+
+	return nil
 }
 
 func (v *validator) validateRedirectRoute(ctx context.Context, route *Route, index int) {
@@ -281,6 +356,10 @@ func (v *validator) validateRedirectRoute(ctx context.Context, route *Route, ind
 		if _, err := url.ParseRequestURI(*route.TargetURI); err != nil {
 			v.delegate.Add(targetURIField, i18n.M(ctx, i18n.K.CoreHostInvalidUri))
 		}
+
+		if groups := sourcePathCaptureGroups(route); groups >= 0 {
+			v.validateCaptureReferences(ctx, *route.TargetURI, groups, targetURIField)
+		}
 	}
 
 	if route.RedirectCode == nil || !redirectStatusCodeRange.Contains(*route.RedirectCode) {
@@ -334,15 +413,132 @@ func (v *validator) validateIntegrationRoute(ctx context.Context, route *Route,
 	}
 
 	if route.TargetURI != nil && strings.TrimSpace(*route.TargetURI) != "" {
+		fieldPath := buildIndexedRoutePath(index, "targetUri")
 		if _, err := url.ParseRequestURI(*route.TargetURI); err != nil {
-			fieldPath := buildIndexedRoutePath(index, "targetUri")
 			v.delegate.Add(fieldPath, i18n.M(ctx, i18n.K.CoreHostInvalidUri))
 		}
+
+		v.validateRegexTargetPath(ctx, route, *route.TargetURI, fieldPath)
 	}
 
 	return nil
 }
 
+func (v *validator) validateMatchType(ctx context.Context, route *Route, index int) {
+	switch route.MatchType {
+	case PrefixRouteMatchType, ExactRouteMatchType:
+		return
+	case RegexRouteMatchType, CaseInsensitiveRegexRouteMatchType:
+		v.validateRegularExpression(
+			ctx,
+			route.SourcePath,
+			buildIndexedRoutePath(index, "sourcePath"),
+		)
+	default:
+		v.delegate.Add(
+			buildIndexedRoutePath(index, "matchType"),
+			i18n.M(ctx, i18n.K.CommonInvalidValue),
+		)
+	}
+}
+
+func (v *validator) validateRewriteRules(ctx context.Context, rules []RewriteRule, index int) {
+	for ruleIndex, rule := range rules {
+		basePath := buildIndexedRoutePath(index, fmt.Sprintf("rewriteRules[%d]", ruleIndex))
+		patternPath := basePath + ".pattern"
+		replacementPath := basePath + ".replacement"
+
+		groups := -1
+		if strings.TrimSpace(rule.Pattern) == "" {
+			v.delegate.Add(patternPath, i18n.M(ctx, i18n.K.CommonValueMissing))
+		} else if expression := v.validateRegularExpression(ctx, rule.Pattern, patternPath); expression != nil {
+			groups = expression.NumSubexp()
+		}
+
+		switch {
+		case strings.TrimSpace(rule.Replacement) == "":
+			v.delegate.Add(replacementPath, i18n.M(ctx, i18n.K.CommonValueMissing))
+		case strings.ContainsAny(rule.Replacement, "\r\n"):
+			v.delegate.Add(replacementPath, i18n.M(ctx, i18n.K.CommonLineBreaksNotAllowed))
+		case groups >= 0:
+			v.validateCaptureReferences(ctx, rule.Replacement, groups, replacementPath)
+		}
+
+		if rule.Flag != nil &&
+			*rule.Flag != LastRewriteRuleFlag &&
+			*rule.Flag != BreakRewriteRuleFlag &&
+			*rule.Flag != RedirectRewriteRuleFlag &&
+			*rule.Flag != PermanentRewriteRuleFlag {
+			v.delegate.Add(basePath+".flag", i18n.M(ctx, i18n.K.CommonInvalidValue))
+		}
+	}
+}
+
+// validateRegularExpression compiles the value using Go's RE2 syntax, which is mostly a subset of the PCRE one
+// used by nginx. Features exclusive to PCRE, like lookarounds and backreferences, end up rejected.
+func (v *validator) validateRegularExpression(
+	ctx context.Context,
+	value, path string,
+) *regexp.Regexp {
+	if strings.ContainsAny(value, "\r\n") {
+		v.delegate.Add(path, i18n.M(ctx, i18n.K.CommonLineBreaksNotAllowed))
+		return nil
+	}
+
+	expression, err := regexp.Compile(value)
+	if err != nil {
+		v.delegate.Add(path, i18n.M(ctx, i18n.K.CoreHostInvalidRegularExpression))
+		return nil
+	}
+
+	return expression
+}
+
+func (v *validator) validateCaptureReferences(
+	ctx context.Context,
+	value string,
+	groups int,
+	path string,
+) {
+	for _, match := range captureReferencePattern.FindAllStringSubmatch(value, -1) {
+		group := int(match[1][0] - '0')
+		if group > groups {
+			v.delegate.Add(
+				path,
+				i18n.M(ctx, i18n.K.CoreHostUndefinedCaptureGroup).V("group", group),
+			)
+			return
+		}
+	}
+}
+
+func (v *validator) validateRegexTargetPath(
+	ctx context.Context,
+	route *Route,
+	path, fieldPath string,
+) {
+	if !route.MatchType.IsRegex() {
+		return
+	}
+
+	if path = strings.TrimSpace(path); path != "" && path != "/" {
+		v.delegate.Add(fieldPath, i18n.M(ctx, i18n.K.CoreHostRegexTargetPathNotAllowed))
+	}
+}
+
+func sourcePathCaptureGroups(route *Route) int {
+	if !route.MatchType.IsRegex() {
+		return 0
+	}
+
+	expression, err := regexp.Compile(route.SourcePath)
+	if err != nil {
+		return -1
+	}
+
+	return expression.NumSubexp()
+}
+
 func (v *validator) validateExecuteCodeRoute(ctx context.Context, route *Route, index int) {
 	requiredMessage := i18n.M(ctx, i18n.K.CoreHostSourceCodeRequired)
 
@@ -564,3 +760,79 @@ func (v *validator) validateCache(ctx context.Context, cacheID *uuid.UUID, path
 
 	return nil
 }
+
+func (v *validator) validateRateLimit(
+	ctx context.Context,
+	rateLimitID *uuid.UUID,
+	path string,
+) error {
+	if rateLimitID == nil {
+		return nil
+	}
+
+	exists, err := v.rateLimitCommands.Exists(ctx, *rateLimitID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		v.delegate.Add(path, i18n.M(ctx, i18n.K.CoreHostRateLimitNotFound))
+	}
+
+	return nil
+}
+
+func (v *validator) validateSecurityHeaders(
+	ctx context.Context,
+	securityHeadersID *uuid.UUID,
+) error {
+	if securityHeadersID == nil {
+		return nil
+	}
+
+	exists, err := v.securityHeadersCommands.Exists(ctx, *securityHeadersID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		v.delegate.Add("securityHeadersId", i18n.M(ctx, i18n.K.CoreHostSecurityHeadersNotFound))
+	}
+
+	return nil
+}
+
+func (v *validator) validateHeaderRules(ctx context.Context, rules []HeaderRule, basePath string) {
+	for index, rule := range rules {
+		rulePath := fmt.Sprintf("%s[%d]", basePath, index)
+
+		if strings.TrimSpace(rule.Name) == "" {
+			v.delegate.Add(rulePath+".name", i18n.M(ctx, i18n.K.CommonValueMissing))
+		} else if !headerNamePattern.MatchString(rule.Name) {
+			v.delegate.Add(rulePath+".name", i18n.M(ctx, i18n.K.CoreHostInvalidHeaderName))
+		}
+
+		if rule.Target != RequestHeaderRuleTarget && rule.Target != ResponseHeaderRuleTarget {
+			v.delegate.Add(rulePath+".target", i18n.M(ctx, i18n.K.CommonInvalidValue))
+		}
+
+		switch rule.Action {
+		case SetHeaderRuleAction, AppendHeaderRuleAction:
+			v.validateHeaderRuleValue(ctx, rule.Value, rulePath+".value")
+		case RemoveHeaderRuleAction:
+		default:
+			v.delegate.Add(rulePath+".action", i18n.M(ctx, i18n.K.CommonInvalidValue))
+		}
+	}
+}
+
+func (v *validator) validateHeaderRuleValue(ctx context.Context, value *string, path string) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		v.delegate.Add(path, i18n.M(ctx, i18n.K.CommonValueMissing))
+		return
+	}
+
+	if strings.ContainsAny(*value, "\r\n") {
+		v.delegate.Add(path, i18n.M(ctx, i18n.K.CommonLineBreaksNotAllowed))
+	}
+}
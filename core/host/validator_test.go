@@ -124,12 +124,14 @@ func Test_validator(t *testing.T) {
 					{
 						Priority:   10,
 						SourcePath: "/a",
+						MatchType:  PrefixRouteMatchType,
 						Type:       StaticResponseRouteType,
 						Response:   &RouteStaticResponse{StatusCode: 200, Payload: new("ok")},
 					},
 					{
 						Priority:   10,
 						SourcePath: "/b",
+						MatchType:  PrefixRouteMatchType,
 						Type:       StaticResponseRouteType,
 						Response:   &RouteStaticResponse{StatusCode: 200, Payload: new("ok")},
 					},
@@ -151,12 +153,14 @@ func Test_validator(t *testing.T) {
 					{
 						Priority:   10,
 						SourcePath: "/a",
+						MatchType:  PrefixRouteMatchType,
 						Type:       StaticResponseRouteType,
 						Response:   &RouteStaticResponse{StatusCode: 200, Payload: new("ok")},
 					},
 					{
 						Priority:   20,
 						SourcePath: "/a",
+						MatchType:  PrefixRouteMatchType,
 						Type:       StaticResponseRouteType,
 						Response:   &RouteStaticResponse{StatusCode: 200, Payload: new("ok")},
 					},
@@ -194,6 +198,37 @@ func Test_validator(t *testing.T) {
 					assertViolations(t, err, i18n.K.CommonInvalidUrl)
 				})
 
+				t.Run("Proxy with upstream", func(t *testing.T) {
+					hostValidator, mocks := setupValidator(t)
+					h := newHost()
+					upstreamID := uuid.New()
+					h.Routes[0].Type = ProxyRouteType
+					h.Routes[0].UpstreamID = &upstreamID
+					h.Routes[0].TargetURI = new("api")
+
+					mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+					mocks.repository.EXPECT().FindDefault(t.Context()).Return(nil, nil).AnyTimes()
+					mocks.binding.EXPECT().
+						Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(nil).
+						AnyTimes()
+					mocks.upstream.EXPECT().Exists(t.Context(), upstreamID).Return(false, nil)
+
+					err := hostValidator.validate(t.Context(), h)
+					assertViolations(
+						t,
+						err,
+						i18n.K.CoreHostUpstreamNotFound,
+						i18n.K.CommonStartsWithSlashRequired,
+					)
+
+					h.Routes[0].TargetURI = nil
+					mocks.upstream.EXPECT().Exists(t.Context(), upstreamID).Return(true, nil)
+					hostValidator = mocks.newValidator()
+					err = hostValidator.validate(t.Context(), h)
+					assert.NoError(t, err)
+				})
+
 				t.Run("Redirect", func(t *testing.T) {
 					hostValidator, mocks := setupValidator(t)
 					h := newHost()
@@ -581,5 +616,257 @@ func Test_validator(t *testing.T) {
 			err := hostValidator.validate(t.Context(), h)
 			assertViolations(t, err, i18n.K.CoreHostCacheNotFound)
 		})
+
+		t.Run("validates rate limit", func(t *testing.T) {
+			hostValidator, mocks := setupValidator(t)
+			h := newHost()
+			rateLimitID := uuid.New()
+			h.Routes[0].RateLimitID = &rateLimitID
+
+			mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+			mocks.binding.EXPECT().
+				Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil)
+			mocks.rateLimit.EXPECT().Exists(t.Context(), rateLimitID).Return(false, nil)
+
+			err := hostValidator.validate(t.Context(), h)
+			assertViolations(t, err, i18n.K.CoreHostRateLimitNotFound)
+		})
+
+		t.Run("validates security headers", func(t *testing.T) {
+			hostValidator, mocks := setupValidator(t)
+			h := newHost()
+			securityHeadersID := uuid.New()
+			h.SecurityHeadersID = &securityHeadersID
+
+			mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+			mocks.binding.EXPECT().
+				Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil)
+			mocks.securityHeaders.EXPECT().Exists(t.Context(), securityHeadersID).Return(false, nil)
+
+			err := hostValidator.validate(t.Context(), h)
+			assertViolations(t, err, i18n.K.CoreHostSecurityHeadersNotFound)
+		})
+
+		t.Run("validates match types", func(t *testing.T) {
+			t.Run("invalid regular expression", func(t *testing.T) {
+				hostValidator, mocks := setupValidator(t)
+				h := newHost()
+				h.Routes[0].MatchType = RegexRouteMatchType
+				h.Routes[0].SourcePath = "^/(?=api)"
+
+				mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+				mocks.binding.EXPECT().
+					Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				err := hostValidator.validate(t.Context(), h)
+				assertViolations(t, err, i18n.K.CoreHostInvalidRegularExpression)
+			})
+
+			t.Run("unknown match type", func(t *testing.T) {
+				hostValidator, mocks := setupValidator(t)
+				h := newHost()
+				h.Routes[0].MatchType = "SUFFIX"
+
+				mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+				mocks.binding.EXPECT().
+					Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				err := hostValidator.validate(t.Context(), h)
+				assertViolations(t, err, i18n.K.CommonInvalidValue)
+			})
+
+			t.Run("same path with another match type is allowed", func(t *testing.T) {
+				hostValidator, mocks := setupValidator(t)
+				h := newHost()
+				exactRoute := h.Routes[0]
+				exactRoute.Priority = 10
+				exactRoute.MatchType = ExactRouteMatchType
+				h.Routes = append(h.Routes, exactRoute)
+
+				mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+				mocks.binding.EXPECT().
+					Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				err := hostValidator.validate(t.Context(), h)
+				assert.NoError(t, err)
+			})
+
+			t.Run("regex proxy target with a path", func(t *testing.T) {
+				hostValidator, mocks := setupValidator(t)
+				h := newHost()
+				h.Routes[0].Type = ProxyRouteType
+				h.Routes[0].MatchType = CaseInsensitiveRegexRouteMatchType
+				h.Routes[0].SourcePath = `\.php$`
+				h.Routes[0].TargetURI = new("http://backend:8080/app")
+
+				mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+				mocks.binding.EXPECT().
+					Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					AnyTimes()
+
+				err := hostValidator.validate(t.Context(), h)
+				assertViolations(t, err, i18n.K.CoreHostRegexTargetPathNotAllowed)
+
+				h.Routes[0].TargetURI = new("http://backend:8080/")
+				hostValidator = mocks.newValidator()
+				err = hostValidator.validate(t.Context(), h)
+				assert.NoError(t, err)
+			})
+
+			t.Run("redirect reusing the captured groups", func(t *testing.T) {
+				hostValidator, mocks := setupValidator(t)
+				h := newHost()
+				h.Routes[0].Type = RedirectRouteType
+				h.Routes[0].MatchType = RegexRouteMatchType
+				h.Routes[0].SourcePath = `^/blog/(\d+)$`
+				h.Routes[0].RedirectCode = new(301)
+				h.Routes[0].TargetURI = new("https://example.com/posts/$1")
+
+				mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+				mocks.binding.EXPECT().
+					Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					AnyTimes()
+
+				err := hostValidator.validate(t.Context(), h)
+				assert.NoError(t, err)
+
+				h.Routes[0].TargetURI = new("https://example.com/posts/$2")
+				hostValidator = mocks.newValidator()
+				err = hostValidator.validate(t.Context(), h)
+				assertViolations(t, err, i18n.K.CoreHostUndefinedCaptureGroup)
+			})
+		})
+
+		t.Run("validates rewrite rules", func(t *testing.T) {
+			t.Run("undefined capture group and invalid flag", func(t *testing.T) {
+				hostValidator, mocks := setupValidator(t)
+				h := newHost()
+				h.Routes[0].RewriteRules = []RewriteRule{
+					{
+						Pattern:     "^/old/(.*)$",
+						Replacement: "/new/$2",
+						Flag:        new(RewriteRuleFlag("STOP")),
+					},
+				}
+
+				mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+				mocks.binding.EXPECT().
+					Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				err := hostValidator.validate(t.Context(), h)
+				assertViolations(
+					t,
+					err,
+					i18n.K.CoreHostUndefinedCaptureGroup,
+					i18n.K.CommonInvalidValue,
+				)
+			})
+
+			t.Run("missing values", func(t *testing.T) {
+				hostValidator, mocks := setupValidator(t)
+				h := newHost()
+				h.Routes[0].RewriteRules = []RewriteRule{{}}
+
+				mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+				mocks.binding.EXPECT().
+					Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				err := hostValidator.validate(t.Context(), h)
+				assertViolations(t, err, i18n.K.CommonValueMissing)
+			})
+
+			t.Run("valid rule passes", func(t *testing.T) {
+				hostValidator, mocks := setupValidator(t)
+				h := newHost()
+				h.Routes[0].RewriteRules = []RewriteRule{
+					{
+						Pattern:     "^/old/(?P<slug>[a-z-]+)/(\\d+)$",
+						Replacement: "/new/$slug?page=$2",
+						Flag:        new(LastRewriteRuleFlag),
+					},
+				}
+
+				mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+				mocks.binding.EXPECT().
+					Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				err := hostValidator.validate(t.Context(), h)
+				assert.NoError(t, err)
+			})
+		})
+
+		t.Run("validates header rules", func(t *testing.T) {
+			t.Run("invalid header name", func(t *testing.T) {
+				hostValidator, mocks := setupValidator(t)
+				h := newHost()
+				h.HeaderRules = []HeaderRule{
+					{
+						Name:   "X Custom",
+						Value:  new("value"),
+						Target: RequestHeaderRuleTarget,
+						Action: SetHeaderRuleAction,
+					},
+				}
+
+				mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+				mocks.binding.EXPECT().
+					Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				err := hostValidator.validate(t.Context(), h)
+				assertViolations(t, err, i18n.K.CoreHostInvalidHeaderName)
+			})
+
+			t.Run("value with line breaks", func(t *testing.T) {
+				hostValidator, mocks := setupValidator(t)
+				h := newHost()
+				h.Routes[0].HeaderRules = []HeaderRule{
+					{
+						Name:   "X-Custom",
+						Value:  new("first\nsecond"),
+						Target: ResponseHeaderRuleTarget,
+						Action: AppendHeaderRuleAction,
+					},
+				}
+
+				mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+				mocks.binding.EXPECT().
+					Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				err := hostValidator.validate(t.Context(), h)
+				assertViolations(t, err, i18n.K.CommonLineBreaksNotAllowed)
+			})
+
+			t.Run("value not required when removing", func(t *testing.T) {
+				hostValidator, mocks := setupValidator(t)
+				h := newHost()
+				h.HeaderRules = []HeaderRule{
+					{
+						Name:   "Server",
+						Target: ResponseHeaderRuleTarget,
+						Action: RemoveHeaderRuleAction,
+					},
+				}
+
+				mocks.vpn.EXPECT().GetAvailableDrivers(t.Context()).Return(nil, nil).AnyTimes()
+				mocks.binding.EXPECT().
+					Validate(t.Context(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				err := hostValidator.validate(t.Context(), h)
+				assert.NoError(t, err)
+			})
+		})
 	})
 }
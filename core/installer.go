@@ -2,6 +2,8 @@ package core
 
 import (
 	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/audit"
 	"dillmann.com.br/nginx-ignition/core/backup"
 	"dillmann.com.br/nginx-ignition/core/binding"
 	"dillmann.com.br/nginx-ignition/core/cache"
@@ -11,9 +13,19 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/scheduler"
 	"dillmann.com.br/nginx-ignition/core/host"
 	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/loginattempt"
+	"dillmann.com.br/nginx-ignition/core/logshipping"
 	"dillmann.com.br/nginx-ignition/core/nginx"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/revision"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/session"
 	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/state"
 	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/trafficstats"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 	"dillmann.com.br/nginx-ignition/core/user"
 	"dillmann.com.br/nginx-ignition/core/vpn"
 )
@@ -22,17 +34,29 @@ func Install() error {
 	return container.Run(
 		broadcast.Install,
 		scheduler.Install,
+		audit.Install,
 		settings.Install,
+		loginattempt.Install,
 		user.Install,
+		apitoken.Install,
+		session.Install,
 		accesslist.Install,
 		binding.Install,
 		cache.Install,
+		ratelimit.Install,
+		securityheaders.Install,
+		upstream.Install,
 		certificate.Install,
+		tlsprofile.Install,
 		vpn.Install,
 		host.Install,
 		integration.Install,
 		stream.Install,
+		revision.Install,
+		state.Install,
 		nginx.Install,
+		trafficstats.Install,
+		logshipping.Install,
 		backup.Install,
 	)
 }
@@ -0,0 +1,27 @@
+package loginattempt
+
+import (
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+)
+
+func newAttempt(scope Scope, identifier string) *Attempt {
+	return &Attempt{
+		Scope:         scope,
+		Identifier:    identifier,
+		FailureCount:  1,
+		LastFailureAt: time.Now(),
+	}
+}
+
+func newConfiguration() *configuration.Configuration {
+	return configuration.NewWithOverrides(map[string]string{
+		"nginx-ignition.security.login-protection.enabled":                "true",
+		"nginx-ignition.security.login-protection.max-username-failures":  "3",
+		"nginx-ignition.security.login-protection.max-address-failures":   "10",
+		"nginx-ignition.security.login-protection.lockout-seconds":        "60",
+		"nginx-ignition.security.login-protection.max-lockout-seconds":    "300",
+		"nginx-ignition.security.login-protection.failure-window-seconds": "900",
+	})
+}
@@ -0,0 +1,14 @@
+package loginattempt
+
+import (
+	"context"
+	"time"
+)
+
+type Commands interface {
+	IsLocked(ctx context.Context, username, sourceAddress string) (bool, error)
+	RecordFailure(ctx context.Context, username, sourceAddress string) error
+	RecordSuccess(ctx context.Context, username string) error
+	GetLockedUntil(ctx context.Context, username string) (*time.Time, error)
+	Unlock(ctx context.Context, username string) error
+}
@@ -0,0 +1,26 @@
+package loginattempt
+
+import (
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/container"
+)
+
+func Install() error {
+	if err := container.Provide(newCommands); err != nil {
+		return err
+	}
+
+	return container.Run(registerScheduledTask)
+}
+
+func newCommands(
+	repository Repository,
+	cfg *configuration.Configuration,
+) (Commands, *service, error) {
+	serviceInstance := newService(repository, cfg)
+	if _, err := serviceInstance.readSettings(); err != nil {
+		return nil, nil, err
+	}
+
+	return serviceInstance, serviceInstance, nil
+}
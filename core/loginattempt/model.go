@@ -0,0 +1,20 @@
+package loginattempt
+
+import (
+	"time"
+)
+
+type Scope string
+
+const (
+	UsernameScope Scope = "USERNAME"
+	AddressScope  Scope = "ADDRESS"
+)
+
+type Attempt struct {
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+	Scope         Scope
+	Identifier    string
+	FailureCount  int
+}
@@ -0,0 +1,44 @@
+package loginattempt
+
+import (
+	"context"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+)
+
+const (
+	purgeInterval = time.Hour
+)
+
+type purgeTask struct {
+	service *service
+}
+
+func registerScheduledTask(
+	ctx context.Context,
+	service *service,
+	sched *scheduler.Scheduler,
+) error {
+	task := purgeTask{service}
+	return sched.Register(ctx, &task)
+}
+
+func (t purgeTask) Run(ctx context.Context) error {
+	return t.service.purgeStale(ctx)
+}
+
+func (t purgeTask) Schedule(_ context.Context) (*scheduler.Schedule, error) {
+	return &scheduler.Schedule{
+		Enabled:  true,
+		Interval: purgeInterval,
+	}, nil
+}
+
+func (t purgeTask) OnScheduleStarted(_ context.Context) {
+	log.Infof(
+		"Stale login attempts purge task scheduled to run every %v minutes",
+		purgeInterval.Minutes(),
+	)
+}
@@ -0,0 +1,20 @@
+package loginattempt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_purgeTask(t *testing.T) {
+	t.Run("Schedule", func(t *testing.T) {
+		t.Run("runs hourly", func(t *testing.T) {
+			task := &purgeTask{}
+			schedule, err := task.Schedule(t.Context())
+
+			assert.NoError(t, err)
+			assert.True(t, schedule.Enabled)
+			assert.Equal(t, purgeInterval, schedule.Interval)
+		})
+	})
+}
@@ -0,0 +1,19 @@
+package loginattempt
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	FindByKey(ctx context.Context, scope Scope, identifier string) (*Attempt, error)
+	IncrementFailures(
+		ctx context.Context,
+		scope Scope,
+		identifier string,
+		now, windowStart time.Time,
+	) (*Attempt, error)
+	ExtendLock(ctx context.Context, scope Scope, identifier string, lockedUntil time.Time) error
+	DeleteByKey(ctx context.Context, scope Scope, identifier string) error
+	DeleteStale(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,222 @@
+package loginattempt
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+)
+
+type settings struct {
+	enabled             bool
+	maxUsernameFailures int
+	maxAddressFailures  int
+	lockout             time.Duration
+	maxLockout          time.Duration
+	failureWindow       time.Duration
+}
+
+type service struct {
+	repository    Repository
+	configuration *configuration.Configuration
+}
+
+func newService(repository Repository, cfg *configuration.Configuration) *service {
+	return &service{
+		repository:    repository,
+		configuration: cfg,
+	}
+}
+
+func (s *service) IsLocked(ctx context.Context, username, sourceAddress string) (bool, error) {
+	cfg, err := s.readSettings()
+	if err != nil || !cfg.enabled {
+		return false, err
+	}
+
+	now := time.Now()
+	for scope, identifier := range attemptKeys(username, sourceAddress) {
+		attempt, err := s.repository.FindByKey(ctx, scope, identifier)
+		if err != nil {
+			return false, err
+		}
+
+		if attempt != nil && attempt.LockedUntil != nil && attempt.LockedUntil.After(now) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *service) RecordFailure(ctx context.Context, username, sourceAddress string) error {
+	cfg, err := s.readSettings()
+	if err != nil || !cfg.enabled {
+		return err
+	}
+
+	maxFailures := map[Scope]int{
+		UsernameScope: cfg.maxUsernameFailures,
+		AddressScope:  cfg.maxAddressFailures,
+	}
+
+	now := time.Now()
+	for scope, identifier := range attemptKeys(username, sourceAddress) {
+		attempt, err := s.repository.IncrementFailures(
+			ctx,
+			scope,
+			identifier,
+			now,
+			now.Add(-cfg.failureWindow),
+		)
+		if err != nil {
+			return err
+		}
+
+		if attempt.FailureCount < maxFailures[scope] {
+			continue
+		}
+
+		lockedUntil := now.Add(lockoutDuration(attempt.FailureCount-maxFailures[scope], cfg))
+		if err = s.repository.ExtendLock(ctx, scope, identifier, lockedUntil); err != nil {
+			return err
+		}
+
+		log.Warnf(
+			"Login locked for the %s %s until %s after %d failed attempts",
+			strings.ToLower(string(scope)),
+			identifier,
+			lockedUntil.Format(time.RFC3339),
+			attempt.FailureCount,
+		)
+	}
+
+	return nil
+}
+
+func (s *service) RecordSuccess(ctx context.Context, username string) error {
+	return s.repository.DeleteByKey(ctx, UsernameScope, normalizeUsername(username))
+}
+
+func (s *service) GetLockedUntil(ctx context.Context, username string) (*time.Time, error) {
+	attempt, err := s.repository.FindByKey(ctx, UsernameScope, normalizeUsername(username))
+	if err != nil {
+		return nil, err
+	}
+
+	if attempt == nil || attempt.LockedUntil == nil || !attempt.LockedUntil.After(time.Now()) {
+		return nil, nil
+	}
+
+	return attempt.LockedUntil, nil
+}
+
+func (s *service) Unlock(ctx context.Context, username string) error {
+	identifier := normalizeUsername(username)
+	if err := s.repository.DeleteByKey(ctx, UsernameScope, identifier); err != nil {
+		return err
+	}
+
+	log.Infof("Login unlocked for the username %s", identifier)
+	return nil
+}
+
+func (s *service) purgeStale(ctx context.Context) error {
+	cfg, err := s.readSettings()
+	if err != nil {
+		return err
+	}
+
+	count, err := s.repository.DeleteStale(ctx, time.Now().Add(-cfg.failureWindow))
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		log.Infof("Purged %d stale login attempts", count)
+	}
+
+	return nil
+}
+
+func (s *service) readSettings() (*settings, error) {
+	cfg := s.configuration.WithPrefix("nginx-ignition.security.login-protection")
+
+	enabled, err := cfg.GetBoolean("enabled")
+	if err != nil {
+		return nil, err
+	}
+
+	maxUsernameFailures, err := cfg.GetInt("max-username-failures")
+	if err != nil {
+		return nil, err
+	}
+
+	maxAddressFailures, err := cfg.GetInt("max-address-failures")
+	if err != nil {
+		return nil, err
+	}
+
+	lockoutSeconds, err := cfg.GetInt("lockout-seconds")
+	if err != nil {
+		return nil, err
+	}
+
+	maxLockoutSeconds, err := cfg.GetInt("max-lockout-seconds")
+	if err != nil {
+		return nil, err
+	}
+
+	failureWindowSeconds, err := cfg.GetInt("failure-window-seconds")
+	if err != nil {
+		return nil, err
+	}
+
+	if enabled && (maxUsernameFailures <= 0 || maxAddressFailures <= 0) {
+		return nil, errors.New(
+			"the maximum amount of login failures must be greater than 0 when the login " +
+				"protection is enabled",
+		)
+	}
+
+	return &settings{
+		enabled:             enabled,
+		maxUsernameFailures: maxUsernameFailures,
+		maxAddressFailures:  maxAddressFailures,
+		lockout:             time.Duration(lockoutSeconds) * time.Second,
+		maxLockout:          time.Duration(maxLockoutSeconds) * time.Second,
+		failureWindow:       time.Duration(failureWindowSeconds) * time.Second,
+	}, nil
+}
+
+func attemptKeys(username, sourceAddress string) map[Scope]string {
+	keys := make(map[Scope]string)
+	if identifier := normalizeUsername(username); identifier != "" {
+		keys[UsernameScope] = identifier
+	}
+
+	if identifier := strings.TrimSpace(sourceAddress); identifier != "" {
+		keys[AddressScope] = identifier
+	}
+
+	return keys
+}
+
+func normalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+func lockoutDuration(exceededFailures int, cfg *settings) time.Duration {
+	duration := cfg.lockout
+	for range exceededFailures {
+		duration *= 2
+		if duration >= cfg.maxLockout {
+			return cfg.maxLockout
+		}
+	}
+
+	return min(duration, cfg.maxLockout)
+}
@@ -0,0 +1,275 @@
+package loginattempt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+)
+
+func Test_service(t *testing.T) {
+	t.Run("IsLocked", func(t *testing.T) {
+		t.Run("returns false when there are no failures", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByKey(t.Context(), UsernameScope, "admin").Return(nil, nil)
+			repository.EXPECT().FindByKey(t.Context(), AddressScope, "10.0.0.1").Return(nil, nil)
+
+			locked, err := newService(repository, newConfiguration()).
+				IsLocked(t.Context(), "Admin", "10.0.0.1")
+
+			require.NoError(t, err)
+			assert.False(t, locked)
+		})
+
+		t.Run("returns true when the source address is locked", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attempt := newAttempt(AddressScope, "10.0.0.1")
+			attempt.LockedUntil = new(time.Now().Add(time.Minute))
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				FindByKey(t.Context(), UsernameScope, "admin").
+				Return(nil, nil).
+				AnyTimes()
+			repository.EXPECT().
+				FindByKey(t.Context(), AddressScope, "10.0.0.1").
+				Return(attempt, nil)
+
+			locked, err := newService(repository, newConfiguration()).
+				IsLocked(t.Context(), "admin", "10.0.0.1")
+
+			require.NoError(t, err)
+			assert.True(t, locked)
+		})
+
+		t.Run("returns false when the lockout already expired", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attempt := newAttempt(UsernameScope, "admin")
+			attempt.LockedUntil = new(time.Now().Add(-time.Minute))
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByKey(t.Context(), UsernameScope, "admin").Return(attempt, nil)
+
+			locked, err := newService(
+				repository,
+				newConfiguration(),
+			).IsLocked(t.Context(), "admin", "")
+
+			require.NoError(t, err)
+			assert.False(t, locked)
+		})
+
+		t.Run("returns false when the protection is disabled", func(t *testing.T) {
+			cfg := configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.security.login-protection.enabled": "false",
+			})
+
+			locked, err := newService(nil, cfg).IsLocked(t.Context(), "admin", "10.0.0.1")
+
+			require.NoError(t, err)
+			assert.False(t, locked)
+		})
+	})
+
+	t.Run("RecordFailure", func(t *testing.T) {
+		t.Run("starts tracking new failures", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				IncrementFailures(t.Context(), UsernameScope, "admin", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, _ Scope, _ string, now, windowStart time.Time) (*Attempt, error) {
+					assert.Equal(t, 15*time.Minute, now.Sub(windowStart))
+					return newAttempt(UsernameScope, "admin"), nil
+				})
+
+			err := newService(
+				repository,
+				newConfiguration(),
+			).RecordFailure(t.Context(), "admin", "")
+
+			require.NoError(t, err)
+		})
+
+		t.Run("locks the username after too many failures", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attempt := newAttempt(UsernameScope, "admin")
+			attempt.FailureCount = 3
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				IncrementFailures(t.Context(), UsernameScope, "admin", gomock.Any(), gomock.Any()).
+				Return(attempt, nil)
+			repository.EXPECT().
+				ExtendLock(t.Context(), UsernameScope, "admin", gomock.Any()).
+				DoAndReturn(func(_ any, _ Scope, _ string, lockedUntil time.Time) error {
+					assert.WithinDuration(t, time.Now().Add(time.Minute), lockedUntil, time.Second)
+					return nil
+				})
+
+			err := newService(
+				repository,
+				newConfiguration(),
+			).RecordFailure(t.Context(), "admin", "")
+
+			require.NoError(t, err)
+		})
+
+		t.Run("doubles the lockout for each additional failure", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attempt := newAttempt(UsernameScope, "admin")
+			attempt.FailureCount = 5
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				IncrementFailures(t.Context(), UsernameScope, "admin", gomock.Any(), gomock.Any()).
+				Return(attempt, nil)
+			repository.EXPECT().
+				ExtendLock(t.Context(), UsernameScope, "admin", gomock.Any()).
+				DoAndReturn(func(_ any, _ Scope, _ string, lockedUntil time.Time) error {
+					assert.WithinDuration(
+						t,
+						time.Now().Add(4*time.Minute),
+						lockedUntil,
+						time.Second,
+					)
+					return nil
+				})
+
+			err := newService(
+				repository,
+				newConfiguration(),
+			).RecordFailure(t.Context(), "admin", "")
+
+			require.NoError(t, err)
+		})
+
+		t.Run("caps the lockout at the maximum duration", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attempt := newAttempt(UsernameScope, "admin")
+			attempt.FailureCount = 21
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				IncrementFailures(t.Context(), UsernameScope, "admin", gomock.Any(), gomock.Any()).
+				Return(attempt, nil)
+			repository.EXPECT().
+				ExtendLock(t.Context(), UsernameScope, "admin", gomock.Any()).
+				DoAndReturn(func(_ any, _ Scope, _ string, lockedUntil time.Time) error {
+					assert.WithinDuration(
+						t,
+						time.Now().Add(5*time.Minute),
+						lockedUntil,
+						time.Second,
+					)
+					return nil
+				})
+
+			err := newService(
+				repository,
+				newConfiguration(),
+			).RecordFailure(t.Context(), "admin", "")
+
+			require.NoError(t, err)
+		})
+
+		t.Run("tracks the username and the source address", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				IncrementFailures(t.Context(), UsernameScope, "admin", gomock.Any(), gomock.Any()).
+				Return(newAttempt(UsernameScope, "admin"), nil)
+			repository.EXPECT().
+				IncrementFailures(t.Context(), AddressScope, "10.0.0.1", gomock.Any(), gomock.Any()).
+				Return(newAttempt(AddressScope, "10.0.0.1"), nil)
+
+			err := newService(
+				repository,
+				newConfiguration(),
+			).RecordFailure(t.Context(), "Admin", "10.0.0.1")
+
+			require.NoError(t, err)
+		})
+
+		t.Run("rejects a maximum amount of failures lower than 1", func(t *testing.T) {
+			cfg := configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.security.login-protection.max-address-failures": "0",
+			})
+
+			err := newService(nil, cfg).RecordFailure(t.Context(), "admin", "10.0.0.1")
+
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("GetLockedUntil", func(t *testing.T) {
+		t.Run("returns the lockout expiration when locked", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attempt := newAttempt(UsernameScope, "admin")
+			attempt.LockedUntil = new(time.Now().Add(time.Minute))
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByKey(t.Context(), UsernameScope, "admin").Return(attempt, nil)
+
+			result, err := newService(
+				repository,
+				newConfiguration(),
+			).GetLockedUntil(t.Context(), "admin")
+
+			require.NoError(t, err)
+			assert.Equal(t, attempt.LockedUntil, result)
+		})
+
+		t.Run("returns nil when not locked", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByKey(t.Context(), UsernameScope, "admin").Return(nil, nil)
+
+			result, err := newService(
+				repository,
+				newConfiguration(),
+			).GetLockedUntil(t.Context(), "admin")
+
+			require.NoError(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("Unlock", func(t *testing.T) {
+		t.Run("removes the username failures", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().DeleteByKey(t.Context(), UsernameScope, "admin").Return(nil)
+
+			err := newService(repository, newConfiguration()).Unlock(t.Context(), " Admin ")
+
+			require.NoError(t, err)
+		})
+	})
+}
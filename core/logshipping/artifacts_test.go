@@ -0,0 +1,50 @@
+package logshipping
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+func newRecord(hostID *uuid.UUID) record {
+	return record{
+		time:     time.Date(2026, 10, 18, 10, 30, 0, 0, time.UTC),
+		hostID:   hostID,
+		fileName: "host-" + hostID.String() + ".access.log",
+		logType:  accessQualifier,
+		contents: `127.0.0.1 - - [18/Oct/2026:10:30:00 +0000] "GET / HTTP/1.1" 200 12 "-" "curl"`,
+		severity: infoSeverity,
+	}
+}
+
+func newOTLPDestination(address string) *settings.LogDestination {
+	return &settings.LogDestination{
+		Type:       settings.OTLPLogDestinationType,
+		Protocol:   settings.HTTPLogDestinationProtocol,
+		Address:    address,
+		AccessLogs: true,
+		ErrorLogs:  true,
+	}
+}
+
+func newSyslogDestination(address string) *settings.LogDestination {
+	return &settings.LogDestination{
+		Type:       settings.SyslogLogDestinationType,
+		Protocol:   settings.TCPLogDestinationProtocol,
+		Address:    address,
+		Facility:   "local7",
+		Tag:        new("edge"),
+		AccessLogs: true,
+		ErrorLogs:  true,
+	}
+}
+
+func newConfiguration() *configuration.Configuration {
+	return configuration.NewWithOverrides(map[string]string{
+		"nginx-ignition.log-shipping.reconcile-interval-seconds": "15",
+		"nginx-ignition.log-shipping.request-timeout-seconds":    "5",
+	})
+}
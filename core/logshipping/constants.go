@@ -0,0 +1,12 @@
+package logshipping
+
+const (
+	shutdownPriority  = 0
+	serviceName       = "nginx-ignition"
+	defaultSyslogPort = "514"
+	defaultSyslogTag  = "nginx"
+	otlpLogsPath      = "/v1/logs"
+	accessQualifier   = "access"
+	errorQualifier    = "error"
+	mainLogFileName   = "main.log"
+)
@@ -0,0 +1,30 @@
+package logshipping
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+type exporter interface {
+	export(ctx context.Context, records []record) error
+	close() error
+}
+
+type exporterFactory func(
+	destination *settings.LogDestination,
+	timeout time.Duration,
+) (exporter, error)
+
+func newExporter(destination *settings.LogDestination, timeout time.Duration) (exporter, error) {
+	switch destination.Type {
+	case settings.OTLPLogDestinationType:
+		return newOTLPExporter(destination, timeout)
+	case settings.SyslogLogDestinationType:
+		return newSyslogExporter(destination, timeout)
+	default:
+		return nil, fmt.Errorf("unsupported log destination type: %s", destination.Type)
+	}
+}
@@ -0,0 +1,13 @@
+package logshipping
+
+import (
+	"dillmann.com.br/nginx-ignition/core/common/container"
+)
+
+func Install() error {
+	if err := container.Provide(newService); err != nil {
+		return err
+	}
+
+	return container.Run(registerReconcileTask, registerShutdown)
+}
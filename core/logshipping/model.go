@@ -0,0 +1,54 @@
+package logshipping
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type source struct {
+	hostID    *uuid.UUID
+	qualifier string
+}
+
+type record struct {
+	time     time.Time
+	hostID   *uuid.UUID
+	fileName string
+	logType  string
+	contents string
+	severity severity
+}
+
+type severity struct {
+	text   string
+	number int
+	syslog int
+}
+
+var (
+	debugSeverity  = severity{text: "DEBUG", number: 5, syslog: 7}
+	infoSeverity   = severity{text: "INFO", number: 9, syslog: 6}
+	noticeSeverity = severity{text: "NOTICE", number: 10, syslog: 5}
+	warnSeverity   = severity{text: "WARN", number: 13, syslog: 4}
+	errorSeverity  = severity{text: "ERROR", number: 17, syslog: 3}
+	critSeverity   = severity{text: "CRIT", number: 21, syslog: 2}
+	alertSeverity  = severity{text: "ALERT", number: 22, syslog: 1}
+	emergSeverity  = severity{text: "EMERG", number: 23, syslog: 0}
+)
+
+func (s source) fileName() string {
+	if s.hostID == nil {
+		return mainLogFileName
+	}
+
+	return "host-" + s.hostID.String() + "." + s.qualifier + ".log"
+}
+
+func (s source) logType() string {
+	if s.hostID == nil {
+		return "main"
+	}
+
+	return s.qualifier
+}
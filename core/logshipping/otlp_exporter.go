@@ -0,0 +1,180 @@
+package logshipping
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+type otlpExporter struct {
+	client   *http.Client
+	endpoint string
+	resource otlpResource
+}
+
+type otlpRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano         string          `json:"timeUnixNano"`
+	ObservedTimeUnixNano string          `json:"observedTimeUnixNano"`
+	SeverityText         string          `json:"severityText"`
+	Body                 otlpValue       `json:"body"`
+	Attributes           []otlpAttribute `json:"attributes"`
+	SeverityNumber       int             `json:"severityNumber"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+func newOTLPExporter(
+	destination *settings.LogDestination,
+	timeout time.Duration,
+) (exporter, error) {
+	endpoint, err := url.Parse(destination.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint.Path == "" || endpoint.Path == "/" {
+		endpoint.Path = otlpLogsPath
+	}
+
+	name := serviceName
+	if destination.Tag != nil {
+		name = *destination.Tag
+	}
+
+	attributes := []otlpAttribute{newOTLPAttribute("service.name", name)}
+	if hostname, err := os.Hostname(); err == nil {
+		attributes = append(attributes, newOTLPAttribute("host.name", hostname))
+	}
+
+	return &otlpExporter{
+		client:   &http.Client{Timeout: timeout},
+		endpoint: endpoint.String(),
+		resource: otlpResource{Attributes: attributes},
+	}, nil
+}
+
+func (e *otlpExporter) export(ctx context.Context, records []record) error {
+	payload, err := json.Marshal(e.buildRequest(records, time.Now()))
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		e.endpoint,
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := e.client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer response.Body.Close()
+
+	//nolint:errcheck
+	io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("OTLP collector replied with status %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+func (e *otlpExporter) close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+func (e *otlpExporter) buildRequest(records []record, now time.Time) *otlpRequest {
+	logRecords := make([]otlpLogRecord, 0, len(records))
+	for _, item := range records {
+		attributes := []otlpAttribute{
+			newOTLPAttribute("log.file.name", item.fileName),
+			newOTLPAttribute("nginx_ignition.log.type", item.logType),
+		}
+
+		if item.hostID != nil {
+			attributes = append(
+				attributes,
+				newOTLPAttribute("nginx_ignition.host.id", item.hostID.String()),
+			)
+		}
+
+		logRecords = append(logRecords, otlpLogRecord{
+			TimeUnixNano:         strconv.FormatInt(item.time.UnixNano(), 10),
+			ObservedTimeUnixNano: strconv.FormatInt(now.UnixNano(), 10),
+			SeverityNumber:       item.severity.number,
+			SeverityText:         item.severity.text,
+			Body:                 otlpValue{StringValue: item.contents},
+			Attributes:           attributes,
+		})
+	}
+
+	return &otlpRequest{
+		ResourceLogs: []otlpResourceLogs{
+			{
+				Resource: e.resource,
+				ScopeLogs: []otlpScopeLogs{
+					{
+						Scope:      otlpScope{Name: serviceName},
+						LogRecords: logRecords,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newOTLPAttribute(key, value string) otlpAttribute {
+	return otlpAttribute{
+		Key:   key,
+		Value: otlpValue{StringValue: value},
+	}
+}
@@ -0,0 +1,110 @@
+package logshipping
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_otlpExporter(t *testing.T) {
+	t.Run("export", func(t *testing.T) {
+		t.Run("posts the records to the collector", func(t *testing.T) {
+			var (
+				path        string
+				contentType string
+				body        []byte
+			)
+
+			collector := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					path = r.URL.Path
+					contentType = r.Header.Get("Content-Type")
+					body, _ = io.ReadAll(r.Body)
+					w.WriteHeader(http.StatusOK)
+				}),
+			)
+			defer collector.Close()
+
+			destination := newOTLPDestination(collector.URL)
+			destination.Tag = new("edge")
+			target, err := newOTLPExporter(destination, time.Second)
+			require.NoError(t, err)
+
+			hostID := uuid.New()
+			err = target.export(t.Context(), []record{newRecord(&hostID)})
+			require.NoError(t, err)
+
+			assert.Equal(t, otlpLogsPath, path)
+			assert.Equal(t, "application/json", contentType)
+
+			payload := otlpRequest{}
+			require.NoError(t, json.Unmarshal(body, &payload))
+			require.Len(t, payload.ResourceLogs, 1)
+
+			resource := payload.ResourceLogs[0]
+			assert.Contains(
+				t,
+				resource.Resource.Attributes,
+				newOTLPAttribute("service.name", "edge"),
+			)
+			require.Len(t, resource.ScopeLogs, 1)
+			require.Len(t, resource.ScopeLogs[0].LogRecords, 1)
+
+			logRecord := resource.ScopeLogs[0].LogRecords[0]
+			assert.Equal(t, "1792319400000000000", logRecord.TimeUnixNano)
+			assert.Equal(t, infoSeverity.number, logRecord.SeverityNumber)
+			assert.Equal(t, infoSeverity.text, logRecord.SeverityText)
+			assert.Contains(t, logRecord.Body.StringValue, `"GET / HTTP/1.1" 200`)
+			assert.Contains(
+				t,
+				logRecord.Attributes,
+				newOTLPAttribute("nginx_ignition.host.id", hostID.String()),
+			)
+			assert.Contains(
+				t,
+				logRecord.Attributes,
+				newOTLPAttribute("nginx_ignition.log.type", accessQualifier),
+			)
+		})
+
+		t.Run("keeps a custom endpoint path", func(t *testing.T) {
+			var path string
+			collector := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					path = r.URL.Path
+				}),
+			)
+			defer collector.Close()
+
+			target, err := newOTLPExporter(newOTLPDestination(collector.URL+"/ingest"), time.Second)
+			require.NoError(t, err)
+
+			hostID := uuid.New()
+			require.NoError(t, target.export(t.Context(), []record{newRecord(&hostID)}))
+			assert.Equal(t, "/ingest", path)
+		})
+
+		t.Run("returns error when the collector rejects the records", func(t *testing.T) {
+			collector := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}),
+			)
+			defer collector.Close()
+
+			target, err := newOTLPExporter(newOTLPDestination(collector.URL), time.Second)
+			require.NoError(t, err)
+
+			hostID := uuid.New()
+			err = target.export(t.Context(), []record{newRecord(&hostID)})
+			assert.ErrorContains(t, err, "503")
+		})
+	})
+}
@@ -0,0 +1,55 @@
+package logshipping
+
+import (
+	"context"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+)
+
+type reconcileTask struct {
+	service       *service
+	configuration *configuration.Configuration
+}
+
+func registerReconcileTask(
+	ctx context.Context,
+	service *service,
+	cfg *configuration.Configuration,
+	sched *scheduler.Scheduler,
+) error {
+	task := reconcileTask{service, cfg}
+	return sched.Register(ctx, &task)
+}
+
+func (t reconcileTask) Run(ctx context.Context) error {
+	return t.service.reconcile(ctx)
+}
+
+func (t reconcileTask) Schedule(_ context.Context) (*scheduler.Schedule, error) {
+	intervalSeconds, err := t.configuration.GetInt(
+		"nginx-ignition.log-shipping.reconcile-interval-seconds",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduler.Schedule{
+		Enabled:  intervalSeconds > 0,
+		Interval: time.Duration(intervalSeconds) * time.Second,
+	}, nil
+}
+
+func (t reconcileTask) OnScheduleStarted(ctx context.Context) {
+	schedule, err := t.Schedule(ctx)
+	if err != nil || !schedule.Enabled {
+		return
+	}
+
+	log.Infof(
+		"Log shipping task scheduled to run every %v seconds",
+		schedule.Interval.Seconds(),
+	)
+}
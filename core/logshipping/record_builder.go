@@ -0,0 +1,72 @@
+package logshipping
+
+import (
+	"regexp"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/accesslog"
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+)
+
+const errorLogTimeLayout = "2006/01/02 15:04:05"
+
+var (
+	errorLogPattern = regexp.MustCompile(
+		`^(\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2}) \[([a-z]+)]`,
+	)
+	errorLogSeverities = map[string]severity{
+		"debug":  debugSeverity,
+		"info":   infoSeverity,
+		"notice": noticeSeverity,
+		"warn":   warnSeverity,
+		"error":  errorSeverity,
+		"crit":   critSeverity,
+		"alert":  alertSeverity,
+		"emerg":  emergSeverity,
+	}
+)
+
+func buildRecords(src source, lines []logline.LogLine, now time.Time) []record {
+	output := make([]record, 0, len(lines))
+	for _, line := range lines {
+		if line.Contents == "" {
+			continue
+		}
+
+		item := record{
+			time:     now,
+			hostID:   src.hostID,
+			fileName: src.fileName(),
+			logType:  src.logType(),
+			contents: line.Contents,
+			severity: infoSeverity,
+		}
+
+		if src.qualifier == accessQualifier {
+			if parsed, err := accesslog.Parse(line.Contents); err == nil && !parsed.Time.IsZero() {
+				item.time = parsed.Time
+			}
+		} else {
+			applyErrorLogDetails(&item)
+		}
+
+		output = append(output, item)
+	}
+
+	return output
+}
+
+func applyErrorLogDetails(item *record) {
+	matches := errorLogPattern.FindStringSubmatch(item.contents)
+	if matches == nil {
+		return
+	}
+
+	if value, err := time.ParseInLocation(errorLogTimeLayout, matches[1], time.Local); err == nil {
+		item.time = value
+	}
+
+	if value, found := errorLogSeverities[matches[2]]; found {
+		item.severity = value
+	}
+}
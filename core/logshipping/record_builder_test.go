@@ -0,0 +1,64 @@
+package logshipping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+)
+
+func Test_buildRecords(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	t.Run("uses the access log time and skips empty lines", func(t *testing.T) {
+		hostID := uuid.New()
+		src := source{hostID: &hostID, qualifier: accessQualifier}
+		lines := []logline.LogLine{
+			{
+				Contents: `10.0.0.1 - - [18/Oct/2026:10:30:00 +0000] "GET / HTTP/1.1" 200 12 "-" "curl"`,
+			},
+			{Contents: ""},
+		}
+
+		records := buildRecords(src, lines, now)
+
+		require.Len(t, records, 1)
+		assert.True(t, records[0].time.Equal(time.Date(2026, 10, 18, 10, 30, 0, 0, time.UTC)))
+		assert.Equal(t, infoSeverity, records[0].severity)
+		assert.Equal(t, &hostID, records[0].hostID)
+		assert.Equal(t, "host-"+hostID.String()+".access.log", records[0].fileName)
+		assert.Equal(t, accessQualifier, records[0].logType)
+	})
+
+	t.Run("uses the error log level and time", func(t *testing.T) {
+		src := source{}
+		lines := []logline.LogLine{
+			{Contents: "2026/10/18 10:30:00 [crit] 12#0: *3 connect() failed"},
+		}
+
+		records := buildRecords(src, lines, now)
+
+		require.Len(t, records, 1)
+		expectedTime := time.Date(2026, 10, 18, 10, 30, 0, 0, time.Local)
+		assert.True(t, records[0].time.Equal(expectedTime))
+		assert.Equal(t, critSeverity, records[0].severity)
+		assert.Equal(t, mainLogFileName, records[0].fileName)
+		assert.Equal(t, "main", records[0].logType)
+	})
+
+	t.Run("falls back to the current time for unknown formats", func(t *testing.T) {
+		hostID := uuid.New()
+		src := source{hostID: &hostID, qualifier: errorQualifier}
+		lines := []logline.LogLine{{Contents: "unexpected contents"}}
+
+		records := buildRecords(src, lines, now)
+
+		require.Len(t, records, 1)
+		assert.Equal(t, now, records[0].time)
+		assert.Equal(t, infoSeverity, records[0].severity)
+	})
+}
@@ -0,0 +1,229 @@
+package logshipping
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+type shipment struct {
+	destination settings.LogDestination
+	source      source
+}
+
+type service struct {
+	settingsCommands settings.Commands
+	hostCommands     host.Commands
+	nginxCommands    nginx.Commands
+	configuration    *configuration.Configuration
+	newExporter      exporterFactory
+	running          map[string]context.CancelFunc
+	mutex            sync.Mutex
+}
+
+func newService(
+	settingsCommands settings.Commands,
+	hostCommands host.Commands,
+	nginxCommands nginx.Commands,
+	cfg *configuration.Configuration,
+) *service {
+	return &service{
+		settingsCommands: settingsCommands,
+		hostCommands:     hostCommands,
+		nginxCommands:    nginxCommands,
+		configuration:    cfg,
+		newExporter:      newExporter,
+		running:          make(map[string]context.CancelFunc),
+	}
+}
+
+func (s *service) reconcile(ctx context.Context) error {
+	cfg, err := s.settingsCommands.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	hosts, err := s.hostCommands.GetAllEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	timeoutSeconds, err := s.configuration.GetInt(
+		"nginx-ignition.log-shipping.request-timeout-seconds",
+	)
+	if err != nil {
+		return err
+	}
+
+	desired := buildShipments(cfg.Nginx.Logs, hosts)
+	timeout := time.Duration(timeoutSeconds) * time.Second
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for key, cancel := range s.running {
+		if _, found := desired[key]; !found {
+			cancel()
+			delete(s.running, key)
+		}
+	}
+
+	for key, item := range desired {
+		if _, found := s.running[key]; found {
+			continue
+		}
+
+		cancel, err := s.start(ctx, item, timeout)
+		if err != nil {
+			log.Warnf(
+				"Unable to ship the %s logs to %s: %v",
+				item.source.fileName(),
+				item.destination.Address,
+				err,
+			)
+			continue
+		}
+
+		s.running[key] = cancel
+	}
+
+	return nil
+}
+
+func (s *service) start(
+	ctx context.Context,
+	item *shipment,
+	timeout time.Duration,
+) (context.CancelFunc, error) {
+	target, err := s.newExporter(&item.destination, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	shipmentCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+
+	var lines <-chan []logline.LogLine
+	if item.source.hostID == nil {
+		lines, err = s.nginxCommands.TailMainLogs(shipmentCtx, nil)
+	} else {
+		lines, err = s.nginxCommands.TailHostLogs(
+			shipmentCtx,
+			*item.source.hostID,
+			item.source.qualifier,
+			nil,
+		)
+	}
+
+	if err != nil {
+		cancel()
+		//nolint:errcheck
+		target.close()
+		return nil, err
+	}
+
+	go ship(shipmentCtx, item, lines, target)
+	return cancel, nil
+}
+
+func (s *service) stop() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for key, cancel := range s.running {
+		cancel()
+		delete(s.running, key)
+	}
+}
+
+func ship(
+	ctx context.Context,
+	item *shipment,
+	lines <-chan []logline.LogLine,
+	target exporter,
+) {
+	//nolint:errcheck
+	defer target.close()
+
+	for values := range lines {
+		records := buildRecords(item.source, values, time.Now())
+		if len(records) == 0 {
+			continue
+		}
+
+		if err := target.export(ctx, records); err != nil && ctx.Err() == nil {
+			log.Warnf(
+				"Unable to ship %d lines of the %s logs to %s: %v",
+				len(records),
+				item.source.fileName(),
+				item.destination.Address,
+				err,
+			)
+		}
+	}
+}
+
+func buildShipments(
+	logs *settings.NginxLogsSettings,
+	hosts []host.Host,
+) map[string]*shipment {
+	output := make(map[string]*shipment)
+	if logs == nil {
+		return output
+	}
+
+	add := func(destination *settings.LogDestination, src source) {
+		key := fmt.Sprintf(
+			"%s|%s|%s|%s|%s|%s",
+			destination.Type,
+			destination.Protocol,
+			destination.Address,
+			destination.Facility,
+			valueOrEmpty(destination.Tag),
+			src.fileName(),
+		)
+		output[key] = &shipment{destination: *destination, source: src}
+	}
+
+	for index := range logs.Destinations {
+		destination := &logs.Destinations[index]
+		if destination.IsNative() {
+			continue
+		}
+
+		if destination.HostID == nil && destination.ErrorLogs && logs.ServerLogsEnabled {
+			add(destination, source{})
+		}
+
+		for _, h := range hosts {
+			if !destination.AppliesTo(&h.ID) {
+				continue
+			}
+
+			if destination.AccessLogs && logs.AccessLogsEnabled {
+				add(destination, source{hostID: &h.ID, qualifier: accessQualifier})
+			}
+
+			if destination.ErrorLogs && logs.ErrorLogsEnabled {
+				add(destination, source{hostID: &h.ID, qualifier: errorQualifier})
+			}
+		}
+	}
+
+	return output
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,191 @@
+package logshipping
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+type recordingExporter struct {
+	records chan []record
+	closed  chan struct{}
+	once    sync.Once
+}
+
+func newRecordingExporter() *recordingExporter {
+	return &recordingExporter{
+		records: make(chan []record, 10),
+		closed:  make(chan struct{}),
+	}
+}
+
+func (e *recordingExporter) export(_ context.Context, records []record) error {
+	e.records <- records
+	return nil
+}
+
+func (e *recordingExporter) close() error {
+	e.once.Do(func() { close(e.closed) })
+	return nil
+}
+
+func Test_buildShipments(t *testing.T) {
+	firstHost := host.Host{ID: uuid.New()}
+	secondHost := host.Host{ID: uuid.New()}
+	hosts := []host.Host{firstHost, secondHost}
+
+	t.Run("ships every host and the main logs to global destinations", func(t *testing.T) {
+		logs := &settings.NginxLogsSettings{
+			ServerLogsEnabled: true,
+			AccessLogsEnabled: true,
+			ErrorLogsEnabled:  true,
+			Destinations: []settings.LogDestination{
+				*newOTLPDestination("http://localhost:4318"),
+			},
+		}
+
+		shipments := buildShipments(logs, hosts)
+
+		assert.Len(t, shipments, 5)
+	})
+
+	t.Run("ships only the selected host logs to host destinations", func(t *testing.T) {
+		destination := newSyslogDestination("127.0.0.1:601")
+		destination.HostID = &secondHost.ID
+		destination.ErrorLogs = false
+		logs := &settings.NginxLogsSettings{
+			ServerLogsEnabled: true,
+			AccessLogsEnabled: true,
+			ErrorLogsEnabled:  true,
+			Destinations:      []settings.LogDestination{*destination},
+		}
+
+		shipments := buildShipments(logs, hosts)
+
+		require.Len(t, shipments, 1)
+		for _, item := range shipments {
+			assert.Equal(t, &secondHost.ID, item.source.hostID)
+			assert.Equal(t, accessQualifier, item.source.qualifier)
+		}
+	})
+
+	t.Run("ignores destinations handled natively by nginx", func(t *testing.T) {
+		destination := newSyslogDestination("127.0.0.1:514")
+		destination.Protocol = settings.UDPLogDestinationProtocol
+		logs := &settings.NginxLogsSettings{
+			ServerLogsEnabled: true,
+			AccessLogsEnabled: true,
+			ErrorLogsEnabled:  true,
+			Destinations:      []settings.LogDestination{*destination},
+		}
+
+		assert.Empty(t, buildShipments(logs, hosts))
+	})
+
+	t.Run("ignores log files that are disabled", func(t *testing.T) {
+		logs := &settings.NginxLogsSettings{
+			Destinations: []settings.LogDestination{
+				*newOTLPDestination("http://localhost:4318"),
+			},
+		}
+
+		assert.Empty(t, buildShipments(logs, hosts))
+	})
+}
+
+func Test_service(t *testing.T) {
+	t.Run("reconcile", func(t *testing.T) {
+		t.Run("starts and stops shipments as the settings change", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			hostID := uuid.New()
+			destination := newOTLPDestination("http://localhost:4318")
+			destination.HostID = &hostID
+			destination.ErrorLogs = false
+			cfg := &settings.Settings{
+				Nginx: &settings.NginxSettings{
+					Logs: &settings.NginxLogsSettings{
+						AccessLogsEnabled: true,
+						Destinations:      []settings.LogDestination{*destination},
+					},
+				},
+			}
+
+			settingsCommands := settings.NewMockedCommands(ctrl)
+			settingsCommands.EXPECT().Get(gomock.Any()).Return(cfg, nil).Times(3)
+
+			hostCommands := host.NewMockedCommands(ctrl)
+			hostCommands.EXPECT().
+				GetAllEnabled(gomock.Any()).
+				Return([]host.Host{{ID: hostID}}, nil).
+				Times(3)
+
+			lines := make(chan []logline.LogLine, 1)
+			var tailCtx context.Context
+			nginxCommands := nginx.NewMockedCommands(ctrl)
+			nginxCommands.EXPECT().
+				TailHostLogs(gomock.Any(), hostID, accessQualifier, nil).
+				DoAndReturn(func(
+					ctx context.Context,
+					_ uuid.UUID,
+					_ string,
+					_ *nginx.LogSearch,
+				) (<-chan []logline.LogLine, error) {
+					tailCtx = ctx
+					return lines, nil
+				})
+
+			target := newRecordingExporter()
+			shippingService := newService(
+				settingsCommands,
+				hostCommands,
+				nginxCommands,
+				newConfiguration(),
+			)
+			shippingService.newExporter = func(
+				_ *settings.LogDestination,
+				timeout time.Duration,
+			) (exporter, error) {
+				assert.Equal(t, 5*time.Second, timeout)
+				return target, nil
+			}
+
+			require.NoError(t, shippingService.reconcile(t.Context()))
+			require.NoError(t, shippingService.reconcile(t.Context()))
+			assert.Len(t, shippingService.running, 1)
+
+			lines <- []logline.LogLine{{Contents: "GET /"}}
+			select {
+			case records := <-target.records:
+				require.Len(t, records, 1)
+				assert.Equal(t, "GET /", records[0].contents)
+			case <-time.After(time.Second):
+				t.Fatal("records were not exported")
+			}
+
+			cfg.Nginx.Logs.Destinations = nil
+			require.NoError(t, shippingService.reconcile(t.Context()))
+			assert.Empty(t, shippingService.running)
+			assert.Error(t, tailCtx.Err())
+
+			close(lines)
+			select {
+			case <-target.closed:
+			case <-time.After(time.Second):
+				t.Fatal("exporter was not closed")
+			}
+		})
+	})
+}
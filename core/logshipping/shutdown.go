@@ -0,0 +1,23 @@
+package logshipping
+
+import (
+	"context"
+
+	"dillmann.com.br/nginx-ignition/core/common/lifecycle"
+)
+
+type shutdown struct {
+	service *service
+}
+
+func registerShutdown(lc *lifecycle.Lifecycle, service *service) {
+	lc.RegisterShutdown(shutdown{service})
+}
+
+func (s shutdown) Priority() int {
+	return shutdownPriority
+}
+
+func (s shutdown) Run(_ context.Context) {
+	s.service.stop()
+}
@@ -0,0 +1,123 @@
+package logshipping
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+const syslogTimeLayout = "2006-01-02T15:04:05.000000Z07:00"
+
+type syslogExporter struct {
+	connection net.Conn
+	address    string
+	hostname   string
+	appName    string
+	timeout    time.Duration
+	facility   int
+}
+
+func newSyslogExporter(
+	destination *settings.LogDestination,
+	timeout time.Duration,
+) (exporter, error) {
+	if destination.Protocol != settings.TCPLogDestinationProtocol {
+		return nil, fmt.Errorf(
+			"syslog destinations using %s are handled by nginx itself",
+			destination.Protocol,
+		)
+	}
+
+	facility := slices.Index(settings.SyslogFacilities, destination.Facility)
+	if facility < 0 {
+		return nil, fmt.Errorf("invalid syslog facility: %s", destination.Facility)
+	}
+
+	appName := defaultSyslogTag
+	if destination.Tag != nil {
+		appName = *destination.Tag
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	return &syslogExporter{
+		address:  syslogAddress(destination.Address),
+		hostname: hostname,
+		appName:  appName,
+		timeout:  timeout,
+		facility: facility,
+	}, nil
+}
+
+func (e *syslogExporter) export(ctx context.Context, records []record) error {
+	if e.connection == nil {
+		dialer := net.Dialer{Timeout: e.timeout}
+		connection, err := dialer.DialContext(ctx, "tcp", e.address)
+		if err != nil {
+			return err
+		}
+
+		e.connection = connection
+	}
+
+	var frames strings.Builder
+	for _, item := range records {
+		message := e.buildMessage(&item)
+		frames.WriteString(fmt.Sprintf("%d %s", len(message), message))
+	}
+
+	if err := e.connection.SetWriteDeadline(time.Now().Add(e.timeout)); err != nil {
+		return e.discardConnection(err)
+	}
+
+	if _, err := e.connection.Write([]byte(frames.String())); err != nil {
+		return e.discardConnection(err)
+	}
+
+	return nil
+}
+
+func (e *syslogExporter) close() error {
+	if e.connection == nil {
+		return nil
+	}
+
+	err := e.connection.Close()
+	e.connection = nil
+	return err
+}
+
+func (e *syslogExporter) discardConnection(err error) error {
+	//nolint:errcheck
+	e.close()
+	return err
+}
+
+func (e *syslogExporter) buildMessage(item *record) string {
+	return fmt.Sprintf(
+		"<%d>1 %s %s %s - %s - %s",
+		e.facility*8+item.severity.syslog,
+		item.time.Format(syslogTimeLayout),
+		e.hostname,
+		e.appName,
+		item.logType,
+		item.contents,
+	)
+}
+
+func syslogAddress(address string) string {
+	if _, _, err := net.SplitHostPort(address); err == nil {
+		return address
+	}
+
+	return net.JoinHostPort(strings.Trim(address, "[]"), defaultSyslogPort)
+}
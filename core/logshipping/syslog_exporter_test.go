@@ -0,0 +1,126 @@
+package logshipping
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+func Test_syslogExporter(t *testing.T) {
+	t.Run("newSyslogExporter", func(t *testing.T) {
+		t.Run("rejects destinations handled natively by nginx", func(t *testing.T) {
+			destination := newSyslogDestination("127.0.0.1:514")
+			destination.Protocol = settings.UDPLogDestinationProtocol
+
+			_, err := newSyslogExporter(destination, time.Second)
+			assert.Error(t, err)
+		})
+
+		t.Run("uses the default port when none is provided", func(t *testing.T) {
+			target, err := newSyslogExporter(newSyslogDestination("logs.local"), time.Second)
+			require.NoError(t, err)
+			assert.Equal(t, "logs.local:514", target.(*syslogExporter).address)
+		})
+	})
+
+	t.Run("export", func(t *testing.T) {
+		t.Run("sends octet-counted RFC 5424 messages", func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			defer listener.Close()
+
+			target, err := newSyslogExporter(
+				newSyslogDestination(listener.Addr().String()),
+				time.Second,
+			)
+			require.NoError(t, err)
+			defer target.close()
+
+			hostID := uuid.New()
+			first := newRecord(&hostID)
+			second := newRecord(&hostID)
+			second.logType = errorQualifier
+			second.severity = errorSeverity
+			second.contents = "upstream timed out"
+			require.NoError(t, target.export(t.Context(), []record{first, second}))
+
+			connection, err := listener.Accept()
+			require.NoError(t, err)
+			defer connection.Close()
+
+			reader := bufio.NewReader(connection)
+			firstMessage := readSyslogFrame(t, reader)
+			secondMessage := readSyslogFrame(t, reader)
+
+			assert.True(t, strings.HasPrefix(firstMessage, "<190>1 2026-10-18T10:30:00.000000Z "))
+			assert.Contains(t, firstMessage, " edge - access - ")
+			assert.True(t, strings.HasSuffix(firstMessage, first.contents))
+			assert.True(t, strings.HasPrefix(secondMessage, "<187>1 "))
+			assert.True(t, strings.HasSuffix(secondMessage, " edge - error - upstream timed out"))
+		})
+
+		t.Run("reconnects after the connection is closed", func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			defer listener.Close()
+
+			target, err := newSyslogExporter(
+				newSyslogDestination(listener.Addr().String()),
+				time.Second,
+			)
+			require.NoError(t, err)
+			defer target.close()
+
+			hostID := uuid.New()
+			require.NoError(t, target.export(t.Context(), []record{newRecord(&hostID)}))
+			require.NoError(t, target.close())
+			require.NoError(t, target.export(t.Context(), []record{newRecord(&hostID)}))
+
+			for range 2 {
+				connection, err := listener.Accept()
+				require.NoError(t, err)
+				readSyslogFrame(t, bufio.NewReader(connection))
+				connection.Close()
+			}
+		})
+
+		t.Run("returns error when the server is unreachable", func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			address := listener.Addr().String()
+			require.NoError(t, listener.Close())
+
+			target, err := newSyslogExporter(newSyslogDestination(address), time.Second)
+			require.NoError(t, err)
+
+			hostID := uuid.New()
+			assert.Error(t, target.export(t.Context(), []record{newRecord(&hostID)}))
+		})
+	})
+}
+
+func readSyslogFrame(t *testing.T, reader *bufio.Reader) string {
+	t.Helper()
+
+	prefix, err := reader.ReadString(' ')
+	require.NoError(t, err)
+
+	length, err := strconv.Atoi(strings.TrimSpace(prefix))
+	require.NoError(t, err)
+
+	message := make([]byte, length)
+	_, err = io.ReadFull(reader, message)
+	require.NoError(t, err)
+
+	return string(message)
+}
@@ -10,23 +10,29 @@ import (
 	"dillmann.com.br/nginx-ignition/core/cache"
 	"dillmann.com.br/nginx-ignition/core/certificate"
 	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
 	"dillmann.com.br/nginx-ignition/core/settings"
 	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 )
 
 func newPaths() *Paths {
 	return &Paths{
-		Base:   "/",
-		Config: "/etc/nginx/",
-		Logs:   "/var/log/nginx/",
-		Cache:  "/var/cache/nginx/",
-		Temp:   "/tmp/nginx/",
+		Base:          "/",
+		Config:        "/etc/nginx/",
+		Logs:          "/var/log/nginx/",
+		Cache:         "/var/cache/nginx/",
+		Temp:          "/tmp/nginx/",
+		ACMEChallenge: "/var/lib/nginx/acme-challenge/",
 	}
 }
 
 func newProviderContext(t *testing.T) *providerContext {
 	return &providerContext{
 		context: t.Context(),
+		cfg:     newSettings(),
 		paths:   newPaths(),
 		supportedFeatures: &SupportedFeatures{
 			TLSSNI:      StaticSupportType,
@@ -104,6 +110,21 @@ func newCache() cache.Cache {
 	}
 }
 
+func newUpstream() upstream.Upstream {
+	return upstream.Upstream{
+		ID:       uuid.New(),
+		Name:     "Test upstream",
+		Method:   upstream.RoundRobinBalancingMethod,
+		Protocol: upstream.HTTPProtocol,
+		Servers: []upstream.Server{
+			{
+				Address: "10.0.0.1",
+				Port:    8080,
+			},
+		},
+	}
+}
+
 func newHost() host.Host {
 	return host.Host{
 		ID:            uuid.New(),
@@ -138,3 +159,61 @@ func newStream() stream.Stream {
 		},
 	}
 }
+
+func newTLSProfile() tlsprofile.TLSProfile {
+	return tlsprofile.TLSProfile{
+		ID:     uuid.New(),
+		Name:   "Intermediate",
+		Preset: tlsprofile.IntermediatePreset,
+		Session: tlsprofile.Session{
+			TimeoutMinutes: 60,
+			CacheSizeMB:    10,
+			CacheEnabled:   true,
+			TicketsEnabled: false,
+		},
+	}
+}
+
+func newRateLimit() ratelimit.RateLimit {
+	return ratelimit.RateLimit{
+		ID:                 uuid.New(),
+		Name:               "API clients",
+		KeyType:            ratelimit.ClientIPKeyType,
+		Rate:               10,
+		RateUnit:           ratelimit.PerSecondRateUnit,
+		Burst:              20,
+		NoDelay:            true,
+		ResponseStatusCode: 429,
+	}
+}
+
+func newHeaderRule(
+	target host.HeaderRuleTarget,
+	action host.HeaderRuleAction,
+	name string,
+) host.HeaderRule {
+	return host.HeaderRule{
+		Name:   name,
+		Value:  new("value"),
+		Target: target,
+		Action: action,
+	}
+}
+
+func newSecurityHeaders() securityheaders.SecurityHeaders {
+	return securityheaders.SecurityHeaders{
+		ID:                 uuid.New(),
+		Name:               "Strict",
+		FrameOptions:       new(securityheaders.DenyFrameOptions),
+		ReferrerPolicy:     new(securityheaders.StrictOriginWhenCrossOriginPolicy),
+		ContentTypeNoSniff: true,
+		ContentSecurityPolicy: securityheaders.ContentSecurityPolicy{
+			Enabled: true,
+			Directives: []securityheaders.Directive{
+				{Name: "default-src", Sources: []string{"'self'"}},
+				{Name: "img-src", Sources: []string{"'self'", "data:"}},
+				{Name: "upgrade-insecure-requests"},
+			},
+		},
+	}
+}
@@ -2,9 +2,12 @@ package cfgfiles
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"dillmann.com.br/nginx-ignition/core/accesslist"
 	"dillmann.com.br/nginx-ignition/core/cache"
@@ -13,28 +16,50 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/log"
 	"dillmann.com.br/nginx-ignition/core/host"
 	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
 	"dillmann.com.br/nginx-ignition/core/settings"
 	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 )
 
+const (
+	stagingConfigFolder  = "config.staging"
+	previousConfigFolder = "config.previous"
+	systemResolversFile  = "/etc/resolv.conf"
+)
+
+// Replaced by the tests to simulate the failures of the file system
+var renameFolder = os.Rename
+
 type Facade struct {
-	hostCommands     host.Commands
-	streamCommands   stream.Commands
-	cacheCommands    cache.Commands
-	settingsCommands settings.Commands
-	configuration    *configuration.Configuration
-	providers        []fileProvider
+	hostCommands            host.Commands
+	streamCommands          stream.Commands
+	cacheCommands           cache.Commands
+	upstreamCommands        upstream.Commands
+	tlsProfileCommands      tlsprofile.Commands
+	rateLimitCommands       ratelimit.Commands
+	securityHeadersCommands securityheaders.Commands
+	settingsCommands        settings.Commands
+	configuration           *configuration.Configuration
+	syntaxChecker           *syntaxChecker
+	providers               []fileProvider
 }
 
 func newFacade(
 	hostCommands host.Commands,
 	streamCommands stream.Commands,
 	cacheCommands cache.Commands,
+	upstreamCommands upstream.Commands,
 	integrationCommands integration.Commands,
 	cfg *configuration.Configuration,
 	accessListCommands accesslist.Commands,
 	certificateCommands certificate.Commands,
 	settingsCommands settings.Commands,
+	tlsProfileCommands tlsprofile.Commands,
+	rateLimitCommands ratelimit.Commands,
+	securityHeadersCommands securityheaders.Commands,
 ) *Facade {
 	providers := []fileProvider{
 		newAccessListFileProvider(accessListCommands),
@@ -46,15 +71,23 @@ func newFacade(
 		newMimeTypesFileProvider(),
 		newStreamFileProvider(),
 		newGeoIPFileProvider(cfg),
+		newTLSProfileFileProvider(),
+		newRateLimitFileProvider(),
+		newSecurityHeadersFileProvider(cfg),
 	}
 
 	return &Facade{
-		hostCommands:     hostCommands,
-		streamCommands:   streamCommands,
-		cacheCommands:    cacheCommands,
-		settingsCommands: settingsCommands,
-		providers:        providers,
-		configuration:    cfg,
+		hostCommands:            hostCommands,
+		streamCommands:          streamCommands,
+		cacheCommands:           cacheCommands,
+		upstreamCommands:        upstreamCommands,
+		tlsProfileCommands:      tlsProfileCommands,
+		rateLimitCommands:       rateLimitCommands,
+		securityHeadersCommands: securityHeadersCommands,
+		settingsCommands:        settingsCommands,
+		providers:               providers,
+		configuration:           cfg,
+		syntaxChecker:           newSyntaxChecker(cfg),
 	}
 }
 
@@ -68,100 +101,238 @@ func (f *Facade) GetConfigurationFiles(
 	streams []stream.Stream,
 	err error,
 ) {
-	enabledHosts, err := f.hostCommands.GetAllEnabled(ctx)
+	providerCtx, err := f.buildProviderContext(ctx, paths, supportedFeatures)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	enabledStreams, err := f.streamCommands.GetAllEnabled(ctx)
+	configFiles, err = f.buildFiles(providerCtx)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	enabledCaches, err := f.cacheCommands.GetAllInUse(ctx)
+	return configFiles, providerCtx.hosts, providerCtx.streams, nil
+}
+
+func (f *Facade) ReplaceConfigurationFiles(
+	ctx context.Context,
+	supportedFeatures *SupportedFeatures,
+) ([]File, []host.Host, []stream.Stream, error) {
+	paths, err := f.resolvePaths()
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	cfg, err := f.settingsCommands.Get(ctx)
+	if err = f.createMissingFolders(paths); err != nil {
+		return nil, nil, nil, err
+	}
+
+	stagingPaths := *paths
+	stagingPaths.Config = toNginxPath(filepath.Join(paths.Base, stagingConfigFolder))
+
+	providerCtx, err := f.buildProviderContext(ctx, &stagingPaths, supportedFeatures)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	providerCtx := &providerContext{
-		context:           ctx,
-		paths:             paths,
-		hosts:             enabledHosts,
-		streams:           enabledStreams,
-		caches:            enabledCaches,
-		supportedFeatures: supportedFeatures,
-		cfg:               cfg,
+	stagingFiles, err := f.buildFiles(providerCtx)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	configFiles = make([]File, 0)
-	for _, provider := range f.providers {
-		files, err := provider.provide(providerCtx)
-		if err != nil {
-			return nil, nil, nil, err
-		}
+	log.Infof(
+		"Rebuilding nginx configuration files for %d hosts and %d streams",
+		len(providerCtx.hosts),
+		len(providerCtx.streams),
+	)
+	if err = f.writeConfigFiles(stagingPaths.Config, stagingFiles); err != nil {
+		return nil, nil, nil, err
+	}
 
-		configFiles = append(configFiles, files...)
+	err = f.syntaxChecker.check(ctx, &syntaxCheckInput{
+		paths:   &stagingPaths,
+		files:   stagingFiles,
+		hosts:   providerCtx.hosts,
+		streams: providerCtx.streams,
+	})
+	if err != nil {
+		f.removeFolder(stagingPaths.Config)
+		return nil, nil, nil, err
+	}
+
+	configFiles := relocateFiles(stagingFiles, stagingPaths.Config, paths.Config)
+	if err = f.writeConfigFiles(stagingPaths.Config, configFiles); err != nil {
+		f.removeFolder(stagingPaths.Config)
+		return nil, nil, nil, err
+	}
+
+	if err = f.promoteStagingFolder(paths, stagingPaths.Config); err != nil {
+		return nil, nil, nil, err
 	}
 
-	return configFiles, enabledHosts, enabledStreams, nil
+	return configFiles, providerCtx.hosts, providerCtx.streams, nil
 }
 
-func (f *Facade) ReplaceConfigurationFiles(
+func (f *Facade) RollbackConfigurationFiles() error {
+	paths, err := f.resolvePaths()
+	if err != nil {
+		return err
+	}
+
+	previousFolder := filepath.Join(paths.Base, previousConfigFolder)
+	if _, err = os.Stat(previousFolder); os.IsNotExist(err) {
+		return fmt.Errorf("no previous configuration files available at %s", previousFolder)
+	}
+
+	discardedFolder := filepath.Join(paths.Base, stagingConfigFolder)
+	if err = os.RemoveAll(discardedFolder); err != nil {
+		return err
+	}
+
+	if err = renameFolder(paths.Config, discardedFolder); err != nil {
+		return err
+	}
+
+	if err = renameFolder(previousFolder, paths.Config); err != nil {
+		return restoreFolder(err, discardedFolder, paths.Config)
+	}
+
+	log.Warnf("nginx configuration files rolled back to the last known good version")
+	f.removeFolder(discardedFolder)
+	return nil
+}
+
+func (f *Facade) buildProviderContext(
 	ctx context.Context,
+	paths *Paths,
 	supportedFeatures *SupportedFeatures,
-) ([]host.Host, []stream.Stream, error) {
-	configDir, err := f.configuration.Get("nginx-ignition.nginx.config-path")
+) (*providerContext, error) {
+	enabledHosts, err := f.hostCommands.GetAllEnabled(ctx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	cleanPath := filepath.Clean(configDir)
-	toNginxPath := func(p string) string {
-		return filepath.ToSlash(p) + "/"
+	enabledStreams, err := f.streamCommands.GetAllEnabled(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	paths := &Paths{
-		Base:   toNginxPath(cleanPath),
-		Config: toNginxPath(filepath.Join(cleanPath, "config")),
-		Logs:   toNginxPath(filepath.Join(cleanPath, "logs")),
-		Cache:  toNginxPath(filepath.Join(cleanPath, "cache")),
-		Temp:   toNginxPath(filepath.Join(cleanPath, "temp")),
+	enabledCaches, err := f.cacheCommands.GetAllInUse(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if err = f.createMissingFolders(paths); err != nil {
-		return nil, nil, err
+	enabledUpstreams, err := f.upstreamCommands.GetAllInUse(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	configFiles, hosts, streams, err := f.GetConfigurationFiles(ctx, paths, supportedFeatures)
+	enabledTLSProfiles, err := f.tlsProfileCommands.GetAllInUse(ctx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	log.Infof(
-		"Rebuilding nginx configuration files for %d hosts and %d streams",
-		len(hosts),
-		len(streams),
-	)
-	if err := f.emptyConfigFolder(paths); err != nil {
-		return nil, nil, err
+	enabledRateLimits, err := f.rateLimitCommands.GetAllInUse(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, file := range configFiles {
-		if err := f.writeConfigFile(paths, file); err != nil {
-			return nil, nil, err
+	enabledSecurityHeaders, err := f.securityHeadersCommands.GetAllInUse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := f.settingsCommands.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &providerContext{
+		context:           ctx,
+		paths:             paths,
+		hosts:             enabledHosts,
+		streams:           enabledStreams,
+		caches:            enabledCaches,
+		upstreams:         enabledUpstreams,
+		tlsProfiles:       enabledTLSProfiles,
+		rateLimits:        enabledRateLimits,
+		securityHeaders:   enabledSecurityHeaders,
+		supportedFeatures: supportedFeatures,
+		cfg:               cfg,
+		ocspResolvers:     f.ocspResolvers(enabledTLSProfiles),
+	}, nil
+}
+
+// The resolvers come from the configuration properties or, when not set, from the system
+func (f *Facade) ocspResolvers(profiles []tlsprofile.TLSProfile) []string {
+	staplingEnabled := slices.ContainsFunc(profiles, func(profile tlsprofile.TLSProfile) bool {
+		return profile.OCSPStapling
+	})
+	if !staplingEnabled {
+		return nil
+	}
+
+	value, _ := f.configuration.Get("nginx-ignition.nginx.ocsp-resolvers")
+
+	output := make([]string, 0)
+	for _, resolver := range strings.Split(value, ",") {
+		if resolver = strings.TrimSpace(resolver); resolver != "" {
+			output = append(output, resolver)
+		}
+	}
+
+	if len(output) > 0 {
+		return output
+	}
+
+	if contents, err := os.ReadFile(systemResolversFile); err == nil {
+		output = parseSystemResolvers(string(contents))
+	}
+
+	if len(output) == 0 {
+		log.Warnf(
+			"OCSP stapling disabled since no DNS resolver was found. Set them using the " +
+				"nginx-ignition.nginx.ocsp-resolvers configuration property.",
+		)
+	}
+
+	return output
+}
+
+func (f *Facade) buildFiles(providerCtx *providerContext) ([]File, error) {
+	configFiles := make([]File, 0)
+	for _, provider := range f.providers {
+		files, err := provider.provide(providerCtx)
+		if err != nil {
+			return nil, err
 		}
+
+		configFiles = append(configFiles, files...)
+	}
+
+	return configFiles, nil
+}
+
+func (f *Facade) resolvePaths() (*Paths, error) {
+	configDir, err := f.configuration.Get("nginx-ignition.nginx.config-path")
+	if err != nil {
+		return nil, err
 	}
 
-	return hosts, streams, nil
+	cleanPath := filepath.Clean(configDir)
+	return &Paths{
+		Base:          toNginxPath(cleanPath),
+		Config:        toNginxPath(filepath.Join(cleanPath, "config")),
+		Logs:          toNginxPath(filepath.Join(cleanPath, "logs")),
+		Cache:         toNginxPath(filepath.Join(cleanPath, "cache")),
+		Temp:          toNginxPath(filepath.Join(cleanPath, "temp")),
+		ACMEChallenge: toNginxPath(filepath.Join(cleanPath, "acme-challenge")),
+	}, nil
 }
 
 func (f *Facade) createMissingFolders(paths *Paths) error {
-	for _, folderPath := range []string{paths.Config, paths.Logs, paths.Cache, paths.Temp} {
+	folders := []string{paths.Config, paths.Logs, paths.Cache, paths.Temp, paths.ACMEChallenge}
+	for _, folderPath := range folders {
 		if _, err := os.Stat(folderPath); os.IsNotExist(err) {
 			if err := os.MkdirAll(folderPath, os.ModePerm); err != nil {
 				return fmt.Errorf("unable to create folder %s: %w", folderPath, err)
@@ -172,14 +343,17 @@ func (f *Facade) createMissingFolders(paths *Paths) error {
 	return nil
 }
 
-func (f *Facade) emptyConfigFolder(paths *Paths) error {
-	files, err := os.ReadDir(paths.Config)
-	if err != nil {
+func (f *Facade) writeConfigFiles(folderPath string, configFiles []File) error {
+	if err := os.RemoveAll(folderPath); err != nil {
 		return err
 	}
 
-	for _, file := range files {
-		if err := os.RemoveAll(filepath.Join(paths.Config, file.Name())); err != nil {
+	if err := os.MkdirAll(folderPath, os.ModePerm); err != nil {
+		return fmt.Errorf("unable to create folder %s: %w", folderPath, err)
+	}
+
+	for _, file := range configFiles {
+		if err := f.writeConfigFile(folderPath, file); err != nil {
 			return err
 		}
 	}
@@ -187,11 +361,69 @@ func (f *Facade) emptyConfigFolder(paths *Paths) error {
 	return nil
 }
 
-func (f *Facade) writeConfigFile(paths *Paths, file File) error {
-	filePath := filepath.Join(paths.Config, file.Name)
+func (f *Facade) writeConfigFile(folderPath string, file File) error {
+	filePath := filepath.Join(folderPath, file.Name)
 	if err := os.WriteFile(filePath, []byte(file.FormattedContents()), 0o644); err != nil {
 		return fmt.Errorf("unable to write file %s: %w", filePath, err)
 	}
 
 	return nil
 }
+
+func (f *Facade) promoteStagingFolder(paths *Paths, stagingFolder string) error {
+	previousFolder := filepath.Join(paths.Base, previousConfigFolder)
+	if err := os.RemoveAll(previousFolder); err != nil {
+		return err
+	}
+
+	if err := renameFolder(paths.Config, previousFolder); err != nil {
+		return err
+	}
+
+	if err := renameFolder(stagingFolder, paths.Config); err != nil {
+		return restoreFolder(err, previousFolder, paths.Config)
+	}
+
+	return nil
+}
+
+// restoreFolder moves the replaced folder back after the switch failed, returning both errors when
+// that fails too since nginx is left without any configuration folder in such case
+func restoreFolder(cause error, replacedFolder, configFolder string) error {
+	if err := renameFolder(replacedFolder, configFolder); err != nil {
+		return errors.Join(
+			cause,
+			fmt.Errorf(
+				"unable to restore the nginx configuration files from %s: %w",
+				replacedFolder,
+				err,
+			),
+		)
+	}
+
+	return cause
+}
+
+func (f *Facade) removeFolder(folderPath string) {
+	if err := os.RemoveAll(folderPath); err != nil {
+		log.Warnf("Unable to remove the folder %s: %v", folderPath, err)
+	}
+}
+
+// relocateFiles points the files validated in the staging folder to the live folder, keeping everything else
+// byte for byte as checked by nginx instead of rendering the configuration again.
+func relocateFiles(files []File, fromFolder, toFolder string) []File {
+	result := make([]File, len(files))
+	for index, file := range files {
+		result[index] = File{
+			Name:     file.Name,
+			Contents: strings.ReplaceAll(file.Contents, fromFolder, toFolder),
+		}
+	}
+
+	return result
+}
+
+func toNginxPath(path string) string {
+	return filepath.ToSlash(path) + "/"
+}
@@ -1,8 +1,10 @@
 package cfgfiles
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -13,8 +15,12 @@ import (
 	"dillmann.com.br/nginx-ignition/core/cache"
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
 	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
 	"dillmann.com.br/nginx-ignition/core/settings"
 	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 )
 
 func Test_Facade(t *testing.T) {
@@ -43,6 +49,16 @@ func Test_Facade(t *testing.T) {
 			streamCmds.EXPECT().GetAllEnabled(t.Context()).Return([]stream.Stream{}, nil)
 			cacheCmds := cache.NewMockedCommands(ctrl)
 			cacheCmds.EXPECT().GetAllInUse(t.Context()).Return([]cache.Cache{}, nil)
+			upstreamCmds := upstream.NewMockedCommands(ctrl)
+			upstreamCmds.EXPECT().GetAllInUse(t.Context()).Return([]upstream.Upstream{}, nil)
+			tlsProfileCmds := tlsprofile.NewMockedCommands(ctrl)
+			tlsProfileCmds.EXPECT().GetAllInUse(t.Context()).Return([]tlsprofile.TLSProfile{}, nil)
+			rateLimitCmds := ratelimit.NewMockedCommands(ctrl)
+			rateLimitCmds.EXPECT().GetAllInUse(t.Context()).Return([]ratelimit.RateLimit{}, nil)
+			securityHeadersCmds := securityheaders.NewMockedCommands(ctrl)
+			securityHeadersCmds.EXPECT().
+				GetAllInUse(t.Context()).
+				Return([]securityheaders.SecurityHeaders{}, nil)
 
 			provider := NewMockedfileProvider(ctrl)
 			provider.EXPECT().
@@ -58,11 +74,15 @@ func Test_Facade(t *testing.T) {
 			settingsCmds.EXPECT().Get(t.Context()).Return(&settings.Settings{}, nil)
 
 			facade := &Facade{
-				hostCommands:     hostCmds,
-				streamCommands:   streamCmds,
-				cacheCommands:    cacheCmds,
-				settingsCommands: settingsCmds,
-				providers:        []fileProvider{provider},
+				hostCommands:            hostCmds,
+				streamCommands:          streamCmds,
+				cacheCommands:           cacheCmds,
+				upstreamCommands:        upstreamCmds,
+				tlsProfileCommands:      tlsProfileCmds,
+				rateLimitCommands:       rateLimitCmds,
+				securityHeadersCommands: securityHeadersCmds,
+				settingsCommands:        settingsCmds,
+				providers:               []fileProvider{provider},
 			}
 
 			configFiles, hosts, streams, err := facade.GetConfigurationFiles(
@@ -93,14 +113,28 @@ func Test_Facade(t *testing.T) {
 			streamCmds.EXPECT().GetAllEnabled(gomock.Any()).Return([]stream.Stream{}, nil)
 			cacheCmds := cache.NewMockedCommands(ctrl)
 			cacheCmds.EXPECT().GetAllInUse(gomock.Any()).Return([]cache.Cache{}, nil)
+			upstreamCmds := upstream.NewMockedCommands(ctrl)
+			upstreamCmds.EXPECT().GetAllInUse(gomock.Any()).Return([]upstream.Upstream{}, nil)
+			tlsProfileCmds := tlsprofile.NewMockedCommands(ctrl)
+			tlsProfileCmds.EXPECT().GetAllInUse(gomock.Any()).Return([]tlsprofile.TLSProfile{}, nil)
+			rateLimitCmds := ratelimit.NewMockedCommands(ctrl)
+			rateLimitCmds.EXPECT().GetAllInUse(gomock.Any()).Return([]ratelimit.RateLimit{}, nil)
+			securityHeadersCmds := securityheaders.NewMockedCommands(ctrl)
+			securityHeadersCmds.EXPECT().
+				GetAllInUse(gomock.Any()).
+				Return([]securityheaders.SecurityHeaders{}, nil)
 			settingsCmds := settings.NewMockedCommands(ctrl)
 			settingsCmds.EXPECT().Get(gomock.Any()).Return(nil, assert.AnError)
 
 			facade := &Facade{
-				hostCommands:     hostCmds,
-				streamCommands:   streamCmds,
-				cacheCommands:    cacheCmds,
-				settingsCommands: settingsCmds,
+				hostCommands:            hostCmds,
+				streamCommands:          streamCmds,
+				cacheCommands:           cacheCmds,
+				upstreamCommands:        upstreamCmds,
+				tlsProfileCommands:      tlsProfileCmds,
+				rateLimitCommands:       rateLimitCmds,
+				securityHeadersCommands: securityHeadersCmds,
+				settingsCommands:        settingsCmds,
 			}
 			_, _, _, err := facade.GetConfigurationFiles(t.Context(), paths, features)
 			assert.ErrorIs(t, err, assert.AnError)
@@ -141,6 +175,25 @@ func Test_Facade(t *testing.T) {
 			assert.ErrorIs(t, err, assert.AnError)
 		})
 
+		t.Run("returns error when upstreamCommands fails", func(t *testing.T) {
+			hostCmds := host.NewMockedCommands(ctrl)
+			hostCmds.EXPECT().GetAllEnabled(t.Context()).Return([]host.Host{}, nil)
+			streamCmds := stream.NewMockedCommands(ctrl)
+			streamCmds.EXPECT().GetAllEnabled(t.Context()).Return([]stream.Stream{}, nil)
+			cacheCmds := cache.NewMockedCommands(ctrl)
+			cacheCmds.EXPECT().GetAllInUse(t.Context()).Return([]cache.Cache{}, nil)
+			upstreamCmds := upstream.NewMockedCommands(ctrl)
+			upstreamCmds.EXPECT().GetAllInUse(t.Context()).Return(nil, assert.AnError)
+			facade := &Facade{
+				hostCommands:     hostCmds,
+				streamCommands:   streamCmds,
+				cacheCommands:    cacheCmds,
+				upstreamCommands: upstreamCmds,
+			}
+			_, _, _, err := facade.GetConfigurationFiles(t.Context(), paths, features)
+			assert.ErrorIs(t, err, assert.AnError)
+		})
+
 		t.Run("returns error when provider fails", func(t *testing.T) {
 			hostCmds := host.NewMockedCommands(ctrl)
 			hostCmds.EXPECT().GetAllEnabled(t.Context()).Return([]host.Host{}, nil)
@@ -148,6 +201,16 @@ func Test_Facade(t *testing.T) {
 			streamCmds.EXPECT().GetAllEnabled(t.Context()).Return([]stream.Stream{}, nil)
 			cacheCmds := cache.NewMockedCommands(ctrl)
 			cacheCmds.EXPECT().GetAllInUse(t.Context()).Return([]cache.Cache{}, nil)
+			upstreamCmds := upstream.NewMockedCommands(ctrl)
+			upstreamCmds.EXPECT().GetAllInUse(t.Context()).Return([]upstream.Upstream{}, nil)
+			tlsProfileCmds := tlsprofile.NewMockedCommands(ctrl)
+			tlsProfileCmds.EXPECT().GetAllInUse(t.Context()).Return([]tlsprofile.TLSProfile{}, nil)
+			rateLimitCmds := ratelimit.NewMockedCommands(ctrl)
+			rateLimitCmds.EXPECT().GetAllInUse(t.Context()).Return([]ratelimit.RateLimit{}, nil)
+			securityHeadersCmds := securityheaders.NewMockedCommands(ctrl)
+			securityHeadersCmds.EXPECT().
+				GetAllInUse(t.Context()).
+				Return([]securityheaders.SecurityHeaders{}, nil)
 
 			provider := NewMockedfileProvider(ctrl)
 			provider.EXPECT().provide(gomock.Any()).Return(nil, assert.AnError)
@@ -156,11 +219,15 @@ func Test_Facade(t *testing.T) {
 			settingsCmds.EXPECT().Get(gomock.Any()).Return(&settings.Settings{}, nil).AnyTimes()
 
 			facade := &Facade{
-				hostCommands:     hostCmds,
-				streamCommands:   streamCmds,
-				cacheCommands:    cacheCmds,
-				settingsCommands: settingsCmds,
-				providers:        []fileProvider{provider},
+				hostCommands:            hostCmds,
+				streamCommands:          streamCmds,
+				cacheCommands:           cacheCmds,
+				upstreamCommands:        upstreamCmds,
+				tlsProfileCommands:      tlsProfileCmds,
+				rateLimitCommands:       rateLimitCmds,
+				securityHeadersCommands: securityHeadersCmds,
+				settingsCommands:        settingsCmds,
+				providers:               []fileProvider{provider},
 			}
 
 			_, _, _, err := facade.GetConfigurationFiles(t.Context(), paths, features)
@@ -174,6 +241,16 @@ func Test_Facade(t *testing.T) {
 			streamCmds.EXPECT().GetAllEnabled(t.Context()).Return([]stream.Stream{}, nil)
 			cacheCmds := cache.NewMockedCommands(ctrl)
 			cacheCmds.EXPECT().GetAllInUse(t.Context()).Return([]cache.Cache{}, nil)
+			upstreamCmds := upstream.NewMockedCommands(ctrl)
+			upstreamCmds.EXPECT().GetAllInUse(t.Context()).Return([]upstream.Upstream{}, nil)
+			tlsProfileCmds := tlsprofile.NewMockedCommands(ctrl)
+			tlsProfileCmds.EXPECT().GetAllInUse(t.Context()).Return([]tlsprofile.TLSProfile{}, nil)
+			rateLimitCmds := ratelimit.NewMockedCommands(ctrl)
+			rateLimitCmds.EXPECT().GetAllInUse(t.Context()).Return([]ratelimit.RateLimit{}, nil)
+			securityHeadersCmds := securityheaders.NewMockedCommands(ctrl)
+			securityHeadersCmds.EXPECT().
+				GetAllInUse(t.Context()).
+				Return([]securityheaders.SecurityHeaders{}, nil)
 
 			p1 := NewMockedfileProvider(ctrl)
 			p1.EXPECT().provide(gomock.Any()).Return([]File{{Name: "f1.conf"}}, nil)
@@ -184,11 +261,15 @@ func Test_Facade(t *testing.T) {
 			settingsCmds.EXPECT().Get(gomock.Any()).Return(&settings.Settings{}, nil).AnyTimes()
 
 			facade := &Facade{
-				hostCommands:     hostCmds,
-				streamCommands:   streamCmds,
-				cacheCommands:    cacheCmds,
-				settingsCommands: settingsCmds,
-				providers:        []fileProvider{p1, p2},
+				hostCommands:            hostCmds,
+				streamCommands:          streamCmds,
+				cacheCommands:           cacheCmds,
+				upstreamCommands:        upstreamCmds,
+				tlsProfileCommands:      tlsProfileCmds,
+				rateLimitCommands:       rateLimitCmds,
+				securityHeadersCommands: securityHeadersCmds,
+				settingsCommands:        settingsCmds,
+				providers:               []fileProvider{p1, p2},
 			}
 
 			files, _, _, err := facade.GetConfigurationFiles(t.Context(), paths, features)
@@ -201,46 +282,73 @@ func Test_Facade(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		tmpDir := t.TempDir()
-		cfg := configuration.NewWithOverrides(map[string]string{
-			"nginx-ignition.nginx.config-path": tmpDir,
-		})
-
 		features := &SupportedFeatures{
 			StreamType: NoneSupportType,
 		}
 
-		t.Run("successfully replaces all files", func(t *testing.T) {
+		newFacade := func(t *testing.T, tmpDir, nginxScript string, files func(*providerContext) []File) *Facade {
+			fakeNginx := filepath.Join(t.TempDir(), "nginx_fake")
+			err := os.WriteFile(fakeNginx, []byte(nginxScript), 0o755)
+			require.NoError(t, err)
+
+			cfg := configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.nginx.config-path": tmpDir,
+				"nginx-ignition.nginx.binary-path": fakeNginx,
+			})
+
 			hostCmds := host.NewMockedCommands(ctrl)
 			hostCmds.EXPECT().GetAllEnabled(t.Context()).Return([]host.Host{}, nil)
 			streamCmds := stream.NewMockedCommands(ctrl)
 			streamCmds.EXPECT().GetAllEnabled(t.Context()).Return([]stream.Stream{}, nil)
 			cacheCmds := cache.NewMockedCommands(ctrl)
 			cacheCmds.EXPECT().GetAllInUse(t.Context()).Return([]cache.Cache{}, nil)
+			upstreamCmds := upstream.NewMockedCommands(ctrl)
+			upstreamCmds.EXPECT().GetAllInUse(t.Context()).Return([]upstream.Upstream{}, nil)
+			tlsProfileCmds := tlsprofile.NewMockedCommands(ctrl)
+			tlsProfileCmds.EXPECT().GetAllInUse(t.Context()).Return([]tlsprofile.TLSProfile{}, nil)
+			rateLimitCmds := ratelimit.NewMockedCommands(ctrl)
+			rateLimitCmds.EXPECT().GetAllInUse(t.Context()).Return([]ratelimit.RateLimit{}, nil)
+			securityHeadersCmds := securityheaders.NewMockedCommands(ctrl)
+			securityHeadersCmds.EXPECT().
+				GetAllInUse(t.Context()).
+				Return([]securityheaders.SecurityHeaders{}, nil)
 
 			provider := NewMockedfileProvider(ctrl)
 			provider.EXPECT().
 				provide(gomock.Any()).
-				Return([]File{
-					{
-						Name:     "nginx.conf",
-						Contents: "events {}",
-					},
-				}, nil)
+				DoAndReturn(func(ctx *providerContext) ([]File, error) { return files(ctx), nil }).
+				Times(1)
 
 			settingsCmds := settings.NewMockedCommands(ctrl)
 			settingsCmds.EXPECT().Get(gomock.Any()).Return(&settings.Settings{}, nil).AnyTimes()
 
-			facade := &Facade{
-				hostCommands:     hostCmds,
-				streamCommands:   streamCmds,
-				cacheCommands:    cacheCmds,
-				settingsCommands: settingsCmds,
-				configuration:    cfg,
-				providers:        []fileProvider{provider},
+			return &Facade{
+				hostCommands:            hostCmds,
+				streamCommands:          streamCmds,
+				cacheCommands:           cacheCmds,
+				upstreamCommands:        upstreamCmds,
+				tlsProfileCommands:      tlsProfileCmds,
+				rateLimitCommands:       rateLimitCmds,
+				securityHeadersCommands: securityHeadersCmds,
+				settingsCommands:        settingsCmds,
+				configuration:           cfg,
+				syntaxChecker:           newSyntaxChecker(cfg),
+				providers:               []fileProvider{provider},
 			}
+		}
 
-			hosts, streams, err := facade.ReplaceConfigurationFiles(t.Context(), features)
+		t.Run("successfully replaces all files", func(t *testing.T) {
+			tmpDir := t.TempDir()
+			facade := newFacade(t, tmpDir, "#!/bin/sh\nexit 0", func(*providerContext) []File {
+				return []File{
+					{
+						Name:     "nginx.conf",
+						Contents: "events {}",
+					},
+				}
+			})
+
+			_, hosts, streams, err := facade.ReplaceConfigurationFiles(t.Context(), features)
 
 			assert.NoError(t, err)
 			assert.Empty(t, hosts)
@@ -252,6 +360,190 @@ func Test_Facade(t *testing.T) {
 
 			assert.DirExists(t, filepath.Join(tmpDir, "logs"))
 			assert.DirExists(t, filepath.Join(tmpDir, "cache"))
+			assert.DirExists(t, filepath.Join(tmpDir, previousConfigFolder))
+			assert.NoDirExists(t, filepath.Join(tmpDir, stagingConfigFolder))
+		})
+
+		t.Run("keeps the current files when the syntax check fails", func(t *testing.T) {
+			tmpDir := t.TempDir()
+			liveFile := filepath.Join(tmpDir, "config", "nginx.conf")
+			require.NoError(t, os.MkdirAll(filepath.Dir(liveFile), 0o755))
+			require.NoError(t, os.WriteFile(liveFile, []byte("events {}"), 0o644))
+
+			script := "#!/bin/sh\n" +
+				"echo 'nginx: [emerg] unknown directive \"foo\" in /tmp/nginx.conf:1'\n" +
+				"exit 1"
+			facade := newFacade(t, tmpDir, script, func(*providerContext) []File {
+				return []File{
+					{
+						Name:     "nginx.conf",
+						Contents: "foo;",
+					},
+				}
+			})
+
+			_, _, _, err := facade.ReplaceConfigurationFiles(t.Context(), features)
+
+			var syntaxErr *SyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			require.Len(t, syntaxErr.Problems, 1)
+			assert.Equal(t, "nginx.conf", syntaxErr.Problems[0].FileName)
+			assert.Equal(t, 1, syntaxErr.Problems[0].LineNumber)
+
+			content, err := os.ReadFile(liveFile)
+			require.NoError(t, err)
+			assert.Equal(t, "events {}", string(content))
+			assert.NoDirExists(t, filepath.Join(tmpDir, stagingConfigFolder))
+		})
+
+		t.Run("promotes the same files that were checked", func(t *testing.T) {
+			tmpDir := t.TempDir()
+			checkedFile := filepath.Join(t.TempDir(), "checked.conf")
+			script := "#!/bin/sh\ncp \"$6\" " + checkedFile + "\nexit 0"
+			facade := newFacade(t, tmpDir, script, func(ctx *providerContext) []File {
+				return []File{
+					{
+						Name:     "nginx.conf",
+						Contents: "include " + ctx.paths.Config + "mime.types;",
+					},
+				}
+			})
+
+			_, _, _, err := facade.ReplaceConfigurationFiles(t.Context(), features)
+			require.NoError(t, err)
+
+			checked, err := os.ReadFile(checkedFile)
+			require.NoError(t, err)
+			live, err := os.ReadFile(filepath.Join(tmpDir, "config", "nginx.conf"))
+			require.NoError(t, err)
+
+			stagingFolder := toNginxPath(filepath.Join(tmpDir, stagingConfigFolder))
+			liveFolder := toNginxPath(filepath.Join(tmpDir, "config"))
+			assert.Equal(
+				t,
+				strings.ReplaceAll(string(checked), stagingFolder, liveFolder),
+				string(live),
+			)
+			assert.Equal(t, "include "+liveFolder+"mime.types;", string(live))
 		})
 	})
+
+	t.Run("RollbackConfigurationFiles", func(t *testing.T) {
+		t.Run("restores the previous files", func(t *testing.T) {
+			tmpDir := t.TempDir()
+			cfg := configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.nginx.config-path": tmpDir,
+			})
+
+			previousFile := filepath.Join(tmpDir, previousConfigFolder, "nginx.conf")
+			liveFile := filepath.Join(tmpDir, "config", "nginx.conf")
+			require.NoError(t, os.MkdirAll(filepath.Dir(previousFile), 0o755))
+			require.NoError(t, os.MkdirAll(filepath.Dir(liveFile), 0o755))
+			require.NoError(t, os.WriteFile(previousFile, []byte("previous"), 0o644))
+			require.NoError(t, os.WriteFile(liveFile, []byte("current"), 0o644))
+
+			facade := &Facade{configuration: cfg}
+			err := facade.RollbackConfigurationFiles()
+			require.NoError(t, err)
+
+			content, err := os.ReadFile(liveFile)
+			require.NoError(t, err)
+			assert.Equal(t, "previous", string(content))
+			assert.NoDirExists(t, filepath.Join(tmpDir, previousConfigFolder))
+			assert.NoDirExists(t, filepath.Join(tmpDir, stagingConfigFolder))
+		})
+
+		t.Run("returns error when there are no previous files", func(t *testing.T) {
+			cfg := configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.nginx.config-path": t.TempDir(),
+			})
+
+			facade := &Facade{configuration: cfg}
+			assert.Error(t, facade.RollbackConfigurationFiles())
+		})
+
+		t.Run(
+			"returns both errors when the current files can't be brought back",
+			func(t *testing.T) {
+				tmpDir := t.TempDir()
+				cfg := configuration.NewWithOverrides(map[string]string{
+					"nginx-ignition.nginx.config-path": tmpDir,
+				})
+
+				require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, previousConfigFolder), 0o755))
+				require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "config"), 0o755))
+
+				switchErr := errors.New("switch failed")
+				renames := failRenamesAfter(t, 1, switchErr, assert.AnError)
+
+				facade := &Facade{configuration: cfg}
+				err := facade.RollbackConfigurationFiles()
+
+				assert.ErrorIs(t, err, switchErr)
+				assert.ErrorIs(t, err, assert.AnError)
+				assert.Equal(t, 3, *renames)
+			},
+		)
+	})
+
+	t.Run("promoteStagingFolder", func(t *testing.T) {
+		newPaths := func(t *testing.T) *Paths {
+			tmpDir := t.TempDir()
+			paths := &Paths{Base: tmpDir, Config: filepath.Join(tmpDir, "config")}
+			require.NoError(t, os.MkdirAll(paths.Config, 0o755))
+			require.NoError(
+				t,
+				os.WriteFile(filepath.Join(paths.Config, "nginx.conf"), []byte("current"), 0o644),
+			)
+
+			return paths
+		}
+
+		t.Run("restores the current files when the switch fails", func(t *testing.T) {
+			paths := newPaths(t)
+			missingFolder := filepath.Join(paths.Base, stagingConfigFolder)
+
+			err := (&Facade{}).promoteStagingFolder(paths, missingFolder)
+
+			assert.Error(t, err)
+			content, readErr := os.ReadFile(filepath.Join(paths.Config, "nginx.conf"))
+			require.NoError(t, readErr)
+			assert.Equal(t, "current", string(content))
+		})
+
+		t.Run("returns both errors when the current files can't be restored", func(t *testing.T) {
+			paths := newPaths(t)
+			stagingFolder := filepath.Join(paths.Base, stagingConfigFolder)
+			require.NoError(t, os.MkdirAll(stagingFolder, 0o755))
+
+			switchErr := errors.New("switch failed")
+			renames := failRenamesAfter(t, 1, switchErr, assert.AnError)
+
+			err := (&Facade{}).promoteStagingFolder(paths, stagingFolder)
+
+			assert.ErrorIs(t, err, switchErr)
+			assert.ErrorIs(t, err, assert.AnError)
+			assert.Contains(t, err.Error(), previousConfigFolder)
+			assert.Equal(t, 3, *renames)
+		})
+	})
+}
+
+// failRenamesAfter lets the first renames go through and fails the following ones with the given
+// errors, in order, returning the amount of renames attempted
+func failRenamesAfter(t *testing.T, succeeded int, failures ...error) *int {
+	original := renameFolder
+	t.Cleanup(func() { renameFolder = original })
+
+	attempts := 0
+	renameFolder = func(from, to string) error {
+		attempts++
+		if attempts <= succeeded {
+			return original(from, to)
+		}
+
+		return failures[attempts-succeeded-1]
+	}
+
+	return &attempts
 }
@@ -6,8 +6,12 @@ import (
 
 	"dillmann.com.br/nginx-ignition/core/cache"
 	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
 	"dillmann.com.br/nginx-ignition/core/settings"
 	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 )
 
 type SupportType string
@@ -33,14 +37,28 @@ type providerContext struct {
 	hosts             []host.Host
 	streams           []stream.Stream
 	caches            []cache.Cache
+	upstreams         []upstream.Upstream
+	tlsProfiles       []tlsprofile.TLSProfile
+	rateLimits        []ratelimit.RateLimit
+	securityHeaders   []securityheaders.SecurityHeaders
+	host              *hostContext
+	ocspResolvers     []string
+}
+
+// Directives of the host being rendered, repeated in every location of it
+type hostContext struct {
+	locationHeaders          string
+	hstsEnabled              bool
+	clientCertificateHeaders bool
 }
 
 type Paths struct {
-	Base   string
-	Config string
-	Logs   string
-	Cache  string
-	Temp   string
+	Base          string
+	Config        string
+	Logs          string
+	Cache         string
+	Temp          string
+	ACMEChallenge string
 }
 
 type fileProvider interface {
@@ -0,0 +1,112 @@
+package cfgfiles
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"dillmann.com.br/nginx-ignition/core/host"
+)
+
+var headerVariableInvalidChars = regexp.MustCompile(`[^a-z0-9]`)
+
+func mergeHeaderRules(hostRules, routeRules []host.HeaderRule) []host.HeaderRule {
+	if len(routeRules) == 0 {
+		return hostRules
+	}
+
+	overridden := make(map[string]bool, len(routeRules))
+	for _, rule := range routeRules {
+		overridden[headerRuleKey(&rule)] = true
+	}
+
+	output := make([]host.HeaderRule, 0, len(hostRules)+len(routeRules))
+	for _, rule := range hostRules {
+		if !overridden[headerRuleKey(&rule)] {
+			output = append(output, rule)
+		}
+	}
+
+	return append(output, routeRules...)
+}
+
+func headerRuleKey(rule *host.HeaderRule) string {
+	return string(rule.Target) + ":" + strings.ToLower(rule.Name)
+}
+
+func headerRules(rules []host.HeaderRule) string {
+	builder := strings.Builder{}
+
+	for index, rule := range rules {
+		switch rule.Target {
+		case host.RequestHeaderRuleTarget:
+			appendRequestHeaderRule(&builder, index, &rule)
+		case host.ResponseHeaderRuleTarget:
+			appendResponseHeaderRule(&builder, &rule)
+		}
+	}
+
+	return builder.String()
+}
+
+func appendRequestHeaderRule(builder *strings.Builder, index int, rule *host.HeaderRule) {
+	switch rule.Action {
+	case host.SetHeaderRuleAction:
+		_, _ = fmt.Fprintf(
+			builder,
+			"\nproxy_set_header \"%s\" \"%s\";",
+			rule.Name,
+			headerRuleValue(rule),
+		)
+	case host.AppendHeaderRuleAction:
+		variable := fmt.Sprintf("$header_rule_%d", index)
+		original := "$http_" + headerVariableInvalidChars.ReplaceAllString(
+			strings.ToLower(rule.Name),
+			"_",
+		)
+		value := headerRuleValue(rule)
+
+		_, _ = fmt.Fprintf(
+			builder,
+			"\nset %s \"%s\";\nif (%s) { set %s \"%s, %s\"; }\nproxy_set_header \"%s\" %s;",
+			variable,
+			value,
+			original,
+			variable,
+			original,
+			value,
+			rule.Name,
+			variable,
+		)
+	case host.RemoveHeaderRuleAction:
+		_, _ = fmt.Fprintf(builder, "\nproxy_set_header \"%s\" \"\";", rule.Name)
+	}
+}
+
+func appendResponseHeaderRule(builder *strings.Builder, rule *host.HeaderRule) {
+	if rule.Action == host.SetHeaderRuleAction || rule.Action == host.RemoveHeaderRuleAction {
+		_, _ = fmt.Fprintf(builder, "\nproxy_hide_header \"%s\";", rule.Name)
+	}
+
+	if rule.Action == host.SetHeaderRuleAction || rule.Action == host.AppendHeaderRuleAction {
+		_, _ = fmt.Fprintf(
+			builder,
+			"\nadd_header \"%s\" \"%s\"%s;",
+			rule.Name,
+			headerRuleValue(rule),
+			flag(rule.Always, " always", ""),
+		)
+	}
+}
+
+func headerRuleValue(rule *host.HeaderRule) string {
+	if rule.Value == nil {
+		return ""
+	}
+
+	return escapeHeaderValue(*rule.Value)
+}
+
+func escapeHeaderValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+}
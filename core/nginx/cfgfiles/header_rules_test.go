@@ -0,0 +1,99 @@
+package cfgfiles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dillmann.com.br/nginx-ignition/core/host"
+)
+
+func Test_headerRules(t *testing.T) {
+	t.Run("sets and removes the request headers sent upstream", func(t *testing.T) {
+		result := headerRules([]host.HeaderRule{
+			newHeaderRule(host.RequestHeaderRuleTarget, host.SetHeaderRuleAction, "X-Tenant"),
+			newHeaderRule(host.RequestHeaderRuleTarget, host.RemoveHeaderRuleAction, "Cookie"),
+		})
+
+		assert.Contains(t, result, `proxy_set_header "X-Tenant" "value";`)
+		assert.Contains(t, result, `proxy_set_header "Cookie" "";`)
+	})
+
+	t.Run("appends to the original request header when present", func(t *testing.T) {
+		result := headerRules([]host.HeaderRule{
+			newHeaderRule(host.RequestHeaderRuleTarget, host.SetHeaderRuleAction, "X-Tenant"),
+			newHeaderRule(
+				host.RequestHeaderRuleTarget,
+				host.AppendHeaderRuleAction,
+				"X-Forwarded-Tag",
+			),
+		})
+
+		assert.Contains(t, result, `set $header_rule_1 "value";`)
+		assert.Contains(
+			t,
+			result,
+			`if ($http_x_forwarded_tag) { set $header_rule_1 "$http_x_forwarded_tag, value"; }`,
+		)
+		assert.Contains(t, result, `proxy_set_header "X-Forwarded-Tag" $header_rule_1;`)
+	})
+
+	t.Run("replaces the upstream response header when setting it", func(t *testing.T) {
+		rule := newHeaderRule(host.ResponseHeaderRuleTarget, host.SetHeaderRuleAction, "Server")
+		rule.Always = true
+
+		result := headerRules([]host.HeaderRule{rule})
+
+		assert.Contains(t, result, `proxy_hide_header "Server";`)
+		assert.Contains(t, result, `add_header "Server" "value" always;`)
+	})
+
+	t.Run("appends and removes the response headers", func(t *testing.T) {
+		result := headerRules([]host.HeaderRule{
+			newHeaderRule(host.ResponseHeaderRuleTarget, host.AppendHeaderRuleAction, "Vary"),
+			newHeaderRule(
+				host.ResponseHeaderRuleTarget,
+				host.RemoveHeaderRuleAction,
+				"X-Powered-By",
+			),
+		})
+
+		assert.Contains(t, result, `add_header "Vary" "value";`)
+		assert.NotContains(t, result, `proxy_hide_header "Vary";`)
+		assert.Contains(t, result, `proxy_hide_header "X-Powered-By";`)
+		assert.NotContains(t, result, `add_header "X-Powered-By"`)
+	})
+
+	t.Run("escapes the quotes while keeping the variables", func(t *testing.T) {
+		rule := newHeaderRule(host.ResponseHeaderRuleTarget, host.SetHeaderRuleAction, "X-Info")
+		rule.Value = new(`served by "$hostname"`)
+
+		result := headerRules([]host.HeaderRule{rule})
+
+		assert.Contains(t, result, `add_header "X-Info" "served by \"$hostname\"";`)
+	})
+}
+
+func Test_mergeHeaderRules(t *testing.T) {
+	t.Run("keeps the host rules when the route has none", func(t *testing.T) {
+		hostRules := []host.HeaderRule{
+			newHeaderRule(host.RequestHeaderRuleTarget, host.SetHeaderRuleAction, "X-Tenant"),
+		}
+
+		assert.Equal(t, hostRules, mergeHeaderRules(hostRules, nil))
+	})
+
+	t.Run("route rules replace the host ones for the same header and target", func(t *testing.T) {
+		hostRules := []host.HeaderRule{
+			newHeaderRule(host.RequestHeaderRuleTarget, host.SetHeaderRuleAction, "X-Tenant"),
+			newHeaderRule(host.ResponseHeaderRuleTarget, host.SetHeaderRuleAction, "X-Tenant"),
+		}
+		routeRules := []host.HeaderRule{
+			newHeaderRule(host.RequestHeaderRuleTarget, host.RemoveHeaderRuleAction, "x-tenant"),
+		}
+
+		result := mergeHeaderRules(hostRules, routeRules)
+
+		assert.Equal(t, []host.HeaderRule{hostRules[1], routeRules[0]}, result)
+	})
+}
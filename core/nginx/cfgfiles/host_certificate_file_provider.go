@@ -35,6 +35,7 @@ func (p *hostCertificateFileProvider) provide(ctx *providerContext) ([]File, err
 
 	outputs := make([]File, 0)
 	uniqueCertIDs := map[string]bool{}
+	uniqueChainIDs := map[string]bool{}
 
 	for _, b := range bindings {
 		if b.Type == binding.HTTPSBindingType && b.CertificateID != nil {
@@ -49,12 +50,55 @@ func (p *hostCertificateFileProvider) provide(ctx *providerContext) ([]File, err
 
 				outputs = append(outputs, *output)
 			}
+
+			if ocspStaplingEnabled(ctx, &b) && !uniqueChainIDs[certID] {
+				uniqueChainIDs[certID] = true
+
+				output, err := p.buildCertificateChainFile(ctx.context, *b.CertificateID)
+				if err != nil {
+					return nil, err
+				}
+
+				outputs = append(outputs, *output)
+			}
 		}
 	}
 
 	return outputs, nil
 }
 
+// The chain used by nginx to verify the OCSP responses. Certificates without a chain fall back to
+// the certificate itself, which keeps the file valid even though no response can be verified.
+func (p *hostCertificateFileProvider) buildCertificateChainFile(
+	ctx context.Context,
+	certificateID uuid.UUID,
+) (*File, error) {
+	cert, err := p.certificateCommands.Get(ctx, certificateID)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := cert.CertificationChain
+	if len(chain) == 0 {
+		chain = []string{cert.PublicKey}
+	}
+
+	contents := make([]string, 0, len(chain))
+	for _, chainElement := range chain {
+		decodedBytes, _ := base64.StdEncoding.DecodeString(chainElement)
+		contents = append(contents, convertToPemEncodedCertificateString(decodedBytes))
+	}
+
+	return &File{
+		Name:     certificateChainFileName(certificateID),
+		Contents: strings.Join(contents, "\n"),
+	}, nil
+}
+
+func certificateChainFileName(id uuid.UUID) string {
+	return fmt.Sprintf("certificate-%s-chain.pem", id)
+}
+
 func (p *hostCertificateFileProvider) buildCertificateFile(
 	ctx context.Context,
 	certificateID uuid.UUID,
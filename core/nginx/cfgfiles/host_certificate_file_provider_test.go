@@ -12,6 +12,7 @@ import (
 	"dillmann.com.br/nginx-ignition/core/binding"
 	"dillmann.com.br/nginx-ignition/core/certificate"
 	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
 )
 
 func Test_hostCertificateFileProvider(t *testing.T) {
@@ -133,6 +134,49 @@ func Test_hostCertificateFileProvider(t *testing.T) {
 		})
 	})
 
+	t.Run("Provide with OCSP stapling", func(t *testing.T) {
+		t.Run("provides the certificate chain", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			profile := newTLSProfile()
+			profile.OCSPStapling = true
+
+			cert := newCertificate()
+			cert.CertificationChain = []string{
+				base64.StdEncoding.EncodeToString([]byte("chain-data")),
+			}
+
+			certificateCmds := certificate.NewMockedCommands(ctrl)
+			certificateCmds.EXPECT().Get(gomock.Any(), cert.ID).Times(2).Return(cert, nil)
+
+			provider := &hostCertificateFileProvider{
+				certificateCommands: certificateCmds,
+			}
+
+			ctx := newProviderContext(t)
+			ctx.tlsProfiles = []tlsprofile.TLSProfile{profile}
+			ctx.hosts = []host.Host{
+				{
+					Bindings: []binding.Binding{
+						{
+							Type:          binding.HTTPSBindingType,
+							CertificateID: &cert.ID,
+							TLSProfileID:  &profile.ID,
+						},
+					},
+				},
+			}
+
+			files, err := provider.provide(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, files, 2)
+			assert.Equal(t, fmt.Sprintf("certificate-%s-chain.pem", cert.ID), files[1].Name)
+			assert.Contains(t, files[1].Contents, "BEGIN CERTIFICATE")
+			assert.NotContains(t, files[1].Contents, "PRIVATE KEY")
+		})
+	})
+
 	t.Run("PemEncoding", func(t *testing.T) {
 		t.Run("convertToPemEncodedCertificateString wraps raw bytes in PEM", func(t *testing.T) {
 			raw := []byte("fake-cert")
@@ -2,7 +2,10 @@ package cfgfiles
 
 import (
 	"fmt"
+	"net"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -13,6 +16,9 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 	"dillmann.com.br/nginx-ignition/core/host"
 	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 )
 
 type hostConfigurationFileProvider struct {
@@ -40,13 +46,73 @@ func (p *hostConfigurationFileProvider) provide(ctx *providerContext) ([]File, e
 		}
 	}
 
+	for _, u := range ctx.upstreams {
+		outputs = append(outputs, File{
+			Name:     fmt.Sprintf("upstream-%s.conf", u.ID),
+			Contents: p.buildUpstream(&u),
+		})
+	}
+
 	return outputs, nil
 }
 
+func (p *hostConfigurationFileProvider) buildUpstream(u *upstream.Upstream) string {
+	builder := strings.Builder{}
+	_, _ = fmt.Fprintf(&builder, "upstream %s {\n", upstreamName(u.ID))
+
+	switch u.Method {
+	case upstream.LeastConnectionsBalancingMethod:
+		_, _ = builder.WriteString("least_conn;\n")
+	case upstream.IPHashBalancingMethod:
+		_, _ = builder.WriteString("ip_hash;\n")
+	case upstream.HashBalancingMethod:
+		_, _ = fmt.Fprintf(&builder, "hash %s;\n", *u.HashKey)
+	}
+
+	for _, server := range u.Servers {
+		address := net.JoinHostPort(strings.Trim(server.Address, "[]"), strconv.Itoa(server.Port))
+		_, _ = fmt.Fprintf(&builder, "server %s", address)
+
+		if server.Weight != nil {
+			_, _ = fmt.Fprintf(&builder, " weight=%d", *server.Weight)
+		}
+
+		if server.CircuitBreaker != nil {
+			_, _ = fmt.Fprintf(
+				&builder,
+				" max_fails=%d fail_timeout=%ds",
+				server.CircuitBreaker.MaxFailures,
+				server.CircuitBreaker.OpenSeconds,
+			)
+		}
+
+		if server.Backup {
+			_, _ = builder.WriteString(" backup")
+		}
+
+		_, _ = builder.WriteString(";\n")
+	}
+
+	if u.Keepalive != nil {
+		_, _ = fmt.Fprintf(&builder, "keepalive %d;\n", u.Keepalive.Connections)
+		_, _ = fmt.Fprintf(&builder, "keepalive_timeout %ds;\n", u.Keepalive.TimeoutSeconds)
+	}
+
+	_, _ = builder.WriteString("}\n")
+	return builder.String()
+}
+
 func (p *hostConfigurationFileProvider) buildHost(
 	ctx *providerContext,
 	h *host.Host,
 ) (*File, error) {
+	bindings := h.Bindings
+	if h.UseGlobalBindings {
+		bindings = ctx.cfg.GlobalBindings
+	}
+
+	ctx = p.withHostContext(ctx, h, bindings)
+
 	routes := make([]string, 0)
 	for _, r := range h.Routes {
 		if r.Enabled {
@@ -63,7 +129,11 @@ func (p *hostConfigurationFileProvider) buildHost(
 
 	httpsRedirect := ""
 	if h.FeatureSet.RedirectHTTPToHTTPS {
-		httpsRedirect = `if ($scheme = "http") { return 301 https://$server_name$request_uri; }`
+		httpsRedirect = `
+			set $https_redirect $scheme;
+			if ($uri ~ "^/\.well-known/acme-challenge/") { set $https_redirect ""; }
+			if ($https_redirect = "http") { return 301 https://$server_name$request_uri; }
+		`
 	}
 
 	http2 := ""
@@ -71,11 +141,6 @@ func (p *hostConfigurationFileProvider) buildHost(
 		http2 = "http2 on;"
 	}
 
-	bindings := h.Bindings
-	if h.UseGlobalBindings {
-		bindings = ctx.cfg.GlobalBindings
-	}
-
 	stats := ""
 	statsCfg := ctx.cfg.Nginx.Stats
 
@@ -98,6 +163,10 @@ func (p *hostConfigurationFileProvider) buildHost(
 	}
 
 	contents := make([]string, 0)
+	if p.jsonAccessLogsEnabled(ctx) {
+		contents = append(contents, p.buildAccessLogFormat(ctx, h))
+	}
+
 	for _, b := range bindings {
 		b, err := p.buildBinding(ctx, h, &b, routes, serverNames, httpsRedirect, http2, stats)
 		if err != nil {
@@ -112,6 +181,59 @@ func (p *hostConfigurationFileProvider) buildHost(
 	}, nil
 }
 
+func (p *hostConfigurationFileProvider) withHostContext(
+	ctx *providerContext,
+	h *host.Host,
+	bindings []binding.Binding,
+) *providerContext {
+	hostCtx := &hostContext{
+		hstsEnabled:              hstsEnabled(ctx, bindings),
+		clientCertificateHeaders: clientCertificateHeadersEnabled(ctx, bindings),
+	}
+
+	if h.SecurityHeadersID != nil {
+		hostCtx.locationHeaders += fmt.Sprintf(
+			"\ninclude \"%s%s\";",
+			ctx.paths.Config,
+			securityHeadersFileName(*h.SecurityHeadersID),
+		)
+	}
+
+	if hostCtx.hstsEnabled {
+		hostCtx.locationHeaders += "\n" + hstsLocationHeader
+	}
+
+	output := *ctx
+	output.host = hostCtx
+	return &output
+}
+
+func (p *hostConfigurationFileProvider) buildSecurityHeadersConfig(
+	ctx *providerContext,
+	h *host.Host,
+) string {
+	if h.SecurityHeadersID == nil {
+		return ""
+	}
+
+	output := fmt.Sprintf(
+		"include \"%s%s\";",
+		ctx.paths.Config,
+		securityHeadersFileName(*h.SecurityHeadersID),
+	)
+
+	profile := findSecurityHeaders(ctx.securityHeaders, h.SecurityHeadersID)
+	if profile != nil && collectsSecurityHeadersReports(profile) {
+		output += fmt.Sprintf(
+			"\ninclude \"%s%s\";",
+			ctx.paths.Config,
+			securityHeadersReportsFileName(profile.ID),
+		)
+	}
+
+	return output
+}
+
 func (p *hostConfigurationFileProvider) buildServerNames(h *host.Host) string {
 	if h.DefaultServer {
 		return "server_name _;"
@@ -137,8 +259,7 @@ func (p *hostConfigurationFileProvider) buildBinding(
 				listen %s:%d ssl %s;
 				ssl_certificate "%scertificate-%s.pem";
 				ssl_certificate_key "%scertificate-%s.pem";
-				ssl_protocols TLSv1.2 TLSv1.3;
-				ssl_ciphers HIGH:!aNULL:!MD5;
+				%s
 			`,
 			b.IP,
 			b.Port,
@@ -147,23 +268,36 @@ func (p *hostConfigurationFileProvider) buildBinding(
 			b.CertificateID,
 			ctx.paths.Config,
 			b.CertificateID,
+			tlsSettings(ctx, b),
 		)
 	default:
 		return "", fmt.Errorf("invalid binding type: %s", b.Type)
 	}
 
 	conditionalHTTPSRedirect := ""
+	acmeChallenge := ""
 	if b.Type == binding.HTTPBindingType {
 		conditionalHTTPSRedirect = httpsRedirect
+		acmeChallenge = p.buildACMEChallengeLocation(ctx)
 	}
 
-	logs := ctx.cfg.Nginx.Logs
+	accessLogFormat := ""
+	routeIDDefault := ""
+	if p.jsonAccessLogsEnabled(ctx) {
+		accessLogFormat = " " + accessLogFormatName(h.ID)
+		routeIDDefault = `set $ignition_route_id "";`
+	}
+
+	hsts := ""
+	if ctx.host != nil && ctx.host.hstsEnabled {
+		hsts = hstsVariable(ctx, b)
+	}
 
 	return fmt.Sprintf(
 		`server {
 			root /dev/null;
-			access_log %s;
-			error_log %s;
+			%s
+			%s
 			gzip %s;
 			client_max_body_size %dM;
 			%s
@@ -174,39 +308,156 @@ func (p *hostConfigurationFileProvider) buildBinding(
 			%s
 			%s
 			%s
+			%s
+			%s
+			%s
+			%s
+			%s
 		}`,
-		flag(
-			logs.AccessLogsEnabled,
-			fmt.Sprintf("\"%shost-%s.access.log\"", ctx.paths.Logs, h.ID),
-			"off",
-		),
-		flag(
-			logs.ErrorLogsEnabled,
-			fmt.Sprintf(
-				"\"%shost-%s.error.log\" %s",
-				ctx.paths.Logs,
-				h.ID,
-				strings.ToLower(string(logs.ErrorLogsLevel)),
-			),
-			"off",
-		),
+		p.buildAccessLogs(ctx, h, accessLogFormat),
+		p.buildErrorLogs(ctx, h),
 		statusFlag(ctx.cfg.Nginx.GzipEnabled),
 		ctx.cfg.Nginx.MaximumBodySizeMb,
+		routeIDDefault,
+		hsts,
 		flag(
 			h.AccessListID != nil,
 			fmt.Sprintf("include \"%saccess-list-%s.conf\";", ctx.paths.Config, h.AccessListID),
 			"",
 		),
 		p.buildCacheConfig(ctx.caches, h.CacheID),
+		p.buildRateLimitConfig(ctx.rateLimits, h.RateLimitID),
+		p.buildSecurityHeadersConfig(ctx, h),
 		conditionalHTTPSRedirect,
 		http2,
 		stats,
 		listen,
 		serverNames,
+		acmeChallenge,
 		strings.Join(routes, "\n"),
 	), nil
 }
 
+func (p *hostConfigurationFileProvider) buildAccessLogs(
+	ctx *providerContext,
+	h *host.Host,
+	format string,
+) string {
+	logs := ctx.cfg.Nginx.Logs
+	lines := make([]string, 0)
+
+	if logs.AccessLogsEnabled {
+		lines = append(
+			lines,
+			fmt.Sprintf("access_log \"%shost-%s.access.log\"%s;", ctx.paths.Logs, h.ID, format),
+		)
+	}
+
+	for _, destination := range nativeLogDestinations(logs, &h.ID, true) {
+		lines = append(lines, fmt.Sprintf("access_log %s%s;", syslogTarget(&destination), format))
+	}
+
+	if len(lines) == 0 {
+		return "access_log off;"
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (p *hostConfigurationFileProvider) buildErrorLogs(ctx *providerContext, h *host.Host) string {
+	logs := ctx.cfg.Nginx.Logs
+	level := strings.ToLower(string(logs.ErrorLogsLevel))
+	lines := make([]string, 0)
+
+	if logs.ErrorLogsEnabled {
+		lines = append(
+			lines,
+			fmt.Sprintf("error_log \"%shost-%s.error.log\" %s;", ctx.paths.Logs, h.ID, level),
+		)
+	}
+
+	for _, destination := range nativeLogDestinations(logs, &h.ID, false) {
+		lines = append(lines, fmt.Sprintf("error_log %s %s;", syslogTarget(&destination), level))
+	}
+
+	if len(lines) == 0 {
+		return "error_log off;"
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (p *hostConfigurationFileProvider) jsonAccessLogsEnabled(ctx *providerContext) bool {
+	logs := ctx.cfg.Nginx.Logs
+	if logs.AccessLogsFormat != settings.JSONAccessLogFormat {
+		return false
+	}
+
+	return logs.AccessLogsEnabled || slices.ContainsFunc(
+		logs.Destinations,
+		func(destination settings.LogDestination) bool {
+			return destination.IsNative() && destination.AccessLogs
+		},
+	)
+}
+
+func (p *hostConfigurationFileProvider) buildAccessLogFormat(
+	ctx *providerContext,
+	h *host.Host,
+) string {
+	countryCode := ""
+	if ctx.cfg.Nginx.Stats.Enabled {
+		countryCode = "$geoip_country_code"
+	}
+
+	return fmt.Sprintf(
+		`log_format %s escape=json '{'
+			'"time":"$time_iso8601",'
+			'"hostId":"%s",'
+			'"routeId":"$ignition_route_id",'
+			'"clientIp":"$remote_addr",'
+			'"method":"$request_method",'
+			'"requestUri":"$request_uri",'
+			'"protocol":"$server_protocol",'
+			'"status":$status,'
+			'"bytesSent":$body_bytes_sent,'
+			'"requestTime":$request_time,'
+			'"upstreamTime":"$upstream_response_time",'
+			'"cacheStatus":"$upstream_cache_status",'
+			'"referer":"$http_referer",'
+			'"userAgent":"$http_user_agent",'
+			'"countryCode":"%s"'
+		'}';`,
+		accessLogFormatName(h.ID),
+		h.ID,
+		countryCode,
+	)
+}
+
+func (p *hostConfigurationFileProvider) buildRouteLogVariables(
+	ctx *providerContext,
+	r *host.Route,
+) string {
+	if !p.jsonAccessLogsEnabled(ctx) {
+		return ""
+	}
+
+	return fmt.Sprintf(`set $ignition_route_id "%s";`, r.ID)
+}
+
+func (p *hostConfigurationFileProvider) buildACMEChallengeLocation(ctx *providerContext) string {
+	return fmt.Sprintf(
+		`location ^~ /.well-known/acme-challenge/ {
+			root "%s";
+			default_type "text/plain";
+			auth_basic off;
+			allow all;
+			try_files $uri =404;
+		}`,
+		ctx.paths.ACMEChallenge,
+	)
+}
+
 func (p *hostConfigurationFileProvider) buildBindingAdditionalParams(h *host.Host) string {
 	if h.DefaultServer {
 		return "default_server"
@@ -220,11 +471,15 @@ func (p *hostConfigurationFileProvider) buildRoute(
 	h *host.Host,
 	r *host.Route,
 ) (string, error) {
+	effectiveRoute := *r
+	effectiveRoute.HeaderRules = mergeHeaderRules(h.HeaderRules, r.HeaderRules)
+	r = &effectiveRoute
+
 	switch r.Type {
 	case host.StaticResponseRouteType:
 		return p.buildStaticResponseRoute(ctx, h, r), nil
 	case host.ProxyRouteType:
-		return p.buildProxyRoute(ctx, r, h.FeatureSet), nil
+		return p.buildProxyRoute(ctx, r, h.FeatureSet)
 	case host.RedirectRouteType:
 		return p.buildRedirectRoute(ctx, r, h.FeatureSet), nil
 	case host.IntegrationRouteType:
@@ -254,6 +509,8 @@ func (p *hostConfigurationFileProvider) buildStaticFilesRoute(
 
 	return fmt.Sprintf(
 		`location %s {
+			%s
+			%s
 			rewrite  ^%s(.*) /$1 break;
 			root "%s";
 			%s
@@ -264,6 +521,8 @@ func (p *hostConfigurationFileProvider) buildStaticFilesRoute(
 			%s
 		}`,
 		normalizedSourcePath,
+		p.buildRouteLogVariables(ctx, r),
+		rewriteRules(r),
 		normalizedSourcePath,
 		*r.TargetURI,
 		indexFile,
@@ -289,11 +548,14 @@ func (p *hostConfigurationFileProvider) buildStaticResponseRoute(
 		location @route_%d/static_payload {
 			internal;
 			%s
+			%s
 			root "%s";
 			try_files "%s" =%d;
 		}
 
 		location %s {
+			%s
+			%s
 			%s
 			error_page 599 =%d @route_%d/static_payload;
 			%s
@@ -302,10 +564,13 @@ func (p *hostConfigurationFileProvider) buildStaticResponseRoute(
 		}`,
 		r.Priority,
 		headers,
+		p.buildLocationHeaders(ctx),
 		ctx.paths.Config,
 		payloadFilePath,
 		r.Response.StatusCode,
-		r.SourcePath,
+		routeLocation(r),
+		p.buildRouteLogVariables(ctx, r),
+		rewriteRules(r),
 		headers,
 		r.Response.StatusCode,
 		r.Priority,
@@ -318,18 +583,75 @@ func (p *hostConfigurationFileProvider) buildProxyRoute(
 	ctx *providerContext,
 	r *host.Route,
 	features host.FeatureSet,
-) string {
+) (string, error) {
+	if r.UpstreamID != nil {
+		return p.buildUpstreamProxyRoute(ctx, r, features)
+	}
+
 	return fmt.Sprintf(
 		`location %s {
 			%s
 			%s
 			%s
+			%s
+			%s
 		}`,
-		r.SourcePath,
+		routeLocation(r),
+		p.buildRouteLogVariables(ctx, r),
+		rewriteRules(r),
 		p.buildProxyPass(r),
 		p.buildRouteFeatures(features),
 		p.buildRouteSettings(ctx, r),
-	)
+	), nil
+}
+
+func (p *hostConfigurationFileProvider) buildUpstreamProxyRoute(
+	ctx *providerContext,
+	r *host.Route,
+	features host.FeatureSet,
+) (string, error) {
+	var u *upstream.Upstream
+	for index := range ctx.upstreams {
+		if ctx.upstreams[index].ID == *r.UpstreamID {
+			u = &ctx.upstreams[index]
+			break
+		}
+	}
+
+	if u == nil {
+		return "", fmt.Errorf("upstream not found: %s", *r.UpstreamID)
+	}
+
+	path := ""
+	if r.TargetURI != nil {
+		path = strings.TrimSpace(*r.TargetURI)
+	}
+
+	routeFeatures := p.buildRouteFeatures(features)
+	if routeFeatures == "" && u.Keepalive != nil {
+		routeFeatures = `
+			proxy_http_version 1.1;
+			proxy_set_header Connection "";
+		`
+	}
+
+	return fmt.Sprintf(
+		`location %s {
+			%s
+			%s
+			proxy_pass %s://%s%s;
+			%s
+			%s
+		}`,
+		routeLocation(r),
+		p.buildRouteLogVariables(ctx, r),
+		rewriteRules(r),
+		strings.ToLower(string(u.Protocol)),
+		upstreamName(u.ID),
+		regexLocationTarget(r, path),
+		routeFeatures,
+		p.buildRouteSettings(ctx, r),
+	), nil
 }
 
 func (p *hostConfigurationFileProvider) buildIntegrationRoute(
@@ -374,8 +696,12 @@ func (p *hostConfigurationFileProvider) buildIntegrationRoute(
 			%s
 			%s
 			%s
+			%s
+			%s
 		}`,
-		r.SourcePath,
+		routeLocation(r),
+		p.buildRouteLogVariables(ctx, r),
+		rewriteRules(r),
 		dnsConfig,
 		p.buildProxyPass(r, *proxyURL),
 		p.buildRouteFeatures(features),
@@ -390,13 +716,19 @@ func (p *hostConfigurationFileProvider) buildRedirectRoute(
 ) string {
 	return fmt.Sprintf(
 		`location %s {
+			%s
+			%s
+			%s
 			return %d %s;
 			%s
 			%s
 		}`,
-		r.SourcePath,
+		routeLocation(r),
+		p.buildRouteLogVariables(ctx, r),
+		rewriteRules(r),
+		redirectArgsSeparator(r),
 		*r.RedirectCode,
-		*r.TargetURI,
+		redirectTarget(r),
 		p.buildRouteFeatures(features),
 		p.buildRouteSettings(ctx, r),
 	)
@@ -435,9 +767,13 @@ func (p *hostConfigurationFileProvider) buildExecuteCodeRoute(
 			%s
 			%s
 			%s
+			%s
+			%s
 		}`,
 		headerBlock,
-		r.SourcePath,
+		routeLocation(r),
+		p.buildRouteLogVariables(ctx, r),
+		rewriteRules(r),
 		routeBlock,
 		p.buildRouteFeatures(h.FeatureSet),
 		p.buildRouteSettings(ctx, r),
@@ -467,7 +803,7 @@ func (p *hostConfigurationFileProvider) buildProxyPass(r *host.Route, uri ...str
 	}
 
 	builder := strings.Builder{}
-	_, _ = fmt.Fprintf(&builder, "proxy_pass %s;", *targetURI)
+	_, _ = fmt.Fprintf(&builder, "proxy_pass %s;", regexLocationTarget(r, *targetURI))
 
 	if r.Settings.KeepOriginalDomainName {
 		u, _ := url.Parse(*targetURI)
@@ -502,6 +838,10 @@ func (p *hostConfigurationFileProvider) buildRouteSettings(
 		`)
 	}
 
+	if ctx.host != nil && ctx.host.clientCertificateHeaders {
+		_, _ = builder.WriteString(clientCertificateHeaders)
+	}
+
 	if r.Settings.Custom != nil {
 		_, _ = builder.WriteString("\n")
 		_, _ = builder.WriteString(*r.Settings.Custom)
@@ -517,6 +857,64 @@ func (p *hostConfigurationFileProvider) buildRouteSettings(
 	}
 
 	_, _ = builder.WriteString(p.buildCacheConfig(ctx.caches, r.CacheID))
+	_, _ = builder.WriteString(p.buildRateLimitConfig(ctx.rateLimits, r.RateLimitID))
+	_, _ = builder.WriteString(headerRules(r.HeaderRules))
+	_, _ = builder.WriteString(p.buildLocationHeaders(ctx))
+
+	return builder.String()
+}
+
+func (p *hostConfigurationFileProvider) buildLocationHeaders(ctx *providerContext) string {
+	if ctx.host == nil {
+		return ""
+	}
+
+	return ctx.host.locationHeaders
+}
+
+func (p *hostConfigurationFileProvider) buildRateLimitConfig(
+	rateLimits []ratelimit.RateLimit,
+	rateLimitID *uuid.UUID,
+) string {
+	if rateLimitID == nil {
+		return ""
+	}
+
+	var r *ratelimit.RateLimit
+	for _, item := range rateLimits {
+		if item.ID == *rateLimitID {
+			r = &item
+			break
+		}
+	}
+
+	if r == nil {
+		return ""
+	}
+
+	builder := strings.Builder{}
+	idNoDashes := rateLimitIDNoDashes(r.ID)
+
+	_, _ = fmt.Fprintf(&builder, "\nlimit_req zone=rate_limit_%s", idNoDashes)
+	if r.Burst > 0 {
+		_, _ = fmt.Fprintf(&builder, " burst=%d", r.Burst)
+	}
+
+	if r.NoDelay {
+		_, _ = builder.WriteString(" nodelay")
+	}
+
+	_, _ = fmt.Fprintf(&builder, ";\nlimit_req_status %d;", r.ResponseStatusCode)
+
+	if r.ConnectionLimit.Enabled && r.ConnectionLimit.MaximumConnections != nil {
+		_, _ = fmt.Fprintf(
+			&builder,
+			"\nlimit_conn conn_limit_%s %d;\nlimit_conn_status %d;",
+			idNoDashes,
+			*r.ConnectionLimit.MaximumConnections,
+			r.ResponseStatusCode,
+		)
+	}
 
 	return builder.String()
 }
@@ -680,3 +1078,11 @@ func (p *hostConfigurationFileProvider) appendCacheFileExtensions(
 		fmt.Sprintf("\\.(%s)$", strings.Join(extensions, "|")),
 	)
 }
+
+func accessLogFormatName(id uuid.UUID) string {
+	return "host_" + strings.ReplaceAll(id.String(), "-", "")
+}
+
+func upstreamName(id uuid.UUID) string {
+	return "upstream_" + strings.ReplaceAll(id.String(), "-", "")
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"dillmann.com.br/nginx-ignition/core/binding"
@@ -15,6 +16,11 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 	"dillmann.com.br/nginx-ignition/core/host"
 	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 )
 
 func Test_hostConfigurationFileProvider(t *testing.T) {
@@ -50,6 +56,250 @@ func Test_hostConfigurationFileProvider(t *testing.T) {
 		assert.Contains(t, files[0].Contents, "proxy_pass http://backend:8080;")
 	})
 
+	t.Run("Provide keeps the ACME challenge reachable with HTTPS redirect", func(t *testing.T) {
+		provider := &hostConfigurationFileProvider{}
+
+		h := newHost()
+		h.FeatureSet.RedirectHTTPToHTTPS = true
+
+		ctx := newProviderContext(t)
+		ctx.hosts = []host.Host{h}
+		ctx.cfg = newSettings()
+
+		files, err := provider.provide(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+		assert.Contains(t, files[0].Contents, "location ^~ /.well-known/acme-challenge/ {")
+		assert.Contains(
+			t,
+			files[0].Contents,
+			`if ($uri ~ "^/\.well-known/acme-challenge/") { set $https_redirect ""; }`,
+		)
+		assert.Contains(
+			t,
+			files[0].Contents,
+			`if ($https_redirect = "http") { return 301 https://$server_name$request_uri; }`,
+		)
+	})
+
+	t.Run("Provide includes the security headers", func(t *testing.T) {
+		provider := &hostConfigurationFileProvider{}
+
+		h := newHost()
+		securityHeadersID := uuid.New()
+		h.SecurityHeadersID = &securityHeadersID
+
+		ctx := newProviderContext(t)
+		ctx.hosts = []host.Host{h}
+		ctx.cfg = newSettings()
+
+		files, err := provider.provide(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+		assert.Contains(
+			t,
+			files[0].Contents,
+			fmt.Sprintf("include \"/etc/nginx/security-headers-%s.conf\";", securityHeadersID),
+		)
+	})
+
+	t.Run("Provide repeats the security headers in the locations", func(t *testing.T) {
+		provider := &hostConfigurationFileProvider{}
+
+		securityHeaders := newSecurityHeaders()
+		securityHeaders.ContentSecurityPolicy.CollectReports = true
+
+		c := newCache()
+		c.CacheStatusResponseHeaderEnabled = true
+
+		h := newHost()
+		h.SecurityHeadersID = &securityHeaders.ID
+		h.Routes = []host.Route{
+			{
+				Enabled:    true,
+				Type:       host.ProxyRouteType,
+				SourcePath: "/",
+				TargetURI:  new("http://backend:8080"),
+				CacheID:    &c.ID,
+				HeaderRules: []host.HeaderRule{
+					{
+						Target: host.ResponseHeaderRuleTarget,
+						Action: host.SetHeaderRuleAction,
+						Name:   "X-Served-By",
+						Value:  new("nginx"),
+					},
+				},
+			},
+		}
+
+		ctx := newProviderContext(t)
+		ctx.hosts = []host.Host{h}
+		ctx.caches = []cache.Cache{c}
+		ctx.securityHeaders = []securityheaders.SecurityHeaders{securityHeaders}
+
+		files, err := provider.provide(ctx)
+		assert.NoError(t, err)
+		require.Len(t, files, 1)
+
+		include := fmt.Sprintf(
+			"include \"/etc/nginx/security-headers-%s.conf\";",
+			securityHeaders.ID,
+		)
+		server, location, found := strings.Cut(files[0].Contents, "location / {")
+		require.True(t, found)
+		assert.Contains(t, server, include)
+		assert.Contains(
+			t,
+			server,
+			fmt.Sprintf(
+				"include \"/etc/nginx/security-headers-%s-reports.conf\";",
+				securityHeaders.ID,
+			),
+		)
+		assert.Contains(t, location, `add_header "X-Served-By" "nginx";`)
+		assert.Contains(t, location, "add_header X-Cache-Status $upstream_cache_status;")
+		assert.Contains(t, location, include)
+	})
+
+	t.Run("Provide repeats the HSTS header in the locations", func(t *testing.T) {
+		provider := &hostConfigurationFileProvider{}
+
+		profile := newTLSProfile()
+		profile.HSTS = tlsprofile.HSTS{Enabled: true, MaxAgeSeconds: 31536000}
+
+		c := newCache()
+		c.CacheStatusResponseHeaderEnabled = true
+
+		h := newHost()
+		h.Bindings = append(h.Bindings, binding.Binding{
+			Type:          binding.HTTPSBindingType,
+			IP:            "0.0.0.0",
+			Port:          443,
+			CertificateID: new(uuid.New()),
+			TLSProfileID:  &profile.ID,
+		})
+		h.Routes = []host.Route{
+			{
+				Enabled:    true,
+				Type:       host.ProxyRouteType,
+				SourcePath: "/",
+				TargetURI:  new("http://backend:8080"),
+				CacheID:    &c.ID,
+			},
+		}
+
+		ctx := newProviderContext(t)
+		ctx.hosts = []host.Host{h}
+		ctx.caches = []cache.Cache{c}
+		ctx.tlsProfiles = []tlsprofile.TLSProfile{profile}
+
+		files, err := provider.provide(ctx)
+		assert.NoError(t, err)
+		require.Len(t, files, 1)
+
+		servers := strings.Split(files[0].Contents, "server {")
+		require.Len(t, servers, 3)
+		assert.Contains(t, servers[1], `set $ignition_hsts "";`)
+		assert.Contains(t, servers[2], `set $ignition_hsts "max-age=31536000";`)
+
+		for _, server := range servers[1:] {
+			location := server[strings.Index(server, "location / {"):]
+			assert.Contains(t, location, "add_header X-Cache-Status $upstream_cache_status;")
+			assert.Contains(
+				t,
+				location,
+				"add_header Strict-Transport-Security $ignition_hsts always;",
+			)
+		}
+	})
+
+	t.Run(
+		"Provide forwards the client certificate only in the hosts verifying it",
+		func(t *testing.T) {
+			provider := &hostConfigurationFileProvider{}
+
+			mutual := newTLSProfile()
+			mutual.ClientVerification.Enabled = true
+			regular := newTLSProfile()
+
+			route := host.Route{
+				Enabled:    true,
+				Type:       host.ProxyRouteType,
+				SourcePath: "/",
+				TargetURI:  new("http://backend:8080"),
+			}
+
+			verified := newHost()
+			verified.DefaultServer = false
+			verified.Bindings = []binding.Binding{{
+				Type:          binding.HTTPSBindingType,
+				IP:            "0.0.0.0",
+				Port:          443,
+				CertificateID: new(uuid.New()),
+				TLSProfileID:  &mutual.ID,
+			}}
+			verified.Routes = []host.Route{route}
+
+			unverified := newHost()
+			unverified.DomainNames = []string{"example.org"}
+			unverified.Bindings = []binding.Binding{{
+				Type:          binding.HTTPSBindingType,
+				IP:            "0.0.0.0",
+				Port:          443,
+				CertificateID: new(uuid.New()),
+				TLSProfileID:  &regular.ID,
+			}}
+			unverified.Routes = []host.Route{route}
+
+			ctx := newProviderContext(t)
+			ctx.hosts = []host.Host{verified, unverified}
+			ctx.tlsProfiles = []tlsprofile.TLSProfile{mutual, regular}
+
+			files, err := provider.provide(ctx)
+			assert.NoError(t, err)
+
+			contents := make(map[string]string)
+			for _, file := range files {
+				contents[file.Name] = file.Contents
+			}
+
+			header := "proxy_set_header x-ssl-client-verify $ssl_client_verify;"
+			assert.Contains(t, contents[fmt.Sprintf("host-%s.conf", verified.ID)], header)
+			assert.NotContains(t, contents[fmt.Sprintf("host-%s.conf", unverified.ID)], header)
+		},
+	)
+
+	t.Run("Provide with upstreams", func(t *testing.T) {
+		provider := &hostConfigurationFileProvider{}
+		u := newUpstream()
+
+		h := newHost()
+		h.Routes = []host.Route{
+			{
+				Enabled:    true,
+				Type:       host.ProxyRouteType,
+				SourcePath: "/",
+				UpstreamID: &u.ID,
+			},
+		}
+
+		ctx := newProviderContext(t)
+		ctx.hosts = []host.Host{h}
+		ctx.upstreams = []upstream.Upstream{u}
+		ctx.cfg = newSettings()
+
+		files, err := provider.provide(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, files, 2)
+		assert.Equal(t, fmt.Sprintf("upstream-%s.conf", u.ID), files[1].Name)
+		assert.Contains(t, files[1].Contents, fmt.Sprintf("upstream %s {", upstreamName(u.ID)))
+		assert.Contains(
+			t,
+			files[0].Contents,
+			fmt.Sprintf("proxy_pass http://%s;", upstreamName(u.ID)),
+		)
+	})
+
 	t.Run("Provide with traffic stats enabled", func(t *testing.T) {
 		provider := &hostConfigurationFileProvider{}
 
@@ -121,6 +371,151 @@ func Test_hostConfigurationFileProvider(t *testing.T) {
 		assert.Contains(t, files[0].Contents, "vhost_traffic_status on;")
 	})
 
+	t.Run("Provide with JSON access logs", func(t *testing.T) {
+		provider := &hostConfigurationFileProvider{}
+
+		h := newHost()
+		h.Routes = []host.Route{
+			{
+				ID:           uuid.New(),
+				Enabled:      true,
+				Type:         host.RedirectRouteType,
+				SourcePath:   "/",
+				TargetURI:    new("https://example.com"),
+				RedirectCode: new(301),
+			},
+		}
+
+		ctx := newProviderContext(t)
+		ctx.hosts = []host.Host{h}
+		ctx.cfg.Nginx.Logs.AccessLogsEnabled = true
+		ctx.cfg.Nginx.Logs.AccessLogsFormat = settings.JSONAccessLogFormat
+		ctx.cfg.Nginx.Stats.Enabled = true
+
+		files, err := provider.provide(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+
+		formatName := accessLogFormatName(h.ID)
+		contents := files[0].Contents
+		assert.Contains(t, contents, fmt.Sprintf("log_format %s escape=json '{'", formatName))
+		assert.Contains(t, contents, fmt.Sprintf(`'"hostId":"%s",'`, h.ID))
+		assert.Contains(t, contents, `'"countryCode":"$geoip_country_code"'`)
+		assert.Contains(
+			t,
+			contents,
+			fmt.Sprintf(`access_log "/var/log/nginx/host-%s.access.log" %s;`, h.ID, formatName),
+		)
+		assert.Contains(t, contents, `set $ignition_route_id "";`)
+		assert.Less(
+			t,
+			strings.Index(contents, fmt.Sprintf(`set $ignition_route_id "%s";`, h.Routes[0].ID)),
+			strings.Index(contents, "return 301 https://example.com;"),
+		)
+	})
+
+	t.Run("Provide with combined access logs", func(t *testing.T) {
+		provider := &hostConfigurationFileProvider{}
+
+		h := newHost()
+		ctx := newProviderContext(t)
+		ctx.hosts = []host.Host{h}
+		ctx.cfg.Nginx.Logs.AccessLogsEnabled = true
+		ctx.cfg.Nginx.Logs.AccessLogsFormat = settings.CombinedAccessLogFormat
+
+		files, err := provider.provide(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+		assert.NotContains(t, files[0].Contents, "log_format")
+		assert.NotContains(t, files[0].Contents, "$ignition_route_id")
+		assert.Contains(
+			t,
+			files[0].Contents,
+			fmt.Sprintf(`access_log "/var/log/nginx/host-%s.access.log";`, h.ID),
+		)
+	})
+
+	t.Run("Provide with native syslog destinations", func(t *testing.T) {
+		provider := &hostConfigurationFileProvider{}
+
+		h := newHost()
+		otherHostID := uuid.New()
+		ctx := newProviderContext(t)
+		ctx.hosts = []host.Host{h}
+		ctx.cfg.Nginx.Logs.AccessLogsEnabled = false
+		ctx.cfg.Nginx.Logs.ErrorLogsEnabled = true
+		ctx.cfg.Nginx.Logs.ErrorLogsLevel = settings.WarnLogLevel
+		ctx.cfg.Nginx.Logs.AccessLogsFormat = settings.JSONAccessLogFormat
+		ctx.cfg.Nginx.Logs.Destinations = []settings.LogDestination{
+			{
+				Tag:        new("edge"),
+				Type:       settings.SyslogLogDestinationType,
+				Protocol:   settings.UDPLogDestinationProtocol,
+				Address:    "127.0.0.1:514",
+				Facility:   "local7",
+				AccessLogs: true,
+				ErrorLogs:  true,
+			},
+			{
+				HostID:     &h.ID,
+				Type:       settings.SyslogLogDestinationType,
+				Protocol:   settings.UnixLogDestinationProtocol,
+				Address:    "/dev/log",
+				Facility:   "daemon",
+				AccessLogs: true,
+			},
+			{
+				HostID:     &otherHostID,
+				Type:       settings.SyslogLogDestinationType,
+				Protocol:   settings.UDPLogDestinationProtocol,
+				Address:    "10.0.0.1",
+				Facility:   "local0",
+				AccessLogs: true,
+			},
+			{
+				Type:       settings.OTLPLogDestinationType,
+				Protocol:   settings.HTTPLogDestinationProtocol,
+				Address:    "http://localhost:4318/v1/logs",
+				AccessLogs: true,
+			},
+		}
+
+		files, err := provider.provide(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+
+		formatName := accessLogFormatName(h.ID)
+		contents := files[0].Contents
+		assert.Contains(t, contents, fmt.Sprintf("log_format %s escape=json '{'", formatName))
+		assert.Contains(
+			t,
+			contents,
+			fmt.Sprintf(
+				"access_log syslog:server=127.0.0.1:514,facility=local7,tag=edge %s;",
+				formatName,
+			),
+		)
+		assert.Contains(
+			t,
+			contents,
+			fmt.Sprintf("access_log syslog:server=unix:/dev/log,facility=daemon %s;", formatName),
+		)
+		assert.Contains(
+			t,
+			contents,
+			fmt.Sprintf(`error_log "/var/log/nginx/host-%s.error.log" warn;`, h.ID),
+		)
+		assert.Contains(
+			t,
+			contents,
+			"error_log syslog:server=127.0.0.1:514,facility=local7,tag=edge warn;",
+		)
+		assert.NotContains(t, contents, "access_log off;")
+		assert.NotContains(t, contents, "access.log")
+		assert.NotContains(t, contents, "10.0.0.1")
+		assert.NotContains(t, contents, "localhost:4318")
+	})
+
 	t.Run("BuildServerNames", func(t *testing.T) {
 		provider := &hostConfigurationFileProvider{}
 
@@ -175,6 +570,131 @@ func Test_hostConfigurationFileProvider(t *testing.T) {
 			result := provider.buildProxyPass(r, "http://override:9090")
 			assert.Equal(t, "proxy_pass http://override:9090;", result)
 		})
+
+		t.Run("removes the root path in regex locations", func(t *testing.T) {
+			r := &host.Route{
+				MatchType: host.CaseInsensitiveRegexRouteMatchType,
+				TargetURI: new("http://backend:8080/"),
+			}
+			assert.Equal(t, "proxy_pass http://backend:8080;", provider.buildProxyPass(r))
+		})
+	})
+
+	t.Run("BuildUpstream", func(t *testing.T) {
+		provider := &hostConfigurationFileProvider{}
+
+		t.Run("generates round robin upstream with server options", func(t *testing.T) {
+			u := newUpstream()
+			u.Servers = append(u.Servers, upstream.Server{
+				Address: "10.0.0.2",
+				Port:    8081,
+				Weight:  new(3),
+				CircuitBreaker: &upstream.CircuitBreaker{
+					MaxFailures: 2,
+					OpenSeconds: 30,
+				},
+				Backup: true,
+			})
+			u.Keepalive = &upstream.Keepalive{
+				Connections:    16,
+				TimeoutSeconds: 60,
+			}
+
+			result := provider.buildUpstream(&u)
+			assert.Contains(t, result, fmt.Sprintf("upstream %s {", upstreamName(u.ID)))
+			assert.Contains(t, result, "server 10.0.0.1:8080;")
+			assert.Contains(
+				t,
+				result,
+				"server 10.0.0.2:8081 weight=3 max_fails=2 fail_timeout=30s backup;",
+			)
+			assert.Contains(t, result, "keepalive 16;")
+			assert.Contains(t, result, "keepalive_timeout 60s;")
+			assert.NotContains(t, result, "least_conn;")
+		})
+
+		t.Run("wraps the IPv6 addresses in brackets", func(t *testing.T) {
+			u := newUpstream()
+			u.Servers = []upstream.Server{
+				{Address: "2001:db8::1", Port: 8080},
+				{Address: "[2001:db8::2]", Port: 8081},
+			}
+
+			result := provider.buildUpstream(&u)
+			assert.Contains(t, result, "server [2001:db8::1]:8080;")
+			assert.Contains(t, result, "server [2001:db8::2]:8081;")
+		})
+
+		t.Run("renders the balancing method", func(t *testing.T) {
+			u := newUpstream()
+
+			u.Method = upstream.LeastConnectionsBalancingMethod
+			assert.Contains(t, provider.buildUpstream(&u), "least_conn;")
+
+			u.Method = upstream.IPHashBalancingMethod
+			assert.Contains(t, provider.buildUpstream(&u), "ip_hash;")
+
+			u.Method = upstream.HashBalancingMethod
+			u.HashKey = new("$request_uri")
+			assert.Contains(t, provider.buildUpstream(&u), "hash $request_uri;")
+		})
+	})
+
+	t.Run("BuildProxyRoute", func(t *testing.T) {
+		provider := &hostConfigurationFileProvider{}
+		u := newUpstream()
+		ctx := newProviderContext(t)
+		ctx.upstreams = []upstream.Upstream{u}
+
+		t.Run("targets the upstream when present", func(t *testing.T) {
+			r := &host.Route{
+				SourcePath: "/api",
+				UpstreamID: &u.ID,
+				TargetURI:  new("/v1"),
+			}
+			result, err := provider.buildProxyRoute(ctx, r, host.FeatureSet{})
+			assert.NoError(t, err)
+			assert.Contains(
+				t,
+				result,
+				fmt.Sprintf("proxy_pass http://%s/v1;", upstreamName(u.ID)),
+			)
+			assert.NotContains(t, result, "proxy_http_version")
+		})
+
+		t.Run("enables HTTP/1.1 when the upstream uses keepalive", func(t *testing.T) {
+			keepaliveUpstream := newUpstream()
+			keepaliveUpstream.Protocol = upstream.HTTPSProtocol
+			keepaliveUpstream.Keepalive = &upstream.Keepalive{
+				Connections:    8,
+				TimeoutSeconds: 30,
+			}
+			keepaliveCtx := newProviderContext(t)
+			keepaliveCtx.upstreams = []upstream.Upstream{keepaliveUpstream}
+
+			r := &host.Route{
+				SourcePath: "/",
+				UpstreamID: &keepaliveUpstream.ID,
+			}
+			result, err := provider.buildProxyRoute(keepaliveCtx, r, host.FeatureSet{})
+			assert.NoError(t, err)
+			assert.Contains(
+				t,
+				result,
+				fmt.Sprintf("proxy_pass https://%s;", upstreamName(keepaliveUpstream.ID)),
+			)
+			assert.Contains(t, result, "proxy_http_version 1.1;")
+			assert.Contains(t, result, `proxy_set_header Connection "";`)
+		})
+
+		t.Run("returns error when upstream is not available", func(t *testing.T) {
+			r := &host.Route{
+				SourcePath: "/",
+				UpstreamID: new(uuid.New()),
+			}
+			_, err := provider.buildProxyRoute(ctx, r, host.FeatureSet{})
+			assert.Error(t, err)
+		})
 	})
 
 	t.Run("BuildRedirectRoute", func(t *testing.T) {
@@ -191,6 +711,28 @@ func Test_hostConfigurationFileProvider(t *testing.T) {
 			assert.Contains(t, result, "location /old {")
 			assert.Contains(t, result, "return 301 http://new.example.com;")
 		})
+
+		t.Run("reuses the captured groups of regex locations", func(t *testing.T) {
+			r := &host.Route{
+				SourcePath:   `^/blog/(\d+)$`,
+				MatchType:    host.RegexRouteMatchType,
+				RedirectCode: new(301),
+				TargetURI:    new("https://example.com/posts/$1"),
+				Settings:     host.RouteSettings{PreserveQueryString: true},
+				RewriteRules: []host.RewriteRule{
+					{
+						Pattern:     "^/blog/0+(\\d+)$",
+						Replacement: "/blog/$1",
+						Flag:        new(host.LastRewriteRuleFlag),
+					},
+				},
+			}
+			result := provider.buildRedirectRoute(ctx, r, host.FeatureSet{})
+			assert.Contains(t, result, `location ~ "^/blog/(\\d+)$" {`)
+			assert.Contains(t, result, `rewrite "^/blog/0+(\\d+)$" "/blog/$1" last;`)
+			assert.Contains(t, result, "return 301 https://example.com/posts/$1$is_args$args;")
+			assert.Less(t, strings.Index(result, "rewrite"), strings.Index(result, "return"))
+		})
 	})
 
 	t.Run("BuildIntegrationRoute", func(t *testing.T) {
@@ -410,6 +952,21 @@ func Test_hostConfigurationFileProvider(t *testing.T) {
 			assert.Contains(t, result, "proxy_set_header x-real-ip $remote_addr;")
 		})
 
+		t.Run("forwards the client certificate when the host verifies it", func(t *testing.T) {
+			verifyingCtx := newProviderContext(t)
+			verifyingCtx.host = &hostContext{clientCertificateHeaders: true}
+
+			result := provider.buildRouteSettings(verifyingCtx, &host.Route{})
+
+			assert.Contains(t, result, "proxy_set_header x-ssl-client-subject $ssl_client_s_dn;")
+			assert.Contains(t, result, "proxy_set_header x-ssl-client-verify $ssl_client_verify;")
+		})
+
+		t.Run("does not forward the client certificate by default", func(t *testing.T) {
+			result := provider.buildRouteSettings(ctx, &host.Route{})
+			assert.NotContains(t, result, "x-ssl-client")
+		})
+
 		t.Run("includes custom configuration", func(t *testing.T) {
 			r := &host.Route{
 				Settings: host.RouteSettings{
@@ -478,6 +1035,53 @@ func Test_hostConfigurationFileProvider(t *testing.T) {
 			result := provider.buildRouteSettings(ctx, r)
 			assert.NotContains(t, result, "proxy_ssl_verify")
 		})
+
+		t.Run("includes the rate limit when present", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.ConnectionLimit = ratelimit.ConnectionLimit{
+				Enabled:            true,
+				MaximumConnections: new(5),
+			}
+			limitedCtx := newProviderContext(t)
+			limitedCtx.rateLimits = []ratelimit.RateLimit{rateLimit}
+			idNoDashes := strings.ReplaceAll(rateLimit.ID.String(), "-", "")
+
+			result := provider.buildRouteSettings(
+				limitedCtx,
+				&host.Route{RateLimitID: &rateLimit.ID},
+			)
+
+			assert.Contains(
+				t,
+				result,
+				fmt.Sprintf("limit_req zone=rate_limit_%s burst=20 nodelay;", idNoDashes),
+			)
+			assert.Contains(t, result, "limit_req_status 429;")
+			assert.Contains(t, result, fmt.Sprintf("limit_conn conn_limit_%s 5;", idNoDashes))
+			assert.Contains(t, result, "limit_conn_status 429;")
+		})
+
+		t.Run("omits the optional rate limit parameters", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.Burst = 0
+			rateLimit.NoDelay = false
+			limitedCtx := newProviderContext(t)
+			limitedCtx.rateLimits = []ratelimit.RateLimit{rateLimit}
+			idNoDashes := strings.ReplaceAll(rateLimit.ID.String(), "-", "")
+
+			result := provider.buildRouteSettings(
+				limitedCtx,
+				&host.Route{RateLimitID: &rateLimit.ID},
+			)
+
+			assert.Contains(t, result, fmt.Sprintf("limit_req zone=rate_limit_%s;", idNoDashes))
+			assert.NotContains(t, result, "limit_conn")
+		})
+
+		t.Run("does not include rate limits by default", func(t *testing.T) {
+			result := provider.buildRouteSettings(ctx, &host.Route{})
+			assert.NotContains(t, result, "limit_req")
+		})
 	})
 
 	t.Run("BuildBinding", func(t *testing.T) {
@@ -535,6 +1139,26 @@ func Test_hostConfigurationFileProvider(t *testing.T) {
 			)
 		})
 
+		t.Run("applies the TLS profile to the HTTPS binding", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Preset = tlsprofile.ModernPreset
+			profileCtx := newProviderContext(t)
+			profileCtx.tlsProfiles = []tlsprofile.TLSProfile{profile}
+			b := &binding.Binding{
+				Type:          binding.HTTPSBindingType,
+				IP:            "0.0.0.0",
+				Port:          443,
+				CertificateID: new(uuid.New()),
+				TLSProfileID:  &profile.ID,
+			}
+
+			result, err := provider.buildBinding(profileCtx, h, b, []string{}, "", "", "", "")
+
+			assert.NoError(t, err)
+			assert.Contains(t, result, "ssl_protocols TLSv1.3;")
+			assert.NotContains(t, result, "ssl_ciphers HIGH:!aNULL:!MD5;")
+		})
+
 		t.Run("includes HTTP to HTTPS redirect in HTTP binding", func(t *testing.T) {
 			b := &binding.Binding{Type: binding.HTTPBindingType}
 			redirect := "return 301 https://$server_name$request_uri;"
@@ -543,6 +1167,24 @@ func Test_hostConfigurationFileProvider(t *testing.T) {
 			assert.Contains(t, result, redirect)
 		})
 
+		t.Run("includes the ACME challenge location in HTTP binding", func(t *testing.T) {
+			b := &binding.Binding{Type: binding.HTTPBindingType}
+			result, err := provider.buildBinding(ctx, h, b, []string{}, "", "", "", "")
+			assert.NoError(t, err)
+			assert.Contains(t, result, "location ^~ /.well-known/acme-challenge/ {")
+			assert.Contains(t, result, `root "/var/lib/nginx/acme-challenge/";`)
+		})
+
+		t.Run("does not include the ACME challenge location in HTTPS binding", func(t *testing.T) {
+			b := &binding.Binding{
+				Type:          binding.HTTPSBindingType,
+				CertificateID: new(uuid.New()),
+			}
+			result, err := provider.buildBinding(ctx, h, b, []string{}, "", "", "", "")
+			assert.NoError(t, err)
+			assert.NotContains(t, result, "acme-challenge")
+		})
+
 		t.Run("includes HTTP2 in HTTPS binding", func(t *testing.T) {
 			b := &binding.Binding{
 				Type:          binding.HTTPSBindingType,
@@ -572,6 +1214,44 @@ func Test_hostConfigurationFileProvider(t *testing.T) {
 			assert.Error(t, err)
 			assert.Contains(t, err.Error(), "invalid route type")
 		})
+
+		t.Run("applies the host header rules unless the route overrides them", func(t *testing.T) {
+			hostWithRules := &host.Host{
+				HeaderRules: []host.HeaderRule{
+					newHeaderRule(
+						host.ResponseHeaderRuleTarget,
+						host.SetHeaderRuleAction,
+						"X-Frame-Options",
+					),
+					newHeaderRule(
+						host.RequestHeaderRuleTarget,
+						host.SetHeaderRuleAction,
+						"X-Tenant",
+					),
+				},
+			}
+			r := &host.Route{
+				Type:         host.RedirectRouteType,
+				SourcePath:   "/",
+				TargetURI:    new("https://example.com"),
+				RedirectCode: new(301),
+				HeaderRules: []host.HeaderRule{
+					newHeaderRule(
+						host.RequestHeaderRuleTarget,
+						host.RemoveHeaderRuleAction,
+						"x-tenant",
+					),
+				},
+			}
+
+			result, err := provider.buildRoute(ctx, hostWithRules, r)
+
+			assert.NoError(t, err)
+			assert.Contains(t, result, `add_header "X-Frame-Options" "value";`)
+			assert.Contains(t, result, `proxy_set_header "x-tenant" "";`)
+			assert.NotContains(t, result, `proxy_set_header "X-Tenant"`)
+			assert.Len(t, r.HeaderRules, 1)
+		})
 	})
 
 	t.Run("BuildCacheConfig", func(t *testing.T) {
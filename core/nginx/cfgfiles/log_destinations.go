@@ -0,0 +1,46 @@
+package cfgfiles
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+func nativeLogDestinations(
+	logs *settings.NginxLogsSettings,
+	hostID *uuid.UUID,
+	accessLogs bool,
+) []settings.LogDestination {
+	output := make([]settings.LogDestination, 0)
+	for _, destination := range logs.Destinations {
+		if !destination.IsNative() {
+			continue
+		}
+
+		if !destination.AppliesTo(hostID) {
+			continue
+		}
+
+		if (accessLogs && destination.AccessLogs) || (!accessLogs && destination.ErrorLogs) {
+			output = append(output, destination)
+		}
+	}
+
+	return output
+}
+
+func syslogTarget(destination *settings.LogDestination) string {
+	server := destination.Address
+	if destination.Protocol == settings.UnixLogDestinationProtocol {
+		server = "unix:" + destination.Address
+	}
+
+	output := fmt.Sprintf("syslog:server=%s,facility=%s", server, destination.Facility)
+	if destination.Tag != nil {
+		output += ",tag=" + *destination.Tag
+	}
+
+	return output
+}
@@ -9,8 +9,10 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
 	"dillmann.com.br/nginx-ignition/core/common/runtime"
 	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
 	"dillmann.com.br/nginx-ignition/core/settings"
 	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 )
 
 type mainConfigurationFileProvider struct {
@@ -76,6 +78,7 @@ func (p *mainConfigurationFileProvider) provide(ctx *providerContext) ([]File, e
 			worker_processes %d;
 			pid "%snginx.pid";
 			error_log %s;
+			%s
 			
 			events {
 				worker_connections %d;
@@ -110,6 +113,8 @@ func (p *mainConfigurationFileProvider) provide(ctx *providerContext) ([]File, e
 				%s
 				%s
 				%s
+				%s
+				%s
 			}
 			
 			%s
@@ -119,6 +124,7 @@ func (p *mainConfigurationFileProvider) provide(ctx *providerContext) ([]File, e
 		cfg.Nginx.WorkerProcesses,
 		ctx.paths.Base,
 		p.getErrorLogPath(ctx.paths, logs),
+		p.getErrorLogDestinations(logs),
 		cfg.Nginx.WorkerConnections,
 		statusFlag(cfg.Nginx.SendfileEnabled),
 		statusFlag(cfg.Nginx.ServerTokensEnabled),
@@ -145,7 +151,9 @@ func (p *mainConfigurationFileProvider) provide(ctx *providerContext) ([]File, e
 		ctx.paths.Config,
 		customCfg,
 		p.getCacheDefinitions(ctx.paths, ctx.caches),
+		p.getRateLimitIncludes(ctx.paths, ctx.rateLimits),
 		statsDefinitions,
+		p.getUpstreamIncludes(ctx.paths, ctx.upstreams),
 		p.getHostIncludes(ctx.paths, ctx.hosts),
 		streamLines.String(),
 	)
@@ -173,6 +181,49 @@ func (p *mainConfigurationFileProvider) getErrorLogPath(
 	return "off"
 }
 
+func (p *mainConfigurationFileProvider) getErrorLogDestinations(
+	logs *settings.NginxLogsSettings,
+) string {
+	level := strings.ToLower(string(logs.ServerLogsLevel))
+	lines := make([]string, 0)
+
+	for _, destination := range nativeLogDestinations(logs, nil, false) {
+		lines = append(lines, fmt.Sprintf("error_log %s %s;", syslogTarget(&destination), level))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (p *mainConfigurationFileProvider) getUpstreamIncludes(
+	paths *Paths,
+	upstreams []upstream.Upstream,
+) string {
+	includes := make([]string, 0, len(upstreams))
+	for _, u := range upstreams {
+		includes = append(
+			includes,
+			fmt.Sprintf("include \"%supstream-%s.conf\";", paths.Config, u.ID),
+		)
+	}
+
+	return strings.Join(includes, "\n")
+}
+
+func (p *mainConfigurationFileProvider) getRateLimitIncludes(
+	paths *Paths,
+	rateLimits []ratelimit.RateLimit,
+) string {
+	includes := make([]string, 0, len(rateLimits))
+	for _, r := range rateLimits {
+		includes = append(
+			includes,
+			fmt.Sprintf("include \"%srate-limit-%s.conf\";", paths.Config, r.ID),
+		)
+	}
+
+	return strings.Join(includes, "\n")
+}
+
 func (p *mainConfigurationFileProvider) getHostIncludes(paths *Paths, hosts []host.Host) string {
 	includes := make([]string, 0, len(hosts))
 	for _, h := range hosts {
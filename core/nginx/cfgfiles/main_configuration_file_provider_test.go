@@ -10,8 +10,10 @@ import (
 	"dillmann.com.br/nginx-ignition/core/cache"
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
 	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
 	"dillmann.com.br/nginx-ignition/core/settings"
 	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/upstream"
 )
 
 func Test_mainConfigurationFileProvider(t *testing.T) {
@@ -96,6 +98,61 @@ func Test_mainConfigurationFileProvider(t *testing.T) {
 		})
 	})
 
+	t.Run("getErrorLogDestinations", func(t *testing.T) {
+		provider := &mainConfigurationFileProvider{
+			config: configuration.New(),
+		}
+
+		t.Run("returns empty string without destinations", func(t *testing.T) {
+			logs := &settings.NginxLogsSettings{}
+			assert.Empty(t, provider.getErrorLogDestinations(logs))
+		})
+
+		t.Run("returns only global native destinations with error logs", func(t *testing.T) {
+			hostID := uuid.New()
+			logs := &settings.NginxLogsSettings{
+				ServerLogsLevel: settings.ErrorLogLevel,
+				Destinations: []settings.LogDestination{
+					{
+						Type:      settings.SyslogLogDestinationType,
+						Protocol:  settings.UnixLogDestinationProtocol,
+						Address:   "/dev/log",
+						Facility:  "daemon",
+						ErrorLogs: true,
+					},
+					{
+						HostID:    &hostID,
+						Type:      settings.SyslogLogDestinationType,
+						Protocol:  settings.UDPLogDestinationProtocol,
+						Address:   "10.0.0.1",
+						Facility:  "local7",
+						ErrorLogs: true,
+					},
+					{
+						Type:      settings.SyslogLogDestinationType,
+						Protocol:  settings.TCPLogDestinationProtocol,
+						Address:   "10.0.0.2:601",
+						Facility:  "local7",
+						ErrorLogs: true,
+					},
+					{
+						Type:       settings.SyslogLogDestinationType,
+						Protocol:   settings.UDPLogDestinationProtocol,
+						Address:    "10.0.0.3",
+						Facility:   "local7",
+						AccessLogs: true,
+					},
+				},
+			}
+
+			assert.Equal(
+				t,
+				"error_log syslog:server=unix:/dev/log,facility=daemon error;",
+				provider.getErrorLogDestinations(logs),
+			)
+		})
+	})
+
 	t.Run("getHostIncludes", func(t *testing.T) {
 		provider := &mainConfigurationFileProvider{
 			config: configuration.New(),
@@ -145,6 +202,46 @@ func Test_mainConfigurationFileProvider(t *testing.T) {
 		})
 	})
 
+	t.Run("getUpstreamIncludes", func(t *testing.T) {
+		provider := &mainConfigurationFileProvider{
+			config: configuration.New(),
+		}
+		paths := &Paths{
+			Config: "/etc/nginx/",
+		}
+		id1 := uuid.New()
+
+		t.Run("returns include directives for upstreams", func(t *testing.T) {
+			upstreams := []upstream.Upstream{
+				{
+					ID: id1,
+				},
+			}
+			result := provider.getUpstreamIncludes(paths, upstreams)
+			assert.Equal(t, fmt.Sprintf("include \"/etc/nginx/upstream-%s.conf\";", id1), result)
+		})
+	})
+
+	t.Run("getRateLimitIncludes", func(t *testing.T) {
+		provider := &mainConfigurationFileProvider{
+			config: configuration.New(),
+		}
+		paths := &Paths{
+			Config: "/etc/nginx/",
+		}
+		id1 := uuid.New()
+
+		t.Run("returns include directives for rate limits", func(t *testing.T) {
+			rateLimits := []ratelimit.RateLimit{
+				{
+					ID: id1,
+				},
+			}
+			result := provider.getRateLimitIncludes(paths, rateLimits)
+			assert.Equal(t, fmt.Sprintf("include \"/etc/nginx/rate-limit-%s.conf\";", id1), result)
+		})
+	})
+
 	t.Run("getCacheDefinitions", func(t *testing.T) {
 		provider := &mainConfigurationFileProvider{
 			config: configuration.New(),
@@ -0,0 +1,130 @@
+package cfgfiles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+)
+
+// The claim is read from the payload without verifying the token signature, so anyone can pick the
+// value of the key. It only spreads the requests across buckets, it doesn't authenticate them.
+const rateLimitClaimExtractor = `function key(r) {
+    const authorization = r.headersIn.Authorization || "";
+    const match = authorization.match(/^Bearer\s+[^.\s]+\.([^.\s]+)\.\S*$/i);
+    if (!match) {
+        return "";
+    }
+
+    try {
+        const payload = JSON.parse(Buffer.from(match[1], "base64url").toString());
+        const value = payload[%q];
+        return value === undefined || value === null ? "" : String(value);
+    } catch (e) {
+        return "";
+    }
+}
+
+export default { key };
+`
+
+type rateLimitFileProvider struct{}
+
+func newRateLimitFileProvider() *rateLimitFileProvider {
+	return &rateLimitFileProvider{}
+}
+
+func (p *rateLimitFileProvider) provide(ctx *providerContext) ([]File, error) {
+	outputs := make([]File, 0)
+
+	for _, r := range ctx.rateLimits {
+		if r.KeyType == ratelimit.JWTClaimKeyType {
+			if ctx.supportedFeatures.RunCodeType == NoneSupportType {
+				return nil, coreerror.New(
+					i18n.M(ctx.context, i18n.K.CoreNginxCfgfilesRateLimitJwtNotEnabled),
+					false,
+				)
+			}
+
+			outputs = append(outputs, File{
+				Name:     fmt.Sprintf("rate-limit-%s.js", r.ID),
+				Contents: fmt.Sprintf(rateLimitClaimExtractor, *r.KeyName),
+			})
+		}
+
+		outputs = append(outputs, File{
+			Name:     fmt.Sprintf("rate-limit-%s.conf", r.ID),
+			Contents: p.buildZones(ctx.paths, &r),
+		})
+	}
+
+	return outputs, nil
+}
+
+func (p *rateLimitFileProvider) buildZones(paths *Paths, r *ratelimit.RateLimit) string {
+	builder := strings.Builder{}
+	idNoDashes := rateLimitIDNoDashes(r.ID)
+	key := "$binary_remote_addr"
+	value := ""
+
+	switch r.KeyType {
+	case ratelimit.HeaderKeyType:
+		value = "$http_" + strings.ReplaceAll(strings.ToLower(*r.KeyName), "-", "_")
+	case ratelimit.JWTClaimKeyType:
+		value = "$rate_limit_claim_" + idNoDashes
+		_, _ = fmt.Fprintf(
+			&builder,
+			"js_import rate_limit_%s from \"%srate-limit-%s.js\";\n",
+			idNoDashes,
+			paths.Config,
+			r.ID,
+		)
+		_, _ = fmt.Fprintf(&builder, "js_set %s rate_limit_%s.key;\n", value, idNoDashes)
+	}
+
+	// nginx doesn't count the requests with an empty key, so the ones without the header or the
+	// claim are limited by the client address instead of not being limited at all
+	if value != "" {
+		key = "$rate_limit_key_" + idNoDashes
+		_, _ = fmt.Fprintf(
+			&builder,
+			"map %s %s {\n\"\" $binary_remote_addr;\ndefault %s;\n}\n",
+			value,
+			key,
+			value,
+		)
+	}
+
+	unit := "s"
+	if r.RateUnit == ratelimit.PerMinuteRateUnit {
+		unit = "m"
+	}
+
+	_, _ = fmt.Fprintf(
+		&builder,
+		"limit_req_zone %s zone=rate_limit_%s:10m rate=%dr/%s;\n",
+		key,
+		idNoDashes,
+		r.Rate,
+		unit,
+	)
+
+	if r.ConnectionLimit.Enabled {
+		_, _ = fmt.Fprintf(
+			&builder,
+			"limit_conn_zone %s zone=conn_limit_%s:10m;\n",
+			key,
+			idNoDashes,
+		)
+	}
+
+	return builder.String()
+}
+
+func rateLimitIDNoDashes(id uuid.UUID) string {
+	return strings.ReplaceAll(id.String(), "-", "")
+}
@@ -0,0 +1,135 @@
+package cfgfiles
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+)
+
+func Test_rateLimitFileProvider(t *testing.T) {
+	t.Run("Provide", func(t *testing.T) {
+		t.Run("declares the zones keyed by the client address", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.ConnectionLimit = ratelimit.ConnectionLimit{
+				Enabled:            true,
+				MaximumConnections: new(5),
+			}
+			ctx := newProviderContext(t)
+			ctx.rateLimits = []ratelimit.RateLimit{rateLimit}
+			idNoDashes := strings.ReplaceAll(rateLimit.ID.String(), "-", "")
+
+			files, err := newRateLimitFileProvider().provide(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []File{
+				{
+					Name: fmt.Sprintf("rate-limit-%s.conf", rateLimit.ID),
+					Contents: fmt.Sprintf(
+						"limit_req_zone $binary_remote_addr zone=rate_limit_%s:10m rate=10r/s;\n"+
+							"limit_conn_zone $binary_remote_addr zone=conn_limit_%s:10m;\n",
+						idNoDashes,
+						idNoDashes,
+					),
+				},
+			}, files)
+		})
+
+		t.Run("keys the zone by the request header", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.KeyType = ratelimit.HeaderKeyType
+			rateLimit.KeyName = new("X-Api-Key")
+			rateLimit.RateUnit = ratelimit.PerMinuteRateUnit
+			ctx := newProviderContext(t)
+			ctx.rateLimits = []ratelimit.RateLimit{rateLimit}
+			idNoDashes := strings.ReplaceAll(rateLimit.ID.String(), "-", "")
+
+			files, err := newRateLimitFileProvider().provide(ctx)
+
+			assert.NoError(t, err)
+			assert.Len(t, files, 1)
+			assert.Contains(
+				t,
+				files[0].Contents,
+				fmt.Sprintf(
+					"map $http_x_api_key $rate_limit_key_%s {\n\"\" $binary_remote_addr;\n"+
+						"default $http_x_api_key;\n}",
+					idNoDashes,
+				),
+			)
+			assert.Contains(
+				t,
+				files[0].Contents,
+				fmt.Sprintf("limit_req_zone $rate_limit_key_%s zone=", idNoDashes),
+			)
+			assert.Contains(t, files[0].Contents, "rate=10r/m;")
+		})
+
+		t.Run("extracts the JWT claim using a script", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.KeyType = ratelimit.JWTClaimKeyType
+			rateLimit.KeyName = new("tenant_id")
+			ctx := newProviderContext(t)
+			ctx.rateLimits = []ratelimit.RateLimit{rateLimit}
+			idNoDashes := strings.ReplaceAll(rateLimit.ID.String(), "-", "")
+
+			files, err := newRateLimitFileProvider().provide(ctx)
+
+			assert.NoError(t, err)
+			assert.Len(t, files, 2)
+			assert.Equal(t, fmt.Sprintf("rate-limit-%s.js", rateLimit.ID), files[0].Name)
+			assert.Contains(t, files[0].Contents, `payload["tenant_id"]`)
+			assert.Contains(
+				t,
+				files[1].Contents,
+				fmt.Sprintf(
+					"js_import rate_limit_%s from \"/etc/nginx/rate-limit-%s.js\";",
+					idNoDashes,
+					rateLimit.ID,
+				),
+			)
+			assert.Contains(
+				t,
+				files[1].Contents,
+				fmt.Sprintf(
+					"js_set $rate_limit_claim_%s rate_limit_%s.key;",
+					idNoDashes,
+					idNoDashes,
+				),
+			)
+			assert.Contains(
+				t,
+				files[1].Contents,
+				fmt.Sprintf(
+					"map $rate_limit_claim_%s $rate_limit_key_%s {\n\"\" $binary_remote_addr;",
+					idNoDashes,
+					idNoDashes,
+				),
+			)
+			assert.Contains(
+				t,
+				files[1].Contents,
+				fmt.Sprintf("limit_req_zone $rate_limit_key_%s zone=", idNoDashes),
+			)
+		})
+
+		t.Run("returns error for JWT claims without code support", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.KeyType = ratelimit.JWTClaimKeyType
+			rateLimit.KeyName = new("sub")
+			ctx := newProviderContext(t)
+			ctx.supportedFeatures.RunCodeType = NoneSupportType
+			ctx.rateLimits = []ratelimit.RateLimit{rateLimit}
+
+			files, err := newRateLimitFileProvider().provide(ctx)
+
+			assert.Nil(t, files)
+			var coreErr *coreerror.CoreError
+			assert.ErrorAs(t, err, &coreErr)
+		})
+	})
+}
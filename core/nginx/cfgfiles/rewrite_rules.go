@@ -0,0 +1,94 @@
+package cfgfiles
+
+import (
+	"fmt"
+	"strings"
+
+	"dillmann.com.br/nginx-ignition/core/host"
+)
+
+var rewriteRuleFlagValues = map[host.RewriteRuleFlag]string{
+	host.LastRewriteRuleFlag:      "last",
+	host.BreakRewriteRuleFlag:     "break",
+	host.RedirectRewriteRuleFlag:  "redirect",
+	host.PermanentRewriteRuleFlag: "permanent",
+}
+
+func routeLocation(r *host.Route) string {
+	switch r.MatchType {
+	case host.ExactRouteMatchType:
+		return "= " + r.SourcePath
+	case host.RegexRouteMatchType:
+		return fmt.Sprintf(`~ "%s"`, escapeHeaderValue(r.SourcePath))
+	case host.CaseInsensitiveRegexRouteMatchType:
+		return fmt.Sprintf(`~* "%s"`, escapeHeaderValue(r.SourcePath))
+	default:
+		return r.SourcePath
+	}
+}
+
+func rewriteRules(r *host.Route) string {
+	builder := strings.Builder{}
+
+	for _, rule := range r.RewriteRules {
+		replacement := rule.Replacement
+		if !r.Settings.PreserveQueryString && !strings.HasSuffix(replacement, "?") {
+			replacement += "?"
+		}
+
+		_, _ = fmt.Fprintf(
+			&builder,
+			"\nrewrite \"%s\" \"%s\"",
+			escapeHeaderValue(rule.Pattern),
+			escapeHeaderValue(replacement),
+		)
+
+		if rule.Flag != nil {
+			_, _ = builder.WriteString(" " + rewriteRuleFlagValues[*rule.Flag])
+		}
+
+		_, _ = builder.WriteString(";")
+	}
+
+	return builder.String()
+}
+
+const redirectArgsSeparatorVariable = "$redirect_args_separator"
+
+func redirectTarget(r *host.Route) string {
+	target := *r.TargetURI
+	switch {
+	case !r.Settings.PreserveQueryString:
+		return target
+	case strings.HasSuffix(target, "?") || strings.HasSuffix(target, "&"):
+		return target + "$args"
+	case strings.Contains(target, "?"):
+		return target + redirectArgsSeparatorVariable + "$args"
+	default:
+		return target + "$is_args$args"
+	}
+}
+
+// redirectArgsSeparator works like $is_args for targets that already have a query string, adding
+// the "&" only when the request has arguments to append
+func redirectArgsSeparator(r *host.Route) string {
+	if !strings.Contains(redirectTarget(r), redirectArgsSeparatorVariable) {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		"set %s \"\";\nif ($args) { set %s \"&\"; }",
+		redirectArgsSeparatorVariable,
+		redirectArgsSeparatorVariable,
+	)
+}
+
+// regexLocationTarget removes the root path from the target since nginx doesn't allow a URI in the proxy_pass
+// of a location matched by a regular expression. Any other path is rejected when the route is validated.
+func regexLocationTarget(r *host.Route, target string) string {
+	if !r.MatchType.IsRegex() {
+		return target
+	}
+
+	return strings.TrimSuffix(target, "/")
+}
@@ -0,0 +1,115 @@
+package cfgfiles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dillmann.com.br/nginx-ignition/core/host"
+)
+
+func Test_routeLocation(t *testing.T) {
+	t.Run("keeps the prefix path as is", func(t *testing.T) {
+		r := &host.Route{SourcePath: "/api", MatchType: host.PrefixRouteMatchType}
+		assert.Equal(t, "/api", routeLocation(r))
+	})
+
+	t.Run("uses the exact match modifier", func(t *testing.T) {
+		r := &host.Route{SourcePath: "/health", MatchType: host.ExactRouteMatchType}
+		assert.Equal(t, "= /health", routeLocation(r))
+	})
+
+	t.Run("quotes the regular expressions", func(t *testing.T) {
+		r := &host.Route{
+			SourcePath: `^/files/(\d{2,4})\.json$`,
+			MatchType:  host.RegexRouteMatchType,
+		}
+		assert.Equal(t, `~ "^/files/(\\d{2,4})\\.json$"`, routeLocation(r))
+
+		r.MatchType = host.CaseInsensitiveRegexRouteMatchType
+		assert.Equal(t, `~* "^/files/(\\d{2,4})\\.json$"`, routeLocation(r))
+	})
+}
+
+func Test_rewriteRules(t *testing.T) {
+	t.Run("drops the original query string by default", func(t *testing.T) {
+		r := &host.Route{
+			RewriteRules: []host.RewriteRule{
+				{
+					Pattern:     "^/old/(.*)$",
+					Replacement: "/new/$1",
+					Flag:        new(host.LastRewriteRuleFlag),
+				},
+				{
+					Pattern:     "^/legacy$",
+					Replacement: "https://example.com/",
+					Flag:        new(host.PermanentRewriteRuleFlag),
+				},
+			},
+		}
+
+		result := rewriteRules(r)
+
+		assert.Contains(t, result, `rewrite "^/old/(.*)$" "/new/$1?" last;`)
+		assert.Contains(t, result, `rewrite "^/legacy$" "https://example.com/?" permanent;`)
+	})
+
+	t.Run("keeps the original query string when enabled", func(t *testing.T) {
+		r := &host.Route{
+			Settings: host.RouteSettings{PreserveQueryString: true},
+			RewriteRules: []host.RewriteRule{
+				{Pattern: "^/a$", Replacement: "/b"},
+			},
+		}
+
+		assert.Equal(t, "\nrewrite \"^/a$\" \"/b\";", rewriteRules(r))
+	})
+}
+
+func Test_redirectTarget(t *testing.T) {
+	t.Run("appends the query string when enabled", func(t *testing.T) {
+		r := &host.Route{
+			TargetURI: new("https://example.com/$1"),
+			Settings:  host.RouteSettings{PreserveQueryString: true},
+		}
+		assert.Equal(t, "https://example.com/$1$is_args$args", redirectTarget(r))
+
+		r.TargetURI = new("https://example.com/?source=legacy")
+		assert.Equal(
+			t,
+			"https://example.com/?source=legacy$redirect_args_separator$args",
+			redirectTarget(r),
+		)
+
+		r.TargetURI = new("https://example.com/?")
+		assert.Equal(t, "https://example.com/?$args", redirectTarget(r))
+	})
+
+	t.Run("keeps the target as is by default", func(t *testing.T) {
+		r := &host.Route{TargetURI: new("https://example.com")}
+		assert.Equal(t, "https://example.com", redirectTarget(r))
+	})
+}
+
+func Test_redirectArgsSeparator(t *testing.T) {
+	t.Run("adds the separator only when the request has arguments", func(t *testing.T) {
+		r := &host.Route{
+			TargetURI: new("https://example.com/?source=legacy"),
+			Settings:  host.RouteSettings{PreserveQueryString: true},
+		}
+		assert.Equal(
+			t,
+			"set $redirect_args_separator \"\";\n"+
+				"if ($args) { set $redirect_args_separator \"&\"; }",
+			redirectArgsSeparator(r),
+		)
+	})
+
+	t.Run("is not needed without a query string in the target", func(t *testing.T) {
+		r := &host.Route{
+			TargetURI: new("https://example.com/"),
+			Settings:  host.RouteSettings{PreserveQueryString: true},
+		}
+		assert.Empty(t, redirectArgsSeparator(r))
+	})
+}
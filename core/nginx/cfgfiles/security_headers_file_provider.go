@@ -0,0 +1,231 @@
+package cfgfiles
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+const (
+	securityHeadersReportsPath     = "/.well-known/nginx-ignition/csp-reports"
+	securityHeadersReportsGroup    = "nginx-ignition"
+	securityHeadersReportToGroup   = "csp-endpoint"
+	securityHeadersReportsBodySize = "64k"
+)
+
+var (
+	frameOptionsValues = map[securityheaders.FrameOptions]string{
+		securityheaders.DenyFrameOptions:       "DENY",
+		securityheaders.SameOriginFrameOptions: "SAMEORIGIN",
+	}
+	referrerPolicyValues = map[securityheaders.ReferrerPolicy]string{
+		securityheaders.NoReferrerPolicy:                  "no-referrer",
+		securityheaders.NoReferrerWhenDowngradePolicy:     "no-referrer-when-downgrade",
+		securityheaders.OriginPolicy:                      "origin",
+		securityheaders.OriginWhenCrossOriginPolicy:       "origin-when-cross-origin",
+		securityheaders.SameOriginPolicy:                  "same-origin",
+		securityheaders.StrictOriginPolicy:                "strict-origin",
+		securityheaders.StrictOriginWhenCrossOriginPolicy: "strict-origin-when-cross-origin",
+		securityheaders.UnsafeURLPolicy:                   "unsafe-url",
+	}
+)
+
+type securityHeadersFileProvider struct {
+	config *configuration.Configuration
+}
+
+func newSecurityHeadersFileProvider(
+	config *configuration.Configuration,
+) *securityHeadersFileProvider {
+	return &securityHeadersFileProvider{
+		config: config,
+	}
+}
+
+func (p *securityHeadersFileProvider) provide(ctx *providerContext) ([]File, error) {
+	outputs := make([]File, 0)
+
+	for _, h := range ctx.securityHeaders {
+		outputs = append(outputs, File{
+			Name:     securityHeadersFileName(h.ID),
+			Contents: p.buildHeaders(&h),
+		})
+
+		if collectsSecurityHeadersReports(&h) {
+			reportsLocation, err := p.buildReportsLocation(h.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			outputs = append(outputs, File{
+				Name:     securityHeadersReportsFileName(h.ID),
+				Contents: reportsLocation,
+			})
+		}
+	}
+
+	return outputs, nil
+}
+
+func (p *securityHeadersFileProvider) buildHeaders(h *securityheaders.SecurityHeaders) string {
+	builder := strings.Builder{}
+
+	if h.FrameOptions != nil {
+		appendSecurityHeader(&builder, "X-Frame-Options", frameOptionsValues[*h.FrameOptions])
+	}
+
+	if h.ContentTypeNoSniff {
+		appendSecurityHeader(&builder, "X-Content-Type-Options", "nosniff")
+	}
+
+	if h.ReferrerPolicy != nil {
+		appendSecurityHeader(&builder, "Referrer-Policy", referrerPolicyValues[*h.ReferrerPolicy])
+	}
+
+	if h.PermissionsPolicy != nil && strings.TrimSpace(*h.PermissionsPolicy) != "" {
+		appendSecurityHeader(
+			&builder,
+			"Permissions-Policy",
+			strings.TrimSpace(*h.PermissionsPolicy),
+		)
+	}
+
+	csp := &h.ContentSecurityPolicy
+	if !csp.Enabled {
+		return builder.String()
+	}
+
+	if endpoints := p.buildReportingEndpoints(csp); endpoints != "" {
+		appendSecurityHeader(&builder, "Reporting-Endpoints", endpoints)
+	}
+
+	headerName := "Content-Security-Policy"
+	if csp.ReportOnly {
+		headerName = "Content-Security-Policy-Report-Only"
+	}
+
+	appendSecurityHeader(&builder, headerName, p.buildContentSecurityPolicy(csp))
+	return builder.String()
+}
+
+func (p *securityHeadersFileProvider) buildContentSecurityPolicy(
+	csp *securityheaders.ContentSecurityPolicy,
+) string {
+	parts := make([]string, 0, len(csp.Directives)+2)
+	for _, directive := range csp.Directives {
+		name := strings.ToLower(strings.TrimSpace(directive.Name))
+		parts = append(parts, strings.TrimSpace(name+" "+strings.Join(directive.Sources, " ")))
+	}
+
+	reportURIs := make([]string, 0, 2)
+	if csp.CollectReports {
+		reportURIs = append(reportURIs, securityHeadersReportsPath)
+	}
+
+	if csp.ReportURI != nil {
+		reportURIs = append(reportURIs, *csp.ReportURI)
+	}
+
+	if len(reportURIs) > 0 {
+		parts = append(parts, "report-uri "+strings.Join(reportURIs, " "))
+	}
+
+	switch {
+	case csp.ReportTo != nil:
+		parts = append(parts, "report-to "+securityHeadersReportToGroup)
+	case csp.CollectReports:
+		parts = append(parts, "report-to "+securityHeadersReportsGroup)
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+func (p *securityHeadersFileProvider) buildReportingEndpoints(
+	csp *securityheaders.ContentSecurityPolicy,
+) string {
+	switch {
+	case csp.ReportTo != nil:
+		return fmt.Sprintf(`%s="%s"`, securityHeadersReportToGroup, *csp.ReportTo)
+	case csp.CollectReports:
+		return fmt.Sprintf(`%s="%s"`, securityHeadersReportsGroup, securityHeadersReportsPath)
+	default:
+		return ""
+	}
+}
+
+func (p *securityHeadersFileProvider) buildReportsLocation(id uuid.UUID) (string, error) {
+	port, err := p.config.Get("nginx-ignition.server.port")
+	if err != nil {
+		return "", err
+	}
+
+	address, err := p.config.Get("nginx-ignition.server.address")
+	if err != nil {
+		return "", err
+	}
+
+	if address == "" || address == "0.0.0.0" || address == "::" {
+		address = "127.0.0.1"
+	}
+
+	return fmt.Sprintf(
+		`
+location = %s {
+	limit_except POST { deny all; }
+	client_max_body_size %s;
+	auth_basic off;
+	allow all;
+	proxy_pass http://%s/api/security-headers/%s/reports;
+}
+`,
+		securityHeadersReportsPath,
+		securityHeadersReportsBodySize,
+		net.JoinHostPort(address, port),
+		id,
+	), nil
+}
+
+func appendSecurityHeader(builder *strings.Builder, name, value string) {
+	_, _ = fmt.Fprintf(
+		builder,
+		"add_header \"%s\" \"%s\" always;\n",
+		name,
+		escapeHeaderValue(value),
+	)
+}
+
+func securityHeadersFileName(id uuid.UUID) string {
+	return fmt.Sprintf("security-headers-%s.conf", id)
+}
+
+// The reports location is kept apart from the headers, since the headers are included in every
+// location of the hosts and nginx doesn't allow a location nested in an unrelated one
+func securityHeadersReportsFileName(id uuid.UUID) string {
+	return fmt.Sprintf("security-headers-%s-reports.conf", id)
+}
+
+func collectsSecurityHeadersReports(h *securityheaders.SecurityHeaders) bool {
+	return h.ContentSecurityPolicy.Enabled && h.ContentSecurityPolicy.CollectReports
+}
+
+func findSecurityHeaders(
+	items []securityheaders.SecurityHeaders,
+	id *uuid.UUID,
+) *securityheaders.SecurityHeaders {
+	if id == nil {
+		return nil
+	}
+
+	for index := range items {
+		if items[index].ID == *id {
+			return &items[index]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package cfgfiles
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+func Test_securityHeadersFileProvider(t *testing.T) {
+	t.Run("Provide", func(t *testing.T) {
+		t.Run("renders the enabled headers", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.PermissionsPolicy = new("camera=(), geolocation=(self)")
+			ctx := newProviderContext(t)
+			ctx.securityHeaders = []securityheaders.SecurityHeaders{securityHeaders}
+
+			files, err := newSecurityHeadersFileProvider(configuration.New()).provide(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []File{
+				{
+					Name: fmt.Sprintf("security-headers-%s.conf", securityHeaders.ID),
+					Contents: "add_header \"X-Frame-Options\" \"DENY\" always;\n" +
+						"add_header \"X-Content-Type-Options\" \"nosniff\" always;\n" +
+						"add_header \"Referrer-Policy\" \"strict-origin-when-cross-origin\" always;\n" +
+						"add_header \"Permissions-Policy\" \"camera=(), geolocation=(self)\" always;\n" +
+						"add_header \"Content-Security-Policy\" " +
+						"\"default-src 'self'; img-src 'self' data:; upgrade-insecure-requests\" always;\n",
+				},
+			}, files)
+		})
+
+		t.Run("uses the report only header with the external destinations", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.ContentSecurityPolicy.ReportOnly = true
+			securityHeaders.ContentSecurityPolicy.ReportURI = new("https://reports.example.com/csp")
+			securityHeaders.ContentSecurityPolicy.ReportTo = new("https://reports.example.com/v2")
+			ctx := newProviderContext(t)
+			ctx.securityHeaders = []securityheaders.SecurityHeaders{securityHeaders}
+
+			files, err := newSecurityHeadersFileProvider(configuration.New()).provide(ctx)
+
+			assert.NoError(t, err)
+			assert.Len(t, files, 1)
+			assert.Contains(
+				t,
+				files[0].Contents,
+				`add_header "Reporting-Endpoints" "csp-endpoint=\"https://reports.example.com/v2\"" always;`,
+			)
+			assert.Contains(
+				t,
+				files[0].Contents,
+				"add_header \"Content-Security-Policy-Report-Only\" \"default-src 'self'; img-src 'self' data:; "+
+					"upgrade-insecure-requests; report-uri https://reports.example.com/csp; "+
+					"report-to csp-endpoint\" always;",
+			)
+		})
+
+		t.Run("forwards the collected reports to the application", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.ContentSecurityPolicy.CollectReports = true
+			ctx := newProviderContext(t)
+			ctx.securityHeaders = []securityheaders.SecurityHeaders{securityHeaders}
+			config := configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.server.address": "0.0.0.0",
+				"nginx-ignition.server.port":    "8090",
+			})
+
+			files, err := newSecurityHeadersFileProvider(config).provide(ctx)
+
+			assert.NoError(t, err)
+			assert.Len(t, files, 2)
+			assert.Contains(
+				t,
+				files[0].Contents,
+				"report-uri /.well-known/nginx-ignition/csp-reports; report-to nginx-ignition\" always;",
+			)
+			assert.NotContains(t, files[0].Contents, "location")
+			assert.Equal(
+				t,
+				fmt.Sprintf("security-headers-%s-reports.conf", securityHeaders.ID),
+				files[1].Name,
+			)
+			assert.Contains(
+				t,
+				files[1].Contents,
+				"location = /.well-known/nginx-ignition/csp-reports {",
+			)
+			assert.Contains(
+				t,
+				files[1].Contents,
+				fmt.Sprintf(
+					"proxy_pass http://127.0.0.1:8090/api/security-headers/%s/reports;",
+					securityHeaders.ID,
+				),
+			)
+		})
+
+		t.Run("omits the policy when disabled", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.ContentSecurityPolicy.Enabled = false
+			securityHeaders.ContentSecurityPolicy.CollectReports = true
+			ctx := newProviderContext(t)
+			ctx.securityHeaders = []securityheaders.SecurityHeaders{securityHeaders}
+
+			files, err := newSecurityHeadersFileProvider(configuration.New()).provide(ctx)
+
+			assert.NoError(t, err)
+			assert.Len(t, files, 1)
+			assert.NotContains(t, files[0].Contents, "Content-Security-Policy")
+			assert.NotContains(t, files[0].Contents, "location")
+		})
+	})
+}
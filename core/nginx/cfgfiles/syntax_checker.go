@@ -0,0 +1,192 @@
+package cfgfiles
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/stream"
+)
+
+var (
+	syntaxProblemRegex = regexp.MustCompile(
+		`\[(?:emerg|alert|crit|error)] (.+?)(?: in (.+):(\d+))?$`,
+	)
+	hostFileNameRegex   = regexp.MustCompile(`^host-([0-9a-f-]{36})[.-]`)
+	streamFileNameRegex = regexp.MustCompile(`^stream-([0-9a-f-]{36})\.conf$`)
+	staticPayloadRegex  = regexp.MustCompile(`^@route_(\d+)/static_payload$`)
+)
+
+type syntaxChecker struct {
+	configuration *configuration.Configuration
+}
+
+type syntaxCheckInput struct {
+	paths   *Paths
+	files   []File
+	hosts   []host.Host
+	streams []stream.Stream
+}
+
+func newSyntaxChecker(cfg *configuration.Configuration) *syntaxChecker {
+	return &syntaxChecker{
+		configuration: cfg,
+	}
+}
+
+func (c *syntaxChecker) check(ctx context.Context, input *syntaxCheckInput) error {
+	binaryPath, err := c.configuration.Get("nginx-ignition.nginx.binary-path")
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(
+		ctx,
+		binaryPath,
+		"-t",
+		"-q",
+		"-e", filepath.Join(input.paths.Logs, "main.log"),
+		"-c", filepath.Join(input.paths.Config, "nginx.conf"),
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+
+	rawOutput := strings.TrimSpace(string(output))
+	if rawOutput == "" {
+		rawOutput = err.Error()
+	}
+
+	return &SyntaxError{
+		Message:  i18n.M(ctx, i18n.K.CoreNginxCfgfilesSyntaxCheckFailed),
+		Output:   rawOutput,
+		Problems: c.parseProblems(rawOutput, input),
+	}
+}
+
+func (c *syntaxChecker) parseProblems(output string, input *syntaxCheckInput) []SyntaxProblem {
+	problems := make([]SyntaxProblem, 0)
+
+	for line := range strings.SplitSeq(output, "\n") {
+		matches := syntaxProblemRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if matches == nil {
+			continue
+		}
+
+		problem := SyntaxProblem{
+			Message: matches[1],
+		}
+
+		if matches[2] != "" {
+			problem.FileName = filepath.Base(matches[2])
+			problem.LineNumber, _ = strconv.Atoi(matches[3])
+			c.resolveProblemOrigin(&problem, input)
+		}
+
+		problems = append(problems, problem)
+	}
+
+	return problems
+}
+
+func (c *syntaxChecker) resolveProblemOrigin(problem *SyntaxProblem, input *syntaxCheckInput) {
+	if matches := streamFileNameRegex.FindStringSubmatch(problem.FileName); matches != nil {
+		for _, s := range input.streams {
+			if s.ID.String() == matches[1] {
+				problem.StreamID = &s.ID
+				return
+			}
+		}
+
+		return
+	}
+
+	matches := hostFileNameRegex.FindStringSubmatch(problem.FileName)
+	if matches == nil {
+		return
+	}
+
+	for _, h := range input.hosts {
+		if h.ID.String() != matches[1] {
+			continue
+		}
+
+		problem.HostID = &h.ID
+		problem.RouteID = c.resolveRouteID(&h, input.files, problem)
+		return
+	}
+}
+
+func (c *syntaxChecker) resolveRouteID(
+	h *host.Host,
+	files []File,
+	problem *SyntaxProblem,
+) *uuid.UUID {
+	var contents string
+	for _, file := range files {
+		if file.Name == problem.FileName {
+			contents = file.FormattedContents()
+			break
+		}
+	}
+
+	location := c.findEnclosingLocation(contents, problem.LineNumber)
+	if location == "" {
+		return nil
+	}
+
+	if matches := staticPayloadRegex.FindStringSubmatch(location); matches != nil {
+		priority, _ := strconv.Atoi(matches[1])
+		for _, r := range h.Routes {
+			if r.Priority == priority {
+				return &r.ID
+			}
+		}
+
+		return nil
+	}
+
+	for _, r := range h.Routes {
+		if r.Enabled && (routeLocation(&r) == location || r.SourcePath+"/" == location) {
+			return &r.ID
+		}
+	}
+
+	return nil
+}
+
+func (c *syntaxChecker) findEnclosingLocation(contents string, lineNumber int) string {
+	blocks := make([]string, 0)
+	lines := strings.Split(contents, "\n")
+
+	for index := 0; index < lineNumber && index < len(lines); index++ {
+		trimmed := strings.TrimSpace(lines[index])
+
+		switch {
+		case strings.HasSuffix(trimmed, "{"):
+			blocks = append(blocks, strings.TrimSpace(strings.TrimSuffix(trimmed, "{")))
+		case strings.HasSuffix(trimmed, "}") && !strings.Contains(trimmed, "{"):
+			if len(blocks) > 0 && index < lineNumber-1 {
+				blocks = blocks[:len(blocks)-1]
+			}
+		}
+	}
+
+	for index := len(blocks) - 1; index >= 0; index-- {
+		if location, found := strings.CutPrefix(blocks[index], "location "); found {
+			return strings.TrimSpace(location)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,132 @@
+package cfgfiles
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/stream"
+)
+
+func Test_syntaxChecker(t *testing.T) {
+	t.Run("check", func(t *testing.T) {
+		newChecker := func(t *testing.T, script string) *syntaxChecker {
+			fakeNginx := filepath.Join(t.TempDir(), "nginx_fake")
+			require.NoError(t, os.WriteFile(fakeNginx, []byte(script), 0o755))
+
+			return newSyntaxChecker(configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.nginx.binary-path": fakeNginx,
+			}))
+		}
+
+		t.Run("returns nil when the test passes", func(t *testing.T) {
+			checker := newChecker(t, "#!/bin/sh\nexit 0")
+			err := checker.check(t.Context(), &syntaxCheckInput{paths: newPaths()})
+			assert.NoError(t, err)
+		})
+
+		t.Run("returns a syntax error with the raw output when the test fails", func(t *testing.T) {
+			checker := newChecker(t, "#!/bin/sh\necho 'nginx: [emerg] broken'\nexit 1")
+			err := checker.check(t.Context(), &syntaxCheckInput{paths: newPaths()})
+
+			var syntaxErr *SyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			assert.Equal(t, "nginx: [emerg] broken", syntaxErr.Output)
+			require.Len(t, syntaxErr.Problems, 1)
+			assert.Equal(t, "broken", syntaxErr.Problems[0].Message)
+			assert.Empty(t, syntaxErr.Problems[0].FileName)
+		})
+	})
+
+	t.Run("parseProblems", func(t *testing.T) {
+		checker := &syntaxChecker{}
+
+		t.Run("points the problem to the host route", func(t *testing.T) {
+			routeID := uuid.New()
+			h := host.Host{
+				ID: uuid.New(),
+				Routes: []host.Route{
+					{ID: uuid.New(), SourcePath: "/", Enabled: true},
+					{ID: routeID, SourcePath: "/api", Enabled: true},
+				},
+			}
+
+			fileName := fmt.Sprintf("host-%s.conf", h.ID)
+			input := &syntaxCheckInput{
+				hosts: []host.Host{h},
+				files: []File{
+					{
+						Name: fileName,
+						Contents: "server {\n" +
+							"location / {\nproxy_pass http://a;\n}\n" +
+							"location /api {\nproxy_pass http://b;\nfoo bar;\n}\n" +
+							"}",
+					},
+				},
+			}
+			output := fmt.Sprintf(
+				"nginx: [emerg] unknown directive \"foo\" in /etc/nginx/%s:7\n"+
+					"nginx: configuration file /etc/nginx/nginx.conf test failed",
+				fileName,
+			)
+
+			problems := checker.parseProblems(output, input)
+
+			require.Len(t, problems, 1)
+			assert.Equal(t, `unknown directive "foo"`, problems[0].Message)
+			assert.Equal(t, fileName, problems[0].FileName)
+			assert.Equal(t, 7, problems[0].LineNumber)
+			assert.Equal(t, &h.ID, problems[0].HostID)
+			assert.Equal(t, &routeID, problems[0].RouteID)
+			assert.Nil(t, problems[0].StreamID)
+		})
+
+		t.Run("points the problem to the host when outside of a route", func(t *testing.T) {
+			h := host.Host{ID: uuid.New()}
+			fileName := fmt.Sprintf("host-%s.conf", h.ID)
+			input := &syntaxCheckInput{
+				hosts: []host.Host{h},
+				files: []File{{Name: fileName, Contents: "server {\nfoo;\n}"}},
+			}
+
+			problems := checker.parseProblems(
+				fmt.Sprintf("nginx: [emerg] invalid in /etc/nginx/%s:2", fileName),
+				input,
+			)
+
+			require.Len(t, problems, 1)
+			assert.Equal(t, &h.ID, problems[0].HostID)
+			assert.Nil(t, problems[0].RouteID)
+		})
+
+		t.Run("points the problem to the stream", func(t *testing.T) {
+			s := stream.Stream{ID: uuid.New()}
+			input := &syntaxCheckInput{streams: []stream.Stream{s}}
+
+			problems := checker.parseProblems(
+				fmt.Sprintf("nginx: [emerg] invalid in /etc/nginx/stream-%s.conf:3", s.ID),
+				input,
+			)
+
+			require.Len(t, problems, 1)
+			assert.Equal(t, &s.ID, problems[0].StreamID)
+			assert.Nil(t, problems[0].HostID)
+		})
+
+		t.Run("ignores warnings and informational lines", func(t *testing.T) {
+			problems := checker.parseProblems(
+				"nginx: [warn] something\nnginx: the configuration file syntax is ok",
+				&syntaxCheckInput{},
+			)
+
+			assert.Empty(t, problems)
+		})
+	})
+}
@@ -0,0 +1,26 @@
+package cfgfiles
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+)
+
+type SyntaxError struct {
+	Message  *i18n.Message
+	Output   string
+	Problems []SyntaxProblem
+}
+
+type SyntaxProblem struct {
+	HostID     *uuid.UUID
+	RouteID    *uuid.UUID
+	StreamID   *uuid.UUID
+	FileName   string
+	Message    string
+	LineNumber int
+}
+
+func (e SyntaxError) Error() string {
+	return e.Message.String()
+}
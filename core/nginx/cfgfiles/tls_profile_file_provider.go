@@ -0,0 +1,35 @@
+package cfgfiles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type tlsProfileFileProvider struct{}
+
+func newTLSProfileFileProvider() *tlsProfileFileProvider {
+	return &tlsProfileFileProvider{}
+}
+
+func (p *tlsProfileFileProvider) provide(ctx *providerContext) ([]File, error) {
+	outputs := make([]File, 0)
+	for _, profile := range ctx.tlsProfiles {
+		verification := profile.ClientVerification
+		if !verification.Enabled || verification.CertificateAuthorities == nil {
+			continue
+		}
+
+		outputs = append(outputs, File{
+			Name:     tlsProfileCertificateAuthoritiesFileName(profile.ID),
+			Contents: strings.TrimSpace(*verification.CertificateAuthorities) + "\n",
+		})
+	}
+
+	return outputs, nil
+}
+
+func tlsProfileCertificateAuthoritiesFileName(id uuid.UUID) string {
+	return fmt.Sprintf("tls-profile-%s-ca.pem", id)
+}
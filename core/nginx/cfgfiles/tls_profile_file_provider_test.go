@@ -0,0 +1,46 @@
+package cfgfiles
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+)
+
+func Test_tlsProfileFileProvider(t *testing.T) {
+	t.Run("Provide", func(t *testing.T) {
+		t.Run("writes the certificate authorities of the verifying profiles", func(t *testing.T) {
+			verifying := newTLSProfile()
+			verifying.ClientVerification = tlsprofile.ClientVerification{
+				Enabled:                true,
+				Mode:                   tlsprofile.RequiredClientVerificationMode,
+				Depth:                  1,
+				CertificateAuthorities: new("  -----BEGIN CERTIFICATE-----\n  "),
+			}
+			ctx := newProviderContext(t)
+			ctx.tlsProfiles = []tlsprofile.TLSProfile{newTLSProfile(), verifying}
+
+			files, err := newTLSProfileFileProvider().provide(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []File{
+				{
+					Name:     fmt.Sprintf("tls-profile-%s-ca.pem", verifying.ID),
+					Contents: "-----BEGIN CERTIFICATE-----\n",
+				},
+			}, files)
+		})
+
+		t.Run("returns no files without verifying profiles", func(t *testing.T) {
+			ctx := newProviderContext(t)
+			ctx.tlsProfiles = []tlsprofile.TLSProfile{newTLSProfile()}
+
+			files, err := newTLSProfileFileProvider().provide(ctx)
+
+			assert.NoError(t, err)
+			assert.Empty(t, files)
+		})
+	})
+}
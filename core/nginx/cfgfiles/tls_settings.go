@@ -0,0 +1,237 @@
+package cfgfiles
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/binding"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+)
+
+const (
+	hstsVariableName   = "$ignition_hsts"
+	hstsLocationHeader = "add_header Strict-Transport-Security " + hstsVariableName + " always;"
+)
+
+const defaultTLSSettings = `
+	ssl_protocols TLSv1.2 TLSv1.3;
+	ssl_ciphers HIGH:!aNULL:!MD5;
+`
+
+const clientCertificateHeaders = `
+	proxy_set_header x-ssl-client-verify $ssl_client_verify;
+	proxy_set_header x-ssl-client-subject $ssl_client_s_dn;
+	proxy_set_header x-ssl-client-issuer $ssl_client_i_dn;
+	proxy_set_header x-ssl-client-serial $ssl_client_serial;
+	proxy_set_header x-ssl-client-fingerprint $ssl_client_fingerprint;
+`
+
+func findTLSProfile(profiles []tlsprofile.TLSProfile, id *uuid.UUID) *tlsprofile.TLSProfile {
+	if id == nil {
+		return nil
+	}
+
+	for index := range profiles {
+		if profiles[index].ID == *id {
+			return &profiles[index]
+		}
+	}
+
+	return nil
+}
+
+func tlsSettings(ctx *providerContext, b *binding.Binding) string {
+	profile := findTLSProfile(ctx.tlsProfiles, b.TLSProfileID)
+	if profile == nil {
+		return defaultTLSSettings
+	}
+
+	builder := strings.Builder{}
+
+	protocols := make([]string, 0, len(profile.EffectiveProtocols()))
+	for _, protocol := range profile.EffectiveProtocols() {
+		protocols = append(protocols, string(protocol))
+	}
+
+	_, _ = fmt.Fprintf(&builder, "ssl_protocols %s;\n", strings.Join(protocols, " "))
+
+	if ciphers := profile.EffectiveCiphers(); ciphers != nil && strings.TrimSpace(*ciphers) != "" {
+		_, _ = fmt.Fprintf(&builder, "ssl_ciphers %s;\n", strings.TrimSpace(*ciphers))
+	}
+
+	_, _ = fmt.Fprintf(
+		&builder,
+		"ssl_prefer_server_ciphers %s;\n",
+		statusFlag(profile.EffectivePreferServerCiphers()),
+	)
+
+	appendTLSSessionSettings(&builder, profile)
+
+	appendOCSPStapling(&builder, ctx, profile, b.CertificateID)
+
+	appendHSTSHeader(&builder, profile.HSTS)
+	appendClientVerification(&builder, ctx.paths, profile)
+
+	return builder.String()
+}
+
+func appendTLSSessionSettings(builder *strings.Builder, profile *tlsprofile.TLSProfile) {
+	session := profile.Session
+	if session.CacheEnabled {
+		_, _ = fmt.Fprintf(
+			builder,
+			"ssl_session_cache shared:tls_%s:%dm;\n",
+			strings.ReplaceAll(profile.ID.String(), "-", ""),
+			session.CacheSizeMB,
+		)
+	} else {
+		_, _ = builder.WriteString("ssl_session_cache off;\n")
+	}
+
+	_, _ = fmt.Fprintf(builder, "ssl_session_timeout %dm;\n", session.TimeoutMinutes)
+	_, _ = fmt.Fprintf(builder, "ssl_session_tickets %s;\n", statusFlag(session.TicketsEnabled))
+}
+
+// nginx looks up the OCSP responder of the certificate by itself and checks its response against
+// the certificate chain. Without a resolver the stapling never happens, so it's left out when no
+// resolver is available.
+func appendOCSPStapling(
+	builder *strings.Builder,
+	ctx *providerContext,
+	profile *tlsprofile.TLSProfile,
+	certificateID *uuid.UUID,
+) {
+	if !profile.OCSPStapling || certificateID == nil || len(ctx.ocspResolvers) == 0 {
+		return
+	}
+
+	_, _ = builder.WriteString("ssl_stapling on;\n")
+	_, _ = builder.WriteString("ssl_stapling_verify on;\n")
+	_, _ = fmt.Fprintf(
+		builder,
+		"ssl_trusted_certificate \"%s%s\";\n",
+		ctx.paths.Config,
+		certificateChainFileName(*certificateID),
+	)
+	_, _ = fmt.Fprintf(builder, "resolver %s valid=300s;\n", strings.Join(ctx.ocspResolvers, " "))
+}
+
+func ocspStaplingEnabled(ctx *providerContext, b *binding.Binding) bool {
+	if b.Type != binding.HTTPSBindingType || b.CertificateID == nil {
+		return false
+	}
+
+	profile := findTLSProfile(ctx.tlsProfiles, b.TLSProfileID)
+	return profile != nil && profile.OCSPStapling
+}
+
+// Reads the nameservers of a resolv.conf file, with the IPv6 ones in brackets as nginx expects them
+func parseSystemResolvers(contents string) []string {
+	output := make([]string, 0)
+	for _, line := range strings.Split(contents, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "nameserver" {
+			continue
+		}
+
+		address := strings.Split(fields[1], "%")[0]
+		if strings.Contains(address, ":") {
+			address = "[" + address + "]"
+		}
+
+		output = append(output, address)
+	}
+
+	return output
+}
+
+func appendHSTSHeader(builder *strings.Builder, hsts tlsprofile.HSTS) {
+	if value := hstsHeaderValue(hsts); value != "" {
+		_, _ = fmt.Fprintf(builder, "add_header Strict-Transport-Security \"%s\" always;\n", value)
+	}
+}
+
+func hstsHeaderValue(hsts tlsprofile.HSTS) string {
+	if !hsts.Enabled {
+		return ""
+	}
+
+	value := fmt.Sprintf("max-age=%d", hsts.MaxAgeSeconds)
+	if hsts.IncludeSubdomains {
+		value += "; includeSubDomains"
+	}
+
+	if hsts.Preload {
+		value += "; preload"
+	}
+
+	return value
+}
+
+// The locations with their own add_header directives don't inherit the ones from the server, so
+// they send the header again using a variable that is empty (and skipped by nginx) in the servers
+// without HSTS
+func hstsEnabled(ctx *providerContext, bindings []binding.Binding) bool {
+	for _, b := range bindings {
+		if b.Type != binding.HTTPSBindingType {
+			continue
+		}
+
+		profile := findTLSProfile(ctx.tlsProfiles, b.TLSProfileID)
+		if profile != nil && profile.HSTS.Enabled {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hstsVariable(ctx *providerContext, b *binding.Binding) string {
+	value := ""
+	if b.Type == binding.HTTPSBindingType {
+		if profile := findTLSProfile(ctx.tlsProfiles, b.TLSProfileID); profile != nil {
+			value = hstsHeaderValue(profile.HSTS)
+		}
+	}
+
+	return fmt.Sprintf("set %s \"%s\";", hstsVariableName, value)
+}
+
+func appendClientVerification(
+	builder *strings.Builder,
+	paths *Paths,
+	profile *tlsprofile.TLSProfile,
+) {
+	verification := profile.ClientVerification
+	if !verification.Enabled {
+		return
+	}
+
+	mode := "on"
+	if verification.Mode == tlsprofile.OptionalClientVerificationMode {
+		mode = "optional"
+	}
+
+	_, _ = fmt.Fprintf(
+		builder,
+		"ssl_client_certificate \"%s%s\";\n",
+		paths.Config,
+		tlsProfileCertificateAuthoritiesFileName(profile.ID),
+	)
+	_, _ = fmt.Fprintf(builder, "ssl_verify_client %s;\n", mode)
+	_, _ = fmt.Fprintf(builder, "ssl_verify_depth %d;\n", verification.Depth)
+}
+
+func clientCertificateHeadersEnabled(ctx *providerContext, bindings []binding.Binding) bool {
+	return slices.ContainsFunc(bindings, func(b binding.Binding) bool {
+		if b.Type != binding.HTTPSBindingType {
+			return false
+		}
+
+		profile := findTLSProfile(ctx.tlsProfiles, b.TLSProfileID)
+		return profile != nil && profile.ClientVerification.Enabled
+	})
+}
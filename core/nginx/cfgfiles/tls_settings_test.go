@@ -0,0 +1,208 @@
+package cfgfiles
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"dillmann.com.br/nginx-ignition/core/binding"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+)
+
+func Test_tlsSettings(t *testing.T) {
+	t.Run("keeps the default settings when the binding has no profile", func(t *testing.T) {
+		ctx := newProviderContext(t)
+
+		result := tlsSettings(ctx, &binding.Binding{})
+
+		assert.Contains(t, result, "ssl_protocols TLSv1.2 TLSv1.3;")
+		assert.Contains(t, result, "ssl_ciphers HIGH:!aNULL:!MD5;")
+	})
+
+	t.Run("keeps the default settings when the profile is not available", func(t *testing.T) {
+		ctx := newProviderContext(t)
+
+		result := tlsSettings(ctx, &binding.Binding{TLSProfileID: new(uuid.New())})
+
+		assert.Equal(t, defaultTLSSettings, result)
+	})
+
+	t.Run("renders the preset protocols, ciphers and session settings", func(t *testing.T) {
+		profile := newTLSProfile()
+		ctx := newProviderContext(t)
+		ctx.tlsProfiles = []tlsprofile.TLSProfile{profile}
+
+		result := tlsSettings(ctx, &binding.Binding{TLSProfileID: &profile.ID})
+
+		assert.Contains(t, result, "ssl_protocols TLSv1.2 TLSv1.3;")
+		assert.Contains(t, result, "ssl_ciphers ECDHE-ECDSA-AES128-GCM-SHA256:")
+		assert.Contains(t, result, "ssl_prefer_server_ciphers off;")
+		assert.Contains(
+			t,
+			result,
+			fmt.Sprintf(
+				"ssl_session_cache shared:tls_%s:10m;",
+				strings.ReplaceAll(profile.ID.String(), "-", ""),
+			),
+		)
+		assert.Contains(t, result, "ssl_session_timeout 60m;")
+		assert.Contains(t, result, "ssl_session_tickets off;")
+		assert.NotContains(t, result, "ssl_stapling")
+		assert.NotContains(t, result, "Strict-Transport-Security")
+		assert.NotContains(t, result, "ssl_verify_client")
+	})
+
+	t.Run("omits the cipher list for the modern preset", func(t *testing.T) {
+		profile := newTLSProfile()
+		profile.Preset = tlsprofile.ModernPreset
+		profile.Session.CacheEnabled = false
+		ctx := newProviderContext(t)
+		ctx.tlsProfiles = []tlsprofile.TLSProfile{profile}
+
+		result := tlsSettings(ctx, &binding.Binding{TLSProfileID: &profile.ID})
+
+		assert.Contains(t, result, "ssl_protocols TLSv1.3;")
+		assert.NotContains(t, result, "ssl_ciphers")
+		assert.Contains(t, result, "ssl_session_cache off;")
+	})
+
+	t.Run("renders the custom protocols and ciphers", func(t *testing.T) {
+		profile := newTLSProfile()
+		profile.Preset = tlsprofile.CustomPreset
+		profile.Protocols = []tlsprofile.Protocol{tlsprofile.TLSv12Protocol}
+		profile.Ciphers = new("HIGH:!aNULL")
+		profile.PreferServerCiphers = true
+		ctx := newProviderContext(t)
+		ctx.tlsProfiles = []tlsprofile.TLSProfile{profile}
+
+		result := tlsSettings(ctx, &binding.Binding{TLSProfileID: &profile.ID})
+
+		assert.Contains(t, result, "ssl_protocols TLSv1.2;")
+		assert.Contains(t, result, "ssl_ciphers HIGH:!aNULL;")
+		assert.Contains(t, result, "ssl_prefer_server_ciphers on;")
+	})
+
+	t.Run("renders OCSP stapling and the HSTS header", func(t *testing.T) {
+		profile := newTLSProfile()
+		profile.OCSPStapling = true
+		profile.HSTS = tlsprofile.HSTS{
+			Enabled:           true,
+			MaxAgeSeconds:     63072000,
+			IncludeSubdomains: true,
+			Preload:           true,
+		}
+		ctx := newProviderContext(t)
+		ctx.tlsProfiles = []tlsprofile.TLSProfile{profile}
+		ctx.ocspResolvers = []string{"1.1.1.1", "[2606:4700:4700::1111]"}
+		certificateID := uuid.New()
+
+		result := tlsSettings(ctx, &binding.Binding{
+			TLSProfileID:  &profile.ID,
+			CertificateID: &certificateID,
+		})
+
+		assert.Contains(t, result, "ssl_stapling on;")
+		assert.Contains(t, result, "ssl_stapling_verify on;")
+		assert.Contains(
+			t,
+			result,
+			fmt.Sprintf(
+				"ssl_trusted_certificate \"/etc/nginx/certificate-%s-chain.pem\";",
+				certificateID,
+			),
+		)
+		assert.Contains(t, result, "resolver 1.1.1.1 [2606:4700:4700::1111] valid=300s;")
+		assert.Contains(
+			t,
+			result,
+			`add_header Strict-Transport-Security "max-age=63072000; includeSubDomains; preload" always;`,
+		)
+	})
+
+	t.Run("leaves OCSP stapling out without a resolver", func(t *testing.T) {
+		profile := newTLSProfile()
+		profile.OCSPStapling = true
+		ctx := newProviderContext(t)
+		ctx.tlsProfiles = []tlsprofile.TLSProfile{profile}
+
+		result := tlsSettings(ctx, &binding.Binding{
+			TLSProfileID:  &profile.ID,
+			CertificateID: new(uuid.New()),
+		})
+
+		assert.NotContains(t, result, "ssl_stapling")
+	})
+
+	t.Run("renders the client certificate verification", func(t *testing.T) {
+		profile := newTLSProfile()
+		profile.ClientVerification = tlsprofile.ClientVerification{
+			Enabled:                true,
+			Mode:                   tlsprofile.OptionalClientVerificationMode,
+			Depth:                  2,
+			CertificateAuthorities: new("ca"),
+		}
+		ctx := newProviderContext(t)
+		ctx.tlsProfiles = []tlsprofile.TLSProfile{profile}
+
+		result := tlsSettings(ctx, &binding.Binding{TLSProfileID: &profile.ID})
+
+		assert.Contains(
+			t,
+			result,
+			fmt.Sprintf("ssl_client_certificate \"/etc/nginx/tls-profile-%s-ca.pem\";", profile.ID),
+		)
+		assert.Contains(t, result, "ssl_verify_client optional;")
+		assert.Contains(t, result, "ssl_verify_depth 2;")
+	})
+}
+
+func Test_clientCertificateHeadersEnabled(t *testing.T) {
+	httpsBinding := func(profileID uuid.UUID) binding.Binding {
+		return binding.Binding{Type: binding.HTTPSBindingType, TLSProfileID: &profileID}
+	}
+
+	t.Run("returns false without client verification", func(t *testing.T) {
+		profile := newTLSProfile()
+		ctx := newProviderContext(t)
+		ctx.tlsProfiles = []tlsprofile.TLSProfile{profile}
+
+		assert.False(t, clientCertificateHeadersEnabled(ctx, []binding.Binding{
+			httpsBinding(profile.ID),
+		}))
+	})
+
+	t.Run("returns true when a binding profile verifies client certificates", func(t *testing.T) {
+		profile := newTLSProfile()
+		profile.ClientVerification.Enabled = true
+		ctx := newProviderContext(t)
+		ctx.tlsProfiles = []tlsprofile.TLSProfile{newTLSProfile(), profile}
+
+		assert.True(t, clientCertificateHeadersEnabled(ctx, []binding.Binding{
+			httpsBinding(profile.ID),
+		}))
+	})
+
+	t.Run("ignores the profiles of other hosts", func(t *testing.T) {
+		profile := newTLSProfile()
+		profile.ClientVerification.Enabled = true
+		other := newTLSProfile()
+		ctx := newProviderContext(t)
+		ctx.tlsProfiles = []tlsprofile.TLSProfile{other, profile}
+
+		assert.False(t, clientCertificateHeadersEnabled(ctx, []binding.Binding{
+			httpsBinding(other.ID),
+		}))
+	})
+}
+
+func Test_parseSystemResolvers(t *testing.T) {
+	t.Run("returns the nameservers with the IPv6 ones in brackets", func(t *testing.T) {
+		contents := "# generated\nsearch example.com\nnameserver 127.0.0.11\n" +
+			"nameserver fe80::1%eth0\noptions ndots:0\n"
+
+		assert.Equal(t, []string{"127.0.0.11", "[fe80::1]"}, parseSystemResolvers(contents))
+	})
+}
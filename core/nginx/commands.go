@@ -5,10 +5,12 @@ import (
 
 	"github.com/google/uuid"
 
+	"dillmann.com.br/nginx-ignition/core/common/accesslog"
 	"dillmann.com.br/nginx-ignition/core/common/logline"
 )
 
 type LogSearch struct {
+	Filter           *accesslog.Filter
 	Query            string
 	SurroundingLines int
 }
@@ -27,13 +29,28 @@ type Commands interface {
 		hostID uuid.UUID,
 		qualifier string,
 		lines int,
+		before *int64,
 		search *LogSearch,
 	) ([]logline.LogLine, error)
-	GetMainLogs(ctx context.Context, lines int, search *LogSearch) ([]logline.LogLine, error)
+	GetMainLogs(
+		ctx context.Context,
+		lines int,
+		before *int64,
+		search *LogSearch,
+	) ([]logline.LogLine, error)
+	TailHostLogs(
+		ctx context.Context,
+		hostID uuid.UUID,
+		qualifier string,
+		search *LogSearch,
+	) (<-chan []logline.LogLine, error)
+	TailMainLogs(ctx context.Context, search *LogSearch) (<-chan []logline.LogLine, error)
 	GetStatus(ctx context.Context) bool
 	GetTrafficStats(ctx context.Context) (*Stats, error)
 	GetConfigFiles(ctx context.Context, input GetConfigFilesInput) ([]byte, error)
 	GetMetadata(ctx context.Context) (*Metadata, error)
+	GetLastReload(ctx context.Context) *ReloadResult
+	GetVPNEndpointStatuses(ctx context.Context) []VPNEndpointStatus
 	Reload(ctx context.Context, failIfNotRunning bool) error
 	Start(ctx context.Context) error
 	Stop(ctx context.Context) error
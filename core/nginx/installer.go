@@ -6,6 +6,7 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/container"
 	"dillmann.com.br/nginx-ignition/core/host"
 	"dillmann.com.br/nginx-ignition/core/nginx/cfgfiles"
+	"dillmann.com.br/nginx-ignition/core/revision"
 	"dillmann.com.br/nginx-ignition/core/settings"
 	"dillmann.com.br/nginx-ignition/core/vpn"
 )
@@ -29,7 +30,8 @@ func newCommands(
 	vpnCommands vpn.Commands,
 	settingsCommands settings.Commands,
 	certificateCommands certificate.Commands,
-) (*service, Commands, error) {
+	revisionCommands revision.Commands,
+) (*service, Commands, revision.Reloader, error) {
 	serviceInstance, err := newService(
 		cfg,
 		hostCommands,
@@ -37,10 +39,11 @@ func newCommands(
 		vpnCommands,
 		settingsCommands,
 		certificateCommands,
+		revisionCommands,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return serviceInstance, serviceInstance, nil
+	return serviceInstance, serviceInstance, serviceInstance, nil
 }
@@ -0,0 +1,69 @@
+package nginx
+
+import (
+	"slices"
+
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+)
+
+type logLineCollector struct {
+	match            logline.MatchFunc
+	recent           []logline.LogLine
+	output           []logline.LogLine
+	limit            int
+	surroundingLines int
+	pendingLines     int
+}
+
+func newLogLineCollector(limit int, search *LogSearch) (*logLineCollector, error) {
+	collector := &logLineCollector{
+		limit:  limit,
+		output: make([]logline.LogLine, 0),
+	}
+
+	match, err := newLogLineMatcher(search)
+	if err != nil || match == nil {
+		return collector, err
+	}
+
+	collector.match = match
+	collector.surroundingLines = logline.ClampSurroundingLines(search.SurroundingLines)
+	return collector, nil
+}
+
+func (c *logLineCollector) add(line logline.LogLine) bool {
+	if c.match == nil {
+		c.output = append(c.output, line)
+		return len(c.output) >= c.limit
+	}
+
+	if highlight, matched := c.match(line.Contents); matched {
+		line.Highlight = highlight
+		c.output = append(c.output, c.recent...)
+		c.output = append(c.output, line)
+		c.recent = c.recent[:0]
+		c.pendingLines = c.surroundingLines
+		return c.pendingLines == 0 && len(c.output) >= c.limit
+	}
+
+	if c.pendingLines > 0 {
+		c.output = append(c.output, line)
+		c.pendingLines--
+		return c.pendingLines == 0 && len(c.output) >= c.limit
+	}
+
+	if c.surroundingLines > 0 {
+		c.recent = append(c.recent, line)
+		if len(c.recent) > c.surroundingLines {
+			c.recent = slices.Delete(c.recent, 0, 1)
+		}
+	}
+
+	return len(c.output) >= c.limit
+}
+
+func (c *logLineCollector) result() []logline.LogLine {
+	output := c.output[:min(len(c.output), c.limit)]
+	slices.Reverse(output)
+	return output
+}
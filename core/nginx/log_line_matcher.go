@@ -0,0 +1,40 @@
+package nginx
+
+import (
+	"dillmann.com.br/nginx-ignition/core/common/accesslog"
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+)
+
+func newLogLineMatcher(search *LogSearch) (logline.MatchFunc, error) {
+	if search == nil {
+		return nil, nil
+	}
+
+	var matcher *logline.Matcher
+	if search.Query != "" {
+		var err error
+		if matcher, err = logline.NewMatcher(search.Query); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := search.Filter
+	if matcher == nil && filter.IsEmpty() {
+		return nil, nil
+	}
+
+	return func(contents string) (*logline.Highlight, bool) {
+		if !filter.IsEmpty() {
+			record, err := accesslog.Parse(contents)
+			if err != nil || !filter.Matches(record) {
+				return nil, false
+			}
+		}
+
+		if matcher == nil {
+			return nil, true
+		}
+
+		return matcher.Match(contents)
+	}, nil
+}
@@ -0,0 +1,58 @@
+package nginx
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/common/accesslog"
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+)
+
+func Test_newLogLineMatcher(t *testing.T) {
+	accessLine := `{"time":"2026-03-01T10:00:00+00:00","method":"DELETE","requestUri":"/api","status":404}`
+
+	t.Run("returns no matcher without search criteria", func(t *testing.T) {
+		match, err := newLogLineMatcher(&LogSearch{Filter: &accesslog.Filter{}})
+
+		assert.NoError(t, err)
+		assert.Nil(t, match)
+	})
+
+	t.Run("matches lines accepted by the filter", func(t *testing.T) {
+		match, err := newLogLineMatcher(&LogSearch{
+			Filter: &accesslog.Filter{Method: new("DELETE")},
+		})
+		require.NoError(t, err)
+
+		highlight, matched := match(accessLine)
+		assert.True(t, matched)
+		assert.Nil(t, highlight)
+
+		_, matched = match("unparseable line")
+		assert.False(t, matched)
+	})
+
+	t.Run("combines the filter with the search query", func(t *testing.T) {
+		match, err := newLogLineMatcher(&LogSearch{
+			Query:  "api",
+			Filter: &accesslog.Filter{Path: new("/api")},
+		})
+		require.NoError(t, err)
+
+		highlight, matched := match(accessLine)
+		assert.True(t, matched)
+		start := strings.Index(accessLine, "api")
+		assert.Equal(t, &logline.Highlight{Start: start, End: start + 3}, highlight)
+
+		_, matched = match(
+			`{"time":"2026-03-01T10:00:00+00:00","requestUri":"/api","referer":"none"}`,
+		)
+		assert.True(t, matched)
+
+		_, matched = match(`{"time":"2026-03-01T10:00:00+00:00","requestUri":"/","referer":"api"}`)
+		assert.False(t, matched)
+	})
+}
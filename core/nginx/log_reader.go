@@ -1,15 +1,20 @@
 package nginx
 
 import (
-	"bufio"
+	"bytes"
 	"context"
+	"errors"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
 	"dillmann.com.br/nginx-ignition/core/common/logline"
 )
 
+const logReadBlockSize = 64 * 1024
+
 type logReader struct {
 	configProvider *configuration.Configuration
 }
@@ -20,14 +25,19 @@ func newLogReader(configProvider *configuration.Configuration) *logReader {
 	}
 }
 
-func (r *logReader) read(_ context.Context, fileName string) ([]logline.LogLine, error) {
+func (r *logReader) read(
+	_ context.Context,
+	fileName string,
+	lines int,
+	before *int64,
+	search *LogSearch,
+) ([]logline.LogLine, error) {
 	basePath, err := r.configProvider.Get("nginx-ignition.nginx.config-path")
 	if err != nil {
 		return nil, err
 	}
 
-	normalizedPath := filepath.Join(basePath, "logs")
-	filePath := filepath.Join(normalizedPath, fileName)
+	filePath := filepath.Join(basePath, "logs", fileName)
 	file, err := os.Open(filePath)
 	if err != nil {
 		return nil, err
@@ -36,14 +46,90 @@ func (r *logReader) read(_ context.Context, fileName string) ([]logline.LogLine,
 	//nolint:errcheck
 	defer file.Close()
 
-	lines := make([]logline.LogLine, 0)
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		lines = append(lines, logline.LogLine{
-			LineNumber: len(lines),
-			Contents:   scanner.Text(),
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	end := fileInfo.Size()
+	if before != nil {
+		end = min(max(*before, 0), end)
+	}
+
+	collector, err := newLogLineCollector(lines, search)
+	if err != nil {
+		return nil, err
+	}
+
+	err = readLinesBackwards(file, end, func(contents string, offset int64) bool {
+		done := collector.add(logline.LogLine{
+			Contents: contents,
+			Offset:   offset,
 		})
+		return !done
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return collector.result(), nil
+}
+
+func readLinesBackwards(
+	file *os.File,
+	end int64,
+	visit func(contents string, offset int64) bool,
+) error {
+	position := end
+	first := true
+	var carry []byte
+
+	for position > 0 {
+		size := min(position, logReadBlockSize)
+		position -= size
+
+		block := make([]byte, size, size+int64(len(carry)))
+		if err := readFully(file, block, position); err != nil {
+			return err
+		}
+
+		data := append(block, carry...)
+		for {
+			index := bytes.LastIndexByte(data, '\n')
+			if index < 0 {
+				break
+			}
+
+			contents := data[index+1:]
+			data = data[:index]
+
+			if first && len(contents) == 0 {
+				first = false
+				continue
+			}
+
+			first = false
+			if !visit(strings.TrimSuffix(string(contents), "\r"), position+int64(index)+1) {
+				return nil
+			}
+		}
+
+		carry = data
+	}
+
+	if first && len(carry) == 0 {
+		return nil
+	}
+
+	visit(strings.TrimSuffix(string(carry), "\r"), 0)
+	return nil
+}
+
+func readFully(file *os.File, buffer []byte, offset int64) error {
+	read, err := file.ReadAt(buffer, offset)
+	if err != nil && (!errors.Is(err, io.EOF) || read < len(buffer)) {
+		return err
 	}
 
-	return lines, scanner.Err()
+	return nil
 }
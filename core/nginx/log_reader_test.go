@@ -1,23 +1,29 @@
 package nginx
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"dillmann.com.br/nginx-ignition/core/common/accesslog"
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
 	"dillmann.com.br/nginx-ignition/core/common/logline"
+	"dillmann.com.br/nginx-ignition/core/common/valuerange"
 )
 
 func Test_logReader(t *testing.T) {
-	tmpDir, _ := os.MkdirTemp("", "logs")
-	defer os.RemoveAll(tmpDir)
+	tmpDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(tmpDir, "logs"), 0o755))
 
-	_ = os.Mkdir(filepath.Join(tmpDir, "logs"), 0o755)
-	logFile := filepath.Join(tmpDir, "logs", "test.log")
-	_ = os.WriteFile(logFile, []byte("line1\nline2\nline3\n"), 0o644)
+	writeLog := func(t *testing.T, fileName, contents string) {
+		logFile := filepath.Join(tmpDir, "logs", fileName)
+		require.NoError(t, os.WriteFile(logFile, []byte(contents), 0o644))
+	}
 
 	cfg := configuration.NewWithOverrides(map[string]string{
 		"nginx-ignition.nginx.config-path": tmpDir,
@@ -26,13 +32,166 @@ func Test_logReader(t *testing.T) {
 
 	t.Run("read", func(t *testing.T) {
 		t.Run("reads lines correctly", func(t *testing.T) {
-			lines, err := reader.read(t.Context(), "test.log")
+			writeLog(t, "test.log", "line1\nline2\nline3\n")
+
+			lines, err := reader.read(t.Context(), "test.log", 10, nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, []logline.LogLine{
+				{Contents: "line1", Offset: 0},
+				{Contents: "line2", Offset: 6},
+				{Contents: "line3", Offset: 12},
+			}, lines)
+		})
+
+		t.Run("reads only the last lines", func(t *testing.T) {
+			writeLog(t, "last.log", "line1\nline2\nline3")
+
+			lines, err := reader.read(t.Context(), "last.log", 2, nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, []logline.LogLine{
+				{Contents: "line2", Offset: 6},
+				{Contents: "line3", Offset: 12},
+			}, lines)
+		})
+
+		t.Run("reads the lines before the given offset", func(t *testing.T) {
+			writeLog(t, "before.log", "line1\nline2\nline3\nline4\n")
+			before := int64(12)
+
+			lines, err := reader.read(t.Context(), "before.log", 10, &before, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, []logline.LogLine{
-				{LineNumber: 0, Contents: "line1"},
-				{LineNumber: 1, Contents: "line2"},
-				{LineNumber: 2, Contents: "line3"},
+				{Contents: "line1", Offset: 0},
+				{Contents: "line2", Offset: 6},
 			}, lines)
 		})
+
+		t.Run("returns no lines for an empty file", func(t *testing.T) {
+			writeLog(t, "empty.log", "")
+
+			lines, err := reader.read(t.Context(), "empty.log", 10, nil, nil)
+			assert.NoError(t, err)
+			assert.Empty(t, lines)
+		})
+
+		t.Run("reads lines spanning multiple blocks", func(t *testing.T) {
+			var builder strings.Builder
+			for index := range 20_000 {
+				builder.WriteString(fmt.Sprintf("line number %d\n", index))
+			}
+			contents := builder.String()
+			writeLog(t, "large.log", contents)
+
+			lines, err := reader.read(t.Context(), "large.log", 3, nil, nil)
+			assert.NoError(t, err)
+			require.Len(t, lines, 3)
+			assert.Equal(t, int64(strings.Index(contents, "line number 19997\n")), lines[0].Offset)
+			assert.Equal(t, "line number 19997", lines[0].Contents)
+			assert.Equal(t, "line number 19999", lines[2].Contents)
+
+			older, err := reader.read(t.Context(), "large.log", 2, &lines[0].Offset, nil)
+			assert.NoError(t, err)
+			require.Len(t, older, 2)
+			assert.Equal(t, int64(strings.Index(contents, "line number 19995\n")), older[0].Offset)
+			assert.Equal(t, "line number 19996", older[1].Contents)
+		})
+
+		t.Run("reads from the middle of the file without scanning it", func(t *testing.T) {
+			var builder strings.Builder
+			for index := range 300_000 {
+				builder.WriteString(fmt.Sprintf("checkpoint %07d\n", index))
+			}
+			writeLog(t, "checkpoint.log", builder.String())
+
+			before := int64(250_000 * 19)
+			lines, err := reader.read(t.Context(), "checkpoint.log", 1, &before, nil)
+			assert.NoError(t, err)
+			require.Len(t, lines, 1)
+			assert.Equal(t, int64(249_999*19), lines[0].Offset)
+			assert.Equal(t, "checkpoint 0249999", lines[0].Contents)
+		})
+
+		t.Run("searches with surrounding lines", func(t *testing.T) {
+			writeLog(t, "search.log", "a\nb\nmatch\nc\nd\ne\nmatch\nf\n")
+
+			search := &LogSearch{Query: "match", SurroundingLines: 1}
+			lines, err := reader.read(t.Context(), "search.log", 10, nil, search)
+			assert.NoError(t, err)
+
+			contents := make([]string, len(lines))
+			offsets := make([]int64, len(lines))
+			for index, line := range lines {
+				contents[index] = line.Contents
+				offsets[index] = line.Offset
+			}
+
+			assert.Equal(t, []string{"b", "match", "c", "e", "match", "f"}, contents)
+			assert.Equal(t, []int64{2, 4, 10, 14, 16, 22}, offsets)
+			assert.Equal(t, &logline.Highlight{Start: 0, End: 5}, lines[1].Highlight)
+			assert.Nil(t, lines[0].Highlight)
+		})
+
+		t.Run("limits the search results to the most recent lines", func(t *testing.T) {
+			writeLog(t, "limited.log", "match 1\nother\nmatch 2\nmatch 3\n")
+
+			search := &LogSearch{Query: "match"}
+			lines, err := reader.read(t.Context(), "limited.log", 2, nil, search)
+			assert.NoError(t, err)
+			require.Len(t, lines, 2)
+			assert.Equal(t, "match 2", lines[0].Contents)
+			assert.Equal(t, "match 3", lines[1].Contents)
+		})
+
+		t.Run("filters access log records", func(t *testing.T) {
+			writeLog(
+				t,
+				"filter.log",
+				`{"time":"2026-03-01T10:00:00+00:00","method":"GET","requestUri":"/","status":200}`+"\n"+
+					`{"time":"2026-03-01T10:00:01+00:00","method":"GET","requestUri":"/api","status":502}`+"\n"+
+					"not an access log line\n"+
+					`{"time":"2026-03-01T10:00:02+00:00","method":"POST","requestUri":"/api","status":500}`+"\n",
+			)
+			search := &LogSearch{
+				Filter: &accesslog.Filter{
+					StatusRange: valuerange.New(500, 599),
+					Method:      new("GET"),
+				},
+			}
+
+			lines, err := reader.read(t.Context(), "filter.log", 10, nil, search)
+			assert.NoError(t, err)
+			require.Len(t, lines, 1)
+			assert.Contains(t, lines[0].Contents, `"status":502`)
+			assert.Nil(t, lines[0].Highlight)
+		})
+
+		t.Run("reads the lines appended to the file", func(t *testing.T) {
+			writeLog(t, "appended.log", "line1\nline2\n")
+			_, err := reader.read(t.Context(), "appended.log", 10, nil, nil)
+			require.NoError(t, err)
+
+			file, err := os.OpenFile(
+				filepath.Join(tmpDir, "logs", "appended.log"),
+				os.O_APPEND|os.O_WRONLY,
+				0o644,
+			)
+			require.NoError(t, err)
+			_, err = file.WriteString("line3\n")
+			require.NoError(t, err)
+			require.NoError(t, file.Close())
+
+			lines, err := reader.read(t.Context(), "appended.log", 1, nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(
+				t,
+				[]logline.LogLine{{Contents: "line3", Offset: 12}},
+				lines,
+			)
+		})
+
+		t.Run("returns an error when the file does not exist", func(t *testing.T) {
+			_, err := reader.read(t.Context(), "missing.log", 10, nil, nil)
+			assert.Error(t, err)
+		})
 	})
 }
@@ -0,0 +1,198 @@
+package nginx
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+)
+
+const (
+	logTailPollInterval = 500 * time.Millisecond
+	logTailMaximumRead  = 1024 * 1024
+)
+
+type logTailer struct {
+	configProvider *configuration.Configuration
+	pollInterval   time.Duration
+}
+
+type logTailState struct {
+	fileInfo         os.FileInfo
+	match            logline.MatchFunc
+	filePath         string
+	partial          string
+	offset           int64
+	surroundingLines int
+}
+
+func newLogTailer(configProvider *configuration.Configuration) *logTailer {
+	return &logTailer{
+		configProvider: configProvider,
+		pollInterval:   logTailPollInterval,
+	}
+}
+
+func (t *logTailer) tail(
+	ctx context.Context,
+	fileName string,
+	search *LogSearch,
+) (<-chan []logline.LogLine, error) {
+	basePath, err := t.configProvider.Get("nginx-ignition.nginx.config-path")
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := newLogLineMatcher(search)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &logTailState{
+		filePath: filepath.Join(basePath, "logs", fileName),
+		match:    match,
+	}
+
+	if search != nil {
+		state.surroundingLines = search.SurroundingLines
+	}
+
+	fileInfo, err := os.Stat(state.filePath)
+	switch {
+	case err == nil:
+		state.fileInfo = fileInfo
+		state.offset = fileInfo.Size()
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, err
+	}
+
+	output := make(chan []logline.LogLine)
+	go t.follow(ctx, state, output)
+
+	return output, nil
+}
+
+func (t *logTailer) follow(
+	ctx context.Context,
+	state *logTailState,
+	output chan<- []logline.LogLine,
+) {
+	defer close(output)
+
+	ticker := time.NewTicker(t.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		lines, err := state.poll()
+		if err != nil {
+			log.Warnf("Unable to follow the log file %s: %v", state.filePath, err)
+			continue
+		}
+
+		if len(lines) == 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case output <- lines:
+		}
+	}
+}
+
+func (s *logTailState) poll() ([]logline.LogLine, error) {
+	fileInfo, err := os.Stat(s.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		s.reset(nil, 0)
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case s.fileInfo != nil && !os.SameFile(s.fileInfo, fileInfo):
+		s.reset(fileInfo, 0)
+	case fileInfo.Size() < s.offset:
+		// The log rotator rewrites the file in place with lines that were already streamed
+		s.reset(fileInfo, fileInfo.Size())
+		return nil, nil
+	default:
+		s.fileInfo = fileInfo
+	}
+
+	if fileInfo.Size() == s.offset {
+		return nil, nil
+	}
+
+	contents, err := s.readFrom(fileInfo.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildLines(contents)
+}
+
+func (s *logTailState) readFrom(size int64) (string, error) {
+	file, err := os.Open(s.filePath)
+	if err != nil {
+		return "", err
+	}
+
+	//nolint:errcheck
+	defer file.Close()
+
+	if _, err = file.Seek(s.offset, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	contents, err := io.ReadAll(io.LimitReader(file, min(size-s.offset, logTailMaximumRead)))
+	if err != nil {
+		return "", err
+	}
+
+	s.offset += int64(len(contents))
+	return string(contents), nil
+}
+
+func (s *logTailState) buildLines(contents string) ([]logline.LogLine, error) {
+	offset := s.offset - int64(len(contents)) - int64(len(s.partial))
+	values := strings.Split(s.partial+contents, "\n")
+	s.partial = values[len(values)-1]
+
+	lines := make([]logline.LogLine, 0, len(values)-1)
+	for _, value := range values[:len(values)-1] {
+		lines = append(lines, logline.LogLine{
+			Contents: strings.TrimSuffix(value, "\r"),
+			Offset:   offset,
+		})
+		offset += int64(len(value)) + 1
+	}
+
+	if s.match == nil || len(lines) == 0 {
+		return lines, nil
+	}
+
+	return logline.SearchWith(lines, s.match, s.surroundingLines), nil
+}
+
+func (s *logTailState) reset(fileInfo os.FileInfo, offset int64) {
+	s.fileInfo = fileInfo
+	s.offset = offset
+	s.partial = ""
+}
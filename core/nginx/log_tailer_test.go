@@ -0,0 +1,128 @@
+package nginx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/logline"
+)
+
+func Test_logTailer(t *testing.T) {
+	newTailer := func(t *testing.T) (*logTailer, string) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(tmpDir, "logs"), 0o755))
+
+		cfg := configuration.NewWithOverrides(map[string]string{
+			"nginx-ignition.nginx.config-path": tmpDir,
+		})
+
+		tailer := newLogTailer(cfg)
+		tailer.pollInterval = 10 * time.Millisecond
+
+		return tailer, filepath.Join(tmpDir, "logs", "test.log")
+	}
+
+	appendLines := func(t *testing.T, filePath, contents string) {
+		file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		require.NoError(t, err)
+		_, err = file.WriteString(contents)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+	}
+
+	receive := func(t *testing.T, channel <-chan []logline.LogLine) []logline.LogLine {
+		select {
+		case lines := <-channel:
+			return lines
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for log lines")
+			return nil
+		}
+	}
+
+	t.Run("tail", func(t *testing.T) {
+		t.Run("streams only the lines appended after it started", func(t *testing.T) {
+			tailer, filePath := newTailer(t)
+			appendLines(t, filePath, "old1\nold2\n")
+
+			channel, err := tailer.tail(t.Context(), "test.log", nil)
+			require.NoError(t, err)
+
+			appendLines(t, filePath, "new1\nnew2\n")
+
+			assert.Equal(t, []logline.LogLine{
+				{Contents: "new1", Offset: 10},
+				{Contents: "new2", Offset: 15},
+			}, receive(t, channel))
+		})
+
+		t.Run("waits for incomplete lines to be finished", func(t *testing.T) {
+			tailer, filePath := newTailer(t)
+
+			channel, err := tailer.tail(t.Context(), "test.log", nil)
+			require.NoError(t, err)
+
+			appendLines(t, filePath, "partial")
+			time.Sleep(50 * time.Millisecond)
+			appendLines(t, filePath, " line\n")
+
+			assert.Equal(t, []logline.LogLine{
+				{Contents: "partial line", Offset: 0},
+			}, receive(t, channel))
+		})
+
+		t.Run("applies the search filter", func(t *testing.T) {
+			tailer, filePath := newTailer(t)
+
+			search := &LogSearch{Query: "error"}
+			channel, err := tailer.tail(t.Context(), "test.log", search)
+			require.NoError(t, err)
+
+			appendLines(t, filePath, "info message\nerror message\n")
+
+			lines := receive(t, channel)
+			require.Len(t, lines, 1)
+			assert.Equal(t, "error message", lines[0].Contents)
+			assert.Equal(t, &logline.Highlight{Start: 0, End: 5}, lines[0].Highlight)
+		})
+
+		t.Run("keeps following the file after a rotation", func(t *testing.T) {
+			tailer, filePath := newTailer(t)
+			appendLines(t, filePath, "line1\nline2\nline3\n")
+
+			channel, err := tailer.tail(t.Context(), "test.log", nil)
+			require.NoError(t, err)
+
+			require.NoError(t, os.WriteFile(filePath, []byte("line3\n"), 0o644))
+			time.Sleep(50 * time.Millisecond)
+			appendLines(t, filePath, "line4\n")
+
+			lines := receive(t, channel)
+			require.Len(t, lines, 1)
+			assert.Equal(t, "line4", lines[0].Contents)
+		})
+
+		t.Run("closes the channel when the context is cancelled", func(t *testing.T) {
+			tailer, _ := newTailer(t)
+			ctx, cancel := context.WithCancel(t.Context())
+
+			channel, err := tailer.tail(ctx, "test.log", nil)
+			require.NoError(t, err)
+			cancel()
+
+			select {
+			case _, open := <-channel:
+				assert.False(t, open)
+			case <-time.After(2 * time.Second):
+				t.Fatal("timed out waiting for the channel to close")
+			}
+		})
+	})
+}
@@ -1,5 +1,11 @@
 package nginx
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type SupportType string
 
 const (
@@ -84,6 +90,18 @@ type StatsUpstreamResponses struct {
 	Status5xx uint64
 }
 
+type ReloadResult struct {
+	Timestamp time.Time
+	Error     error
+}
+
+type VPNEndpointStatus struct {
+	Error  error
+	Name   string
+	VPNID  uuid.UUID
+	Active bool
+}
+
 type Metadata struct {
 	Version       string
 	BuildDetails  string
@@ -5,6 +5,8 @@ import (
 	"bytes"
 	"context"
 	"net/http"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -17,6 +19,7 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/logline"
 	"dillmann.com.br/nginx-ignition/core/host"
 	"dillmann.com.br/nginx-ignition/core/nginx/cfgfiles"
+	"dillmann.com.br/nginx-ignition/core/revision"
 	"dillmann.com.br/nginx-ignition/core/settings"
 	"dillmann.com.br/nginx-ignition/core/vpn"
 )
@@ -27,9 +30,12 @@ type service struct {
 	semaphore          *semaphore
 	logReader          *logReader
 	logRotator         *logRotator
+	logTailer          *logTailer
 	vpnManager         *vpnManager
 	settingsCommands   settings.Commands
+	revisionCommands   revision.Commands
 	statsClient        *http.Client
+	lastReload         atomic.Pointer[ReloadResult]
 }
 
 func newService(
@@ -39,6 +45,7 @@ func newService(
 	vpnCommands vpn.Commands,
 	settingsCommands settings.Commands,
 	certificateCommands certificate.Commands,
+	revisionCommands revision.Commands,
 ) (*service, error) {
 	pManager, err := newProcessManager(cfg)
 	if err != nil {
@@ -52,9 +59,11 @@ func newService(
 		processManager:     pManager,
 		vpnManager:         vManager,
 		settingsCommands:   settingsCommands,
+		revisionCommands:   revisionCommands,
 		semaphore:          newSemaphore(),
 		logReader:          newLogReader(cfg),
 		logRotator:         newLogRotator(cfg, settingsCommands, hostCommands, pManager),
+		logTailer:          newLogTailer(cfg),
 		statsClient:        buildStatsClient(pManager.configPath),
 	}, nil
 }
@@ -64,22 +73,36 @@ func (s *service) Reload(ctx context.Context, failIfNotRunning bool) error {
 		return coreerror.New(i18n.M(ctx, i18n.K.CoreNginxNotRunning), false)
 	}
 
+	err := s.reload(ctx)
+	s.lastReload.Store(&ReloadResult{
+		Timestamp: time.Now(),
+		Error:     err,
+	})
+
+	return err
+}
+
+func (s *service) reload(ctx context.Context) error {
 	supportedFeatures, err := s.resolveSupportedFeatures(ctx)
 	if err != nil {
 		return err
 	}
 
 	return s.semaphore.changeState(runningState, func() error {
-		hosts, _, err := s.configFilesManager.ReplaceConfigurationFiles(ctx, supportedFeatures)
+		configFiles, hosts, _, err := s.configFilesManager.ReplaceConfigurationFiles(
+			ctx,
+			supportedFeatures,
+		)
 		if err != nil {
 			return err
 		}
 
 		err = s.processManager.sendReloadSignal()
 		if err != nil {
-			return err
+			return s.rollbackConfigurationFiles(err)
 		}
 
+		s.recordRevision(ctx, configFiles)
 		return s.vpnManager.reload(ctx, hosts)
 	})
 }
@@ -105,20 +128,46 @@ func (s *service) Start(ctx context.Context) error {
 	}
 
 	return s.semaphore.changeState(runningState, func() error {
-		hosts, _, err := s.configFilesManager.ReplaceConfigurationFiles(ctx, supportedFeatures)
+		configFiles, hosts, _, err := s.configFilesManager.ReplaceConfigurationFiles(
+			ctx,
+			supportedFeatures,
+		)
 		if err != nil {
 			return err
 		}
 
 		err = s.processManager.start()
 		if err != nil {
-			return err
+			return s.rollbackConfigurationFiles(err)
 		}
 
+		s.recordRevision(ctx, configFiles)
 		return s.vpnManager.start(ctx, hosts)
 	})
 }
 
+func (s *service) rollbackConfigurationFiles(cause error) error {
+	if err := s.configFilesManager.RollbackConfigurationFiles(); err != nil {
+		log.Warnf("Unable to roll back the nginx configuration files: %v", err)
+	}
+
+	return cause
+}
+
+func (s *service) recordRevision(ctx context.Context, configFiles []cfgfiles.File) {
+	files := make([]revision.File, len(configFiles))
+	for index, file := range configFiles {
+		files[index] = revision.File{
+			Name:     file.Name,
+			Contents: file.FormattedContents(),
+		}
+	}
+
+	if err := s.revisionCommands.Record(ctx, files); err != nil {
+		log.Warnf("Unable to record the nginx configuration revision: %v", err)
+	}
+}
+
 func (s *service) Stop(ctx context.Context) error {
 	if s.semaphore.currentState() == stoppedState {
 		return nil
@@ -137,47 +186,54 @@ func (s *service) GetStatus(_ context.Context) bool {
 	return s.semaphore.currentState() == runningState
 }
 
+func (s *service) GetLastReload(_ context.Context) *ReloadResult {
+	return s.lastReload.Load()
+}
+
+func (s *service) GetVPNEndpointStatuses(_ context.Context) []VPNEndpointStatus {
+	return s.vpnManager.statuses()
+}
+
 func (s *service) GetHostLogs(
 	ctx context.Context,
 	hostID uuid.UUID,
 	qualifier string,
 	lines int,
+	before *int64,
 	search *LogSearch,
 ) ([]logline.LogLine, error) {
-	return s.readLogs(ctx, lines, "host-"+hostID.String()+"."+qualifier+".log", search)
+	return s.logReader.read(
+		ctx,
+		"host-"+hostID.String()+"."+qualifier+".log",
+		lines,
+		before,
+		search,
+	)
 }
 
 func (s *service) GetMainLogs(
 	ctx context.Context,
 	lines int,
+	before *int64,
 	search *LogSearch,
 ) ([]logline.LogLine, error) {
-	return s.readLogs(ctx, lines, "main.log", search)
+	return s.logReader.read(ctx, "main.log", lines, before, search)
 }
 
-func (s *service) readLogs(
+func (s *service) TailHostLogs(
 	ctx context.Context,
-	lines int,
-	fileName string,
+	hostID uuid.UUID,
+	qualifier string,
 	search *LogSearch,
-) ([]logline.LogLine, error) {
-	output, err := s.logReader.read(ctx, fileName)
-	if err != nil {
-		return nil, err
-	}
-
-	if search != nil {
-		output, err = logline.Search(output, search.Query, search.SurroundingLines)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if len(output) > lines {
-		output = output[len(output)-lines:]
-	}
+) (<-chan []logline.LogLine, error) {
+	return s.logTailer.tail(ctx, "host-"+hostID.String()+"."+qualifier+".log", search)
+}
 
-	return output, nil
+func (s *service) TailMainLogs(
+	ctx context.Context,
+	search *LogSearch,
+) (<-chan []logline.LogLine, error) {
+	return s.logTailer.tail(ctx, "main.log", search)
 }
 
 func (s *service) rotateLogs(ctx context.Context) error {
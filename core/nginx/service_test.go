@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,8 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 	"dillmann.com.br/nginx-ignition/core/common/logline"
 	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/nginx/cfgfiles"
+	"dillmann.com.br/nginx-ignition/core/revision"
 	"dillmann.com.br/nginx-ignition/core/settings"
 )
 
@@ -38,11 +41,11 @@ func Test_service(t *testing.T) {
 		}
 
 		t.Run("returns requested number of lines", func(t *testing.T) {
-			lines, err := nginxService.GetMainLogs(t.Context(), 2, nil)
+			lines, err := nginxService.GetMainLogs(t.Context(), 2, nil, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, []logline.LogLine{
-				{LineNumber: 1, Contents: "line2"},
-				{LineNumber: 2, Contents: "line3"},
+				{Contents: "line2", Offset: 6},
+				{Contents: "line3", Offset: 12},
 			}, lines)
 		})
 	})
@@ -66,10 +69,10 @@ func Test_service(t *testing.T) {
 		}
 
 		t.Run("returns host specific logs", func(t *testing.T) {
-			lines, err := nginxService.GetHostLogs(t.Context(), hostID, "access", 1, nil)
+			lines, err := nginxService.GetHostLogs(t.Context(), hostID, "access", 1, nil, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, []logline.LogLine{
-				{LineNumber: 1, Contents: "access2"},
+				{Contents: "access2", Offset: 8},
 			}, lines)
 		})
 	})
@@ -139,6 +142,26 @@ func Test_service(t *testing.T) {
 			var coreErr *coreerror.CoreError
 			assert.ErrorAs(t, err, &coreErr)
 			assert.Equal(t, i18n.K.CoreNginxNotRunning, coreErr.Message.Key)
+			assert.Nil(t, nginxService.GetLastReload(t.Context()))
+		})
+
+		t.Run("records the outcome of the reload attempt", func(t *testing.T) {
+			nginxService := &service{
+				semaphore: &semaphore{
+					state: runningState,
+				},
+				processManager: &processManager{
+					binaryPath: filepath.Join(t.TempDir(), "missing-nginx"),
+				},
+			}
+
+			err := nginxService.Reload(t.Context(), true)
+			assert.Error(t, err)
+
+			result := nginxService.GetLastReload(t.Context())
+			require.NotNil(t, result)
+			assert.Equal(t, err, result.Error)
+			assert.WithinDuration(t, time.Now(), result.Timestamp, time.Minute)
 		})
 	})
 
@@ -187,4 +210,24 @@ func Test_service(t *testing.T) {
 			assert.False(t, nginxService.GetStatus(t.Context()))
 		})
 	})
+
+	t.Run("recordRevision", func(t *testing.T) {
+		t.Run("records the formatted configuration files", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			revisionCmds := revision.NewMockedCommands(ctrl)
+			revisionCmds.EXPECT().Record(t.Context(), []revision.File{
+				{Name: "nginx.conf", Contents: "events {\n    worker_connections 1024;\n}"},
+			}).Return(nil)
+
+			nginxService := &service{
+				revisionCommands: revisionCmds,
+			}
+
+			nginxService.recordRevision(t.Context(), []cfgfiles.File{
+				{Name: "nginx.conf", Contents: "events {\nworker_connections 1024;\n}"},
+			})
+		})
+	})
 }
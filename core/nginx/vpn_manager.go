@@ -1,9 +1,12 @@
 package nginx
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
+	"sync"
 
 	"github.com/google/uuid"
 
@@ -28,6 +31,8 @@ type vpnManager struct {
 	settingsCommands    settings.Commands
 	certificateCommands certificate.Commands
 	currentEndpoints    []vpn.Endpoint
+	endpointStatuses    map[string]VPNEndpointStatus
+	statusesLock        sync.Mutex
 }
 
 func newVpnManager(
@@ -40,6 +45,7 @@ func newVpnManager(
 		settingsCommands:    settingsCommands,
 		certificateCommands: certificateCommands,
 		currentEndpoints:    make([]vpn.Endpoint, 0),
+		endpointStatuses:    make(map[string]VPNEndpointStatus),
 	}
 }
 
@@ -50,7 +56,7 @@ func (m *vpnManager) start(ctx context.Context, hosts []host.Host) error {
 	}
 
 	for _, endpoint := range endpoints {
-		if err := m.vpnCommands.Start(ctx, endpoint); err != nil {
+		if err := m.startEndpoint(ctx, endpoint); err != nil {
 			return err
 		}
 	}
@@ -88,7 +94,7 @@ func (m *vpnManager) stopObsoleteEndpoints(ctx context.Context, newEndpoints []v
 		}
 
 		if !found {
-			if err := m.vpnCommands.Stop(ctx, oldEndpoint); err != nil {
+			if err := m.stopEndpoint(ctx, oldEndpoint); err != nil {
 				return err
 			}
 		}
@@ -108,7 +114,7 @@ func (m *vpnManager) startNewEndpoints(ctx context.Context, newEndpoints []vpn.E
 		}
 
 		if !found {
-			if err := m.vpnCommands.Start(ctx, newEndpoint); err != nil {
+			if err := m.startEndpoint(ctx, newEndpoint); err != nil {
 				return err
 			}
 		}
@@ -211,7 +217,7 @@ func (m *vpnManager) getVPNCertificateDetails(
 
 func (m *vpnManager) stop(ctx context.Context) error {
 	for _, endpoint := range m.currentEndpoints {
-		if err := m.vpnCommands.Stop(ctx, endpoint); err != nil {
+		if err := m.stopEndpoint(ctx, endpoint); err != nil {
 			return err
 		}
 	}
@@ -219,6 +225,53 @@ func (m *vpnManager) stop(ctx context.Context) error {
 	return nil
 }
 
+func (m *vpnManager) startEndpoint(ctx context.Context, endpoint vpn.Endpoint) error {
+	err := m.vpnCommands.Start(ctx, endpoint)
+
+	m.statusesLock.Lock()
+	defer m.statusesLock.Unlock()
+
+	m.endpointStatuses[endpoint.Hash()] = VPNEndpointStatus{
+		VPNID:  endpoint.VPNID(),
+		Name:   endpoint.SourceName(),
+		Active: err == nil,
+		Error:  err,
+	}
+
+	return err
+}
+
+func (m *vpnManager) stopEndpoint(ctx context.Context, endpoint vpn.Endpoint) error {
+	if err := m.vpnCommands.Stop(ctx, endpoint); err != nil {
+		return err
+	}
+
+	m.statusesLock.Lock()
+	defer m.statusesLock.Unlock()
+
+	delete(m.endpointStatuses, endpoint.Hash())
+	return nil
+}
+
+func (m *vpnManager) statuses() []VPNEndpointStatus {
+	m.statusesLock.Lock()
+	defer m.statusesLock.Unlock()
+
+	output := make([]VPNEndpointStatus, 0, len(m.endpointStatuses))
+	for _, status := range m.endpointStatuses {
+		output = append(output, status)
+	}
+
+	slices.SortFunc(output, func(left, right VPNEndpointStatus) int {
+		return cmp.Or(
+			cmp.Compare(left.Name, right.Name),
+			cmp.Compare(left.VPNID.String(), right.VPNID.String()),
+		)
+	})
+
+	return output
+}
+
 func (a *endpointAdapter) Hash() string {
 	var domainNameStr string
 	if a.domainName != nil {
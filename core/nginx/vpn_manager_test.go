@@ -1,6 +1,7 @@
 package nginx
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
@@ -251,10 +252,14 @@ func Test_vpnManager(t *testing.T) {
 			manager := &vpnManager{
 				vpnCommands:      vpnCmds,
 				currentEndpoints: []vpn.Endpoint{ep1, ep2},
+				endpointStatuses: map[string]VPNEndpointStatus{
+					ep2.Hash(): {VPNID: vpnID, Name: "ep2", Active: true},
+				},
 			}
 
 			err := manager.stopObsoleteEndpoints(t.Context(), []vpn.Endpoint{ep1})
 			assert.NoError(t, err)
+			assert.Empty(t, manager.statuses())
 		})
 	})
 
@@ -279,10 +284,62 @@ func Test_vpnManager(t *testing.T) {
 			manager := &vpnManager{
 				vpnCommands:      vpnCmds,
 				currentEndpoints: []vpn.Endpoint{ep1},
+				endpointStatuses: make(map[string]VPNEndpointStatus),
 			}
 
 			err := manager.startNewEndpoints(t.Context(), []vpn.Endpoint{ep1, ep2})
 			assert.NoError(t, err)
 		})
 	})
+
+	t.Run("statuses", func(t *testing.T) {
+		vpnID := uuid.New()
+		ep1 := &endpointAdapter{
+			vpnID: vpnID,
+			name:  "ep1",
+		}
+		ep2 := &endpointAdapter{
+			vpnID: vpnID,
+			name:  "ep2",
+		}
+
+		t.Run("reports active and failed endpoints sorted by name", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			startErr := errors.New("start failed")
+			vpnCmds := vpn.NewMockedCommands(ctrl)
+			vpnCmds.EXPECT().Start(t.Context(), ep2).Return(startErr)
+			vpnCmds.EXPECT().Start(t.Context(), ep1).Return(nil)
+
+			manager := newVpnManager(vpnCmds, nil, nil)
+
+			assert.ErrorIs(t, manager.startEndpoint(t.Context(), ep2), startErr)
+			assert.NoError(t, manager.startEndpoint(t.Context(), ep1))
+
+			statuses := manager.statuses()
+			assert.Len(t, statuses, 2)
+			assert.Equal(t, "ep1", statuses[0].Name)
+			assert.True(t, statuses[0].Active)
+			assert.Nil(t, statuses[0].Error)
+			assert.Equal(t, "ep2", statuses[1].Name)
+			assert.False(t, statuses[1].Active)
+			assert.Equal(t, startErr, statuses[1].Error)
+		})
+
+		t.Run("keeps the endpoint status when stopping fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			vpnCmds := vpn.NewMockedCommands(ctrl)
+			vpnCmds.EXPECT().Start(t.Context(), ep1).Return(nil)
+			vpnCmds.EXPECT().Stop(t.Context(), ep1).Return(errors.New("stop failed"))
+
+			manager := newVpnManager(vpnCmds, nil, nil)
+
+			assert.NoError(t, manager.startEndpoint(t.Context(), ep1))
+			assert.Error(t, manager.stopEndpoint(t.Context(), ep1))
+			assert.Len(t, manager.statuses(), 1)
+		})
+	})
 }
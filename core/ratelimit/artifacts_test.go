@@ -0,0 +1,22 @@
+package ratelimit
+
+import (
+	"github.com/google/uuid"
+)
+
+func newRateLimit() *RateLimit {
+	return &RateLimit{
+		ID:                 uuid.New(),
+		Name:               "API clients",
+		KeyType:            ClientIPKeyType,
+		Rate:               10,
+		RateUnit:           PerSecondRateUnit,
+		Burst:              20,
+		NoDelay:            true,
+		ResponseStatusCode: 429,
+		ConnectionLimit: ConnectionLimit{
+			Enabled:            true,
+			MaximumConnections: new(50),
+		},
+	}
+}
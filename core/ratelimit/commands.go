@@ -0,0 +1,22 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type Commands interface {
+	Delete(ctx context.Context, id uuid.UUID) error
+	Get(ctx context.Context, id uuid.UUID) (*RateLimit, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+	List(
+		ctx context.Context,
+		pageSize, pageNumber int,
+		searchTerms *string,
+	) (*pagination.Page[RateLimit], error)
+	GetAllInUse(ctx context.Context) ([]RateLimit, error)
+	Save(ctx context.Context, rateLimit *RateLimit) error
+}
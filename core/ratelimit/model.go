@@ -0,0 +1,38 @@
+package ratelimit
+
+import (
+	"github.com/google/uuid"
+)
+
+type KeyType string
+
+const (
+	ClientIPKeyType KeyType = "CLIENT_IP"
+	HeaderKeyType   KeyType = "HEADER"
+	JWTClaimKeyType KeyType = "JWT_CLAIM"
+)
+
+type RateUnit string
+
+const (
+	PerSecondRateUnit RateUnit = "PER_SECOND"
+	PerMinuteRateUnit RateUnit = "PER_MINUTE"
+)
+
+type RateLimit struct {
+	KeyName            *string
+	Name               string
+	KeyType            KeyType
+	RateUnit           RateUnit
+	ConnectionLimit    ConnectionLimit
+	Rate               int
+	Burst              int
+	ResponseStatusCode int
+	ID                 uuid.UUID
+	NoDelay            bool
+}
+
+type ConnectionLimit struct {
+	MaximumConnections *int
+	Enabled            bool
+}
@@ -0,0 +1,23 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type Repository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*RateLimit, error)
+	InUseByID(ctx context.Context, id uuid.UUID) (bool, error)
+	ExistsByID(ctx context.Context, id uuid.UUID) (bool, error)
+	DeleteByID(ctx context.Context, id uuid.UUID) error
+	FindPage(
+		ctx context.Context,
+		pageNumber, pageSize int,
+		searchTerms *string,
+	) (*pagination.Page[RateLimit], error)
+	FindAllInUse(ctx context.Context) ([]RateLimit, error)
+	Save(ctx context.Context, rateLimit *RateLimit) error
+}
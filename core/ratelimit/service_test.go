@@ -0,0 +1,223 @@
+package ratelimit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+func Test_service(t *testing.T) {
+	t.Run("Save", func(t *testing.T) {
+		t.Run("valid rate limit saves successfully", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			rateLimit := newRateLimit()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Save(t.Context(), rateLimit).Return(nil)
+
+			rateLimitService := newCommands(repository)
+			err := rateLimitService.Save(t.Context(), rateLimit)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("invalid rate limit returns validation error", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			rateLimit := newRateLimit()
+			rateLimit.Name = ""
+
+			repository := NewMockedRepository(ctrl)
+			rateLimitService := newCommands(repository)
+			err := rateLimitService.Save(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("repository error is returned", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			rateLimit := newRateLimit()
+			expectedErr := errors.New("repository error")
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Save(t.Context(), rateLimit).Return(expectedErr)
+
+			rateLimitService := newCommands(repository)
+			err := rateLimitService.Save(t.Context(), rateLimit)
+
+			assert.Equal(t, expectedErr, err)
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("deletes successfully when not in use", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().InUseByID(t.Context(), id).Return(false, nil)
+			repository.EXPECT().DeleteByID(t.Context(), id).Return(nil)
+
+			rateLimitService := newCommands(repository)
+			err := rateLimitService.Delete(t.Context(), id)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("returns error when in use", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().InUseByID(t.Context(), id).Return(true, nil)
+
+			rateLimitService := newCommands(repository)
+			err := rateLimitService.Delete(t.Context(), id)
+
+			require.Error(t, err)
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+			assert.Equal(t, i18n.K.CoreRatelimitInUse, coreErr.Message.Key)
+		})
+
+		t.Run("returns error when InUseByID fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+			expectedErr := errors.New("check failed")
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().InUseByID(t.Context(), id).Return(false, expectedErr)
+
+			rateLimitService := newCommands(repository)
+			err := rateLimitService.Delete(t.Context(), id)
+
+			assert.Equal(t, expectedErr, err)
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("returns rate limit when found", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := newRateLimit()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), expected.ID).Return(expected, nil)
+
+			rateLimitService := newCommands(repository)
+			result, err := rateLimitService.Get(t.Context(), expected.ID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+
+		t.Run("returns error when repository fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+			expectedErr := errors.New("not found")
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), id).Return(nil, expectedErr)
+
+			rateLimitService := newCommands(repository)
+			result, err := rateLimitService.Get(t.Context(), id)
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+			assert.Equal(t, expectedErr, err)
+		})
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Run("returns paginated results", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expectedPage := pagination.Of([]RateLimit{*newRateLimit()})
+			searchTerms := "test"
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindPage(t.Context(), 1, 10, &searchTerms).Return(expectedPage, nil)
+
+			rateLimitService := newCommands(repository)
+			result, err := rateLimitService.List(t.Context(), 10, 1, &searchTerms)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expectedPage, result)
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		t.Run("returns true when exists", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().ExistsByID(t.Context(), id).Return(true, nil)
+
+			rateLimitService := newCommands(repository)
+			exists, err := rateLimitService.Exists(t.Context(), id)
+
+			assert.NoError(t, err)
+			assert.True(t, exists)
+		})
+
+		t.Run("returns false when not exists", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().ExistsByID(t.Context(), id).Return(false, nil)
+
+			rateLimitService := newCommands(repository)
+			exists, err := rateLimitService.Exists(t.Context(), id)
+
+			assert.NoError(t, err)
+			assert.False(t, exists)
+		})
+	})
+
+	t.Run("GetAllInUse", func(t *testing.T) {
+		t.Run("returns all in use rate limits", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := []RateLimit{*newRateLimit(), *newRateLimit()}
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindAllInUse(t.Context()).Return(expected, nil)
+
+			rateLimitService := newCommands(repository)
+			result, err := rateLimitService.GetAllInUse(t.Context())
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+	})
+}
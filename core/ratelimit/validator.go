@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/validation"
+	"dillmann.com.br/nginx-ignition/core/common/valuerange"
+)
+
+var (
+	headerNamePattern      = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+	claimNamePattern       = regexp.MustCompile(`^[A-Za-z0-9_.:-]+$`)
+	burstRange             = valuerange.New(0, 100000)
+	responseStatusRange    = valuerange.New(400, 599)
+	maximumConnectionRange = valuerange.New(1, 100000)
+)
+
+type validator struct {
+	delegate *validation.ConsistencyValidator
+}
+
+func newValidator() *validator {
+	return &validator{
+		delegate: validation.NewValidator(),
+	}
+}
+
+func (v *validator) validate(ctx context.Context, r *RateLimit) error {
+	if strings.TrimSpace(r.Name) == "" {
+		v.delegate.Add("name", i18n.M(ctx, i18n.K.CommonValueMissing))
+	}
+
+	v.validateKey(ctx, r)
+	v.validateRate(ctx, r)
+	v.validateConnectionLimit(ctx, r.ConnectionLimit)
+
+	return v.delegate.Result()
+}
+
+func (v *validator) validateKey(ctx context.Context, r *RateLimit) {
+	var pattern *regexp.Regexp
+
+	switch r.KeyType {
+	case ClientIPKeyType:
+		return
+	case HeaderKeyType:
+		pattern = headerNamePattern
+	case JWTClaimKeyType:
+		pattern = claimNamePattern
+	default:
+		v.delegate.Add("keyType", i18n.M(ctx, i18n.K.CommonInvalidValue))
+		return
+	}
+
+	if r.KeyName == nil || strings.TrimSpace(*r.KeyName) == "" {
+		v.delegate.Add("keyName", i18n.M(ctx, i18n.K.CommonValueMissing))
+		return
+	}
+
+	if !pattern.MatchString(*r.KeyName) {
+		v.delegate.Add("keyName", i18n.M(ctx, i18n.K.CoreRatelimitInvalidKeyName))
+	}
+}
+
+func (v *validator) validateRate(ctx context.Context, r *RateLimit) {
+	if r.Rate < 1 {
+		v.delegate.Add("rate", i18n.M(ctx, i18n.K.CommonCannotBeZero))
+	}
+
+	switch r.RateUnit {
+	case PerSecondRateUnit, PerMinuteRateUnit:
+		// Valid
+	default:
+		v.delegate.Add("rateUnit", i18n.M(ctx, i18n.K.CommonInvalidValue))
+	}
+
+	if !burstRange.Contains(r.Burst) {
+		v.delegate.Add(
+			"burst",
+			i18n.M(ctx, i18n.K.CommonBetweenValues).
+				V("min", burstRange.Min).
+				V("max", burstRange.Max),
+		)
+	}
+
+	if !responseStatusRange.Contains(r.ResponseStatusCode) {
+		v.delegate.Add(
+			"responseStatusCode",
+			i18n.M(ctx, i18n.K.CommonBetweenValues).
+				V("min", responseStatusRange.Min).
+				V("max", responseStatusRange.Max),
+		)
+	}
+}
+
+func (v *validator) validateConnectionLimit(ctx context.Context, limit ConnectionLimit) {
+	if !limit.Enabled {
+		return
+	}
+
+	const path = "connectionLimit.maximumConnections"
+	if limit.MaximumConnections == nil {
+		v.delegate.Add(path, i18n.M(ctx, i18n.K.CommonValueMissing))
+		return
+	}
+
+	if !maximumConnectionRange.Contains(*limit.MaximumConnections) {
+		v.delegate.Add(
+			path,
+			i18n.M(ctx, i18n.K.CommonBetweenValues).
+				V("min", maximumConnectionRange.Min).
+				V("max", maximumConnectionRange.Max),
+		)
+	}
+}
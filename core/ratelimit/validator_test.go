@@ -0,0 +1,164 @@
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validator(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		t.Run("valid rate limit passes", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("empty name fails", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.Name = "   "
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("invalid key type fails", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.KeyType = "INVALID"
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("header key without name fails", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.KeyType = HeaderKeyType
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("header key with invalid name fails", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.KeyType = HeaderKeyType
+			rateLimit.KeyName = new("X Api Key")
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("header key with valid name passes", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.KeyType = HeaderKeyType
+			rateLimit.KeyName = new("X-Api-Key")
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("JWT claim key with valid name passes", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.KeyType = JWTClaimKeyType
+			rateLimit.KeyName = new("sub")
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("JWT claim key with invalid name fails", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.KeyType = JWTClaimKeyType
+			rateLimit.KeyName = new("tenant id")
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("zero rate fails", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.Rate = 0
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("invalid rate unit fails", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.RateUnit = "PER_HOUR"
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("negative burst fails", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.Burst = -1
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("non-error response status code fails", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.ResponseStatusCode = 200
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("enabled connection limit without maximum fails", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.ConnectionLimit.MaximumConnections = nil
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("enabled connection limit with zero maximum fails", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.ConnectionLimit.MaximumConnections = new(0)
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("disabled connection limit without maximum passes", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			rateLimit.ConnectionLimit = ConnectionLimit{}
+			rateLimitValidator := newValidator()
+
+			err := rateLimitValidator.validate(t.Context(), rateLimit)
+
+			assert.NoError(t, err)
+		})
+	})
+}
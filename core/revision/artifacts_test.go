@@ -0,0 +1,40 @@
+package revision
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/stream"
+)
+
+func newRevision() *Revision {
+	return &Revision{
+		ID:        uuid.New(),
+		CreatedAt: time.Now(),
+		Checksum:  "checksum",
+		Files: []File{
+			{
+				Name:     "nginx.conf",
+				Contents: "events {}\n",
+			},
+		},
+		Snapshot: &Snapshot{
+			Settings: &settings.Settings{},
+			Hosts: []host.Host{
+				{
+					ID:      uuid.New(),
+					Enabled: true,
+				},
+			},
+			Streams: []stream.Stream{
+				{
+					ID:      uuid.New(),
+					Enabled: true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,23 @@
+package revision
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type Commands interface {
+	Record(ctx context.Context, files []File) error
+	List(ctx context.Context, pageSize, pageNumber int) (*pagination.Page[Summary], error)
+	Get(ctx context.Context, id uuid.UUID) (*Revision, error)
+	Diff(ctx context.Context, fromID, toID uuid.UUID) (*Diff, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+}
+
+// Implemented by the nginx commands, which can't be referenced here since they depend on the
+// revisions themselves
+type Reloader interface {
+	Reload(ctx context.Context, failIfNotRunning bool) error
+}
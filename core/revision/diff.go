@@ -0,0 +1,252 @@
+package revision
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const diffContextLines = 3
+
+type diffOperation byte
+
+const (
+	equalDiffOperation  diffOperation = ' '
+	deleteDiffOperation diffOperation = '-'
+	insertDiffOperation diffOperation = '+'
+)
+
+type diffEdit struct {
+	line      string
+	operation diffOperation
+	fromIndex int
+	toIndex   int
+}
+
+func diffFiles(fromFiles, toFiles []File) []FileDiff {
+	fromContents := make(map[string]string, len(fromFiles))
+	for _, file := range fromFiles {
+		fromContents[file.Name] = file.Contents
+	}
+
+	toContents := make(map[string]string, len(toFiles))
+	for _, file := range toFiles {
+		toContents[file.Name] = file.Contents
+	}
+
+	names := make([]string, 0, len(fromContents)+len(toContents))
+	for name := range fromContents {
+		names = append(names, name)
+	}
+
+	for name := range toContents {
+		if _, exists := fromContents[name]; !exists {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	output := make([]FileDiff, 0)
+	for _, name := range names {
+		from, inFrom := fromContents[name]
+		to, inTo := toContents[name]
+
+		var changeType FileChangeType
+		switch {
+		case !inFrom:
+			changeType = AddedFileChangeType
+		case !inTo:
+			changeType = RemovedFileChangeType
+		case from != to:
+			changeType = ModifiedFileChangeType
+		default:
+			continue
+		}
+
+		output = append(output, FileDiff{
+			Name:        name,
+			ChangeType:  changeType,
+			UnifiedDiff: unifiedDiff(name, from, to),
+		})
+	}
+
+	return output
+}
+
+func unifiedDiff(name, from, to string) string {
+	edits := diffLines(splitLines(from), splitLines(to))
+
+	var builder strings.Builder
+	builder.WriteString("--- a/" + name + "\n")
+	builder.WriteString("+++ b/" + name + "\n")
+
+	for start := 0; start < len(edits); {
+		if edits[start].operation == equalDiffOperation {
+			start++
+			continue
+		}
+
+		hunkStart := max(start-diffContextLines, 0)
+		hunkEnd := start
+		for hunkEnd < len(edits) {
+			if edits[hunkEnd].operation != equalDiffOperation {
+				hunkEnd++
+				continue
+			}
+
+			nextChange := hunkEnd
+			for nextChange < len(edits) && edits[nextChange].operation == equalDiffOperation {
+				nextChange++
+			}
+
+			if nextChange == len(edits) || nextChange-hunkEnd > diffContextLines*2 {
+				hunkEnd = min(hunkEnd+diffContextLines, len(edits))
+				break
+			}
+
+			hunkEnd = nextChange
+		}
+
+		writeHunk(&builder, edits[hunkStart:hunkEnd])
+		start = hunkEnd
+	}
+
+	return builder.String()
+}
+
+func writeHunk(builder *strings.Builder, edits []diffEdit) {
+	fromStart, toStart := edits[0].fromIndex, edits[0].toIndex
+	fromCount, toCount := 0, 0
+
+	for _, edit := range edits {
+		if edit.operation != insertDiffOperation {
+			fromCount++
+		}
+
+		if edit.operation != deleteDiffOperation {
+			toCount++
+		}
+	}
+
+	if fromCount > 0 {
+		fromStart++
+	}
+
+	if toCount > 0 {
+		toStart++
+	}
+
+	fmt.Fprintf(builder, "@@ -%d,%d +%d,%d @@\n", fromStart, fromCount, toStart, toCount)
+	for _, edit := range edits {
+		builder.WriteByte(byte(edit.operation))
+		builder.WriteString(edit.line)
+		builder.WriteByte('\n')
+	}
+}
+
+func splitLines(contents string) []string {
+	if contents == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(contents, "\n"), "\n")
+}
+
+func diffLines(from, to []string) []diffEdit {
+	fromSize, toSize := len(from), len(to)
+	maxDistance := fromSize + toSize
+	offset := maxDistance + 1
+	frontier := make([]int, 2*maxDistance+3)
+	trace := make([][]int, 0)
+
+	for distance := 0; distance <= maxDistance; distance++ {
+		trace = append(trace, slices.Clone(frontier))
+
+		for diagonal := -distance; diagonal <= distance; diagonal += 2 {
+			var x int
+			if movesDown(frontier, offset, diagonal, distance) {
+				x = frontier[offset+diagonal+1]
+			} else {
+				x = frontier[offset+diagonal-1] + 1
+			}
+
+			y := x - diagonal
+			for x < fromSize && y < toSize && from[x] == to[y] {
+				x++
+				y++
+			}
+
+			frontier[offset+diagonal] = x
+			if x >= fromSize && y >= toSize {
+				return backtrackEdits(from, to, trace, offset)
+			}
+		}
+	}
+
+	return nil
+}
+
+func movesDown(frontier []int, offset, diagonal, distance int) bool {
+	if diagonal == -distance {
+		return true
+	}
+
+	return diagonal != distance && frontier[offset+diagonal-1] < frontier[offset+diagonal+1]
+}
+
+func backtrackEdits(from, to []string, trace [][]int, offset int) []diffEdit {
+	edits := make([]diffEdit, 0, len(from)+len(to))
+	x, y := len(from), len(to)
+
+	for distance := len(trace) - 1; distance >= 0; distance-- {
+		frontier := trace[distance]
+		diagonal := x - y
+
+		var previousDiagonal int
+		if movesDown(frontier, offset, diagonal, distance) {
+			previousDiagonal = diagonal + 1
+		} else {
+			previousDiagonal = diagonal - 1
+		}
+
+		previousX := frontier[offset+previousDiagonal]
+		previousY := previousX - previousDiagonal
+
+		for x > previousX && y > previousY {
+			x--
+			y--
+			edits = append(edits, diffEdit{
+				operation: equalDiffOperation,
+				line:      from[x],
+				fromIndex: x,
+				toIndex:   y,
+			})
+		}
+
+		if distance == 0 {
+			break
+		}
+
+		if x == previousX {
+			y--
+			edits = append(edits, diffEdit{
+				operation: insertDiffOperation,
+				line:      to[y],
+				fromIndex: x,
+				toIndex:   y,
+			})
+		} else {
+			x--
+			edits = append(edits, diffEdit{
+				operation: deleteDiffOperation,
+				line:      from[x],
+				fromIndex: x,
+				toIndex:   y,
+			})
+		}
+	}
+
+	slices.Reverse(edits)
+	return edits
+}
@@ -0,0 +1,84 @@
+package revision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_diffFiles(t *testing.T) {
+	t.Run("classifies the changed files", func(t *testing.T) {
+		from := []File{
+			{Name: "a.conf", Contents: "a\n"},
+			{Name: "b.conf", Contents: "b\n"},
+			{Name: "c.conf", Contents: "c\n"},
+		}
+		to := []File{
+			{Name: "a.conf", Contents: "a\n"},
+			{Name: "b.conf", Contents: "b2\n"},
+			{Name: "d.conf", Contents: "d\n"},
+		}
+
+		result := diffFiles(from, to)
+
+		require.Len(t, result, 3)
+		assert.Equal(t, "b.conf", result[0].Name)
+		assert.Equal(t, ModifiedFileChangeType, result[0].ChangeType)
+		assert.Equal(t, "c.conf", result[1].Name)
+		assert.Equal(t, RemovedFileChangeType, result[1].ChangeType)
+		assert.Equal(t, "d.conf", result[2].Name)
+		assert.Equal(t, AddedFileChangeType, result[2].ChangeType)
+	})
+
+	t.Run("returns an empty result when nothing changed", func(t *testing.T) {
+		files := []File{{Name: "a.conf", Contents: "a\n"}}
+		assert.Empty(t, diffFiles(files, files))
+	})
+}
+
+func Test_unifiedDiff(t *testing.T) {
+	t.Run("renders a modified line with its context", func(t *testing.T) {
+		from := "1\n2\n3\n4\n5\n6\n7\n8\n9\n"
+		to := "1\n2\n3\n4\nfive\n6\n7\n8\n9\n"
+
+		result := unifiedDiff("nginx.conf", from, to)
+
+		assert.Equal(t,
+			"--- a/nginx.conf\n"+
+				"+++ b/nginx.conf\n"+
+				"@@ -2,7 +2,7 @@\n"+
+				" 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+			result,
+		)
+	})
+
+	t.Run("splits distant changes into separate hunks", func(t *testing.T) {
+		from := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"
+		to := "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\ntwelve\n"
+
+		result := unifiedDiff("nginx.conf", from, to)
+
+		assert.Equal(t,
+			"--- a/nginx.conf\n"+
+				"+++ b/nginx.conf\n"+
+				"@@ -1,4 +1,4 @@\n"+
+				"-1\n+one\n 2\n 3\n 4\n"+
+				"@@ -9,4 +9,4 @@\n"+
+				" 9\n 10\n 11\n-12\n+twelve\n",
+			result,
+		)
+	})
+
+	t.Run("renders a new file", func(t *testing.T) {
+		result := unifiedDiff("new.conf", "", "a\nb\n")
+
+		assert.Equal(t,
+			"--- a/new.conf\n"+
+				"+++ b/new.conf\n"+
+				"@@ -0,0 +1,2 @@\n"+
+				"+a\n+b\n",
+			result,
+		)
+	})
+}
@@ -0,0 +1,55 @@
+package revision
+
+import (
+	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/cache"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/container"
+	"dillmann.com.br/nginx-ignition/core/common/transaction"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+func Install() error {
+	return container.Provide(newCommands)
+}
+
+func newCommands(
+	cfg *configuration.Configuration,
+	repository Repository,
+	transactions transaction.Manager,
+	hostCommands host.Commands,
+	streamCommands stream.Commands,
+	accessListCommands accesslist.Commands,
+	cacheCommands cache.Commands,
+	rateLimitCommands ratelimit.Commands,
+	securityHeadersCommands securityheaders.Commands,
+	upstreamCommands upstream.Commands,
+	tlsProfileCommands tlsprofile.Commands,
+	settingsCommands settings.Commands,
+) Commands {
+	reloader := func() Reloader {
+		return container.Get[Reloader]()
+	}
+
+	return newService(
+		cfg,
+		repository,
+		transactions,
+		hostCommands,
+		streamCommands,
+		accessListCommands,
+		cacheCommands,
+		rateLimitCommands,
+		securityHeadersCommands,
+		upstreamCommands,
+		tlsProfileCommands,
+		settingsCommands,
+		reloader,
+	)
+}
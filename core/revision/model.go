@@ -0,0 +1,68 @@
+package revision
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/cache"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+type FileChangeType string
+
+const (
+	AddedFileChangeType    FileChangeType = "ADDED"
+	RemovedFileChangeType  FileChangeType = "REMOVED"
+	ModifiedFileChangeType FileChangeType = "MODIFIED"
+)
+
+type Revision struct {
+	CreatedAt time.Time
+	Snapshot  *Snapshot
+	Checksum  string
+	Files     []File
+	ID        uuid.UUID
+}
+
+type Summary struct {
+	CreatedAt time.Time
+	Checksum  string
+	ID        uuid.UUID
+}
+
+type Snapshot struct {
+	Settings        *settings.Settings
+	Hosts           []host.Host
+	Streams         []stream.Stream
+	AccessLists     []accesslist.AccessList
+	Caches          []cache.Cache
+	RateLimits      []ratelimit.RateLimit
+	SecurityHeaders []securityheaders.SecurityHeaders
+	TLSProfiles     []tlsprofile.TLSProfile
+	Upstreams       []upstream.Upstream
+}
+
+type File struct {
+	Name     string
+	Contents string
+}
+
+type Diff struct {
+	Files  []FileDiff
+	FromID uuid.UUID
+	ToID   uuid.UUID
+}
+
+type FileDiff struct {
+	Name        string
+	ChangeType  FileChangeType
+	UnifiedDiff string
+}
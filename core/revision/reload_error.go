@@ -0,0 +1,15 @@
+package revision
+
+// Returned by the restore when nginx can't be reloaded with the restored configuration, in which
+// case the changes to the database are rolled back
+type ReloadError struct {
+	Cause error
+}
+
+func (e ReloadError) Error() string {
+	return e.Cause.Error()
+}
+
+func (e ReloadError) Unwrap() error {
+	return e.Cause
+}
@@ -0,0 +1,17 @@
+package revision
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type Repository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*Revision, error)
+	FindLatest(ctx context.Context) (*Summary, error)
+	FindPage(ctx context.Context, pageNumber, pageSize int) (*pagination.Page[Summary], error)
+	DeleteOldest(ctx context.Context, amountToKeep int) error
+	Save(ctx context.Context, revision *Revision) error
+}
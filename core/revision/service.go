@@ -0,0 +1,386 @@
+package revision
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/cache"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/common/transaction"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+type service struct {
+	configuration           *configuration.Configuration
+	repository              Repository
+	transactions            transaction.Manager
+	hostCommands            host.Commands
+	streamCommands          stream.Commands
+	accessListCommands      accesslist.Commands
+	cacheCommands           cache.Commands
+	rateLimitCommands       ratelimit.Commands
+	securityHeadersCommands securityheaders.Commands
+	upstreamCommands        upstream.Commands
+	tlsProfileCommands      tlsprofile.Commands
+	settingsCommands        settings.Commands
+	reloader                func() Reloader
+}
+
+func newService(
+	cfg *configuration.Configuration,
+	repository Repository,
+	transactions transaction.Manager,
+	hostCommands host.Commands,
+	streamCommands stream.Commands,
+	accessListCommands accesslist.Commands,
+	cacheCommands cache.Commands,
+	rateLimitCommands ratelimit.Commands,
+	securityHeadersCommands securityheaders.Commands,
+	upstreamCommands upstream.Commands,
+	tlsProfileCommands tlsprofile.Commands,
+	settingsCommands settings.Commands,
+	reloader func() Reloader,
+) *service {
+	return &service{
+		configuration:           cfg,
+		repository:              repository,
+		transactions:            transactions,
+		hostCommands:            hostCommands,
+		streamCommands:          streamCommands,
+		accessListCommands:      accessListCommands,
+		cacheCommands:           cacheCommands,
+		rateLimitCommands:       rateLimitCommands,
+		securityHeadersCommands: securityHeadersCommands,
+		upstreamCommands:        upstreamCommands,
+		tlsProfileCommands:      tlsProfileCommands,
+		settingsCommands:        settingsCommands,
+		reloader:                reloader,
+	}
+}
+
+func (s *service) Record(ctx context.Context, files []File) error {
+	textFiles := make([]File, 0, len(files))
+	for _, file := range files {
+		if utf8.ValidString(file.Contents) {
+			textFiles = append(textFiles, file)
+		}
+	}
+
+	slices.SortFunc(textFiles, func(left, right File) int {
+		return strings.Compare(left.Name, right.Name)
+	})
+
+	checksum := buildChecksum(textFiles)
+	latest, err := s.repository.FindLatest(ctx)
+	if err != nil {
+		return err
+	}
+
+	if latest != nil && latest.Checksum == checksum {
+		return nil
+	}
+
+	snapshot, err := s.buildSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	revision := &Revision{
+		ID:        uuid.New(),
+		CreatedAt: time.Now(),
+		Checksum:  checksum,
+		Files:     textFiles,
+		Snapshot:  snapshot,
+	}
+
+	if err = s.repository.Save(ctx, revision); err != nil {
+		return err
+	}
+
+	maximumAmount, err := s.configuration.GetInt("nginx-ignition.revision.maximum-amount")
+	if err != nil {
+		return err
+	}
+
+	return s.repository.DeleteOldest(ctx, maximumAmount)
+}
+
+func (s *service) List(
+	ctx context.Context,
+	pageSize,
+	pageNumber int,
+) (*pagination.Page[Summary], error) {
+	return s.repository.FindPage(ctx, pageNumber, pageSize)
+}
+
+func (s *service) Get(ctx context.Context, id uuid.UUID) (*Revision, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+func (s *service) Diff(ctx context.Context, fromID, toID uuid.UUID) (*Diff, error) {
+	from, err := s.findRequired(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.findRequired(ctx, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Diff{
+		FromID: fromID,
+		ToID:   toID,
+		Files:  diffFiles(from.Files, to.Files),
+	}, nil
+}
+
+func (s *service) Restore(ctx context.Context, id uuid.UUID) error {
+	revision, err := s.findRequired(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	normalizeSnapshot(revision.Snapshot)
+
+	return s.transactions.Run(ctx, func(ctx context.Context) error {
+		if err := s.applySnapshot(ctx, revision.Snapshot); err != nil {
+			return err
+		}
+
+		if err := s.reloader().Reload(ctx, false); err != nil {
+			return &ReloadError{Cause: err}
+		}
+
+		return nil
+	})
+}
+
+// Fills the values the snapshots recorded by older versions don't have with the defaults the
+// database migrations gave to the existing records, so they pass the current validations
+func normalizeSnapshot(snapshot *Snapshot) {
+	for hostIndex := range snapshot.Hosts {
+		routes := snapshot.Hosts[hostIndex].Routes
+		for routeIndex := range routes {
+			if routes[routeIndex].MatchType == "" {
+				routes[routeIndex].MatchType = host.PrefixRouteMatchType
+			}
+		}
+	}
+
+	if snapshot.Settings != nil && snapshot.Settings.Nginx != nil &&
+		snapshot.Settings.Nginx.Logs != nil && snapshot.Settings.Nginx.Logs.AccessLogsFormat == "" {
+		snapshot.Settings.Nginx.Logs.AccessLogsFormat = settings.CombinedAccessLogFormat
+	}
+}
+
+func (s *service) applySnapshot(ctx context.Context, snapshot *Snapshot) error {
+	var err error
+	for index := range snapshot.AccessLists {
+		if err = s.accessListCommands.Save(ctx, &snapshot.AccessLists[index]); err != nil {
+			return err
+		}
+	}
+
+	for index := range snapshot.Caches {
+		if err = s.cacheCommands.Save(ctx, &snapshot.Caches[index]); err != nil {
+			return err
+		}
+	}
+
+	for index := range snapshot.RateLimits {
+		if err = s.rateLimitCommands.Save(ctx, &snapshot.RateLimits[index]); err != nil {
+			return err
+		}
+	}
+
+	for index := range snapshot.SecurityHeaders {
+		if err = s.securityHeadersCommands.Save(ctx, &snapshot.SecurityHeaders[index]); err != nil {
+			return err
+		}
+	}
+
+	for index := range snapshot.Upstreams {
+		if err = s.upstreamCommands.Save(ctx, &snapshot.Upstreams[index]); err != nil {
+			return err
+		}
+	}
+
+	for index := range snapshot.TLSProfiles {
+		if err = s.tlsProfileCommands.Save(ctx, &snapshot.TLSProfiles[index]); err != nil {
+			return err
+		}
+	}
+
+	if err = s.restoreHosts(ctx, snapshot.Hosts); err != nil {
+		return err
+	}
+
+	if err = s.restoreStreams(ctx, snapshot.Streams); err != nil {
+		return err
+	}
+
+	if snapshot.Settings == nil {
+		return nil
+	}
+
+	return s.settingsCommands.Save(ctx, snapshot.Settings)
+}
+
+func (s *service) restoreHosts(ctx context.Context, hosts []host.Host) error {
+	enabledHosts, err := s.hostCommands.GetAllEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	for index := range enabledHosts {
+		current := &enabledHosts[index]
+		if slices.ContainsFunc(hosts, func(h host.Host) bool { return h.ID == current.ID }) {
+			continue
+		}
+
+		current.Enabled = false
+		if err = s.hostCommands.Save(ctx, current); err != nil {
+			return err
+		}
+	}
+
+	for index := range hosts {
+		if err = s.hostCommands.Save(ctx, &hosts[index]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) restoreStreams(ctx context.Context, streams []stream.Stream) error {
+	enabledStreams, err := s.streamCommands.GetAllEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	for index := range enabledStreams {
+		current := &enabledStreams[index]
+		if slices.ContainsFunc(
+			streams,
+			func(st stream.Stream) bool { return st.ID == current.ID },
+		) {
+			continue
+		}
+
+		current.Enabled = false
+		if err = s.streamCommands.Save(ctx, current); err != nil {
+			return err
+		}
+	}
+
+	for index := range streams {
+		if err = s.streamCommands.Save(ctx, &streams[index]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) findRequired(ctx context.Context, id uuid.UUID) (*Revision, error) {
+	revision, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if revision == nil {
+		return nil, coreerror.New(i18n.M(ctx, i18n.K.CoreRevisionNotFound), true)
+	}
+
+	return revision, nil
+}
+
+func (s *service) buildSnapshot(ctx context.Context) (*Snapshot, error) {
+	hosts, err := s.hostCommands.GetAllEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	streams, err := s.streamCommands.GetAllEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accessLists, err := s.accessListCommands.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	caches, err := s.cacheCommands.GetAllInUse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimits, err := s.rateLimitCommands.GetAllInUse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	securityHeaders, err := s.securityHeadersCommands.GetAllInUse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upstreams, err := s.upstreamCommands.GetAllInUse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsProfiles, err := s.tlsProfileCommands.GetAllInUse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currentSettings, err := s.settingsCommands.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{
+		Settings:        currentSettings,
+		Hosts:           hosts,
+		Streams:         streams,
+		AccessLists:     accessLists,
+		Caches:          caches,
+		RateLimits:      rateLimits,
+		SecurityHeaders: securityHeaders,
+		Upstreams:       upstreams,
+		TLSProfiles:     tlsProfiles,
+	}, nil
+}
+
+func buildChecksum(files []File) string {
+	hash := sha256.New()
+	for _, file := range files {
+		hash.Write([]byte(file.Name))
+		hash.Write([]byte{0})
+		hash.Write([]byte(file.Contents))
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
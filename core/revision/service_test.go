@@ -0,0 +1,328 @@
+package revision
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/cache"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/transaction"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+type serviceMocks struct {
+	repository              *MockedRepository
+	transactions            *transaction.MockedManager
+	hostCommands            *host.MockedCommands
+	streamCommands          *stream.MockedCommands
+	accessListCommands      *accesslist.MockedCommands
+	cacheCommands           *cache.MockedCommands
+	rateLimitCommands       *ratelimit.MockedCommands
+	securityHeadersCommands *securityheaders.MockedCommands
+	upstreamCommands        *upstream.MockedCommands
+	tlsProfileCommands      *tlsprofile.MockedCommands
+	settingsCommands        *settings.MockedCommands
+	reloader                *MockedReloader
+}
+
+func newServiceWithMocks(ctrl *gomock.Controller) (Commands, *serviceMocks) {
+	mocks := &serviceMocks{
+		repository:              NewMockedRepository(ctrl),
+		transactions:            transaction.NewMockedManager(ctrl),
+		hostCommands:            host.NewMockedCommands(ctrl),
+		streamCommands:          stream.NewMockedCommands(ctrl),
+		accessListCommands:      accesslist.NewMockedCommands(ctrl),
+		cacheCommands:           cache.NewMockedCommands(ctrl),
+		rateLimitCommands:       ratelimit.NewMockedCommands(ctrl),
+		securityHeadersCommands: securityheaders.NewMockedCommands(ctrl),
+		upstreamCommands:        upstream.NewMockedCommands(ctrl),
+		tlsProfileCommands:      tlsprofile.NewMockedCommands(ctrl),
+		settingsCommands:        settings.NewMockedCommands(ctrl),
+		reloader:                NewMockedReloader(ctrl),
+	}
+
+	cfg := configuration.NewWithOverrides(map[string]string{
+		"nginx-ignition.revision.maximum-amount": "10",
+	})
+
+	return newService(
+		cfg,
+		mocks.repository,
+		mocks.transactions,
+		mocks.hostCommands,
+		mocks.streamCommands,
+		mocks.accessListCommands,
+		mocks.cacheCommands,
+		mocks.rateLimitCommands,
+		mocks.securityHeadersCommands,
+		mocks.upstreamCommands,
+		mocks.tlsProfileCommands,
+		mocks.settingsCommands,
+		func() Reloader { return mocks.reloader },
+	), mocks
+}
+
+func Test_service(t *testing.T) {
+	t.Run("Record", func(t *testing.T) {
+		files := []File{
+			{Name: "nginx.conf", Contents: "events {}"},
+			{Name: "GeoLite2-Country.mmdb", Contents: string([]byte{0xff, 0xfe, 0x00})},
+		}
+
+		t.Run("saves a new revision with the current snapshot", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			revisionService, mocks := newServiceWithMocks(ctrl)
+			hosts := []host.Host{{ID: uuid.New()}}
+			currentSettings := &settings.Settings{}
+
+			mocks.repository.EXPECT().FindLatest(t.Context()).Return(nil, nil)
+			mocks.hostCommands.EXPECT().GetAllEnabled(t.Context()).Return(hosts, nil)
+			mocks.streamCommands.EXPECT().GetAllEnabled(t.Context()).Return(nil, nil)
+			mocks.accessListCommands.EXPECT().GetAll(t.Context()).Return(nil, nil)
+			mocks.cacheCommands.EXPECT().GetAllInUse(t.Context()).Return(nil, nil)
+			mocks.rateLimitCommands.EXPECT().GetAllInUse(t.Context()).Return(nil, nil)
+			mocks.securityHeadersCommands.EXPECT().GetAllInUse(t.Context()).Return(nil, nil)
+			mocks.upstreamCommands.EXPECT().GetAllInUse(t.Context()).Return(nil, nil)
+			mocks.tlsProfileCommands.EXPECT().GetAllInUse(t.Context()).Return(nil, nil)
+			mocks.settingsCommands.EXPECT().Get(t.Context()).Return(currentSettings, nil)
+
+			var saved *Revision
+			mocks.repository.EXPECT().
+				Save(t.Context(), gomock.Any()).
+				DoAndReturn(func(_ any, revision *Revision) error {
+					saved = revision
+					return nil
+				})
+			mocks.repository.EXPECT().DeleteOldest(t.Context(), 10).Return(nil)
+
+			err := revisionService.Record(t.Context(), files)
+
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, []File{files[0]}, saved.Files)
+			assert.Equal(t, buildChecksum([]File{files[0]}), saved.Checksum)
+			assert.Equal(t, hosts, saved.Snapshot.Hosts)
+			assert.Equal(t, currentSettings, saved.Snapshot.Settings)
+		})
+
+		t.Run("skips the recording when the files did not change", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			revisionService, mocks := newServiceWithMocks(ctrl)
+			mocks.repository.EXPECT().FindLatest(t.Context()).Return(&Summary{
+				Checksum: buildChecksum([]File{files[0]}),
+			}, nil)
+
+			err := revisionService.Record(t.Context(), files)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		t.Run("returns the differences between the revisions", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			from := newRevision()
+			to := newRevision()
+			to.Files[0].Contents = "events {\n}\n"
+
+			revisionService, mocks := newServiceWithMocks(ctrl)
+			mocks.repository.EXPECT().FindByID(t.Context(), from.ID).Return(from, nil)
+			mocks.repository.EXPECT().FindByID(t.Context(), to.ID).Return(to, nil)
+
+			result, err := revisionService.Diff(t.Context(), from.ID, to.ID)
+
+			require.NoError(t, err)
+			assert.Equal(t, from.ID, result.FromID)
+			assert.Equal(t, to.ID, result.ToID)
+			require.Len(t, result.Files, 1)
+			assert.Equal(t, ModifiedFileChangeType, result.Files[0].ChangeType)
+		})
+
+		t.Run("returns an error when a revision does not exist", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+			revisionService, mocks := newServiceWithMocks(ctrl)
+			mocks.repository.EXPECT().FindByID(t.Context(), id).Return(nil, nil)
+
+			_, err := revisionService.Diff(t.Context(), id, uuid.New())
+
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+			assert.True(t, coreErr.UserRelated)
+		})
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		t.Run("applies the snapshot and disables the hosts and streams absent from it",
+			func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				revision := newRevision()
+				extraHost := host.Host{ID: uuid.New(), Enabled: true}
+				extraStream := stream.Stream{ID: uuid.New(), Enabled: true}
+
+				revisionService, mocks := newServiceWithMocks(ctrl)
+				mocks.repository.EXPECT().FindByID(t.Context(), revision.ID).Return(revision, nil)
+				mocks.transactions.EXPECT().
+					Run(t.Context(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, action func(context.Context) error) error {
+						return action(ctx)
+					})
+				mocks.hostCommands.EXPECT().
+					GetAllEnabled(t.Context()).
+					Return([]host.Host{revision.Snapshot.Hosts[0], extraHost}, nil)
+				mocks.hostCommands.EXPECT().
+					Save(t.Context(), &host.Host{ID: extraHost.ID, Enabled: false}).
+					Return(nil)
+				mocks.hostCommands.EXPECT().
+					Save(t.Context(), &revision.Snapshot.Hosts[0]).
+					Return(nil)
+				mocks.streamCommands.EXPECT().
+					GetAllEnabled(t.Context()).
+					Return([]stream.Stream{extraStream}, nil)
+				mocks.streamCommands.EXPECT().
+					Save(t.Context(), &stream.Stream{ID: extraStream.ID, Enabled: false}).
+					Return(nil)
+				mocks.streamCommands.EXPECT().
+					Save(t.Context(), &revision.Snapshot.Streams[0]).
+					Return(nil)
+				mocks.settingsCommands.EXPECT().
+					Save(t.Context(), revision.Snapshot.Settings).
+					Return(nil)
+				mocks.reloader.EXPECT().Reload(t.Context(), false).Return(nil)
+
+				err := revisionService.Restore(t.Context(), revision.ID)
+
+				assert.NoError(t, err)
+			},
+		)
+
+		t.Run("fills the values absent from the snapshots of older versions", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			revision := newRevision()
+			revision.Snapshot.Streams = nil
+			revision.Snapshot.Hosts[0].Routes = []host.Route{{SourcePath: "/"}}
+			revision.Snapshot.Settings = &settings.Settings{
+				Nginx: &settings.NginxSettings{Logs: &settings.NginxLogsSettings{}},
+			}
+
+			revisionService, mocks := newServiceWithMocks(ctrl)
+			mocks.repository.EXPECT().FindByID(t.Context(), revision.ID).Return(revision, nil)
+			mocks.transactions.EXPECT().
+				Run(t.Context(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, action func(context.Context) error) error {
+					return action(ctx)
+				})
+			mocks.hostCommands.EXPECT().GetAllEnabled(t.Context()).Return(nil, nil)
+			mocks.hostCommands.EXPECT().
+				Save(t.Context(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, restored *host.Host) error {
+					assert.Equal(t, host.PrefixRouteMatchType, restored.Routes[0].MatchType)
+					return nil
+				})
+			mocks.streamCommands.EXPECT().GetAllEnabled(t.Context()).Return(nil, nil)
+			mocks.settingsCommands.EXPECT().
+				Save(t.Context(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, restored *settings.Settings) error {
+					assert.Equal(
+						t,
+						settings.CombinedAccessLogFormat,
+						restored.Nginx.Logs.AccessLogsFormat,
+					)
+					return nil
+				})
+			mocks.reloader.EXPECT().Reload(t.Context(), false).Return(nil)
+
+			err := revisionService.Restore(t.Context(), revision.ID)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("returns a reload error when nginx rejects the restored configuration",
+			func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				revision := newRevision()
+				revision.Snapshot.Hosts = nil
+				revision.Snapshot.Streams = nil
+
+				revisionService, mocks := newServiceWithMocks(ctrl)
+				mocks.repository.EXPECT().FindByID(t.Context(), revision.ID).Return(revision, nil)
+				mocks.transactions.EXPECT().
+					Run(t.Context(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, action func(context.Context) error) error {
+						return action(ctx)
+					})
+				mocks.hostCommands.EXPECT().GetAllEnabled(t.Context()).Return(nil, nil)
+				mocks.streamCommands.EXPECT().GetAllEnabled(t.Context()).Return(nil, nil)
+				mocks.settingsCommands.EXPECT().
+					Save(t.Context(), revision.Snapshot.Settings).
+					Return(nil)
+				mocks.reloader.EXPECT().Reload(t.Context(), false).Return(assert.AnError)
+
+				err := revisionService.Restore(t.Context(), revision.ID)
+
+				assert.ErrorAs(t, err, new(*ReloadError))
+				assert.ErrorIs(t, err, assert.AnError)
+			},
+		)
+
+		t.Run("returns an error when the revision does not exist", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+			revisionService, mocks := newServiceWithMocks(ctrl)
+			mocks.repository.EXPECT().FindByID(t.Context(), id).Return(nil, nil)
+
+			err := revisionService.Restore(t.Context(), id)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("returns the error of the transaction when a save fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			revision := newRevision()
+			revisionService, mocks := newServiceWithMocks(ctrl)
+			mocks.repository.EXPECT().FindByID(t.Context(), revision.ID).Return(revision, nil)
+			mocks.transactions.EXPECT().
+				Run(t.Context(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, action func(context.Context) error) error {
+					return action(ctx)
+				})
+			mocks.hostCommands.EXPECT().GetAllEnabled(t.Context()).Return(nil, nil)
+			mocks.hostCommands.EXPECT().Save(t.Context(), gomock.Any()).Return(assert.AnError)
+
+			err := revisionService.Restore(t.Context(), revision.ID)
+
+			assert.ErrorIs(t, err, assert.AnError)
+		})
+	})
+}
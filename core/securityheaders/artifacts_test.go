@@ -0,0 +1,28 @@
+package securityheaders
+
+import (
+	"github.com/google/uuid"
+)
+
+func newSecurityHeaders() *SecurityHeaders {
+	frameOptions := SameOriginFrameOptions
+	referrerPolicy := StrictOriginWhenCrossOriginPolicy
+
+	return &SecurityHeaders{
+		ID:                 uuid.New(),
+		Name:               "Default web application",
+		FrameOptions:       &frameOptions,
+		ReferrerPolicy:     &referrerPolicy,
+		PermissionsPolicy:  new("camera=(), microphone=()"),
+		ContentTypeNoSniff: true,
+		ContentSecurityPolicy: ContentSecurityPolicy{
+			Enabled:        true,
+			CollectReports: true,
+			Directives: []Directive{
+				{Name: "default-src", Sources: []string{"'self'"}},
+				{Name: "img-src", Sources: []string{"'self'", "data:", "https://cdn.example.com"}},
+				{Name: "upgrade-insecure-requests"},
+			},
+		},
+	}
+}
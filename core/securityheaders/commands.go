@@ -0,0 +1,29 @@
+package securityheaders
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type Commands interface {
+	Delete(ctx context.Context, id uuid.UUID) error
+	Get(ctx context.Context, id uuid.UUID) (*SecurityHeaders, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+	List(
+		ctx context.Context,
+		pageSize, pageNumber int,
+		searchTerms *string,
+	) (*pagination.Page[SecurityHeaders], error)
+	GetAllInUse(ctx context.Context) ([]SecurityHeaders, error)
+	Save(ctx context.Context, securityHeaders *SecurityHeaders) error
+	SaveReports(ctx context.Context, id uuid.UUID, reports []Report) error
+	ListReports(
+		ctx context.Context,
+		id uuid.UUID,
+		pageSize, pageNumber int,
+	) (*pagination.Page[Report], error)
+	DeleteReports(ctx context.Context, id uuid.UUID) error
+}
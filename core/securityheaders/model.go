@@ -0,0 +1,65 @@
+package securityheaders
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FrameOptions string
+
+const (
+	DenyFrameOptions       FrameOptions = "DENY"
+	SameOriginFrameOptions FrameOptions = "SAMEORIGIN"
+)
+
+type ReferrerPolicy string
+
+const (
+	NoReferrerPolicy                  ReferrerPolicy = "NO_REFERRER"
+	NoReferrerWhenDowngradePolicy     ReferrerPolicy = "NO_REFERRER_WHEN_DOWNGRADE"
+	OriginPolicy                      ReferrerPolicy = "ORIGIN"
+	OriginWhenCrossOriginPolicy       ReferrerPolicy = "ORIGIN_WHEN_CROSS_ORIGIN"
+	SameOriginPolicy                  ReferrerPolicy = "SAME_ORIGIN"
+	StrictOriginPolicy                ReferrerPolicy = "STRICT_ORIGIN"
+	StrictOriginWhenCrossOriginPolicy ReferrerPolicy = "STRICT_ORIGIN_WHEN_CROSS_ORIGIN"
+	UnsafeURLPolicy                   ReferrerPolicy = "UNSAFE_URL"
+)
+
+type SecurityHeaders struct {
+	FrameOptions          *FrameOptions
+	ReferrerPolicy        *ReferrerPolicy
+	PermissionsPolicy     *string
+	Name                  string
+	ContentSecurityPolicy ContentSecurityPolicy
+	ID                    uuid.UUID
+	ContentTypeNoSniff    bool
+}
+
+type ContentSecurityPolicy struct {
+	ReportURI      *string
+	ReportTo       *string
+	Directives     []Directive
+	Enabled        bool
+	ReportOnly     bool
+	CollectReports bool
+}
+
+type Directive struct {
+	Name    string
+	Sources []string
+}
+
+type Report struct {
+	CreatedAt          time.Time
+	DocumentURI        *string
+	BlockedURI         *string
+	EffectiveDirective *string
+	OriginalPolicy     *string
+	Disposition        *string
+	SourceFile         *string
+	LineNumber         *int
+	UserAgent          *string
+	ID                 uuid.UUID
+	SecurityHeadersID  uuid.UUID
+}
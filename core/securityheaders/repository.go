@@ -0,0 +1,31 @@
+package securityheaders
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type Repository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*SecurityHeaders, error)
+	InUseByID(ctx context.Context, id uuid.UUID) (bool, error)
+	ExistsByID(ctx context.Context, id uuid.UUID) (bool, error)
+	DeleteByID(ctx context.Context, id uuid.UUID) error
+	FindPage(
+		ctx context.Context,
+		pageNumber, pageSize int,
+		searchTerms *string,
+	) (*pagination.Page[SecurityHeaders], error)
+	FindAllInUse(ctx context.Context) ([]SecurityHeaders, error)
+	Save(ctx context.Context, securityHeaders *SecurityHeaders) error
+	SaveReport(ctx context.Context, report *Report) error
+	FindReportsPage(
+		ctx context.Context,
+		id uuid.UUID,
+		pageNumber, pageSize int,
+	) (*pagination.Page[Report], error)
+	DeleteReportsByID(ctx context.Context, id uuid.UUID) error
+	DeleteExceedingReports(ctx context.Context, id uuid.UUID, maximumAmount int) error
+}
@@ -0,0 +1,98 @@
+package securityheaders
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+const maximumStoredReports = 1000
+
+type service struct {
+	repository Repository
+}
+
+func newCommands(repository Repository) Commands {
+	return &service{
+		repository: repository,
+	}
+}
+
+func (s *service) Save(ctx context.Context, h *SecurityHeaders) error {
+	if err := newValidator().validate(ctx, h); err != nil {
+		return err
+	}
+
+	return s.repository.Save(ctx, h)
+}
+
+func (s *service) Delete(ctx context.Context, id uuid.UUID) error {
+	inUse, err := s.repository.InUseByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if inUse {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreSecurityheadersInUse), true)
+	}
+
+	return s.repository.DeleteByID(ctx, id)
+}
+
+func (s *service) Get(ctx context.Context, id uuid.UUID) (*SecurityHeaders, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+func (s *service) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	return s.repository.ExistsByID(ctx, id)
+}
+
+func (s *service) List(
+	ctx context.Context,
+	pageSize,
+	pageNumber int,
+	searchTerms *string,
+) (*pagination.Page[SecurityHeaders], error) {
+	return s.repository.FindPage(ctx, pageNumber, pageSize, searchTerms)
+}
+
+func (s *service) GetAllInUse(ctx context.Context) ([]SecurityHeaders, error) {
+	return s.repository.FindAllInUse(ctx)
+}
+
+func (s *service) SaveReports(ctx context.Context, id uuid.UUID, reports []Report) error {
+	h, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if h == nil || !h.ContentSecurityPolicy.Enabled || !h.ContentSecurityPolicy.CollectReports {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreSecurityheadersReportCollectionDisabled), true)
+	}
+
+	for index := range reports {
+		reports[index].SecurityHeadersID = id
+		if err = s.repository.SaveReport(ctx, &reports[index]); err != nil {
+			return err
+		}
+	}
+
+	return s.repository.DeleteExceedingReports(ctx, id, maximumStoredReports)
+}
+
+func (s *service) ListReports(
+	ctx context.Context,
+	id uuid.UUID,
+	pageSize,
+	pageNumber int,
+) (*pagination.Page[Report], error) {
+	return s.repository.FindReportsPage(ctx, id, pageNumber, pageSize)
+}
+
+func (s *service) DeleteReports(ctx context.Context, id uuid.UUID) error {
+	return s.repository.DeleteReportsByID(ctx, id)
+}
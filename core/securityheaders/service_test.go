@@ -0,0 +1,305 @@
+package securityheaders
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+func Test_service(t *testing.T) {
+	t.Run("Save", func(t *testing.T) {
+		t.Run("valid security headers saves successfully", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			securityHeaders := newSecurityHeaders()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Save(t.Context(), securityHeaders).Return(nil)
+
+			securityHeadersService := newCommands(repository)
+			err := securityHeadersService.Save(t.Context(), securityHeaders)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("invalid security headers returns validation error", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.Name = ""
+
+			repository := NewMockedRepository(ctrl)
+			securityHeadersService := newCommands(repository)
+			err := securityHeadersService.Save(t.Context(), securityHeaders)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("repository error is returned", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			securityHeaders := newSecurityHeaders()
+			expectedErr := errors.New("repository error")
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Save(t.Context(), securityHeaders).Return(expectedErr)
+
+			securityHeadersService := newCommands(repository)
+			err := securityHeadersService.Save(t.Context(), securityHeaders)
+
+			assert.Equal(t, expectedErr, err)
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("deletes successfully when not in use", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().InUseByID(t.Context(), id).Return(false, nil)
+			repository.EXPECT().DeleteByID(t.Context(), id).Return(nil)
+
+			securityHeadersService := newCommands(repository)
+			err := securityHeadersService.Delete(t.Context(), id)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("returns error when in use", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().InUseByID(t.Context(), id).Return(true, nil)
+
+			securityHeadersService := newCommands(repository)
+			err := securityHeadersService.Delete(t.Context(), id)
+
+			require.Error(t, err)
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+			assert.Equal(t, i18n.K.CoreSecurityheadersInUse, coreErr.Message.Key)
+		})
+
+		t.Run("returns error when InUseByID fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+			expectedErr := errors.New("check failed")
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().InUseByID(t.Context(), id).Return(false, expectedErr)
+
+			securityHeadersService := newCommands(repository)
+			err := securityHeadersService.Delete(t.Context(), id)
+
+			assert.Equal(t, expectedErr, err)
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("returns security headers when found", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := newSecurityHeaders()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), expected.ID).Return(expected, nil)
+
+			securityHeadersService := newCommands(repository)
+			result, err := securityHeadersService.Get(t.Context(), expected.ID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+
+		t.Run("returns error when repository fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+			expectedErr := errors.New("not found")
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), id).Return(nil, expectedErr)
+
+			securityHeadersService := newCommands(repository)
+			result, err := securityHeadersService.Get(t.Context(), id)
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+			assert.Equal(t, expectedErr, err)
+		})
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Run("returns paginated results", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expectedPage := pagination.Of([]SecurityHeaders{*newSecurityHeaders()})
+			searchTerms := "test"
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindPage(t.Context(), 1, 10, &searchTerms).Return(expectedPage, nil)
+
+			securityHeadersService := newCommands(repository)
+			result, err := securityHeadersService.List(t.Context(), 10, 1, &searchTerms)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expectedPage, result)
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		t.Run("returns true when exists", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().ExistsByID(t.Context(), id).Return(true, nil)
+
+			securityHeadersService := newCommands(repository)
+			exists, err := securityHeadersService.Exists(t.Context(), id)
+
+			assert.NoError(t, err)
+			assert.True(t, exists)
+		})
+
+		t.Run("returns false when not exists", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().ExistsByID(t.Context(), id).Return(false, nil)
+
+			securityHeadersService := newCommands(repository)
+			exists, err := securityHeadersService.Exists(t.Context(), id)
+
+			assert.NoError(t, err)
+			assert.False(t, exists)
+		})
+	})
+
+	t.Run("GetAllInUse", func(t *testing.T) {
+		t.Run("returns all in use security headers", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := []SecurityHeaders{*newSecurityHeaders(), *newSecurityHeaders()}
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindAllInUse(t.Context()).Return(expected, nil)
+
+			securityHeadersService := newCommands(repository)
+			result, err := securityHeadersService.GetAllInUse(t.Context())
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+	})
+
+	t.Run("SaveReports", func(t *testing.T) {
+		t.Run("saves the reports and trims the exceeding ones", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			securityHeaders := newSecurityHeaders()
+			reports := []Report{{ID: uuid.New()}, {ID: uuid.New()}}
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				FindByID(t.Context(), securityHeaders.ID).
+				Return(securityHeaders, nil)
+			repository.EXPECT().SaveReport(t.Context(), &reports[0]).Return(nil)
+			repository.EXPECT().SaveReport(t.Context(), &reports[1]).Return(nil)
+			repository.EXPECT().
+				DeleteExceedingReports(t.Context(), securityHeaders.ID, maximumStoredReports).
+				Return(nil)
+
+			securityHeadersService := newCommands(repository)
+			err := securityHeadersService.SaveReports(t.Context(), securityHeaders.ID, reports)
+
+			assert.NoError(t, err)
+			assert.Equal(t, securityHeaders.ID, reports[0].SecurityHeadersID)
+			assert.Equal(t, securityHeaders.ID, reports[1].SecurityHeadersID)
+		})
+
+		t.Run("returns error when report collection is disabled", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.ContentSecurityPolicy.CollectReports = false
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				FindByID(t.Context(), securityHeaders.ID).
+				Return(securityHeaders, nil)
+
+			securityHeadersService := newCommands(repository)
+			err := securityHeadersService.SaveReports(t.Context(), securityHeaders.ID, []Report{{}})
+
+			require.Error(t, err)
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+			assert.Equal(t, i18n.K.CoreSecurityheadersReportCollectionDisabled, coreErr.Message.Key)
+		})
+
+		t.Run("returns error when security headers are not found", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), id).Return(nil, nil)
+
+			securityHeadersService := newCommands(repository)
+			err := securityHeadersService.SaveReports(t.Context(), id, []Report{{}})
+
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("ListReports", func(t *testing.T) {
+		t.Run("returns paginated results", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+			expectedPage := pagination.Of([]Report{{ID: uuid.New(), SecurityHeadersID: id}})
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindReportsPage(t.Context(), id, 2, 25).Return(expectedPage, nil)
+
+			securityHeadersService := newCommands(repository)
+			result, err := securityHeadersService.ListReports(t.Context(), id, 25, 2)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expectedPage, result)
+		})
+	})
+}
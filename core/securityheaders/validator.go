@@ -0,0 +1,186 @@
+package securityheaders
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/validation"
+)
+
+type directiveSources int
+
+const (
+	requiredDirectiveSources directiveSources = iota
+	optionalDirectiveSources
+	forbiddenDirectiveSources
+)
+
+var (
+	sourcePattern = regexp.MustCompile(`^[^\s;,"]+$`)
+	directives    = map[string]directiveSources{
+		"base-uri":                  requiredDirectiveSources,
+		"child-src":                 requiredDirectiveSources,
+		"connect-src":               requiredDirectiveSources,
+		"default-src":               requiredDirectiveSources,
+		"font-src":                  requiredDirectiveSources,
+		"form-action":               requiredDirectiveSources,
+		"frame-ancestors":           requiredDirectiveSources,
+		"frame-src":                 requiredDirectiveSources,
+		"img-src":                   requiredDirectiveSources,
+		"manifest-src":              requiredDirectiveSources,
+		"media-src":                 requiredDirectiveSources,
+		"object-src":                requiredDirectiveSources,
+		"require-trusted-types-for": requiredDirectiveSources,
+		"sandbox":                   optionalDirectiveSources,
+		"script-src":                requiredDirectiveSources,
+		"script-src-attr":           requiredDirectiveSources,
+		"script-src-elem":           requiredDirectiveSources,
+		"style-src":                 requiredDirectiveSources,
+		"style-src-attr":            requiredDirectiveSources,
+		"style-src-elem":            requiredDirectiveSources,
+		"trusted-types":             optionalDirectiveSources,
+		"upgrade-insecure-requests": forbiddenDirectiveSources,
+		"worker-src":                requiredDirectiveSources,
+	}
+)
+
+type validator struct {
+	delegate *validation.ConsistencyValidator
+}
+
+func newValidator() *validator {
+	return &validator{
+		delegate: validation.NewValidator(),
+	}
+}
+
+func (v *validator) validate(ctx context.Context, h *SecurityHeaders) error {
+	if strings.TrimSpace(h.Name) == "" {
+		v.delegate.Add("name", i18n.M(ctx, i18n.K.CommonValueMissing))
+	}
+
+	if h.FrameOptions != nil {
+		switch *h.FrameOptions {
+		case DenyFrameOptions, SameOriginFrameOptions:
+			// Valid
+		default:
+			v.delegate.Add("frameOptions", i18n.M(ctx, i18n.K.CommonInvalidValue))
+		}
+	}
+
+	if h.ReferrerPolicy != nil {
+		switch *h.ReferrerPolicy {
+		case NoReferrerPolicy,
+			NoReferrerWhenDowngradePolicy,
+			OriginPolicy,
+			OriginWhenCrossOriginPolicy,
+			SameOriginPolicy,
+			StrictOriginPolicy,
+			StrictOriginWhenCrossOriginPolicy,
+			UnsafeURLPolicy:
+			// Valid
+		default:
+			v.delegate.Add("referrerPolicy", i18n.M(ctx, i18n.K.CommonInvalidValue))
+		}
+	}
+
+	if h.PermissionsPolicy != nil && strings.ContainsAny(*h.PermissionsPolicy, "\r\n") {
+		v.delegate.Add("permissionsPolicy", i18n.M(ctx, i18n.K.CommonLineBreaksNotAllowed))
+	}
+
+	v.validateContentSecurityPolicy(ctx, &h.ContentSecurityPolicy)
+
+	return v.delegate.Result()
+}
+
+func (v *validator) validateContentSecurityPolicy(ctx context.Context, csp *ContentSecurityPolicy) {
+	if !csp.Enabled {
+		return
+	}
+
+	if len(csp.Directives) == 0 {
+		v.delegate.Add("contentSecurityPolicy.directives", i18n.M(ctx, i18n.K.CommonValueMissing))
+	}
+
+	names := make(map[string]bool)
+	for index, directive := range csp.Directives {
+		v.validateDirective(ctx, &directive, index, names)
+	}
+
+	v.validateReportDestination(ctx, csp.ReportURI, "contentSecurityPolicy.reportUri")
+	v.validateReportDestination(ctx, csp.ReportTo, "contentSecurityPolicy.reportTo")
+
+	if csp.ReportOnly && csp.ReportURI == nil && csp.ReportTo == nil && !csp.CollectReports {
+		v.delegate.Add(
+			"contentSecurityPolicy.reportOnly",
+			i18n.M(ctx, i18n.K.CoreSecurityheadersReportDestinationMissing),
+		)
+	}
+}
+
+func (v *validator) validateDirective(
+	ctx context.Context,
+	directive *Directive,
+	index int,
+	names map[string]bool,
+) {
+	basePath := fmt.Sprintf("contentSecurityPolicy.directives[%d]", index)
+	name := strings.ToLower(strings.TrimSpace(directive.Name))
+
+	sources, known := directives[name]
+	switch {
+	case name == "":
+		v.delegate.Add(basePath+".name", i18n.M(ctx, i18n.K.CommonValueMissing))
+		return
+	case !known:
+		v.delegate.Add(basePath+".name", i18n.M(ctx, i18n.K.CoreSecurityheadersUnknownDirective))
+		return
+	case names[name]:
+		v.delegate.Add(basePath+".name", i18n.M(ctx, i18n.K.CoreSecurityheadersDuplicatedDirective))
+	}
+
+	names[name] = true
+
+	switch {
+	case sources == forbiddenDirectiveSources && len(directive.Sources) > 0:
+		v.delegate.Add(
+			basePath+".sources",
+			i18n.M(ctx, i18n.K.CoreSecurityheadersSourcesNotAccepted),
+		)
+		return
+	case sources == requiredDirectiveSources && len(directive.Sources) == 0:
+		v.delegate.Add(basePath+".sources", i18n.M(ctx, i18n.K.CommonValueMissing))
+		return
+	}
+
+	for sourceIndex, source := range directive.Sources {
+		if !sourcePattern.MatchString(source) {
+			v.delegate.Add(
+				fmt.Sprintf("%s.sources[%d]", basePath, sourceIndex),
+				i18n.M(ctx, i18n.K.CoreSecurityheadersInvalidSource),
+			)
+		}
+	}
+}
+
+func (v *validator) validateReportDestination(ctx context.Context, value *string, path string) {
+	if value == nil {
+		return
+	}
+
+	if strings.HasPrefix(*value, "/") && sourcePattern.MatchString(*value) {
+		return
+	}
+
+	parsed, err := url.Parse(*value)
+	if err != nil ||
+		(parsed.Scheme != "http" && parsed.Scheme != "https") ||
+		parsed.Host == "" ||
+		!sourcePattern.MatchString(*value) {
+		v.delegate.Add(path, i18n.M(ctx, i18n.K.CoreSecurityheadersInvalidReportDestination))
+	}
+}
@@ -0,0 +1,172 @@
+package securityheaders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validator(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		t.Run("valid security headers pass", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("empty name fails", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.Name = "   "
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("invalid frame options fails", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			frameOptions := FrameOptions("ALLOW-FROM")
+			securityHeaders.FrameOptions = &frameOptions
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("invalid referrer policy fails", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			referrerPolicy := ReferrerPolicy("NEVER")
+			securityHeaders.ReferrerPolicy = &referrerPolicy
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("permissions policy with line breaks fails", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.PermissionsPolicy = new("camera=()\nmicrophone=()")
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("disabled content security policy is not validated", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.ContentSecurityPolicy = ContentSecurityPolicy{
+				Directives: []Directive{{Name: "unknown-src"}},
+			}
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("enabled content security policy without directives fails", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.ContentSecurityPolicy.Directives = nil
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("unknown directive fails", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.ContentSecurityPolicy.Directives = []Directive{
+				{Name: "unknown-src", Sources: []string{"'self'"}},
+			}
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("duplicated directive fails", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.ContentSecurityPolicy.Directives = []Directive{
+				{Name: "script-src", Sources: []string{"'self'"}},
+				{Name: "Script-Src", Sources: []string{"'none'"}},
+			}
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("directive without required sources fails", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.ContentSecurityPolicy.Directives = []Directive{{Name: "script-src"}}
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("directive with forbidden sources fails", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.ContentSecurityPolicy.Directives = []Directive{
+				{Name: "upgrade-insecure-requests", Sources: []string{"'self'"}},
+			}
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("source with separators fails", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			securityHeaders.ContentSecurityPolicy.Directives = []Directive{
+				{Name: "script-src", Sources: []string{"'self'; object-src *"}},
+			}
+
+			err := newValidator().validate(t.Context(), securityHeaders)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("report destinations", func(t *testing.T) {
+			t.Run("absolute URL passes", func(t *testing.T) {
+				securityHeaders := newSecurityHeaders()
+				securityHeaders.ContentSecurityPolicy.ReportURI = new(
+					"https://reports.example.com/csp",
+				)
+
+				err := newValidator().validate(t.Context(), securityHeaders)
+
+				assert.NoError(t, err)
+			})
+
+			t.Run("path passes", func(t *testing.T) {
+				securityHeaders := newSecurityHeaders()
+				securityHeaders.ContentSecurityPolicy.ReportTo = new("/csp-reports")
+
+				err := newValidator().validate(t.Context(), securityHeaders)
+
+				assert.NoError(t, err)
+			})
+
+			t.Run("URL with unsupported scheme fails", func(t *testing.T) {
+				securityHeaders := newSecurityHeaders()
+				securityHeaders.ContentSecurityPolicy.ReportURI = new("ftp://reports.example.com")
+
+				err := newValidator().validate(t.Context(), securityHeaders)
+
+				assert.Error(t, err)
+			})
+
+			t.Run("report-only mode without destination fails", func(t *testing.T) {
+				securityHeaders := newSecurityHeaders()
+				securityHeaders.ContentSecurityPolicy.ReportOnly = true
+				securityHeaders.ContentSecurityPolicy.CollectReports = false
+
+				err := newValidator().validate(t.Context(), securityHeaders)
+
+				assert.Error(t, err)
+			})
+		})
+	})
+}
@@ -0,0 +1,18 @@
+package session
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func newSession() *Session {
+	return &Session{
+		ID:            uuid.New(),
+		UserID:        uuid.New(),
+		UserAgent:     "Mozilla/5.0",
+		SourceAddress: "192.168.0.10",
+		CreatedAt:     time.Now(),
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+}
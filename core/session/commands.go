@@ -0,0 +1,17 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Commands interface {
+	Create(ctx context.Context, session *Session) error
+	IsActive(ctx context.Context, id uuid.UUID) (bool, error)
+	Extend(ctx context.Context, id uuid.UUID, expiresAt time.Time) error
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]Session, error)
+	Revoke(ctx context.Context, userID, id uuid.UUID) error
+	RevokeAll(ctx context.Context, userID uuid.UUID, exceptID *uuid.UUID) error
+}
@@ -0,0 +1,18 @@
+package session
+
+import (
+	"dillmann.com.br/nginx-ignition/core/common/container"
+)
+
+func Install() error {
+	if err := container.Provide(newCommands); err != nil {
+		return err
+	}
+
+	return container.Run(registerScheduledTask)
+}
+
+func newCommands(repository Repository) (Commands, *service) {
+	serviceInstance := newService(repository)
+	return serviceInstance, serviceInstance
+}
@@ -0,0 +1,16 @@
+package session
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Session struct {
+	CreatedAt     time.Time
+	ExpiresAt     time.Time
+	UserAgent     string
+	SourceAddress string
+	ID            uuid.UUID
+	UserID        uuid.UUID
+}
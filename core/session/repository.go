@@ -0,0 +1,18 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*Session, error)
+	FindActiveByUserID(ctx context.Context, userID uuid.UUID, now time.Time) ([]Session, error)
+	UpdateExpiresAt(ctx context.Context, id uuid.UUID, expiresAt time.Time) error
+	DeleteByID(ctx context.Context, id uuid.UUID) error
+	DeleteByUserID(ctx context.Context, userID uuid.UUID, exceptID *uuid.UUID) error
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+	Save(ctx context.Context, session *Session) error
+}
@@ -0,0 +1,85 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+)
+
+const (
+	maximumUserAgentSize = 512
+)
+
+type service struct {
+	repository Repository
+}
+
+func newService(repository Repository) *service {
+	return &service{
+		repository: repository,
+	}
+}
+
+func (s *service) Create(ctx context.Context, session *Session) error {
+	if len(session.UserAgent) > maximumUserAgentSize {
+		session.UserAgent = session.UserAgent[:maximumUserAgentSize]
+	}
+
+	if session.CreatedAt.IsZero() {
+		session.CreatedAt = time.Now()
+	}
+
+	return s.repository.Save(ctx, session)
+}
+
+func (s *service) IsActive(ctx context.Context, id uuid.UUID) (bool, error) {
+	session, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return session != nil && session.ExpiresAt.After(time.Now()), nil
+}
+
+func (s *service) Extend(ctx context.Context, id uuid.UUID, expiresAt time.Time) error {
+	return s.repository.UpdateExpiresAt(ctx, id, expiresAt)
+}
+
+func (s *service) ListByUser(ctx context.Context, userID uuid.UUID) ([]Session, error) {
+	return s.repository.FindActiveByUserID(ctx, userID, time.Now())
+}
+
+func (s *service) Revoke(ctx context.Context, userID, id uuid.UUID) error {
+	session, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if session == nil || session.UserID != userID {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreSessionNotFound), true)
+	}
+
+	return s.repository.DeleteByID(ctx, id)
+}
+
+func (s *service) RevokeAll(ctx context.Context, userID uuid.UUID, exceptID *uuid.UUID) error {
+	return s.repository.DeleteByUserID(ctx, userID, exceptID)
+}
+
+func (s *service) purgeExpired(ctx context.Context) error {
+	count, err := s.repository.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		log.Infof("Purged %d expired user sessions", count)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package session
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+)
+
+func Test_service(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		t.Run("truncates long user agents", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			session := newSession()
+			session.UserAgent = strings.Repeat("a", maximumUserAgentSize+10)
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Save(t.Context(), session).Return(nil)
+
+			err := newService(repository).Create(t.Context(), session)
+
+			require.NoError(t, err)
+			assert.Len(t, session.UserAgent, maximumUserAgentSize)
+		})
+
+		t.Run("defines the creation date when missing", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			session := newSession()
+			session.CreatedAt = time.Time{}
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Save(t.Context(), session).Return(nil)
+
+			err := newService(repository).Create(t.Context(), session)
+
+			require.NoError(t, err)
+			assert.False(t, session.CreatedAt.IsZero())
+		})
+	})
+
+	t.Run("IsActive", func(t *testing.T) {
+		t.Run("returns true for an existing session", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			session := newSession()
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), session.ID).Return(session, nil)
+
+			active, err := newService(repository).IsActive(t.Context(), session.ID)
+
+			require.NoError(t, err)
+			assert.True(t, active)
+		})
+
+		t.Run("returns false for an expired session", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			session := newSession()
+			session.ExpiresAt = time.Now().Add(-time.Minute)
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), session.ID).Return(session, nil)
+
+			active, err := newService(repository).IsActive(t.Context(), session.ID)
+
+			require.NoError(t, err)
+			assert.False(t, active)
+		})
+
+		t.Run("returns false for a revoked session", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), id).Return(nil, nil)
+
+			active, err := newService(repository).IsActive(t.Context(), id)
+
+			require.NoError(t, err)
+			assert.False(t, active)
+		})
+	})
+
+	t.Run("Revoke", func(t *testing.T) {
+		t.Run("deletes a session owned by the user", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			session := newSession()
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), session.ID).Return(session, nil)
+			repository.EXPECT().DeleteByID(t.Context(), session.ID).Return(nil)
+
+			err := newService(repository).Revoke(t.Context(), session.UserID, session.ID)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("returns not found for a session of another user", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			session := newSession()
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), session.ID).Return(session, nil)
+
+			err := newService(repository).Revoke(t.Context(), uuid.New(), session.ID)
+
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+		})
+	})
+
+	t.Run("RevokeAll", func(t *testing.T) {
+		t.Run("deletes the sessions of the user except the informed one", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userID := uuid.New()
+			exceptID := uuid.New()
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().DeleteByUserID(t.Context(), userID, &exceptID).Return(nil)
+
+			err := newService(repository).RevokeAll(t.Context(), userID, &exceptID)
+
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("purgeExpired", func(t *testing.T) {
+		t.Run("deletes the expired sessions", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().DeleteExpired(t.Context(), gomock.Any()).Return(3, nil)
+
+			err := newService(repository).purgeExpired(t.Context())
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("returns the repository error", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().DeleteExpired(t.Context(), gomock.Any()).Return(0, assert.AnError)
+
+			err := newService(repository).purgeExpired(t.Context())
+
+			assert.ErrorIs(t, err, assert.AnError)
+		})
+	})
+}
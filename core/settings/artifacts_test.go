@@ -27,5 +27,40 @@ func newSettings() *Settings {
 		CertificateAutoRenew: &CertificateAutoRenewSettings{
 			IntervalUnitCount: 1,
 		},
+		Backup: &BackupSettings{
+			Destination:       LocalBackupDestination,
+			IntervalUnit:      DaysTimeUnit,
+			IntervalUnitCount: 1,
+			MaximumCount:      7,
+		},
+	}
+}
+
+func newBackupS3Settings() *BackupS3Settings {
+	return &BackupS3Settings{
+		Endpoint:  "http://localhost:9000",
+		Region:    "us-east-1",
+		Bucket:    "backups",
+		AccessKey: "access-key",
+		SecretKey: new("secret-key"),
+	}
+}
+
+func newLogsSettings(destinations ...LogDestination) *NginxLogsSettings {
+	return &NginxLogsSettings{
+		AccessLogsFormat: CombinedAccessLogFormat,
+		Destinations:     destinations,
+	}
+}
+
+func newSyslogDestination() LogDestination {
+	return LogDestination{
+		Type:       SyslogLogDestinationType,
+		Protocol:   UDPLogDestinationProtocol,
+		Address:    "127.0.0.1:514",
+		Facility:   "local7",
+		Tag:        new("nginx"),
+		AccessLogs: true,
+		ErrorLogs:  true,
 	}
 }
@@ -1,6 +1,8 @@
 package settings
 
 import (
+	"github.com/google/uuid"
+
 	"dillmann.com.br/nginx-ignition/core/binding"
 )
 
@@ -8,6 +10,7 @@ type Settings struct {
 	Nginx                *NginxSettings
 	LogRotation          *LogRotationSettings
 	CertificateAutoRenew *CertificateAutoRenewSettings
+	Backup               *BackupSettings
 	GlobalBindings       []binding.Binding
 }
 
@@ -49,6 +52,26 @@ type CertificateAutoRenewSettings struct {
 	Enabled           bool
 }
 
+type BackupSettings struct {
+	LocalPath         *string
+	S3                *BackupS3Settings
+	Destination       BackupDestination
+	IntervalUnit      TimeUnit
+	IntervalUnitCount int
+	MaximumCount      int
+	MaximumAgeDays    int
+	Enabled           bool
+}
+
+type BackupS3Settings struct {
+	Prefix    *string
+	SecretKey *string
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+}
+
 type NginxTimeoutsSettings struct {
 	Read       int
 	Connect    int
@@ -70,13 +93,60 @@ type NginxBufferSize struct {
 }
 
 type NginxLogsSettings struct {
+	Destinations      []LogDestination
 	ServerLogsLevel   LogLevel
 	ErrorLogsLevel    LogLevel
+	AccessLogsFormat  AccessLogFormat
 	ServerLogsEnabled bool
 	AccessLogsEnabled bool
 	ErrorLogsEnabled  bool
 }
 
+type LogDestination struct {
+	HostID     *uuid.UUID
+	Tag        *string
+	Type       LogDestinationType
+	Protocol   LogDestinationProtocol
+	Address    string
+	Facility   string
+	AccessLogs bool
+	ErrorLogs  bool
+}
+
+type LogDestinationType string
+
+const (
+	SyslogLogDestinationType LogDestinationType = "SYSLOG"
+	OTLPLogDestinationType   LogDestinationType = "OTLP"
+)
+
+type LogDestinationProtocol string
+
+const (
+	UDPLogDestinationProtocol  LogDestinationProtocol = "UDP"
+	TCPLogDestinationProtocol  LogDestinationProtocol = "TCP"
+	UnixLogDestinationProtocol LogDestinationProtocol = "UNIX"
+	HTTPLogDestinationProtocol LogDestinationProtocol = "HTTP"
+)
+
+var SyslogFacilities = []string{
+	"kern", "user", "mail", "daemon", "auth", "intern", "lpr", "news", "uucp", "clock",
+	"authpriv", "ftp", "ntp", "audit", "alert", "cron", "local0", "local1", "local2",
+	"local3", "local4", "local5", "local6", "local7",
+}
+
+func (d *LogDestination) AppliesTo(hostID *uuid.UUID) bool {
+	if d.HostID == nil {
+		return true
+	}
+
+	return hostID != nil && *d.HostID == *hostID
+}
+
+func (d *LogDestination) IsNative() bool {
+	return d.Type == SyslogLogDestinationType && d.Protocol != TCPLogDestinationProtocol
+}
+
 type LogLevel string
 
 const (
@@ -87,6 +157,13 @@ const (
 	EmergLogLevel LogLevel = "EMERG"
 )
 
+type AccessLogFormat string
+
+const (
+	CombinedAccessLogFormat AccessLogFormat = "COMBINED"
+	JSONAccessLogFormat     AccessLogFormat = "JSON"
+)
+
 type TimeUnit string
 
 const (
@@ -94,3 +171,10 @@ const (
 	HoursTimeUnit   TimeUnit = "HOURS"
 	DaysTimeUnit    TimeUnit = "DAYS"
 )
+
+type BackupDestination string
+
+const (
+	LocalBackupDestination BackupDestination = "LOCAL"
+	S3BackupDestination    BackupDestination = "S3"
+)
@@ -5,22 +5,26 @@ import (
 
 	"dillmann.com.br/nginx-ignition/core/binding"
 	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+	"dillmann.com.br/nginx-ignition/core/host"
 )
 
 type service struct {
 	repository      Repository
 	bindingCommands binding.Commands
+	hostCommands    host.Commands
 	scheduler       *scheduler.Scheduler
 }
 
 func newCommands(
 	repository Repository,
 	bindingCommands binding.Commands,
+	hostCommands host.Commands,
 	sched *scheduler.Scheduler,
 ) Commands {
 	return &service{
 		repository:      repository,
 		bindingCommands: bindingCommands,
+		hostCommands:    hostCommands,
 		scheduler:       sched,
 	}
 }
@@ -30,7 +34,11 @@ func (s *service) Get(ctx context.Context) (*Settings, error) {
 }
 
 func (s *service) Save(ctx context.Context, settings *Settings) error {
-	if err := newValidator(s.bindingCommands).validate(ctx, settings); err != nil {
+	if err := s.keepBackupSecretKey(ctx, settings); err != nil {
+		return err
+	}
+
+	if err := newValidator(s.bindingCommands, s.hostCommands).validate(ctx, settings); err != nil {
 		return err
 	}
 
@@ -40,3 +48,20 @@ func (s *service) Save(ctx context.Context, settings *Settings) error {
 
 	return s.scheduler.Reload(ctx)
 }
+
+func (s *service) keepBackupSecretKey(ctx context.Context, settings *Settings) error {
+	if settings.Backup == nil || settings.Backup.S3 == nil || settings.Backup.S3.SecretKey != nil {
+		return nil
+	}
+
+	current, err := s.repository.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if current.Backup != nil && current.Backup.S3 != nil {
+		settings.Backup.S3.SecretKey = current.Backup.S3.SecretKey
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"dillmann.com.br/nginx-ignition/core/binding"
 	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+	"dillmann.com.br/nginx-ignition/core/host"
 )
 
 func Test_service(t *testing.T) {
@@ -23,9 +24,10 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().Get(t.Context()).Return(expected, nil)
 
 			bindingCommands := binding.NewMockedCommands(ctrl)
+			hostCommands := host.NewMockedCommands(ctrl)
 			sched := &scheduler.Scheduler{}
 
-			settingsService := newCommands(repo, bindingCommands, sched)
+			settingsService := newCommands(repo, bindingCommands, hostCommands, sched)
 			result, err := settingsService.Get(t.Context())
 
 			assert.NoError(t, err)
@@ -42,9 +44,10 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().Get(t.Context()).Return(nil, expectedErr)
 
 			bindingCommands := binding.NewMockedCommands(ctrl)
+			hostCommands := host.NewMockedCommands(ctrl)
 			sched := &scheduler.Scheduler{}
 
-			settingsService := newCommands(repo, bindingCommands, sched)
+			settingsService := newCommands(repo, bindingCommands, hostCommands, sched)
 			result, err := settingsService.Get(t.Context())
 
 			assert.Error(t, err)
@@ -63,12 +66,40 @@ func Test_service(t *testing.T) {
 
 			repo := NewMockedRepository(ctrl)
 			bindingCommands := binding.NewMockedCommands(ctrl)
+			hostCommands := host.NewMockedCommands(ctrl)
 			sched := &scheduler.Scheduler{}
 
-			settingsService := newCommands(repo, bindingCommands, sched)
+			settingsService := newCommands(repo, bindingCommands, hostCommands, sched)
 			err := settingsService.Save(t.Context(), s)
 
 			assert.Error(t, err)
 		})
+
+		t.Run("keeps the current backup secret key when omitted", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expectedErr := errors.New("repository error")
+			current := newSettings()
+			current.Backup.S3 = newBackupS3Settings()
+
+			s := newSettings()
+			s.Backup.S3 = newBackupS3Settings()
+			s.Backup.S3.SecretKey = nil
+
+			repo := NewMockedRepository(ctrl)
+			repo.EXPECT().Get(t.Context()).Return(current, nil)
+			repo.EXPECT().Save(t.Context(), s).Return(expectedErr)
+
+			bindingCommands := binding.NewMockedCommands(ctrl)
+			hostCommands := host.NewMockedCommands(ctrl)
+			sched := &scheduler.Scheduler{}
+
+			settingsService := newCommands(repo, bindingCommands, hostCommands, sched)
+			err := settingsService.Save(t.Context(), s)
+
+			assert.Equal(t, expectedErr, err)
+			assert.Equal(t, "secret-key", *s.Backup.S3.SecretKey)
+		})
 	})
 }
@@ -2,20 +2,30 @@ package settings
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/google/uuid"
+
 	"dillmann.com.br/nginx-ignition/core/binding"
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 	"dillmann.com.br/nginx-ignition/core/common/validation"
 	"dillmann.com.br/nginx-ignition/core/common/valuerange"
+	"dillmann.com.br/nginx-ignition/core/host"
 )
 
 const (
 	maximumDefaultContentTypeLength = 128
 	maximumRuntimeUserLength        = 32
 	maximumDatabaseLocationLength   = 128
+	maximumBackupLocalPathLength    = 256
 	defaultContentTypePath          = "nginx.defaultContentType"
 )
 
@@ -27,24 +37,34 @@ var (
 	workerConnectionsRange = valuerange.New(32, 4096)
 	maximumBodySizeRange   = valuerange.New(1, int(^uint(0)>>1))
 	statsMaximumSizeRange  = valuerange.New(1, 512)
+	backupRetentionRange   = valuerange.New(0, 99_999)
+	syslogTagPattern       = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
 )
 
 type validator struct {
-	commands binding.Commands
-	delegate *validation.ConsistencyValidator
+	commands     binding.Commands
+	hostCommands host.Commands
+	delegate     *validation.ConsistencyValidator
 }
 
-func newValidator(commands binding.Commands) *validator {
+func newValidator(commands binding.Commands, hostCommands host.Commands) *validator {
 	return &validator{
 		commands,
+		hostCommands,
 		validation.NewValidator(),
 	}
 }
 
 func (v *validator) validate(ctx context.Context, settings *Settings) error {
 	v.validateNginx(ctx, settings.Nginx)
+
+	if err := v.validateLogDestinations(ctx, settings.Nginx.Logs); err != nil {
+		return err
+	}
+
 	v.validateLogRotation(ctx, settings.LogRotation)
 	v.validateCertificateAutoRenew(ctx, settings.CertificateAutoRenew)
+	v.validateBackup(ctx, settings.Backup)
 
 	if err := v.validateGlobalBindings(ctx, settings.GlobalBindings); err != nil {
 		return err
@@ -62,6 +82,7 @@ func (v *validator) validateNginx(ctx context.Context, settings *NginxSettings)
 	v.checkRange(ctx, settings.WorkerConnections, workerConnectionsRange, "nginx.workerConnections")
 	v.checkRange(ctx, settings.MaximumBodySizeMb, maximumBodySizeRange, "nginx.maximumBodySizeMb")
 	v.validateStats(ctx, settings.Stats)
+	v.validateLogs(ctx, settings.Logs)
 
 	if settings.DefaultContentType == "" {
 		v.delegate.Add(defaultContentTypePath, i18n.M(ctx, i18n.K.CommonValueMissing))
@@ -86,6 +107,155 @@ func (v *validator) validateNginx(ctx context.Context, settings *NginxSettings)
 	}
 }
 
+func (v *validator) validateLogs(ctx context.Context, settings *NginxLogsSettings) {
+	if settings == nil {
+		return
+	}
+
+	switch settings.AccessLogsFormat {
+	case CombinedAccessLogFormat, JSONAccessLogFormat:
+	default:
+		v.delegate.Add("nginx.logs.accessLogsFormat", i18n.M(ctx, i18n.K.CommonInvalidValue))
+	}
+}
+
+func (v *validator) validateLogDestinations(
+	ctx context.Context,
+	settings *NginxLogsSettings,
+) error {
+	if settings == nil {
+		return nil
+	}
+
+	for index, destination := range settings.Destinations {
+		prefix := fmt.Sprintf("nginx.logs.destinations[%d]", index)
+
+		if err := v.validateLogDestinationHost(ctx, prefix, destination.HostID); err != nil {
+			return err
+		}
+
+		if !destination.AccessLogs && !destination.ErrorLogs {
+			v.delegate.Add(prefix+".accessLogs", i18n.M(ctx, i18n.K.CommonAtLeastOneRequired))
+		}
+
+		switch destination.Type {
+		case SyslogLogDestinationType:
+			v.validateSyslogDestination(ctx, prefix, &destination)
+		case OTLPLogDestinationType:
+			v.validateOTLPDestination(ctx, prefix, &destination)
+		default:
+			v.delegate.Add(prefix+".type", i18n.M(ctx, i18n.K.CommonInvalidValue))
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) validateLogDestinationHost(
+	ctx context.Context,
+	prefix string,
+	hostID *uuid.UUID,
+) error {
+	if hostID == nil {
+		return nil
+	}
+
+	exists, err := v.hostCommands.Exists(ctx, *hostID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		v.delegate.Add(prefix+".hostId", i18n.M(ctx, i18n.K.CoreSettingsHostNotFound))
+	}
+
+	return nil
+}
+
+func (v *validator) validateSyslogDestination(
+	ctx context.Context,
+	prefix string,
+	destination *LogDestination,
+) {
+	addressField := prefix + ".address"
+
+	switch destination.Protocol {
+	case UDPLogDestinationProtocol, TCPLogDestinationProtocol:
+		if !isValidSyslogAddress(destination.Address) {
+			v.delegate.Add(
+				addressField,
+				i18n.M(ctx, i18n.K.CoreSettingsInvalidLogDestinationAddress),
+			)
+		}
+	case UnixLogDestinationProtocol:
+		if !filepath.IsAbs(destination.Address) {
+			v.delegate.Add(
+				addressField,
+				i18n.M(ctx, i18n.K.CoreSettingsAbsoluteSocketPathRequired),
+			)
+		}
+	default:
+		v.delegate.Add(prefix+".protocol", i18n.M(ctx, i18n.K.CommonInvalidValue))
+	}
+
+	if !slices.Contains(SyslogFacilities, destination.Facility) {
+		v.delegate.Add(prefix+".facility", i18n.M(ctx, i18n.K.CommonInvalidValue))
+	}
+
+	if destination.Tag != nil && !syslogTagPattern.MatchString(*destination.Tag) {
+		v.delegate.Add(prefix+".tag", i18n.M(ctx, i18n.K.CoreSettingsInvalidSyslogTag))
+	}
+}
+
+func (v *validator) validateOTLPDestination(
+	ctx context.Context,
+	prefix string,
+	destination *LogDestination,
+) {
+	if destination.Protocol != HTTPLogDestinationProtocol {
+		v.delegate.Add(prefix+".protocol", i18n.M(ctx, i18n.K.CommonInvalidValue))
+	}
+
+	endpoint, err := url.Parse(destination.Address)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") ||
+		endpoint.Host == "" {
+		v.delegate.Add(prefix+".address", i18n.M(ctx, i18n.K.CommonInvalidUrl))
+	}
+}
+
+func isValidSyslogAddress(address string) bool {
+	if strings.TrimSpace(address) == "" || strings.ContainsAny(address, " ,;/") {
+		return false
+	}
+
+	hostname, port, err := net.SplitHostPort(address)
+	if err != nil {
+		switch {
+		case strings.HasPrefix(address, "[") && strings.HasSuffix(address, "]"):
+			hostname = address[1 : len(address)-1]
+		case strings.Contains(address, ":"):
+			return false
+		default:
+			hostname = address
+		}
+
+		port = ""
+	}
+
+	if hostname == "" {
+		return false
+	}
+
+	if port != "" {
+		value, err := strconv.Atoi(port)
+		if err != nil || value < 1 || value > 65535 {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (v *validator) validateStats(ctx context.Context, settings *NginxStatsSettings) {
 	v.checkRange(ctx, settings.MaximumSizeMB, statsMaximumSizeRange, "nginx.stats.maximumSizeMb")
 
@@ -133,6 +303,73 @@ func (v *validator) validateCertificateAutoRenew(
 	)
 }
 
+func (v *validator) validateBackup(ctx context.Context, settings *BackupSettings) {
+	v.checkRange(ctx, settings.IntervalUnitCount, intervalRange, "backup.intervalUnitCount")
+	v.checkRange(ctx, settings.MaximumCount, backupRetentionRange, "backup.maximumCount")
+	v.checkRange(ctx, settings.MaximumAgeDays, backupRetentionRange, "backup.maximumAgeDays")
+
+	if !settings.Enabled {
+		return
+	}
+
+	switch settings.Destination {
+	case LocalBackupDestination:
+		v.validateBackupLocalPath(ctx, settings.LocalPath)
+	case S3BackupDestination:
+		v.validateBackupS3(ctx, settings.S3)
+	default:
+		v.delegate.Add("backup.destination", i18n.M(ctx, i18n.K.CommonInvalidValue))
+	}
+}
+
+func (v *validator) validateBackupLocalPath(ctx context.Context, path *string) {
+	field := "backup.localPath"
+	if path == nil || strings.TrimSpace(*path) == "" {
+		v.delegate.Add(field, i18n.M(ctx, i18n.K.CommonValueMissing))
+		return
+	}
+
+	if info, err := os.Stat(*path); err != nil || !info.IsDir() {
+		v.delegate.Add(field, i18n.M(ctx, i18n.K.CoreSettingsInvalidFolder))
+	}
+
+	if len(*path) > maximumBackupLocalPathLength {
+		v.delegate.Add(
+			field,
+			i18n.M(ctx, i18n.K.CommonValueTooLong).V("max", maximumBackupLocalPathLength),
+		)
+	}
+}
+
+func (v *validator) validateBackupS3(ctx context.Context, settings *BackupS3Settings) {
+	if settings == nil {
+		v.delegate.Add("backup.s3", i18n.M(ctx, i18n.K.CommonValueMissing))
+		return
+	}
+
+	endpoint, err := url.Parse(settings.Endpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") ||
+		endpoint.Host == "" {
+		v.delegate.Add("backup.s3.endpoint", i18n.M(ctx, i18n.K.CommonInvalidUrl))
+	}
+
+	secretKey := ""
+	if settings.SecretKey != nil {
+		secretKey = *settings.SecretKey
+	}
+
+	v.checkRequired(ctx, settings.Region, "backup.s3.region")
+	v.checkRequired(ctx, settings.Bucket, "backup.s3.bucket")
+	v.checkRequired(ctx, settings.AccessKey, "backup.s3.accessKey")
+	v.checkRequired(ctx, secretKey, "backup.s3.secretKey")
+}
+
+func (v *validator) checkRequired(ctx context.Context, value, path string) {
+	if strings.TrimSpace(value) == "" {
+		v.delegate.Add(path, i18n.M(ctx, i18n.K.CommonValueMissing))
+	}
+}
+
 func (v *validator) validateGlobalBindings(ctx context.Context, settings []binding.Binding) error {
 	for index, b := range settings {
 		if err := v.commands.Validate(ctx, "globalBindings", index, &b, v.delegate); err != nil {
@@ -1,13 +1,16 @@
 package settings
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	"dillmann.com.br/nginx-ignition/core/binding"
+	"dillmann.com.br/nginx-ignition/core/host"
 )
 
 func Test_validator(t *testing.T) {
@@ -15,11 +18,12 @@ func Test_validator(t *testing.T) {
 	defer ctrl.Finish()
 
 	bindingCommands := binding.NewMockedCommands(ctrl)
+	hostCommands := host.NewMockedCommands(ctrl)
 
 	t.Run("validate", func(t *testing.T) {
 		t.Run("valid settings pass", func(t *testing.T) {
 			s := newSettings()
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -29,7 +33,7 @@ func Test_validator(t *testing.T) {
 		t.Run("empty default content type fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.DefaultContentType = ""
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -39,7 +43,7 @@ func Test_validator(t *testing.T) {
 		t.Run("default content type exceeds maximum length fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.DefaultContentType = strings.Repeat("a", 129)
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -49,7 +53,7 @@ func Test_validator(t *testing.T) {
 		t.Run("empty runtime user fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.RuntimeUser = ""
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -59,7 +63,7 @@ func Test_validator(t *testing.T) {
 		t.Run("whitespace-only runtime user fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.RuntimeUser = "   "
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -69,7 +73,7 @@ func Test_validator(t *testing.T) {
 		t.Run("runtime user exceeds maximum length fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.RuntimeUser = strings.Repeat("a", 33)
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -79,7 +83,7 @@ func Test_validator(t *testing.T) {
 		t.Run("runtime user at maximum length passes", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.RuntimeUser = strings.Repeat("a", 32)
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -89,7 +93,7 @@ func Test_validator(t *testing.T) {
 		t.Run("default content type at maximum length passes", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.DefaultContentType = strings.Repeat("a", 128)
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -99,7 +103,7 @@ func Test_validator(t *testing.T) {
 		t.Run("timeout read below range fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.Timeouts.Read = 0
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -109,7 +113,7 @@ func Test_validator(t *testing.T) {
 		t.Run("timeout send below range fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.Timeouts.Send = 0
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -119,7 +123,7 @@ func Test_validator(t *testing.T) {
 		t.Run("timeout connect below range fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.Timeouts.Connect = 0
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -129,7 +133,7 @@ func Test_validator(t *testing.T) {
 		t.Run("timeout keepalive below range fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.Timeouts.Keepalive = 0
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -139,7 +143,7 @@ func Test_validator(t *testing.T) {
 		t.Run("worker processes below range fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.WorkerProcesses = 0
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -149,7 +153,7 @@ func Test_validator(t *testing.T) {
 		t.Run("worker processes above range fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.WorkerProcesses = 101
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -159,7 +163,7 @@ func Test_validator(t *testing.T) {
 		t.Run("worker connections below range fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.WorkerConnections = 31
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -169,7 +173,7 @@ func Test_validator(t *testing.T) {
 		t.Run("worker connections above range fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.WorkerConnections = 4097
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -179,7 +183,7 @@ func Test_validator(t *testing.T) {
 		t.Run("maximum body size below range fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.MaximumBodySizeMb = 0
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -189,7 +193,7 @@ func Test_validator(t *testing.T) {
 		t.Run("log rotation interval unit count below range fails", func(t *testing.T) {
 			s := newSettings()
 			s.LogRotation.IntervalUnitCount = 0
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -199,7 +203,7 @@ func Test_validator(t *testing.T) {
 		t.Run("log rotation maximum lines below range fails", func(t *testing.T) {
 			s := newSettings()
 			s.LogRotation.MaximumLines = -1
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -209,7 +213,7 @@ func Test_validator(t *testing.T) {
 		t.Run("log rotation maximum lines above range fails", func(t *testing.T) {
 			s := newSettings()
 			s.LogRotation.MaximumLines = 100000
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -219,7 +223,188 @@ func Test_validator(t *testing.T) {
 		t.Run("certificate auto renew interval unit count below range fails", func(t *testing.T) {
 			s := newSettings()
 			s.CertificateAutoRenew.IntervalUnitCount = 0
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("json access logs format passes", func(t *testing.T) {
+			s := newSettings()
+			s.Nginx.Logs = &NginxLogsSettings{AccessLogsFormat: JSONAccessLogFormat}
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("invalid access logs format fails", func(t *testing.T) {
+			s := newSettings()
+			s.Nginx.Logs = &NginxLogsSettings{AccessLogsFormat: "XML"}
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("valid log destinations pass", func(t *testing.T) {
+			hostID := uuid.New()
+			hostCommands.EXPECT().Exists(gomock.Any(), hostID).Return(true, nil)
+			s := newSettings()
+			s.Nginx.Logs = newLogsSettings(
+				newSyslogDestination(),
+				LogDestination{
+					HostID:     &hostID,
+					Type:       SyslogLogDestinationType,
+					Protocol:   UnixLogDestinationProtocol,
+					Address:    "/dev/log",
+					Facility:   "daemon",
+					ErrorLogs:  true,
+					AccessLogs: false,
+				},
+				LogDestination{
+					Type:       OTLPLogDestinationType,
+					Protocol:   HTTPLogDestinationProtocol,
+					Address:    "http://localhost:4318/v1/logs",
+					AccessLogs: true,
+				},
+			)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("log destination with unknown host fails", func(t *testing.T) {
+			hostID := uuid.New()
+			hostCommands.EXPECT().Exists(gomock.Any(), hostID).Return(false, nil)
+			destination := newSyslogDestination()
+			destination.HostID = &hostID
+			s := newSettings()
+			s.Nginx.Logs = newLogsSettings(destination)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("log destination host lookup error is returned", func(t *testing.T) {
+			hostID := uuid.New()
+			expectedErr := errors.New("lookup failed")
+			hostCommands.EXPECT().Exists(gomock.Any(), hostID).Return(false, expectedErr)
+			destination := newSyslogDestination()
+			destination.HostID = &hostID
+			s := newSettings()
+			s.Nginx.Logs = newLogsSettings(destination)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.ErrorIs(t, err, expectedErr)
+		})
+
+		t.Run("log destination without log types fails", func(t *testing.T) {
+			destination := newSyslogDestination()
+			destination.AccessLogs = false
+			destination.ErrorLogs = false
+			s := newSettings()
+			s.Nginx.Logs = newLogsSettings(destination)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("syslog destination with invalid address fails", func(t *testing.T) {
+			for _, address := range []string{"", "logs.local:99999", "logs.local,tag=x", ":514", "::1"} {
+				destination := newSyslogDestination()
+				destination.Address = address
+				s := newSettings()
+				s.Nginx.Logs = newLogsSettings(destination)
+				settingsValidator := newValidator(bindingCommands, hostCommands)
+
+				err := settingsValidator.validate(t.Context(), s)
+
+				assert.Error(t, err, address)
+			}
+		})
+
+		t.Run("syslog destination with relative unix socket path fails", func(t *testing.T) {
+			destination := newSyslogDestination()
+			destination.Protocol = UnixLogDestinationProtocol
+			destination.Address = "dev/log"
+			s := newSettings()
+			s.Nginx.Logs = newLogsSettings(destination)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("syslog destination with HTTP protocol fails", func(t *testing.T) {
+			destination := newSyslogDestination()
+			destination.Protocol = HTTPLogDestinationProtocol
+			s := newSettings()
+			s.Nginx.Logs = newLogsSettings(destination)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("syslog destination with invalid facility fails", func(t *testing.T) {
+			destination := newSyslogDestination()
+			destination.Facility = "local9"
+			s := newSettings()
+			s.Nginx.Logs = newLogsSettings(destination)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("syslog destination with invalid tag fails", func(t *testing.T) {
+			destination := newSyslogDestination()
+			destination.Tag = new("nginx-ignition")
+			s := newSettings()
+			s.Nginx.Logs = newLogsSettings(destination)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("OTLP destination with invalid URL fails", func(t *testing.T) {
+			s := newSettings()
+			s.Nginx.Logs = newLogsSettings(LogDestination{
+				Type:       OTLPLogDestinationType,
+				Protocol:   HTTPLogDestinationProtocol,
+				Address:    "localhost:4318",
+				AccessLogs: true,
+			})
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("log destination with unknown type fails", func(t *testing.T) {
+			destination := newSyslogDestination()
+			destination.Type = "KAFKA"
+			s := newSettings()
+			s.Nginx.Logs = newLogsSettings(destination)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -229,7 +414,7 @@ func Test_validator(t *testing.T) {
 		t.Run("stats maximum size below range fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.Stats.MaximumSizeMB = 0
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -239,7 +424,7 @@ func Test_validator(t *testing.T) {
 		t.Run("stats maximum size above range fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.Stats.MaximumSizeMB = 513
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -249,7 +434,7 @@ func Test_validator(t *testing.T) {
 		t.Run("stats database location invalid extension fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.Stats.DatabaseLocation = new("/tmp/test.txt")
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -259,7 +444,7 @@ func Test_validator(t *testing.T) {
 		t.Run("stats database location invalid folder fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.Stats.DatabaseLocation = new("/non-existing-folder/test.db")
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
@@ -269,7 +454,118 @@ func Test_validator(t *testing.T) {
 		t.Run("stats database location too long fails", func(t *testing.T) {
 			s := newSettings()
 			s.Nginx.Stats.DatabaseLocation = new("/tmp/" + strings.Repeat("a", 122) + ".db")
-			settingsValidator := newValidator(bindingCommands)
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("backup interval unit count below range fails", func(t *testing.T) {
+			s := newSettings()
+			s.Backup.IntervalUnitCount = 0
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("backup maximum count below range fails", func(t *testing.T) {
+			s := newSettings()
+			s.Backup.MaximumCount = -1
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("disabled backup with incomplete destination passes", func(t *testing.T) {
+			s := newSettings()
+			s.Backup.Destination = S3BackupDestination
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("enabled local backup with existing folder passes", func(t *testing.T) {
+			s := newSettings()
+			s.Backup.Enabled = true
+			s.Backup.LocalPath = new(t.TempDir())
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("enabled local backup without path fails", func(t *testing.T) {
+			s := newSettings()
+			s.Backup.Enabled = true
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("enabled local backup with invalid folder fails", func(t *testing.T) {
+			s := newSettings()
+			s.Backup.Enabled = true
+			s.Backup.LocalPath = new("/non-existing-folder")
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("enabled S3 backup with complete settings passes", func(t *testing.T) {
+			s := newSettings()
+			s.Backup.Enabled = true
+			s.Backup.Destination = S3BackupDestination
+			s.Backup.S3 = newBackupS3Settings()
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("enabled S3 backup with invalid endpoint fails", func(t *testing.T) {
+			s := newSettings()
+			s.Backup.Enabled = true
+			s.Backup.Destination = S3BackupDestination
+			s.Backup.S3 = newBackupS3Settings()
+			s.Backup.S3.Endpoint = "minio:9000"
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("enabled S3 backup without secret key fails", func(t *testing.T) {
+			s := newSettings()
+			s.Backup.Enabled = true
+			s.Backup.Destination = S3BackupDestination
+			s.Backup.S3 = newBackupS3Settings()
+			s.Backup.S3.SecretKey = nil
+			settingsValidator := newValidator(bindingCommands, hostCommands)
+
+			err := settingsValidator.validate(t.Context(), s)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("enabled backup with unknown destination fails", func(t *testing.T) {
+			s := newSettings()
+			s.Backup.Enabled = true
+			s.Backup.Destination = "FTP"
+			settingsValidator := newValidator(bindingCommands, hostCommands)
 
 			err := settingsValidator.validate(t.Context(), s)
 
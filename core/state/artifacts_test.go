@@ -0,0 +1,192 @@
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/cache"
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/dynamicfields"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+	"dillmann.com.br/nginx-ignition/core/common/transaction"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+	"dillmann.com.br/nginx-ignition/core/vpn"
+)
+
+type mockedCommands struct {
+	transactions    *transaction.MockedManager
+	transactionErr  error
+	settings        *settings.MockedCommands
+	integrations    *integration.MockedCommands
+	vpns            *vpn.MockedCommands
+	accessLists     *accesslist.MockedCommands
+	caches          *cache.MockedCommands
+	rateLimits      *ratelimit.MockedCommands
+	securityHeaders *securityheaders.MockedCommands
+	upstreams       *upstream.MockedCommands
+	certificates    *certificate.MockedCommands
+	tlsProfiles     *tlsprofile.MockedCommands
+	hosts           *host.MockedCommands
+	streams         *stream.MockedCommands
+}
+
+func newMockedCommands(ctrl *gomock.Controller) *mockedCommands {
+	output := &mockedCommands{
+		transactions:    transaction.NewMockedManager(ctrl),
+		settings:        settings.NewMockedCommands(ctrl),
+		integrations:    integration.NewMockedCommands(ctrl),
+		vpns:            vpn.NewMockedCommands(ctrl),
+		accessLists:     accesslist.NewMockedCommands(ctrl),
+		caches:          cache.NewMockedCommands(ctrl),
+		rateLimits:      ratelimit.NewMockedCommands(ctrl),
+		securityHeaders: securityheaders.NewMockedCommands(ctrl),
+		upstreams:       upstream.NewMockedCommands(ctrl),
+		certificates:    certificate.NewMockedCommands(ctrl),
+		tlsProfiles:     tlsprofile.NewMockedCommands(ctrl),
+		hosts:           host.NewMockedCommands(ctrl),
+		streams:         stream.NewMockedCommands(ctrl),
+	}
+
+	output.transactions.EXPECT().
+		Run(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, action func(context.Context) error) error {
+			output.transactionErr = action(ctx)
+			return output.transactionErr
+		}).
+		AnyTimes()
+
+	return output
+}
+
+func (m *mockedCommands) service() Commands {
+	return newCommands(
+		m.transactions,
+		&scheduler.Scheduler{},
+		m.settings,
+		m.integrations,
+		m.vpns,
+		m.accessLists,
+		m.caches,
+		m.rateLimits,
+		m.securityHeaders,
+		m.upstreams,
+		m.certificates,
+		m.tlsProfiles,
+		m.hosts,
+		m.streams,
+	)
+}
+
+func (m *mockedCommands) expectCurrentState(t *testing.T, current *Document) {
+	m.settings.EXPECT().Get(t.Context()).Return(current.Settings, nil)
+	m.integrations.EXPECT().
+		List(t.Context(), exportPageSize, 0, nil, false).
+		Return(pagination.Of(current.Integrations), nil)
+	m.vpns.EXPECT().
+		List(t.Context(), exportPageSize, 0, nil, false).
+		Return(pagination.Of(current.VPNs), nil)
+	m.accessLists.EXPECT().
+		List(t.Context(), exportPageSize, 0, nil).
+		Return(pagination.Of(current.AccessLists), nil)
+	m.caches.EXPECT().
+		List(t.Context(), exportPageSize, 0, nil).
+		Return(pagination.Of(current.Caches), nil)
+	m.rateLimits.EXPECT().
+		List(t.Context(), exportPageSize, 0, nil).
+		Return(pagination.Of(current.RateLimits), nil)
+	m.securityHeaders.EXPECT().
+		List(t.Context(), exportPageSize, 0, nil).
+		Return(pagination.Of(current.SecurityHeaders), nil)
+	m.upstreams.EXPECT().
+		List(t.Context(), exportPageSize, 0, nil).
+		Return(pagination.Of(current.Upstreams), nil)
+	m.certificates.EXPECT().
+		List(t.Context(), exportPageSize, 0, nil).
+		Return(pagination.Of(current.Certificates), nil)
+	m.tlsProfiles.EXPECT().
+		List(t.Context(), exportPageSize, 0, nil).
+		Return(pagination.Of(current.TLSProfiles), nil)
+	m.hosts.EXPECT().
+		List(t.Context(), exportPageSize, 0, nil).
+		Return(pagination.Of(current.Hosts), nil)
+	m.streams.EXPECT().
+		List(t.Context(), exportPageSize, 0, nil).
+		Return(pagination.Of(current.Streams), nil)
+	m.integrations.EXPECT().
+		GetAvailableDrivers(t.Context()).
+		Return([]integration.AvailableDriver{
+			{
+				ID: "TRUENAS",
+				ConfigurationFields: []dynamicfields.DynamicField{
+					{ID: "url"},
+					{ID: "password", Sensitive: true},
+				},
+			},
+		}, nil).
+		AnyTimes()
+	m.vpns.EXPECT().
+		GetAvailableDrivers(t.Context()).
+		Return([]vpn.AvailableDriver{
+			{
+				ID: "TAILSCALE",
+				ConfigurationFields: []dynamicfields.DynamicField{
+					{ID: "authKey", Sensitive: true},
+					{ID: "coordinatorUrl"},
+				},
+			},
+		}, nil).
+		AnyTimes()
+	m.certificates.EXPECT().AvailableProviders(t.Context()).Return(nil, nil).AnyTimes()
+}
+
+func newDocument() *Document {
+	return &Document{
+		Version: CurrentVersion,
+		Settings: &settings.Settings{
+			Nginx: &settings.NginxSettings{
+				WorkerProcesses: 2,
+			},
+			Backup: &settings.BackupSettings{
+				Destination: settings.S3BackupDestination,
+				S3: &settings.BackupS3Settings{
+					Bucket:    "backups",
+					SecretKey: new("secret"),
+				},
+			},
+		},
+		Certificates: []certificate.Certificate{
+			{
+				ID:          uuid.New(),
+				ProviderID:  "CUSTOM",
+				DomainNames: []string{"example.com"},
+				PrivateKey:  "private",
+				PublicKey:   "public",
+			},
+		},
+		Hosts: []host.Host{
+			{
+				ID:          uuid.New(),
+				DomainNames: []string{"example.com"},
+				Enabled:     true,
+			},
+		},
+		Streams: []stream.Stream{
+			{
+				ID:   uuid.New(),
+				Name: "Stream",
+			},
+		},
+	}
+}
@@ -0,0 +1,8 @@
+package state
+
+import "context"
+
+type Commands interface {
+	Export(ctx context.Context, includeCertificateKeys bool) (*Document, error)
+	Import(ctx context.Context, document *Document, dryRun bool) ([]Change, error)
+}
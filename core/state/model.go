@@ -0,0 +1,68 @@
+package state
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/cache"
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+	"dillmann.com.br/nginx-ignition/core/vpn"
+)
+
+const CurrentVersion = 1
+
+type EntityType string
+
+const (
+	SettingsEntityType        EntityType = "SETTINGS"
+	IntegrationEntityType     EntityType = "INTEGRATION"
+	VPNEntityType             EntityType = "VPN"
+	AccessListEntityType      EntityType = "ACCESS_LIST"
+	CacheEntityType           EntityType = "CACHE"
+	RateLimitEntityType       EntityType = "RATE_LIMIT"
+	SecurityHeadersEntityType EntityType = "SECURITY_HEADERS"
+	UpstreamEntityType        EntityType = "UPSTREAM"
+	CertificateEntityType     EntityType = "CERTIFICATE"
+	TLSProfileEntityType      EntityType = "TLS_PROFILE"
+	HostEntityType            EntityType = "HOST"
+	StreamEntityType          EntityType = "STREAM"
+)
+
+type Action string
+
+const (
+	CreateAction Action = "CREATE"
+	UpdateAction Action = "UPDATE"
+	DeleteAction Action = "DELETE"
+)
+
+type Document struct {
+	Settings        *settings.Settings
+	Integrations    []integration.Integration
+	VPNs            []vpn.VPN
+	AccessLists     []accesslist.AccessList
+	Caches          []cache.Cache
+	RateLimits      []ratelimit.RateLimit
+	SecurityHeaders []securityheaders.SecurityHeaders
+	Upstreams       []upstream.Upstream
+	Certificates    []certificate.Certificate
+	TLSProfiles     []tlsprofile.TLSProfile
+	Hosts           []host.Host
+	Streams         []stream.Stream
+	Version         int
+}
+
+type Change struct {
+	EntityID   *uuid.UUID
+	EntityType EntityType
+	Action     Action
+	Name       string
+}
@@ -0,0 +1,371 @@
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/cache"
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+	"dillmann.com.br/nginx-ignition/core/vpn"
+)
+
+type step struct {
+	apply  func(ctx context.Context) error
+	change Change
+}
+
+type entityHandler[T any] struct {
+	id         func(item *T) uuid.UUID
+	name       func(item *T) string
+	save       func(ctx context.Context, item *T) error
+	delete     func(ctx context.Context, id uuid.UUID) error
+	entityType EntityType
+}
+
+func (h entityHandler[T]) saves(current, desired []T) []step {
+	currentByID := make(map[uuid.UUID]*T, len(current))
+	for index := range current {
+		currentByID[h.id(&current[index])] = &current[index]
+	}
+
+	output := make([]step, 0)
+	for index := range desired {
+		item := &desired[index]
+
+		action := CreateAction
+		if existing, found := currentByID[h.id(item)]; found {
+			if sameState(existing, item) {
+				continue
+			}
+
+			action = UpdateAction
+		}
+
+		output = append(output, step{
+			change: h.change(item, action),
+			apply: func(ctx context.Context) error {
+				return h.save(ctx, item)
+			},
+		})
+	}
+
+	return output
+}
+
+func (h entityHandler[T]) deletes(current, desired []T) []step {
+	desiredIDs := make(map[uuid.UUID]bool, len(desired))
+	for index := range desired {
+		desiredIDs[h.id(&desired[index])] = true
+	}
+
+	output := make([]step, 0)
+	for index := range current {
+		item := &current[index]
+		id := h.id(item)
+		if desiredIDs[id] {
+			continue
+		}
+
+		output = append(output, step{
+			change: h.change(item, DeleteAction),
+			apply: func(ctx context.Context) error {
+				return h.delete(ctx, id)
+			},
+		})
+	}
+
+	return output
+}
+
+func (h entityHandler[T]) change(item *T, action Action) Change {
+	return Change{
+		EntityID:   new(h.id(item)),
+		EntityType: h.entityType,
+		Action:     action,
+		Name:       h.name(item),
+	}
+}
+
+func (s *service) plan(current, desired *Document) []step {
+	integrations := entityHandler[integration.Integration]{
+		entityType: IntegrationEntityType,
+		id:         func(item *integration.Integration) uuid.UUID { return item.ID },
+		name:       func(item *integration.Integration) string { return item.Name },
+		save:       s.integrationCommands.Save,
+		delete:     s.integrationCommands.Delete,
+	}
+	vpns := entityHandler[vpn.VPN]{
+		entityType: VPNEntityType,
+		id:         func(item *vpn.VPN) uuid.UUID { return item.ID },
+		name:       func(item *vpn.VPN) string { return item.Name },
+		save:       s.vpnCommands.Save,
+		delete:     s.vpnCommands.Delete,
+	}
+	accessLists := entityHandler[accesslist.AccessList]{
+		entityType: AccessListEntityType,
+		id:         func(item *accesslist.AccessList) uuid.UUID { return item.ID },
+		name:       func(item *accesslist.AccessList) string { return item.Name },
+		save:       s.accessListCommands.Save,
+		delete:     s.accessListCommands.Delete,
+	}
+	caches := entityHandler[cache.Cache]{
+		entityType: CacheEntityType,
+		id:         func(item *cache.Cache) uuid.UUID { return item.ID },
+		name:       func(item *cache.Cache) string { return item.Name },
+		save:       s.cacheCommands.Save,
+		delete:     s.cacheCommands.Delete,
+	}
+	upstreams := entityHandler[upstream.Upstream]{
+		entityType: UpstreamEntityType,
+		id:         func(item *upstream.Upstream) uuid.UUID { return item.ID },
+		name:       func(item *upstream.Upstream) string { return item.Name },
+		save:       s.upstreamCommands.Save,
+		delete:     s.upstreamCommands.Delete,
+	}
+	certificates := entityHandler[certificate.Certificate]{
+		entityType: CertificateEntityType,
+		id:         func(item *certificate.Certificate) uuid.UUID { return item.ID },
+		name: func(item *certificate.Certificate) string {
+			return strings.Join(item.DomainNames, ", ")
+		},
+		save:   s.certificateCommands.Save,
+		delete: s.certificateCommands.Delete,
+	}
+	rateLimits := entityHandler[ratelimit.RateLimit]{
+		entityType: RateLimitEntityType,
+		id:         func(item *ratelimit.RateLimit) uuid.UUID { return item.ID },
+		name:       func(item *ratelimit.RateLimit) string { return item.Name },
+		save:       s.rateLimitCommands.Save,
+		delete:     s.rateLimitCommands.Delete,
+	}
+	securityHeaders := entityHandler[securityheaders.SecurityHeaders]{
+		entityType: SecurityHeadersEntityType,
+		id:         func(item *securityheaders.SecurityHeaders) uuid.UUID { return item.ID },
+		name:       func(item *securityheaders.SecurityHeaders) string { return item.Name },
+		save:       s.securityHeadersCommands.Save,
+		delete:     s.securityHeadersCommands.Delete,
+	}
+	tlsProfiles := entityHandler[tlsprofile.TLSProfile]{
+		entityType: TLSProfileEntityType,
+		id:         func(item *tlsprofile.TLSProfile) uuid.UUID { return item.ID },
+		name:       func(item *tlsprofile.TLSProfile) string { return item.Name },
+		save:       s.tlsProfileCommands.Save,
+		delete:     s.tlsProfileCommands.Delete,
+	}
+	hosts := entityHandler[host.Host]{
+		entityType: HostEntityType,
+		id:         func(item *host.Host) uuid.UUID { return item.ID },
+		name:       func(item *host.Host) string { return strings.Join(item.DomainNames, ", ") },
+		save:       s.hostCommands.Save,
+		delete:     s.hostCommands.Delete,
+	}
+	streams := entityHandler[stream.Stream]{
+		entityType: StreamEntityType,
+		id:         func(item *stream.Stream) uuid.UUID { return item.ID },
+		name:       func(item *stream.Stream) string { return item.Name },
+		save:       s.streamCommands.Save,
+		delete:     s.streamCommands.Delete,
+	}
+
+	output := make([]step, 0)
+	output = append(output, hosts.deletes(current.Hosts, desired.Hosts)...)
+	output = append(output, streams.deletes(current.Streams, desired.Streams)...)
+	output = append(output, integrations.saves(current.Integrations, desired.Integrations)...)
+	output = append(output, vpns.saves(current.VPNs, desired.VPNs)...)
+	output = append(output, accessLists.saves(current.AccessLists, desired.AccessLists)...)
+	output = append(output, caches.saves(current.Caches, desired.Caches)...)
+	output = append(output, rateLimits.saves(current.RateLimits, desired.RateLimits)...)
+	output = append(
+		output,
+		securityHeaders.saves(current.SecurityHeaders, desired.SecurityHeaders)...,
+	)
+	output = append(output, upstreams.saves(current.Upstreams, desired.Upstreams)...)
+	output = append(output, certificates.saves(current.Certificates, desired.Certificates)...)
+	output = append(output, tlsProfiles.saves(current.TLSProfiles, desired.TLSProfiles)...)
+	output = append(output, hosts.saves(current.Hosts, desired.Hosts)...)
+	output = append(output, streams.saves(current.Streams, desired.Streams)...)
+
+	if desired.Settings != nil && !sameState(current.Settings, desired.Settings) {
+		output = append(output, step{
+			change: Change{
+				EntityType: SettingsEntityType,
+				Action:     UpdateAction,
+			},
+			apply: func(ctx context.Context) error {
+				return s.settingsCommands.Save(ctx, desired.Settings)
+			},
+		})
+	}
+
+	output = append(output, tlsProfiles.deletes(current.TLSProfiles, desired.TLSProfiles)...)
+	output = append(output, certificates.deletes(current.Certificates, desired.Certificates)...)
+	output = append(output, upstreams.deletes(current.Upstreams, desired.Upstreams)...)
+	output = append(
+		output,
+		securityHeaders.deletes(current.SecurityHeaders, desired.SecurityHeaders)...,
+	)
+	output = append(output, rateLimits.deletes(current.RateLimits, desired.RateLimits)...)
+	output = append(output, caches.deletes(current.Caches, desired.Caches)...)
+	output = append(output, accessLists.deletes(current.AccessLists, desired.AccessLists)...)
+	output = append(output, vpns.deletes(current.VPNs, desired.VPNs)...)
+	output = append(output, integrations.deletes(current.Integrations, desired.Integrations)...)
+	return output
+}
+
+func resolveCertificates(
+	ctx context.Context,
+	current, desired []certificate.Certificate,
+) ([]certificate.Certificate, error) {
+	output := make([]certificate.Certificate, 0, len(desired))
+	for _, item := range desired {
+		if item.PrivateKey != "" {
+			output = append(output, item)
+			continue
+		}
+
+		index := slices.IndexFunc(current, func(existing certificate.Certificate) bool {
+			return existing.ID == item.ID
+		})
+		if index < 0 {
+			return nil, coreerror.New(
+				i18n.M(ctx, i18n.K.CoreStateCertificateWithoutKeys).V("id", item.ID),
+				true,
+			)
+		}
+
+		output = append(output, current[index])
+	}
+
+	return output, nil
+}
+
+func resolveSettings(current, desired *settings.Settings) *settings.Settings {
+	if desired == nil || desired.Backup == nil || desired.Backup.S3 == nil ||
+		desired.Backup.S3.SecretKey != nil {
+		return desired
+	}
+
+	if current.Backup == nil || current.Backup.S3 == nil {
+		return desired
+	}
+
+	s3 := *desired.Backup.S3
+	s3.SecretKey = current.Backup.S3.SecretKey
+
+	backup := *desired.Backup
+	backup.S3 = &s3
+
+	output := *desired
+	output.Backup = &backup
+	return &output
+}
+
+func validateIDs(ctx context.Context, document *Document) error {
+	missingID := hasMissingID(document.Integrations, func(item *integration.Integration) uuid.UUID {
+		return item.ID
+	}) ||
+		hasMissingID(document.VPNs, func(item *vpn.VPN) uuid.UUID { return item.ID }) ||
+		hasMissingID(document.AccessLists, func(item *accesslist.AccessList) uuid.UUID {
+			return item.ID
+		}) ||
+		hasMissingID(document.Caches, func(item *cache.Cache) uuid.UUID { return item.ID }) ||
+		hasMissingID(document.RateLimits, func(item *ratelimit.RateLimit) uuid.UUID {
+			return item.ID
+		}) ||
+		hasMissingID(
+			document.SecurityHeaders,
+			func(item *securityheaders.SecurityHeaders) uuid.UUID { return item.ID },
+		) ||
+		hasMissingID(document.Upstreams, func(item *upstream.Upstream) uuid.UUID {
+			return item.ID
+		}) ||
+		hasMissingID(document.Certificates, func(item *certificate.Certificate) uuid.UUID {
+			return item.ID
+		}) ||
+		hasMissingID(document.TLSProfiles, func(item *tlsprofile.TLSProfile) uuid.UUID {
+			return item.ID
+		}) ||
+		hasMissingID(document.Hosts, func(item *host.Host) uuid.UUID { return item.ID }) ||
+		hasMissingID(document.Streams, func(item *stream.Stream) uuid.UUID { return item.ID })
+
+	if missingID {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreStateMissingId), true)
+	}
+
+	return nil
+}
+
+func hasMissingID[T any](items []T, id func(item *T) uuid.UUID) bool {
+	for index := range items {
+		if id(&items[index]) == uuid.Nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sameState(left, right any) bool {
+	return reflect.DeepEqual(normalize(left), normalize(right))
+}
+
+func normalize(value any) any {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var decoded any
+	if err = json.Unmarshal(encoded, &decoded); err != nil {
+		return value
+	}
+
+	return pruneEmpty(decoded)
+}
+
+func pruneEmpty(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		output := make(map[string]any, len(typed))
+		for key, item := range typed {
+			if pruned := pruneEmpty(item); pruned != nil {
+				output[key] = pruned
+			}
+		}
+
+		if len(output) == 0 {
+			return nil
+		}
+
+		return output
+	case []any:
+		if len(typed) == 0 {
+			return nil
+		}
+
+		output := make([]any, len(typed))
+		for index, item := range typed {
+			output[index] = pruneEmpty(item)
+		}
+
+		return output
+	default:
+		return value
+	}
+}
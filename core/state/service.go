@@ -0,0 +1,422 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/accesslist"
+	"dillmann.com.br/nginx-ignition/core/cache"
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/dynamicfields"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+	"dillmann.com.br/nginx-ignition/core/common/transaction"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+	"dillmann.com.br/nginx-ignition/core/vpn"
+)
+
+const exportPageSize = 100
+
+var errDryRun = errors.New("dry-run import rolled back")
+
+type service struct {
+	transactions            transaction.Manager
+	scheduler               *scheduler.Scheduler
+	settingsCommands        settings.Commands
+	integrationCommands     integration.Commands
+	vpnCommands             vpn.Commands
+	accessListCommands      accesslist.Commands
+	cacheCommands           cache.Commands
+	rateLimitCommands       ratelimit.Commands
+	securityHeadersCommands securityheaders.Commands
+	upstreamCommands        upstream.Commands
+	certificateCommands     certificate.Commands
+	tlsProfileCommands      tlsprofile.Commands
+	hostCommands            host.Commands
+	streamCommands          stream.Commands
+}
+
+func newCommands(
+	transactions transaction.Manager,
+	sched *scheduler.Scheduler,
+	settingsCommands settings.Commands,
+	integrationCommands integration.Commands,
+	vpnCommands vpn.Commands,
+	accessListCommands accesslist.Commands,
+	cacheCommands cache.Commands,
+	rateLimitCommands ratelimit.Commands,
+	securityHeadersCommands securityheaders.Commands,
+	upstreamCommands upstream.Commands,
+	certificateCommands certificate.Commands,
+	tlsProfileCommands tlsprofile.Commands,
+	hostCommands host.Commands,
+	streamCommands stream.Commands,
+) Commands {
+	return &service{
+		transactions:            transactions,
+		scheduler:               sched,
+		settingsCommands:        settingsCommands,
+		integrationCommands:     integrationCommands,
+		vpnCommands:             vpnCommands,
+		accessListCommands:      accessListCommands,
+		cacheCommands:           cacheCommands,
+		rateLimitCommands:       rateLimitCommands,
+		securityHeadersCommands: securityHeadersCommands,
+		upstreamCommands:        upstreamCommands,
+		certificateCommands:     certificateCommands,
+		tlsProfileCommands:      tlsProfileCommands,
+		hostCommands:            hostCommands,
+		streamCommands:          streamCommands,
+	}
+}
+
+func (s *service) Export(ctx context.Context, includeCertificateKeys bool) (*Document, error) {
+	document, err := s.currentState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !includeCertificateKeys {
+		for index := range document.Certificates {
+			document.Certificates[index].PrivateKey = ""
+			document.Certificates[index].PublicKey = ""
+			document.Certificates[index].CertificationChain = nil
+		}
+	}
+
+	if backup := document.Settings.Backup; backup != nil && backup.S3 != nil {
+		backup.S3.SecretKey = nil
+	}
+
+	fields, err := s.parameterFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range document.Integrations {
+		item := &document.Integrations[index]
+		dynamicfields.RemoveSensitiveFields(&item.Parameters, fields.integrations[item.Driver])
+	}
+
+	for index := range document.VPNs {
+		item := &document.VPNs[index]
+		dynamicfields.RemoveSensitiveFields(&item.Parameters, fields.vpns[item.Driver])
+	}
+
+	for index := range document.Certificates {
+		item := &document.Certificates[index]
+		dynamicfields.RemoveSensitiveFields(&item.Parameters, fields.certificates[item.ProviderID])
+	}
+
+	return document, nil
+}
+
+func (s *service) Import(ctx context.Context, document *Document, dryRun bool) ([]Change, error) {
+	if document.Version != CurrentVersion {
+		return nil, coreerror.New(
+			i18n.M(ctx, i18n.K.CoreStateUnsupportedVersion).V("version", document.Version),
+			true,
+		)
+	}
+
+	if err := validateIDs(ctx, document); err != nil {
+		return nil, err
+	}
+
+	current, err := s.currentState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := *document
+	desired.Certificates, err = resolveCertificates(
+		ctx,
+		current.Certificates,
+		document.Certificates,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := s.parameterFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	desired.Integrations = resolveParameters(
+		current.Integrations,
+		document.Integrations,
+		func(item *integration.Integration) (uuid.UUID, *map[string]any) {
+			return item.ID, &item.Parameters
+		},
+		func(item *integration.Integration) []dynamicfields.DynamicField {
+			return fields.integrations[item.Driver]
+		},
+	)
+	desired.VPNs = resolveParameters(
+		current.VPNs,
+		document.VPNs,
+		func(item *vpn.VPN) (uuid.UUID, *map[string]any) { return item.ID, &item.Parameters },
+		func(item *vpn.VPN) []dynamicfields.DynamicField { return fields.vpns[item.Driver] },
+	)
+
+	desired.Settings = resolveSettings(current.Settings, document.Settings)
+	steps := s.plan(current, &desired)
+	changes := make([]Change, 0, len(steps))
+	for _, step := range steps {
+		changes = append(changes, step.change)
+	}
+
+	if len(steps) == 0 {
+		return changes, nil
+	}
+
+	if err = s.apply(ctx, steps, dryRun); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// apply runs every step inside a single transaction, which is rolled back when any of them fails or
+// when running in dry-run mode. Since the steps are applied through the entity commands, their
+// validators run in both cases.
+func (s *service) apply(ctx context.Context, steps []step, dryRun bool) error {
+	err := s.transactions.Run(ctx, func(ctx context.Context) error {
+		for _, step := range steps {
+			if err := step.apply(ctx); err != nil {
+				return err
+			}
+		}
+
+		if dryRun {
+			return errDryRun
+		}
+
+		return nil
+	})
+
+	if err == nil {
+		return nil
+	}
+
+	// The settings may have rescheduled the tasks with the values that were just rolled back
+	reloadErr := s.scheduler.Reload(ctx)
+	if errors.Is(err, errDryRun) {
+		return reloadErr
+	}
+
+	return err
+}
+
+type parameterFields struct {
+	integrations map[string][]dynamicfields.DynamicField
+	vpns         map[string][]dynamicfields.DynamicField
+	certificates map[string][]dynamicfields.DynamicField
+}
+
+func (s *service) parameterFields(ctx context.Context) (*parameterFields, error) {
+	integrationDrivers, err := s.integrationCommands.GetAvailableDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vpnDrivers, err := s.vpnCommands.GetAvailableDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	certificateProviders, err := s.certificateCommands.AvailableProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &parameterFields{
+		integrations: make(map[string][]dynamicfields.DynamicField, len(integrationDrivers)),
+		vpns:         make(map[string][]dynamicfields.DynamicField, len(vpnDrivers)),
+		certificates: make(map[string][]dynamicfields.DynamicField, len(certificateProviders)),
+	}
+
+	for _, driver := range integrationDrivers {
+		output.integrations[driver.ID] = driver.ConfigurationFields
+	}
+
+	for _, driver := range vpnDrivers {
+		output.vpns[driver.ID] = driver.ConfigurationFields
+	}
+
+	for _, provider := range certificateProviders {
+		output.certificates[provider.ID()] = provider.DynamicFields(ctx)
+	}
+
+	return output, nil
+}
+
+// resolveParameters fills the sensitive parameters absent from the desired entities, which are
+// removed on export, with the values of the existing entities.
+func resolveParameters[T any](
+	current, desired []T,
+	parameters func(item *T) (uuid.UUID, *map[string]any),
+	fields func(item *T) []dynamicfields.DynamicField,
+) []T {
+	currentByID := make(map[uuid.UUID]map[string]any, len(current))
+	for index := range current {
+		id, values := parameters(&current[index])
+		currentByID[id] = *values
+	}
+
+	output := make([]T, len(desired))
+	for index := range desired {
+		output[index] = desired[index]
+		item := &output[index]
+
+		id, values := parameters(item)
+		*values = maps.Clone(*values)
+		dynamicfields.RestoreSensitiveFields(values, currentByID[id], fields(item))
+	}
+
+	return output
+}
+
+func (s *service) currentState(ctx context.Context) (*Document, error) {
+	settingsData, err := s.settingsCommands.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	integrations, err := listAll(
+		func(pageSize, pageNumber int) (*pagination.Page[integration.Integration], error) {
+			return s.integrationCommands.List(ctx, pageSize, pageNumber, nil, false)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	vpns, err := listAll(func(pageSize, pageNumber int) (*pagination.Page[vpn.VPN], error) {
+		return s.vpnCommands.List(ctx, pageSize, pageNumber, nil, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accessLists, err := listAll(
+		func(pageSize, pageNumber int) (*pagination.Page[accesslist.AccessList], error) {
+			return s.accessListCommands.List(ctx, pageSize, pageNumber, nil)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	caches, err := listAll(func(pageSize, pageNumber int) (*pagination.Page[cache.Cache], error) {
+		return s.cacheCommands.List(ctx, pageSize, pageNumber, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimits, err := listAll(
+		func(pageSize, pageNumber int) (*pagination.Page[ratelimit.RateLimit], error) {
+			return s.rateLimitCommands.List(ctx, pageSize, pageNumber, nil)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	securityHeaders, err := listAll(
+		func(pageSize, pageNumber int) (*pagination.Page[securityheaders.SecurityHeaders], error) {
+			return s.securityHeadersCommands.List(ctx, pageSize, pageNumber, nil)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	upstreams, err := listAll(
+		func(pageSize, pageNumber int) (*pagination.Page[upstream.Upstream], error) {
+			return s.upstreamCommands.List(ctx, pageSize, pageNumber, nil)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	certificates, err := listAll(
+		func(pageSize, pageNumber int) (*pagination.Page[certificate.Certificate], error) {
+			return s.certificateCommands.List(ctx, pageSize, pageNumber, nil)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsProfiles, err := listAll(
+		func(pageSize, pageNumber int) (*pagination.Page[tlsprofile.TLSProfile], error) {
+			return s.tlsProfileCommands.List(ctx, pageSize, pageNumber, nil)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts, err := listAll(func(pageSize, pageNumber int) (*pagination.Page[host.Host], error) {
+		return s.hostCommands.List(ctx, pageSize, pageNumber, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	streams, err := listAll(
+		func(pageSize, pageNumber int) (*pagination.Page[stream.Stream], error) {
+			return s.streamCommands.List(ctx, pageSize, pageNumber, nil)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{
+		Version:         CurrentVersion,
+		Settings:        settingsData,
+		Integrations:    integrations,
+		VPNs:            vpns,
+		AccessLists:     accessLists,
+		Caches:          caches,
+		RateLimits:      rateLimits,
+		SecurityHeaders: securityHeaders,
+		Upstreams:       upstreams,
+		Certificates:    certificates,
+		TLSProfiles:     tlsProfiles,
+		Hosts:           hosts,
+		Streams:         streams,
+	}, nil
+}
+
+func listAll[T any](list func(pageSize, pageNumber int) (*pagination.Page[T], error)) ([]T, error) {
+	output := make([]T, 0)
+	for pageNumber := 0; ; pageNumber++ {
+		page, err := list(exportPageSize, pageNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Contents...)
+		if len(page.Contents) < exportPageSize || len(output) >= page.TotalItems {
+			return output, nil
+		}
+	}
+}
@@ -0,0 +1,473 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/certificate"
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/integration"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/core/stream"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/core/vpn"
+)
+
+func Test_service(t *testing.T) {
+	t.Run("Export", func(t *testing.T) {
+		t.Run("returns the current state with the certificate keys", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			result, err := commands.service().Export(t.Context(), true)
+
+			require.NoError(t, err)
+			assert.Equal(t, CurrentVersion, result.Version)
+			assert.Equal(t, current.Hosts, result.Hosts)
+			assert.Equal(t, "private", result.Certificates[0].PrivateKey)
+		})
+
+		t.Run("removes the certificate keys when not requested", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, newDocument())
+
+			result, err := commands.service().Export(t.Context(), false)
+
+			require.NoError(t, err)
+			assert.Empty(t, result.Certificates[0].PrivateKey)
+			assert.Empty(t, result.Certificates[0].PublicKey)
+			assert.Equal(t, []string{"example.com"}, result.Certificates[0].DomainNames)
+		})
+
+		t.Run("removes the backup secret key", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, newDocument())
+
+			result, err := commands.service().Export(t.Context(), true)
+
+			require.NoError(t, err)
+			assert.Nil(t, result.Settings.Backup.S3.SecretKey)
+			assert.Equal(t, "backups", result.Settings.Backup.S3.Bucket)
+		})
+
+		t.Run("removes the sensitive parameters of the integrations and VPNs", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			current.Integrations = []integration.Integration{
+				{
+					ID:     uuid.New(),
+					Driver: "TRUENAS",
+					Parameters: map[string]any{
+						"url":      "https://truenas.example.com",
+						"password": "truenas-password",
+					},
+				},
+			}
+			current.VPNs = []vpn.VPN{
+				{
+					ID:     uuid.New(),
+					Driver: "TAILSCALE",
+					Parameters: map[string]any{
+						"authKey":        "tskey-auth-value",
+						"coordinatorUrl": "https://login.example.com",
+					},
+				},
+			}
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			result, err := commands.service().Export(t.Context(), true)
+
+			require.NoError(t, err)
+			assert.Equal(
+				t,
+				map[string]any{"url": "https://truenas.example.com"},
+				result.Integrations[0].Parameters,
+			)
+			assert.Equal(
+				t,
+				map[string]any{"coordinatorUrl": "https://login.example.com"},
+				result.VPNs[0].Parameters,
+			)
+		})
+	})
+
+	t.Run("Import", func(t *testing.T) {
+		t.Run("rejects unsupported versions", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			document := newDocument()
+			document.Version = CurrentVersion + 1
+
+			_, err := newMockedCommands(ctrl).service().Import(t.Context(), document, true)
+
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+		})
+
+		t.Run("rejects entries without an ID", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			document := newDocument()
+			document.Hosts[0].ID = uuid.Nil
+
+			_, err := newMockedCommands(ctrl).service().Import(t.Context(), document, true)
+
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+		})
+
+		t.Run("returns an empty plan when nothing changed", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desired := *current
+			desired.Hosts = []host.Host{current.Hosts[0]}
+			desired.Hosts[0].DomainNames = []string{"example.com"}
+
+			changes, err := commands.service().Import(t.Context(), &desired, false)
+
+			require.NoError(t, err)
+			assert.Empty(t, changes)
+		})
+
+		t.Run("validates the changes on dry-run and rolls them back", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desired := newDocument()
+			desired.Settings = current.Settings
+			desired.Certificates = current.Certificates
+			desired.Hosts = []host.Host{current.Hosts[0]}
+			desired.Hosts[0].Enabled = false
+
+			commands.streams.EXPECT().Delete(t.Context(), current.Streams[0].ID).Return(nil)
+			commands.hosts.EXPECT().Save(t.Context(), &desired.Hosts[0]).Return(nil)
+			commands.streams.EXPECT().Save(t.Context(), &desired.Streams[0]).Return(nil)
+
+			changes, err := commands.service().Import(t.Context(), desired, true)
+
+			require.NoError(t, err)
+			assert.ErrorIs(t, commands.transactionErr, errDryRun)
+			assert.Equal(t, []Change{
+				{
+					EntityID:   &current.Streams[0].ID,
+					EntityType: StreamEntityType,
+					Action:     DeleteAction,
+					Name:       "Stream",
+				},
+				{
+					EntityID:   &desired.Hosts[0].ID,
+					EntityType: HostEntityType,
+					Action:     UpdateAction,
+					Name:       "example.com",
+				},
+				{
+					EntityID:   &desired.Streams[0].ID,
+					EntityType: StreamEntityType,
+					Action:     CreateAction,
+					Name:       "Stream",
+				},
+			}, changes)
+		})
+
+		t.Run("applies the changes in dependency order", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desired := newDocument()
+			desired.Streams = nil
+
+			gomock.InOrder(
+				commands.hosts.EXPECT().Delete(t.Context(), current.Hosts[0].ID).Return(nil),
+				commands.streams.EXPECT().Delete(t.Context(), current.Streams[0].ID).Return(nil),
+				commands.certificates.EXPECT().
+					Save(t.Context(), &desired.Certificates[0]).
+					Return(nil),
+				commands.hosts.EXPECT().Save(t.Context(), &desired.Hosts[0]).Return(nil),
+				commands.certificates.EXPECT().
+					Delete(t.Context(), current.Certificates[0].ID).
+					Return(nil),
+			)
+
+			changes, err := commands.service().Import(t.Context(), desired, false)
+
+			require.NoError(t, err)
+			assert.Len(t, changes, 5)
+		})
+
+		t.Run("saves the rate limits before the hosts", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desired := *current
+			desired.RateLimits = []ratelimit.RateLimit{{ID: uuid.New(), Name: "API clients"}}
+			desired.Hosts = []host.Host{current.Hosts[0]}
+			desired.Hosts[0].RateLimitID = &desired.RateLimits[0].ID
+
+			gomock.InOrder(
+				commands.rateLimits.EXPECT().
+					Save(t.Context(), &desired.RateLimits[0]).
+					Return(nil),
+				commands.hosts.EXPECT().Save(t.Context(), &desired.Hosts[0]).Return(nil),
+			)
+
+			changes, err := commands.service().Import(t.Context(), &desired, false)
+
+			require.NoError(t, err)
+			assert.Len(t, changes, 2)
+			assert.Equal(t, RateLimitEntityType, changes[0].EntityType)
+		})
+
+		t.Run("saves the security headers before the hosts", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desired := *current
+			desired.SecurityHeaders = []securityheaders.SecurityHeaders{
+				{ID: uuid.New(), Name: "Strict"},
+			}
+			desired.Hosts = []host.Host{current.Hosts[0]}
+			desired.Hosts[0].SecurityHeadersID = &desired.SecurityHeaders[0].ID
+
+			gomock.InOrder(
+				commands.securityHeaders.EXPECT().
+					Save(t.Context(), &desired.SecurityHeaders[0]).
+					Return(nil),
+				commands.hosts.EXPECT().Save(t.Context(), &desired.Hosts[0]).Return(nil),
+			)
+
+			changes, err := commands.service().Import(t.Context(), &desired, false)
+
+			require.NoError(t, err)
+			assert.Len(t, changes, 2)
+			assert.Equal(t, SecurityHeadersEntityType, changes[0].EntityType)
+		})
+
+		t.Run("saves the TLS profiles before the hosts", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desired := *current
+			desired.TLSProfiles = []tlsprofile.TLSProfile{{ID: uuid.New(), Name: "Modern"}}
+			desired.Hosts = []host.Host{current.Hosts[0]}
+			desired.Hosts[0].DomainNames = []string{"example.org"}
+
+			gomock.InOrder(
+				commands.tlsProfiles.EXPECT().
+					Save(t.Context(), &desired.TLSProfiles[0]).
+					Return(nil),
+				commands.hosts.EXPECT().Save(t.Context(), &desired.Hosts[0]).Return(nil),
+			)
+
+			changes, err := commands.service().Import(t.Context(), &desired, false)
+
+			require.NoError(t, err)
+			assert.Len(t, changes, 2)
+			assert.Equal(t, TLSProfileEntityType, changes[0].EntityType)
+		})
+
+		t.Run("stops on the first failed change", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desired := *current
+			desired.Streams = []stream.Stream{current.Streams[0], {ID: uuid.New()}}
+
+			commands.streams.EXPECT().Save(t.Context(), gomock.Any()).Return(assert.AnError)
+
+			_, err := commands.service().Import(t.Context(), &desired, false)
+
+			assert.Equal(t, assert.AnError, err)
+			assert.Equal(t, assert.AnError, commands.transactionErr)
+		})
+
+		t.Run("returns the validation errors on dry-run", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desired := *current
+			desired.Streams = []stream.Stream{current.Streams[0], {ID: uuid.New()}}
+
+			commands.streams.EXPECT().Save(t.Context(), gomock.Any()).Return(assert.AnError)
+
+			_, err := commands.service().Import(t.Context(), &desired, true)
+
+			assert.Equal(t, assert.AnError, err)
+		})
+
+		t.Run("keeps the existing certificate when the keys are not provided", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desired := *current
+			desired.Certificates = []certificate.Certificate{
+				{ID: current.Certificates[0].ID, ProviderID: "OTHER"},
+			}
+
+			changes, err := commands.service().Import(t.Context(), &desired, true)
+
+			require.NoError(t, err)
+			assert.Empty(t, changes)
+		})
+
+		t.Run("keeps the existing backup secret key when not provided", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desiredS3 := *current.Settings.Backup.S3
+			desiredS3.SecretKey = nil
+			desiredBackup := *current.Settings.Backup
+			desiredBackup.S3 = &desiredS3
+			desiredSettings := *current.Settings
+			desiredSettings.Backup = &desiredBackup
+
+			desired := *current
+			desired.Settings = &desiredSettings
+
+			changes, err := commands.service().Import(t.Context(), &desired, true)
+
+			require.NoError(t, err)
+			assert.Empty(t, changes)
+			assert.Nil(t, desiredS3.SecretKey)
+		})
+
+		t.Run("keeps the existing sensitive parameters when not provided", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			current.VPNs = []vpn.VPN{
+				{
+					ID:     uuid.New(),
+					Driver: "TAILSCALE",
+					Parameters: map[string]any{
+						"authKey":        "tskey-auth-value",
+						"coordinatorUrl": "https://login.example.com",
+					},
+				},
+			}
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desired := *current
+			desired.VPNs = []vpn.VPN{current.VPNs[0]}
+			desired.VPNs[0].Parameters = map[string]any{
+				"coordinatorUrl": "https://login.example.com",
+			}
+
+			changes, err := commands.service().Import(t.Context(), &desired, true)
+
+			require.NoError(t, err)
+			assert.Empty(t, changes)
+			assert.NotContains(t, desired.VPNs[0].Parameters, "authKey")
+		})
+
+		t.Run("rejects new certificates without keys", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			current := newDocument()
+			commands := newMockedCommands(ctrl)
+			commands.expectCurrentState(t, current)
+
+			desired := *current
+			desired.Certificates = []certificate.Certificate{{ID: uuid.New()}}
+
+			_, err := commands.service().Import(t.Context(), &desired, true)
+
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+		})
+	})
+
+	t.Run("listAll", func(t *testing.T) {
+		t.Run("reads every page of the entities", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			firstPage := make([]host.Host, exportPageSize)
+			for index := range firstPage {
+				firstPage[index] = host.Host{ID: uuid.New()}
+			}
+
+			commands := newMockedCommands(ctrl)
+			commands.hosts.EXPECT().
+				List(t.Context(), exportPageSize, 0, nil).
+				Return(pagination.New(0, exportPageSize, exportPageSize+1, firstPage), nil)
+			commands.hosts.EXPECT().
+				List(t.Context(), exportPageSize, 1, nil).
+				Return(pagination.New(1, exportPageSize, exportPageSize+1, []host.Host{{}}), nil)
+
+			result, err := listAll(
+				func(pageSize, pageNumber int) (*pagination.Page[host.Host], error) {
+					return commands.hosts.List(t.Context(), pageSize, pageNumber, nil)
+				},
+			)
+
+			require.NoError(t, err)
+			assert.Len(t, result, exportPageSize+1)
+		})
+	})
+}
@@ -0,0 +1,88 @@
+package tlsprofile
+
+import (
+	"github.com/google/uuid"
+)
+
+type Preset string
+
+const (
+	ModernPreset       Preset = "MODERN"
+	IntermediatePreset Preset = "INTERMEDIATE"
+	LegacyPreset       Preset = "LEGACY"
+	CustomPreset       Preset = "CUSTOM"
+)
+
+type Protocol string
+
+const (
+	TLSv1Protocol  Protocol = "TLSv1"
+	TLSv11Protocol Protocol = "TLSv1.1"
+	TLSv12Protocol Protocol = "TLSv1.2"
+	TLSv13Protocol Protocol = "TLSv1.3"
+)
+
+type ClientVerificationMode string
+
+const (
+	RequiredClientVerificationMode ClientVerificationMode = "REQUIRED"
+	OptionalClientVerificationMode ClientVerificationMode = "OPTIONAL"
+)
+
+type TLSProfile struct {
+	Ciphers             *string
+	Name                string
+	Preset              Preset
+	Protocols           []Protocol
+	ClientVerification  ClientVerification
+	HSTS                HSTS
+	Session             Session
+	ID                  uuid.UUID
+	PreferServerCiphers bool
+	OCSPStapling        bool
+}
+
+type HSTS struct {
+	MaxAgeSeconds     int
+	Enabled           bool
+	IncludeSubdomains bool
+	Preload           bool
+}
+
+type Session struct {
+	CacheSizeMB    int
+	TimeoutMinutes int
+	CacheEnabled   bool
+	TicketsEnabled bool
+}
+
+type ClientVerification struct {
+	CertificateAuthorities *string
+	Mode                   ClientVerificationMode
+	Depth                  int
+	Enabled                bool
+}
+
+func (p *TLSProfile) EffectiveProtocols() []Protocol {
+	if definition, found := presetDefinitions[p.Preset]; found {
+		return definition.protocols
+	}
+
+	return p.Protocols
+}
+
+func (p *TLSProfile) EffectiveCiphers() *string {
+	if definition, found := presetDefinitions[p.Preset]; found {
+		return definition.ciphers
+	}
+
+	return p.Ciphers
+}
+
+func (p *TLSProfile) EffectivePreferServerCiphers() bool {
+	if definition, found := presetDefinitions[p.Preset]; found {
+		return definition.preferServerCiphers
+	}
+
+	return p.PreferServerCiphers
+}
@@ -0,0 +1,54 @@
+package tlsprofile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TLSProfile(t *testing.T) {
+	t.Run("EffectiveProtocols", func(t *testing.T) {
+		t.Run("returns the preset protocols", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Preset = ModernPreset
+			profile.Protocols = []Protocol{TLSv1Protocol}
+
+			assert.Equal(t, []Protocol{TLSv13Protocol}, profile.EffectiveProtocols())
+		})
+
+		t.Run("returns the custom protocols", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Preset = CustomPreset
+			profile.Protocols = []Protocol{TLSv12Protocol}
+
+			assert.Equal(t, []Protocol{TLSv12Protocol}, profile.EffectiveProtocols())
+		})
+	})
+
+	t.Run("EffectiveCiphers", func(t *testing.T) {
+		t.Run("returns nil for the modern preset", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Preset = ModernPreset
+
+			assert.Nil(t, profile.EffectiveCiphers())
+		})
+
+		t.Run("lowers the security level for the legacy preset", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Preset = LegacyPreset
+
+			assert.Contains(t, *profile.EffectiveCiphers(), "@SECLEVEL=0")
+			assert.True(t, profile.EffectivePreferServerCiphers())
+		})
+
+		t.Run("returns the custom ciphers", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Preset = CustomPreset
+			profile.Ciphers = new("HIGH:!aNULL")
+			profile.PreferServerCiphers = true
+
+			assert.Equal(t, "HIGH:!aNULL", *profile.EffectiveCiphers())
+			assert.True(t, profile.EffectivePreferServerCiphers())
+		})
+	})
+}
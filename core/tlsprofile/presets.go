@@ -0,0 +1,40 @@
+package tlsprofile
+
+type presetDefinition struct {
+	ciphers             *string
+	protocols           []Protocol
+	preferServerCiphers bool
+}
+
+const (
+	intermediateCiphers = "ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256:" +
+		"ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384:" +
+		"ECDHE-ECDSA-CHACHA20-POLY1305:ECDHE-RSA-CHACHA20-POLY1305:" +
+		"DHE-RSA-AES128-GCM-SHA256:DHE-RSA-AES256-GCM-SHA384:DHE-RSA-CHACHA20-POLY1305"
+
+	legacyCiphers = intermediateCiphers +
+		":ECDHE-ECDSA-AES128-SHA256:ECDHE-RSA-AES128-SHA256:ECDHE-ECDSA-AES128-SHA:ECDHE-RSA-AES128-SHA" +
+		":ECDHE-ECDSA-AES256-SHA384:ECDHE-RSA-AES256-SHA384:ECDHE-ECDSA-AES256-SHA:ECDHE-RSA-AES256-SHA" +
+		":DHE-RSA-AES128-SHA256:DHE-RSA-AES256-SHA256:AES128-GCM-SHA256:AES256-GCM-SHA384" +
+		":AES128-SHA256:AES256-SHA256:AES128-SHA:AES256-SHA:DES-CBC3-SHA:@SECLEVEL=0"
+)
+
+var presetDefinitions = map[Preset]presetDefinition{
+	ModernPreset: {
+		protocols: []Protocol{TLSv13Protocol},
+	},
+	IntermediatePreset: {
+		protocols: []Protocol{TLSv12Protocol, TLSv13Protocol},
+		ciphers:   new(intermediateCiphers),
+	},
+	LegacyPreset: {
+		protocols: []Protocol{
+			TLSv1Protocol,
+			TLSv11Protocol,
+			TLSv12Protocol,
+			TLSv13Protocol,
+		},
+		ciphers:             new(legacyCiphers),
+		preferServerCiphers: true,
+	},
+}
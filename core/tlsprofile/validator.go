@@ -0,0 +1,190 @@
+package tlsprofile
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/validation"
+	"dillmann.com.br/nginx-ignition/core/common/valuerange"
+)
+
+const hstsPreloadMinimumMaxAge = 31536000
+
+var (
+	cipherListPattern     = regexp.MustCompile(`^[A-Za-z0-9@=+!._:-]+$`)
+	sessionTimeoutRange   = valuerange.New(1, 1440)
+	sessionCacheSizeRange = valuerange.New(1, 1024)
+	verifyDepthRange      = valuerange.New(1, 10)
+)
+
+type validator struct {
+	delegate *validation.ConsistencyValidator
+}
+
+func newValidator() *validator {
+	return &validator{
+		delegate: validation.NewValidator(),
+	}
+}
+
+func (v *validator) validate(ctx context.Context, p *TLSProfile) error {
+	if strings.TrimSpace(p.Name) == "" {
+		v.delegate.Add("name", i18n.M(ctx, i18n.K.CommonValueMissing))
+	}
+
+	v.validatePreset(ctx, p)
+	v.validateHSTS(ctx, p.HSTS)
+	v.validateSession(ctx, p.Session)
+	v.validateClientVerification(ctx, p.ClientVerification)
+
+	return v.delegate.Result()
+}
+
+func (v *validator) validatePreset(ctx context.Context, p *TLSProfile) {
+	switch p.Preset {
+	case ModernPreset, IntermediatePreset, LegacyPreset:
+		return
+	case CustomPreset:
+		// Validated below
+	default:
+		v.delegate.Add("preset", i18n.M(ctx, i18n.K.CommonInvalidValue))
+		return
+	}
+
+	if len(p.Protocols) == 0 {
+		v.delegate.Add("protocols", i18n.M(ctx, i18n.K.CommonAtLeastOneRequired))
+	}
+
+	for index, protocol := range p.Protocols {
+		switch protocol {
+		case TLSv1Protocol, TLSv11Protocol, TLSv12Protocol, TLSv13Protocol:
+			// Valid
+		default:
+			path := fmt.Sprintf("protocols[%d]", index)
+			v.delegate.Add(path, i18n.M(ctx, i18n.K.CommonInvalidValue))
+		}
+	}
+
+	if p.Ciphers != nil && !cipherListPattern.MatchString(*p.Ciphers) {
+		v.delegate.Add("ciphers", i18n.M(ctx, i18n.K.CoreTlsprofileInvalidCiphers))
+	}
+}
+
+func (v *validator) validateHSTS(ctx context.Context, hsts HSTS) {
+	if !hsts.Enabled {
+		return
+	}
+
+	if hsts.MaxAgeSeconds < 1 {
+		v.delegate.Add("hsts.maxAgeSeconds", i18n.M(ctx, i18n.K.CommonCannotBeZero))
+	}
+
+	if !hsts.Preload {
+		return
+	}
+
+	if !hsts.IncludeSubdomains {
+		v.delegate.Add(
+			"hsts.preload",
+			i18n.M(ctx, i18n.K.CoreTlsprofilePreloadRequiresSubdomains),
+		)
+	}
+
+	if hsts.MaxAgeSeconds < hstsPreloadMinimumMaxAge {
+		v.delegate.Add(
+			"hsts.maxAgeSeconds",
+			i18n.M(ctx, i18n.K.CoreTlsprofilePreloadMinimumMaxAge).
+				V("min", hstsPreloadMinimumMaxAge),
+		)
+	}
+}
+
+func (v *validator) validateSession(ctx context.Context, session Session) {
+	if !sessionTimeoutRange.Contains(session.TimeoutMinutes) {
+		v.delegate.Add(
+			"session.timeoutMinutes",
+			i18n.M(ctx, i18n.K.CommonBetweenValues).
+				V("min", sessionTimeoutRange.Min).
+				V("max", sessionTimeoutRange.Max),
+		)
+	}
+
+	if session.CacheEnabled && !sessionCacheSizeRange.Contains(session.CacheSizeMB) {
+		v.delegate.Add(
+			"session.cacheSizeMb",
+			i18n.M(ctx, i18n.K.CommonBetweenValues).
+				V("min", sessionCacheSizeRange.Min).
+				V("max", sessionCacheSizeRange.Max),
+		)
+	}
+}
+
+func (v *validator) validateClientVerification(
+	ctx context.Context,
+	verification ClientVerification,
+) {
+	if !verification.Enabled {
+		return
+	}
+
+	switch verification.Mode {
+	case RequiredClientVerificationMode, OptionalClientVerificationMode:
+		// Valid
+	default:
+		v.delegate.Add("clientVerification.mode", i18n.M(ctx, i18n.K.CommonInvalidValue))
+	}
+
+	if !verifyDepthRange.Contains(verification.Depth) {
+		v.delegate.Add(
+			"clientVerification.depth",
+			i18n.M(ctx, i18n.K.CommonBetweenValues).
+				V("min", verifyDepthRange.Min).
+				V("max", verifyDepthRange.Max),
+		)
+	}
+
+	const authoritiesField = "clientVerification.certificateAuthorities"
+	if verification.CertificateAuthorities == nil ||
+		strings.TrimSpace(*verification.CertificateAuthorities) == "" {
+		v.delegate.Add(authoritiesField, i18n.M(ctx, i18n.K.CommonValueMissing))
+		return
+	}
+
+	if !containsCertificates(*verification.CertificateAuthorities) {
+		v.delegate.Add(
+			authoritiesField,
+			i18n.M(ctx, i18n.K.CoreTlsprofileInvalidCertificateAuthorities),
+		)
+	}
+}
+
+func containsCertificates(bundle string) bool {
+	rest := []byte(strings.TrimSpace(bundle))
+	found := false
+
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return false
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return false
+		}
+
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return false
+		}
+
+		found = true
+		rest = []byte(strings.TrimSpace(string(rest)))
+	}
+
+	return found
+}
@@ -0,0 +1,228 @@
+package tlsprofile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validator(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		t.Run("valid profile passes", func(t *testing.T) {
+			profile := newTLSProfile()
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("empty name fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Name = "   "
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("invalid preset fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Preset = "INVALID"
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("custom preset without protocols fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Preset = CustomPreset
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("custom preset with invalid protocol fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Preset = CustomPreset
+			profile.Protocols = []Protocol{"SSLv3"}
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("custom preset with valid protocols and ciphers passes", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Preset = CustomPreset
+			profile.Protocols = []Protocol{TLSv12Protocol, TLSv13Protocol}
+			profile.Ciphers = new("ECDHE-RSA-AES128-GCM-SHA256:!aNULL")
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("custom preset with malformed ciphers fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Preset = CustomPreset
+			profile.Protocols = []Protocol{TLSv13Protocol}
+			profile.Ciphers = new("HIGH; return 200")
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("HSTS without maximum age fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.HSTS = HSTS{Enabled: true}
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("HSTS preload without subdomains fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.HSTS = HSTS{Enabled: true, Preload: true, MaxAgeSeconds: 63072000}
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("HSTS preload with short maximum age fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.HSTS = HSTS{
+				Enabled:           true,
+				Preload:           true,
+				IncludeSubdomains: true,
+				MaxAgeSeconds:     3600,
+			}
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("HSTS preload with subdomains and long maximum age passes", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.HSTS = HSTS{
+				Enabled:           true,
+				Preload:           true,
+				IncludeSubdomains: true,
+				MaxAgeSeconds:     63072000,
+			}
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("session timeout out of range fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Session.TimeoutMinutes = 0
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("session cache size out of range fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Session.CacheSizeMB = 0
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("disabled session cache ignores the size", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.Session.CacheEnabled = false
+			profile.Session.CacheSizeMB = 0
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("client verification without certificate authorities fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.ClientVerification = ClientVerification{
+				Enabled: true,
+				Mode:    RequiredClientVerificationMode,
+				Depth:   1,
+			}
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run(
+			"client verification with malformed certificate authorities fails",
+			func(t *testing.T) {
+				profile := newTLSProfile()
+				profile.ClientVerification = ClientVerification{
+					Enabled:                true,
+					Mode:                   RequiredClientVerificationMode,
+					Depth:                  1,
+					CertificateAuthorities: new("not a certificate"),
+				}
+				profileValidator := newValidator()
+
+				err := profileValidator.validate(t.Context(), profile)
+
+				assert.Error(t, err)
+			},
+		)
+
+		t.Run("client verification with invalid mode fails", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.ClientVerification = ClientVerification{
+				Enabled:                true,
+				Mode:                   "INVALID",
+				Depth:                  1,
+				CertificateAuthorities: new(newCertificateAuthorityBundle(t)),
+			}
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("client verification with valid settings passes", func(t *testing.T) {
+			profile := newTLSProfile()
+			profile.ClientVerification = ClientVerification{
+				Enabled:                true,
+				Mode:                   OptionalClientVerificationMode,
+				Depth:                  2,
+				CertificateAuthorities: new(newCertificateAuthorityBundle(t)),
+			}
+			profileValidator := newValidator()
+
+			err := profileValidator.validate(t.Context(), profile)
+
+			assert.NoError(t, err)
+		})
+	})
+}
@@ -0,0 +1,74 @@
+package trafficstats
+
+import (
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+func newStats(requests uint64) *nginx.Stats {
+	zone := nginx.StatsZoneData{
+		RequestCounter:     requests,
+		InBytes:            requests * 100,
+		OutBytes:           requests * 1000,
+		RequestMsecCounter: requests * 10,
+		Responses: nginx.StatsResponses{
+			Status2xx: requests,
+		},
+	}
+
+	return &nginx.Stats{
+		ServerZones: map[string]nginx.StatsZoneData{
+			"*":           zone,
+			"example.com": zone,
+		},
+		FilterZones: map[string]map[string]nginx.StatsZoneData{
+			"hosts": {
+				"host-1": zone,
+			},
+		},
+		UpstreamZones: map[string][]nginx.StatsUpstreamZoneData{
+			"backend": {
+				{
+					Server:              "10.0.0.1:8080",
+					RequestCounter:      requests,
+					ResponseMsecCounter: requests * 5,
+					Responses: nginx.StatsUpstreamResponses{
+						Status2xx: requests,
+					},
+				},
+				{
+					Server:         "10.0.0.2:8080",
+					RequestCounter: requests,
+					Responses: nginx.StatsUpstreamResponses{
+						Status5xx: requests,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newSample(resolution Resolution, timestamp time.Time, requests uint64) Sample {
+	return Sample{
+		Timestamp:  timestamp,
+		Resolution: resolution,
+		Scope:      HostScope,
+		Key:        "host-1",
+		Counters: Counters{
+			Requests:  requests,
+			Status2xx: requests,
+		},
+	}
+}
+
+func newConfiguration() *configuration.Configuration {
+	return configuration.NewWithOverrides(map[string]string{
+		"nginx-ignition.traffic-stats.history.enabled":                 "true",
+		"nginx-ignition.traffic-stats.history.sample-interval-seconds": "60",
+		"nginx-ignition.traffic-stats.history.minute-retention-hours":  "24",
+		"nginx-ignition.traffic-stats.history.hour-retention-days":     "30",
+		"nginx-ignition.traffic-stats.history.day-retention-days":      "365",
+	})
+}
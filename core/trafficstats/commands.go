@@ -0,0 +1,9 @@
+package trafficstats
+
+import (
+	"context"
+)
+
+type Commands interface {
+	GetHistory(ctx context.Context, query *HistoryQuery) ([]Sample, error)
+}
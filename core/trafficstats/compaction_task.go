@@ -0,0 +1,44 @@
+package trafficstats
+
+import (
+	"context"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+)
+
+const (
+	compactionInterval = time.Hour
+)
+
+type compactionTask struct {
+	service *service
+}
+
+func registerCompactionTask(
+	ctx context.Context,
+	service *service,
+	sched *scheduler.Scheduler,
+) error {
+	task := compactionTask{service}
+	return sched.Register(ctx, &task)
+}
+
+func (t compactionTask) Run(ctx context.Context) error {
+	return t.service.compact(ctx)
+}
+
+func (t compactionTask) Schedule(_ context.Context) (*scheduler.Schedule, error) {
+	return &scheduler.Schedule{
+		Enabled:  true,
+		Interval: compactionInterval,
+	}, nil
+}
+
+func (t compactionTask) OnScheduleStarted(_ context.Context) {
+	log.Infof(
+		"Traffic stats history compaction task scheduled to run every %v minutes",
+		compactionInterval.Minutes(),
+	)
+}
@@ -0,0 +1,24 @@
+package trafficstats
+
+import (
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/container"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+func Install() error {
+	if err := container.Provide(newCommands); err != nil {
+		return err
+	}
+
+	return container.Run(registerSampleTask, registerCompactionTask)
+}
+
+func newCommands(
+	repository Repository,
+	nginxCommands nginx.Commands,
+	cfg *configuration.Configuration,
+) (Commands, *service) {
+	serviceInstance := newService(repository, nginxCommands, cfg)
+	return serviceInstance, serviceInstance
+}
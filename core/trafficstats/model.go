@@ -0,0 +1,85 @@
+package trafficstats
+
+import (
+	"time"
+)
+
+type Scope string
+
+const (
+	GlobalScope   Scope = "GLOBAL"
+	HostScope     Scope = "HOST"
+	DomainScope   Scope = "DOMAIN"
+	UpstreamScope Scope = "UPSTREAM"
+)
+
+type Resolution string
+
+const (
+	MinuteResolution Resolution = "MINUTE"
+	HourResolution   Resolution = "HOUR"
+	DayResolution    Resolution = "DAY"
+)
+
+type Counters struct {
+	Requests     uint64
+	InBytes      uint64
+	OutBytes     uint64
+	RequestMsecs uint64
+	Status1xx    uint64
+	Status2xx    uint64
+	Status3xx    uint64
+	Status4xx    uint64
+	Status5xx    uint64
+}
+
+type Sample struct {
+	Timestamp  time.Time
+	Resolution Resolution
+	Scope      Scope
+	Key        string
+	Counters   Counters
+}
+
+type HistoryQuery struct {
+	From       time.Time
+	To         time.Time
+	Key        *string
+	Resolution Resolution
+	Scope      Scope
+}
+
+func (r Resolution) Duration() time.Duration {
+	switch r {
+	case HourResolution:
+		return time.Hour
+	case DayResolution:
+		return 24 * time.Hour
+	default:
+		return time.Minute
+	}
+}
+
+func (r Resolution) IsValid() bool {
+	return r == MinuteResolution || r == HourResolution || r == DayResolution
+}
+
+func (r Resolution) BucketOf(timestamp time.Time) time.Time {
+	return timestamp.UTC().Truncate(r.Duration())
+}
+
+func (s Scope) IsValid() bool {
+	return s == GlobalScope || s == HostScope || s == DomainScope || s == UpstreamScope
+}
+
+func (c *Counters) Add(other *Counters) {
+	c.Requests += other.Requests
+	c.InBytes += other.InBytes
+	c.OutBytes += other.OutBytes
+	c.RequestMsecs += other.RequestMsecs
+	c.Status1xx += other.Status1xx
+	c.Status2xx += other.Status2xx
+	c.Status3xx += other.Status3xx
+	c.Status4xx += other.Status4xx
+	c.Status5xx += other.Status5xx
+}
@@ -0,0 +1,19 @@
+package trafficstats
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Add(ctx context.Context, samples []Sample) error
+	FindRange(ctx context.Context, scope Scope, key *string, from, to time.Time) ([]Sample, error)
+	FindOlderThan(ctx context.Context, resolution Resolution, before time.Time) ([]Sample, error)
+	Compact(
+		ctx context.Context,
+		source Resolution,
+		before time.Time,
+		samples []Sample,
+	) error
+	DeleteOlderThan(ctx context.Context, resolution Resolution, before time.Time) (int, error)
+}
@@ -0,0 +1,49 @@
+package trafficstats
+
+import (
+	"context"
+
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/common/scheduler"
+)
+
+type sampleTask struct {
+	service *service
+}
+
+func registerSampleTask(
+	ctx context.Context,
+	service *service,
+	sched *scheduler.Scheduler,
+) error {
+	task := sampleTask{service}
+	return sched.Register(ctx, &task)
+}
+
+func (t sampleTask) Run(ctx context.Context) error {
+	return t.service.sample(ctx)
+}
+
+func (t sampleTask) Schedule(_ context.Context) (*scheduler.Schedule, error) {
+	cfg, err := t.service.readSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduler.Schedule{
+		Enabled:  cfg.enabled,
+		Interval: cfg.sampleInterval,
+	}, nil
+}
+
+func (t sampleTask) OnScheduleStarted(ctx context.Context) {
+	schedule, err := t.Schedule(ctx)
+	if err != nil {
+		return
+	}
+
+	log.Infof(
+		"Traffic stats history sampling task scheduled to run every %v seconds",
+		schedule.Interval.Seconds(),
+	)
+}
@@ -0,0 +1,35 @@
+package trafficstats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+)
+
+func Test_sampleTask(t *testing.T) {
+	t.Run("Schedule", func(t *testing.T) {
+		t.Run("uses the configured interval", func(t *testing.T) {
+			task := &sampleTask{newService(nil, nil, newConfiguration())}
+			schedule, err := task.Schedule(t.Context())
+
+			assert.NoError(t, err)
+			assert.True(t, schedule.Enabled)
+			assert.Equal(t, time.Minute, schedule.Interval)
+		})
+
+		t.Run("returns error when the interval is not positive", func(t *testing.T) {
+			cfg := configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.traffic-stats.history.enabled":                 "true",
+				"nginx-ignition.traffic-stats.history.sample-interval-seconds": "0",
+			})
+			task := &sampleTask{newService(nil, nil, cfg)}
+			schedule, err := task.Schedule(t.Context())
+
+			assert.Error(t, err)
+			assert.Nil(t, schedule)
+		})
+	})
+}
@@ -0,0 +1,203 @@
+package trafficstats
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+const (
+	globalServerZone = "*"
+	hostsFilterZone  = "hosts"
+)
+
+type sampleKey struct {
+	scope Scope
+	key   string
+}
+
+type sampler struct {
+	previous map[sampleKey]Counters
+	lock     sync.Mutex
+}
+
+func newSampler() *sampler {
+	return &sampler{}
+}
+
+func (s *sampler) deltas(stats *nginx.Stats, timestamp time.Time) []Sample {
+	current := extractCounters(stats)
+
+	s.lock.Lock()
+	previous := s.previous
+	s.previous = current
+	s.lock.Unlock()
+
+	if previous == nil {
+		return nil
+	}
+
+	bucket := MinuteResolution.BucketOf(timestamp)
+	output := make([]Sample, 0, len(current))
+	for key, counters := range current {
+		delta, valid := subtractCounters(&counters, new(previous[key]))
+		if !valid || (delta.Requests == 0 && delta.InBytes == 0 && delta.OutBytes == 0) {
+			continue
+		}
+
+		output = append(output, Sample{
+			Timestamp:  bucket,
+			Resolution: MinuteResolution,
+			Scope:      key.scope,
+			Key:        key.key,
+			Counters:   *delta,
+		})
+	}
+
+	sortSamples(output)
+	return output
+}
+
+func (s *sampler) reset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.previous = nil
+}
+
+func extractCounters(stats *nginx.Stats) map[sampleKey]Counters {
+	output := make(map[sampleKey]Counters)
+
+	for domain, zone := range stats.ServerZones {
+		key := sampleKey{DomainScope, domain}
+		if domain == globalServerZone {
+			key = sampleKey{GlobalScope, ""}
+		}
+
+		output[key] = zoneCounters(&zone)
+	}
+
+	for hostID, zone := range stats.FilterZones[hostsFilterZone] {
+		output[sampleKey{HostScope, hostID}] = zoneCounters(&zone)
+	}
+
+	for upstream, servers := range stats.UpstreamZones {
+		counters := Counters{}
+		for _, server := range servers {
+			counters.Add(new(upstreamServerCounters(&server)))
+		}
+
+		output[sampleKey{UpstreamScope, upstream}] = counters
+	}
+
+	return output
+}
+
+func zoneCounters(zone *nginx.StatsZoneData) Counters {
+	return Counters{
+		Requests:     zone.RequestCounter,
+		InBytes:      zone.InBytes,
+		OutBytes:     zone.OutBytes,
+		RequestMsecs: zone.RequestMsecCounter,
+		Status1xx:    zone.Responses.Status1xx,
+		Status2xx:    zone.Responses.Status2xx,
+		Status3xx:    zone.Responses.Status3xx,
+		Status4xx:    zone.Responses.Status4xx,
+		Status5xx:    zone.Responses.Status5xx,
+	}
+}
+
+func upstreamServerCounters(server *nginx.StatsUpstreamZoneData) Counters {
+	return Counters{
+		Requests:     server.RequestCounter,
+		InBytes:      server.InBytes,
+		OutBytes:     server.OutBytes,
+		RequestMsecs: server.ResponseMsecCounter,
+		Status1xx:    server.Responses.Status1xx,
+		Status2xx:    server.Responses.Status2xx,
+		Status3xx:    server.Responses.Status3xx,
+		Status4xx:    server.Responses.Status4xx,
+		Status5xx:    server.Responses.Status5xx,
+	}
+}
+
+func subtractCounters(current, previous *Counters) (*Counters, bool) {
+	pairs := [][2]uint64{
+		{current.Requests, previous.Requests},
+		{current.InBytes, previous.InBytes},
+		{current.OutBytes, previous.OutBytes},
+		{current.RequestMsecs, previous.RequestMsecs},
+		{current.Status1xx, previous.Status1xx},
+		{current.Status2xx, previous.Status2xx},
+		{current.Status3xx, previous.Status3xx},
+		{current.Status4xx, previous.Status4xx},
+		{current.Status5xx, previous.Status5xx},
+	}
+
+	for _, pair := range pairs {
+		if pair[0] < pair[1] {
+			return nil, false
+		}
+	}
+
+	return &Counters{
+		Requests:     current.Requests - previous.Requests,
+		InBytes:      current.InBytes - previous.InBytes,
+		OutBytes:     current.OutBytes - previous.OutBytes,
+		RequestMsecs: current.RequestMsecs - previous.RequestMsecs,
+		Status1xx:    current.Status1xx - previous.Status1xx,
+		Status2xx:    current.Status2xx - previous.Status2xx,
+		Status3xx:    current.Status3xx - previous.Status3xx,
+		Status4xx:    current.Status4xx - previous.Status4xx,
+		Status5xx:    current.Status5xx - previous.Status5xx,
+	}, true
+}
+
+func aggregate(samples []Sample, resolution Resolution) []Sample {
+	type bucketKey struct {
+		timestamp time.Time
+		sampleKey
+	}
+
+	buckets := make(map[bucketKey]*Sample)
+	for _, sample := range samples {
+		key := bucketKey{
+			timestamp: resolution.BucketOf(sample.Timestamp),
+			sampleKey: sampleKey{sample.Scope, sample.Key},
+		}
+
+		bucket, exists := buckets[key]
+		if !exists {
+			bucket = &Sample{
+				Timestamp:  key.timestamp,
+				Resolution: resolution,
+				Scope:      sample.Scope,
+				Key:        sample.Key,
+			}
+			buckets[key] = bucket
+		}
+
+		bucket.Counters.Add(&sample.Counters)
+	}
+
+	output := make([]Sample, 0, len(buckets))
+	for _, bucket := range buckets {
+		output = append(output, *bucket)
+	}
+
+	sortSamples(output)
+	return output
+}
+
+func sortSamples(samples []Sample) {
+	slices.SortFunc(samples, func(left, right Sample) int {
+		return cmp.Or(
+			cmp.Compare(left.Scope, right.Scope),
+			cmp.Compare(left.Key, right.Key),
+			left.Timestamp.Compare(right.Timestamp),
+		)
+	})
+}
@@ -0,0 +1,101 @@
+package trafficstats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sampler(t *testing.T) {
+	timestamp := time.Date(2026, 3, 10, 14, 25, 42, 0, time.UTC)
+
+	t.Run("deltas", func(t *testing.T) {
+		t.Run("returns nothing on the first sample", func(t *testing.T) {
+			instance := newSampler()
+			assert.Empty(t, instance.deltas(newStats(10), timestamp))
+		})
+
+		t.Run("returns the difference since the previous sample", func(t *testing.T) {
+			instance := newSampler()
+			instance.deltas(newStats(10), timestamp)
+
+			samples := instance.deltas(newStats(15), timestamp)
+
+			require.Len(t, samples, 4)
+			assert.Equal(t, DomainScope, samples[0].Scope)
+			assert.Equal(t, "example.com", samples[0].Key)
+			assert.Equal(t, GlobalScope, samples[1].Scope)
+			assert.Equal(t, "", samples[1].Key)
+			assert.Equal(t, HostScope, samples[2].Scope)
+			assert.Equal(t, "host-1", samples[2].Key)
+			assert.Equal(t, UpstreamScope, samples[3].Scope)
+			assert.Equal(t, "backend", samples[3].Key)
+
+			for _, sample := range samples {
+				assert.Equal(t, MinuteResolution, sample.Resolution)
+				assert.Equal(t, time.Date(2026, 3, 10, 14, 25, 0, 0, time.UTC), sample.Timestamp)
+			}
+
+			assert.Equal(t, Counters{
+				Requests:     5,
+				InBytes:      500,
+				OutBytes:     5000,
+				RequestMsecs: 50,
+				Status2xx:    5,
+			}, samples[2].Counters)
+			assert.Equal(t, Counters{
+				Requests:     10,
+				RequestMsecs: 25,
+				Status2xx:    5,
+				Status5xx:    5,
+			}, samples[3].Counters)
+		})
+
+		t.Run("skips zones without new traffic", func(t *testing.T) {
+			instance := newSampler()
+			instance.deltas(newStats(10), timestamp)
+
+			assert.Empty(t, instance.deltas(newStats(10), timestamp))
+		})
+
+		t.Run("skips zones whose counters were reset", func(t *testing.T) {
+			instance := newSampler()
+			instance.deltas(newStats(10), timestamp)
+
+			assert.Empty(t, instance.deltas(newStats(3), timestamp))
+			assert.Len(t, instance.deltas(newStats(4), timestamp), 4)
+		})
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		t.Run("makes the next sample a new baseline", func(t *testing.T) {
+			instance := newSampler()
+			instance.deltas(newStats(10), timestamp)
+			instance.reset()
+
+			assert.Empty(t, instance.deltas(newStats(20), timestamp))
+		})
+	})
+}
+
+func Test_aggregate(t *testing.T) {
+	t.Run("sums the samples of the same bucket", func(t *testing.T) {
+		hour := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+		samples := []Sample{
+			newSample(MinuteResolution, hour.Add(70*time.Minute), 4),
+			newSample(MinuteResolution, hour.Add(time.Minute), 2),
+			newSample(MinuteResolution, hour.Add(59*time.Minute), 3),
+		}
+
+		output := aggregate(samples, HourResolution)
+
+		require.Len(t, output, 2)
+		assert.Equal(t, hour, output[0].Timestamp)
+		assert.Equal(t, HourResolution, output[0].Resolution)
+		assert.Equal(t, uint64(5), output[0].Counters.Requests)
+		assert.Equal(t, hour.Add(time.Hour), output[1].Timestamp)
+		assert.Equal(t, uint64(4), output[1].Counters.Requests)
+	})
+}
@@ -0,0 +1,201 @@
+package trafficstats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+const (
+	maxHistoryPoints = 10000
+)
+
+type settings struct {
+	enabled         bool
+	sampleInterval  time.Duration
+	minuteRetention time.Duration
+	hourRetention   time.Duration
+	dayRetention    time.Duration
+}
+
+type service struct {
+	repository    Repository
+	nginxCommands nginx.Commands
+	configuration *configuration.Configuration
+	sampler       *sampler
+}
+
+func newService(
+	repository Repository,
+	nginxCommands nginx.Commands,
+	cfg *configuration.Configuration,
+) *service {
+	return &service{
+		repository:    repository,
+		nginxCommands: nginxCommands,
+		configuration: cfg,
+		sampler:       newSampler(),
+	}
+}
+
+func (s *service) GetHistory(ctx context.Context, query *HistoryQuery) ([]Sample, error) {
+	if err := validateQuery(ctx, query); err != nil {
+		return nil, err
+	}
+
+	samples, err := s.repository.FindRange(
+		ctx,
+		query.Scope,
+		query.Key,
+		query.Resolution.BucketOf(query.From),
+		query.To,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregate(samples, query.Resolution), nil
+}
+
+func (s *service) sample(ctx context.Context) error {
+	stats, err := s.nginxCommands.GetTrafficStats(ctx)
+	if err != nil || stats == nil {
+		s.sampler.reset()
+		return nil
+	}
+
+	samples := s.sampler.deltas(stats, time.Now())
+	if len(samples) == 0 {
+		return nil
+	}
+
+	return s.repository.Add(ctx, samples)
+}
+
+func (s *service) compact(ctx context.Context) error {
+	cfg, err := s.readSettings()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err = s.downsample(
+		ctx,
+		MinuteResolution,
+		HourResolution,
+		now.Add(-cfg.minuteRetention),
+	); err != nil {
+		return err
+	}
+
+	if err = s.downsample(
+		ctx,
+		HourResolution,
+		DayResolution,
+		now.Add(-cfg.hourRetention),
+	); err != nil {
+		return err
+	}
+
+	count, err := s.repository.DeleteOlderThan(
+		ctx,
+		DayResolution,
+		DayResolution.BucketOf(now.Add(-cfg.dayRetention)),
+	)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		log.Infof("Purged %d traffic stats history entries older than the retention period", count)
+	}
+
+	return nil
+}
+
+func (s *service) downsample(
+	ctx context.Context,
+	source, target Resolution,
+	threshold time.Time,
+) error {
+	before := target.BucketOf(threshold)
+	samples, err := s.repository.FindOlderThan(ctx, source, before)
+	if err != nil || len(samples) == 0 {
+		return err
+	}
+
+	return s.repository.Compact(ctx, source, before, aggregate(samples, target))
+}
+
+func (s *service) readSettings() (*settings, error) {
+	cfg := s.configuration.WithPrefix("nginx-ignition.traffic-stats.history")
+
+	enabled, err := cfg.GetBoolean("enabled")
+	if err != nil {
+		return nil, err
+	}
+
+	sampleIntervalSeconds, err := cfg.GetInt("sample-interval-seconds")
+	if err != nil {
+		return nil, err
+	}
+
+	if sampleIntervalSeconds <= 0 {
+		return nil, fmt.Errorf(
+			"traffic stats history sample interval must be greater than zero, got %d",
+			sampleIntervalSeconds,
+		)
+	}
+
+	minuteRetentionHours, err := cfg.GetInt("minute-retention-hours")
+	if err != nil {
+		return nil, err
+	}
+
+	hourRetentionDays, err := cfg.GetInt("hour-retention-days")
+	if err != nil {
+		return nil, err
+	}
+
+	dayRetentionDays, err := cfg.GetInt("day-retention-days")
+	if err != nil {
+		return nil, err
+	}
+
+	return &settings{
+		enabled:         enabled,
+		sampleInterval:  time.Duration(sampleIntervalSeconds) * time.Second,
+		minuteRetention: time.Duration(minuteRetentionHours) * time.Hour,
+		hourRetention:   time.Duration(hourRetentionDays) * 24 * time.Hour,
+		dayRetention:    time.Duration(dayRetentionDays) * 24 * time.Hour,
+	}, nil
+}
+
+func validateQuery(ctx context.Context, query *HistoryQuery) error {
+	if !query.Resolution.IsValid() {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreTrafficStatsInvalidResolution), true)
+	}
+
+	if !query.Scope.IsValid() {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreTrafficStatsInvalidScope), true)
+	}
+
+	if !query.To.After(query.From) {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreTrafficStatsInvalidRange), true)
+	}
+
+	if query.To.Sub(query.From)/query.Resolution.Duration() > maxHistoryPoints {
+		return coreerror.New(
+			i18n.M(ctx, i18n.K.CoreTrafficStatsRangeTooLarge).V("maximum", maxHistoryPoints),
+			true,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package trafficstats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/nginx"
+)
+
+func Test_service(t *testing.T) {
+	t.Run("GetHistory", func(t *testing.T) {
+		to := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+
+		t.Run("returns the samples aggregated by the requested resolution", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			from := to.Add(-3 * time.Hour).Add(15 * time.Minute)
+			key := "host-1"
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				FindRange(t.Context(), HostScope, &key, to.Add(-3*time.Hour), to).
+				Return([]Sample{
+					newSample(HourResolution, to.Add(-2*time.Hour), 100),
+					newSample(MinuteResolution, to.Add(-50*time.Minute), 5),
+					newSample(MinuteResolution, to.Add(-10*time.Minute), 7),
+				}, nil)
+
+			samples, err := newService(repository, nil, newConfiguration()).
+				GetHistory(t.Context(), &HistoryQuery{
+					From:       from,
+					To:         to,
+					Key:        &key,
+					Resolution: HourResolution,
+					Scope:      HostScope,
+				})
+
+			require.NoError(t, err)
+			require.Len(t, samples, 2)
+			assert.Equal(t, uint64(100), samples[0].Counters.Requests)
+			assert.Equal(t, to.Add(-time.Hour), samples[1].Timestamp)
+			assert.Equal(t, uint64(12), samples[1].Counters.Requests)
+		})
+
+		invalidQueries := map[string]struct {
+			query       HistoryQuery
+			expectedKey string
+		}{
+			"rejects invalid resolutions": {
+				query: HistoryQuery{
+					From:       to.Add(-time.Hour),
+					To:         to,
+					Resolution: "WEEK",
+					Scope:      GlobalScope,
+				},
+				expectedKey: i18n.K.CoreTrafficStatsInvalidResolution,
+			},
+			"rejects invalid scopes": {
+				query: HistoryQuery{
+					From:       to.Add(-time.Hour),
+					To:         to,
+					Resolution: HourResolution,
+					Scope:      "CITY",
+				},
+				expectedKey: i18n.K.CoreTrafficStatsInvalidScope,
+			},
+			"rejects ranges ending before they start": {
+				query: HistoryQuery{
+					From:       to,
+					To:         to.Add(-time.Hour),
+					Resolution: HourResolution,
+					Scope:      GlobalScope,
+				},
+				expectedKey: i18n.K.CoreTrafficStatsInvalidRange,
+			},
+			"rejects ranges with too many points": {
+				query: HistoryQuery{
+					From:       to.Add(-30 * 24 * time.Hour),
+					To:         to,
+					Resolution: MinuteResolution,
+					Scope:      GlobalScope,
+				},
+				expectedKey: i18n.K.CoreTrafficStatsRangeTooLarge,
+			},
+		}
+
+		for name, testCase := range invalidQueries {
+			t.Run(name, func(t *testing.T) {
+				samples, err := newService(nil, nil, newConfiguration()).
+					GetHistory(t.Context(), &testCase.query)
+
+				assert.Nil(t, samples)
+				var coreErr *coreerror.CoreError
+				require.ErrorAs(t, err, &coreErr)
+				assert.Equal(t, testCase.expectedKey, coreErr.Message.Key)
+				assert.True(t, coreErr.UserRelated)
+			})
+		}
+	})
+
+	t.Run("sample", func(t *testing.T) {
+		t.Run("stores the deltas since the previous sample", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			nginxCommands := nginx.NewMockedCommands(ctrl)
+			gomock.InOrder(
+				nginxCommands.EXPECT().GetTrafficStats(t.Context()).Return(newStats(10), nil),
+				nginxCommands.EXPECT().GetTrafficStats(t.Context()).Return(newStats(12), nil),
+			)
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				Add(t.Context(), gomock.Len(4)).
+				Return(nil)
+
+			instance := newService(repository, nginxCommands, newConfiguration())
+
+			require.NoError(t, instance.sample(t.Context()))
+			require.NoError(t, instance.sample(t.Context()))
+		})
+
+		t.Run("starts a new baseline when the stats are unavailable", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			nginxCommands := nginx.NewMockedCommands(ctrl)
+			gomock.InOrder(
+				nginxCommands.EXPECT().GetTrafficStats(t.Context()).Return(newStats(10), nil),
+				nginxCommands.EXPECT().GetTrafficStats(t.Context()).Return(nil, assert.AnError),
+				nginxCommands.EXPECT().GetTrafficStats(t.Context()).Return(newStats(50), nil),
+			)
+
+			instance := newService(NewMockedRepository(ctrl), nginxCommands, newConfiguration())
+
+			require.NoError(t, instance.sample(t.Context()))
+			require.NoError(t, instance.sample(t.Context()))
+			require.NoError(t, instance.sample(t.Context()))
+		})
+	})
+
+	t.Run("compact", func(t *testing.T) {
+		t.Run("downsamples old entries and purges expired ones", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			old := time.Now().UTC().Add(-48 * time.Hour).Truncate(time.Hour)
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				FindOlderThan(t.Context(), MinuteResolution, gomock.Any()).
+				Return([]Sample{
+					newSample(MinuteResolution, old.Add(time.Minute), 2),
+					newSample(MinuteResolution, old.Add(2*time.Minute), 3),
+				}, nil)
+			repository.EXPECT().
+				Compact(t.Context(), MinuteResolution, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_, _, before, samples any) error {
+					assert.True(t, before.(time.Time).After(old))
+					require.Len(t, samples, 1)
+					aggregated := samples.([]Sample)[0]
+					assert.Equal(t, HourResolution, aggregated.Resolution)
+					assert.Equal(t, old, aggregated.Timestamp)
+					assert.Equal(t, uint64(5), aggregated.Counters.Requests)
+					return nil
+				})
+			repository.EXPECT().
+				FindOlderThan(t.Context(), HourResolution, gomock.Any()).
+				Return(nil, nil)
+			repository.EXPECT().
+				DeleteOlderThan(t.Context(), DayResolution, gomock.Any()).
+				Return(3, nil)
+
+			err := newService(repository, nil, newConfiguration()).compact(t.Context())
+			assert.NoError(t, err)
+		})
+
+		t.Run("returns error when the downsampling fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().
+				FindOlderThan(t.Context(), MinuteResolution, gomock.Any()).
+				Return(nil, assert.AnError)
+
+			err := newService(repository, nil, newConfiguration()).compact(t.Context())
+			assert.Equal(t, assert.AnError, err)
+		})
+	})
+}
@@ -0,0 +1,20 @@
+package upstream
+
+import (
+	"github.com/google/uuid"
+)
+
+func newUpstream() *Upstream {
+	return &Upstream{
+		ID:       uuid.New(),
+		Name:     "Default Upstream",
+		Method:   RoundRobinBalancingMethod,
+		Protocol: HTTPProtocol,
+		Servers: []Server{
+			{
+				Address: "10.0.0.1",
+				Port:    8080,
+			},
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package upstream
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type Commands interface {
+	Delete(ctx context.Context, id uuid.UUID) error
+	Get(ctx context.Context, id uuid.UUID) (*Upstream, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+	List(
+		ctx context.Context,
+		pageSize, pageNumber int,
+		searchTerms *string,
+	) (*pagination.Page[Upstream], error)
+	GetAllInUse(ctx context.Context) ([]Upstream, error)
+	Save(ctx context.Context, upstream *Upstream) error
+}
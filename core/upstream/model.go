@@ -0,0 +1,49 @@
+package upstream
+
+import (
+	"github.com/google/uuid"
+)
+
+type BalancingMethod string
+
+const (
+	RoundRobinBalancingMethod       BalancingMethod = "ROUND_ROBIN"
+	LeastConnectionsBalancingMethod BalancingMethod = "LEAST_CONNECTIONS"
+	IPHashBalancingMethod           BalancingMethod = "IP_HASH"
+	HashBalancingMethod             BalancingMethod = "HASH"
+)
+
+type Protocol string
+
+const (
+	HTTPProtocol  Protocol = "HTTP"
+	HTTPSProtocol Protocol = "HTTPS"
+)
+
+type Upstream struct {
+	HashKey   *string
+	Keepalive *Keepalive
+	Name      string
+	Method    BalancingMethod
+	Protocol  Protocol
+	Servers   []Server
+	ID        uuid.UUID
+}
+
+type Server struct {
+	Weight         *int
+	CircuitBreaker *CircuitBreaker
+	Address        string
+	Port           int
+	Backup         bool
+}
+
+type CircuitBreaker struct {
+	MaxFailures int
+	OpenSeconds int
+}
+
+type Keepalive struct {
+	Connections    int
+	TimeoutSeconds int
+}
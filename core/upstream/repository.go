@@ -0,0 +1,23 @@
+package upstream
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type Repository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*Upstream, error)
+	InUseByID(ctx context.Context, id uuid.UUID) (bool, error)
+	ExistsByID(ctx context.Context, id uuid.UUID) (bool, error)
+	DeleteByID(ctx context.Context, id uuid.UUID) error
+	FindPage(
+		ctx context.Context,
+		pageNumber, pageSize int,
+		searchTerms *string,
+	) (*pagination.Page[Upstream], error)
+	FindAllInUse(ctx context.Context) ([]Upstream, error)
+	Save(ctx context.Context, upstream *Upstream) error
+}
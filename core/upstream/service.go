@@ -0,0 +1,63 @@
+package upstream
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+type service struct {
+	repository Repository
+}
+
+func newCommands(repository Repository) Commands {
+	return &service{
+		repository: repository,
+	}
+}
+
+func (s *service) Save(ctx context.Context, u *Upstream) error {
+	if err := newValidator().validate(ctx, u); err != nil {
+		return err
+	}
+
+	return s.repository.Save(ctx, u)
+}
+
+func (s *service) Delete(ctx context.Context, id uuid.UUID) error {
+	inUse, err := s.repository.InUseByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if inUse {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreUpstreamInUse), true)
+	}
+
+	return s.repository.DeleteByID(ctx, id)
+}
+
+func (s *service) Get(ctx context.Context, id uuid.UUID) (*Upstream, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+func (s *service) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	return s.repository.ExistsByID(ctx, id)
+}
+
+func (s *service) List(
+	ctx context.Context,
+	pageSize,
+	pageNumber int,
+	searchTerms *string,
+) (*pagination.Page[Upstream], error) {
+	return s.repository.FindPage(ctx, pageNumber, pageSize, searchTerms)
+}
+
+func (s *service) GetAllInUse(ctx context.Context) ([]Upstream, error) {
+	return s.repository.FindAllInUse(ctx)
+}
@@ -0,0 +1,223 @@
+package upstream
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+)
+
+func Test_service(t *testing.T) {
+	t.Run("Save", func(t *testing.T) {
+		t.Run("valid upstream saves successfully", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			upstream := newUpstream()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Save(t.Context(), upstream).Return(nil)
+
+			upstreamService := newCommands(repository)
+			err := upstreamService.Save(t.Context(), upstream)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("invalid upstream returns validation error", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			upstream := newUpstream()
+			upstream.Name = ""
+
+			repository := NewMockedRepository(ctrl)
+			upstreamService := newCommands(repository)
+			err := upstreamService.Save(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("repository error is returned", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			upstream := newUpstream()
+			expectedErr := errors.New("repository error")
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().Save(t.Context(), upstream).Return(expectedErr)
+
+			upstreamService := newCommands(repository)
+			err := upstreamService.Save(t.Context(), upstream)
+
+			assert.Equal(t, expectedErr, err)
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("deletes successfully when not in use", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().InUseByID(t.Context(), id).Return(false, nil)
+			repository.EXPECT().DeleteByID(t.Context(), id).Return(nil)
+
+			upstreamService := newCommands(repository)
+			err := upstreamService.Delete(t.Context(), id)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("returns error when in use", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().InUseByID(t.Context(), id).Return(true, nil)
+
+			upstreamService := newCommands(repository)
+			err := upstreamService.Delete(t.Context(), id)
+
+			require.Error(t, err)
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+			assert.Equal(t, i18n.K.CoreUpstreamInUse, coreErr.Message.Key)
+		})
+
+		t.Run("returns error when InUseByID fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+			expectedErr := errors.New("check failed")
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().InUseByID(t.Context(), id).Return(false, expectedErr)
+
+			upstreamService := newCommands(repository)
+			err := upstreamService.Delete(t.Context(), id)
+
+			assert.Equal(t, expectedErr, err)
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("returns upstream when found", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := newUpstream()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), expected.ID).Return(expected, nil)
+
+			upstreamService := newCommands(repository)
+			result, err := upstreamService.Get(t.Context(), expected.ID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+
+		t.Run("returns error when repository fails", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+			expectedErr := errors.New("not found")
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindByID(t.Context(), id).Return(nil, expectedErr)
+
+			upstreamService := newCommands(repository)
+			result, err := upstreamService.Get(t.Context(), id)
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+			assert.Equal(t, expectedErr, err)
+		})
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Run("returns paginated results", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expectedPage := pagination.Of([]Upstream{*newUpstream()})
+			searchTerms := "test"
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindPage(t.Context(), 1, 10, &searchTerms).Return(expectedPage, nil)
+
+			upstreamService := newCommands(repository)
+			result, err := upstreamService.List(t.Context(), 10, 1, &searchTerms)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expectedPage, result)
+		})
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		t.Run("returns true when exists", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().ExistsByID(t.Context(), id).Return(true, nil)
+
+			upstreamService := newCommands(repository)
+			exists, err := upstreamService.Exists(t.Context(), id)
+
+			assert.NoError(t, err)
+			assert.True(t, exists)
+		})
+
+		t.Run("returns false when not exists", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().ExistsByID(t.Context(), id).Return(false, nil)
+
+			upstreamService := newCommands(repository)
+			exists, err := upstreamService.Exists(t.Context(), id)
+
+			assert.NoError(t, err)
+			assert.False(t, exists)
+		})
+	})
+
+	t.Run("GetAllInUse", func(t *testing.T) {
+		t.Run("returns all in use upstreams", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := []Upstream{*newUpstream(), *newUpstream()}
+
+			repository := NewMockedRepository(ctrl)
+			repository.EXPECT().FindAllInUse(t.Context()).Return(expected, nil)
+
+			upstreamService := newCommands(repository)
+			result, err := upstreamService.GetAllInUse(t.Context())
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+	})
+}
@@ -0,0 +1,130 @@
+package upstream
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/validation"
+	"dillmann.com.br/nginx-ignition/core/common/valuerange"
+)
+
+var portRange = valuerange.New(1, 65535)
+
+type validator struct {
+	delegate *validation.ConsistencyValidator
+}
+
+func newValidator() *validator {
+	return &validator{
+		delegate: validation.NewValidator(),
+	}
+}
+
+func (v *validator) validate(ctx context.Context, u *Upstream) error {
+	v.validateBasicSettings(ctx, u)
+	v.validateKeepalive(ctx, u.Keepalive)
+	v.validateServers(ctx, u)
+
+	return v.delegate.Result()
+}
+
+func (v *validator) validateBasicSettings(ctx context.Context, u *Upstream) {
+	if strings.TrimSpace(u.Name) == "" {
+		v.delegate.Add("name", i18n.M(ctx, i18n.K.CommonValueMissing))
+	}
+
+	switch u.Protocol {
+	case HTTPProtocol, HTTPSProtocol:
+		// Valid
+	default:
+		v.delegate.Add("protocol", i18n.M(ctx, i18n.K.CommonInvalidValue))
+	}
+
+	switch u.Method {
+	case RoundRobinBalancingMethod, LeastConnectionsBalancingMethod, IPHashBalancingMethod:
+		// Valid
+	case HashBalancingMethod:
+		if u.HashKey == nil || strings.TrimSpace(*u.HashKey) == "" {
+			v.delegate.Add("hashKey", i18n.M(ctx, i18n.K.CoreUpstreamHashKeyRequired))
+		}
+	default:
+		v.delegate.Add("method", i18n.M(ctx, i18n.K.CommonInvalidValue))
+	}
+}
+
+func (v *validator) validateKeepalive(ctx context.Context, keepalive *Keepalive) {
+	if keepalive == nil {
+		return
+	}
+
+	if keepalive.Connections < 1 {
+		v.delegate.Add("keepalive.connections", i18n.M(ctx, i18n.K.CommonCannotBeZero))
+	}
+
+	if keepalive.TimeoutSeconds < 1 {
+		v.delegate.Add("keepalive.timeoutSeconds", i18n.M(ctx, i18n.K.CommonCannotBeZero))
+	}
+}
+
+func (v *validator) validateServers(ctx context.Context, u *Upstream) {
+	if len(u.Servers) == 0 {
+		v.delegate.Add("servers", i18n.M(ctx, i18n.K.CommonAtLeastOneRequired))
+		return
+	}
+
+	primaryServers := 0
+	for index, server := range u.Servers {
+		v.validateServer(ctx, u, &server, index)
+
+		if !server.Backup {
+			primaryServers++
+		}
+	}
+
+	if primaryServers == 0 {
+		v.delegate.Add("servers", i18n.M(ctx, i18n.K.CoreUpstreamPrimaryServerRequired))
+	}
+}
+
+func (v *validator) validateServer(ctx context.Context, u *Upstream, server *Server, index int) {
+	prefix := fmt.Sprintf("servers[%d]", index)
+
+	if strings.TrimSpace(server.Address) == "" {
+		v.delegate.Add(prefix+".address", i18n.M(ctx, i18n.K.CommonCannotBeEmpty))
+	}
+
+	if !portRange.Contains(server.Port) {
+		v.delegate.Add(
+			prefix+".port",
+			i18n.M(ctx, i18n.K.CommonBetweenValues).
+				V("min", portRange.Min).
+				V("max", portRange.Max),
+		)
+	}
+
+	if server.Weight != nil && *server.Weight < 1 {
+		v.delegate.Add(prefix+".weight", i18n.M(ctx, i18n.K.CommonCannotBeZero))
+	}
+
+	if server.Backup && (u.Method == IPHashBalancingMethod || u.Method == HashBalancingMethod) {
+		v.delegate.Add(prefix+".backup", i18n.M(ctx, i18n.K.CoreUpstreamBackupNotSupported))
+	}
+
+	if server.CircuitBreaker != nil {
+		if server.CircuitBreaker.MaxFailures < 1 {
+			v.delegate.Add(
+				prefix+".circuitBreaker.maxFailures",
+				i18n.M(ctx, i18n.K.CommonCannotBeZero),
+			)
+		}
+
+		if server.CircuitBreaker.OpenSeconds < 1 {
+			v.delegate.Add(
+				prefix+".circuitBreaker.openSeconds",
+				i18n.M(ctx, i18n.K.CommonCannotBeZero),
+			)
+		}
+	}
+}
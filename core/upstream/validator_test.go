@@ -0,0 +1,189 @@
+package upstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validator(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		t.Run("valid upstream passes", func(t *testing.T) {
+			upstream := newUpstream()
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("empty name fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Name = "   "
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("invalid protocol fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Protocol = "FTP"
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("invalid balancing method fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Method = "RANDOM"
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("hash method without key fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Method = HashBalancingMethod
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("hash method with key passes", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Method = HashBalancingMethod
+			upstream.HashKey = new("$request_uri")
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("keepalive with zero connections fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Keepalive = &Keepalive{
+				Connections:    0,
+				TimeoutSeconds: 60,
+			}
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("keepalive with zero timeout fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Keepalive = &Keepalive{
+				Connections:    16,
+				TimeoutSeconds: 0,
+			}
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("without servers fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Servers = nil
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("server without address fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Servers[0].Address = ""
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("server with port out of range fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Servers[0].Port = 70000
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("server with weight less than 1 fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Servers[0].Weight = new(0)
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("server circuit breaker with invalid values fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Servers[0].CircuitBreaker = &CircuitBreaker{
+				MaxFailures: 0,
+				OpenSeconds: 0,
+			}
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("only backup servers fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Servers[0].Backup = true
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("backup server with round robin passes", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Servers = append(upstream.Servers, Server{
+				Address: "10.0.0.2",
+				Port:    8080,
+				Backup:  true,
+			})
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("backup server with IP hash fails", func(t *testing.T) {
+			upstream := newUpstream()
+			upstream.Method = IPHashBalancingMethod
+			upstream.Servers = append(upstream.Servers, Server{
+				Address: "10.0.0.2",
+				Port:    8080,
+				Backup:  true,
+			})
+			upstreamValidator := newValidator()
+
+			err := upstreamValidator.validate(t.Context(), upstream)
+
+			assert.Error(t, err)
+		})
+	})
+}
@@ -11,19 +11,24 @@ func newUser() *User {
 		Name:     "Test User",
 		Enabled:  true,
 		Permissions: Permissions{
-			Hosts:        NoAccessAccessLevel,
-			Streams:      NoAccessAccessLevel,
-			Certificates: NoAccessAccessLevel,
-			Logs:         NoAccessAccessLevel,
-			Integrations: NoAccessAccessLevel,
-			AccessLists:  NoAccessAccessLevel,
-			Settings:     NoAccessAccessLevel,
-			Users:        NoAccessAccessLevel,
-			NginxServer:  ReadOnlyAccessLevel,
-			ExportData:   NoAccessAccessLevel,
-			VPNs:         NoAccessAccessLevel,
-			Caches:       NoAccessAccessLevel,
-			TrafficStats: NoAccessAccessLevel,
+			Hosts:           NoAccessAccessLevel,
+			Streams:         NoAccessAccessLevel,
+			Certificates:    NoAccessAccessLevel,
+			Logs:            NoAccessAccessLevel,
+			Integrations:    NoAccessAccessLevel,
+			AccessLists:     NoAccessAccessLevel,
+			Settings:        NoAccessAccessLevel,
+			Users:           NoAccessAccessLevel,
+			NginxServer:     ReadOnlyAccessLevel,
+			ExportData:      NoAccessAccessLevel,
+			VPNs:            NoAccessAccessLevel,
+			Caches:          NoAccessAccessLevel,
+			Upstreams:       NoAccessAccessLevel,
+			TLSProfiles:     NoAccessAccessLevel,
+			RateLimits:      NoAccessAccessLevel,
+			SecurityHeaders: NoAccessAccessLevel,
+			TrafficStats:    NoAccessAccessLevel,
+			Audit:           NoAccessAccessLevel,
 		},
 	}
 }
@@ -37,19 +42,24 @@ func newSaveRequest() *SaveRequest {
 		Password:   new("password123"),
 		RemoveTOTP: false,
 		Permissions: Permissions{
-			Hosts:        NoAccessAccessLevel,
-			Streams:      NoAccessAccessLevel,
-			Certificates: NoAccessAccessLevel,
-			Logs:         NoAccessAccessLevel,
-			Integrations: NoAccessAccessLevel,
-			AccessLists:  NoAccessAccessLevel,
-			Settings:     NoAccessAccessLevel,
-			Users:        NoAccessAccessLevel,
-			NginxServer:  ReadOnlyAccessLevel,
-			ExportData:   NoAccessAccessLevel,
-			VPNs:         NoAccessAccessLevel,
-			Caches:       NoAccessAccessLevel,
-			TrafficStats: NoAccessAccessLevel,
+			Hosts:           NoAccessAccessLevel,
+			Streams:         NoAccessAccessLevel,
+			Certificates:    NoAccessAccessLevel,
+			Logs:            NoAccessAccessLevel,
+			Integrations:    NoAccessAccessLevel,
+			AccessLists:     NoAccessAccessLevel,
+			Settings:        NoAccessAccessLevel,
+			Users:           NoAccessAccessLevel,
+			NginxServer:     ReadOnlyAccessLevel,
+			ExportData:      NoAccessAccessLevel,
+			VPNs:            NoAccessAccessLevel,
+			Caches:          NoAccessAccessLevel,
+			Upstreams:       NoAccessAccessLevel,
+			TLSProfiles:     NoAccessAccessLevel,
+			RateLimits:      NoAccessAccessLevel,
+			SecurityHeaders: NoAccessAccessLevel,
+			TrafficStats:    NoAccessAccessLevel,
+			Audit:           NoAccessAccessLevel,
 		},
 	}
 }
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -11,10 +12,13 @@ import (
 type Commands interface {
 	Authenticate(
 		ctx context.Context,
-		username, password, code string,
+		username, password, code, sourceAddress string,
 	) (AuthenticationOutcome, *User, error)
+	AuthenticateExternal(ctx context.Context, identity *ExternalIdentity) (*User, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	Get(ctx context.Context, id uuid.UUID) (*User, error)
+	GetLockedUntil(ctx context.Context, id uuid.UUID) (*time.Time, error)
+	Unlock(ctx context.Context, id uuid.UUID) error
 	GetCount(ctx context.Context) (int, error)
 	GetStatus(ctx context.Context, id uuid.UUID) (bool, error)
 	List(
@@ -0,0 +1,131 @@
+package user
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	allPermissionsKey = "*"
+)
+
+type groupMapping struct {
+	accessLevels map[string]AccessLevel
+	group        string
+}
+
+var readOnlyLimitedPermissions = []string{"logs", "exportData", "trafficStats", "audit"}
+
+func parseGroupMappings(value string) ([]groupMapping, error) {
+	output := make([]groupMapping, 0)
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		separatorIndex := strings.LastIndex(entry, "=")
+		if separatorIndex <= 0 {
+			return nil, fmt.Errorf("invalid group mapping %q: expected <group>=<access>", entry)
+		}
+
+		accessLevels, err := parseGroupAccessLevels(entry[separatorIndex+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid group mapping %q: %w", entry, err)
+		}
+
+		output = append(output, groupMapping{
+			group:        strings.TrimSpace(entry[:separatorIndex]),
+			accessLevels: accessLevels,
+		})
+	}
+
+	return output, nil
+}
+
+func parseGroupAccessLevels(value string) (map[string]AccessLevel, error) {
+	value = strings.TrimSpace(value)
+	if !strings.Contains(value, ":") {
+		level, err := parseAccessLevel(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]AccessLevel{allPermissionsKey: level}, nil
+	}
+
+	output := make(map[string]AccessLevel)
+	for _, item := range strings.Split(value, ",") {
+		permission, rawLevel, found := strings.Cut(strings.TrimSpace(item), ":")
+		if !found {
+			return nil, fmt.Errorf("expected <permission>:<access level> but got %q", item)
+		}
+
+		permission = strings.TrimSpace(permission)
+		if _, exists := permissionAccessors[permission]; !exists {
+			return nil, fmt.Errorf("unknown permission %q", permission)
+		}
+
+		level, err := parseAccessLevel(rawLevel)
+		if err != nil {
+			return nil, err
+		}
+
+		output[permission] = level
+	}
+
+	return output, nil
+}
+
+func parseAccessLevel(value string) (AccessLevel, error) {
+	level := AccessLevel(strings.ToUpper(strings.TrimSpace(value)))
+	switch level {
+	case NoAccessAccessLevel, ReadOnlyAccessLevel, ReadWriteAccessLevel:
+		return level, nil
+	default:
+		return "", fmt.Errorf("unknown access level %q", value)
+	}
+}
+
+func resolveGroupPermissions(mappings []groupMapping, groups []string) (*Permissions, bool) {
+	permissions := &Permissions{}
+	for _, accessor := range permissionAccessors {
+		*accessor(permissions) = NoAccessAccessLevel
+	}
+
+	matched := false
+	for _, mapping := range mappings {
+		if !slices.Contains(groups, mapping.group) {
+			continue
+		}
+
+		matched = true
+		for name, accessor := range permissionAccessors {
+			level, exists := mapping.accessLevels[name]
+			if !exists {
+				level, exists = mapping.accessLevels[allPermissionsKey]
+			}
+
+			if exists && accessLevelRank(level) > accessLevelRank(*accessor(permissions)) {
+				*accessor(permissions) = level
+			}
+		}
+	}
+
+	if !matched {
+		return nil, false
+	}
+
+	for _, name := range readOnlyLimitedPermissions {
+		if level := permissionAccessors[name](permissions); *level == ReadWriteAccessLevel {
+			*level = ReadOnlyAccessLevel
+		}
+	}
+
+	if permissions.NginxServer == NoAccessAccessLevel {
+		permissions.NginxServer = ReadOnlyAccessLevel
+	}
+
+	return permissions, true
+}
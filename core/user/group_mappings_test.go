@@ -0,0 +1,90 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_groupMappings(t *testing.T) {
+	t.Run("parseGroupMappings", func(t *testing.T) {
+		t.Run("parses global and per permission access levels", func(t *testing.T) {
+			result, err := parseGroupMappings(
+				"admins=READ_WRITE; cn=dns,ou=groups=hosts:read_write,certificates:READ_ONLY",
+			)
+
+			require.NoError(t, err)
+			require.Len(t, result, 2)
+			assert.Equal(t, "admins", result[0].group)
+			assert.Equal(t, ReadWriteAccessLevel, result[0].accessLevels[allPermissionsKey])
+			assert.Equal(t, "cn=dns,ou=groups", result[1].group)
+			assert.Equal(t, ReadWriteAccessLevel, result[1].accessLevels["hosts"])
+			assert.Equal(t, ReadOnlyAccessLevel, result[1].accessLevels["certificates"])
+		})
+
+		t.Run("returns empty when not configured", func(t *testing.T) {
+			result, err := parseGroupMappings("")
+
+			require.NoError(t, err)
+			assert.Empty(t, result)
+		})
+
+		t.Run("returns error for unknown permission", func(t *testing.T) {
+			_, err := parseGroupMappings("admins=unknown:READ_WRITE")
+			assert.Error(t, err)
+		})
+
+		t.Run("returns error for unknown access level", func(t *testing.T) {
+			_, err := parseGroupMappings("admins=EVERYTHING")
+			assert.Error(t, err)
+		})
+
+		t.Run("returns error when the access level is missing", func(t *testing.T) {
+			_, err := parseGroupMappings("admins")
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("resolveGroupPermissions", func(t *testing.T) {
+		mappings, err := parseGroupMappings(
+			"viewers=READ_ONLY;admins=READ_WRITE;dns=hosts:READ_WRITE",
+		)
+		require.NoError(t, err)
+
+		t.Run("returns false when no group matches", func(t *testing.T) {
+			result, matched := resolveGroupPermissions(mappings, []string{"other"})
+
+			assert.False(t, matched)
+			assert.Nil(t, result)
+		})
+
+		t.Run("keeps the highest access level across groups", func(t *testing.T) {
+			result, matched := resolveGroupPermissions(mappings, []string{"viewers", "dns"})
+
+			require.True(t, matched)
+			assert.Equal(t, ReadWriteAccessLevel, result.Hosts)
+			assert.Equal(t, ReadOnlyAccessLevel, result.Streams)
+			assert.Equal(t, ReadOnlyAccessLevel, result.Users)
+		})
+
+		t.Run("limits read-only permissions", func(t *testing.T) {
+			result, matched := resolveGroupPermissions(mappings, []string{"admins"})
+
+			require.True(t, matched)
+			assert.Equal(t, ReadWriteAccessLevel, result.Settings)
+			assert.Equal(t, ReadOnlyAccessLevel, result.Logs)
+			assert.Equal(t, ReadOnlyAccessLevel, result.ExportData)
+			assert.Equal(t, ReadOnlyAccessLevel, result.TrafficStats)
+			assert.Equal(t, ReadOnlyAccessLevel, result.Audit)
+		})
+
+		t.Run("grants at least read-only access to the nginx server", func(t *testing.T) {
+			result, matched := resolveGroupPermissions(mappings, []string{"dns"})
+
+			require.True(t, matched)
+			assert.Equal(t, ReadOnlyAccessLevel, result.NginxServer)
+			assert.Equal(t, NoAccessAccessLevel, result.Streams)
+		})
+	})
+}
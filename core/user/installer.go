@@ -3,6 +3,7 @@ package user
 import (
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
 	"dillmann.com.br/nginx-ignition/core/common/container"
+	"dillmann.com.br/nginx-ignition/core/loginattempt"
 )
 
 func Install() error {
@@ -17,7 +18,8 @@ func Install() error {
 func newCommands(
 	repository Repository,
 	cfg *configuration.Configuration,
+	loginAttemptCommands loginattempt.Commands,
 ) (*service, Commands) {
-	serviceInstance := newService(repository, cfg)
+	serviceInstance := newService(repository, cfg, loginAttemptCommands)
 	return serviceInstance, serviceInstance
 }
@@ -21,14 +21,15 @@ const (
 )
 
 type User struct {
-	Permissions  Permissions
-	Name         string
-	Username     string
-	PasswordHash string
-	PasswordSalt string
-	TOTP         TOTP
-	ID           uuid.UUID
-	Enabled      bool
+	ExternalSubject *string
+	Permissions     Permissions
+	Name            string
+	Username        string
+	PasswordHash    string
+	PasswordSalt    string
+	TOTP            TOTP
+	ID              uuid.UUID
+	Enabled         bool
 }
 
 type TOTP struct {
@@ -38,19 +39,33 @@ type TOTP struct {
 }
 
 type Permissions struct {
-	Hosts        AccessLevel
-	Streams      AccessLevel
-	Certificates AccessLevel
-	Logs         AccessLevel
-	Integrations AccessLevel
-	AccessLists  AccessLevel
-	Settings     AccessLevel
-	Users        AccessLevel
-	NginxServer  AccessLevel
-	ExportData   AccessLevel
-	VPNs         AccessLevel
-	Caches       AccessLevel
-	TrafficStats AccessLevel
+	Hosts           AccessLevel
+	Streams         AccessLevel
+	Certificates    AccessLevel
+	Logs            AccessLevel
+	Integrations    AccessLevel
+	AccessLists     AccessLevel
+	Settings        AccessLevel
+	Users           AccessLevel
+	NginxServer     AccessLevel
+	ExportData      AccessLevel
+	VPNs            AccessLevel
+	Caches          AccessLevel
+	Upstreams       AccessLevel
+	TLSProfiles     AccessLevel
+	RateLimits      AccessLevel
+	SecurityHeaders AccessLevel
+	TrafficStats    AccessLevel
+	Audit           AccessLevel
+}
+
+type ExternalIdentity struct {
+	Subject       string
+	Username      string
+	Name          string
+	Email         string
+	Groups        []string
+	EmailVerified bool
 }
 
 type AuthenticationOutcome string
@@ -59,4 +74,5 @@ const (
 	AuthenticationSuccessful  AuthenticationOutcome = "SUCCESS"
 	AuthenticationFailed      AuthenticationOutcome = "FAILURE"
 	AuthenticationMissingTOTP AuthenticationOutcome = "MISSING_TOTP"
+	AuthenticationLocked      AuthenticationOutcome = "LOCKED"
 )
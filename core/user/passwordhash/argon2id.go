@@ -0,0 +1,160 @@
+package passwordhash
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+)
+
+const argon2idPrefix = "$argon2id$"
+
+type argon2idParameters struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+type argon2idHasher struct {
+	parameters argon2idParameters
+	saltSize   int
+	keySize    int
+}
+
+func newArgon2idHasher(cfg *configuration.Configuration) (*argon2idHasher, error) {
+	saltSize, err := cfg.GetInt("salt-size")
+	if err != nil {
+		return nil, err
+	}
+
+	memory, err := cfg.GetInt("argon2id.memory-kib")
+	if err != nil {
+		return nil, err
+	}
+
+	iterations, err := cfg.GetInt("argon2id.iterations")
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism, err := cfg.GetInt("argon2id.parallelism")
+	if err != nil {
+		return nil, err
+	}
+
+	keySize, err := cfg.GetInt("argon2id.key-size")
+	if err != nil {
+		return nil, err
+	}
+
+	return &argon2idHasher{
+		parameters: argon2idParameters{
+			memory:      uint32(memory),
+			iterations:  uint32(iterations),
+			parallelism: uint8(parallelism),
+		},
+		saltSize: saltSize,
+		keySize:  keySize,
+	}, nil
+}
+
+func (a *argon2idHasher) hash(password string) (string, error) {
+	salt := make([]byte, a.saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := deriveArgon2idKey(password, salt, a.parameters, uint32(a.keySize))
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		a.parameters.memory,
+		a.parameters.iterations,
+		a.parameters.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (a *argon2idHasher) verify(password, encodedHash string) (bool, error) {
+	parameters, salt, key, err := decodeArgon2idHash(encodedHash)
+	if err != nil {
+		return false, err
+	}
+
+	generatedKey := deriveArgon2idKey(password, salt, parameters, uint32(len(key)))
+	return subtle.ConstantTimeCompare(generatedKey, key) == 1, nil
+}
+
+func (a *argon2idHasher) needsRehash(encodedHash string) bool {
+	parameters, salt, key, err := decodeArgon2idHash(encodedHash)
+	if err != nil {
+		return true
+	}
+
+	return parameters != a.parameters || len(salt) != a.saltSize || len(key) != a.keySize
+}
+
+func deriveArgon2idKey(
+	password string,
+	salt []byte,
+	parameters argon2idParameters,
+	keySize uint32,
+) []byte {
+	return argon2.IDKey(
+		[]byte(password),
+		salt,
+		parameters.iterations,
+		parameters.memory,
+		parameters.parallelism,
+		keySize,
+	)
+}
+
+func decodeArgon2idHash(encodedHash string) (argon2idParameters, []byte, []byte, error) {
+	var parameters argon2idParameters
+
+	parts := strings.Split(encodedHash, "$")
+	if !strings.HasPrefix(encodedHash, argon2idPrefix) || len(parts) != 6 {
+		return parameters, nil, nil, errors.New("invalid argon2id password hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return parameters, nil, nil, err
+	}
+
+	if version != argon2.Version {
+		return parameters, nil, nil, fmt.Errorf("unsupported argon2id version: %d", version)
+	}
+
+	_, err := fmt.Sscanf(
+		parts[3],
+		"m=%d,t=%d,p=%d",
+		&parameters.memory,
+		&parameters.iterations,
+		&parameters.parallelism,
+	)
+	if err != nil {
+		return parameters, nil, nil, err
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return parameters, nil, nil, err
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return parameters, nil, nil, err
+	}
+
+	return parameters, salt, key, nil
+}
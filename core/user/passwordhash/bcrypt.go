@@ -0,0 +1,62 @@
+package passwordhash
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+)
+
+var bcryptPrefixes = []string{"$2a$", "$2b$", "$2y$"}
+
+type bcryptHasher struct {
+	cost int
+}
+
+func newBcryptHasher(cfg *configuration.Configuration) (*bcryptHasher, error) {
+	cost, err := cfg.GetInt("bcrypt.cost")
+	if err != nil {
+		return nil, err
+	}
+
+	return &bcryptHasher{cost}, nil
+}
+
+func (b *bcryptHasher) hash(password string) (string, error) {
+	output, err := bcrypt.GenerateFromPassword([]byte(password), b.cost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+func (b *bcryptHasher) verify(password, encodedHash string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encodedHash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (b *bcryptHasher) needsRehash(encodedHash string) bool {
+	if !isBcryptHash(encodedHash) {
+		return true
+	}
+
+	cost, err := bcrypt.Cost([]byte(encodedHash))
+	return err != nil || cost != b.cost
+}
+
+func isBcryptHash(encodedHash string) bool {
+	for _, prefix := range bcryptPrefixes {
+		if strings.HasPrefix(encodedHash, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
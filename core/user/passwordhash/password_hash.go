@@ -1,15 +1,24 @@
 package passwordhash
 
 import (
-	"crypto/rand"
-	"crypto/sha512"
-	"encoding/base64"
-	"reflect"
-	"slices"
+	"fmt"
+	"strings"
 
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
 )
 
+const (
+	algorithmArgon2id = "ARGON2ID"
+	algorithmBcrypt   = "BCRYPT"
+	algorithmSHA512   = "SHA-512"
+)
+
+type hasher interface {
+	hash(password string) (string, error)
+	verify(password, encodedHash string) (bool, error)
+	needsRehash(encodedHash string) bool
+}
+
 type PasswordHash struct {
 	configuration *configuration.Configuration
 }
@@ -18,87 +27,65 @@ func New(cfg *configuration.Configuration) *PasswordHash {
 	return &PasswordHash{cfg}
 }
 
-func (h *PasswordHash) Hash(password string) (
-	encodedHash string,
-	encodedSalt string,
-	err error,
-) {
-	saltSize, hashIterations, err := readConfigValues(h.configuration)
-	if err != nil {
-		return "", "", err
-	}
-
-	salt := make([]byte, saltSize)
-	if _, err = rand.Read(salt); err != nil {
-		return "", "", err
-	}
-
-	hashResult, err := h.hashValue([]byte(password), salt, hashIterations)
+func (h *PasswordHash) Hash(password string) (string, error) {
+	configuredHasher, err := h.configuredHasher()
 	if err != nil {
-		return "", "", err
+		return "", err
 	}
 
-	return base64.StdEncoding.EncodeToString(
-			hashResult,
-		), base64.StdEncoding.EncodeToString(
-			salt,
-		), nil
+	return configuredHasher.hash(password)
 }
 
 func (h *PasswordHash) Verify(password, hash, salt string) (bool, error) {
-	saltBytes, err := base64.StdEncoding.DecodeString(salt)
-	if err != nil {
-		return false, err
+	switch {
+	case strings.HasPrefix(hash, argon2idPrefix):
+		return (&argon2idHasher{}).verify(password, hash)
+	case isBcryptHash(hash):
+		return (&bcryptHasher{}).verify(password, hash)
+	case strings.HasPrefix(hash, sha512Prefix):
+		return (&sha512Hasher{}).verify(password, hash)
+	default:
+		return h.verifyLegacy(password, hash, salt)
 	}
+}
 
-	_, hashIterations, err := readConfigValues(h.configuration)
+func (h *PasswordHash) NeedsRehash(hash string) (bool, error) {
+	configuredHasher, err := h.configuredHasher()
 	if err != nil {
 		return false, err
 	}
 
-	generatedHash, err := h.hashValue([]byte(password), saltBytes, hashIterations)
-	if err != nil {
-		return false, err
-	}
+	return configuredHasher.needsRehash(hash), nil
+}
 
-	hashBytes, err := base64.StdEncoding.DecodeString(hash)
+func (h *PasswordHash) verifyLegacy(password, hash, salt string) (bool, error) {
+	iterations, err := h.prefixedConfiguration().GetInt("iterations")
 	if err != nil {
 		return false, err
 	}
 
-	return reflect.DeepEqual(generatedHash, hashBytes), nil
+	return verifyLegacySHA512(password, hash, salt, iterations)
 }
 
-func (h *PasswordHash) hashValue(password, salt []byte, hashIterations int) ([]byte, error) {
-	output := slices.Concat(password, salt)
-	hash := sha512.New()
-
-	for range hashIterations {
-		if _, err := hash.Write(output); err != nil {
-			return nil, err
-		}
-		output = hash.Sum(nil)
-		hash.Reset()
-	}
-
-	return output, nil
-}
-
-func readConfigValues(cfg *configuration.Configuration) (
-	saltSize int,
-	iterations int,
-	err error,
-) {
-	prefixedConfiguration := cfg.WithPrefix("nginx-ignition.security.user-password-hashing")
-	saltSize, err = prefixedConfiguration.GetInt("salt-size")
+func (h *PasswordHash) configuredHasher() (hasher, error) {
+	cfg := h.prefixedConfiguration()
+	algorithm, err := cfg.Get("algorithm")
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
 
-	iterations, err = prefixedConfiguration.GetInt("iterations")
-	if err != nil {
-		return 0, 0, err
+	switch strings.ToUpper(strings.TrimSpace(algorithm)) {
+	case algorithmArgon2id:
+		return newArgon2idHasher(cfg)
+	case algorithmBcrypt:
+		return newBcryptHasher(cfg)
+	case algorithmSHA512:
+		return newSHA512Hasher(cfg)
+	default:
+		return nil, fmt.Errorf("unsupported password hashing algorithm: %s", algorithm)
 	}
+}
 
-	return saltSize, iterations, nil
+func (h *PasswordHash) prefixedConfiguration() *configuration.Configuration {
+	return h.configuration.WithPrefix("nginx-ignition.security.user-password-hashing")
 }
@@ -1,7 +1,9 @@
 package passwordhash
 
 import (
+	"encoding/base64"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,10 +12,18 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
 )
 
-func setupConfigForTest(t *testing.T, saltSize, iterations int) *PasswordHash {
+func setupConfigForTest(t *testing.T, algorithm string, saltSize, iterations int) *PasswordHash {
 	t.Helper()
 
-	overrides := make(map[string]string)
+	overrides := map[string]string{
+		"nginx-ignition.security.user-password-hashing.algorithm":            algorithm,
+		"nginx-ignition.security.user-password-hashing.argon2id.memory-kib":  "1024",
+		"nginx-ignition.security.user-password-hashing.argon2id.iterations":  "1",
+		"nginx-ignition.security.user-password-hashing.argon2id.parallelism": "1",
+		"nginx-ignition.security.user-password-hashing.argon2id.key-size":    "32",
+		"nginx-ignition.security.user-password-hashing.bcrypt.cost":          "4",
+	}
+
 	if saltSize > 0 {
 		overrides["nginx-ignition.security.user-password-hashing.salt-size"] = strconv.Itoa(
 			saltSize,
@@ -36,84 +46,188 @@ func setupConfigForTest(t *testing.T, saltSize, iterations int) *PasswordHash {
 	return passwordHash
 }
 
+func legacyHashForTest(t *testing.T, password string, iterations int) (string, string) {
+	t.Helper()
+
+	salt := []byte("legacy-salt")
+	hash, err := hashSHA512([]byte(password), salt, iterations)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(hash), base64.StdEncoding.EncodeToString(salt)
+}
+
 func Test_PasswordHash(t *testing.T) {
+	algorithms := map[string]string{
+		algorithmArgon2id: "$argon2id$v=19$m=1024,t=1,p=1$",
+		algorithmBcrypt:   "$2a$04$",
+		algorithmSHA512:   "$sha512$i=2$",
+	}
+
 	t.Run("Hash", func(t *testing.T) {
-		t.Run("creates a valid hash", func(t *testing.T) {
-			passwordHash := setupConfigForTest(t, 32, 1)
-			password := "plain-text-password"
+		for algorithm, expectedPrefix := range algorithms {
+			t.Run("creates a valid hash with "+algorithm, func(t *testing.T) {
+				passwordHash := setupConfigForTest(t, algorithm, 16, 2)
 
-			hash, salt, err := passwordHash.Hash(password)
-			require.NoError(t, err)
-			assert.NotEmpty(t, hash)
-			assert.NotEmpty(t, salt)
-		})
+				hash, err := passwordHash.Hash("plain-text-password")
+				require.NoError(t, err)
+				assert.True(t, strings.HasPrefix(hash, expectedPrefix))
+			})
+		}
 
 		t.Run("fails when config is invalid", func(t *testing.T) {
-			passwordHash := setupConfigForTest(t, 0, 1)
-			password := "plain-text-password"
+			passwordHash := setupConfigForTest(t, algorithmArgon2id, 0, 1)
+
+			hash, err := passwordHash.Hash("plain-text-password")
+			assert.Error(t, err)
+			assert.Empty(t, hash)
+		})
 
-			hash, salt, err := passwordHash.Hash(password)
+		t.Run("fails when the algorithm is not supported", func(t *testing.T) {
+			passwordHash := setupConfigForTest(t, "MD5", 16, 1)
+
+			hash, err := passwordHash.Hash("plain-text-password")
 			assert.Error(t, err)
 			assert.Empty(t, hash)
-			assert.Empty(t, salt)
 		})
 	})
 
 	t.Run("Verify", func(t *testing.T) {
-		t.Run("verifies a valid password", func(t *testing.T) {
-			passwordHash := setupConfigForTest(t, 64, 2)
-			password := "plain-text-password"
+		for algorithm := range algorithms {
+			t.Run("verifies a valid password with "+algorithm, func(t *testing.T) {
+				passwordHash := setupConfigForTest(t, algorithm, 16, 2)
+				password := "plain-text-password"
+
+				hash, err := passwordHash.Hash(password)
+				require.NoError(t, err)
+
+				ok, err := passwordHash.Verify(password, hash, "")
+				require.NoError(t, err)
+				assert.True(t, ok)
+			})
+
+			t.Run("fails on wrong password with "+algorithm, func(t *testing.T) {
+				passwordHash := setupConfigForTest(t, algorithm, 16, 2)
+				password := "plain-text-password"
+
+				hash, err := passwordHash.Hash(password)
+				require.NoError(t, err)
 
-			hash, salt, err := passwordHash.Hash(password)
+				ok, err := passwordHash.Verify(password+"-wrong", hash, "")
+				require.NoError(t, err)
+				assert.False(t, ok)
+			})
+		}
+
+		t.Run("verifies hashes created by another algorithm", func(t *testing.T) {
+			password := "plain-text-password"
+			hash, err := setupConfigForTest(t, algorithmBcrypt, 16, 1).Hash(password)
 			require.NoError(t, err)
 
-			ok, err := passwordHash.Verify(password, hash, salt)
+			ok, err := setupConfigForTest(t, algorithmArgon2id, 16, 1).Verify(password, hash, "")
 			require.NoError(t, err)
 			assert.True(t, ok)
 		})
 
-		t.Run("fails on wrong password", func(t *testing.T) {
-			passwordHash := setupConfigForTest(t, 32, 1)
+		t.Run("verifies a valid legacy password", func(t *testing.T) {
+			passwordHash := setupConfigForTest(t, algorithmArgon2id, 64, 2)
 			password := "plain-text-password"
+			hash, salt := legacyHashForTest(t, password, 2)
 
-			hash, salt, err := passwordHash.Hash(password)
+			ok, err := passwordHash.Verify(password, hash, salt)
 			require.NoError(t, err)
+			assert.True(t, ok)
+		})
+
+		t.Run("fails on wrong legacy password", func(t *testing.T) {
+			passwordHash := setupConfigForTest(t, algorithmArgon2id, 32, 1)
+			password := "plain-text-password"
+			hash, salt := legacyHashForTest(t, password, 1)
 
 			ok, err := passwordHash.Verify(password+"-wrong", hash, salt)
 			require.NoError(t, err)
 			assert.False(t, ok)
 		})
 
-		t.Run("fails on invalid hash", func(t *testing.T) {
-			passwordHash := setupConfigForTest(t, 32, 1)
+		t.Run("fails on invalid legacy hash", func(t *testing.T) {
+			passwordHash := setupConfigForTest(t, algorithmArgon2id, 32, 1)
 			password := "plain-text-password"
-
-			_, salt, err := passwordHash.Hash(password)
-			require.NoError(t, err)
+			_, salt := legacyHashForTest(t, password, 1)
 
 			ok, err := passwordHash.Verify(password, "invalid-hash", salt)
 			assert.Error(t, err)
 			assert.False(t, ok)
 		})
 
-		t.Run("fails on invalid salt", func(t *testing.T) {
-			passwordHash := setupConfigForTest(t, 32, 1)
+		t.Run("fails on invalid legacy salt", func(t *testing.T) {
+			passwordHash := setupConfigForTest(t, algorithmArgon2id, 32, 1)
 			password := "plain-text-password"
-
-			hash, _, err := passwordHash.Hash(password)
-			require.NoError(t, err)
+			hash, _ := legacyHashForTest(t, password, 1)
 
 			ok, err := passwordHash.Verify(password, hash, "invalid-salt")
 			assert.Error(t, err)
 			assert.False(t, ok)
 		})
 
+		t.Run("fails on malformed argon2id hash", func(t *testing.T) {
+			passwordHash := setupConfigForTest(t, algorithmArgon2id, 32, 1)
+
+			ok, err := passwordHash.Verify("password", "$argon2id$v=19$invalid", "")
+			assert.Error(t, err)
+			assert.False(t, ok)
+		})
+
 		t.Run("fails when config is invalid", func(t *testing.T) {
-			passwordHash := setupConfigForTest(t, 32, 0)
+			passwordHash := setupConfigForTest(t, algorithmArgon2id, 32, 0)
 
 			ok, err := passwordHash.Verify("password", "hash", "salt")
 			assert.Error(t, err)
 			assert.False(t, ok)
 		})
 	})
+
+	t.Run("NeedsRehash", func(t *testing.T) {
+		for algorithm := range algorithms {
+			t.Run("returns false for current "+algorithm+" hashes", func(t *testing.T) {
+				passwordHash := setupConfigForTest(t, algorithm, 16, 2)
+				hash, err := passwordHash.Hash("plain-text-password")
+				require.NoError(t, err)
+
+				needsRehash, err := passwordHash.NeedsRehash(hash)
+				require.NoError(t, err)
+				assert.False(t, needsRehash)
+			})
+		}
+
+		t.Run("returns true for legacy hashes", func(t *testing.T) {
+			passwordHash := setupConfigForTest(t, algorithmSHA512, 16, 1)
+			hash, _ := legacyHashForTest(t, "plain-text-password", 1)
+
+			needsRehash, err := passwordHash.NeedsRehash(hash)
+			require.NoError(t, err)
+			assert.True(t, needsRehash)
+		})
+
+		t.Run("returns true when the algorithm changes", func(t *testing.T) {
+			hash, err := setupConfigForTest(t, algorithmSHA512, 16, 1).Hash("plain-text-password")
+			require.NoError(t, err)
+
+			needsRehash, err := setupConfigForTest(t, algorithmArgon2id, 16, 1).NeedsRehash(hash)
+			require.NoError(t, err)
+			assert.True(t, needsRehash)
+		})
+
+		t.Run("returns true when the parameters change", func(t *testing.T) {
+			hash, err := setupConfigForTest(t, algorithmArgon2id, 16, 1).Hash("plain-text-password")
+			require.NoError(t, err)
+
+			needsRehash, err := setupConfigForTest(t, algorithmArgon2id, 32, 1).NeedsRehash(hash)
+			require.NoError(t, err)
+			assert.True(t, needsRehash)
+		})
+
+		t.Run("fails when the algorithm is not supported", func(t *testing.T) {
+			_, err := setupConfigForTest(t, "MD5", 16, 1).NeedsRehash("hash")
+			assert.Error(t, err)
+		})
+	})
 }
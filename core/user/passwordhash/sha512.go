@@ -0,0 +1,136 @@
+package passwordhash
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+)
+
+const sha512Prefix = "$sha512$"
+
+type sha512Hasher struct {
+	saltSize   int
+	iterations int
+}
+
+func newSHA512Hasher(cfg *configuration.Configuration) (*sha512Hasher, error) {
+	saltSize, err := cfg.GetInt("salt-size")
+	if err != nil {
+		return nil, err
+	}
+
+	iterations, err := cfg.GetInt("iterations")
+	if err != nil {
+		return nil, err
+	}
+
+	return &sha512Hasher{saltSize, iterations}, nil
+}
+
+func (s *sha512Hasher) hash(password string) (string, error) {
+	salt := make([]byte, s.saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	hash, err := hashSHA512([]byte(password), salt, s.iterations)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(
+		"%si=%d$%s$%s",
+		sha512Prefix,
+		s.iterations,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(hash),
+	), nil
+}
+
+func (s *sha512Hasher) verify(password, encodedHash string) (bool, error) {
+	iterations, salt, hash, err := decodeSHA512Hash(encodedHash)
+	if err != nil {
+		return false, err
+	}
+
+	generatedHash, err := hashSHA512([]byte(password), salt, iterations)
+	if err != nil {
+		return false, err
+	}
+
+	return subtle.ConstantTimeCompare(generatedHash, hash) == 1, nil
+}
+
+func (s *sha512Hasher) needsRehash(encodedHash string) bool {
+	iterations, salt, _, err := decodeSHA512Hash(encodedHash)
+	if err != nil {
+		return true
+	}
+
+	return iterations != s.iterations || len(salt) != s.saltSize
+}
+
+func verifyLegacySHA512(password, hash, salt string, iterations int) (bool, error) {
+	saltBytes, err := base64.StdEncoding.DecodeString(salt)
+	if err != nil {
+		return false, err
+	}
+
+	generatedHash, err := hashSHA512([]byte(password), saltBytes, iterations)
+	if err != nil {
+		return false, err
+	}
+
+	hashBytes, err := base64.StdEncoding.DecodeString(hash)
+	if err != nil {
+		return false, err
+	}
+
+	return subtle.ConstantTimeCompare(generatedHash, hashBytes) == 1, nil
+}
+
+func hashSHA512(password, salt []byte, iterations int) ([]byte, error) {
+	output := slices.Concat(password, salt)
+	hash := sha512.New()
+
+	for range iterations {
+		if _, err := hash.Write(output); err != nil {
+			return nil, err
+		}
+		output = hash.Sum(nil)
+		hash.Reset()
+	}
+
+	return output, nil
+}
+
+func decodeSHA512Hash(encodedHash string) (int, []byte, []byte, error) {
+	parts := strings.Split(encodedHash, "$")
+	if !strings.HasPrefix(encodedHash, sha512Prefix) || len(parts) != 5 {
+		return 0, nil, nil, errors.New("invalid SHA-512 password hash")
+	}
+
+	var iterations int
+	if _, err := fmt.Sscanf(parts[2], "i=%d", &iterations); err != nil {
+		return 0, nil, nil, err
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	hash, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	return iterations, salt, hash, nil
+}
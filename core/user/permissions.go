@@ -0,0 +1,53 @@
+package user
+
+var permissionAccessors = map[string]func(*Permissions) *AccessLevel{
+	"hosts":           func(p *Permissions) *AccessLevel { return &p.Hosts },
+	"streams":         func(p *Permissions) *AccessLevel { return &p.Streams },
+	"certificates":    func(p *Permissions) *AccessLevel { return &p.Certificates },
+	"logs":            func(p *Permissions) *AccessLevel { return &p.Logs },
+	"integrations":    func(p *Permissions) *AccessLevel { return &p.Integrations },
+	"accessLists":     func(p *Permissions) *AccessLevel { return &p.AccessLists },
+	"settings":        func(p *Permissions) *AccessLevel { return &p.Settings },
+	"users":           func(p *Permissions) *AccessLevel { return &p.Users },
+	"nginxServer":     func(p *Permissions) *AccessLevel { return &p.NginxServer },
+	"exportData":      func(p *Permissions) *AccessLevel { return &p.ExportData },
+	"vpns":            func(p *Permissions) *AccessLevel { return &p.VPNs },
+	"caches":          func(p *Permissions) *AccessLevel { return &p.Caches },
+	"upstreams":       func(p *Permissions) *AccessLevel { return &p.Upstreams },
+	"tlsProfiles":     func(p *Permissions) *AccessLevel { return &p.TLSProfiles },
+	"rateLimits":      func(p *Permissions) *AccessLevel { return &p.RateLimits },
+	"securityHeaders": func(p *Permissions) *AccessLevel { return &p.SecurityHeaders },
+	"trafficStats":    func(p *Permissions) *AccessLevel { return &p.TrafficStats },
+	"audit":           func(p *Permissions) *AccessLevel { return &p.Audit },
+}
+
+func (p Permissions) Intersect(other Permissions) Permissions {
+	output := p
+	for _, accessor := range permissionAccessors {
+		if accessLevelRank(*accessor(&other)) < accessLevelRank(*accessor(&output)) {
+			*accessor(&output) = *accessor(&other)
+		}
+	}
+
+	return output
+}
+
+func (p Permissions) Levels() map[string]AccessLevel {
+	output := make(map[string]AccessLevel, len(permissionAccessors))
+	for name, accessor := range permissionAccessors {
+		output[name] = *accessor(&p)
+	}
+
+	return output
+}
+
+func accessLevelRank(level AccessLevel) int {
+	switch level {
+	case ReadOnlyAccessLevel:
+		return 1
+	case ReadWriteAccessLevel:
+		return 2
+	default:
+		return 0
+	}
+}
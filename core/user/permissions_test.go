@@ -0,0 +1,44 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Permissions(t *testing.T) {
+	t.Run("Intersect", func(t *testing.T) {
+		t.Run("keeps the lowest access level of each permission", func(t *testing.T) {
+			first := Permissions{
+				Hosts:      ReadWriteAccessLevel,
+				Streams:    ReadOnlyAccessLevel,
+				Users:      NoAccessAccessLevel,
+				RateLimits: ReadWriteAccessLevel,
+			}
+			second := Permissions{
+				Hosts:      ReadOnlyAccessLevel,
+				Streams:    ReadWriteAccessLevel,
+				Users:      ReadWriteAccessLevel,
+				RateLimits: ReadOnlyAccessLevel,
+			}
+
+			result := first.Intersect(second)
+
+			assert.Equal(t, ReadOnlyAccessLevel, result.Hosts)
+			assert.Equal(t, ReadOnlyAccessLevel, result.Streams)
+			assert.Equal(t, NoAccessAccessLevel, result.Users)
+			assert.Equal(t, ReadOnlyAccessLevel, result.RateLimits)
+			assert.Equal(t, AccessLevel(""), result.Caches)
+		})
+	})
+
+	t.Run("Levels", func(t *testing.T) {
+		t.Run("returns every permission by name", func(t *testing.T) {
+			result := Permissions{Hosts: ReadWriteAccessLevel}.Levels()
+
+			assert.Len(t, result, 18)
+			assert.Equal(t, ReadWriteAccessLevel, result["hosts"])
+			assert.Equal(t, AccessLevel(""), result["audit"])
+		})
+	})
+}
@@ -13,6 +13,7 @@ type Repository interface {
 	DeleteByID(ctx context.Context, id uuid.UUID) error
 	FindByID(ctx context.Context, id uuid.UUID) (*User, error)
 	FindByUsername(ctx context.Context, username string) (*User, error)
+	FindByExternalSubject(ctx context.Context, subject string) (*User, error)
 	FindPage(
 		ctx context.Context,
 		pageSize, pageNumber int,
@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pquerna/otp/totp"
@@ -10,51 +11,73 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
 	"dillmann.com.br/nginx-ignition/core/common/coreerror"
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/log"
 	"dillmann.com.br/nginx-ignition/core/common/pagination"
 	"dillmann.com.br/nginx-ignition/core/common/validation"
+	"dillmann.com.br/nginx-ignition/core/loginattempt"
 	"dillmann.com.br/nginx-ignition/core/user/passwordhash"
 )
 
 type service struct {
 	repository    Repository
 	configuration *configuration.Configuration
+	loginAttempts loginattempt.Commands
 }
 
-func newService(repository Repository, cfg *configuration.Configuration) *service {
+func newService(
+	repository Repository,
+	cfg *configuration.Configuration,
+	loginAttempts loginattempt.Commands,
+) *service {
 	return &service{
 		repository:    repository,
 		configuration: cfg,
+		loginAttempts: loginAttempts,
 	}
 }
 
 func (s *service) Authenticate(
 	ctx context.Context,
-	username, password, code string,
+	username, password, code, sourceAddress string,
 ) (AuthenticationOutcome, *User, error) {
-	usr, err := s.repository.FindByUsername(ctx, username)
+	localLoginEnabled, err := s.isLocalLoginEnabled()
 	if err != nil {
 		return AuthenticationFailed, nil, err
 	}
 
-	if usr == nil {
+	if !localLoginEnabled {
 		return AuthenticationFailed, nil, coreerror.New(
-			i18n.M(ctx, i18n.K.CoreUserInvalidCredentials),
+			i18n.M(ctx, i18n.K.CoreUserLocalLoginDisabled),
 			true,
 		)
 	}
 
-	passwordMatches, err := passwordhash.
-		New(s.configuration).
-		Verify(password, usr.PasswordHash, usr.PasswordSalt)
+	locked, err := s.loginAttempts.IsLocked(ctx, username, sourceAddress)
+	if err != nil {
+		return AuthenticationFailed, nil, err
+	}
+
+	if locked {
+		return AuthenticationLocked, nil, nil
+	}
+
+	usr, err := s.repository.FindByUsername(ctx, username)
+	if err != nil {
+		return AuthenticationFailed, nil, err
+	}
+
+	if usr == nil || usr.PasswordHash == "" {
+		return s.rejectCredentials(ctx, username, sourceAddress)
+	}
+
+	hash := passwordhash.New(s.configuration)
+	passwordMatches, err := hash.Verify(password, usr.PasswordHash, usr.PasswordSalt)
 	if err != nil {
 		return AuthenticationFailed, nil, err
 	}
 
 	if !passwordMatches {
-		return AuthenticationFailed, nil, coreerror.New(
-			i18n.M(ctx, i18n.K.CoreUserInvalidCredentials),
-			true,
-		)
+		return s.rejectCredentials(ctx, username, sourceAddress)
 	}
 
 	totpData := usr.TOTP
@@ -64,7 +87,8 @@ func (s *service) Authenticate(
 		}
 
 		if !totp.Validate(code, *totpData.Secret) {
-			return AuthenticationFailed, nil, nil
+			err = s.loginAttempts.RecordFailure(ctx, username, sourceAddress)
+			return AuthenticationFailed, nil, err
 		}
 
 		updated, err := s.repository.TryUpdateLastUsedTOTPCode(ctx, usr.ID, code)
@@ -78,9 +102,179 @@ func (s *service) Authenticate(
 		}
 	}
 
+	if err = s.loginAttempts.RecordSuccess(ctx, username); err != nil {
+		return AuthenticationFailed, nil, err
+	}
+
+	s.upgradePasswordHash(ctx, hash, usr, password)
 	return AuthenticationSuccessful, usr, nil
 }
 
+func (s *service) rejectCredentials(
+	ctx context.Context,
+	username, sourceAddress string,
+) (AuthenticationOutcome, *User, error) {
+	if err := s.loginAttempts.RecordFailure(ctx, username, sourceAddress); err != nil {
+		return AuthenticationFailed, nil, err
+	}
+
+	return AuthenticationFailed, nil, coreerror.New(
+		i18n.M(ctx, i18n.K.CoreUserInvalidCredentials),
+		true,
+	)
+}
+
+func (s *service) upgradePasswordHash(
+	ctx context.Context,
+	hash *passwordhash.PasswordHash,
+	usr *User,
+	password string,
+) {
+	needsRehash, err := hash.NeedsRehash(usr.PasswordHash)
+	if err != nil {
+		log.Warnf("Unable to check the password hash of the user %s: %s", usr.Username, err)
+		return
+	}
+
+	if !needsRehash {
+		return
+	}
+
+	updatedHash, err := hash.Hash(password)
+	if err != nil {
+		log.Warnf("Unable to upgrade the password hash of the user %s: %s", usr.Username, err)
+		return
+	}
+
+	usr.PasswordHash = updatedHash
+	usr.PasswordSalt = ""
+	if err = s.repository.Save(ctx, usr); err != nil {
+		log.Warnf("Unable to upgrade the password hash of the user %s: %s", usr.Username, err)
+	}
+}
+
+func (s *service) AuthenticateExternal(
+	ctx context.Context,
+	identity *ExternalIdentity,
+) (*User, error) {
+	cfg := s.configuration.WithPrefix("nginx-ignition.security.oidc")
+	mappingsValue, _ := cfg.Get("group-mappings")
+	mappings, err := parseGroupMappings(mappingsValue)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, groupMatched := resolveGroupPermissions(mappings, identity.Groups)
+
+	usr, err := s.repository.FindByExternalSubject(ctx, identity.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	if usr == nil {
+		usr, err = s.findUserToLink(ctx, cfg, identity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if usr == nil {
+		usr, err = s.buildExternalUser(ctx, cfg, identity, permissions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !usr.Enabled {
+		return nil, coreerror.New(i18n.M(ctx, i18n.K.CoreUserExternalUserDisabled), true)
+	}
+
+	usr.ExternalSubject = &identity.Subject
+	if groupMatched {
+		usr.Permissions = *permissions
+	}
+
+	if err = s.repository.Save(ctx, usr); err != nil {
+		return nil, err
+	}
+
+	return usr, nil
+}
+
+func (s *service) findUserToLink(
+	ctx context.Context,
+	cfg *configuration.Configuration,
+	identity *ExternalIdentity,
+) (*User, error) {
+	linkByEmail, err := cfg.GetBoolean("link-by-email")
+	if err != nil {
+		return nil, err
+	}
+
+	if !linkByEmail || !identity.EmailVerified || strings.TrimSpace(identity.Email) == "" {
+		return nil, nil
+	}
+
+	usr, err := s.repository.FindByUsername(ctx, identity.Email)
+	if err != nil || usr == nil {
+		return nil, err
+	}
+
+	if usr.ExternalSubject != nil {
+		return nil, coreerror.New(i18n.M(ctx, i18n.K.CoreUserExternalAlreadyLinked), true)
+	}
+
+	return usr, nil
+}
+
+func (s *service) buildExternalUser(
+	ctx context.Context,
+	cfg *configuration.Configuration,
+	identity *ExternalIdentity,
+	permissions *Permissions,
+) (*User, error) {
+	autoProvisioning, err := cfg.GetBoolean("auto-provisioning")
+	if err != nil {
+		return nil, err
+	}
+
+	if !autoProvisioning {
+		return nil, coreerror.New(i18n.M(ctx, i18n.K.CoreUserExternalNotProvisioned), true)
+	}
+
+	if permissions == nil {
+		return nil, coreerror.New(i18n.M(ctx, i18n.K.CoreUserExternalNoGroupMatched), true)
+	}
+
+	username := firstNonBlank(identity.Username, identity.Email, identity.Subject)
+	existingUser, err := s.repository.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingUser != nil {
+		return nil, coreerror.New(i18n.M(ctx, i18n.K.CoreUserDuplicatedUsername), true)
+	}
+
+	return &User{
+		ID:          uuid.New(),
+		Enabled:     true,
+		Name:        firstNonBlank(identity.Name, username),
+		Username:    username,
+		Permissions: *permissions,
+	}, nil
+}
+
+func (s *service) isLocalLoginEnabled() (bool, error) {
+	cfg := s.configuration.WithPrefix("nginx-ignition.security.oidc")
+	oidcEnabled, err := cfg.GetBoolean("enabled")
+	if err != nil || !oidcEnabled {
+		return true, nil
+	}
+
+	return cfg.GetBoolean("local-login-enabled")
+}
+
 func (s *service) UpdatePassword(
 	ctx context.Context,
 	id uuid.UUID,
@@ -119,13 +313,13 @@ func (s *service) UpdatePassword(
 		)
 	}
 
-	updatedHash, updatedSalt, err := hash.Hash(newPassword)
+	updatedHash, err := hash.Hash(newPassword)
 	if err != nil {
 		return err
 	}
 
 	databaseState.PasswordHash = updatedHash
-	databaseState.PasswordSalt = updatedSalt
+	databaseState.PasswordSalt = ""
 	return s.repository.Save(ctx, databaseState)
 }
 
@@ -133,6 +327,28 @@ func (s *service) Get(ctx context.Context, id uuid.UUID) (*User, error) {
 	return s.repository.FindByID(ctx, id)
 }
 
+func (s *service) GetLockedUntil(ctx context.Context, id uuid.UUID) (*time.Time, error) {
+	usr, err := s.repository.FindByID(ctx, id)
+	if err != nil || usr == nil {
+		return nil, err
+	}
+
+	return s.loginAttempts.GetLockedUntil(ctx, usr.Username)
+}
+
+func (s *service) Unlock(ctx context.Context, id uuid.UUID) error {
+	usr, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if usr == nil {
+		return coreerror.New(i18n.M(ctx, i18n.K.CoreUserNotFoundById), true)
+	}
+
+	return s.loginAttempts.Unlock(ctx, usr.Username)
+}
+
 func (s *service) Delete(ctx context.Context, id uuid.UUID) error {
 	return s.repository.DeleteByID(ctx, id)
 }
@@ -166,7 +382,7 @@ func (s *service) Save(ctx context.Context, request *SaveRequest, currentUserID
 		passwordSalt = databaseState.PasswordSalt
 		totpValue = databaseState.TOTP
 	} else if request.Password != nil {
-		passwordHash, passwordSalt, err = passwordhash.New(s.configuration).Hash(*request.Password)
+		passwordHash, err = passwordhash.New(s.configuration).Hash(*request.Password)
 		if err != nil {
 			return err
 		}
@@ -177,15 +393,21 @@ func (s *service) Save(ctx context.Context, request *SaveRequest, currentUserID
 		totpValue.Validated = false
 	}
 
+	var externalSubject *string
+	if databaseState != nil {
+		externalSubject = databaseState.ExternalSubject
+	}
+
 	updatedState := &User{
-		ID:           request.ID,
-		Enabled:      request.Enabled,
-		Name:         request.Name,
-		Username:     request.Username,
-		PasswordHash: passwordHash,
-		PasswordSalt: passwordSalt,
-		Permissions:  request.Permissions,
-		TOTP:         totpValue,
+		ExternalSubject: externalSubject,
+		ID:              request.ID,
+		Enabled:         request.Enabled,
+		Name:            request.Name,
+		Username:        request.Username,
+		PasswordHash:    passwordHash,
+		PasswordSalt:    passwordSalt,
+		Permissions:     request.Permissions,
+		TOTP:            totpValue,
 	}
 
 	if err := newValidator(s.repository).validate(
@@ -309,12 +531,22 @@ func (s *service) resetPassword(ctx context.Context, username string) (string, e
 	}
 
 	newPassword := uuid.NewString()[:8]
-	updatedHash, updatedSalt, err := passwordhash.New(s.configuration).Hash(newPassword)
+	updatedHash, err := passwordhash.New(s.configuration).Hash(newPassword)
 	if err != nil {
 		return "", err
 	}
 
 	user.PasswordHash = updatedHash
-	user.PasswordSalt = updatedSalt
+	user.PasswordSalt = ""
 	return newPassword, s.repository.Save(ctx, user)
 }
+
+func firstNonBlank(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	return ""
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"dillmann.com.br/nginx-ignition/core/common/coreerror"
 	"dillmann.com.br/nginx-ignition/core/common/i18n"
 	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/loginattempt"
 	"dillmann.com.br/nginx-ignition/core/user/passwordhash"
 )
 
@@ -30,7 +32,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().FindByID(t.Context(), expected.ID).Return(expected, nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			result, err := svc.Get(t.Context(), expected.ID)
 
 			assert.NoError(t, err)
@@ -48,7 +50,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().FindByID(t.Context(), id).Return(nil, expectedErr)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			result, err := svc.Get(t.Context(), id)
 
 			assert.Error(t, err)
@@ -68,7 +70,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().DeleteByID(t.Context(), id).Return(nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			err := svc.Delete(t.Context(), id)
 
 			assert.NoError(t, err)
@@ -87,7 +89,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().FindPage(t.Context(), 10, 1, &searchTerms).Return(expectedPage, nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			result, err := svc.List(t.Context(), 10, 1, &searchTerms)
 
 			assert.NoError(t, err)
@@ -106,7 +108,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().Count(t.Context()).Return(expectedCount, nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			count, err := svc.GetCount(t.Context())
 
 			assert.NoError(t, err)
@@ -123,7 +125,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().Count(t.Context()).Return(1, nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			completed, err := svc.OnboardingCompleted(t.Context())
 
 			assert.NoError(t, err)
@@ -138,7 +140,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().Count(t.Context()).Return(0, nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			completed, err := svc.OnboardingCompleted(t.Context())
 
 			assert.NoError(t, err)
@@ -158,7 +160,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().Save(t.Context(), gomock.Any()).Return(nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			err := svc.Save(t.Context(), request, nil)
 
 			assert.NoError(t, err)
@@ -186,7 +188,7 @@ func Test_service(t *testing.T) {
 			})
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			err := svc.Save(t.Context(), request, nil)
 
 			assert.NoError(t, err)
@@ -197,7 +199,8 @@ func Test_service(t *testing.T) {
 		cfg := &configuration.Configuration{}
 		ph := passwordhash.New(cfg)
 		password := "password"
-		hash, salt, _ := ph.Hash(password)
+		hash, _ := ph.Hash(password)
+		address := "192.168.0.10"
 
 		t.Run("returns error when user not found", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -206,8 +209,18 @@ func Test_service(t *testing.T) {
 			repo := NewMockedRepository(ctrl)
 			repo.EXPECT().FindByUsername(t.Context(), "nonexistent").Return(nil, nil)
 
-			svc, _ := newCommands(repo, cfg)
-			outcome, result, err := svc.Authenticate(t.Context(), "nonexistent", "password", "")
+			loginAttempts := loginattempt.NewMockedCommands(ctrl)
+			loginAttempts.EXPECT().IsLocked(t.Context(), "nonexistent", address).Return(false, nil)
+			loginAttempts.EXPECT().RecordFailure(t.Context(), "nonexistent", address).Return(nil)
+
+			svc, _ := newCommands(repo, cfg, loginAttempts)
+			outcome, result, err := svc.Authenticate(
+				t.Context(),
+				"nonexistent",
+				"password",
+				"",
+				address,
+			)
 
 			require.Error(t, err)
 			assert.Nil(t, result)
@@ -224,14 +237,62 @@ func Test_service(t *testing.T) {
 
 			usr := newUser()
 			usr.PasswordHash = hash
-			usr.PasswordSalt = salt
 			usr.TOTP.Validated = false
 
 			repo := NewMockedRepository(ctrl)
 			repo.EXPECT().FindByUsername(t.Context(), usr.Username).Return(usr, nil)
 
-			svc, _ := newCommands(repo, cfg)
-			outcome, result, err := svc.Authenticate(t.Context(), usr.Username, password, "")
+			loginAttempts := loginattempt.NewMockedCommands(ctrl)
+			loginAttempts.EXPECT().IsLocked(t.Context(), usr.Username, address).Return(false, nil)
+			loginAttempts.EXPECT().RecordSuccess(t.Context(), usr.Username).Return(nil)
+
+			svc, _ := newCommands(repo, cfg, loginAttempts)
+			outcome, result, err := svc.Authenticate(
+				t.Context(),
+				usr.Username,
+				password,
+				"",
+				address,
+			)
+
+			assert.NoError(t, err)
+			assert.Equal(t, usr, result)
+			assert.Equal(t, AuthenticationSuccessful, outcome)
+		})
+
+		t.Run("upgrades outdated password hashes on success", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			legacyCfg := configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.security.user-password-hashing.algorithm": "SHA-512",
+			})
+			legacyHash, _ := passwordhash.New(legacyCfg).Hash(password)
+
+			usr := newUser()
+			usr.PasswordHash = legacyHash
+			usr.TOTP.Validated = false
+
+			repo := NewMockedRepository(ctrl)
+			repo.EXPECT().FindByUsername(t.Context(), usr.Username).Return(usr, nil)
+			repo.EXPECT().Save(t.Context(), usr).DoAndReturn(func(_ any, u *User) error {
+				assert.True(t, strings.HasPrefix(u.PasswordHash, "$argon2id$"))
+				assert.Empty(t, u.PasswordSalt)
+				return nil
+			})
+
+			loginAttempts := loginattempt.NewMockedCommands(ctrl)
+			loginAttempts.EXPECT().IsLocked(t.Context(), usr.Username, address).Return(false, nil)
+			loginAttempts.EXPECT().RecordSuccess(t.Context(), usr.Username).Return(nil)
+
+			svc, _ := newCommands(repo, cfg, loginAttempts)
+			outcome, result, err := svc.Authenticate(
+				t.Context(),
+				usr.Username,
+				password,
+				"",
+				address,
+			)
 
 			assert.NoError(t, err)
 			assert.Equal(t, usr, result)
@@ -244,13 +305,22 @@ func Test_service(t *testing.T) {
 
 			usr := newUser()
 			usr.PasswordHash = hash
-			usr.PasswordSalt = salt
 
 			repo := NewMockedRepository(ctrl)
 			repo.EXPECT().FindByUsername(t.Context(), usr.Username).Return(usr, nil)
 
-			svc, _ := newCommands(repo, cfg)
-			outcome, result, err := svc.Authenticate(t.Context(), usr.Username, "wrongpassword", "")
+			loginAttempts := loginattempt.NewMockedCommands(ctrl)
+			loginAttempts.EXPECT().IsLocked(t.Context(), usr.Username, address).Return(false, nil)
+			loginAttempts.EXPECT().RecordFailure(t.Context(), usr.Username, address).Return(nil)
+
+			svc, _ := newCommands(repo, cfg, loginAttempts)
+			outcome, result, err := svc.Authenticate(
+				t.Context(),
+				usr.Username,
+				"wrongpassword",
+				"",
+				address,
+			)
 
 			require.Error(t, err)
 			assert.Nil(t, result)
@@ -267,14 +337,22 @@ func Test_service(t *testing.T) {
 
 			usr := newUser()
 			usr.PasswordHash = hash
-			usr.PasswordSalt = salt
 			usr.TOTP = TOTP{Secret: new("JBSWY3DPEHPK3PXP"), Validated: true}
 
 			repo := NewMockedRepository(ctrl)
 			repo.EXPECT().FindByUsername(t.Context(), usr.Username).Return(usr, nil)
 
-			svc, _ := newCommands(repo, cfg)
-			outcome, result, err := svc.Authenticate(t.Context(), usr.Username, password, "")
+			loginAttempts := loginattempt.NewMockedCommands(ctrl)
+			loginAttempts.EXPECT().IsLocked(t.Context(), usr.Username, address).Return(false, nil)
+
+			svc, _ := newCommands(repo, cfg, loginAttempts)
+			outcome, result, err := svc.Authenticate(
+				t.Context(),
+				usr.Username,
+				password,
+				"",
+				address,
+			)
 
 			assert.NoError(t, err)
 			assert.Nil(t, result)
@@ -287,14 +365,23 @@ func Test_service(t *testing.T) {
 
 			usr := newUser()
 			usr.PasswordHash = hash
-			usr.PasswordSalt = salt
 			usr.TOTP = TOTP{Secret: new("JBSWY3DPEHPK3PXP"), Validated: true}
 
 			repo := NewMockedRepository(ctrl)
 			repo.EXPECT().FindByUsername(t.Context(), usr.Username).Return(usr, nil)
 
-			svc, _ := newCommands(repo, cfg)
-			outcome, result, err := svc.Authenticate(t.Context(), usr.Username, password, "000000")
+			loginAttempts := loginattempt.NewMockedCommands(ctrl)
+			loginAttempts.EXPECT().IsLocked(t.Context(), usr.Username, address).Return(false, nil)
+			loginAttempts.EXPECT().RecordFailure(t.Context(), usr.Username, address).Return(nil)
+
+			svc, _ := newCommands(repo, cfg, loginAttempts)
+			outcome, result, err := svc.Authenticate(
+				t.Context(),
+				usr.Username,
+				password,
+				"000000",
+				address,
+			)
 
 			assert.NoError(t, err)
 			assert.Nil(t, result)
@@ -310,15 +397,24 @@ func Test_service(t *testing.T) {
 
 			usr := newUser()
 			usr.PasswordHash = hash
-			usr.PasswordSalt = salt
 			usr.TOTP = TOTP{Secret: &secret, Validated: true}
 
 			repo := NewMockedRepository(ctrl)
 			repo.EXPECT().FindByUsername(t.Context(), usr.Username).Return(usr, nil)
 			repo.EXPECT().TryUpdateLastUsedTOTPCode(t.Context(), usr.ID, code).Return(true, nil)
 
-			svc, _ := newCommands(repo, cfg)
-			outcome, result, err := svc.Authenticate(t.Context(), usr.Username, password, code)
+			loginAttempts := loginattempt.NewMockedCommands(ctrl)
+			loginAttempts.EXPECT().IsLocked(t.Context(), usr.Username, address).Return(false, nil)
+			loginAttempts.EXPECT().RecordSuccess(t.Context(), usr.Username).Return(nil)
+
+			svc, _ := newCommands(repo, cfg, loginAttempts)
+			outcome, result, err := svc.Authenticate(
+				t.Context(),
+				usr.Username,
+				password,
+				code,
+				address,
+			)
 
 			assert.NoError(t, err)
 			assert.Equal(t, usr, result)
@@ -334,19 +430,206 @@ func Test_service(t *testing.T) {
 
 			usr := newUser()
 			usr.PasswordHash = hash
-			usr.PasswordSalt = salt
 			usr.TOTP = TOTP{Secret: &secret, Validated: true}
 
 			repo := NewMockedRepository(ctrl)
 			repo.EXPECT().FindByUsername(t.Context(), usr.Username).Return(usr, nil)
 			repo.EXPECT().TryUpdateLastUsedTOTPCode(t.Context(), usr.ID, code).Return(false, nil)
 
-			svc, _ := newCommands(repo, cfg)
-			outcome, result, err := svc.Authenticate(t.Context(), usr.Username, password, code)
+			loginAttempts := loginattempt.NewMockedCommands(ctrl)
+			loginAttempts.EXPECT().IsLocked(t.Context(), usr.Username, address).Return(false, nil)
+
+			svc, _ := newCommands(repo, cfg, loginAttempts)
+			outcome, result, err := svc.Authenticate(
+				t.Context(),
+				usr.Username,
+				password,
+				code,
+				address,
+			)
+
+			assert.NoError(t, err)
+			assert.Nil(t, result)
+			assert.Equal(t, AuthenticationFailed, outcome)
+		})
+
+		t.Run("returns locked outcome when login is locked", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := NewMockedRepository(ctrl)
+			loginAttempts := loginattempt.NewMockedCommands(ctrl)
+			loginAttempts.EXPECT().IsLocked(t.Context(), "user", address).Return(true, nil)
+
+			svc, _ := newCommands(repo, cfg, loginAttempts)
+			outcome, result, err := svc.Authenticate(t.Context(), "user", password, "", address)
 
 			assert.NoError(t, err)
 			assert.Nil(t, result)
+			assert.Equal(t, AuthenticationLocked, outcome)
+		})
+
+		t.Run("returns error when local login is disabled", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			oidcCfg := configuration.NewWithOverrides(map[string]string{
+				"nginx-ignition.security.oidc.enabled":             "true",
+				"nginx-ignition.security.oidc.local-login-enabled": "false",
+			})
+
+			repo := NewMockedRepository(ctrl)
+			svc, _ := newCommands(repo, oidcCfg, nil)
+			outcome, result, err := svc.Authenticate(t.Context(), "user", password, "", address)
+
+			require.Error(t, err)
+			assert.Nil(t, result)
 			assert.Equal(t, AuthenticationFailed, outcome)
+
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+			assert.Equal(t, i18n.K.CoreUserLocalLoginDisabled, coreErr.Message.Key)
+		})
+	})
+
+	t.Run("AuthenticateExternal", func(t *testing.T) {
+		cfg := configuration.NewWithOverrides(map[string]string{
+			"nginx-ignition.security.oidc.group-mappings": "admins=READ_WRITE",
+		})
+
+		newIdentity := func() *ExternalIdentity {
+			return &ExternalIdentity{
+				Subject:       uuid.NewString(),
+				Username:      "jdoe",
+				Name:          "John Doe",
+				Email:         "jdoe@example.com",
+				EmailVerified: true,
+				Groups:        []string{"admins"},
+			}
+		}
+
+		t.Run("returns the user linked to the subject", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			identity := newIdentity()
+			usr := newUser()
+			usr.ExternalSubject = &identity.Subject
+
+			repo := NewMockedRepository(ctrl)
+			repo.EXPECT().FindByExternalSubject(t.Context(), identity.Subject).Return(usr, nil)
+			repo.EXPECT().Save(t.Context(), usr).Return(nil)
+
+			svc, _ := newCommands(repo, cfg, nil)
+			result, err := svc.AuthenticateExternal(t.Context(), identity)
+
+			require.NoError(t, err)
+			assert.Equal(t, usr.ID, result.ID)
+			assert.Equal(t, ReadWriteAccessLevel, result.Permissions.Hosts)
+		})
+
+		t.Run("links an existing user by e-mail", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			identity := newIdentity()
+			usr := newUser()
+			usr.Username = identity.Email
+
+			repo := NewMockedRepository(ctrl)
+			repo.EXPECT().FindByExternalSubject(t.Context(), identity.Subject).Return(nil, nil)
+			repo.EXPECT().FindByUsername(t.Context(), identity.Email).Return(usr, nil)
+			repo.EXPECT().Save(t.Context(), usr).Return(nil)
+
+			svc, _ := newCommands(repo, cfg, nil)
+			result, err := svc.AuthenticateExternal(t.Context(), identity)
+
+			require.NoError(t, err)
+			assert.Equal(t, usr.ID, result.ID)
+			assert.Equal(t, identity.Subject, *result.ExternalSubject)
+		})
+
+		t.Run("does not link by e-mail when it is not verified", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			identity := newIdentity()
+			identity.EmailVerified = false
+
+			repo := NewMockedRepository(ctrl)
+			repo.EXPECT().FindByExternalSubject(t.Context(), identity.Subject).Return(nil, nil)
+			repo.EXPECT().FindByUsername(t.Context(), identity.Username).Return(nil, nil)
+			repo.EXPECT().Save(t.Context(), gomock.Any()).Return(nil)
+
+			svc, _ := newCommands(repo, cfg, nil)
+			result, err := svc.AuthenticateExternal(t.Context(), identity)
+
+			require.NoError(t, err)
+			assert.Equal(t, identity.Username, result.Username)
+		})
+
+		t.Run("provisions a new user with the mapped permissions", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			identity := newIdentity()
+
+			repo := NewMockedRepository(ctrl)
+			repo.EXPECT().FindByExternalSubject(t.Context(), identity.Subject).Return(nil, nil)
+			repo.EXPECT().FindByUsername(t.Context(), identity.Email).Return(nil, nil)
+			repo.EXPECT().FindByUsername(t.Context(), identity.Username).Return(nil, nil)
+			repo.EXPECT().Save(t.Context(), gomock.Any()).Return(nil)
+
+			svc, _ := newCommands(repo, cfg, nil)
+			result, err := svc.AuthenticateExternal(t.Context(), identity)
+
+			require.NoError(t, err)
+			assert.True(t, result.Enabled)
+			assert.Equal(t, identity.Username, result.Username)
+			assert.Equal(t, identity.Name, result.Name)
+			assert.Equal(t, identity.Subject, *result.ExternalSubject)
+			assert.Equal(t, ReadWriteAccessLevel, result.Permissions.Hosts)
+			assert.Equal(t, ReadOnlyAccessLevel, result.Permissions.Logs)
+		})
+
+		t.Run("returns error when no group is mapped for a new user", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			identity := newIdentity()
+			identity.Groups = []string{"others"}
+			identity.EmailVerified = false
+
+			repo := NewMockedRepository(ctrl)
+			repo.EXPECT().FindByExternalSubject(t.Context(), identity.Subject).Return(nil, nil)
+
+			svc, _ := newCommands(repo, cfg, nil)
+			result, err := svc.AuthenticateExternal(t.Context(), identity)
+
+			assert.Nil(t, result)
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+			assert.Equal(t, i18n.K.CoreUserExternalNoGroupMatched, coreErr.Message.Key)
+		})
+
+		t.Run("returns error when the linked user is disabled", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			identity := newIdentity()
+			usr := newUser()
+			usr.Enabled = false
+
+			repo := NewMockedRepository(ctrl)
+			repo.EXPECT().FindByExternalSubject(t.Context(), identity.Subject).Return(usr, nil)
+
+			svc, _ := newCommands(repo, cfg, nil)
+			result, err := svc.AuthenticateExternal(t.Context(), identity)
+
+			assert.Nil(t, result)
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+			assert.Equal(t, i18n.K.CoreUserExternalUserDisabled, coreErr.Message.Key)
 		})
 	})
 
@@ -361,7 +644,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().IsEnabledByID(t.Context(), id).Return(true, nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			enabled, err := svc.GetStatus(t.Context(), id)
 
 			assert.NoError(t, err)
@@ -369,6 +652,65 @@ func Test_service(t *testing.T) {
 		})
 	})
 
+	t.Run("GetLockedUntil", func(t *testing.T) {
+		t.Run("returns the lockout of the user", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usr := newUser()
+			lockedUntil := time.Now().Add(time.Minute)
+
+			repo := NewMockedRepository(ctrl)
+			repo.EXPECT().FindByID(t.Context(), usr.ID).Return(usr, nil)
+			loginAttempts := loginattempt.NewMockedCommands(ctrl)
+			loginAttempts.EXPECT().
+				GetLockedUntil(t.Context(), usr.Username).
+				Return(&lockedUntil, nil)
+
+			svc, _ := newCommands(repo, &configuration.Configuration{}, loginAttempts)
+			result, err := svc.GetLockedUntil(t.Context(), usr.ID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &lockedUntil, result)
+		})
+	})
+
+	t.Run("Unlock", func(t *testing.T) {
+		t.Run("unlocks the user", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			usr := newUser()
+
+			repo := NewMockedRepository(ctrl)
+			repo.EXPECT().FindByID(t.Context(), usr.ID).Return(usr, nil)
+			loginAttempts := loginattempt.NewMockedCommands(ctrl)
+			loginAttempts.EXPECT().Unlock(t.Context(), usr.Username).Return(nil)
+
+			svc, _ := newCommands(repo, &configuration.Configuration{}, loginAttempts)
+			err := svc.Unlock(t.Context(), usr.ID)
+
+			assert.NoError(t, err)
+		})
+
+		t.Run("returns error when user not found", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id := uuid.New()
+
+			repo := NewMockedRepository(ctrl)
+			repo.EXPECT().FindByID(t.Context(), id).Return(nil, nil)
+
+			svc, _ := newCommands(repo, &configuration.Configuration{}, nil)
+			err := svc.Unlock(t.Context(), id)
+
+			var coreErr *coreerror.CoreError
+			require.ErrorAs(t, err, &coreErr)
+			assert.Equal(t, i18n.K.CoreUserNotFoundById, coreErr.Message.Key)
+		})
+	})
+
 	t.Run("GetTOTPStatus", func(t *testing.T) {
 		t.Run("returns true when validated and secret exists", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -381,7 +723,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().FindByID(t.Context(), usr.ID).Return(usr, nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			status, err := svc.GetTOTPStatus(t.Context(), usr.ID)
 
 			assert.NoError(t, err)
@@ -399,7 +741,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().FindByID(t.Context(), usr.ID).Return(usr, nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			status, err := svc.GetTOTPStatus(t.Context(), usr.ID)
 
 			assert.NoError(t, err)
@@ -417,7 +759,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().FindByID(t.Context(), usr.ID).Return(usr, nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			status, err := svc.GetTOTPStatus(t.Context(), usr.ID)
 
 			assert.NoError(t, err)
@@ -442,7 +784,7 @@ func Test_service(t *testing.T) {
 			})
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			err := svc.DisableTOTP(t.Context(), usr.ID)
 
 			assert.NoError(t, err)
@@ -465,7 +807,7 @@ func Test_service(t *testing.T) {
 			})
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			url, err := svc.EnableTOTP(t.Context(), usr.ID)
 
 			assert.NoError(t, err)
@@ -495,7 +837,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().TryUpdateLastUsedTOTPCode(t.Context(), usr.ID, code).Return(true, nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			ok, err := svc.ActivateTOTP(t.Context(), usr.ID, code)
 
 			assert.NoError(t, err)
@@ -513,7 +855,7 @@ func Test_service(t *testing.T) {
 			repo.EXPECT().FindByID(t.Context(), usr.ID).Return(usr, nil)
 
 			cfg := &configuration.Configuration{}
-			svc, _ := newCommands(repo, cfg)
+			svc, _ := newCommands(repo, cfg, nil)
 			ok, err := svc.ActivateTOTP(t.Context(), usr.ID, "000000")
 
 			assert.NoError(t, err)
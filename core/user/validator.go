@@ -81,7 +81,12 @@ func (v *validator) validatePermissions(ctx context.Context, permissions Permiss
 	v.validatePermission(ctx, "exportData", permissions.ExportData)
 	v.validatePermission(ctx, "vpns", permissions.VPNs)
 	v.validatePermission(ctx, "caches", permissions.Caches)
+	v.validatePermission(ctx, "upstreams", permissions.Upstreams)
+	v.validatePermission(ctx, "tlsProfiles", permissions.TLSProfiles)
+	v.validatePermission(ctx, "rateLimits", permissions.RateLimits)
+	v.validatePermission(ctx, "securityHeaders", permissions.SecurityHeaders)
 	v.validatePermission(ctx, "trafficStats", permissions.TrafficStats)
+	v.validatePermission(ctx, "audit", permissions.Audit)
 
 	if permissions.NginxServer == NoAccessAccessLevel {
 		v.delegate.Add("permissions.nginxServer", i18n.M(ctx, i18n.K.CoreUserAtLeastReadOnly))
@@ -104,6 +109,13 @@ func (v *validator) validatePermissions(ctx context.Context, permissions Permiss
 			i18n.M(ctx, i18n.K.CoreUserCannotHaveWriteAccess),
 		)
 	}
+
+	if permissions.Audit == ReadWriteAccessLevel {
+		v.delegate.Add(
+			"permissions.audit",
+			i18n.M(ctx, i18n.K.CoreUserCannotHaveWriteAccess),
+		)
+	}
 }
 
 func (v *validator) validatePermission(ctx context.Context, key string, value AccessLevel) {
@@ -31,7 +31,7 @@ func New(db *database.Database) accesslist.Repository {
 func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*accesslist.AccessList, error) {
 	var model accessListModel
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&model).
 		Relation("Credentials").
 		Relation("EntrySets").
@@ -50,14 +50,14 @@ func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*accesslist.Ac
 }
 
 func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
-	return r.database.Select().
+	return r.database.Select(ctx).
 		Model((*accessListModel)(nil)).
 		Where(constants.ByIDFilter, id).
 		Exists(ctx)
 }
 
 func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (bool, error) {
-	hostExists, err := r.database.Select().
+	hostExists, err := r.database.Select(ctx).
 		Table("host").
 		Where(byAccessListIDFilter, id).
 		Exists(ctx)
@@ -65,14 +65,14 @@ func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (bool, error)
 		return hostExists, err
 	}
 
-	return r.database.Select().
+	return r.database.Select(ctx).
 		Table("host_route").
 		Where(byAccessListIDFilter, id).
 		Exists(ctx)
 }
 
 func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -103,7 +103,7 @@ func (r *repository) FindPage(
 ) (*pagination.Page[accesslist.AccessList], error) {
 	models := make([]accessListModel, 0)
 
-	query := r.database.Select().Model(&models)
+	query := r.database.Select(ctx).Model(&models)
 	if searchTerms != nil {
 		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+*searchTerms+"%")
 	}
@@ -135,7 +135,7 @@ func (r *repository) FindPage(
 func (r *repository) FindAll(ctx context.Context) ([]accesslist.AccessList, error) {
 	models := make([]accessListModel, 0)
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&models).
 		Relation("Credentials").
 		Relation("EntrySets").
@@ -154,7 +154,7 @@ func (r *repository) FindAll(ctx context.Context) ([]accesslist.AccessList, erro
 }
 
 func (r *repository) Save(ctx context.Context, accessList *accesslist.AccessList) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
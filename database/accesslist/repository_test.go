@@ -110,7 +110,7 @@ func runRepositoryTests(t *testing.T, db *database.Database) {
 				AccessListID: cmd.ID,
 			}
 
-			_, err := db.Insert().Model(host).Exec(t.Context())
+			_, err := db.Insert(t.Context()).Model(host).Exec(t.Context())
 			require.NoError(t, err)
 
 			inUse, err := repo.InUseByID(t.Context(), cmd.ID)
@@ -0,0 +1,79 @@
+package apitoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/user"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	userrepository "dillmann.com.br/nginx-ignition/database/user"
+)
+
+func newAPIToken(userID uuid.UUID) *apitoken.APIToken {
+	return &apitoken.APIToken{
+		ID:                     uuid.New(),
+		UserID:                 userID,
+		Name:                   "CI pipeline",
+		TokenHash:              uuid.New().String(),
+		TokenPrefix:            "nig_abcdefgh",
+		CreatedAt:              time.Now().UTC().Truncate(time.Second),
+		ExpiresAt:              new(time.Now().UTC().Add(time.Hour).Truncate(time.Second)),
+		AllowedSourceAddresses: []string{"10.0.0.0/8"},
+		Permissions: user.Permissions{
+			Hosts:           user.ReadWriteAccessLevel,
+			Streams:         user.ReadOnlyAccessLevel,
+			Certificates:    user.NoAccessAccessLevel,
+			Logs:            user.ReadOnlyAccessLevel,
+			Integrations:    user.NoAccessAccessLevel,
+			AccessLists:     user.NoAccessAccessLevel,
+			Settings:        user.NoAccessAccessLevel,
+			Users:           user.NoAccessAccessLevel,
+			NginxServer:     user.ReadOnlyAccessLevel,
+			ExportData:      user.NoAccessAccessLevel,
+			VPNs:            user.NoAccessAccessLevel,
+			Caches:          user.NoAccessAccessLevel,
+			Upstreams:       user.NoAccessAccessLevel,
+			TLSProfiles:     user.NoAccessAccessLevel,
+			RateLimits:      user.NoAccessAccessLevel,
+			SecurityHeaders: user.NoAccessAccessLevel,
+			TrafficStats:    user.NoAccessAccessLevel,
+			Audit:           user.NoAccessAccessLevel,
+		},
+	}
+}
+
+func newOwner(ctx context.Context, db *database.Database) (*user.User, error) {
+	owner := &user.User{
+		ID:           uuid.New(),
+		Name:         "Token Owner",
+		Username:     "owner-" + uuid.New().String(),
+		PasswordHash: "hash",
+		PasswordSalt: "salt",
+		Enabled:      true,
+		Permissions: user.Permissions{
+			Hosts:           user.ReadWriteAccessLevel,
+			Streams:         user.ReadWriteAccessLevel,
+			Certificates:    user.ReadWriteAccessLevel,
+			Logs:            user.ReadOnlyAccessLevel,
+			Integrations:    user.ReadWriteAccessLevel,
+			AccessLists:     user.ReadWriteAccessLevel,
+			Settings:        user.ReadWriteAccessLevel,
+			Users:           user.ReadWriteAccessLevel,
+			NginxServer:     user.ReadWriteAccessLevel,
+			ExportData:      user.ReadOnlyAccessLevel,
+			VPNs:            user.ReadWriteAccessLevel,
+			Caches:          user.ReadWriteAccessLevel,
+			Upstreams:       user.ReadWriteAccessLevel,
+			TLSProfiles:     user.ReadWriteAccessLevel,
+			RateLimits:      user.ReadWriteAccessLevel,
+			SecurityHeaders: user.ReadWriteAccessLevel,
+			TrafficStats:    user.ReadOnlyAccessLevel,
+			Audit:           user.ReadOnlyAccessLevel,
+		},
+	}
+
+	return owner, userrepository.New(db).Save(ctx, owner)
+}
@@ -0,0 +1,77 @@
+package apitoken
+
+import (
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/user"
+)
+
+func toDomain(model *apiTokenModel) apitoken.APIToken {
+	return apitoken.APIToken{
+		ID:                     model.ID,
+		UserID:                 model.UserID,
+		Name:                   model.Name,
+		TokenHash:              model.TokenHash,
+		TokenPrefix:            model.TokenPrefix,
+		ExpiresAt:              model.ExpiresAt,
+		LastUsedAt:             model.LastUsedAt,
+		CreatedAt:              model.CreatedAt,
+		AllowedSourceAddresses: model.AllowedSourceAddresses,
+		Permissions: user.Permissions{
+			Hosts:           user.AccessLevel(model.HostsAccessLevel),
+			Streams:         user.AccessLevel(model.StreamsAccessLevel),
+			Certificates:    user.AccessLevel(model.CertificatesAccessLevel),
+			Logs:            user.AccessLevel(model.LogsAccessLevel),
+			Integrations:    user.AccessLevel(model.IntegrationsAccessLevel),
+			AccessLists:     user.AccessLevel(model.AccessListsAccessLevel),
+			Settings:        user.AccessLevel(model.SettingsAccessLevel),
+			Users:           user.AccessLevel(model.UsersAccessLevel),
+			NginxServer:     user.AccessLevel(model.NginxServerAccessLevel),
+			ExportData:      user.AccessLevel(model.ExportDataAccessLevel),
+			VPNs:            user.AccessLevel(model.VPNsAccessLevel),
+			Caches:          user.AccessLevel(model.CachesAccessLevel),
+			Upstreams:       user.AccessLevel(model.UpstreamsAccessLevel),
+			TLSProfiles:     user.AccessLevel(model.TLSProfilesAccessLevel),
+			RateLimits:      user.AccessLevel(model.RateLimitsAccessLevel),
+			SecurityHeaders: user.AccessLevel(model.SecurityHeadersAccessLevel),
+			TrafficStats:    user.AccessLevel(model.TrafficStatsAccessLevel),
+			Audit:           user.AccessLevel(model.AuditAccessLevel),
+		},
+	}
+}
+
+func toModel(domain *apitoken.APIToken) apiTokenModel {
+	allowedSourceAddresses := domain.AllowedSourceAddresses
+	if allowedSourceAddresses == nil {
+		allowedSourceAddresses = make([]string, 0)
+	}
+
+	return apiTokenModel{
+		ID:                         domain.ID,
+		UserID:                     domain.UserID,
+		Name:                       domain.Name,
+		TokenHash:                  domain.TokenHash,
+		TokenPrefix:                domain.TokenPrefix,
+		ExpiresAt:                  domain.ExpiresAt,
+		LastUsedAt:                 domain.LastUsedAt,
+		CreatedAt:                  domain.CreatedAt,
+		AllowedSourceAddresses:     allowedSourceAddresses,
+		HostsAccessLevel:           string(domain.Permissions.Hosts),
+		StreamsAccessLevel:         string(domain.Permissions.Streams),
+		CertificatesAccessLevel:    string(domain.Permissions.Certificates),
+		LogsAccessLevel:            string(domain.Permissions.Logs),
+		IntegrationsAccessLevel:    string(domain.Permissions.Integrations),
+		AccessListsAccessLevel:     string(domain.Permissions.AccessLists),
+		SettingsAccessLevel:        string(domain.Permissions.Settings),
+		UsersAccessLevel:           string(domain.Permissions.Users),
+		NginxServerAccessLevel:     string(domain.Permissions.NginxServer),
+		ExportDataAccessLevel:      string(domain.Permissions.ExportData),
+		VPNsAccessLevel:            string(domain.Permissions.VPNs),
+		CachesAccessLevel:          string(domain.Permissions.Caches),
+		UpstreamsAccessLevel:       string(domain.Permissions.Upstreams),
+		TLSProfilesAccessLevel:     string(domain.Permissions.TLSProfiles),
+		RateLimitsAccessLevel:      string(domain.Permissions.RateLimits),
+		SecurityHeadersAccessLevel: string(domain.Permissions.SecurityHeaders),
+		TrafficStatsAccessLevel:    string(domain.Permissions.TrafficStats),
+		AuditAccessLevel:           string(domain.Permissions.Audit),
+	}
+}
@@ -0,0 +1,40 @@
+package apitoken
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type apiTokenModel struct {
+	bun.BaseModel `bun:"api_token"`
+
+	ExpiresAt                  *time.Time `bun:"expires_at"`
+	LastUsedAt                 *time.Time `bun:"last_used_at"`
+	CreatedAt                  time.Time  `bun:"created_at,notnull"`
+	Name                       string     `bun:"name,notnull"`
+	TokenHash                  string     `bun:"token_hash,notnull"`
+	TokenPrefix                string     `bun:"token_prefix,notnull"`
+	HostsAccessLevel           string     `bun:"hosts_access_level,notnull"`
+	StreamsAccessLevel         string     `bun:"streams_access_level,notnull"`
+	CertificatesAccessLevel    string     `bun:"certificates_access_level,notnull"`
+	LogsAccessLevel            string     `bun:"logs_access_level,notnull"`
+	IntegrationsAccessLevel    string     `bun:"integrations_access_level,notnull"`
+	AccessListsAccessLevel     string     `bun:"access_lists_access_level,notnull"`
+	SettingsAccessLevel        string     `bun:"settings_access_level,notnull"`
+	UsersAccessLevel           string     `bun:"users_access_level,notnull"`
+	NginxServerAccessLevel     string     `bun:"nginx_server_access_level,notnull"`
+	ExportDataAccessLevel      string     `bun:"export_data_access_level,notnull"`
+	VPNsAccessLevel            string     `bun:"vpns_access_level,notnull"`
+	CachesAccessLevel          string     `bun:"caches_access_level,notnull"`
+	UpstreamsAccessLevel       string     `bun:"upstreams_access_level,notnull"`
+	TLSProfilesAccessLevel     string     `bun:"tls_profiles_access_level,notnull"`
+	RateLimitsAccessLevel      string     `bun:"rate_limits_access_level,notnull"`
+	SecurityHeadersAccessLevel string     `bun:"security_headers_access_level,notnull"`
+	TrafficStatsAccessLevel    string     `bun:"traffic_stats_access_level,notnull"`
+	AuditAccessLevel           string     `bun:"audit_access_level,notnull"`
+	AllowedSourceAddresses     []string   `bun:"allowed_source_addresses,array,notnull"`
+	ID                         uuid.UUID  `bun:"id,pk"`
+	UserID                     uuid.UUID  `bun:"user_id,notnull"`
+}
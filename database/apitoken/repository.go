@@ -0,0 +1,132 @@
+package apitoken
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/database/common/constants"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+)
+
+type repository struct {
+	database *database.Database
+}
+
+func New(db *database.Database) apitoken.Repository {
+	return &repository{
+		database: db,
+	}
+}
+
+func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*apitoken.APIToken, error) {
+	return r.findOne(ctx, constants.ByIDFilter, id)
+}
+
+func (r *repository) FindByTokenHash(
+	ctx context.Context,
+	tokenHash string,
+) (*apitoken.APIToken, error) {
+	return r.findOne(ctx, "token_hash = ?", tokenHash)
+}
+
+func (r *repository) FindByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]apitoken.APIToken, error) {
+	models := make([]apiTokenModel, 0)
+
+	err := r.database.Select(ctx).
+		Model(&models).
+		Where("user_id = ?", userID).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]apitoken.APIToken, len(models))
+	for index, model := range models {
+		result[index] = toDomain(&model)
+	}
+
+	return result, nil
+}
+
+func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	_, err := r.database.Delete(ctx).
+		Model((*apiTokenModel)(nil)).
+		Where(constants.ByIDFilter, id).
+		Exec(ctx)
+	return err
+}
+
+func (r *repository) UpdateLastUsedAt(
+	ctx context.Context,
+	id uuid.UUID,
+	lastUsedAt time.Time,
+) error {
+	_, err := r.database.Update(ctx).
+		Model((*apiTokenModel)(nil)).
+		Set("last_used_at = ?", lastUsedAt).
+		Where(constants.ByIDFilter, id).
+		Exec(ctx)
+	return err
+}
+
+func (r *repository) Save(ctx context.Context, token *apitoken.APIToken) error {
+	transaction, err := r.database.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer transaction.Rollback()
+
+	exists, err := transaction.NewSelect().
+		Model((*apiTokenModel)(nil)).
+		Where(constants.ByIDFilter, token.ID).
+		Exists(ctx)
+	if err != nil {
+		return err
+	}
+
+	model := toModel(token)
+	if exists {
+		_, err = transaction.NewUpdate().
+			Model(&model).
+			Where(constants.ByIDFilter, token.ID).
+			Exec(ctx)
+	} else {
+		_, err = transaction.NewInsert().Model(&model).Exec(ctx)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+func (r *repository) findOne(
+	ctx context.Context,
+	filter string,
+	value any,
+) (*apitoken.APIToken, error) {
+	var model apiTokenModel
+
+	err := r.database.Select(ctx).Model(&model).Where(filter, value).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return new(toDomain(&model)), nil
+}
@@ -0,0 +1,131 @@
+package apitoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	"dillmann.com.br/nginx-ignition/database/common/testutils"
+	userrepository "dillmann.com.br/nginx-ignition/database/user"
+)
+
+func Test_Repository(t *testing.T) {
+	testutils.RunWithMockedDatabases(t, runRepositoryTests)
+}
+
+func runRepositoryTests(t *testing.T, db *database.Database) {
+	repo := New(db)
+
+	owner, err := newOwner(t.Context(), db)
+	require.NoError(t, err)
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("successfully saves a new token", func(t *testing.T) {
+			token := newAPIToken(owner.ID)
+
+			require.NoError(t, repo.Save(t.Context(), token))
+
+			saved, err := repo.FindByID(t.Context(), token.ID)
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, token.Name, saved.Name)
+			assert.Equal(t, token.UserID, saved.UserID)
+			assert.Equal(t, token.TokenHash, saved.TokenHash)
+			assert.Equal(t, token.TokenPrefix, saved.TokenPrefix)
+			assert.Equal(t, token.Permissions, saved.Permissions)
+			assert.Equal(t, token.AllowedSourceAddresses, saved.AllowedSourceAddresses)
+			assert.True(t, token.ExpiresAt.Equal(*saved.ExpiresAt))
+			assert.Nil(t, saved.LastUsedAt)
+		})
+	})
+
+	t.Run("FindByTokenHash", func(t *testing.T) {
+		t.Run("returns the token with the given hash", func(t *testing.T) {
+			token := newAPIToken(owner.ID)
+			require.NoError(t, repo.Save(t.Context(), token))
+
+			saved, err := repo.FindByTokenHash(t.Context(), token.TokenHash)
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, token.ID, saved.ID)
+		})
+
+		t.Run("returns nil if not found", func(t *testing.T) {
+			saved, err := repo.FindByTokenHash(t.Context(), "nonexistent")
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+	})
+
+	t.Run("FindByUserID", func(t *testing.T) {
+		t.Run("returns only the tokens of the user", func(t *testing.T) {
+			otherOwner, err := newOwner(t.Context(), db)
+			require.NoError(t, err)
+
+			first := newAPIToken(otherOwner.ID)
+			first.Name = "First"
+			second := newAPIToken(otherOwner.ID)
+			second.Name = "Second"
+			second.AllowedSourceAddresses = nil
+			require.NoError(t, repo.Save(t.Context(), second))
+			require.NoError(t, repo.Save(t.Context(), first))
+			require.NoError(t, repo.Save(t.Context(), newAPIToken(owner.ID)))
+
+			tokens, err := repo.FindByUserID(t.Context(), otherOwner.ID)
+			require.NoError(t, err)
+			require.Len(t, tokens, 2)
+			assert.Equal(t, first.ID, tokens[0].ID)
+			assert.Equal(t, second.ID, tokens[1].ID)
+			assert.Empty(t, tokens[1].AllowedSourceAddresses)
+		})
+	})
+
+	t.Run("UpdateLastUsedAt", func(t *testing.T) {
+		t.Run("records the last usage", func(t *testing.T) {
+			token := newAPIToken(owner.ID)
+			require.NoError(t, repo.Save(t.Context(), token))
+
+			lastUsedAt := time.Now().UTC().Truncate(time.Second)
+			require.NoError(t, repo.UpdateLastUsedAt(t.Context(), token.ID, lastUsedAt))
+
+			saved, err := repo.FindByID(t.Context(), token.ID)
+			require.NoError(t, err)
+			require.NotNil(t, saved.LastUsedAt)
+			assert.True(t, lastUsedAt.Equal(*saved.LastUsedAt))
+		})
+	})
+
+	t.Run("DeleteByID", func(t *testing.T) {
+		t.Run("removes the token", func(t *testing.T) {
+			token := newAPIToken(owner.ID)
+			require.NoError(t, repo.Save(t.Context(), token))
+
+			require.NoError(t, repo.DeleteByID(t.Context(), token.ID))
+
+			saved, err := repo.FindByID(t.Context(), token.ID)
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+
+		t.Run("is removed alongside its owner", func(t *testing.T) {
+			otherOwner, err := newOwner(t.Context(), db)
+			require.NoError(t, err)
+
+			token := newAPIToken(otherOwner.ID)
+			require.NoError(t, repo.Save(t.Context(), token))
+			require.NoError(t, userrepository.New(db).DeleteByID(t.Context(), otherOwner.ID))
+
+			saved, err := repo.FindByID(t.Context(), token.ID)
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+
+		t.Run("ignores unknown IDs", func(t *testing.T) {
+			assert.NoError(t, repo.DeleteByID(t.Context(), uuid.New()))
+		})
+	})
+}
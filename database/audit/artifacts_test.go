@@ -0,0 +1,23 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/audit"
+)
+
+func newEvent() *audit.Event {
+	return &audit.Event{
+		ID:         uuid.New(),
+		CreatedAt:  time.Now().UTC().Truncate(time.Second),
+		UserID:     new(uuid.New()),
+		SourceIP:   new("127.0.0.1"),
+		EntityType: audit.HostEntityType,
+		EntityID:   new(uuid.New()),
+		Action:     audit.UpdateAction,
+		Before:     new(`{"Enabled":false}`),
+		After:      new(`{"Enabled":true}`),
+	}
+}
@@ -0,0 +1,33 @@
+package audit
+
+import (
+	"dillmann.com.br/nginx-ignition/core/audit"
+)
+
+func toDomain(model *eventModel) audit.Event {
+	return audit.Event{
+		ID:         model.ID,
+		CreatedAt:  model.CreatedAt,
+		UserID:     model.UserID,
+		SourceIP:   model.SourceIP,
+		EntityType: audit.EntityType(model.EntityType),
+		EntityID:   model.EntityID,
+		Action:     audit.Action(model.Action),
+		Before:     model.BeforeState,
+		After:      model.AfterState,
+	}
+}
+
+func toModel(domain *audit.Event) *eventModel {
+	return &eventModel{
+		ID:          domain.ID,
+		CreatedAt:   domain.CreatedAt,
+		UserID:      domain.UserID,
+		SourceIP:    domain.SourceIP,
+		EntityType:  string(domain.EntityType),
+		EntityID:    domain.EntityID,
+		Action:      string(domain.Action),
+		BeforeState: domain.Before,
+		AfterState:  domain.After,
+	}
+}
@@ -0,0 +1,22 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type eventModel struct {
+	bun.BaseModel `bun:"audit_event"`
+
+	CreatedAt   time.Time  `bun:"created_at"`
+	UserID      *uuid.UUID `bun:"user_id"`
+	SourceIP    *string    `bun:"source_ip"`
+	EntityID    *uuid.UUID `bun:"entity_id"`
+	BeforeState *string    `bun:"before_state"`
+	AfterState  *string    `bun:"after_state"`
+	EntityType  string     `bun:"entity_type"`
+	Action      string     `bun:"action"`
+	ID          uuid.UUID  `bun:"id,pk"`
+}
@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+
+	"dillmann.com.br/nginx-ignition/core/audit"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+)
+
+type repository struct {
+	database *database.Database
+}
+
+func New(db *database.Database) audit.Repository {
+	return &repository{
+		database: db,
+	}
+}
+
+func (r *repository) Save(ctx context.Context, event *audit.Event) error {
+	_, err := r.database.Insert(ctx).Model(toModel(event)).Exec(ctx)
+	return err
+}
+
+func (r *repository) FindPage(
+	ctx context.Context,
+	pageNumber, pageSize int,
+	filters *audit.Filters,
+) (*pagination.Page[audit.Event], error) {
+	models := make([]eventModel, 0)
+
+	query := r.database.Select(ctx).Model(&models)
+	if filters != nil {
+		query = applyFilters(query, filters)
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = query.
+		Limit(pageSize).
+		Offset(pageSize * pageNumber).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]audit.Event, len(models))
+	for index, model := range models {
+		result[index] = toDomain(&model)
+	}
+
+	return pagination.New(pageNumber, pageSize, count, result), nil
+}
+
+func applyFilters(query *bun.SelectQuery, filters *audit.Filters) *bun.SelectQuery {
+	if filters.UserID != nil {
+		query = query.Where("user_id = ?", *filters.UserID)
+	}
+
+	if filters.EntityType != nil {
+		query = query.Where("entity_type = ?", string(*filters.EntityType))
+	}
+
+	if filters.EntityID != nil {
+		query = query.Where("entity_id = ?", *filters.EntityID)
+	}
+
+	if filters.Action != nil {
+		query = query.Where("action = ?", string(*filters.Action))
+	}
+
+	if filters.From != nil {
+		query = query.Where("created_at >= ?", *filters.From)
+	}
+
+	if filters.To != nil {
+		query = query.Where("created_at <= ?", *filters.To)
+	}
+
+	return query
+}
@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/audit"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	"dillmann.com.br/nginx-ignition/database/common/testutils"
+)
+
+func Test_Repository(t *testing.T) {
+	testutils.RunWithMockedDatabases(t, runRepositoryTests)
+}
+
+func runRepositoryTests(t *testing.T, db *database.Database) {
+	repo := New(db)
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("successfully saves a new event", func(t *testing.T) {
+			event := newEvent()
+
+			err := repo.Save(t.Context(), event)
+			require.NoError(t, err)
+
+			page, err := repo.FindPage(t.Context(), 0, 10, &audit.Filters{
+				EntityID: event.EntityID,
+			})
+			require.NoError(t, err)
+			require.Len(t, page.Contents, 1)
+
+			saved := page.Contents[0]
+			assert.Equal(t, event.ID, saved.ID)
+			assert.Equal(t, event.UserID, saved.UserID)
+			assert.Equal(t, event.SourceIP, saved.SourceIP)
+			assert.Equal(t, event.EntityType, saved.EntityType)
+			assert.Equal(t, event.Action, saved.Action)
+			assert.Equal(t, event.Before, saved.Before)
+			assert.Equal(t, event.After, saved.After)
+			assert.True(t, event.CreatedAt.Equal(saved.CreatedAt))
+		})
+	})
+
+	t.Run("FindPage", func(t *testing.T) {
+		t.Run("filters the events", func(t *testing.T) {
+			deletion := newEvent()
+			deletion.Action = audit.DeleteAction
+			deletion.EntityType = audit.StreamEntityType
+			deletion.After = nil
+			require.NoError(t, repo.Save(t.Context(), deletion))
+
+			page, err := repo.FindPage(t.Context(), 0, 10, &audit.Filters{
+				UserID:     deletion.UserID,
+				EntityType: new(audit.StreamEntityType),
+				Action:     new(audit.DeleteAction),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 1, page.TotalItems)
+			assert.Equal(t, deletion.ID, page.Contents[0].ID)
+			assert.Nil(t, page.Contents[0].After)
+		})
+
+		t.Run("filters the events by period", func(t *testing.T) {
+			old := newEvent()
+			old.CreatedAt = old.CreatedAt.Add(-48 * time.Hour)
+			require.NoError(t, repo.Save(t.Context(), old))
+
+			page, err := repo.FindPage(t.Context(), 0, 10, &audit.Filters{
+				From: new(old.CreatedAt.Add(-time.Hour)),
+				To:   new(old.CreatedAt.Add(time.Hour)),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 1, page.TotalItems)
+			assert.Equal(t, old.ID, page.Contents[0].ID)
+		})
+
+		t.Run("returns the newest events first", func(t *testing.T) {
+			newest := newEvent()
+			newest.CreatedAt = newest.CreatedAt.Add(time.Hour)
+			require.NoError(t, repo.Save(t.Context(), newest))
+
+			page, err := repo.FindPage(t.Context(), 0, 10, nil)
+			require.NoError(t, err)
+			assert.Equal(t, 4, page.TotalItems)
+			assert.Equal(t, newest.ID, page.Contents[0].ID)
+		})
+	})
+}
@@ -0,0 +1,107 @@
+package backup
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/apitoken"
+	"dillmann.com.br/nginx-ignition/core/backup"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/session"
+	"dillmann.com.br/nginx-ignition/core/settings"
+	"dillmann.com.br/nginx-ignition/core/user"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	userrepository "dillmann.com.br/nginx-ignition/database/user"
+)
+
+func newRun(startedAt time.Time, status backup.RunStatus) *backup.Run {
+	startedAt = startedAt.UTC().Truncate(time.Second)
+
+	return &backup.Run{
+		ID:           uuid.New(),
+		StartedAt:    startedAt,
+		FinishedAt:   startedAt.Add(time.Second),
+		Status:       status,
+		Destination:  settings.LocalBackupDestination,
+		FileName:     new("nginx-ignition-backup-20261018T120000Z.db"),
+		SizeBytes:    1024,
+		ErrorMessage: nil,
+	}
+}
+
+func newDatabaseConfiguration(db *database.Database) *configuration.Configuration {
+	driver, dataPath := "postgres", ""
+	if filePath, ok := strings.CutPrefix(db.ConnectionString(), "file:"); ok {
+		driver, dataPath = "sqlite", filepath.Dir(filePath)
+	}
+
+	return configuration.NewWithOverrides(map[string]string{
+		"nginx-ignition.database.driver":    driver,
+		"nginx-ignition.database.data-path": dataPath,
+	})
+}
+
+func newAPIToken(userID uuid.UUID) *apitoken.APIToken {
+	return &apitoken.APIToken{
+		ID:                     uuid.New(),
+		UserID:                 userID,
+		Name:                   "CI pipeline",
+		TokenHash:              uuid.New().String(),
+		TokenPrefix:            "nig_abcdefgh",
+		CreatedAt:              time.Now().UTC().Truncate(time.Second),
+		AllowedSourceAddresses: []string{"10.0.0.0/8"},
+		Permissions:            newPermissions(user.ReadOnlyAccessLevel),
+	}
+}
+
+func newSession(userID uuid.UUID) *session.Session {
+	return &session.Session{
+		ID:            uuid.New(),
+		UserID:        userID,
+		UserAgent:     "Mozilla/5.0",
+		SourceAddress: "192.168.0.10",
+		CreatedAt:     time.Now().UTC().Truncate(time.Second),
+		ExpiresAt:     time.Now().UTC().Add(time.Hour).Truncate(time.Second),
+	}
+}
+
+func newOwner(ctx context.Context, db *database.Database) (*user.User, error) {
+	owner := &user.User{
+		ID:           uuid.New(),
+		Name:         "Backup Owner",
+		Username:     "owner-" + uuid.New().String(),
+		PasswordHash: "hash",
+		PasswordSalt: "salt",
+		Enabled:      true,
+		Permissions:  newPermissions(user.ReadWriteAccessLevel),
+	}
+
+	return owner, userrepository.New(db).Save(ctx, owner)
+}
+
+func newPermissions(level user.AccessLevel) user.Permissions {
+	return user.Permissions{
+		Hosts:           level,
+		Streams:         level,
+		Certificates:    level,
+		Logs:            level,
+		Integrations:    level,
+		AccessLists:     level,
+		Settings:        level,
+		Users:           level,
+		NginxServer:     level,
+		ExportData:      level,
+		VPNs:            level,
+		Caches:          level,
+		Upstreams:       level,
+		TLSProfiles:     level,
+		RateLimits:      level,
+		SecurityHeaders: level,
+		TrafficStats:    level,
+		Audit:           level,
+	}
+}
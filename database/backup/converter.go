@@ -0,0 +1,32 @@
+package backup
+
+import (
+	"dillmann.com.br/nginx-ignition/core/backup"
+	"dillmann.com.br/nginx-ignition/core/settings"
+)
+
+func toRunDomain(model *runModel) backup.Run {
+	return backup.Run{
+		ID:           model.ID,
+		StartedAt:    model.StartedAt,
+		FinishedAt:   model.FinishedAt,
+		Status:       backup.RunStatus(model.Status),
+		Destination:  settings.BackupDestination(model.Destination),
+		FileName:     model.FileName,
+		SizeBytes:    model.SizeBytes,
+		ErrorMessage: model.ErrorMessage,
+	}
+}
+
+func toRunModel(domain *backup.Run) *runModel {
+	return &runModel{
+		ID:           domain.ID,
+		StartedAt:    domain.StartedAt,
+		FinishedAt:   domain.FinishedAt,
+		Status:       string(domain.Status),
+		Destination:  string(domain.Destination),
+		FileName:     domain.FileName,
+		SizeBytes:    domain.SizeBytes,
+		ErrorMessage: domain.ErrorMessage,
+	}
+}
@@ -0,0 +1,21 @@
+package backup
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type runModel struct {
+	bun.BaseModel `bun:"backup_run"`
+
+	StartedAt    time.Time `bun:"started_at"`
+	FinishedAt   time.Time `bun:"finished_at"`
+	FileName     *string   `bun:"file_name"`
+	ErrorMessage *string   `bun:"error_message"`
+	Status       string    `bun:"status"`
+	Destination  string    `bun:"destination"`
+	SizeBytes    int64     `bun:"size_bytes"`
+	ID           uuid.UUID `bun:"id,pk"`
+}
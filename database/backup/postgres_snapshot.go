@@ -0,0 +1,308 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	postgresDumpHeader     = "-- nginx ignition PostgreSQL backup"
+	postgresCopyTerminator = "\\."
+	postgresNullValue      = "\\N"
+	postgresValueSeparator = "\t"
+)
+
+var postgresCopyPattern = regexp.MustCompile(`^COPY (\S+) \((.*)\) FROM stdin;$`)
+
+// The values are written using the text format of the COPY command, where NULL is written as \N
+// and the backslashes, tabs and line breaks inside the values are escaped
+func (r *repository) writePostgresSnapshot(ctx context.Context) ([]byte, error) {
+	tables, err := r.findTables(ctx, "postgres")
+	if err != nil {
+		return nil, err
+	}
+
+	tables = append([]string{schemaVersionTable}, tables...)
+
+	columns, err := r.findPostgresColumns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quotedTables := make([]string, len(tables))
+	for index, table := range tables {
+		quotedTables[index] = quotePostgresIdentifier(table)
+	}
+
+	var output bytes.Buffer
+	output.WriteString(postgresDumpHeader + "\n")
+	output.WriteString(
+		"-- Restore it using psql into a database initialized by the same nginx ignition version\n\n",
+	)
+	output.WriteString("BEGIN;\n\n")
+	output.WriteString("TRUNCATE TABLE " + strings.Join(quotedTables, ", ") + ";\n\n")
+
+	for index, table := range tables {
+		quotedColumns := make([]string, len(columns[table]))
+		for columnIndex, column := range columns[table] {
+			quotedColumns[columnIndex] = quotePostgresIdentifier(column)
+		}
+
+		fmt.Fprintf(
+			&output,
+			"COPY %s (%s) FROM stdin;\n",
+			quotedTables[index],
+			strings.Join(quotedColumns, ", "),
+		)
+
+		if err = r.writePostgresRows(ctx, &output, table, columns[table]); err != nil {
+			return nil, err
+		}
+
+		output.WriteString(postgresCopyTerminator + "\n\n")
+	}
+
+	output.WriteString("COMMIT;\n")
+	return output.Bytes(), nil
+}
+
+func (r *repository) writePostgresRows(
+	ctx context.Context,
+	output *bytes.Buffer,
+	table string,
+	columns []string,
+) error {
+	selectedColumns := make([]string, len(columns))
+	for index, column := range columns {
+		selectedColumns[index] = quotePostgresIdentifier(column) + "::text"
+	}
+
+	//nolint:gosec // G202: table and column names are read from the database schema itself
+	query := "SELECT " + strings.Join(
+		selectedColumns,
+		", ",
+	) + " FROM " + quotePostgresIdentifier(
+		table,
+	)
+
+	rows, err := r.db.Unwrap().QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer rows.Close()
+
+	values := make([]sql.NullString, len(columns))
+	pointers := make([]any, len(columns))
+	for index := range values {
+		pointers[index] = &values[index]
+	}
+
+	for rows.Next() {
+		if err = rows.Scan(pointers...); err != nil {
+			return err
+		}
+
+		for index, value := range values {
+			if index > 0 {
+				output.WriteString(postgresValueSeparator)
+			}
+
+			if value.Valid {
+				output.WriteString(escapePostgresValue(value.String))
+			} else {
+				output.WriteString(postgresNullValue)
+			}
+		}
+
+		output.WriteString("\n")
+	}
+
+	return rows.Err()
+}
+
+func (r *repository) findPostgresColumns(ctx context.Context) (map[string][]string, error) {
+	columns, err := r.queryRows(
+		ctx,
+		"SELECT table_name, column_name FROM information_schema.columns "+
+			"WHERE table_schema = current_schema() ORDER BY table_name, ordinal_position",
+		2,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string][]string)
+	for _, column := range columns {
+		output[column[0]] = append(output[column[0]], column[1])
+	}
+
+	return output, nil
+}
+
+func readPostgresSnapshot(ctx context.Context, contents []byte) (*snapshot, error) {
+	if !bytes.HasPrefix(contents, []byte(postgresDumpHeader)) {
+		return nil, invalidFileError(ctx, "postgres")
+	}
+
+	output := &snapshot{
+		tables:  make(map[string]*snapshotTable),
+		version: -1,
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), len(contents)+1)
+
+	for scanner.Scan() {
+		match := postgresCopyPattern.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+
+		table := unquotePostgresIdentifier(match[1])
+		columns := strings.Split(match[2], ", ")
+		for index, column := range columns {
+			columns[index] = unquotePostgresIdentifier(column)
+		}
+
+		rows, ok := readPostgresCopyRows(scanner, len(columns))
+		if !ok {
+			return nil, invalidFileError(ctx, "postgres")
+		}
+
+		if table == schemaVersionTable {
+			version, ok := readPostgresSchemaVersion(columns, rows)
+			if !ok {
+				return nil, invalidFileError(ctx, "postgres")
+			}
+
+			output.version = version
+			continue
+		}
+
+		output.tables[table] = &snapshotTable{
+			columns: columns,
+			rows:    rows,
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if output.version < 0 {
+		return nil, invalidFileError(ctx, "postgres")
+	}
+
+	return output, nil
+}
+
+func readPostgresCopyRows(scanner *bufio.Scanner, columnsCount int) ([][]any, bool) {
+	rows := make([][]any, 0)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == postgresCopyTerminator {
+			return rows, true
+		}
+
+		fields := strings.Split(line, postgresValueSeparator)
+		if len(fields) != columnsCount {
+			return nil, false
+		}
+
+		values := make([]any, len(fields))
+		for index, field := range fields {
+			if field != postgresNullValue {
+				values[index] = unescapePostgresValue(field)
+			}
+		}
+
+		rows = append(rows, values)
+	}
+
+	return nil, false
+}
+
+func readPostgresSchemaVersion(columns []string, rows [][]any) (int, bool) {
+	index := -1
+	for columnIndex, column := range columns {
+		if column == "version" {
+			index = columnIndex
+		}
+	}
+
+	if index < 0 || len(rows) != 1 {
+		return 0, false
+	}
+
+	value, ok := rows[0][index].(string)
+	if !ok {
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(value)
+	return version, err == nil
+}
+
+var postgresValueEscaper = strings.NewReplacer(
+	"\\", "\\\\",
+	"\t", "\\t",
+	"\n", "\\n",
+	"\r", "\\r",
+)
+
+func escapePostgresValue(value string) string {
+	return postgresValueEscaper.Replace(value)
+}
+
+func unescapePostgresValue(value string) string {
+	if !strings.Contains(value, "\\") {
+		return value
+	}
+
+	var output strings.Builder
+	for index := 0; index < len(value); index++ {
+		if value[index] != '\\' || index == len(value)-1 {
+			output.WriteByte(value[index])
+			continue
+		}
+
+		index++
+		switch value[index] {
+		case 't':
+			output.WriteByte('\t')
+		case 'n':
+			output.WriteByte('\n')
+		case 'r':
+			output.WriteByte('\r')
+		case 'b':
+			output.WriteByte('\b')
+		case 'f':
+			output.WriteByte('\f')
+		case 'v':
+			output.WriteByte('\v')
+		default:
+			output.WriteByte(value[index])
+		}
+	}
+
+	return output.String()
+}
+
+func quotePostgresIdentifier(value string) string {
+	return "\"" + strings.ReplaceAll(value, "\"", "\"\"") + "\""
+}
+
+func unquotePostgresIdentifier(value string) string {
+	value = strings.TrimPrefix(value, "\"")
+	value = strings.TrimSuffix(value, "\"")
+	return strings.ReplaceAll(value, "\"\"", "\"")
+}
@@ -0,0 +1,116 @@
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_readPostgresSnapshot(t *testing.T) {
+	t.Run("reads the tables and the schema version of the dump", func(t *testing.T) {
+		contents := postgresDumpHeader + "\n\n" +
+			"BEGIN;\n\n" +
+			"TRUNCATE TABLE \"schema_version\", \"user\", \"certificate\";\n\n" +
+			"COPY \"schema_version\" (\"version\", \"dirty\") FROM stdin;\n" +
+			"34\tfalse\n" +
+			"\\.\n\n" +
+			"COPY \"user\" (\"id\", \"username\", \"name\") FROM stdin;\n" +
+			"1\tadmin\t\\N\n" +
+			"\\.\n\n" +
+			"COPY \"certificate\" (\"id\", \"private_key\", \"metadata\") FROM stdin;\n" +
+			"2\t-----BEGIN KEY-----\\nabc\\n-----END KEY-----\t\\N\n" +
+			"\\.\n\n" +
+			"COMMIT;\n"
+
+		result, err := readPostgresSnapshot(t.Context(), []byte(contents))
+		require.NoError(t, err)
+
+		assert.Equal(t, 34, result.version)
+		assert.NotContains(t, result.tables, schemaVersionTable)
+
+		users := result.tables["user"]
+		require.NotNil(t, users)
+		assert.Equal(t, []string{"id", "username", "name"}, users.columns)
+		assert.Equal(t, [][]any{{"1", "admin", nil}}, users.rows)
+
+		certificates := result.tables["certificate"]
+		require.NotNil(t, certificates)
+		assert.Equal(
+			t,
+			[][]any{{"2", "-----BEGIN KEY-----\nabc\n-----END KEY-----", nil}},
+			certificates.rows,
+		)
+	})
+
+	t.Run("keeps the empty values apart from the null ones", func(t *testing.T) {
+		contents := postgresDumpHeader + "\n" +
+			"COPY \"schema_version\" (\"version\", \"dirty\") FROM stdin;\n" +
+			"34\tfalse\n" +
+			"\\.\n" +
+			"COPY \"user\" (\"id\", \"username\", \"name\") FROM stdin;\n" +
+			"1\t\t\\N\n" +
+			"\\.\n"
+
+		result, err := readPostgresSnapshot(t.Context(), []byte(contents))
+		require.NoError(t, err)
+		assert.Equal(t, [][]any{{"1", "", nil}}, result.tables["user"].rows)
+	})
+
+	t.Run("reads the escaped tabs and backslashes of the values", func(t *testing.T) {
+		value := "first\tsecond\\third\r\n"
+		contents := postgresDumpHeader + "\n" +
+			"COPY \"schema_version\" (\"version\", \"dirty\") FROM stdin;\n" +
+			"34\tfalse\n" +
+			"\\.\n" +
+			"COPY \"host\" (\"id\", \"name\") FROM stdin;\n" +
+			"1\t" + escapePostgresValue(value) + "\n" +
+			"\\.\n"
+
+		result, err := readPostgresSnapshot(t.Context(), []byte(contents))
+		require.NoError(t, err)
+		assert.Equal(t, [][]any{{"1", value}}, result.tables["host"].rows)
+	})
+
+	t.Run("returns error when the file was not generated by nginx ignition", func(t *testing.T) {
+		contents := "COPY schema_version (version, dirty) FROM stdin;\n" +
+			"34\tfalse\n" +
+			"\\.\n"
+
+		result, err := readPostgresSnapshot(t.Context(), []byte(contents))
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("returns error when the schema version is missing", func(t *testing.T) {
+		contents := postgresDumpHeader + "\n" +
+			"COPY \"user\" (\"id\", \"username\", \"name\") FROM stdin;\n" +
+			"1\tadmin\tAdmin\n" +
+			"\\.\n"
+
+		result, err := readPostgresSnapshot(t.Context(), []byte(contents))
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("returns error when a row has a different amount of values", func(t *testing.T) {
+		contents := postgresDumpHeader + "\n" +
+			"COPY \"schema_version\" (\"version\", \"dirty\") FROM stdin;\n" +
+			"34\tfalse\textra\n" +
+			"\\.\n"
+
+		result, err := readPostgresSnapshot(t.Context(), []byte(contents))
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("returns error when the data block is not terminated", func(t *testing.T) {
+		contents := postgresDumpHeader + "\n" +
+			"COPY \"schema_version\" (\"version\", \"dirty\") FROM stdin;\n" +
+			"34\tfalse\n"
+
+		result, err := readPostgresSnapshot(t.Context(), []byte(contents))
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
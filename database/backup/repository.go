@@ -2,17 +2,24 @@ package backup
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 
-	"github.com/JCoupalK/go-pgdump"
-
 	"dillmann.com.br/nginx-ignition/core/backup"
 	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
 	"dillmann.com.br/nginx-ignition/database/common/database"
 )
 
+const (
+	newestFirstOrdering = "started_at DESC"
+	byStatusFilter      = "status = ?"
+	notInSubqueryFilter = "id NOT IN (?)"
+)
+
 type repository struct {
 	db     *database.Database
 	config *configuration.Configuration
@@ -28,7 +35,7 @@ func New(
 	}
 }
 
-func (r *repository) Get(_ context.Context) (*backup.Backup, error) {
+func (r *repository) Get(ctx context.Context) (*backup.Backup, error) {
 	driver, err := r.config.Get("driver")
 	if err != nil {
 		return nil, err
@@ -38,7 +45,7 @@ func (r *repository) Get(_ context.Context) (*backup.Backup, error) {
 	case "sqlite":
 		return r.getSqliteBackup()
 	case "postgres":
-		return r.getPostgresBackup()
+		return r.getPostgresBackup(ctx)
 	default:
 		return nil, fmt.Errorf("unsupported database driver: %s", driver)
 	}
@@ -66,31 +73,85 @@ func (r *repository) getSqliteBackup() (*backup.Backup, error) {
 	}, nil
 }
 
-func (r *repository) getPostgresBackup() (*backup.Backup, error) {
-	tempFile, err := os.CreateTemp(os.TempDir(), "nginx-ignition.sql")
+func (r *repository) getPostgresBackup(ctx context.Context) (*backup.Backup, error) {
+	contents, err := r.writePostgresSnapshot(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	//nolint:errcheck
-	defer os.Remove(tempFile.Name())
-	//nolint:errcheck
-	defer tempFile.Close()
+	return &backup.Backup{
+		FileName:    "nginx-ignition.sql",
+		ContentType: "text/plain",
+		Contents:    contents,
+	}, nil
+}
 
-	dumper := pgdump.NewDumper(r.db.ConnectionString(), 1)
-	if err = dumper.DumpDatabase(tempFile.Name(), &pgdump.TableOptions{}); err != nil {
+func (r *repository) SaveRun(ctx context.Context, run *backup.Run) error {
+	_, err := r.db.Insert(ctx).Model(toRunModel(run)).Exec(ctx)
+	return err
+}
+
+func (r *repository) FindRunsPage(
+	ctx context.Context,
+	pageNumber, pageSize int,
+) (*pagination.Page[backup.Run], error) {
+	models := make([]runModel, 0)
+
+	query := r.db.Select(ctx).Model(&models)
+	count, err := query.Count(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	//nolint:gosec // G703: tempFile.Name() is generated by os.CreateTemp and is safe
-	contents, err := os.ReadFile(tempFile.Name())
+	err = query.
+		Limit(pageSize).
+		Offset(pageSize * pageNumber).
+		Order(newestFirstOrdering).
+		Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &backup.Backup{
-		FileName:    "nginx-ignition.sql",
-		ContentType: "text/plain",
-		Contents:    contents,
-	}, nil
+	result := make([]backup.Run, len(models))
+	for index, model := range models {
+		result[index] = toRunDomain(&model)
+	}
+
+	return pagination.New(pageNumber, pageSize, count, result), nil
+}
+
+func (r *repository) FindLatestSuccessfulRun(ctx context.Context) (*backup.Run, error) {
+	var model runModel
+
+	err := r.db.Select(ctx).
+		Model(&model).
+		Where(byStatusFilter, string(backup.SucceededRunStatus)).
+		Order(newestFirstOrdering).
+		Limit(1).
+		Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return new(toRunDomain(&model)), nil
+}
+
+func (r *repository) DeleteOldestRuns(ctx context.Context, amountToKeep int) error {
+	newestSubquery := r.db.Select(ctx).
+		Model((*runModel)(nil)).
+		Column("id").
+		Order(newestFirstOrdering).
+		Limit(amountToKeep)
+
+	_, err := r.db.Delete(ctx).
+		Model((*runModel)(nil)).
+		Where(notInSubqueryFilter, newestSubquery).
+		Exec(ctx)
+
+	return err
 }
@@ -0,0 +1,164 @@
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/backup"
+	"dillmann.com.br/nginx-ignition/core/common/configuration"
+	apitokenrepository "dillmann.com.br/nginx-ignition/database/apitoken"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	"dillmann.com.br/nginx-ignition/database/common/testutils"
+	sessionrepository "dillmann.com.br/nginx-ignition/database/session"
+)
+
+func Test_Repository(t *testing.T) {
+	testutils.RunWithMockedDatabases(t, runRepositoryTests)
+}
+
+func runRepositoryTests(t *testing.T, db *database.Database) {
+	repo := New(db, configuration.New())
+	now := time.Now()
+
+	t.Run("FindLatestSuccessfulRun", func(t *testing.T) {
+		t.Run("returns nil when there are no runs", func(t *testing.T) {
+			latest, err := repo.FindLatestSuccessfulRun(t.Context())
+			require.NoError(t, err)
+			assert.Nil(t, latest)
+		})
+
+		t.Run("ignores the failed runs", func(t *testing.T) {
+			succeeded := newRun(now.Add(-time.Hour), backup.SucceededRunStatus)
+			failed := newRun(now, backup.FailedRunStatus)
+			failed.FileName = nil
+			failed.ErrorMessage = new("connection refused")
+
+			require.NoError(t, repo.SaveRun(t.Context(), succeeded))
+			require.NoError(t, repo.SaveRun(t.Context(), failed))
+
+			latest, err := repo.FindLatestSuccessfulRun(t.Context())
+			require.NoError(t, err)
+			require.NotNil(t, latest)
+			assert.Equal(t, succeeded.ID, latest.ID)
+			assert.Equal(t, succeeded.FileName, latest.FileName)
+			assert.True(t, succeeded.StartedAt.Equal(latest.StartedAt))
+		})
+	})
+
+	t.Run("FindRunsPage", func(t *testing.T) {
+		t.Run("returns the runs with the newest first", func(t *testing.T) {
+			newest := newRun(now.Add(time.Hour), backup.FailedRunStatus)
+			newest.ErrorMessage = new("access denied")
+			require.NoError(t, repo.SaveRun(t.Context(), newest))
+
+			page, err := repo.FindRunsPage(t.Context(), 0, 10)
+			require.NoError(t, err)
+			assert.Equal(t, 3, page.TotalItems)
+			require.Len(t, page.Contents, 3)
+			assert.Equal(t, newest.ID, page.Contents[0].ID)
+			assert.Equal(t, newest.ErrorMessage, page.Contents[0].ErrorMessage)
+			assert.Equal(t, backup.FailedRunStatus, page.Contents[0].Status)
+		})
+	})
+
+	t.Run("DeleteOldestRuns", func(t *testing.T) {
+		t.Run("keeps only the newest runs", func(t *testing.T) {
+			require.NoError(t, repo.DeleteOldestRuns(t.Context(), 1))
+
+			page, err := repo.FindRunsPage(t.Context(), 0, 10)
+			require.NoError(t, err)
+			require.Len(t, page.Contents, 1)
+			assert.True(t, now.Add(time.Hour).UTC().Truncate(time.Second).Equal(
+				page.Contents[0].StartedAt,
+			))
+		})
+	})
+	t.Run("Restore", func(t *testing.T) {
+		restoreRepo := New(db, newDatabaseConfiguration(db))
+
+		t.Run("replaces the data with the backup contents", func(t *testing.T) {
+			kept := newRun(now.Add(2*time.Hour), backup.SucceededRunStatus)
+			require.NoError(t, restoreRepo.SaveRun(t.Context(), kept))
+
+			artifact, err := restoreRepo.Get(t.Context())
+			require.NoError(t, err)
+
+			discarded := newRun(now.Add(3*time.Hour), backup.SucceededRunStatus)
+			require.NoError(t, restoreRepo.SaveRun(t.Context(), discarded))
+
+			require.NoError(t, restoreRepo.Restore(t.Context(), artifact.Contents))
+
+			latest, err := restoreRepo.FindLatestSuccessfulRun(t.Context())
+			require.NoError(t, err)
+			require.NotNil(t, latest)
+			assert.Equal(t, kept.ID, latest.ID)
+			assert.Equal(t, kept.FileName, latest.FileName)
+		})
+
+		t.Run("keeps the login attempts of the running instance", func(t *testing.T) {
+			artifact, err := restoreRepo.Get(t.Context())
+			require.NoError(t, err)
+
+			_, err = db.Unwrap().Exec(
+				"INSERT INTO login_attempt (scope, identifier, failure_count, last_failure_at) " +
+					"VALUES ('USERNAME', 'admin', 3, CURRENT_TIMESTAMP)",
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, restoreRepo.Restore(t.Context(), artifact.Contents))
+
+			var count int
+			err = db.Unwrap().QueryRow("SELECT COUNT(*) FROM login_attempt").Scan(&count)
+			require.NoError(t, err)
+			assert.Equal(t, 1, count)
+		})
+
+		t.Run("restores the API tokens and revokes the sessions", func(t *testing.T) {
+			owner, err := newOwner(t.Context(), db)
+			require.NoError(t, err)
+
+			token := newAPIToken(owner.ID)
+			require.NoError(t, apitokenrepository.New(db).Save(t.Context(), token))
+
+			artifact, err := restoreRepo.Get(t.Context())
+			require.NoError(t, err)
+
+			require.NoError(t, apitokenrepository.New(db).DeleteByID(t.Context(), token.ID))
+			userSession := newSession(owner.ID)
+			require.NoError(t, sessionrepository.New(db).Save(t.Context(), userSession))
+
+			require.NoError(t, restoreRepo.Restore(t.Context(), artifact.Contents))
+
+			restoredToken, err := apitokenrepository.New(db).FindByID(t.Context(), token.ID)
+			require.NoError(t, err)
+			require.NotNil(t, restoredToken)
+			assert.Equal(t, token.TokenHash, restoredToken.TokenHash)
+
+			revokedSession, err := sessionrepository.New(db).FindByID(t.Context(), userSession.ID)
+			require.NoError(t, err)
+			assert.Nil(t, revokedSession)
+		})
+
+		t.Run("rejects backups with a different schema version", func(t *testing.T) {
+			artifact, err := restoreRepo.Get(t.Context())
+			require.NoError(t, err)
+
+			_, err = db.Unwrap().Exec("UPDATE schema_version SET version = version + 1")
+			require.NoError(t, err)
+
+			err = restoreRepo.Restore(t.Context(), artifact.Contents)
+			assert.Error(t, err)
+
+			_, err = db.Unwrap().Exec("UPDATE schema_version SET version = version - 1")
+			require.NoError(t, err)
+		})
+
+		t.Run("rejects files that are not a backup", func(t *testing.T) {
+			err := restoreRepo.Restore(t.Context(), []byte("not a backup"))
+			assert.Error(t, err)
+		})
+	})
+}
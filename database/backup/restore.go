@@ -0,0 +1,257 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/uptrace/bun"
+
+	"dillmann.com.br/nginx-ignition/core/common/coreerror"
+	"dillmann.com.br/nginx-ignition/core/common/i18n"
+	"dillmann.com.br/nginx-ignition/core/common/log"
+)
+
+const (
+	schemaVersionTable = "schema_version"
+)
+
+var (
+	// Tables left untouched by the restore, keeping the history of the running instance
+	preservedTables = []string{"audit_event", "login_attempt"}
+
+	// Tables emptied by the restore without bringing back the contents of the backup, so the
+	// sessions revoked after the backup was generated can't be used again. The API tokens are
+	// restored since, unlike the sessions, they can't be recreated by simply logging in again.
+	revokedTables = []string{"user_session"}
+)
+
+type snapshot struct {
+	tables  map[string]*snapshotTable
+	version int
+}
+
+type snapshotTable struct {
+	columns []string
+	rows    [][]any
+}
+
+func (r *repository) Restore(ctx context.Context, contents []byte) error {
+	driver, err := r.config.Get("driver")
+	if err != nil {
+		return err
+	}
+
+	tables, err := r.findTables(ctx, driver)
+	if err != nil {
+		return err
+	}
+
+	tables = slices.DeleteFunc(tables, func(table string) bool {
+		return slices.Contains(preservedTables, table)
+	})
+
+	restoredTables := slices.DeleteFunc(slices.Clone(tables), func(table string) bool {
+		return slices.Contains(revokedTables, table)
+	})
+
+	var data *snapshot
+	switch driver {
+	case "sqlite":
+		data, err = readSqliteSnapshot(ctx, contents, restoredTables)
+	case "postgres":
+		data, err = readPostgresSnapshot(ctx, contents)
+	default:
+		return fmt.Errorf("unsupported database driver: %s", driver)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	currentVersion, err := r.currentSchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	if data.version != currentVersion {
+		return coreerror.New(
+			i18n.M(ctx, i18n.K.DatabaseBackupIncompatibleVersion).
+				V("backupVersion", data.version).
+				V("currentVersion", currentVersion),
+			true,
+		)
+	}
+
+	for _, table := range restoredTables {
+		if data.tables[table] == nil {
+			return invalidFileError(ctx, driver)
+		}
+	}
+
+	return r.replaceData(ctx, tables, restoredTables, data)
+}
+
+func (r *repository) replaceData(
+	ctx context.Context,
+	tables, restoredTables []string,
+	data *snapshot,
+) error {
+	transaction, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer transaction.Rollback()
+
+	for _, table := range slices.Backward(tables) {
+		if _, err = transaction.NewRaw("DELETE FROM ?", bun.Ident(table)).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, table := range restoredTables {
+		contents := data.tables[table]
+
+		columns := make([]bun.Ident, len(contents.columns))
+		for index, column := range contents.columns {
+			columns[index] = bun.Ident(column)
+		}
+
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+		query := "INSERT INTO ? (?) VALUES (" + placeholders + ")"
+
+		for _, row := range contents.rows {
+			args := append([]any{bun.Ident(table), bun.In(columns)}, row...)
+			if _, err = transaction.NewRaw(query, args...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		log.Infof("Restored %d rows of the %s table", len(contents.rows), table)
+	}
+
+	return transaction.Commit()
+}
+
+func (r *repository) currentSchemaVersion(ctx context.Context) (int, error) {
+	var version int
+
+	err := r.db.Unwrap().
+		QueryRowContext(ctx, "SELECT version FROM schema_version LIMIT 1").
+		Scan(&version)
+
+	return version, err
+}
+
+func (r *repository) findTables(ctx context.Context, driver string) ([]string, error) {
+	var tablesQuery, dependenciesQuery string
+	switch driver {
+	case "sqlite":
+		tablesQuery = "SELECT name FROM sqlite_master " +
+			"WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name"
+		dependenciesQuery = "SELECT m.name, f.\"table\" FROM sqlite_master m " +
+			"JOIN pragma_foreign_key_list(m.name) f WHERE m.type = 'table'"
+	case "postgres":
+		tablesQuery = "SELECT table_name FROM information_schema.tables " +
+			"WHERE table_schema = current_schema() AND table_type = 'BASE TABLE' " +
+			"ORDER BY table_name"
+		dependenciesQuery = "SELECT source.relname, target.relname FROM pg_constraint c " +
+			"JOIN pg_class source ON source.oid = c.conrelid " +
+			"JOIN pg_class target ON target.oid = c.confrelid " +
+			"JOIN pg_namespace n ON n.oid = source.relnamespace " +
+			"WHERE c.contype = 'f' AND n.nspname = current_schema()"
+	default:
+		return nil, fmt.Errorf("unsupported database driver: %s", driver)
+	}
+
+	tables, err := r.queryRows(ctx, tablesQuery, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	dependencies, err := r.queryRows(ctx, dependenciesQuery, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(tables))
+	for _, table := range tables {
+		if table[0] != schemaVersionTable {
+			names = append(names, table[0])
+		}
+	}
+
+	dependenciesByTable := make(map[string][]string)
+	for _, dependency := range dependencies {
+		dependenciesByTable[dependency[0]] = append(
+			dependenciesByTable[dependency[0]],
+			dependency[1],
+		)
+	}
+
+	return sortByDependencies(names, dependenciesByTable), nil
+}
+
+func (r *repository) queryRows(ctx context.Context, query string, size int) ([][]string, error) {
+	rows, err := r.db.Unwrap().QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:errcheck
+	defer rows.Close()
+
+	output := make([][]string, 0)
+	for rows.Next() {
+		values := make([]string, size)
+		pointers := make([]any, size)
+		for index := range values {
+			pointers[index] = &values[index]
+		}
+
+		if err = rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+
+		output = append(output, values)
+	}
+
+	return output, rows.Err()
+}
+
+func sortByDependencies(tables []string, dependencies map[string][]string) []string {
+	output := make([]string, 0, len(tables))
+	visited := make(map[string]bool)
+
+	var visit func(table string)
+	visit = func(table string) {
+		if visited[table] {
+			return
+		}
+
+		visited[table] = true
+		for _, dependency := range dependencies[table] {
+			if slices.Contains(tables, dependency) {
+				visit(dependency)
+			}
+		}
+
+		output = append(output, table)
+	}
+
+	for _, table := range tables {
+		visit(table)
+	}
+
+	return output
+}
+
+func invalidFileError(ctx context.Context, driver string) error {
+	return coreerror.New(
+		i18n.M(ctx, i18n.K.DatabaseBackupInvalidFile).V("driver", driver),
+		true,
+	)
+}
@@ -0,0 +1,102 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+const (
+	sqliteFileHeader = "SQLite format 3\x00"
+)
+
+func readSqliteSnapshot(ctx context.Context, contents []byte, tables []string) (*snapshot, error) {
+	if !bytes.HasPrefix(contents, []byte(sqliteFileHeader)) {
+		return nil, invalidFileError(ctx, "sqlite")
+	}
+
+	tempFile, err := os.CreateTemp(os.TempDir(), "nginx-ignition-restore-*.db")
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:errcheck
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.Write(contents)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=ro", tempFile.Name()))
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:errcheck
+	defer db.Close()
+
+	output := &snapshot{
+		tables: make(map[string]*snapshotTable),
+	}
+
+	err = db.QueryRowContext(ctx, "SELECT version FROM schema_version LIMIT 1").
+		Scan(&output.version)
+	if err != nil {
+		return nil, invalidFileError(ctx, "sqlite")
+	}
+
+	for _, table := range tables {
+		contents, err := readSqliteTable(ctx, db, table)
+		if err != nil {
+			return nil, invalidFileError(ctx, "sqlite")
+		}
+
+		output.tables[table] = contents
+	}
+
+	return output, nil
+}
+
+func readSqliteTable(ctx context.Context, db *sql.DB, table string) (*snapshotTable, error) {
+	//nolint:gosec // G202: table names are read from the database schema itself
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("SELECT * FROM \"%s\"", table))
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:errcheck
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	output := &snapshotTable{
+		columns: columns,
+		rows:    make([][]any, 0),
+	}
+
+	for rows.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for index := range values {
+			pointers[index] = &values[index]
+		}
+
+		if err = rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+
+		output.rows = append(output.rows, values)
+	}
+
+	return output, rows.Err()
+}
@@ -31,7 +31,7 @@ func New(db *database.Database) cache.Repository {
 func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*cache.Cache, error) {
 	var model cacheModel
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&model).
 		Relation("Durations").
 		Where(constants.ByIDFilter, id).
@@ -49,7 +49,7 @@ func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*cache.Cache,
 }
 
 func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (bool, error) {
-	hostExists, err := r.database.Select().
+	hostExists, err := r.database.Select(ctx).
 		Table("host").
 		Where(byCacheIDFilter, id).
 		Exists(ctx)
@@ -57,21 +57,21 @@ func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (bool, error)
 		return hostExists, err
 	}
 
-	return r.database.Select().
+	return r.database.Select(ctx).
 		Table("host_route").
 		Where(byCacheIDFilter, id).
 		Exists(ctx)
 }
 
 func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
-	return r.database.Select().
+	return r.database.Select(ctx).
 		Model((*cacheModel)(nil)).
 		Where(constants.ByIDFilter, id).
 		Exists(ctx)
 }
 
 func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -102,7 +102,7 @@ func (r *repository) FindPage(
 ) (*pagination.Page[cache.Cache], error) {
 	models := make([]cacheModel, 0)
 
-	query := r.database.Select().Model(&models)
+	query := r.database.Select(ctx).Model(&models)
 	if searchTerms != nil {
 		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+*searchTerms+"%")
 	}
@@ -134,17 +134,17 @@ func (r *repository) FindAllInUse(ctx context.Context) ([]cache.Cache, error) {
 	models := make([]cacheModel, 0)
 
 	hostSubquery := r.database.
-		Select().
+		Select(ctx).
 		Table("host").
 		Column("cache_id").
 		Where("cache_id is not null")
 	routeSubquery := r.database.
-		Select().
+		Select(ctx).
 		Table("host_route").
 		Column("cache_id").
 		Where("cache_id is not null")
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&models).
 		Relation("Durations").
 		Where("id in (?)", hostSubquery).
@@ -163,7 +163,7 @@ func (r *repository) FindAllInUse(ctx context.Context) ([]cache.Cache, error) {
 }
 
 func (r *repository) Save(ctx context.Context, domain *cache.Cache) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -31,7 +31,7 @@ func New(db *database.Database) certificate.Repository {
 func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*certificate.Certificate, error) {
 	var model certificateModel
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&model).
 		Where(constants.ByIDFilter, id).
 		Scan(ctx)
@@ -53,14 +53,14 @@ func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*certificate.C
 }
 
 func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
-	return r.database.Select().
+	return r.database.Select(ctx).
 		Model((*certificateModel)(nil)).
 		Where(constants.ByIDFilter, id).
 		Exists(ctx)
 }
 
 func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (bool, error) {
-	linkedToBindings, err := r.database.Select().
+	linkedToBindings, err := r.database.Select(ctx).
 		Table("host_binding").
 		Where(byCertificateIDFilter, id).
 		Exists(ctx)
@@ -72,7 +72,7 @@ func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (bool, error)
 		return true, nil
 	}
 
-	linkedToVPNs, err := r.database.Select().
+	linkedToVPNs, err := r.database.Select(ctx).
 		Table("host_vpn").
 		Where(byCertificateIDFilter, id).
 		Exists(ctx)
@@ -85,7 +85,7 @@ func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (bool, error)
 	}
 
 	return r.database.
-		Select().
+		Select(ctx).
 		Table("settings_global_binding").
 		Where(byCertificateIDFilter, id).
 		Exists(ctx)
@@ -99,7 +99,7 @@ func (r *repository) GetAutoRenewSettings(
 	var intervalUnitCount int
 
 	err := r.database.
-		Select().
+		Select(ctx).
 		Column("enabled", "interval_unit", "interval_unit_count").
 		Table("settings_certificate_auto_renew").
 		Limit(1).
@@ -116,7 +116,7 @@ func (r *repository) GetAutoRenewSettings(
 }
 
 func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -136,7 +136,7 @@ func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
 }
 
 func (r *repository) Save(ctx context.Context, cert *certificate.Certificate) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -180,7 +180,7 @@ func (r *repository) FindPage(
 ) (*pagination.Page[certificate.Certificate], error) {
 	certificates := make([]certificateModel, 0)
 
-	query := r.database.Select().Model(&certificates)
+	query := r.database.Select(ctx).Model(&certificates)
 	if searchTerms != nil {
 		query = query.Where(
 			"LOWER(CAST(domain_names AS varchar)) LIKE LOWER(?)",
@@ -193,7 +193,7 @@ func (r *repository) FindPage(
 		return nil, err
 	}
 
-	query = r.database.Select().Model(&certificates)
+	query = r.database.Select(ctx).Model(&certificates)
 	if searchTerms != nil {
 		query = query.Where(
 			"LOWER(CAST(domain_names AS varchar)) LIKE LOWER(?)",
@@ -226,7 +226,7 @@ func (r *repository) FindPage(
 func (r *repository) FindAllDueToRenew(ctx context.Context) ([]certificate.Certificate, error) {
 	certificates := make([]certificateModel, 0)
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&certificates).
 		Where("renew_after IS NOT NULL AND renew_after <= ?", time.Now()).
 		Scan(ctx)
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/uptrace/bun"
@@ -28,24 +29,38 @@ func (d *Database) Unwrap() *sql.DB {
 	return d.db
 }
 
-func (d *Database) Begin() (bun.Tx, error) {
-	return d.bun.Begin()
+// Begin starts a new transaction or, when the context already carries one, a savepoint inside of it. This way
+// repositories keep their own commit and rollback logic while still taking part in a wider transaction.
+func (d *Database) Begin(ctx context.Context) (bun.Tx, error) {
+	if tx, ok := ctx.Value(transactionKey{}).(bun.Tx); ok {
+		return tx.BeginTx(ctx, nil)
+	}
+
+	return d.bun.BeginTx(ctx, nil)
+}
+
+func (d *Database) Select(ctx context.Context) *bun.SelectQuery {
+	return d.connection(ctx).NewSelect()
 }
 
-func (d *Database) Select() *bun.SelectQuery {
-	return d.bun.NewSelect()
+func (d *Database) Insert(ctx context.Context) *bun.InsertQuery {
+	return d.connection(ctx).NewInsert()
 }
 
-func (d *Database) Insert() *bun.InsertQuery {
-	return d.bun.NewInsert()
+func (d *Database) Update(ctx context.Context) *bun.UpdateQuery {
+	return d.connection(ctx).NewUpdate()
 }
 
-func (d *Database) Update() *bun.UpdateQuery {
-	return d.bun.NewUpdate()
+func (d *Database) Delete(ctx context.Context) *bun.DeleteQuery {
+	return d.connection(ctx).NewDelete()
 }
 
-func (d *Database) Delete() *bun.DeleteQuery {
-	return d.bun.NewDelete()
+func (d *Database) connection(ctx context.Context) bun.IDB {
+	if tx, ok := ctx.Value(transactionKey{}).(bun.Tx); ok {
+		return tx
+	}
+
+	return d.bun
 }
 
 func (d *Database) ConnectionString() string {
@@ -0,0 +1,38 @@
+package database
+
+import (
+	"context"
+
+	"dillmann.com.br/nginx-ignition/core/common/transaction"
+)
+
+type transactionKey struct{}
+
+type transactionManager struct {
+	database *Database
+}
+
+func NewTransactionManager(db *Database) transaction.Manager {
+	return &transactionManager{
+		database: db,
+	}
+}
+
+func (m *transactionManager) Run(
+	ctx context.Context,
+	action func(ctx context.Context) error,
+) error {
+	tx, err := m.database.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer tx.Rollback()
+
+	if err = action(context.WithValue(ctx, transactionKey{}, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
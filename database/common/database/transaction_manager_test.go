@@ -0,0 +1,85 @@
+package database_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	"dillmann.com.br/nginx-ignition/database/common/testutils"
+)
+
+func Test_TransactionManager(t *testing.T) {
+	testutils.RunWithMockedDatabases(t, runTransactionManagerTests)
+}
+
+func runTransactionManagerTests(t *testing.T, db *database.Database) {
+	manager := database.NewTransactionManager(db)
+
+	insert := func(ctx context.Context, identifier string) error {
+		values := map[string]any{
+			"scope":           "TEST",
+			"identifier":      identifier,
+			"failure_count":   1,
+			"last_failure_at": time.Now(),
+		}
+
+		_, err := db.Insert(ctx).Model(&values).TableExpr("login_attempt").Exec(ctx)
+		return err
+	}
+
+	count := func(ctx context.Context, identifier string) int {
+		result, err := db.Select(ctx).
+			TableExpr("login_attempt").
+			Where("identifier = ?", identifier).
+			Count(ctx)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("commits the changes when the action succeeds", func(t *testing.T) {
+		identifier := uuid.NewString()
+
+		err := manager.Run(t.Context(), func(ctx context.Context) error {
+			require.NoError(t, insert(ctx, identifier))
+			assert.Equal(t, 1, count(ctx, identifier))
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count(t.Context(), identifier))
+	})
+
+	t.Run("rolls back every change when the action fails", func(t *testing.T) {
+		identifier := uuid.NewString()
+		nestedIdentifier := uuid.NewString()
+		expectedErr := errors.New("action failed")
+
+		err := manager.Run(t.Context(), func(ctx context.Context) error {
+			require.NoError(t, insert(ctx, identifier))
+
+			nested, err := db.Begin(ctx)
+			require.NoError(t, err)
+			_, err = nested.NewRaw(
+				"insert into login_attempt (scope, identifier, failure_count, last_failure_at) values (?, ?, ?, ?)",
+				"TEST",
+				nestedIdentifier,
+				1,
+				time.Now(),
+			).Exec(ctx)
+			require.NoError(t, err)
+			require.NoError(t, nested.Commit())
+
+			return expectedErr
+		})
+
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Equal(t, 0, count(t.Context(), identifier))
+		assert.Equal(t, 0, count(t.Context(), nestedIdentifier))
+	})
+}
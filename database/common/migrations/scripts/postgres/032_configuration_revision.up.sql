@@ -0,0 +1,10 @@
+create table configuration_revision (
+    id uuid not null,
+    created_at timestamp with time zone not null,
+    checksum varchar(64) not null,
+    files text not null,
+    snapshot text not null,
+    constraint pk_configuration_revision primary key (id)
+);
+
+create index idx_configuration_revision_created_at on configuration_revision (created_at);
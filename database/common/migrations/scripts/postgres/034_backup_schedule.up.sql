@@ -0,0 +1,41 @@
+create table settings_backup (
+    id uuid not null,
+    enabled boolean not null,
+    interval_unit varchar(32) not null,
+    interval_unit_count integer not null,
+    maximum_count integer not null,
+    maximum_age_days integer not null,
+    destination varchar(16) not null,
+    local_path varchar(256),
+    s3_endpoint varchar(256),
+    s3_region varchar(64),
+    s3_bucket varchar(256),
+    s3_prefix varchar(256),
+    s3_access_key varchar(256),
+    s3_secret_key varchar(256),
+    constraint pk_settings_backup primary key (id)
+);
+
+insert into settings_backup (
+    id,
+    enabled,
+    interval_unit,
+    interval_unit_count,
+    maximum_count,
+    maximum_age_days,
+    destination
+) values ('5d0c3f2e-8a4b-4f6e-9c1d-7b2a6e4f8c31', false, 'DAYS', 1, 7, 0, 'LOCAL');
+
+create table backup_run (
+    id uuid not null,
+    started_at timestamp with time zone not null,
+    finished_at timestamp with time zone not null,
+    status varchar(16) not null,
+    destination varchar(16) not null,
+    file_name varchar(256),
+    size_bytes bigint not null,
+    error_message text,
+    constraint pk_backup_run primary key (id)
+);
+
+create index idx_backup_run_started_at on backup_run (started_at);
@@ -0,0 +1 @@
+update certificate set parameters = parameters::jsonb || '{"challengeType": "DNS_01"}'::jsonb where provider_id = 'LETS_ENCRYPT';
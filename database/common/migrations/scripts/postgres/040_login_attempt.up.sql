@@ -0,0 +1,10 @@
+create table login_attempt (
+    scope varchar(16) not null,
+    identifier varchar(256) not null,
+    failure_count integer not null,
+    last_failure_at timestamp with time zone not null,
+    locked_until timestamp with time zone null,
+    constraint pk_login_attempt primary key (scope, identifier)
+);
+
+create index idx_login_attempt_last_failure_at on login_attempt (last_failure_at);
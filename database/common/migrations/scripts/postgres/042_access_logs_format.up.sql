@@ -0,0 +1 @@
+alter table settings_nginx add column access_logs_format varchar(16) not null default 'COMBINED';
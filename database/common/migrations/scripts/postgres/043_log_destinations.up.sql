@@ -0,0 +1,16 @@
+create table settings_nginx_log_destination (
+    id          uuid         not null,
+    host_id     uuid,
+    type        varchar(16)  not null,
+    protocol    varchar(16)  not null,
+    address     varchar(512) not null,
+    facility    varchar(16)  not null,
+    tag         varchar(32),
+    access_logs boolean      not null,
+    error_logs  boolean      not null,
+    position    int          not null,
+    constraint pk_settings_nginx_log_destination primary key (id),
+    constraint fk_settings_nginx_log_destination_host_id foreign key (host_id) references host (id)
+);
+
+create index idx_settings_nginx_log_destination_host_id on settings_nginx_log_destination (host_id);
@@ -0,0 +1,33 @@
+create table tls_profile (
+    id uuid not null,
+    name varchar(256) not null,
+    preset varchar(16) not null,
+    protocols varchar[] not null,
+    ciphers text,
+    prefer_server_ciphers boolean not null,
+    ocsp_stapling boolean not null,
+    hsts_enabled boolean not null,
+    hsts_max_age_seconds integer not null,
+    hsts_include_subdomains boolean not null,
+    hsts_preload boolean not null,
+    session_cache_enabled boolean not null,
+    session_cache_size_mb integer not null,
+    session_timeout_minutes integer not null,
+    session_tickets_enabled boolean not null,
+    client_verification_enabled boolean not null,
+    client_verification_mode varchar(16) not null,
+    client_verification_depth integer not null,
+    client_certificate_authorities text,
+    constraint pk_tls_profile primary key (id)
+);
+
+alter table host_binding
+    add column tls_profile_id uuid references tls_profile(id);
+
+alter table settings_global_binding
+    add column tls_profile_id uuid references tls_profile(id);
+
+create index idx_host_binding_tls_profile_id
+    on host_binding (tls_profile_id);
+create index idx_settings_global_binding_tls_profile_id
+    on settings_global_binding (tls_profile_id);
@@ -0,0 +1,5 @@
+alter table host
+    add column header_rules text;
+
+alter table host_route
+    add column header_rules text;
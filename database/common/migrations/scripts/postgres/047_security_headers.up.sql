@@ -0,0 +1,39 @@
+create table security_headers (
+    id uuid not null,
+    name varchar(256) not null,
+    frame_options varchar(16),
+    referrer_policy varchar(64),
+    permissions_policy text,
+    content_type_no_sniff boolean not null,
+    csp_enabled boolean not null,
+    csp_report_only boolean not null,
+    csp_collect_reports boolean not null,
+    csp_report_uri text,
+    csp_report_to text,
+    csp_directives text,
+    constraint pk_security_headers primary key (id)
+);
+
+create table security_headers_report (
+    id uuid not null,
+    security_headers_id uuid not null references security_headers(id),
+    created_at timestamp with time zone not null,
+    document_uri text,
+    blocked_uri text,
+    effective_directive varchar(256),
+    original_policy text,
+    disposition varchar(16),
+    source_file text,
+    line_number integer,
+    user_agent text,
+    constraint pk_security_headers_report primary key (id)
+);
+
+create index idx_security_headers_report_security_headers_id
+    on security_headers_report (security_headers_id, created_at);
+
+alter table host
+    add column security_headers_id uuid references security_headers(id);
+
+create index idx_host_security_headers_id
+    on host (security_headers_id);
@@ -0,0 +1,19 @@
+create table audit_event (
+    id uuid not null,
+    created_at timestamp with time zone not null,
+    user_id uuid,
+    source_ip varchar(64),
+    entity_type varchar(32) not null,
+    entity_id uuid,
+    action varchar(16) not null,
+    before_state text,
+    after_state text,
+    constraint pk_audit_event primary key (id)
+);
+
+create index idx_audit_event_created_at on audit_event (created_at);
+create index idx_audit_event_user_id on audit_event (user_id);
+create index idx_audit_event_entity on audit_event (entity_type, entity_id);
+
+alter table "user" add column audit_access_level varchar(32) not null default 'NO_ACCESS';
+update "user" set audit_access_level = 'READ_ONLY' where users_access_level = 'READ_WRITE';
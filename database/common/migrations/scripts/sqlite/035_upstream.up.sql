@@ -0,0 +1,35 @@
+create table upstream (
+    id uuid not null,
+    name varchar(256) not null,
+    protocol varchar(16) not null,
+    method varchar(32) not null,
+    hash_key varchar(512),
+    keepalive_connections integer,
+    keepalive_timeout_seconds integer,
+    constraint pk_upstream primary key (id)
+);
+
+create table upstream_server (
+    id uuid not null,
+    upstream_id uuid not null,
+    position integer not null,
+    address varchar(512) not null,
+    port integer not null,
+    weight integer,
+    max_failures integer,
+    open_seconds integer,
+    backup boolean not null,
+    constraint pk_upstream_server primary key (id),
+    constraint fk_upstream_server_upstream foreign key (upstream_id) references upstream (id) on delete cascade
+);
+
+create index idx_upstream_server_upstream_id on upstream_server (upstream_id);
+
+alter table host_route
+    add column upstream_id text references upstream(id);
+
+create index idx_host_route_upstream_id
+    on host_route (upstream_id);
+
+alter table "user" add column upstreams_access_level varchar(32) not null default 'NO_ACCESS';
+update "user" set upstreams_access_level = hosts_access_level;
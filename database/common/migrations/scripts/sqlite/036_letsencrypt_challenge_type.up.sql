@@ -0,0 +1 @@
+update certificate set parameters = json_patch(parameters, '{"challengeType": "DNS_01"}') where provider_id = 'LETS_ENCRYPT';
@@ -0,0 +1,2 @@
+alter table "user" add column external_subject varchar(512);
+create unique index idx_user_external_subject on "user" (external_subject);
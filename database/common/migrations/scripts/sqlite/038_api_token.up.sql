@@ -0,0 +1,31 @@
+create table api_token (
+    id uuid not null,
+    user_id uuid not null,
+    name varchar(256) not null,
+    token_hash varchar(64) not null,
+    token_prefix varchar(16) not null,
+    allowed_source_addresses varchar array not null,
+    expires_at timestamp with time zone,
+    last_used_at timestamp with time zone,
+    created_at timestamp with time zone not null,
+    hosts_access_level varchar(32) not null,
+    streams_access_level varchar(32) not null,
+    certificates_access_level varchar(32) not null,
+    logs_access_level varchar(32) not null,
+    integrations_access_level varchar(32) not null,
+    access_lists_access_level varchar(32) not null,
+    settings_access_level varchar(32) not null,
+    users_access_level varchar(32) not null,
+    nginx_server_access_level varchar(32) not null,
+    export_data_access_level varchar(32) not null,
+    vpns_access_level varchar(32) not null,
+    caches_access_level varchar(32) not null,
+    upstreams_access_level varchar(32) not null,
+    traffic_stats_access_level varchar(32) not null,
+    audit_access_level varchar(32) not null,
+    constraint pk_api_token primary key (id),
+    constraint fk_api_token_user foreign key (user_id) references "user" (id) on delete cascade
+);
+
+create unique index idx_api_token_token_hash on api_token (token_hash);
+create index idx_api_token_user_id on api_token (user_id);
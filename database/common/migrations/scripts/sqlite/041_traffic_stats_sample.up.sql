@@ -0,0 +1,19 @@
+create table traffic_stats_sample (
+    resolution varchar(16) not null,
+    scope varchar(16) not null,
+    zone_key varchar(256) not null,
+    bucket_start timestamp with time zone not null,
+    requests bigint not null,
+    in_bytes bigint not null,
+    out_bytes bigint not null,
+    request_msecs bigint not null,
+    status_1xx bigint not null,
+    status_2xx bigint not null,
+    status_3xx bigint not null,
+    status_4xx bigint not null,
+    status_5xx bigint not null,
+    constraint pk_traffic_stats_sample primary key (resolution, scope, zone_key, bucket_start)
+);
+
+create index idx_traffic_stats_sample_zone on traffic_stats_sample (scope, zone_key, bucket_start);
+create index idx_traffic_stats_sample_resolution on traffic_stats_sample (resolution, bucket_start);
@@ -0,0 +1,25 @@
+create table rate_limit (
+    id uuid not null,
+    name varchar(256) not null,
+    key_type varchar(16) not null,
+    key_name varchar(256),
+    rate integer not null,
+    rate_unit varchar(16) not null,
+    burst integer not null,
+    no_delay boolean not null,
+    response_status_code integer not null,
+    connection_limit_enabled boolean not null,
+    maximum_connections integer,
+    constraint pk_rate_limit primary key (id)
+);
+
+alter table host
+    add column rate_limit_id text references rate_limit(id);
+
+alter table host_route
+    add column rate_limit_id text references rate_limit(id);
+
+create index idx_host_rate_limit_id
+    on host (rate_limit_id);
+create index idx_host_route_rate_limit_id
+    on host_route (rate_limit_id);
@@ -0,0 +1,8 @@
+alter table host_route
+    add column match_type text not null default 'PREFIX';
+
+alter table host_route
+    add column rewrite_rules text;
+
+alter table host_route
+    add column preserve_query_string boolean not null default false;
@@ -0,0 +1,17 @@
+alter table "user" add column tls_profiles_access_level varchar(32) not null default 'NO_ACCESS';
+alter table "user" add column rate_limits_access_level varchar(32) not null default 'NO_ACCESS';
+alter table "user" add column security_headers_access_level varchar(32) not null default 'NO_ACCESS';
+
+update "user" set
+    tls_profiles_access_level = certificates_access_level,
+    rate_limits_access_level = access_lists_access_level,
+    security_headers_access_level = hosts_access_level;
+
+alter table api_token add column tls_profiles_access_level varchar(32) not null default 'NO_ACCESS';
+alter table api_token add column rate_limits_access_level varchar(32) not null default 'NO_ACCESS';
+alter table api_token add column security_headers_access_level varchar(32) not null default 'NO_ACCESS';
+
+update api_token set
+    tls_profiles_access_level = certificates_access_level,
+    rate_limits_access_level = access_lists_access_level,
+    security_headers_access_level = hosts_access_level;
@@ -3,7 +3,6 @@ module dillmann.com.br/nginx-ignition/database
 go 1.26.2
 
 require (
-	github.com/JCoupalK/go-pgdump v1.1.1-0.20251117080142-ba155b05e5d3
 	github.com/golang-migrate/migrate/v4 v4.19.1
 	github.com/google/uuid v1.6.0
 	github.com/lib/pq v1.12.3
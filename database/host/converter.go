@@ -18,6 +18,7 @@ func toDomain(model *hostModel) (*host.Host, error) {
 			IP:            b.IP,
 			Port:          b.Port,
 			CertificateID: b.CertificateID,
+			TLSProfileID:  b.TLSProfileID,
 		}
 	}
 
@@ -39,6 +40,16 @@ func toDomain(model *hostModel) (*host.Host, error) {
 			return nil, err
 		}
 
+		routeHeaderRules, err := parseHeaderRules(route.HeaderRules)
+		if err != nil {
+			return nil, err
+		}
+
+		rewriteRules, err := parseRewriteRules(route.RewriteRules)
+		if err != nil {
+			return nil, err
+		}
+
 		var response *host.RouteStaticResponse
 		if route.StaticResponseCode != nil {
 			response = &host.RouteStaticResponse{
@@ -71,11 +82,14 @@ func toDomain(model *hostModel) (*host.Host, error) {
 			Priority:     route.Priority,
 			Enabled:      route.Enabled,
 			Type:         host.RouteType(route.Type),
+			MatchType:    host.RouteMatchType(route.MatchType),
 			SourcePath:   route.SourcePath,
 			TargetURI:    route.TargetURI,
 			RedirectCode: route.RedirectCode,
 			AccessListID: route.AccessListID,
 			CacheID:      route.CacheID,
+			RateLimitID:  route.RateLimitID,
+			UpstreamID:   route.UpstreamID,
 			Settings: host.RouteSettings{
 				IncludeForwardHeaders:   route.IncludeForwardHeaders,
 				ProxySSLServerName:      route.ProxySSLServerName,
@@ -83,14 +97,22 @@ func toDomain(model *hostModel) (*host.Host, error) {
 				KeepOriginalDomainName:  route.KeepOriginalDomainName,
 				DirectoryListingEnabled: route.DirectoryListingEnabled,
 				IndexFile:               route.IndexFile,
+				PreserveQueryString:     route.PreserveQueryString,
 				Custom:                  route.CustomSettings,
 			},
-			Response:    response,
-			Integration: integration,
-			SourceCode:  sourceCode,
+			Response:     response,
+			Integration:  integration,
+			SourceCode:   sourceCode,
+			HeaderRules:  routeHeaderRules,
+			RewriteRules: rewriteRules,
 		}
 	}
 
+	headerRules, err := parseHeaderRules(model.HeaderRules)
+	if err != nil {
+		return nil, err
+	}
+
 	return &host.Host{
 		ID:                model.ID,
 		Enabled:           model.Enabled,
@@ -106,8 +128,11 @@ func toDomain(model *hostModel) (*host.Host, error) {
 			RedirectHTTPToHTTPS: model.RedirectHTTPToHTTPS,
 			StatsEnabled:        model.StatsEnabled,
 		},
-		AccessListID: model.AccessListID,
-		CacheID:      model.CacheID,
+		AccessListID:      model.AccessListID,
+		CacheID:           model.CacheID,
+		RateLimitID:       model.RateLimitID,
+		SecurityHeadersID: model.SecurityHeadersID,
+		HeaderRules:       headerRules,
 	}, nil
 }
 
@@ -121,6 +146,7 @@ func toModel(domain *host.Host) (*hostModel, error) {
 			IP:            b.IP,
 			Port:          b.Port,
 			CertificateID: b.CertificateID,
+			TLSProfileID:  b.TLSProfileID,
 		}
 	}
 
@@ -161,6 +187,16 @@ func toModel(domain *host.Host) (*hostModel, error) {
 			integrationUseHTTPS = route.Integration.UseHTTPS
 		}
 
+		routeHeaderRules, err := formatHeaderRules(route.HeaderRules)
+		if err != nil {
+			return nil, err
+		}
+
+		rewriteRules, err := formatRewriteRules(route.RewriteRules)
+		if err != nil {
+			return nil, err
+		}
+
 		var codeLanguage, codeContents, codeMainFunction *string
 		if route.SourceCode != nil {
 			codeLanguage = (*string)(&route.SourceCode.Language)
@@ -173,6 +209,7 @@ func toModel(domain *host.Host) (*hostModel, error) {
 			HostID:                  domain.ID,
 			Priority:                route.Priority,
 			Type:                    string(route.Type),
+			MatchType:               string(route.MatchType),
 			SourcePath:              route.SourcePath,
 			TargetURI:               route.TargetURI,
 			CustomSettings:          route.Settings.Custom,
@@ -188,16 +225,26 @@ func toModel(domain *host.Host) (*hostModel, error) {
 			KeepOriginalDomainName:  route.Settings.KeepOriginalDomainName,
 			DirectoryListingEnabled: route.Settings.DirectoryListingEnabled,
 			IndexFile:               route.Settings.IndexFile,
+			PreserveQueryString:     route.Settings.PreserveQueryString,
 			IntegrationUseHTTPS:     integrationUseHTTPS,
 			AccessListID:            route.AccessListID,
 			CacheID:                 route.CacheID,
+			RateLimitID:             route.RateLimitID,
+			UpstreamID:              route.UpstreamID,
 			CodeLanguage:            codeLanguage,
 			CodeContents:            codeContents,
 			CodeMainFunction:        codeMainFunction,
+			HeaderRules:             routeHeaderRules,
+			RewriteRules:            rewriteRules,
 			Enabled:                 route.Enabled,
 		}
 	}
 
+	headerRules, err := formatHeaderRules(domain.HeaderRules)
+	if err != nil {
+		return nil, err
+	}
+
 	return &hostModel{
 		ID:                  domain.ID,
 		Enabled:             domain.Enabled,
@@ -210,6 +257,9 @@ func toModel(domain *host.Host) (*hostModel, error) {
 		UseGlobalBindings:   domain.UseGlobalBindings,
 		AccessListID:        domain.AccessListID,
 		CacheID:             domain.CacheID,
+		RateLimitID:         domain.RateLimitID,
+		SecurityHeadersID:   domain.SecurityHeadersID,
+		HeaderRules:         headerRules,
 		Bindings:            bindings,
 		Routes:              routes,
 		VPNs:                vpns,
@@ -237,3 +287,95 @@ func formatHeaders(headers map[string]string) (*string, error) {
 	}
 	return new(string(result)), nil
 }
+
+func parseHeaderRules(rules *string) ([]host.HeaderRule, error) {
+	if rules == nil {
+		return nil, nil
+	}
+
+	var models []headerRuleModel
+	if err := json.Unmarshal([]byte(*rules), &models); err != nil {
+		return nil, err
+	}
+
+	result := make([]host.HeaderRule, len(models))
+	for index, model := range models {
+		result[index] = host.HeaderRule{
+			Name:   model.Name,
+			Value:  model.Value,
+			Target: host.HeaderRuleTarget(model.Target),
+			Action: host.HeaderRuleAction(model.Action),
+			Always: model.Always,
+		}
+	}
+
+	return result, nil
+}
+
+func formatHeaderRules(rules []host.HeaderRule) (*string, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	models := make([]headerRuleModel, len(rules))
+	for index, rule := range rules {
+		models[index] = headerRuleModel{
+			Name:   rule.Name,
+			Value:  rule.Value,
+			Target: string(rule.Target),
+			Action: string(rule.Action),
+			Always: rule.Always,
+		}
+	}
+
+	result, err := json.Marshal(models)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(string(result)), nil
+}
+
+func parseRewriteRules(rules *string) ([]host.RewriteRule, error) {
+	if rules == nil {
+		return nil, nil
+	}
+
+	var models []rewriteRuleModel
+	if err := json.Unmarshal([]byte(*rules), &models); err != nil {
+		return nil, err
+	}
+
+	result := make([]host.RewriteRule, len(models))
+	for index, model := range models {
+		result[index] = host.RewriteRule{
+			Pattern:     model.Pattern,
+			Replacement: model.Replacement,
+			Flag:        (*host.RewriteRuleFlag)(model.Flag),
+		}
+	}
+
+	return result, nil
+}
+
+func formatRewriteRules(rules []host.RewriteRule) (*string, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	models := make([]rewriteRuleModel, len(rules))
+	for index, rule := range rules {
+		models[index] = rewriteRuleModel{
+			Pattern:     rule.Pattern,
+			Replacement: rule.Replacement,
+			Flag:        (*string)(rule.Flag),
+		}
+	}
+
+	result, err := json.Marshal(models)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(string(result)), nil
+}
@@ -69,4 +69,77 @@ func Test_Converter(t *testing.T) {
 			assert.True(t, model.VPNs[0].EnableHTTPS)
 		})
 	})
+
+	t.Run("keeps the header rules of the host and routes", func(t *testing.T) {
+		domain := &host.Host{
+			ID: uuid.New(),
+			HeaderRules: []host.HeaderRule{
+				{
+					Name:   "X-Frame-Options",
+					Value:  new("DENY"),
+					Target: host.ResponseHeaderRuleTarget,
+					Action: host.SetHeaderRuleAction,
+					Always: true,
+				},
+			},
+			Routes: []host.Route{
+				{
+					ID:   uuid.New(),
+					Type: host.ProxyRouteType,
+					HeaderRules: []host.HeaderRule{
+						{
+							Name:   "Cookie",
+							Target: host.RequestHeaderRuleTarget,
+							Action: host.RemoveHeaderRuleAction,
+						},
+					},
+				},
+			},
+		}
+
+		model, err := toModel(domain)
+		assert.NoError(t, err)
+		assert.NotNil(t, model.HeaderRules)
+		assert.NotNil(t, model.Routes[0].HeaderRules)
+
+		result, err := toDomain(model)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.HeaderRules, result.HeaderRules)
+		assert.Equal(t, domain.Routes[0].HeaderRules, result.Routes[0].HeaderRules)
+	})
+
+	t.Run("keeps the match type and rewrite rules of the routes", func(t *testing.T) {
+		domain := &host.Host{
+			ID: uuid.New(),
+			Routes: []host.Route{
+				{
+					ID:         uuid.New(),
+					Type:       host.ProxyRouteType,
+					MatchType:  host.RegexRouteMatchType,
+					SourcePath: "^/api/(v[0-9]+)/",
+					RewriteRules: []host.RewriteRule{
+						{
+							Pattern:     "^/api/(v[0-9]+)/(.*)$",
+							Replacement: "/$1/$2",
+							Flag:        new(host.BreakRewriteRuleFlag),
+						},
+					},
+					Settings: host.RouteSettings{
+						PreserveQueryString: true,
+					},
+				},
+			},
+		}
+
+		model, err := toModel(domain)
+		assert.NoError(t, err)
+		assert.Equal(t, "REGEX", model.Routes[0].MatchType)
+		assert.NotNil(t, model.Routes[0].RewriteRules)
+
+		result, err := toDomain(model)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Routes[0].MatchType, result.Routes[0].MatchType)
+		assert.Equal(t, domain.Routes[0].RewriteRules, result.Routes[0].RewriteRules)
+		assert.True(t, result.Routes[0].Settings.PreserveQueryString)
+	})
 }
@@ -10,6 +10,9 @@ type hostModel struct {
 
 	AccessListID        *uuid.UUID         `bun:"access_list_id"`
 	CacheID             *uuid.UUID         `bun:"cache_id"`
+	RateLimitID         *uuid.UUID         `bun:"rate_limit_id"`
+	SecurityHeadersID   *uuid.UUID         `bun:"security_headers_id"`
+	HeaderRules         *string            `bun:"header_rules"`
 	VPNs                []hostVpnModel     `bun:"rel:has-many,join:id=host_id"`
 	DomainNames         []string           `bun:"domain_names,array"`
 	Routes              []hostRouteModel   `bun:"rel:has-many,join:id=host_id"`
@@ -28,6 +31,7 @@ type hostBindingModel struct {
 	bun.BaseModel `bun:"host_binding"`
 
 	CertificateID *uuid.UUID `bun:"certificate_id"`
+	TLSProfileID  *uuid.UUID `bun:"tls_profile_id"`
 	Type          string     `bun:"type,notnull"`
 	IP            string     `bun:"ip,notnull"`
 	Port          int        `bun:"port,notnull"`
@@ -46,6 +50,12 @@ type hostVpnModel struct {
 	EnableHTTPS   bool       `bun:"enable_https,notnull"`
 }
 
+type hostLogDestinationModel struct {
+	bun.BaseModel `bun:"settings_nginx_log_destination"`
+
+	HostID *uuid.UUID `bun:"host_id"`
+}
+
 type hostRouteModel struct {
 	bun.BaseModel `bun:"host_route"`
 
@@ -58,12 +68,17 @@ type hostRouteModel struct {
 	IntegrationID           *uuid.UUID `bun:"integration_id"`
 	IntegrationOptionID     *string    `bun:"integration_option_id"`
 	CacheID                 *uuid.UUID `bun:"cache_id"`
+	RateLimitID             *uuid.UUID `bun:"rate_limit_id"`
+	UpstreamID              *uuid.UUID `bun:"upstream_id"`
 	StaticResponseHeaders   *string    `bun:"static_response_headers"`
+	HeaderRules             *string    `bun:"header_rules"`
+	RewriteRules            *string    `bun:"rewrite_rules"`
 	StaticResponsePayload   *string    `bun:"static_response_payload"`
 	AccessListID            *uuid.UUID `bun:"access_list_id"`
 	IndexFile               *string    `bun:"index_file"`
 	StaticResponseCode      *int       `bun:"static_response_code"`
 	Type                    string     `bun:"type,notnull"`
+	MatchType               string     `bun:"match_type,notnull"`
 	SourcePath              string     `bun:"source_path,notnull"`
 	Priority                int        `bun:"priority,notnull"`
 	ID                      uuid.UUID  `bun:"id,pk"`
@@ -74,5 +89,20 @@ type hostRouteModel struct {
 	KeepOriginalDomainName  bool       `bun:"keep_original_domain_name,notnull"`
 	DirectoryListingEnabled bool       `bun:"directory_listing_enabled,notnull"`
 	IntegrationUseHTTPS     bool       `bun:"integration_use_https,notnull"`
+	PreserveQueryString     bool       `bun:"preserve_query_string,notnull"`
 	Enabled                 bool       `bun:"enabled,notnull"`
 }
+
+type headerRuleModel struct {
+	Value  *string `json:"value,omitempty"`
+	Name   string  `json:"name"`
+	Target string  `json:"target"`
+	Action string  `json:"action"`
+	Always bool    `json:"always"`
+}
+
+type rewriteRuleModel struct {
+	Flag        *string `json:"flag,omitempty"`
+	Pattern     string  `json:"pattern"`
+	Replacement string  `json:"replacement"`
+}
@@ -32,7 +32,7 @@ func New(db *database.Database) host.Repository {
 func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*host.Host, error) {
 	var model hostModel
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&model).
 		Relation("Bindings").
 		Relation("Routes").
@@ -52,7 +52,7 @@ func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*host.Host, er
 }
 
 func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -84,6 +84,14 @@ func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
+	_, err = transaction.NewDelete().
+		Model((*hostLogDestinationModel)(nil)).
+		Where(byHostIDFilter, id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
 	_, err = transaction.NewDelete().
 		Model((*hostModel)(nil)).
 		Where(constants.ByIDFilter, id).
@@ -96,7 +104,7 @@ func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
 }
 
 func (r *repository) Save(ctx context.Context, h *host.Host) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -215,7 +223,7 @@ func (r *repository) FindPage(
 ) (*pagination.Page[host.Host], error) {
 	models := make([]hostModel, 0)
 
-	query := r.database.Select().Model(&models)
+	query := r.database.Select(ctx).Model(&models)
 	if searchTerms != nil && *searchTerms != "" {
 		query = query.Where(
 			"LOWER(CAST(domain_names AS varchar)) LIKE LOWER(?)",
@@ -256,7 +264,7 @@ func (r *repository) FindPage(
 func (r *repository) FindAllEnabled(ctx context.Context) ([]host.Host, error) {
 	models := make([]hostModel, 0)
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&models).
 		Relation("Bindings").
 		Relation("Routes").
@@ -282,7 +290,7 @@ func (r *repository) FindAllEnabled(ctx context.Context) ([]host.Host, error) {
 func (r *repository) FindDefault(ctx context.Context) (*host.Host, error) {
 	var model hostModel
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&model).
 		Relation("Bindings").
 		Relation("Routes").
@@ -302,7 +310,7 @@ func (r *repository) FindDefault(ctx context.Context) (*host.Host, error) {
 }
 
 func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
-	return r.database.Select().
+	return r.database.Select(ctx).
 		Model((*hostModel)(nil)).
 		Where(constants.ByIDFilter, id).
 		Exists(ctx)
@@ -3,6 +3,8 @@ package database
 import (
 	"dillmann.com.br/nginx-ignition/core/common/container"
 	"dillmann.com.br/nginx-ignition/database/accesslist"
+	"dillmann.com.br/nginx-ignition/database/apitoken"
+	"dillmann.com.br/nginx-ignition/database/audit"
 	"dillmann.com.br/nginx-ignition/database/backup"
 	"dillmann.com.br/nginx-ignition/database/cache"
 	"dillmann.com.br/nginx-ignition/database/certificate"
@@ -10,8 +12,16 @@ import (
 	"dillmann.com.br/nginx-ignition/database/common/migrations"
 	"dillmann.com.br/nginx-ignition/database/host"
 	"dillmann.com.br/nginx-ignition/database/integration"
+	"dillmann.com.br/nginx-ignition/database/loginattempt"
+	"dillmann.com.br/nginx-ignition/database/ratelimit"
+	"dillmann.com.br/nginx-ignition/database/revision"
+	"dillmann.com.br/nginx-ignition/database/securityheaders"
+	"dillmann.com.br/nginx-ignition/database/session"
 	"dillmann.com.br/nginx-ignition/database/settings"
 	"dillmann.com.br/nginx-ignition/database/stream"
+	"dillmann.com.br/nginx-ignition/database/tlsprofile"
+	"dillmann.com.br/nginx-ignition/database/trafficstats"
+	"dillmann.com.br/nginx-ignition/database/upstream"
 	"dillmann.com.br/nginx-ignition/database/user"
 	"dillmann.com.br/nginx-ignition/database/vpn"
 )
@@ -27,13 +37,23 @@ func Install() error {
 	return container.Provide(
 		accesslist.New,
 		cache.New,
+		ratelimit.New,
+		securityheaders.New,
+		upstream.New,
 		host.New,
 		user.New,
+		apitoken.New,
+		session.New,
+		loginattempt.New,
+		trafficstats.New,
 		settings.New,
 		certificate.New,
+		tlsprofile.New,
 		integration.New,
 		stream.New,
 		backup.New,
 		vpn.New,
+		revision.New,
+		audit.New,
 	)
 }
@@ -26,7 +26,7 @@ func New(db *database.Database) integration.Repository {
 func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*integration.Integration, error) {
 	var model integrationModel
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&model).
 		Where(constants.ByIDFilter, id).
 		Scan(ctx)
@@ -43,7 +43,7 @@ func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*integration.I
 }
 
 func (r *repository) ExistsByName(ctx context.Context, name string) (*bool, error) {
-	count, err := r.database.Select().
+	count, err := r.database.Select(ctx).
 		Model((*integrationModel)(nil)).
 		Where("name = ?", name).
 		Count(ctx)
@@ -60,7 +60,7 @@ func (r *repository) ExistsByName(ctx context.Context, name string) (*bool, erro
 }
 
 func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (*bool, error) {
-	exists, err := r.database.Select().
+	exists, err := r.database.Select(ctx).
 		Model((*integrationModel)(nil)).
 		Where(constants.ByIDFilter, id).
 		Exists(ctx)
@@ -69,7 +69,7 @@ func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (*bool, error
 }
 
 func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (*bool, error) {
-	count, err := r.database.Select().
+	count, err := r.database.Select(ctx).
 		Table("host_route").
 		Where("integration_id = ? and type = ?", id, "INTEGRATION").
 		Count(ctx)
@@ -86,7 +86,7 @@ func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (*bool, error)
 }
 
 func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
-	tx, err := r.database.Begin()
+	tx, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -106,7 +106,7 @@ func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
 }
 
 func (r *repository) Save(ctx context.Context, values *integration.Integration) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -151,7 +151,7 @@ func (r *repository) FindPage(
 ) (*pagination.Page[integration.Integration], error) {
 	models := make([]integrationModel, 0)
 
-	query := r.database.Select().Model(&models)
+	query := r.database.Select(ctx).Model(&models)
 	if searchTerms != nil && *searchTerms != "" {
 		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+*searchTerms+"%")
 	}
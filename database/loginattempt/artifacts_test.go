@@ -0,0 +1,42 @@
+package loginattempt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/loginattempt"
+)
+
+func newAttempt(lastFailureAt time.Time) *loginattempt.Attempt {
+	return &loginattempt.Attempt{
+		Scope:         loginattempt.UsernameScope,
+		Identifier:    uuid.NewString(),
+		FailureCount:  1,
+		LastFailureAt: lastFailureAt.UTC().Truncate(time.Second),
+	}
+}
+
+func save(t *testing.T, repo loginattempt.Repository, attempt *loginattempt.Attempt) {
+	t.Helper()
+
+	_, err := repo.IncrementFailures(
+		t.Context(),
+		attempt.Scope,
+		attempt.Identifier,
+		attempt.LastFailureAt,
+		attempt.LastFailureAt,
+	)
+	require.NoError(t, err)
+
+	if attempt.LockedUntil != nil {
+		require.NoError(t, repo.ExtendLock(
+			t.Context(),
+			attempt.Scope,
+			attempt.Identifier,
+			*attempt.LockedUntil,
+		))
+	}
+}
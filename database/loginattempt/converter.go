@@ -0,0 +1,25 @@
+package loginattempt
+
+import (
+	"dillmann.com.br/nginx-ignition/core/loginattempt"
+)
+
+func toDomain(model *attemptModel) *loginattempt.Attempt {
+	return &loginattempt.Attempt{
+		Scope:         loginattempt.Scope(model.Scope),
+		Identifier:    model.Identifier,
+		FailureCount:  model.FailureCount,
+		LastFailureAt: model.LastFailureAt,
+		LockedUntil:   model.LockedUntil,
+	}
+}
+
+func toModel(domain *loginattempt.Attempt) *attemptModel {
+	return &attemptModel{
+		Scope:         string(domain.Scope),
+		Identifier:    domain.Identifier,
+		FailureCount:  domain.FailureCount,
+		LastFailureAt: domain.LastFailureAt,
+		LockedUntil:   domain.LockedUntil,
+	}
+}
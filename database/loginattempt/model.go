@@ -0,0 +1,17 @@
+package loginattempt
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type attemptModel struct {
+	bun.BaseModel `bun:"login_attempt"`
+
+	LastFailureAt time.Time  `bun:"last_failure_at,notnull"`
+	LockedUntil   *time.Time `bun:"locked_until"`
+	Scope         string     `bun:"scope,pk"`
+	Identifier    string     `bun:"identifier,pk"`
+	FailureCount  int        `bun:"failure_count,notnull"`
+}
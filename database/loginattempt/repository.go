@@ -0,0 +1,128 @@
+package loginattempt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"dillmann.com.br/nginx-ignition/core/loginattempt"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+)
+
+const (
+	byKeyFilter   = "scope = ? AND identifier = ?"
+	expiredFilter = "?TableAlias.last_failure_at < ? AND " +
+		"(?TableAlias.locked_until IS NULL OR ?TableAlias.locked_until < ?)"
+)
+
+type repository struct {
+	database *database.Database
+}
+
+func New(db *database.Database) loginattempt.Repository {
+	return &repository{
+		database: db,
+	}
+}
+
+func (r *repository) FindByKey(
+	ctx context.Context,
+	scope loginattempt.Scope,
+	identifier string,
+) (*loginattempt.Attempt, error) {
+	var model attemptModel
+
+	err := r.database.Select(ctx).
+		Model(&model).
+		Where(byKeyFilter, string(scope), identifier).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomain(&model), nil
+}
+
+// The failures are counted by the database itself, so the concurrent attempts can't overwrite each
+// other's count. The count restarts when the last failure and the lockout are older than the window.
+func (r *repository) IncrementFailures(
+	ctx context.Context,
+	scope loginattempt.Scope,
+	identifier string,
+	now, windowStart time.Time,
+) (*loginattempt.Attempt, error) {
+	model := &attemptModel{
+		Scope:         string(scope),
+		Identifier:    identifier,
+		FailureCount:  1,
+		LastFailureAt: now,
+	}
+
+	err := r.database.Insert(ctx).
+		Model(model).
+		On("CONFLICT (scope, identifier) DO UPDATE").
+		Set(
+			"failure_count = CASE WHEN "+expiredFilter+" THEN 1 ELSE ?TableAlias.failure_count + 1 END",
+			windowStart,
+			windowStart,
+		).
+		Set(
+			"locked_until = CASE WHEN "+expiredFilter+" THEN NULL ELSE ?TableAlias.locked_until END",
+			windowStart,
+			windowStart,
+		).
+		Set("last_failure_at = EXCLUDED.last_failure_at").
+		Returning("*").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomain(model), nil
+}
+
+func (r *repository) ExtendLock(
+	ctx context.Context,
+	scope loginattempt.Scope,
+	identifier string,
+	lockedUntil time.Time,
+) error {
+	_, err := r.database.Update(ctx).
+		Model((*attemptModel)(nil)).
+		Set("locked_until = ?", lockedUntil).
+		Where(byKeyFilter, string(scope), identifier).
+		Where("(locked_until IS NULL OR locked_until < ?)", lockedUntil).
+		Exec(ctx)
+	return err
+}
+
+func (r *repository) DeleteByKey(
+	ctx context.Context,
+	scope loginattempt.Scope,
+	identifier string,
+) error {
+	_, err := r.database.Delete(ctx).
+		Model((*attemptModel)(nil)).
+		Where(byKeyFilter, string(scope), identifier).
+		Exec(ctx)
+	return err
+}
+
+func (r *repository) DeleteStale(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.database.Delete(ctx).
+		Model((*attemptModel)(nil)).
+		Where("last_failure_at < ?", before).
+		Where("(locked_until IS NULL OR locked_until < ?)", before).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := result.RowsAffected()
+	return int(count), err
+}
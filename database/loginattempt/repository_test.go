@@ -0,0 +1,156 @@
+package loginattempt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/loginattempt"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	"dillmann.com.br/nginx-ignition/database/common/testutils"
+)
+
+func Test_Repository(t *testing.T) {
+	testutils.RunWithMockedDatabases(t, runRepositoryTests)
+}
+
+func runRepositoryTests(t *testing.T, db *database.Database) {
+	repo := New(db)
+
+	t.Run("IncrementFailures", func(t *testing.T) {
+		t.Run("successfully saves a new attempt", func(t *testing.T) {
+			attempt := newAttempt(time.Now())
+
+			saved, err := repo.IncrementFailures(
+				t.Context(),
+				attempt.Scope,
+				attempt.Identifier,
+				attempt.LastFailureAt,
+				attempt.LastFailureAt.Add(-time.Hour),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, 1, saved.FailureCount)
+
+			saved, err = repo.FindByKey(t.Context(), attempt.Scope, attempt.Identifier)
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, 1, saved.FailureCount)
+			assert.True(t, attempt.LastFailureAt.Equal(saved.LastFailureAt))
+			assert.Nil(t, saved.LockedUntil)
+		})
+
+		t.Run("increments the failures of an existing attempt", func(t *testing.T) {
+			attempt := newAttempt(time.Now())
+			save(t, repo, attempt)
+
+			now := attempt.LastFailureAt.Add(time.Second)
+			saved, err := repo.IncrementFailures(
+				t.Context(),
+				attempt.Scope,
+				attempt.Identifier,
+				now,
+				now.Add(-time.Hour),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, 2, saved.FailureCount)
+			assert.True(t, now.Equal(saved.LastFailureAt))
+		})
+
+		t.Run("restarts the count after the failure window", func(t *testing.T) {
+			attempt := newAttempt(time.Now().Add(-2 * time.Hour))
+			lockedUntil := attempt.LastFailureAt.Add(time.Minute)
+			attempt.LockedUntil = &lockedUntil
+			save(t, repo, attempt)
+
+			now := time.Now().UTC().Truncate(time.Second)
+			saved, err := repo.IncrementFailures(
+				t.Context(),
+				attempt.Scope,
+				attempt.Identifier,
+				now,
+				now.Add(-time.Hour),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, 1, saved.FailureCount)
+			assert.Nil(t, saved.LockedUntil)
+		})
+	})
+
+	t.Run("ExtendLock", func(t *testing.T) {
+		t.Run("never shortens an existing lockout", func(t *testing.T) {
+			attempt := newAttempt(time.Now())
+			save(t, repo, attempt)
+
+			longer := attempt.LastFailureAt.Add(time.Hour)
+			shorter := attempt.LastFailureAt.Add(time.Minute)
+			require.NoError(t, repo.ExtendLock(
+				t.Context(),
+				attempt.Scope,
+				attempt.Identifier,
+				longer,
+			))
+			require.NoError(t, repo.ExtendLock(
+				t.Context(),
+				attempt.Scope,
+				attempt.Identifier,
+				shorter,
+			))
+
+			saved, err := repo.FindByKey(t.Context(), attempt.Scope, attempt.Identifier)
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			require.NotNil(t, saved.LockedUntil)
+			assert.True(t, longer.Equal(*saved.LockedUntil))
+		})
+	})
+
+	t.Run("FindByKey", func(t *testing.T) {
+		t.Run("returns nil if not found", func(t *testing.T) {
+			saved, err := repo.FindByKey(t.Context(), loginattempt.AddressScope, "10.255.255.1")
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+	})
+
+	t.Run("DeleteByKey", func(t *testing.T) {
+		t.Run("successfully deletes the attempt", func(t *testing.T) {
+			attempt := newAttempt(time.Now())
+			save(t, repo, attempt)
+
+			require.NoError(t, repo.DeleteByKey(t.Context(), attempt.Scope, attempt.Identifier))
+
+			saved, err := repo.FindByKey(t.Context(), attempt.Scope, attempt.Identifier)
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+	})
+
+	t.Run("DeleteStale", func(t *testing.T) {
+		t.Run("deletes only the attempts older than the threshold", func(t *testing.T) {
+			stale := newAttempt(time.Now().Add(-2 * time.Hour))
+			locked := newAttempt(time.Now().Add(-2 * time.Hour))
+			lockedUntil := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+			locked.LockedUntil = &lockedUntil
+			recent := newAttempt(time.Now())
+			save(t, repo, stale)
+			save(t, repo, locked)
+			save(t, repo, recent)
+
+			count, err := repo.DeleteStale(t.Context(), time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, count, 1)
+
+			for attempt, expected := range map[*loginattempt.Attempt]bool{
+				stale:  false,
+				locked: true,
+				recent: true,
+			} {
+				saved, err := repo.FindByKey(t.Context(), attempt.Scope, attempt.Identifier)
+				require.NoError(t, err)
+				assert.Equal(t, expected, saved != nil)
+			}
+		})
+	})
+}
@@ -0,0 +1,25 @@
+package ratelimit
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+)
+
+func newRateLimit() *ratelimit.RateLimit {
+	return &ratelimit.RateLimit{
+		ID:                 uuid.New(),
+		Name:               "Test rate limit",
+		KeyType:            ratelimit.HeaderKeyType,
+		KeyName:            new("X-Api-Key"),
+		Rate:               10,
+		RateUnit:           ratelimit.PerSecondRateUnit,
+		Burst:              20,
+		NoDelay:            true,
+		ResponseStatusCode: 429,
+		ConnectionLimit: ratelimit.ConnectionLimit{
+			Enabled:            true,
+			MaximumConnections: new(50),
+		},
+	}
+}
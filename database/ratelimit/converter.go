@@ -0,0 +1,39 @@
+package ratelimit
+
+import (
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+)
+
+func toDomain(model *rateLimitModel) ratelimit.RateLimit {
+	return ratelimit.RateLimit{
+		ID:                 model.ID,
+		Name:               model.Name,
+		KeyType:            ratelimit.KeyType(model.KeyType),
+		KeyName:            model.KeyName,
+		Rate:               model.Rate,
+		RateUnit:           ratelimit.RateUnit(model.RateUnit),
+		Burst:              model.Burst,
+		NoDelay:            model.NoDelay,
+		ResponseStatusCode: model.ResponseStatusCode,
+		ConnectionLimit: ratelimit.ConnectionLimit{
+			Enabled:            model.ConnectionLimitEnabled,
+			MaximumConnections: model.MaximumConnections,
+		},
+	}
+}
+
+func toModel(domain *ratelimit.RateLimit) rateLimitModel {
+	return rateLimitModel{
+		ID:                     domain.ID,
+		Name:                   domain.Name,
+		KeyType:                string(domain.KeyType),
+		KeyName:                domain.KeyName,
+		Rate:                   domain.Rate,
+		RateUnit:               string(domain.RateUnit),
+		Burst:                  domain.Burst,
+		NoDelay:                domain.NoDelay,
+		ResponseStatusCode:     domain.ResponseStatusCode,
+		ConnectionLimitEnabled: domain.ConnectionLimit.Enabled,
+		MaximumConnections:     domain.ConnectionLimit.MaximumConnections,
+	}
+}
@@ -0,0 +1,22 @@
+package ratelimit
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type rateLimitModel struct {
+	bun.BaseModel `bun:"rate_limit"`
+
+	KeyName                *string   `bun:"key_name"`
+	MaximumConnections     *int      `bun:"maximum_connections"`
+	Name                   string    `bun:"name,notnull"`
+	KeyType                string    `bun:"key_type,notnull"`
+	RateUnit               string    `bun:"rate_unit,notnull"`
+	Rate                   int       `bun:"rate,notnull"`
+	Burst                  int       `bun:"burst,notnull"`
+	ResponseStatusCode     int       `bun:"response_status_code,notnull"`
+	ID                     uuid.UUID `bun:"id,pk"`
+	NoDelay                bool      `bun:"no_delay,notnull"`
+	ConnectionLimitEnabled bool      `bun:"connection_limit_enabled,notnull"`
+}
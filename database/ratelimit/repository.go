@@ -0,0 +1,177 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/ratelimit"
+	"dillmann.com.br/nginx-ignition/database/common/constants"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+)
+
+const (
+	byRateLimitIDFilter = "rate_limit_id = ?"
+)
+
+type repository struct {
+	database *database.Database
+}
+
+func New(db *database.Database) ratelimit.Repository {
+	return &repository{
+		database: db,
+	}
+}
+
+func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*ratelimit.RateLimit, error) {
+	var model rateLimitModel
+
+	err := r.database.Select(ctx).
+		Model(&model).
+		Where(constants.ByIDFilter, id).
+		Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return new(toDomain(&model)), nil
+}
+
+func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (bool, error) {
+	hostExists, err := r.database.Select(ctx).
+		Table("host").
+		Where(byRateLimitIDFilter, id).
+		Exists(ctx)
+	if err != nil || hostExists {
+		return hostExists, err
+	}
+
+	return r.database.Select(ctx).
+		Table("host_route").
+		Where(byRateLimitIDFilter, id).
+		Exists(ctx)
+}
+
+func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.database.Select(ctx).
+		Model((*rateLimitModel)(nil)).
+		Where(constants.ByIDFilter, id).
+		Exists(ctx)
+}
+
+func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	_, err := r.database.Delete(ctx).
+		Model((*rateLimitModel)(nil)).
+		Where(constants.ByIDFilter, id).
+		Exec(ctx)
+
+	return err
+}
+
+func (r *repository) FindPage(
+	ctx context.Context,
+	pageNumber, pageSize int,
+	searchTerms *string,
+) (*pagination.Page[ratelimit.RateLimit], error) {
+	models := make([]rateLimitModel, 0)
+
+	query := r.database.Select(ctx).Model(&models)
+	if searchTerms != nil {
+		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+*searchTerms+"%")
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = query.
+		Limit(pageSize).
+		Offset(pageSize * pageNumber).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ratelimit.RateLimit, 0)
+	for _, model := range models {
+		result = append(result, toDomain(&model))
+	}
+
+	return pagination.New(pageNumber, pageSize, count, result), nil
+}
+
+func (r *repository) FindAllInUse(ctx context.Context) ([]ratelimit.RateLimit, error) {
+	models := make([]rateLimitModel, 0)
+
+	hostSubquery := r.database.
+		Select(ctx).
+		Table("host").
+		Column("rate_limit_id").
+		Where("rate_limit_id is not null")
+	routeSubquery := r.database.
+		Select(ctx).
+		Table("host_route").
+		Column("rate_limit_id").
+		Where("rate_limit_id is not null")
+
+	err := r.database.Select(ctx).
+		Model(&models).
+		Where("id in (?)", hostSubquery).
+		WhereOr("id in (?)", routeSubquery).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ratelimit.RateLimit, len(models))
+	for index, model := range models {
+		result[index] = toDomain(&model)
+	}
+
+	return result, nil
+}
+
+func (r *repository) Save(ctx context.Context, domain *ratelimit.RateLimit) error {
+	transaction, err := r.database.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer transaction.Rollback()
+
+	exists, err := transaction.NewSelect().
+		Model((*rateLimitModel)(nil)).
+		Where(constants.ByIDFilter, domain.ID).
+		Exists(ctx)
+	if err != nil {
+		return err
+	}
+
+	model := toModel(domain)
+	if exists {
+		_, err = transaction.NewUpdate().
+			Model(&model).
+			Where(constants.ByIDFilter, model.ID).
+			Exec(ctx)
+	} else {
+		_, err = transaction.NewInsert().Model(&model).Exec(ctx)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
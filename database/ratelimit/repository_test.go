@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	"dillmann.com.br/nginx-ignition/database/common/testutils"
+)
+
+func Test_Repository(t *testing.T) {
+	testutils.RunWithMockedDatabases(t, runRepositoryTests)
+}
+
+func runRepositoryTests(t *testing.T, db *database.Database) {
+	repo := New(db)
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("successfully saves a new rate limit", func(t *testing.T) {
+			rateLimit := newRateLimit()
+
+			err := repo.Save(t.Context(), rateLimit)
+			require.NoError(t, err)
+
+			saved, err := repo.FindByID(t.Context(), rateLimit.ID)
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, *rateLimit, *saved)
+		})
+
+		t.Run("successfully updates an existing rate limit", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			require.NoError(t, repo.Save(t.Context(), rateLimit))
+
+			rateLimit.Name = "Updated Name"
+			rateLimit.NoDelay = false
+			err := repo.Save(t.Context(), rateLimit)
+			require.NoError(t, err)
+
+			saved, err := repo.FindByID(t.Context(), rateLimit.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "Updated Name", saved.Name)
+			assert.False(t, saved.NoDelay)
+		})
+	})
+
+	t.Run("FindByID", func(t *testing.T) {
+		t.Run("returns nil when not exists", func(t *testing.T) {
+			saved, err := repo.FindByID(t.Context(), uuid.New())
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+	})
+
+	t.Run("FindPage", func(t *testing.T) {
+		t.Run("returns a page of rate limits filtered by name", func(t *testing.T) {
+			prefix := uuid.New().String()
+			for _, name := range []string{prefix + "Alpha", prefix + "Beta"} {
+				rateLimit := newRateLimit()
+				rateLimit.Name = name
+				require.NoError(t, repo.Save(t.Context(), rateLimit))
+			}
+
+			other := newRateLimit()
+			other.Name = "Other" + uuid.New().String()
+			require.NoError(t, repo.Save(t.Context(), other))
+
+			page, err := repo.FindPage(t.Context(), 0, 10, new(prefix))
+			require.NoError(t, err)
+
+			assert.Equal(t, 2, page.TotalItems)
+			for _, item := range page.Contents {
+				assert.Contains(t, item.Name, prefix)
+			}
+		})
+	})
+
+	t.Run("DeleteByID", func(t *testing.T) {
+		t.Run("removes the rate limit", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			require.NoError(t, repo.Save(t.Context(), rateLimit))
+
+			err := repo.DeleteByID(t.Context(), rateLimit.ID)
+			require.NoError(t, err)
+
+			exists, err := repo.ExistsByID(t.Context(), rateLimit.ID)
+			require.NoError(t, err)
+			assert.False(t, exists)
+		})
+	})
+
+	t.Run("InUseByID", func(t *testing.T) {
+		t.Run("returns false when not in use", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			require.NoError(t, repo.Save(t.Context(), rateLimit))
+
+			inUse, err := repo.InUseByID(t.Context(), rateLimit.ID)
+			require.NoError(t, err)
+			assert.False(t, inUse)
+		})
+	})
+
+	t.Run("FindAllInUse", func(t *testing.T) {
+		t.Run("returns empty list when no rate limits in use", func(t *testing.T) {
+			rateLimit := newRateLimit()
+			require.NoError(t, repo.Save(t.Context(), rateLimit))
+
+			inUseList, err := repo.FindAllInUse(t.Context())
+			require.NoError(t, err)
+			assert.Empty(t, inUseList)
+		})
+	})
+}
@@ -0,0 +1,33 @@
+package revision
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/host"
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+func newRevision(createdAt time.Time) *revision.Revision {
+	return &revision.Revision{
+		ID:        uuid.New(),
+		CreatedAt: createdAt.UTC().Truncate(time.Second),
+		Checksum:  uuid.NewString(),
+		Files: []revision.File{
+			{
+				Name:     "nginx.conf",
+				Contents: "events {}",
+			},
+		},
+		Snapshot: &revision.Snapshot{
+			Hosts: []host.Host{
+				{
+					ID:          uuid.New(),
+					Enabled:     true,
+					DomainNames: []string{"example.com"},
+				},
+			},
+		},
+	}
+}
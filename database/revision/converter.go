@@ -0,0 +1,55 @@
+package revision
+
+import (
+	"encoding/json"
+
+	"dillmann.com.br/nginx-ignition/core/revision"
+)
+
+func toDomain(model *revisionModel) (*revision.Revision, error) {
+	var files []revision.File
+	if err := json.Unmarshal([]byte(model.Files), &files); err != nil {
+		return nil, err
+	}
+
+	var snapshot revision.Snapshot
+	if err := json.Unmarshal([]byte(model.Snapshot), &snapshot); err != nil {
+		return nil, err
+	}
+
+	return &revision.Revision{
+		ID:        model.ID,
+		CreatedAt: model.CreatedAt,
+		Checksum:  model.Checksum,
+		Files:     files,
+		Snapshot:  &snapshot,
+	}, nil
+}
+
+func toSummary(model *revisionModel) revision.Summary {
+	return revision.Summary{
+		ID:        model.ID,
+		CreatedAt: model.CreatedAt,
+		Checksum:  model.Checksum,
+	}
+}
+
+func toModel(domain *revision.Revision) (*revisionModel, error) {
+	files, err := json.Marshal(domain.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := json.Marshal(domain.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &revisionModel{
+		ID:        domain.ID,
+		CreatedAt: domain.CreatedAt,
+		Checksum:  domain.Checksum,
+		Files:     string(files),
+		Snapshot:  string(snapshot),
+	}, nil
+}
@@ -0,0 +1,18 @@
+package revision
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type revisionModel struct {
+	bun.BaseModel `bun:"configuration_revision"`
+
+	CreatedAt time.Time `bun:"created_at"`
+	Checksum  string    `bun:"checksum"`
+	Files     string    `bun:"files"`
+	Snapshot  string    `bun:"snapshot"`
+	ID        uuid.UUID `bun:"id,pk"`
+}
@@ -0,0 +1,125 @@
+package revision
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/revision"
+	"dillmann.com.br/nginx-ignition/database/common/constants"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+)
+
+const (
+	summaryColumns      = "id, created_at, checksum"
+	newestFirstOrdering = "created_at DESC"
+	notInSubqueryFilter = "id NOT IN (?)"
+)
+
+type repository struct {
+	database *database.Database
+}
+
+func New(db *database.Database) revision.Repository {
+	return &repository{
+		database: db,
+	}
+}
+
+func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*revision.Revision, error) {
+	var model revisionModel
+
+	err := r.database.Select(ctx).
+		Model(&model).
+		Where(constants.ByIDFilter, id).
+		Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomain(&model)
+}
+
+func (r *repository) FindLatest(ctx context.Context) (*revision.Summary, error) {
+	var model revisionModel
+
+	err := r.database.Select(ctx).
+		Model(&model).
+		ColumnExpr(summaryColumns).
+		Order(newestFirstOrdering).
+		Limit(1).
+		Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return new(toSummary(&model)), nil
+}
+
+func (r *repository) FindPage(
+	ctx context.Context,
+	pageNumber, pageSize int,
+) (*pagination.Page[revision.Summary], error) {
+	models := make([]revisionModel, 0)
+
+	query := r.database.Select(ctx).Model(&models)
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = query.
+		ColumnExpr(summaryColumns).
+		Limit(pageSize).
+		Offset(pageSize * pageNumber).
+		Order(newestFirstOrdering).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]revision.Summary, len(models))
+	for index, model := range models {
+		result[index] = toSummary(&model)
+	}
+
+	return pagination.New(pageNumber, pageSize, count, result), nil
+}
+
+func (r *repository) DeleteOldest(ctx context.Context, amountToKeep int) error {
+	newestSubquery := r.database.Select(ctx).
+		Model((*revisionModel)(nil)).
+		Column("id").
+		Order(newestFirstOrdering).
+		Limit(amountToKeep)
+
+	_, err := r.database.Delete(ctx).
+		Model((*revisionModel)(nil)).
+		Where(notInSubqueryFilter, newestSubquery).
+		Exec(ctx)
+
+	return err
+}
+
+func (r *repository) Save(ctx context.Context, domain *revision.Revision) error {
+	model, err := toModel(domain)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.database.Insert(ctx).Model(model).Exec(ctx)
+	return err
+}
@@ -0,0 +1,97 @@
+package revision
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	"dillmann.com.br/nginx-ignition/database/common/testutils"
+)
+
+func Test_Repository(t *testing.T) {
+	testutils.RunWithMockedDatabases(t, runRepositoryTests)
+}
+
+func runRepositoryTests(t *testing.T, db *database.Database) {
+	repo := New(db)
+
+	t.Run("FindLatest", func(t *testing.T) {
+		t.Run("returns nil when there are no revisions", func(t *testing.T) {
+			latest, err := repo.FindLatest(t.Context())
+			require.NoError(t, err)
+			assert.Nil(t, latest)
+		})
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("successfully saves a new revision", func(t *testing.T) {
+			rev := newRevision(time.Now())
+
+			err := repo.Save(t.Context(), rev)
+			require.NoError(t, err)
+
+			saved, err := repo.FindByID(t.Context(), rev.ID)
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, rev.Checksum, saved.Checksum)
+			assert.Equal(t, rev.Files, saved.Files)
+			assert.True(t, rev.CreatedAt.Equal(saved.CreatedAt))
+			require.Len(t, saved.Snapshot.Hosts, 1)
+			assert.Equal(t, rev.Snapshot.Hosts[0].ID, saved.Snapshot.Hosts[0].ID)
+			assert.Equal(t, rev.Snapshot.Hosts[0].DomainNames, saved.Snapshot.Hosts[0].DomainNames)
+		})
+	})
+
+	t.Run("FindByID", func(t *testing.T) {
+		t.Run("returns nil when the revision does not exist", func(t *testing.T) {
+			saved, err := repo.FindByID(t.Context(), uuid.New())
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+	})
+
+	t.Run("FindLatest", func(t *testing.T) {
+		t.Run("returns the most recent revision", func(t *testing.T) {
+			rev := newRevision(time.Now().Add(time.Hour))
+			require.NoError(t, repo.Save(t.Context(), rev))
+
+			latest, err := repo.FindLatest(t.Context())
+			require.NoError(t, err)
+			require.NotNil(t, latest)
+			assert.Equal(t, rev.ID, latest.ID)
+			assert.Equal(t, rev.Checksum, latest.Checksum)
+		})
+	})
+
+	t.Run("FindPage", func(t *testing.T) {
+		t.Run("returns the revisions with the newest first", func(t *testing.T) {
+			newest := newRevision(time.Now().Add(2 * time.Hour))
+			require.NoError(t, repo.Save(t.Context(), newest))
+
+			page, err := repo.FindPage(t.Context(), 0, 10)
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, page.TotalItems, 3)
+			require.NotEmpty(t, page.Contents)
+			assert.Equal(t, newest.ID, page.Contents[0].ID)
+		})
+	})
+
+	t.Run("DeleteOldest", func(t *testing.T) {
+		t.Run("keeps only the given amount of revisions", func(t *testing.T) {
+			newest := newRevision(time.Now().Add(3 * time.Hour))
+			require.NoError(t, repo.Save(t.Context(), newest))
+
+			err := repo.DeleteOldest(t.Context(), 2)
+			require.NoError(t, err)
+
+			page, err := repo.FindPage(t.Context(), 0, 10)
+			require.NoError(t, err)
+			assert.Equal(t, 2, page.TotalItems)
+			assert.Equal(t, newest.ID, page.Contents[0].ID)
+		})
+	})
+}
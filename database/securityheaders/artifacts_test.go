@@ -0,0 +1,42 @@
+package securityheaders
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+func newSecurityHeaders() *securityheaders.SecurityHeaders {
+	return &securityheaders.SecurityHeaders{
+		ID:                 uuid.New(),
+		Name:               "Test security headers",
+		FrameOptions:       new(securityheaders.SameOriginFrameOptions),
+		ReferrerPolicy:     new(securityheaders.StrictOriginWhenCrossOriginPolicy),
+		PermissionsPolicy:  new("camera=()"),
+		ContentTypeNoSniff: true,
+		ContentSecurityPolicy: securityheaders.ContentSecurityPolicy{
+			Enabled:        true,
+			CollectReports: true,
+			ReportURI:      new("https://reports.example.com/csp"),
+			Directives: []securityheaders.Directive{
+				{Name: "default-src", Sources: []string{"'self'"}},
+				{Name: "upgrade-insecure-requests"},
+			},
+		},
+	}
+}
+
+func newReport(securityHeadersID uuid.UUID, createdAt time.Time) *securityheaders.Report {
+	return &securityheaders.Report{
+		ID:                 uuid.New(),
+		SecurityHeadersID:  securityHeadersID,
+		CreatedAt:          createdAt,
+		DocumentURI:        new("https://example.com/"),
+		BlockedURI:         new("https://cdn.example.com/script.js"),
+		EffectiveDirective: new("script-src"),
+		Disposition:        new("enforce"),
+		LineNumber:         new(10),
+	}
+}
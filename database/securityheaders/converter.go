@@ -0,0 +1,128 @@
+package securityheaders
+
+import (
+	"encoding/json"
+
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+)
+
+func toDomain(model *securityHeadersModel) (*securityheaders.SecurityHeaders, error) {
+	directives, err := parseDirectives(model.CSPDirectives)
+	if err != nil {
+		return nil, err
+	}
+
+	return &securityheaders.SecurityHeaders{
+		ID:                 model.ID,
+		Name:               model.Name,
+		FrameOptions:       (*securityheaders.FrameOptions)(model.FrameOptions),
+		ReferrerPolicy:     (*securityheaders.ReferrerPolicy)(model.ReferrerPolicy),
+		PermissionsPolicy:  model.PermissionsPolicy,
+		ContentTypeNoSniff: model.ContentTypeNoSniff,
+		ContentSecurityPolicy: securityheaders.ContentSecurityPolicy{
+			Enabled:        model.CSPEnabled,
+			ReportOnly:     model.CSPReportOnly,
+			CollectReports: model.CSPCollectReports,
+			ReportURI:      model.CSPReportURI,
+			ReportTo:       model.CSPReportTo,
+			Directives:     directives,
+		},
+	}, nil
+}
+
+func toModel(domain *securityheaders.SecurityHeaders) (*securityHeadersModel, error) {
+	csp := &domain.ContentSecurityPolicy
+	directives, err := formatDirectives(csp.Directives)
+	if err != nil {
+		return nil, err
+	}
+
+	return &securityHeadersModel{
+		ID:                 domain.ID,
+		Name:               domain.Name,
+		FrameOptions:       (*string)(domain.FrameOptions),
+		ReferrerPolicy:     (*string)(domain.ReferrerPolicy),
+		PermissionsPolicy:  domain.PermissionsPolicy,
+		ContentTypeNoSniff: domain.ContentTypeNoSniff,
+		CSPEnabled:         csp.Enabled,
+		CSPReportOnly:      csp.ReportOnly,
+		CSPCollectReports:  csp.CollectReports,
+		CSPReportURI:       csp.ReportURI,
+		CSPReportTo:        csp.ReportTo,
+		CSPDirectives:      directives,
+	}, nil
+}
+
+func toReportDomain(model *reportModel) securityheaders.Report {
+	return securityheaders.Report{
+		ID:                 model.ID,
+		SecurityHeadersID:  model.SecurityHeadersID,
+		CreatedAt:          model.CreatedAt,
+		DocumentURI:        model.DocumentURI,
+		BlockedURI:         model.BlockedURI,
+		EffectiveDirective: model.EffectiveDirective,
+		OriginalPolicy:     model.OriginalPolicy,
+		Disposition:        model.Disposition,
+		SourceFile:         model.SourceFile,
+		LineNumber:         model.LineNumber,
+		UserAgent:          model.UserAgent,
+	}
+}
+
+func toReportModel(domain *securityheaders.Report) *reportModel {
+	return &reportModel{
+		ID:                 domain.ID,
+		SecurityHeadersID:  domain.SecurityHeadersID,
+		CreatedAt:          domain.CreatedAt,
+		DocumentURI:        domain.DocumentURI,
+		BlockedURI:         domain.BlockedURI,
+		EffectiveDirective: domain.EffectiveDirective,
+		OriginalPolicy:     domain.OriginalPolicy,
+		Disposition:        domain.Disposition,
+		SourceFile:         domain.SourceFile,
+		LineNumber:         domain.LineNumber,
+		UserAgent:          domain.UserAgent,
+	}
+}
+
+func parseDirectives(directives *string) ([]securityheaders.Directive, error) {
+	if directives == nil {
+		return nil, nil
+	}
+
+	var models []directiveModel
+	if err := json.Unmarshal([]byte(*directives), &models); err != nil {
+		return nil, err
+	}
+
+	result := make([]securityheaders.Directive, len(models))
+	for index, model := range models {
+		result[index] = securityheaders.Directive{
+			Name:    model.Name,
+			Sources: model.Sources,
+		}
+	}
+
+	return result, nil
+}
+
+func formatDirectives(directives []securityheaders.Directive) (*string, error) {
+	if len(directives) == 0 {
+		return nil, nil
+	}
+
+	models := make([]directiveModel, len(directives))
+	for index, directive := range directives {
+		models[index] = directiveModel{
+			Name:    directive.Name,
+			Sources: directive.Sources,
+		}
+	}
+
+	result, err := json.Marshal(models)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(string(result)), nil
+}
@@ -0,0 +1,46 @@
+package securityheaders
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type securityHeadersModel struct {
+	bun.BaseModel `bun:"security_headers"`
+
+	FrameOptions       *string   `bun:"frame_options"`
+	ReferrerPolicy     *string   `bun:"referrer_policy"`
+	PermissionsPolicy  *string   `bun:"permissions_policy"`
+	CSPReportURI       *string   `bun:"csp_report_uri"`
+	CSPReportTo        *string   `bun:"csp_report_to"`
+	CSPDirectives      *string   `bun:"csp_directives"`
+	Name               string    `bun:"name,notnull"`
+	ID                 uuid.UUID `bun:"id,pk"`
+	ContentTypeNoSniff bool      `bun:"content_type_no_sniff,notnull"`
+	CSPEnabled         bool      `bun:"csp_enabled,notnull"`
+	CSPReportOnly      bool      `bun:"csp_report_only,notnull"`
+	CSPCollectReports  bool      `bun:"csp_collect_reports,notnull"`
+}
+
+type directiveModel struct {
+	Name    string   `json:"name"`
+	Sources []string `json:"sources,omitempty"`
+}
+
+type reportModel struct {
+	bun.BaseModel `bun:"security_headers_report"`
+
+	CreatedAt          time.Time `bun:"created_at,notnull"`
+	DocumentURI        *string   `bun:"document_uri"`
+	BlockedURI         *string   `bun:"blocked_uri"`
+	EffectiveDirective *string   `bun:"effective_directive"`
+	OriginalPolicy     *string   `bun:"original_policy"`
+	Disposition        *string   `bun:"disposition"`
+	SourceFile         *string   `bun:"source_file"`
+	LineNumber         *int      `bun:"line_number"`
+	UserAgent          *string   `bun:"user_agent"`
+	ID                 uuid.UUID `bun:"id,pk"`
+	SecurityHeadersID  uuid.UUID `bun:"security_headers_id,notnull"`
+}
@@ -0,0 +1,269 @@
+package securityheaders
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/securityheaders"
+	"dillmann.com.br/nginx-ignition/database/common/constants"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+)
+
+const (
+	bySecurityHeadersIDFilter = "security_headers_id = ?"
+	newestFirstOrdering       = "created_at DESC"
+	notInSubqueryFilter       = "id NOT IN (?)"
+)
+
+type repository struct {
+	database *database.Database
+}
+
+func New(db *database.Database) securityheaders.Repository {
+	return &repository{
+		database: db,
+	}
+}
+
+func (r *repository) FindByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (*securityheaders.SecurityHeaders, error) {
+	var model securityHeadersModel
+
+	err := r.database.Select(ctx).
+		Model(&model).
+		Where(constants.ByIDFilter, id).
+		Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomain(&model)
+}
+
+func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.database.Select(ctx).
+		Table("host").
+		Where(bySecurityHeadersIDFilter, id).
+		Exists(ctx)
+}
+
+func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.database.Select(ctx).
+		Model((*securityHeadersModel)(nil)).
+		Where(constants.ByIDFilter, id).
+		Exists(ctx)
+}
+
+func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	transaction, err := r.database.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer transaction.Rollback()
+
+	_, err = transaction.NewDelete().
+		Model((*reportModel)(nil)).
+		Where(bySecurityHeadersIDFilter, id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = transaction.NewDelete().
+		Model((*securityHeadersModel)(nil)).
+		Where(constants.ByIDFilter, id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+func (r *repository) FindPage(
+	ctx context.Context,
+	pageNumber, pageSize int,
+	searchTerms *string,
+) (*pagination.Page[securityheaders.SecurityHeaders], error) {
+	models := make([]securityHeadersModel, 0)
+
+	query := r.database.Select(ctx).Model(&models)
+	if searchTerms != nil {
+		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+*searchTerms+"%")
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = query.
+		Limit(pageSize).
+		Offset(pageSize * pageNumber).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]securityheaders.SecurityHeaders, 0)
+	for _, model := range models {
+		domain, err := toDomain(&model)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, *domain)
+	}
+
+	return pagination.New(pageNumber, pageSize, count, result), nil
+}
+
+func (r *repository) FindAllInUse(ctx context.Context) ([]securityheaders.SecurityHeaders, error) {
+	models := make([]securityHeadersModel, 0)
+
+	hostSubquery := r.database.
+		Select(ctx).
+		Table("host").
+		Column("security_headers_id").
+		Where("security_headers_id is not null")
+
+	err := r.database.Select(ctx).
+		Model(&models).
+		Where("id in (?)", hostSubquery).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]securityheaders.SecurityHeaders, len(models))
+	for index, model := range models {
+		domain, err := toDomain(&model)
+		if err != nil {
+			return nil, err
+		}
+
+		result[index] = *domain
+	}
+
+	return result, nil
+}
+
+func (r *repository) Save(ctx context.Context, domain *securityheaders.SecurityHeaders) error {
+	model, err := toModel(domain)
+	if err != nil {
+		return err
+	}
+
+	transaction, err := r.database.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer transaction.Rollback()
+
+	exists, err := transaction.NewSelect().
+		Model((*securityHeadersModel)(nil)).
+		Where(constants.ByIDFilter, domain.ID).
+		Exists(ctx)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		_, err = transaction.NewUpdate().
+			Model(model).
+			Where(constants.ByIDFilter, model.ID).
+			Exec(ctx)
+	} else {
+		_, err = transaction.NewInsert().Model(model).Exec(ctx)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+func (r *repository) SaveReport(ctx context.Context, report *securityheaders.Report) error {
+	_, err := r.database.Insert(ctx).Model(toReportModel(report)).Exec(ctx)
+	return err
+}
+
+func (r *repository) FindReportsPage(
+	ctx context.Context,
+	id uuid.UUID,
+	pageNumber, pageSize int,
+) (*pagination.Page[securityheaders.Report], error) {
+	models := make([]reportModel, 0)
+
+	query := r.database.Select(ctx).
+		Model(&models).
+		Where(bySecurityHeadersIDFilter, id)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = query.
+		Limit(pageSize).
+		Offset(pageSize * pageNumber).
+		Order(newestFirstOrdering).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]securityheaders.Report, len(models))
+	for index, model := range models {
+		result[index] = toReportDomain(&model)
+	}
+
+	return pagination.New(pageNumber, pageSize, count, result), nil
+}
+
+func (r *repository) DeleteReportsByID(ctx context.Context, id uuid.UUID) error {
+	_, err := r.database.Delete(ctx).
+		Model((*reportModel)(nil)).
+		Where(bySecurityHeadersIDFilter, id).
+		Exec(ctx)
+
+	return err
+}
+
+func (r *repository) DeleteExceedingReports(
+	ctx context.Context,
+	id uuid.UUID,
+	maximumAmount int,
+) error {
+	newestSubquery := r.database.Select(ctx).
+		Model((*reportModel)(nil)).
+		Column("id").
+		Where(bySecurityHeadersIDFilter, id).
+		Order(newestFirstOrdering).
+		Limit(maximumAmount)
+
+	_, err := r.database.Delete(ctx).
+		Model((*reportModel)(nil)).
+		Where(bySecurityHeadersIDFilter, id).
+		Where(notInSubqueryFilter, newestSubquery).
+		Exec(ctx)
+
+	return err
+}
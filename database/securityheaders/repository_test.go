@@ -0,0 +1,180 @@
+package securityheaders
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	"dillmann.com.br/nginx-ignition/database/common/testutils"
+)
+
+func Test_Repository(t *testing.T) {
+	testutils.RunWithMockedDatabases(t, runRepositoryTests)
+}
+
+func runRepositoryTests(t *testing.T, db *database.Database) {
+	repo := New(db)
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("successfully saves new security headers", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+
+			err := repo.Save(t.Context(), securityHeaders)
+			require.NoError(t, err)
+
+			saved, err := repo.FindByID(t.Context(), securityHeaders.ID)
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, *securityHeaders, *saved)
+		})
+
+		t.Run("successfully updates existing security headers", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			require.NoError(t, repo.Save(t.Context(), securityHeaders))
+
+			securityHeaders.Name = "Updated Name"
+			securityHeaders.ContentSecurityPolicy.ReportOnly = true
+			err := repo.Save(t.Context(), securityHeaders)
+			require.NoError(t, err)
+
+			saved, err := repo.FindByID(t.Context(), securityHeaders.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "Updated Name", saved.Name)
+			assert.True(t, saved.ContentSecurityPolicy.ReportOnly)
+		})
+	})
+
+	t.Run("FindByID", func(t *testing.T) {
+		t.Run("returns nil when not exists", func(t *testing.T) {
+			saved, err := repo.FindByID(t.Context(), uuid.New())
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+	})
+
+	t.Run("FindPage", func(t *testing.T) {
+		t.Run("returns a page of security headers filtered by name", func(t *testing.T) {
+			prefix := uuid.New().String()
+			for _, name := range []string{prefix + "Alpha", prefix + "Beta"} {
+				securityHeaders := newSecurityHeaders()
+				securityHeaders.Name = name
+				require.NoError(t, repo.Save(t.Context(), securityHeaders))
+			}
+
+			other := newSecurityHeaders()
+			other.Name = "Other" + uuid.New().String()
+			require.NoError(t, repo.Save(t.Context(), other))
+
+			page, err := repo.FindPage(t.Context(), 0, 10, new(prefix))
+			require.NoError(t, err)
+
+			assert.Equal(t, 2, page.TotalItems)
+			for _, item := range page.Contents {
+				assert.Contains(t, item.Name, prefix)
+			}
+		})
+	})
+
+	t.Run("DeleteByID", func(t *testing.T) {
+		t.Run("removes the security headers and their reports", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			require.NoError(t, repo.Save(t.Context(), securityHeaders))
+			require.NoError(
+				t,
+				repo.SaveReport(t.Context(), newReport(securityHeaders.ID, time.Now().UTC())),
+			)
+
+			err := repo.DeleteByID(t.Context(), securityHeaders.ID)
+			require.NoError(t, err)
+
+			exists, err := repo.ExistsByID(t.Context(), securityHeaders.ID)
+			require.NoError(t, err)
+			assert.False(t, exists)
+
+			page, err := repo.FindReportsPage(t.Context(), securityHeaders.ID, 0, 10)
+			require.NoError(t, err)
+			assert.Equal(t, 0, page.TotalItems)
+		})
+	})
+
+	t.Run("InUseByID", func(t *testing.T) {
+		t.Run("returns false when not in use", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			require.NoError(t, repo.Save(t.Context(), securityHeaders))
+
+			inUse, err := repo.InUseByID(t.Context(), securityHeaders.ID)
+			require.NoError(t, err)
+			assert.False(t, inUse)
+		})
+	})
+
+	t.Run("FindAllInUse", func(t *testing.T) {
+		t.Run("returns empty list when no security headers in use", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			require.NoError(t, repo.Save(t.Context(), securityHeaders))
+
+			inUseList, err := repo.FindAllInUse(t.Context())
+			require.NoError(t, err)
+			assert.Empty(t, inUseList)
+		})
+	})
+
+	t.Run("Reports", func(t *testing.T) {
+		t.Run("lists the newest reports first", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			require.NoError(t, repo.Save(t.Context(), securityHeaders))
+
+			now := time.Now().UTC().Truncate(time.Second)
+			older := newReport(securityHeaders.ID, now.Add(-time.Hour))
+			newer := newReport(securityHeaders.ID, now)
+			require.NoError(t, repo.SaveReport(t.Context(), older))
+			require.NoError(t, repo.SaveReport(t.Context(), newer))
+
+			page, err := repo.FindReportsPage(t.Context(), securityHeaders.ID, 0, 10)
+			require.NoError(t, err)
+
+			require.Equal(t, 2, page.TotalItems)
+			assert.Equal(t, newer.ID, page.Contents[0].ID)
+			assert.Equal(t, older.ID, page.Contents[1].ID)
+		})
+
+		t.Run("keeps only the newest reports when exceeding the limit", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			require.NoError(t, repo.Save(t.Context(), securityHeaders))
+
+			now := time.Now().UTC().Truncate(time.Second)
+			older := newReport(securityHeaders.ID, now.Add(-time.Hour))
+			newer := newReport(securityHeaders.ID, now)
+			require.NoError(t, repo.SaveReport(t.Context(), older))
+			require.NoError(t, repo.SaveReport(t.Context(), newer))
+
+			err := repo.DeleteExceedingReports(t.Context(), securityHeaders.ID, 1)
+			require.NoError(t, err)
+
+			page, err := repo.FindReportsPage(t.Context(), securityHeaders.ID, 0, 10)
+			require.NoError(t, err)
+			require.Equal(t, 1, page.TotalItems)
+			assert.Equal(t, newer.ID, page.Contents[0].ID)
+		})
+
+		t.Run("removes all reports of the security headers", func(t *testing.T) {
+			securityHeaders := newSecurityHeaders()
+			require.NoError(t, repo.Save(t.Context(), securityHeaders))
+			require.NoError(
+				t,
+				repo.SaveReport(t.Context(), newReport(securityHeaders.ID, time.Now().UTC())),
+			)
+
+			err := repo.DeleteReportsByID(t.Context(), securityHeaders.ID)
+			require.NoError(t, err)
+
+			page, err := repo.FindReportsPage(t.Context(), securityHeaders.ID, 0, 10)
+			require.NoError(t, err)
+			assert.Equal(t, 0, page.TotalItems)
+		})
+	})
+}
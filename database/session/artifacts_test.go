@@ -0,0 +1,57 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/session"
+	"dillmann.com.br/nginx-ignition/core/user"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	userrepository "dillmann.com.br/nginx-ignition/database/user"
+)
+
+func newSession(userID uuid.UUID, expiresAt time.Time) *session.Session {
+	return &session.Session{
+		ID:            uuid.New(),
+		UserID:        userID,
+		UserAgent:     "Mozilla/5.0",
+		SourceAddress: "192.168.0.10",
+		CreatedAt:     time.Now().UTC().Truncate(time.Second),
+		ExpiresAt:     expiresAt.UTC().Truncate(time.Second),
+	}
+}
+
+func newOwner(ctx context.Context, db *database.Database) (*user.User, error) {
+	owner := &user.User{
+		ID:           uuid.New(),
+		Name:         "Session Owner",
+		Username:     "owner-" + uuid.New().String(),
+		PasswordHash: "hash",
+		PasswordSalt: "salt",
+		Enabled:      true,
+		Permissions: user.Permissions{
+			Hosts:           user.ReadWriteAccessLevel,
+			Streams:         user.ReadWriteAccessLevel,
+			Certificates:    user.ReadWriteAccessLevel,
+			Logs:            user.ReadOnlyAccessLevel,
+			Integrations:    user.ReadWriteAccessLevel,
+			AccessLists:     user.ReadWriteAccessLevel,
+			Settings:        user.ReadWriteAccessLevel,
+			Users:           user.ReadWriteAccessLevel,
+			NginxServer:     user.ReadWriteAccessLevel,
+			ExportData:      user.ReadOnlyAccessLevel,
+			VPNs:            user.ReadWriteAccessLevel,
+			Caches:          user.ReadWriteAccessLevel,
+			Upstreams:       user.ReadWriteAccessLevel,
+			TLSProfiles:     user.ReadWriteAccessLevel,
+			RateLimits:      user.ReadWriteAccessLevel,
+			SecurityHeaders: user.ReadWriteAccessLevel,
+			TrafficStats:    user.ReadOnlyAccessLevel,
+			Audit:           user.ReadOnlyAccessLevel,
+		},
+	}
+
+	return owner, userrepository.New(db).Save(ctx, owner)
+}
@@ -0,0 +1,27 @@
+package session
+
+import (
+	"dillmann.com.br/nginx-ignition/core/session"
+)
+
+func toDomain(model *sessionModel) session.Session {
+	return session.Session{
+		ID:            model.ID,
+		UserID:        model.UserID,
+		UserAgent:     model.UserAgent,
+		SourceAddress: model.SourceAddress,
+		CreatedAt:     model.CreatedAt,
+		ExpiresAt:     model.ExpiresAt,
+	}
+}
+
+func toModel(domain *session.Session) sessionModel {
+	return sessionModel{
+		ID:            domain.ID,
+		UserID:        domain.UserID,
+		UserAgent:     domain.UserAgent,
+		SourceAddress: domain.SourceAddress,
+		CreatedAt:     domain.CreatedAt,
+		ExpiresAt:     domain.ExpiresAt,
+	}
+}
@@ -0,0 +1,19 @@
+package session
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type sessionModel struct {
+	bun.BaseModel `bun:"user_session"`
+
+	CreatedAt     time.Time `bun:"created_at,notnull"`
+	ExpiresAt     time.Time `bun:"expires_at,notnull"`
+	UserAgent     string    `bun:"user_agent,notnull"`
+	SourceAddress string    `bun:"source_address,notnull"`
+	ID            uuid.UUID `bun:"id,pk"`
+	UserID        uuid.UUID `bun:"user_id,notnull"`
+}
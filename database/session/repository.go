@@ -0,0 +1,121 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/session"
+	"dillmann.com.br/nginx-ignition/database/common/constants"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+)
+
+type repository struct {
+	database *database.Database
+}
+
+func New(db *database.Database) session.Repository {
+	return &repository{
+		database: db,
+	}
+}
+
+func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*session.Session, error) {
+	var model sessionModel
+
+	err := r.database.Select(ctx).Model(&model).Where(constants.ByIDFilter, id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return new(toDomain(&model)), nil
+}
+
+func (r *repository) FindActiveByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+	now time.Time,
+) ([]session.Session, error) {
+	models := make([]sessionModel, 0)
+
+	err := r.database.Select(ctx).
+		Model(&models).
+		Where("user_id = ?", userID).
+		Where("expires_at > ?", now).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]session.Session, len(models))
+	for index, model := range models {
+		result[index] = toDomain(&model)
+	}
+
+	return result, nil
+}
+
+func (r *repository) UpdateExpiresAt(
+	ctx context.Context,
+	id uuid.UUID,
+	expiresAt time.Time,
+) error {
+	_, err := r.database.Update(ctx).
+		Model((*sessionModel)(nil)).
+		Set("expires_at = ?", expiresAt).
+		Where(constants.ByIDFilter, id).
+		Exec(ctx)
+	return err
+}
+
+func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	_, err := r.database.Delete(ctx).
+		Model((*sessionModel)(nil)).
+		Where(constants.ByIDFilter, id).
+		Exec(ctx)
+	return err
+}
+
+func (r *repository) DeleteByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+	exceptID *uuid.UUID,
+) error {
+	query := r.database.Delete(ctx).
+		Model((*sessionModel)(nil)).
+		Where("user_id = ?", userID)
+
+	if exceptID != nil {
+		query = query.Where("id != ?", *exceptID)
+	}
+
+	_, err := query.Exec(ctx)
+	return err
+}
+
+func (r *repository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	result, err := r.database.Delete(ctx).
+		Model((*sessionModel)(nil)).
+		Where("expires_at <= ?", now).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := result.RowsAffected()
+	return int(count), err
+}
+
+func (r *repository) Save(ctx context.Context, domain *session.Session) error {
+	model := toModel(domain)
+	_, err := r.database.Insert(ctx).Model(&model).Exec(ctx)
+	return err
+}
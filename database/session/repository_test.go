@@ -0,0 +1,172 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	"dillmann.com.br/nginx-ignition/database/common/testutils"
+	userrepository "dillmann.com.br/nginx-ignition/database/user"
+)
+
+func Test_Repository(t *testing.T) {
+	testutils.RunWithMockedDatabases(t, runRepositoryTests)
+}
+
+func runRepositoryTests(t *testing.T, db *database.Database) {
+	repo := New(db)
+
+	owner, err := newOwner(t.Context(), db)
+	require.NoError(t, err)
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("successfully saves a new session", func(t *testing.T) {
+			sess := newSession(owner.ID, time.Now().Add(time.Hour))
+
+			require.NoError(t, repo.Save(t.Context(), sess))
+
+			saved, err := repo.FindByID(t.Context(), sess.ID)
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, sess.UserID, saved.UserID)
+			assert.Equal(t, sess.UserAgent, saved.UserAgent)
+			assert.Equal(t, sess.SourceAddress, saved.SourceAddress)
+			assert.True(t, sess.CreatedAt.Equal(saved.CreatedAt))
+			assert.True(t, sess.ExpiresAt.Equal(saved.ExpiresAt))
+		})
+	})
+
+	t.Run("FindByID", func(t *testing.T) {
+		t.Run("returns nil if not found", func(t *testing.T) {
+			saved, err := repo.FindByID(t.Context(), uuid.New())
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+	})
+
+	t.Run("FindActiveByUserID", func(t *testing.T) {
+		t.Run("returns only the non-expired sessions of the user", func(t *testing.T) {
+			user, err := newOwner(t.Context(), db)
+			require.NoError(t, err)
+
+			active := newSession(user.ID, time.Now().Add(time.Hour))
+			expired := newSession(user.ID, time.Now().Add(-time.Hour))
+			other := newSession(owner.ID, time.Now().Add(time.Hour))
+			require.NoError(t, repo.Save(t.Context(), active))
+			require.NoError(t, repo.Save(t.Context(), expired))
+			require.NoError(t, repo.Save(t.Context(), other))
+
+			sessions, err := repo.FindActiveByUserID(t.Context(), user.ID, time.Now())
+			require.NoError(t, err)
+			require.Len(t, sessions, 1)
+			assert.Equal(t, active.ID, sessions[0].ID)
+		})
+	})
+
+	t.Run("UpdateExpiresAt", func(t *testing.T) {
+		t.Run("updates the expiration date", func(t *testing.T) {
+			sess := newSession(owner.ID, time.Now().Add(time.Hour))
+			require.NoError(t, repo.Save(t.Context(), sess))
+
+			expiresAt := time.Now().UTC().Add(2 * time.Hour).Truncate(time.Second)
+			require.NoError(t, repo.UpdateExpiresAt(t.Context(), sess.ID, expiresAt))
+
+			saved, err := repo.FindByID(t.Context(), sess.ID)
+			require.NoError(t, err)
+			assert.True(t, expiresAt.Equal(saved.ExpiresAt))
+		})
+	})
+
+	t.Run("DeleteByID", func(t *testing.T) {
+		t.Run("deletes the session", func(t *testing.T) {
+			sess := newSession(owner.ID, time.Now().Add(time.Hour))
+			require.NoError(t, repo.Save(t.Context(), sess))
+
+			require.NoError(t, repo.DeleteByID(t.Context(), sess.ID))
+
+			saved, err := repo.FindByID(t.Context(), sess.ID)
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+	})
+
+	t.Run("DeleteByUserID", func(t *testing.T) {
+		t.Run("deletes all the sessions of the user except the informed one", func(t *testing.T) {
+			user, err := newOwner(t.Context(), db)
+			require.NoError(t, err)
+
+			kept := newSession(user.ID, time.Now().Add(time.Hour))
+			deleted := newSession(user.ID, time.Now().Add(time.Hour))
+			require.NoError(t, repo.Save(t.Context(), kept))
+			require.NoError(t, repo.Save(t.Context(), deleted))
+
+			require.NoError(t, repo.DeleteByUserID(t.Context(), user.ID, &kept.ID))
+
+			sessions, err := repo.FindActiveByUserID(t.Context(), user.ID, time.Now())
+			require.NoError(t, err)
+			require.Len(t, sessions, 1)
+			assert.Equal(t, kept.ID, sessions[0].ID)
+		})
+
+		t.Run("deletes all the sessions of the user", func(t *testing.T) {
+			user, err := newOwner(t.Context(), db)
+			require.NoError(t, err)
+
+			require.NoError(
+				t,
+				repo.Save(t.Context(), newSession(user.ID, time.Now().Add(time.Hour))),
+			)
+			require.NoError(
+				t,
+				repo.Save(t.Context(), newSession(user.ID, time.Now().Add(time.Hour))),
+			)
+
+			require.NoError(t, repo.DeleteByUserID(t.Context(), user.ID, nil))
+
+			sessions, err := repo.FindActiveByUserID(t.Context(), user.ID, time.Now())
+			require.NoError(t, err)
+			assert.Empty(t, sessions)
+		})
+	})
+
+	t.Run("DeleteExpired", func(t *testing.T) {
+		t.Run("deletes only the expired sessions", func(t *testing.T) {
+			active := newSession(owner.ID, time.Now().Add(time.Hour))
+			expired := newSession(owner.ID, time.Now().Add(-time.Hour))
+			require.NoError(t, repo.Save(t.Context(), active))
+			require.NoError(t, repo.Save(t.Context(), expired))
+
+			count, err := repo.DeleteExpired(t.Context(), time.Now())
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, count, 1)
+
+			saved, err := repo.FindByID(t.Context(), expired.ID)
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+
+			saved, err = repo.FindByID(t.Context(), active.ID)
+			require.NoError(t, err)
+			assert.NotNil(t, saved)
+		})
+	})
+
+	t.Run("user deletion", func(t *testing.T) {
+		t.Run("deletes the sessions of the user", func(t *testing.T) {
+			user, err := newOwner(t.Context(), db)
+			require.NoError(t, err)
+
+			sess := newSession(user.ID, time.Now().Add(time.Hour))
+			require.NoError(t, repo.Save(t.Context(), sess))
+
+			require.NoError(t, userrepository.New(db).DeleteByID(t.Context(), user.ID))
+
+			saved, err := repo.FindByID(t.Context(), sess.ID)
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+	})
+}
@@ -28,8 +28,20 @@ func newSettings() *settings.Settings {
 				ClientHeaderKb: 1,
 			},
 			Logs: &settings.NginxLogsSettings{
-				ServerLogsLevel:   settings.WarnLogLevel,
-				ErrorLogsLevel:    settings.ErrorLogLevel,
+				ServerLogsLevel:  settings.WarnLogLevel,
+				ErrorLogsLevel:   settings.ErrorLogLevel,
+				AccessLogsFormat: settings.JSONAccessLogFormat,
+				Destinations: []settings.LogDestination{
+					{
+						Tag:        new("nginx"),
+						Type:       settings.SyslogLogDestinationType,
+						Protocol:   settings.UDPLogDestinationProtocol,
+						Address:    "127.0.0.1:514",
+						Facility:   "local7",
+						AccessLogs: true,
+						ErrorLogs:  true,
+					},
+				},
 				ServerLogsEnabled: true,
 				AccessLogsEnabled: true,
 				ErrorLogsEnabled:  true,
@@ -62,6 +74,22 @@ func newSettings() *settings.Settings {
 			IntervalUnitCount: 30,
 			Enabled:           true,
 		},
+		Backup: &settings.BackupSettings{
+			Destination:       settings.S3BackupDestination,
+			IntervalUnit:      settings.HoursTimeUnit,
+			IntervalUnitCount: 12,
+			MaximumCount:      14,
+			MaximumAgeDays:    30,
+			Enabled:           true,
+			S3: &settings.BackupS3Settings{
+				Endpoint:  "http://localhost:9000",
+				Region:    "us-east-1",
+				Bucket:    "backups",
+				Prefix:    new("nginx-ignition"),
+				AccessKey: "access-key",
+				SecretKey: new("secret-key"),
+			},
+		},
 		GlobalBindings: []binding.Binding{
 			{
 				Type: binding.HTTPBindingType,
@@ -1,6 +1,8 @@
 package settings
 
 import (
+	"github.com/google/uuid"
+
 	"dillmann.com.br/nginx-ignition/core/binding"
 	"dillmann.com.br/nginx-ignition/core/settings"
 )
@@ -9,9 +11,11 @@ func toDomain(
 	nginx *nginxModel,
 	logRotation *logRotationModel,
 	certificate *certificateModel,
+	backup *backupModel,
 	bindings []bindingModel,
 	buffers *buffersModel,
 	stats *statsModel,
+	destinations []logDestinationModel,
 ) *settings.Settings {
 	return &settings.Settings{
 		Nginx: &settings.NginxSettings{
@@ -21,6 +25,8 @@ func toDomain(
 				AccessLogsEnabled: nginx.AccessLogsEnabled,
 				ErrorLogsEnabled:  nginx.ErrorLogsEnabled,
 				ErrorLogsLevel:    settings.LogLevel(nginx.ErrorLogsLevel),
+				AccessLogsFormat:  settings.AccessLogFormat(nginx.AccessLogsFormat),
+				Destinations:      toLogDestinationDomain(destinations),
 			},
 			Timeouts: &settings.NginxTimeoutsSettings{
 				Read:       nginx.ReadTimeout,
@@ -70,10 +76,44 @@ func toDomain(
 			IntervalUnit:      settings.TimeUnit(certificate.IntervalUnit),
 			IntervalUnitCount: certificate.IntervalUnitCount,
 		},
+		Backup:         toBackupDomain(backup),
 		GlobalBindings: toBindingDomain(bindings),
 	}
 }
 
+func toBackupDomain(backup *backupModel) *settings.BackupSettings {
+	var s3 *settings.BackupS3Settings
+	if backup.S3Endpoint != nil {
+		s3 = &settings.BackupS3Settings{
+			Endpoint:  *backup.S3Endpoint,
+			Region:    valueOrEmpty(backup.S3Region),
+			Bucket:    valueOrEmpty(backup.S3Bucket),
+			Prefix:    backup.S3Prefix,
+			AccessKey: valueOrEmpty(backup.S3AccessKey),
+			SecretKey: backup.S3SecretKey,
+		}
+	}
+
+	return &settings.BackupSettings{
+		Enabled:           backup.Enabled,
+		IntervalUnit:      settings.TimeUnit(backup.IntervalUnit),
+		IntervalUnitCount: backup.IntervalUnitCount,
+		MaximumCount:      backup.MaximumCount,
+		MaximumAgeDays:    backup.MaximumAgeDays,
+		Destination:       settings.BackupDestination(backup.Destination),
+		LocalPath:         backup.LocalPath,
+		S3:                s3,
+	}
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
 func toBindingDomain(bindings []bindingModel) []binding.Binding {
 	result := make([]binding.Binding, 0, len(bindings))
 
@@ -84,6 +124,26 @@ func toBindingDomain(bindings []bindingModel) []binding.Binding {
 			IP:            b.IP,
 			Port:          b.Port,
 			CertificateID: b.CertificateID,
+			TLSProfileID:  b.TLSProfileID,
+		})
+	}
+
+	return result
+}
+
+func toLogDestinationDomain(destinations []logDestinationModel) []settings.LogDestination {
+	result := make([]settings.LogDestination, 0, len(destinations))
+
+	for _, d := range destinations {
+		result = append(result, settings.LogDestination{
+			HostID:     d.HostID,
+			Tag:        d.Tag,
+			Type:       settings.LogDestinationType(d.Type),
+			Protocol:   settings.LogDestinationProtocol(d.Protocol),
+			Address:    d.Address,
+			Facility:   d.Facility,
+			AccessLogs: d.AccessLogs,
+			ErrorLogs:  d.ErrorLogs,
 		})
 	}
 
@@ -94,9 +154,11 @@ func toModel(set *settings.Settings) (
 	*nginxModel,
 	*logRotationModel,
 	*certificateModel,
+	*backupModel,
 	[]bindingModel,
 	*buffersModel,
 	*statsModel,
+	[]logDestinationModel,
 ) {
 	nginx := &nginxModel{
 		ServerLogsEnabled:   set.Nginx.Logs.ServerLogsEnabled,
@@ -104,6 +166,7 @@ func toModel(set *settings.Settings) (
 		AccessLogsEnabled:   set.Nginx.Logs.AccessLogsEnabled,
 		ErrorLogsEnabled:    set.Nginx.Logs.ErrorLogsEnabled,
 		ErrorLogsLevel:      string(set.Nginx.Logs.ErrorLogsLevel),
+		AccessLogsFormat:    string(set.Nginx.Logs.AccessLogsFormat),
 		ReadTimeout:         set.Nginx.Timeouts.Read,
 		ConnectTimeout:      set.Nginx.Timeouts.Connect,
 		SendTimeout:         set.Nginx.Timeouts.Send,
@@ -134,6 +197,7 @@ func toModel(set *settings.Settings) (
 		IntervalUnitCount: set.CertificateAutoRenew.IntervalUnitCount,
 	}
 
+	backup := toBackupModel(set.Backup)
 	bindings := toBindingModel(set.GlobalBindings)
 
 	buffers := &buffersModel{
@@ -153,7 +217,32 @@ func toModel(set *settings.Settings) (
 		DatabaseLocation: set.Nginx.Stats.DatabaseLocation,
 	}
 
-	return nginx, logRotation, certificate, bindings, buffers, stats
+	destinations := toLogDestinationModel(set.Nginx.Logs.Destinations)
+
+	return nginx, logRotation, certificate, backup, bindings, buffers, stats, destinations
+}
+
+func toBackupModel(backup *settings.BackupSettings) *backupModel {
+	output := &backupModel{
+		Enabled:           backup.Enabled,
+		IntervalUnit:      string(backup.IntervalUnit),
+		IntervalUnitCount: backup.IntervalUnitCount,
+		MaximumCount:      backup.MaximumCount,
+		MaximumAgeDays:    backup.MaximumAgeDays,
+		Destination:       string(backup.Destination),
+		LocalPath:         backup.LocalPath,
+	}
+
+	if s3 := backup.S3; s3 != nil {
+		output.S3Endpoint = &s3.Endpoint
+		output.S3Region = &s3.Region
+		output.S3Bucket = &s3.Bucket
+		output.S3Prefix = s3.Prefix
+		output.S3AccessKey = &s3.AccessKey
+		output.S3SecretKey = s3.SecretKey
+	}
+
+	return output
 }
 
 func toBindingModel(bindings []binding.Binding) []bindingModel {
@@ -166,6 +255,28 @@ func toBindingModel(bindings []binding.Binding) []bindingModel {
 			IP:            b.IP,
 			Port:          b.Port,
 			CertificateID: b.CertificateID,
+			TLSProfileID:  b.TLSProfileID,
+		})
+	}
+
+	return result
+}
+
+func toLogDestinationModel(destinations []settings.LogDestination) []logDestinationModel {
+	result := make([]logDestinationModel, 0, len(destinations))
+
+	for index, d := range destinations {
+		result = append(result, logDestinationModel{
+			ID:         uuid.New(),
+			HostID:     d.HostID,
+			Tag:        d.Tag,
+			Type:       string(d.Type),
+			Protocol:   string(d.Protocol),
+			Address:    d.Address,
+			Facility:   d.Facility,
+			Position:   index,
+			AccessLogs: d.AccessLogs,
+			ErrorLogs:  d.ErrorLogs,
 		})
 	}
 
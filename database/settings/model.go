@@ -12,6 +12,7 @@ type nginxModel struct {
 	RuntimeUser         string    `bun:"runtime_user"`
 	DefaultContentType  string    `bun:"default_content_type"`
 	ErrorLogsLevel      string    `bun:"error_logs_level"`
+	AccessLogsFormat    string    `bun:"access_logs_format"`
 	ServerLogsLevel     string    `bun:"server_logs_level"`
 	WorkerConnections   int       `bun:"worker_connections"`
 	WorkerProcesses     int       `bun:"worker_processes"`
@@ -50,10 +51,30 @@ type certificateModel struct {
 	Enabled           bool      `bun:"enabled"`
 }
 
+type backupModel struct {
+	bun.BaseModel `bun:"settings_backup"`
+
+	LocalPath         *string   `bun:"local_path"`
+	S3Endpoint        *string   `bun:"s3_endpoint"`
+	S3Region          *string   `bun:"s3_region"`
+	S3Bucket          *string   `bun:"s3_bucket"`
+	S3Prefix          *string   `bun:"s3_prefix"`
+	S3AccessKey       *string   `bun:"s3_access_key"`
+	S3SecretKey       *string   `bun:"s3_secret_key"`
+	IntervalUnit      string    `bun:"interval_unit"`
+	Destination       string    `bun:"destination"`
+	IntervalUnitCount int       `bun:"interval_unit_count"`
+	MaximumCount      int       `bun:"maximum_count"`
+	MaximumAgeDays    int       `bun:"maximum_age_days"`
+	ID                uuid.UUID `bun:"id,pk"`
+	Enabled           bool      `bun:"enabled"`
+}
+
 type bindingModel struct {
 	bun.BaseModel `bun:"settings_global_binding"`
 
 	CertificateID *uuid.UUID `bun:"certificate_id"`
+	TLSProfileID  *uuid.UUID `bun:"tls_profile_id"`
 	Type          string     `bun:"type"`
 	IP            string     `bun:"ip"`
 	Port          int        `bun:"port"`
@@ -82,3 +103,18 @@ type statsModel struct {
 	Persistent       bool      `bun:"persistent"`
 	AllHosts         bool      `bun:"all_hosts"`
 }
+
+type logDestinationModel struct {
+	bun.BaseModel `bun:"settings_nginx_log_destination"`
+
+	HostID     *uuid.UUID `bun:"host_id"`
+	Tag        *string    `bun:"tag"`
+	Type       string     `bun:"type"`
+	Protocol   string     `bun:"protocol"`
+	Address    string     `bun:"address"`
+	Facility   string     `bun:"facility"`
+	Position   int        `bun:"position"`
+	ID         uuid.UUID  `bun:"id,pk"`
+	AccessLogs bool       `bun:"access_logs"`
+	ErrorLogs  bool       `bun:"error_logs"`
+}
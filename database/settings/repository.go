@@ -21,32 +21,42 @@ func New(db *database.Database) settings.Repository {
 
 func (r *repository) Get(ctx context.Context) (*settings.Settings, error) {
 	nginx := nginxModel{}
-	if err := r.database.Select().Model(&nginx).Scan(ctx); err != nil {
+	if err := r.database.Select(ctx).Model(&nginx).Scan(ctx); err != nil {
 		return nil, err
 	}
 
 	certificate := certificateModel{}
-	if err := r.database.Select().Model(&certificate).Scan(ctx); err != nil {
+	if err := r.database.Select(ctx).Model(&certificate).Scan(ctx); err != nil {
 		return nil, err
 	}
 
 	logRotation := logRotationModel{}
-	if err := r.database.Select().Model(&logRotation).Scan(ctx); err != nil {
+	if err := r.database.Select(ctx).Model(&logRotation).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	backup := backupModel{}
+	if err := r.database.Select(ctx).Model(&backup).Scan(ctx); err != nil {
 		return nil, err
 	}
 
 	bindings := make([]bindingModel, 0)
-	if err := r.database.Select().Model(&bindings).Scan(ctx); err != nil {
+	if err := r.database.Select(ctx).Model(&bindings).Scan(ctx); err != nil {
 		return nil, err
 	}
 
 	buffers := buffersModel{}
-	if err := r.database.Select().Model(&buffers).Scan(ctx); err != nil {
+	if err := r.database.Select(ctx).Model(&buffers).Scan(ctx); err != nil {
 		return nil, err
 	}
 
 	stats := statsModel{}
-	if err := r.database.Select().Model(&stats).Scan(ctx); err != nil {
+	if err := r.database.Select(ctx).Model(&stats).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	destinations := make([]logDestinationModel, 0)
+	if err := r.database.Select(ctx).Model(&destinations).Order("position").Scan(ctx); err != nil {
 		return nil, err
 	}
 
@@ -56,13 +66,22 @@ func (r *repository) Get(ctx context.Context) (*settings.Settings, error) {
 		}
 	}
 
-	return toDomain(&nginx, &logRotation, &certificate, bindings, &buffers, &stats), nil
+	return toDomain(
+		&nginx,
+		&logRotation,
+		&certificate,
+		&backup,
+		bindings,
+		&buffers,
+		&stats,
+		destinations,
+	), nil
 }
 
 func (r *repository) Save(ctx context.Context, set *settings.Settings) error {
-	nginx, logRotation, certificate, bindings, buffers, stats := toModel(set)
+	nginx, logRotation, certificate, backup, bindings, buffers, stats, destinations := toModel(set)
 
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -82,6 +101,10 @@ func (r *repository) Save(ctx context.Context, set *settings.Settings) error {
 		return err
 	}
 
+	if _, err = transaction.NewTruncateTable().Model(backup).Exec(ctx); err != nil {
+		return err
+	}
+
 	if _, err = transaction.NewTruncateTable().Model(&bindings).Exec(ctx); err != nil {
 		return err
 	}
@@ -94,6 +117,10 @@ func (r *repository) Save(ctx context.Context, set *settings.Settings) error {
 		return err
 	}
 
+	if _, err = transaction.NewTruncateTable().Model(&destinations).Exec(ctx); err != nil {
+		return err
+	}
+
 	if _, err = transaction.NewInsert().Model(nginx).Exec(ctx); err != nil {
 		return err
 	}
@@ -106,6 +133,10 @@ func (r *repository) Save(ctx context.Context, set *settings.Settings) error {
 		return err
 	}
 
+	if _, err = transaction.NewInsert().Model(backup).Exec(ctx); err != nil {
+		return err
+	}
+
 	if _, err = transaction.NewInsert().Model(&bindings).Exec(ctx); err != nil {
 		return err
 	}
@@ -118,5 +149,11 @@ func (r *repository) Save(ctx context.Context, set *settings.Settings) error {
 		return err
 	}
 
+	if len(destinations) > 0 {
+		if _, err = transaction.NewInsert().Model(&destinations).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
 	return transaction.Commit()
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"dillmann.com.br/nginx-ignition/core/settings"
 	"dillmann.com.br/nginx-ignition/database/common/database"
 	"dillmann.com.br/nginx-ignition/database/common/testutils"
 )
@@ -31,6 +32,7 @@ func runRepositoryTests(t *testing.T, db *database.Database) {
 			assert.Equal(t, cmd.Nginx, saved.Nginx)
 			assert.Equal(t, cmd.LogRotation, saved.LogRotation)
 			assert.Equal(t, cmd.CertificateAutoRenew, saved.CertificateAutoRenew)
+			assert.Equal(t, cmd.Backup, saved.Backup)
 			assert.ElementsMatch(t, cmd.GlobalBindings, saved.GlobalBindings)
 		})
 
@@ -48,5 +50,49 @@ func runRepositoryTests(t *testing.T, db *database.Database) {
 			assert.Equal(t, 4, saved.Nginx.WorkerProcesses)
 			assert.False(t, saved.Nginx.GzipEnabled)
 		})
+
+		t.Run("successfully saves local backup settings", func(t *testing.T) {
+			cmd := newSettings()
+			cmd.Backup.Destination = settings.LocalBackupDestination
+			cmd.Backup.LocalPath = new("/var/backups")
+			cmd.Backup.S3 = nil
+
+			require.NoError(t, repo.Save(t.Context(), cmd))
+
+			saved, err := repo.Get(t.Context())
+			require.NoError(t, err)
+			assert.Equal(t, cmd.Backup, saved.Backup)
+		})
+
+		t.Run("successfully saves log destinations in order", func(t *testing.T) {
+			cmd := newSettings()
+			cmd.Nginx.Logs.Destinations = append(
+				cmd.Nginx.Logs.Destinations,
+				settings.LogDestination{
+					Type:       settings.OTLPLogDestinationType,
+					Protocol:   settings.HTTPLogDestinationProtocol,
+					Address:    "http://localhost:4318/v1/logs",
+					AccessLogs: true,
+				},
+			)
+
+			require.NoError(t, repo.Save(t.Context(), cmd))
+
+			saved, err := repo.Get(t.Context())
+			require.NoError(t, err)
+			assert.Equal(t, cmd.Nginx.Logs.Destinations, saved.Nginx.Logs.Destinations)
+		})
+
+		t.Run("successfully removes all log destinations", func(t *testing.T) {
+			cmd := newSettings()
+			require.NoError(t, repo.Save(t.Context(), cmd))
+
+			cmd.Nginx.Logs.Destinations = nil
+			require.NoError(t, repo.Save(t.Context(), cmd))
+
+			saved, err := repo.Get(t.Context())
+			require.NoError(t, err)
+			assert.Empty(t, saved.Nginx.Logs.Destinations)
+		})
 	})
 }
@@ -34,7 +34,7 @@ func New(db *database.Database) stream.Repository {
 func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*stream.Stream, error) {
 	var model streamModel
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&model).
 		Where(constants.ByIDFilter, id).
 		Scan(ctx)
@@ -57,7 +57,7 @@ func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*stream.Stream
 }
 
 func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -88,7 +88,7 @@ func (r *repository) Save(ctx context.Context, strm *stream.Stream) error {
 		return err
 	}
 
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -126,7 +126,7 @@ func (r *repository) FindPage(
 ) (*pagination.Page[stream.Stream], error) {
 	models := make([]streamModel, 0)
 
-	query := r.database.Select().Model(&models)
+	query := r.database.Select(ctx).Model(&models)
 	if searchTerms != nil && *searchTerms != "" {
 		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+*searchTerms+"%")
 	}
@@ -162,7 +162,7 @@ func (r *repository) FindPage(
 func (r *repository) FindAllEnabled(ctx context.Context) ([]stream.Stream, error) {
 	models := make([]streamModel, 0)
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&models).
 		Where("enabled = ?", true).
 		Scan(ctx)
@@ -185,7 +185,7 @@ func (r *repository) FindAllEnabled(ctx context.Context) ([]stream.Stream, error
 }
 
 func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
-	return r.database.Select().
+	return r.database.Select(ctx).
 		Model((*streamModel)(nil)).
 		Where(constants.ByIDFilter, id).
 		Exists(ctx)
@@ -273,7 +273,7 @@ func (r *repository) saveLinkedModels(
 
 func (r *repository) fillLinkedModels(ctx context.Context, strm *stream.Stream) error {
 	routeModels := make([]streamRouteModel, 0)
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&routeModels).
 		Where(byStreamIDFilter, strm.ID).
 		Scan(ctx)
@@ -284,7 +284,7 @@ func (r *repository) fillLinkedModels(ctx context.Context, strm *stream.Stream)
 	for _, routeModel := range routeModels {
 		backendModels := make([]streamBackendModel, 0)
 		err = r.database.
-			Select().
+			Select(ctx).
 			Model(&backendModels).
 			Where(byStreamRouteIDFilter, routeModel.ID).
 			Scan(ctx)
@@ -297,7 +297,7 @@ func (r *repository) fillLinkedModels(ctx context.Context, strm *stream.Stream)
 
 	var defaultBackendModel streamBackendModel
 	err = r.database.
-		Select().
+		Select(ctx).
 		Model(&defaultBackendModel).
 		Where(byStreamIDFilter, strm.ID).
 		Scan(ctx)
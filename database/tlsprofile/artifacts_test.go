@@ -0,0 +1,38 @@
+package tlsprofile
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+)
+
+func newTLSProfile() *tlsprofile.TLSProfile {
+	return &tlsprofile.TLSProfile{
+		ID:     uuid.New(),
+		Name:   "Test TLS profile",
+		Preset: tlsprofile.CustomPreset,
+		Protocols: []tlsprofile.Protocol{
+			tlsprofile.TLSv12Protocol,
+			tlsprofile.TLSv13Protocol,
+		},
+		Ciphers:             new("ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256"),
+		PreferServerCiphers: true,
+		OCSPStapling:        true,
+		HSTS: tlsprofile.HSTS{
+			Enabled:           true,
+			MaxAgeSeconds:     31536000,
+			IncludeSubdomains: true,
+		},
+		Session: tlsprofile.Session{
+			CacheEnabled:   true,
+			CacheSizeMB:    10,
+			TimeoutMinutes: 60,
+		},
+		ClientVerification: tlsprofile.ClientVerification{
+			Enabled:                true,
+			Mode:                   tlsprofile.OptionalClientVerificationMode,
+			Depth:                  2,
+			CertificateAuthorities: new("-----BEGIN CERTIFICATE-----"),
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package tlsprofile
+
+import (
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+)
+
+func toDomain(model *tlsProfileModel) tlsprofile.TLSProfile {
+	protocols := make([]tlsprofile.Protocol, len(model.Protocols))
+	for index, protocol := range model.Protocols {
+		protocols[index] = tlsprofile.Protocol(protocol)
+	}
+
+	return tlsprofile.TLSProfile{
+		ID:                  model.ID,
+		Name:                model.Name,
+		Preset:              tlsprofile.Preset(model.Preset),
+		Protocols:           protocols,
+		Ciphers:             model.Ciphers,
+		PreferServerCiphers: model.PreferServerCiphers,
+		OCSPStapling:        model.OCSPStapling,
+		HSTS: tlsprofile.HSTS{
+			Enabled:           model.HSTSEnabled,
+			MaxAgeSeconds:     model.HSTSMaxAgeSeconds,
+			IncludeSubdomains: model.HSTSIncludeSubdomains,
+			Preload:           model.HSTSPreload,
+		},
+		Session: tlsprofile.Session{
+			CacheEnabled:   model.SessionCacheEnabled,
+			CacheSizeMB:    model.SessionCacheSizeMB,
+			TimeoutMinutes: model.SessionTimeoutMinutes,
+			TicketsEnabled: model.SessionTicketsEnabled,
+		},
+		ClientVerification: tlsprofile.ClientVerification{
+			Enabled:                model.ClientVerificationEnabled,
+			Mode:                   tlsprofile.ClientVerificationMode(model.ClientVerificationMode),
+			Depth:                  model.ClientVerificationDepth,
+			CertificateAuthorities: model.ClientCertificateAuthorities,
+		},
+	}
+}
+
+func toModel(domain *tlsprofile.TLSProfile) tlsProfileModel {
+	protocols := make([]string, len(domain.Protocols))
+	for index, protocol := range domain.Protocols {
+		protocols[index] = string(protocol)
+	}
+
+	return tlsProfileModel{
+		ID:                           domain.ID,
+		Name:                         domain.Name,
+		Preset:                       string(domain.Preset),
+		Protocols:                    protocols,
+		Ciphers:                      domain.Ciphers,
+		PreferServerCiphers:          domain.PreferServerCiphers,
+		OCSPStapling:                 domain.OCSPStapling,
+		HSTSEnabled:                  domain.HSTS.Enabled,
+		HSTSMaxAgeSeconds:            domain.HSTS.MaxAgeSeconds,
+		HSTSIncludeSubdomains:        domain.HSTS.IncludeSubdomains,
+		HSTSPreload:                  domain.HSTS.Preload,
+		SessionCacheEnabled:          domain.Session.CacheEnabled,
+		SessionCacheSizeMB:           domain.Session.CacheSizeMB,
+		SessionTimeoutMinutes:        domain.Session.TimeoutMinutes,
+		SessionTicketsEnabled:        domain.Session.TicketsEnabled,
+		ClientVerificationEnabled:    domain.ClientVerification.Enabled,
+		ClientVerificationMode:       string(domain.ClientVerification.Mode),
+		ClientVerificationDepth:      domain.ClientVerification.Depth,
+		ClientCertificateAuthorities: domain.ClientVerification.CertificateAuthorities,
+	}
+}
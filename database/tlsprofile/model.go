@@ -0,0 +1,30 @@
+package tlsprofile
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type tlsProfileModel struct {
+	bun.BaseModel `bun:"tls_profile"`
+
+	Ciphers                      *string   `bun:"ciphers"`
+	ClientCertificateAuthorities *string   `bun:"client_certificate_authorities"`
+	Name                         string    `bun:"name,notnull"`
+	Preset                       string    `bun:"preset,notnull"`
+	ClientVerificationMode       string    `bun:"client_verification_mode,notnull"`
+	Protocols                    []string  `bun:"protocols,array,notnull"`
+	HSTSMaxAgeSeconds            int       `bun:"hsts_max_age_seconds,notnull"`
+	SessionCacheSizeMB           int       `bun:"session_cache_size_mb,notnull"`
+	SessionTimeoutMinutes        int       `bun:"session_timeout_minutes,notnull"`
+	ClientVerificationDepth      int       `bun:"client_verification_depth,notnull"`
+	ID                           uuid.UUID `bun:"id,pk"`
+	PreferServerCiphers          bool      `bun:"prefer_server_ciphers,notnull"`
+	OCSPStapling                 bool      `bun:"ocsp_stapling,notnull"`
+	HSTSEnabled                  bool      `bun:"hsts_enabled,notnull"`
+	HSTSIncludeSubdomains        bool      `bun:"hsts_include_subdomains,notnull"`
+	HSTSPreload                  bool      `bun:"hsts_preload,notnull"`
+	SessionCacheEnabled          bool      `bun:"session_cache_enabled,notnull"`
+	SessionTicketsEnabled        bool      `bun:"session_tickets_enabled,notnull"`
+	ClientVerificationEnabled    bool      `bun:"client_verification_enabled,notnull"`
+}
@@ -0,0 +1,177 @@
+package tlsprofile
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/tlsprofile"
+	"dillmann.com.br/nginx-ignition/database/common/constants"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+)
+
+const (
+	byTLSProfileIDFilter = "tls_profile_id = ?"
+)
+
+type repository struct {
+	database *database.Database
+}
+
+func New(db *database.Database) tlsprofile.Repository {
+	return &repository{
+		database: db,
+	}
+}
+
+func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*tlsprofile.TLSProfile, error) {
+	var model tlsProfileModel
+
+	err := r.database.Select(ctx).
+		Model(&model).
+		Where(constants.ByIDFilter, id).
+		Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return new(toDomain(&model)), nil
+}
+
+func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (bool, error) {
+	hostExists, err := r.database.Select(ctx).
+		Table("host_binding").
+		Where(byTLSProfileIDFilter, id).
+		Exists(ctx)
+	if err != nil || hostExists {
+		return hostExists, err
+	}
+
+	return r.database.Select(ctx).
+		Table("settings_global_binding").
+		Where(byTLSProfileIDFilter, id).
+		Exists(ctx)
+}
+
+func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.database.Select(ctx).
+		Model((*tlsProfileModel)(nil)).
+		Where(constants.ByIDFilter, id).
+		Exists(ctx)
+}
+
+func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	_, err := r.database.Delete(ctx).
+		Model((*tlsProfileModel)(nil)).
+		Where(constants.ByIDFilter, id).
+		Exec(ctx)
+
+	return err
+}
+
+func (r *repository) FindPage(
+	ctx context.Context,
+	pageNumber, pageSize int,
+	searchTerms *string,
+) (*pagination.Page[tlsprofile.TLSProfile], error) {
+	models := make([]tlsProfileModel, 0)
+
+	query := r.database.Select(ctx).Model(&models)
+	if searchTerms != nil {
+		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+*searchTerms+"%")
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = query.
+		Limit(pageSize).
+		Offset(pageSize * pageNumber).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]tlsprofile.TLSProfile, 0)
+	for _, model := range models {
+		result = append(result, toDomain(&model))
+	}
+
+	return pagination.New(pageNumber, pageSize, count, result), nil
+}
+
+func (r *repository) FindAllInUse(ctx context.Context) ([]tlsprofile.TLSProfile, error) {
+	models := make([]tlsProfileModel, 0)
+
+	hostSubquery := r.database.
+		Select(ctx).
+		Table("host_binding").
+		Column("tls_profile_id").
+		Where("tls_profile_id is not null")
+	settingsSubquery := r.database.
+		Select(ctx).
+		Table("settings_global_binding").
+		Column("tls_profile_id").
+		Where("tls_profile_id is not null")
+
+	err := r.database.Select(ctx).
+		Model(&models).
+		Where("id in (?)", hostSubquery).
+		WhereOr("id in (?)", settingsSubquery).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]tlsprofile.TLSProfile, len(models))
+	for index, model := range models {
+		result[index] = toDomain(&model)
+	}
+
+	return result, nil
+}
+
+func (r *repository) Save(ctx context.Context, domain *tlsprofile.TLSProfile) error {
+	transaction, err := r.database.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer transaction.Rollback()
+
+	exists, err := transaction.NewSelect().
+		Model((*tlsProfileModel)(nil)).
+		Where(constants.ByIDFilter, domain.ID).
+		Exists(ctx)
+	if err != nil {
+		return err
+	}
+
+	model := toModel(domain)
+	if exists {
+		_, err = transaction.NewUpdate().
+			Model(&model).
+			Where(constants.ByIDFilter, model.ID).
+			Exec(ctx)
+	} else {
+		_, err = transaction.NewInsert().Model(&model).Exec(ctx)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
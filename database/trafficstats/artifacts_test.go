@@ -0,0 +1,26 @@
+package trafficstats
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/trafficstats"
+)
+
+func newSample(timestamp time.Time) trafficstats.Sample {
+	return trafficstats.Sample{
+		Timestamp:  timestamp.UTC().Truncate(time.Minute),
+		Resolution: trafficstats.MinuteResolution,
+		Scope:      trafficstats.HostScope,
+		Key:        uuid.NewString(),
+		Counters: trafficstats.Counters{
+			Requests:     10,
+			InBytes:      1024,
+			OutBytes:     4096,
+			RequestMsecs: 150,
+			Status2xx:    8,
+			Status4xx:    2,
+		},
+	}
+}
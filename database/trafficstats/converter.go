@@ -0,0 +1,52 @@
+package trafficstats
+
+import (
+	"dillmann.com.br/nginx-ignition/core/trafficstats"
+)
+
+func toDomain(model *sampleModel) trafficstats.Sample {
+	return trafficstats.Sample{
+		Timestamp:  model.BucketStart.UTC(),
+		Resolution: trafficstats.Resolution(model.Resolution),
+		Scope:      trafficstats.Scope(model.Scope),
+		Key:        model.ZoneKey,
+		Counters: trafficstats.Counters{
+			Requests:     uint64(model.Requests),
+			InBytes:      uint64(model.InBytes),
+			OutBytes:     uint64(model.OutBytes),
+			RequestMsecs: uint64(model.RequestMsecs),
+			Status1xx:    uint64(model.Status1xx),
+			Status2xx:    uint64(model.Status2xx),
+			Status3xx:    uint64(model.Status3xx),
+			Status4xx:    uint64(model.Status4xx),
+			Status5xx:    uint64(model.Status5xx),
+		},
+	}
+}
+
+func toModel(domain *trafficstats.Sample) *sampleModel {
+	return &sampleModel{
+		BucketStart:  domain.Timestamp.UTC(),
+		Resolution:   string(domain.Resolution),
+		Scope:        string(domain.Scope),
+		ZoneKey:      domain.Key,
+		Requests:     int64(domain.Counters.Requests),
+		InBytes:      int64(domain.Counters.InBytes),
+		OutBytes:     int64(domain.Counters.OutBytes),
+		RequestMsecs: int64(domain.Counters.RequestMsecs),
+		Status1xx:    int64(domain.Counters.Status1xx),
+		Status2xx:    int64(domain.Counters.Status2xx),
+		Status3xx:    int64(domain.Counters.Status3xx),
+		Status4xx:    int64(domain.Counters.Status4xx),
+		Status5xx:    int64(domain.Counters.Status5xx),
+	}
+}
+
+func toDomainList(models []sampleModel) []trafficstats.Sample {
+	output := make([]trafficstats.Sample, len(models))
+	for index := range models {
+		output[index] = toDomain(&models[index])
+	}
+
+	return output
+}
@@ -0,0 +1,24 @@
+package trafficstats
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type sampleModel struct {
+	bun.BaseModel `bun:"traffic_stats_sample"`
+	BucketStart   time.Time `bun:"bucket_start,pk"`
+	Resolution    string    `bun:"resolution,pk"`
+	Scope         string    `bun:"scope,pk"`
+	ZoneKey       string    `bun:"zone_key,pk"`
+	Requests      int64     `bun:"requests,notnull"`
+	InBytes       int64     `bun:"in_bytes,notnull"`
+	OutBytes      int64     `bun:"out_bytes,notnull"`
+	RequestMsecs  int64     `bun:"request_msecs,notnull"`
+	Status1xx     int64     `bun:"status_1xx,notnull"`
+	Status2xx     int64     `bun:"status_2xx,notnull"`
+	Status3xx     int64     `bun:"status_3xx,notnull"`
+	Status4xx     int64     `bun:"status_4xx,notnull"`
+	Status5xx     int64     `bun:"status_5xx,notnull"`
+}
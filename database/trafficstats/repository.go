@@ -0,0 +1,177 @@
+package trafficstats
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"dillmann.com.br/nginx-ignition/core/trafficstats"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+)
+
+const byKeyFilter = "resolution = ? AND scope = ? AND zone_key = ? AND bucket_start = ?"
+
+type repository struct {
+	database *database.Database
+}
+
+func New(db *database.Database) trafficstats.Repository {
+	return &repository{
+		database: db,
+	}
+}
+
+func (r *repository) Add(ctx context.Context, samples []trafficstats.Sample) error {
+	transaction, err := r.database.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer transaction.Rollback()
+
+	if err = r.addSamples(ctx, transaction, samples); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+func (r *repository) FindRange(
+	ctx context.Context,
+	scope trafficstats.Scope,
+	key *string,
+	from, to time.Time,
+) ([]trafficstats.Sample, error) {
+	var models []sampleModel
+	query := r.database.Select(ctx).
+		Model(&models).
+		Where("scope = ?", string(scope)).
+		Where("bucket_start >= ?", from.UTC()).
+		Where("bucket_start < ?", to.UTC())
+
+	if key != nil {
+		query = query.Where("zone_key = ?", *key)
+	}
+
+	if err := query.Order("bucket_start").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return toDomainList(models), nil
+}
+
+func (r *repository) FindOlderThan(
+	ctx context.Context,
+	resolution trafficstats.Resolution,
+	before time.Time,
+) ([]trafficstats.Sample, error) {
+	var models []sampleModel
+	err := r.database.Select(ctx).
+		Model(&models).
+		Where("resolution = ?", string(resolution)).
+		Where("bucket_start < ?", before.UTC()).
+		Order("bucket_start").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainList(models), nil
+}
+
+func (r *repository) Compact(
+	ctx context.Context,
+	source trafficstats.Resolution,
+	before time.Time,
+	samples []trafficstats.Sample,
+) error {
+	transaction, err := r.database.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer transaction.Rollback()
+
+	if err = r.addSamples(ctx, transaction, samples); err != nil {
+		return err
+	}
+
+	_, err = transaction.NewDelete().
+		Model((*sampleModel)(nil)).
+		Where("resolution = ?", string(source)).
+		Where("bucket_start < ?", before.UTC()).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+func (r *repository) DeleteOlderThan(
+	ctx context.Context,
+	resolution trafficstats.Resolution,
+	before time.Time,
+) (int, error) {
+	result, err := r.database.Delete(ctx).
+		Model((*sampleModel)(nil)).
+		Where("resolution = ?", string(resolution)).
+		Where("bucket_start < ?", before.UTC()).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := result.RowsAffected()
+	return int(count), err
+}
+
+func (r *repository) addSamples(
+	ctx context.Context,
+	transaction bun.Tx,
+	samples []trafficstats.Sample,
+) error {
+	for index := range samples {
+		model := toModel(&samples[index])
+
+		var existing sampleModel
+		err := transaction.NewSelect().
+			Model(&existing).
+			Where(byKeyFilter, model.Resolution, model.Scope, model.ZoneKey, model.BucketStart).
+			Scan(ctx)
+
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			_, err = transaction.NewInsert().Model(model).Exec(ctx)
+		case err == nil:
+			sumCounters(model, &existing)
+			_, err = transaction.NewUpdate().
+				Model(model).
+				Where(byKeyFilter, model.Resolution, model.Scope, model.ZoneKey, model.BucketStart).
+				Exec(ctx)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sumCounters(target, other *sampleModel) {
+	target.Requests += other.Requests
+	target.InBytes += other.InBytes
+	target.OutBytes += other.OutBytes
+	target.RequestMsecs += other.RequestMsecs
+	target.Status1xx += other.Status1xx
+	target.Status2xx += other.Status2xx
+	target.Status3xx += other.Status3xx
+	target.Status4xx += other.Status4xx
+	target.Status5xx += other.Status5xx
+}
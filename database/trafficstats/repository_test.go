@@ -0,0 +1,132 @@
+package trafficstats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/trafficstats"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	"dillmann.com.br/nginx-ignition/database/common/testutils"
+)
+
+func Test_Repository(t *testing.T) {
+	testutils.RunWithMockedDatabases(t, runRepositoryTests)
+}
+
+func runRepositoryTests(t *testing.T, db *database.Database) {
+	repo := New(db)
+	now := time.Now()
+
+	t.Run("Add", func(t *testing.T) {
+		t.Run("successfully inserts a new sample", func(t *testing.T) {
+			sample := newSample(now)
+
+			require.NoError(t, repo.Add(t.Context(), []trafficstats.Sample{sample}))
+
+			found, err := repo.FindRange(
+				t.Context(),
+				sample.Scope,
+				&sample.Key,
+				sample.Timestamp,
+				sample.Timestamp.Add(time.Minute),
+			)
+			require.NoError(t, err)
+			require.Len(t, found, 1)
+			assert.Equal(t, sample.Counters, found[0].Counters)
+			assert.True(t, sample.Timestamp.Equal(found[0].Timestamp))
+		})
+
+		t.Run("sums the counters of an existing sample", func(t *testing.T) {
+			sample := newSample(now)
+
+			require.NoError(t, repo.Add(t.Context(), []trafficstats.Sample{sample}))
+			require.NoError(t, repo.Add(t.Context(), []trafficstats.Sample{sample}))
+
+			found, err := repo.FindRange(
+				t.Context(),
+				sample.Scope,
+				&sample.Key,
+				sample.Timestamp,
+				sample.Timestamp.Add(time.Minute),
+			)
+			require.NoError(t, err)
+			require.Len(t, found, 1)
+			assert.Equal(t, sample.Counters.Requests*2, found[0].Counters.Requests)
+			assert.Equal(t, sample.Counters.OutBytes*2, found[0].Counters.OutBytes)
+		})
+	})
+
+	t.Run("FindRange", func(t *testing.T) {
+		t.Run("returns only the samples inside the range", func(t *testing.T) {
+			first := newSample(now.Add(-2 * time.Minute))
+			second := first
+			second.Timestamp = first.Timestamp.Add(time.Minute)
+			outside := first
+			outside.Timestamp = first.Timestamp.Add(2 * time.Minute)
+
+			samples := []trafficstats.Sample{outside, second, first}
+			require.NoError(t, repo.Add(t.Context(), samples))
+
+			found, err := repo.FindRange(
+				t.Context(),
+				first.Scope,
+				&first.Key,
+				first.Timestamp,
+				outside.Timestamp,
+			)
+			require.NoError(t, err)
+			require.Len(t, found, 2)
+			assert.True(t, first.Timestamp.Equal(found[0].Timestamp))
+			assert.True(t, second.Timestamp.Equal(found[1].Timestamp))
+		})
+	})
+
+	t.Run("Compact", func(t *testing.T) {
+		t.Run("replaces the source samples with the compacted ones", func(t *testing.T) {
+			before := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+			sample := newSample(before.Add(-time.Hour))
+			require.NoError(t, repo.Add(t.Context(), []trafficstats.Sample{sample}))
+
+			compacted := sample
+			compacted.Resolution = trafficstats.HourResolution
+			require.NoError(
+				t,
+				repo.Compact(
+					t.Context(),
+					trafficstats.MinuteResolution,
+					before,
+					[]trafficstats.Sample{compacted},
+				),
+			)
+
+			remaining, err := repo.FindOlderThan(t.Context(), trafficstats.MinuteResolution, before)
+			require.NoError(t, err)
+			assert.Empty(t, remaining)
+
+			found, err := repo.FindOlderThan(t.Context(), trafficstats.HourResolution, before)
+			require.NoError(t, err)
+			require.NotEmpty(t, found)
+			assert.Contains(t, found, compacted)
+		})
+	})
+
+	t.Run("DeleteOlderThan", func(t *testing.T) {
+		t.Run("successfully deletes the old samples", func(t *testing.T) {
+			before := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+			sample := newSample(before.Add(-time.Hour))
+			sample.Resolution = trafficstats.DayResolution
+			require.NoError(t, repo.Add(t.Context(), []trafficstats.Sample{sample}))
+
+			count, err := repo.DeleteOlderThan(t.Context(), trafficstats.DayResolution, before)
+			require.NoError(t, err)
+			assert.Positive(t, count)
+
+			found, err := repo.FindOlderThan(t.Context(), trafficstats.DayResolution, before)
+			require.NoError(t, err)
+			assert.Empty(t, found)
+		})
+	})
+}
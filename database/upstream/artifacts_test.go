@@ -0,0 +1,40 @@
+package upstream
+
+import (
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+func newUpstream() *upstream.Upstream {
+	return &upstream.Upstream{
+		ID:       uuid.New(),
+		Name:     "Test Upstream",
+		Protocol: upstream.HTTPProtocol,
+		Method:   upstream.LeastConnectionsBalancingMethod,
+		Keepalive: &upstream.Keepalive{
+			Connections:    16,
+			TimeoutSeconds: 60,
+		},
+		Servers: []upstream.Server{
+			{
+				Address: "10.0.0.1",
+				Port:    8080,
+				Weight:  new(2),
+				CircuitBreaker: &upstream.CircuitBreaker{
+					MaxFailures: 3,
+					OpenSeconds: 30,
+				},
+			},
+			{
+				Address: "10.0.0.2",
+				Port:    8080,
+			},
+			{
+				Address: "10.0.0.3",
+				Port:    8080,
+				Backup:  true,
+			},
+		},
+	}
+}
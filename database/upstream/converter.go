@@ -0,0 +1,93 @@
+package upstream
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+
+	"dillmann.com.br/nginx-ignition/core/upstream"
+)
+
+func toDomain(model *upstreamModel) upstream.Upstream {
+	serverModels := slices.Clone(model.Servers)
+	slices.SortFunc(serverModels, func(left, right serverModel) int {
+		return left.Position - right.Position
+	})
+
+	servers := make([]upstream.Server, len(serverModels))
+	for index, server := range serverModels {
+		var circuitBreaker *upstream.CircuitBreaker
+		if server.MaxFailures != nil && server.OpenSeconds != nil {
+			circuitBreaker = &upstream.CircuitBreaker{
+				MaxFailures: *server.MaxFailures,
+				OpenSeconds: *server.OpenSeconds,
+			}
+		}
+
+		servers[index] = upstream.Server{
+			Address:        server.Address,
+			Port:           server.Port,
+			Weight:         server.Weight,
+			CircuitBreaker: circuitBreaker,
+			Backup:         server.Backup,
+		}
+	}
+
+	var keepalive *upstream.Keepalive
+	if model.KeepaliveConnections != nil && model.KeepaliveTimeoutSeconds != nil {
+		keepalive = &upstream.Keepalive{
+			Connections:    *model.KeepaliveConnections,
+			TimeoutSeconds: *model.KeepaliveTimeoutSeconds,
+		}
+	}
+
+	return upstream.Upstream{
+		ID:        model.ID,
+		Name:      model.Name,
+		Protocol:  upstream.Protocol(model.Protocol),
+		Method:    upstream.BalancingMethod(model.Method),
+		HashKey:   model.HashKey,
+		Keepalive: keepalive,
+		Servers:   servers,
+	}
+}
+
+func toModel(domain *upstream.Upstream) upstreamModel {
+	servers := make([]serverModel, len(domain.Servers))
+	for index, server := range domain.Servers {
+		var maxFailures, openSeconds *int
+		if server.CircuitBreaker != nil {
+			maxFailures = &server.CircuitBreaker.MaxFailures
+			openSeconds = &server.CircuitBreaker.OpenSeconds
+		}
+
+		servers[index] = serverModel{
+			ID:          uuid.New(),
+			UpstreamID:  domain.ID,
+			Position:    index,
+			Address:     server.Address,
+			Port:        server.Port,
+			Weight:      server.Weight,
+			MaxFailures: maxFailures,
+			OpenSeconds: openSeconds,
+			Backup:      server.Backup,
+		}
+	}
+
+	var keepaliveConnections, keepaliveTimeoutSeconds *int
+	if domain.Keepalive != nil {
+		keepaliveConnections = &domain.Keepalive.Connections
+		keepaliveTimeoutSeconds = &domain.Keepalive.TimeoutSeconds
+	}
+
+	return upstreamModel{
+		ID:                      domain.ID,
+		Name:                    domain.Name,
+		Protocol:                string(domain.Protocol),
+		Method:                  string(domain.Method),
+		HashKey:                 domain.HashKey,
+		KeepaliveConnections:    keepaliveConnections,
+		KeepaliveTimeoutSeconds: keepaliveTimeoutSeconds,
+		Servers:                 servers,
+	}
+}
@@ -0,0 +1,33 @@
+package upstream
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type upstreamModel struct {
+	bun.BaseModel `bun:"upstream"`
+
+	HashKey                 *string       `bun:"hash_key"`
+	KeepaliveConnections    *int          `bun:"keepalive_connections"`
+	KeepaliveTimeoutSeconds *int          `bun:"keepalive_timeout_seconds"`
+	Name                    string        `bun:"name,notnull"`
+	Protocol                string        `bun:"protocol,notnull"`
+	Method                  string        `bun:"method,notnull"`
+	Servers                 []serverModel `bun:"rel:has-many,join:id=upstream_id"`
+	ID                      uuid.UUID     `bun:"id,pk"`
+}
+
+type serverModel struct {
+	bun.BaseModel `bun:"upstream_server"`
+
+	Weight      *int      `bun:"weight"`
+	MaxFailures *int      `bun:"max_failures"`
+	OpenSeconds *int      `bun:"open_seconds"`
+	Address     string    `bun:"address,notnull"`
+	Position    int       `bun:"position,notnull"`
+	Port        int       `bun:"port,notnull"`
+	ID          uuid.UUID `bun:"id,pk"`
+	UpstreamID  uuid.UUID `bun:"upstream_id,notnull"`
+	Backup      bool      `bun:"backup,notnull"`
+}
@@ -0,0 +1,244 @@
+package upstream
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"dillmann.com.br/nginx-ignition/core/common/pagination"
+	"dillmann.com.br/nginx-ignition/core/upstream"
+	"dillmann.com.br/nginx-ignition/database/common/constants"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+)
+
+const (
+	byUpstreamIDFilter = "upstream_id = ?"
+)
+
+type repository struct {
+	database *database.Database
+}
+
+func New(db *database.Database) upstream.Repository {
+	return &repository{
+		database: db,
+	}
+}
+
+func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*upstream.Upstream, error) {
+	var model upstreamModel
+
+	err := r.database.Select(ctx).
+		Model(&model).
+		Relation("Servers").
+		Where(constants.ByIDFilter, id).
+		Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return new(toDomain(&model)), nil
+}
+
+func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.database.Select(ctx).
+		Table("host_route").
+		Where(byUpstreamIDFilter, id).
+		Exists(ctx)
+}
+
+func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.database.Select(ctx).
+		Model((*upstreamModel)(nil)).
+		Where(constants.ByIDFilter, id).
+		Exists(ctx)
+}
+
+func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	transaction, err := r.database.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer transaction.Rollback()
+
+	err = r.cleanupLinkedModels(ctx, transaction, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = transaction.NewDelete().
+		Model((*upstreamModel)(nil)).
+		Where(constants.ByIDFilter, id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+func (r *repository) FindPage(
+	ctx context.Context,
+	pageNumber, pageSize int,
+	searchTerms *string,
+) (*pagination.Page[upstream.Upstream], error) {
+	models := make([]upstreamModel, 0)
+
+	query := r.database.Select(ctx).Model(&models)
+	if searchTerms != nil {
+		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+*searchTerms+"%")
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = query.
+		Relation("Servers").
+		Limit(pageSize).
+		Offset(pageSize * pageNumber).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]upstream.Upstream, 0)
+	for _, model := range models {
+		result = append(result, toDomain(&model))
+	}
+
+	return pagination.New(pageNumber, pageSize, count, result), nil
+}
+
+func (r *repository) FindAllInUse(ctx context.Context) ([]upstream.Upstream, error) {
+	models := make([]upstreamModel, 0)
+
+	routeSubquery := r.database.
+		Select(ctx).
+		Table("host_route").
+		Column("upstream_id").
+		Where("upstream_id is not null")
+
+	err := r.database.Select(ctx).
+		Model(&models).
+		Relation("Servers").
+		Where("id in (?)", routeSubquery).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]upstream.Upstream, len(models))
+	for index, model := range models {
+		result[index] = toDomain(&model)
+	}
+
+	return result, nil
+}
+
+func (r *repository) Save(ctx context.Context, domain *upstream.Upstream) error {
+	transaction, err := r.database.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer transaction.Rollback()
+
+	exists, err := transaction.NewSelect().
+		Model((*upstreamModel)(nil)).
+		Where(constants.ByIDFilter, domain.ID).
+		Exists(ctx)
+	if err != nil {
+		return err
+	}
+
+	model := toModel(domain)
+	if exists {
+		err = r.performUpdate(ctx, transaction, &model)
+	} else {
+		err = r.performInsert(ctx, transaction, &model)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+func (r *repository) performInsert(
+	ctx context.Context,
+	transaction bun.Tx,
+	model *upstreamModel,
+) error {
+	_, err := transaction.NewInsert().Model(model).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.saveLinkedModels(ctx, transaction, model)
+}
+
+func (r *repository) performUpdate(
+	ctx context.Context,
+	transaction bun.Tx,
+	model *upstreamModel,
+) error {
+	_, err := transaction.NewUpdate().
+		Model(model).
+		Where(constants.ByIDFilter, model.ID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = r.cleanupLinkedModels(ctx, transaction, model.ID)
+	if err != nil {
+		return err
+	}
+
+	return r.saveLinkedModels(ctx, transaction, model)
+}
+
+func (r *repository) saveLinkedModels(
+	ctx context.Context,
+	transaction bun.Tx,
+	model *upstreamModel,
+) error {
+	for index := range model.Servers {
+		_, err := transaction.NewInsert().
+			Model(&model.Servers[index]).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) cleanupLinkedModels(
+	ctx context.Context,
+	transaction bun.Tx,
+	id uuid.UUID,
+) error {
+	_, err := transaction.NewDelete().
+		Table("upstream_server").
+		Where(byUpstreamIDFilter, id).
+		Exec(ctx)
+
+	return err
+}
@@ -0,0 +1,133 @@
+package upstream
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dillmann.com.br/nginx-ignition/core/upstream"
+	"dillmann.com.br/nginx-ignition/database/common/database"
+	"dillmann.com.br/nginx-ignition/database/common/testutils"
+)
+
+func Test_Repository(t *testing.T) {
+	testutils.RunWithMockedDatabases(t, runRepositoryTests)
+}
+
+func runRepositoryTests(t *testing.T, db *database.Database) {
+	repo := New(db)
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("successfully saves a new upstream", func(t *testing.T) {
+			cmd := newUpstream()
+
+			err := repo.Save(t.Context(), cmd)
+			require.NoError(t, err)
+
+			saved, err := repo.FindByID(t.Context(), cmd.ID)
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, cmd.Name, saved.Name)
+			assert.Equal(t, cmd.Protocol, saved.Protocol)
+			assert.Equal(t, cmd.Method, saved.Method)
+			assert.Equal(t, cmd.Keepalive, saved.Keepalive)
+			assert.Equal(t, cmd.Servers, saved.Servers)
+		})
+
+		t.Run("successfully updates an existing upstream", func(t *testing.T) {
+			cmd := newUpstream()
+			require.NoError(t, repo.Save(t.Context(), cmd))
+
+			cmd.Name = "Updated Name"
+			cmd.Method = upstream.HashBalancingMethod
+			cmd.HashKey = new("$request_uri")
+			cmd.Keepalive = nil
+			cmd.Servers = cmd.Servers[:1]
+			err := repo.Save(t.Context(), cmd)
+			require.NoError(t, err)
+
+			saved, err := repo.FindByID(t.Context(), cmd.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "Updated Name", saved.Name)
+			assert.Equal(t, upstream.HashBalancingMethod, saved.Method)
+			assert.Equal(t, cmd.HashKey, saved.HashKey)
+			assert.Nil(t, saved.Keepalive)
+			assert.Len(t, saved.Servers, 1)
+		})
+	})
+
+	t.Run("FindByID", func(t *testing.T) {
+		t.Run("returns nil when not exists", func(t *testing.T) {
+			saved, err := repo.FindByID(t.Context(), uuid.New())
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+	})
+
+	t.Run("FindPage", func(t *testing.T) {
+		t.Run("returns a page of upstreams filtered by name", func(t *testing.T) {
+			prefix := uuid.New().String()
+			names := []string{
+				prefix + "Alpha",
+				prefix + "Beta",
+				prefix + "Gamma",
+			}
+
+			for _, name := range names {
+				cmd := newUpstream()
+				cmd.Name = name
+				require.NoError(t, repo.Save(t.Context(), cmd))
+			}
+
+			other := newUpstream()
+			other.Name = "Other" + uuid.New().String()
+			require.NoError(t, repo.Save(t.Context(), other))
+
+			page, err := repo.FindPage(t.Context(), 0, 10, new(prefix))
+			require.NoError(t, err)
+
+			assert.Equal(t, 3, page.TotalItems)
+			for _, item := range page.Contents {
+				assert.Contains(t, item.Name, prefix)
+			}
+		})
+	})
+
+	t.Run("DeleteByID", func(t *testing.T) {
+		t.Run("removes the upstream", func(t *testing.T) {
+			cmd := newUpstream()
+			require.NoError(t, repo.Save(t.Context(), cmd))
+
+			err := repo.DeleteByID(t.Context(), cmd.ID)
+			require.NoError(t, err)
+
+			exists, err := repo.ExistsByID(t.Context(), cmd.ID)
+			require.NoError(t, err)
+			assert.False(t, exists)
+		})
+	})
+
+	t.Run("InUseByID", func(t *testing.T) {
+		t.Run("returns false when not in use", func(t *testing.T) {
+			cmd := newUpstream()
+			require.NoError(t, repo.Save(t.Context(), cmd))
+
+			inUse, err := repo.InUseByID(t.Context(), cmd.ID)
+			require.NoError(t, err)
+			assert.False(t, inUse)
+		})
+	})
+
+	t.Run("FindAllInUse", func(t *testing.T) {
+		t.Run("returns empty list when no upstreams in use", func(t *testing.T) {
+			cmd := newUpstream()
+			require.NoError(t, repo.Save(t.Context(), cmd))
+
+			inUseList, err := repo.FindAllInUse(t.Context())
+			require.NoError(t, err)
+			assert.Empty(t, inUseList)
+		})
+	})
+}
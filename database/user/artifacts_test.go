@@ -14,19 +14,24 @@ func newUser() *user.User {
 		PasswordHash: "hash",
 		PasswordSalt: "salt",
 		Permissions: user.Permissions{
-			Hosts:        user.ReadWriteAccessLevel,
-			Streams:      user.ReadWriteAccessLevel,
-			Certificates: user.ReadWriteAccessLevel,
-			Logs:         user.ReadOnlyAccessLevel,
-			Integrations: user.ReadWriteAccessLevel,
-			AccessLists:  user.ReadWriteAccessLevel,
-			Settings:     user.ReadWriteAccessLevel,
-			Users:        user.ReadWriteAccessLevel,
-			NginxServer:  user.ReadWriteAccessLevel,
-			ExportData:   user.ReadOnlyAccessLevel,
-			VPNs:         user.ReadWriteAccessLevel,
-			Caches:       user.ReadWriteAccessLevel,
-			TrafficStats: user.ReadOnlyAccessLevel,
+			Hosts:           user.ReadWriteAccessLevel,
+			Streams:         user.ReadWriteAccessLevel,
+			Certificates:    user.ReadWriteAccessLevel,
+			Logs:            user.ReadOnlyAccessLevel,
+			Integrations:    user.ReadWriteAccessLevel,
+			AccessLists:     user.ReadWriteAccessLevel,
+			Settings:        user.ReadWriteAccessLevel,
+			Users:           user.ReadWriteAccessLevel,
+			NginxServer:     user.ReadWriteAccessLevel,
+			ExportData:      user.ReadOnlyAccessLevel,
+			VPNs:            user.ReadWriteAccessLevel,
+			Caches:          user.ReadWriteAccessLevel,
+			Upstreams:       user.ReadWriteAccessLevel,
+			TLSProfiles:     user.ReadWriteAccessLevel,
+			RateLimits:      user.ReadWriteAccessLevel,
+			SecurityHeaders: user.ReadWriteAccessLevel,
+			TrafficStats:    user.ReadOnlyAccessLevel,
+			Audit:           user.ReadOnlyAccessLevel,
 		},
 		Enabled: true,
 		TOTP: user.TOTP{
@@ -8,26 +8,32 @@ import (
 
 func toDomain(model *userModel) user.User {
 	return user.User{
-		ID:           model.ID,
-		Enabled:      model.Enabled,
-		Name:         model.Name,
-		Username:     model.Username,
-		PasswordHash: model.PasswordHash,
-		PasswordSalt: model.PasswordSalt,
+		ID:              model.ID,
+		Enabled:         model.Enabled,
+		Name:            model.Name,
+		Username:        model.Username,
+		PasswordHash:    model.PasswordHash,
+		PasswordSalt:    model.PasswordSalt,
+		ExternalSubject: model.ExternalSubject,
 		Permissions: user.Permissions{
-			Hosts:        user.AccessLevel(model.HostsAccessLevel),
-			Streams:      user.AccessLevel(model.StreamsAccessLevel),
-			Certificates: user.AccessLevel(model.CertificatesAccessLevel),
-			Logs:         user.AccessLevel(model.LogsAccessLevel),
-			Integrations: user.AccessLevel(model.IntegrationsAccessLevel),
-			AccessLists:  user.AccessLevel(model.AccessListsAccessLevel),
-			Settings:     user.AccessLevel(model.SettingsAccessLevel),
-			Users:        user.AccessLevel(model.UsersAccessLevel),
-			NginxServer:  user.AccessLevel(model.NginxServerAccessLevel),
-			ExportData:   user.AccessLevel(model.ExportDataAccessLevel),
-			VPNs:         user.AccessLevel(model.VPNsAccessLevel),
-			Caches:       user.AccessLevel(model.CachesAccessLevel),
-			TrafficStats: user.AccessLevel(model.TrafficStatsAccessLevel),
+			Hosts:           user.AccessLevel(model.HostsAccessLevel),
+			Streams:         user.AccessLevel(model.StreamsAccessLevel),
+			Certificates:    user.AccessLevel(model.CertificatesAccessLevel),
+			Logs:            user.AccessLevel(model.LogsAccessLevel),
+			Integrations:    user.AccessLevel(model.IntegrationsAccessLevel),
+			AccessLists:     user.AccessLevel(model.AccessListsAccessLevel),
+			Settings:        user.AccessLevel(model.SettingsAccessLevel),
+			Users:           user.AccessLevel(model.UsersAccessLevel),
+			NginxServer:     user.AccessLevel(model.NginxServerAccessLevel),
+			ExportData:      user.AccessLevel(model.ExportDataAccessLevel),
+			VPNs:            user.AccessLevel(model.VPNsAccessLevel),
+			Caches:          user.AccessLevel(model.CachesAccessLevel),
+			Upstreams:       user.AccessLevel(model.UpstreamsAccessLevel),
+			TLSProfiles:     user.AccessLevel(model.TLSProfilesAccessLevel),
+			RateLimits:      user.AccessLevel(model.RateLimitsAccessLevel),
+			SecurityHeaders: user.AccessLevel(model.SecurityHeadersAccessLevel),
+			TrafficStats:    user.AccessLevel(model.TrafficStatsAccessLevel),
+			Audit:           user.AccessLevel(model.AuditAccessLevel),
 		},
 		TOTP: user.TOTP{
 			Secret:        model.TotpSecret,
@@ -44,28 +50,34 @@ func toModel(domain *user.User) userModel {
 	}
 
 	return userModel{
-		ID:                      domain.ID,
-		Enabled:                 domain.Enabled,
-		Name:                    domain.Name,
-		Username:                domain.Username,
-		PasswordHash:            domain.PasswordHash,
-		PasswordSalt:            domain.PasswordSalt,
-		HostsAccessLevel:        string(domain.Permissions.Hosts),
-		StreamsAccessLevel:      string(domain.Permissions.Streams),
-		CertificatesAccessLevel: string(domain.Permissions.Certificates),
-		LogsAccessLevel:         string(domain.Permissions.Logs),
-		IntegrationsAccessLevel: string(domain.Permissions.Integrations),
-		AccessListsAccessLevel:  string(domain.Permissions.AccessLists),
-		SettingsAccessLevel:     string(domain.Permissions.Settings),
-		UsersAccessLevel:        string(domain.Permissions.Users),
-		NginxServerAccessLevel:  string(domain.Permissions.NginxServer),
-		ExportDataAccessLevel:   string(domain.Permissions.ExportData),
-		VPNsAccessLevel:         string(domain.Permissions.VPNs),
-		CachesAccessLevel:       string(domain.Permissions.Caches),
-		TrafficStatsAccessLevel: string(domain.Permissions.TrafficStats),
-		TotpSecret:              totpSecret,
-		TotpValidated:           domain.TOTP.Validated,
-		TotpLastUsedCodes:       mapCodesToString(domain.TOTP.LastUsedCodes),
+		ID:                         domain.ID,
+		Enabled:                    domain.Enabled,
+		Name:                       domain.Name,
+		Username:                   domain.Username,
+		PasswordHash:               domain.PasswordHash,
+		PasswordSalt:               domain.PasswordSalt,
+		HostsAccessLevel:           string(domain.Permissions.Hosts),
+		StreamsAccessLevel:         string(domain.Permissions.Streams),
+		CertificatesAccessLevel:    string(domain.Permissions.Certificates),
+		LogsAccessLevel:            string(domain.Permissions.Logs),
+		IntegrationsAccessLevel:    string(domain.Permissions.Integrations),
+		AccessListsAccessLevel:     string(domain.Permissions.AccessLists),
+		SettingsAccessLevel:        string(domain.Permissions.Settings),
+		UsersAccessLevel:           string(domain.Permissions.Users),
+		NginxServerAccessLevel:     string(domain.Permissions.NginxServer),
+		ExportDataAccessLevel:      string(domain.Permissions.ExportData),
+		VPNsAccessLevel:            string(domain.Permissions.VPNs),
+		CachesAccessLevel:          string(domain.Permissions.Caches),
+		UpstreamsAccessLevel:       string(domain.Permissions.Upstreams),
+		TLSProfilesAccessLevel:     string(domain.Permissions.TLSProfiles),
+		RateLimitsAccessLevel:      string(domain.Permissions.RateLimits),
+		SecurityHeadersAccessLevel: string(domain.Permissions.SecurityHeaders),
+		TrafficStatsAccessLevel:    string(domain.Permissions.TrafficStats),
+		AuditAccessLevel:           string(domain.Permissions.Audit),
+		ExternalSubject:            domain.ExternalSubject,
+		TotpSecret:                 totpSecret,
+		TotpValidated:              domain.TOTP.Validated,
+		TotpLastUsedCodes:          mapCodesToString(domain.TOTP.LastUsedCodes),
 	}
 }
 
@@ -13,27 +13,32 @@ func Test_Converter(t *testing.T) {
 	t.Run("toDomain", func(t *testing.T) {
 		t.Run("successfully converts a complete model to domain", func(t *testing.T) {
 			model := &userModel{
-				ID:                      uuid.New(),
-				Enabled:                 true,
-				Name:                    "Name",
-				Username:                "username",
-				PasswordHash:            "hash",
-				PasswordSalt:            "salt",
-				HostsAccessLevel:        "READ_WRITE",
-				StreamsAccessLevel:      "READ_WRITE",
-				CertificatesAccessLevel: "READ_WRITE",
-				LogsAccessLevel:         "READ_ONLY",
-				IntegrationsAccessLevel: "READ_WRITE",
-				AccessListsAccessLevel:  "READ_WRITE",
-				SettingsAccessLevel:     "READ_WRITE",
-				UsersAccessLevel:        "READ_WRITE",
-				NginxServerAccessLevel:  "READ_WRITE",
-				ExportDataAccessLevel:   "READ_ONLY",
-				VPNsAccessLevel:         "READ_WRITE",
-				CachesAccessLevel:       "READ_WRITE",
-				TrafficStatsAccessLevel: "READ_ONLY",
-				TotpSecret:              new("secret"),
-				TotpValidated:           true,
+				ID:                         uuid.New(),
+				Enabled:                    true,
+				Name:                       "Name",
+				Username:                   "username",
+				PasswordHash:               "hash",
+				PasswordSalt:               "salt",
+				HostsAccessLevel:           "READ_WRITE",
+				StreamsAccessLevel:         "READ_WRITE",
+				CertificatesAccessLevel:    "READ_WRITE",
+				LogsAccessLevel:            "READ_ONLY",
+				IntegrationsAccessLevel:    "READ_WRITE",
+				AccessListsAccessLevel:     "READ_WRITE",
+				SettingsAccessLevel:        "READ_WRITE",
+				UsersAccessLevel:           "READ_WRITE",
+				NginxServerAccessLevel:     "READ_WRITE",
+				ExportDataAccessLevel:      "READ_ONLY",
+				VPNsAccessLevel:            "READ_WRITE",
+				CachesAccessLevel:          "READ_WRITE",
+				UpstreamsAccessLevel:       "READ_WRITE",
+				TLSProfilesAccessLevel:     "READ_WRITE",
+				RateLimitsAccessLevel:      "READ_WRITE",
+				SecurityHeadersAccessLevel: "READ_WRITE",
+				TrafficStatsAccessLevel:    "READ_ONLY",
+				AuditAccessLevel:           "READ_ONLY",
+				TotpSecret:                 new("secret"),
+				TotpValidated:              true,
 			}
 
 			domain := toDomain(model)
@@ -80,11 +85,32 @@ func Test_Converter(t *testing.T) {
 			)
 			assert.Equal(t, user.AccessLevel(model.VPNsAccessLevel), domain.Permissions.VPNs)
 			assert.Equal(t, user.AccessLevel(model.CachesAccessLevel), domain.Permissions.Caches)
+			assert.Equal(
+				t,
+				user.AccessLevel(model.UpstreamsAccessLevel),
+				domain.Permissions.Upstreams,
+			)
+			assert.Equal(
+				t,
+				user.AccessLevel(model.TLSProfilesAccessLevel),
+				domain.Permissions.TLSProfiles,
+			)
+			assert.Equal(
+				t,
+				user.AccessLevel(model.RateLimitsAccessLevel),
+				domain.Permissions.RateLimits,
+			)
+			assert.Equal(
+				t,
+				user.AccessLevel(model.SecurityHeadersAccessLevel),
+				domain.Permissions.SecurityHeaders,
+			)
 			assert.Equal(
 				t,
 				user.AccessLevel(model.TrafficStatsAccessLevel),
 				domain.Permissions.TrafficStats,
 			)
+			assert.Equal(t, user.AccessLevel(model.AuditAccessLevel), domain.Permissions.Audit)
 			assert.Equal(t, model.TotpSecret, domain.TOTP.Secret)
 			assert.Equal(t, model.TotpValidated, domain.TOTP.Validated)
 		})
@@ -115,19 +141,24 @@ func Test_Converter(t *testing.T) {
 				PasswordHash: "hash",
 				PasswordSalt: "salt",
 				Permissions: user.Permissions{
-					Hosts:        user.ReadWriteAccessLevel,
-					Streams:      user.ReadWriteAccessLevel,
-					Certificates: user.ReadWriteAccessLevel,
-					Logs:         user.ReadOnlyAccessLevel,
-					Integrations: user.ReadWriteAccessLevel,
-					AccessLists:  user.ReadWriteAccessLevel,
-					Settings:     user.ReadWriteAccessLevel,
-					Users:        user.ReadWriteAccessLevel,
-					NginxServer:  user.ReadWriteAccessLevel,
-					ExportData:   user.ReadOnlyAccessLevel,
-					VPNs:         user.ReadWriteAccessLevel,
-					Caches:       user.ReadWriteAccessLevel,
-					TrafficStats: user.ReadOnlyAccessLevel,
+					Hosts:           user.ReadWriteAccessLevel,
+					Streams:         user.ReadWriteAccessLevel,
+					Certificates:    user.ReadWriteAccessLevel,
+					Logs:            user.ReadOnlyAccessLevel,
+					Integrations:    user.ReadWriteAccessLevel,
+					AccessLists:     user.ReadWriteAccessLevel,
+					Settings:        user.ReadWriteAccessLevel,
+					Users:           user.ReadWriteAccessLevel,
+					NginxServer:     user.ReadWriteAccessLevel,
+					ExportData:      user.ReadOnlyAccessLevel,
+					VPNs:            user.ReadWriteAccessLevel,
+					Caches:          user.ReadWriteAccessLevel,
+					Upstreams:       user.ReadWriteAccessLevel,
+					TLSProfiles:     user.ReadWriteAccessLevel,
+					RateLimits:      user.ReadWriteAccessLevel,
+					SecurityHeaders: user.ReadWriteAccessLevel,
+					TrafficStats:    user.ReadOnlyAccessLevel,
+					Audit:           user.ReadOnlyAccessLevel,
 				},
 				TOTP: user.TOTP{
 					Secret:    new("secret"),
@@ -155,7 +186,16 @@ func Test_Converter(t *testing.T) {
 			assert.Equal(t, string(domain.Permissions.ExportData), model.ExportDataAccessLevel)
 			assert.Equal(t, string(domain.Permissions.VPNs), model.VPNsAccessLevel)
 			assert.Equal(t, string(domain.Permissions.Caches), model.CachesAccessLevel)
+			assert.Equal(t, string(domain.Permissions.Upstreams), model.UpstreamsAccessLevel)
+			assert.Equal(t, string(domain.Permissions.TLSProfiles), model.TLSProfilesAccessLevel)
+			assert.Equal(t, string(domain.Permissions.RateLimits), model.RateLimitsAccessLevel)
+			assert.Equal(
+				t,
+				string(domain.Permissions.SecurityHeaders),
+				model.SecurityHeadersAccessLevel,
+			)
 			assert.Equal(t, string(domain.Permissions.TrafficStats), model.TrafficStatsAccessLevel)
+			assert.Equal(t, string(domain.Permissions.Audit), model.AuditAccessLevel)
 			assert.Equal(t, domain.TOTP.Secret, model.TotpSecret)
 			assert.Equal(t, domain.TOTP.Validated, model.TotpValidated)
 		})
@@ -8,26 +8,32 @@ import (
 type userModel struct {
 	bun.BaseModel `bun:"user"`
 
-	TotpSecret              *string   `bun:"totp_secret"`
-	TotpLastUsedCodes       *string   `bun:"totp_last_used_codes"`
-	IntegrationsAccessLevel string    `bun:"integrations_access_level,notnull"`
-	AccessListsAccessLevel  string    `bun:"access_lists_access_level,notnull"`
-	PasswordHash            string    `bun:"password_hash,notnull"`
-	PasswordSalt            string    `bun:"password_salt,notnull"`
-	HostsAccessLevel        string    `bun:"hosts_access_level,notnull"`
-	CachesAccessLevel       string    `bun:"caches_access_level,notnull"`
-	VPNsAccessLevel         string    `bun:"vpns_access_level,notnull"`
-	LogsAccessLevel         string    `bun:"logs_access_level,notnull"`
-	Name                    string    `bun:"name,notnull"`
-	Username                string    `bun:"username,notnull"`
-	SettingsAccessLevel     string    `bun:"settings_access_level,notnull"`
-	UsersAccessLevel        string    `bun:"users_access_level,notnull"`
-	NginxServerAccessLevel  string    `bun:"nginx_server_access_level,notnull"`
-	ExportDataAccessLevel   string    `bun:"export_data_access_level,notnull"`
-	TrafficStatsAccessLevel string    `bun:"traffic_stats_access_level,notnull"`
-	StreamsAccessLevel      string    `bun:"streams_access_level,notnull"`
-	CertificatesAccessLevel string    `bun:"certificates_access_level,notnull"`
-	ID                      uuid.UUID `bun:"id,pk"`
-	Enabled                 bool      `bun:"enabled,notnull"`
-	TotpValidated           bool      `bun:"totp_validated,notnull"`
+	TotpSecret                 *string   `bun:"totp_secret"`
+	ExternalSubject            *string   `bun:"external_subject"`
+	TotpLastUsedCodes          *string   `bun:"totp_last_used_codes"`
+	IntegrationsAccessLevel    string    `bun:"integrations_access_level,notnull"`
+	AccessListsAccessLevel     string    `bun:"access_lists_access_level,notnull"`
+	PasswordHash               string    `bun:"password_hash,notnull"`
+	PasswordSalt               string    `bun:"password_salt,notnull"`
+	HostsAccessLevel           string    `bun:"hosts_access_level,notnull"`
+	CachesAccessLevel          string    `bun:"caches_access_level,notnull"`
+	UpstreamsAccessLevel       string    `bun:"upstreams_access_level,notnull"`
+	TLSProfilesAccessLevel     string    `bun:"tls_profiles_access_level,notnull"`
+	RateLimitsAccessLevel      string    `bun:"rate_limits_access_level,notnull"`
+	SecurityHeadersAccessLevel string    `bun:"security_headers_access_level,notnull"`
+	VPNsAccessLevel            string    `bun:"vpns_access_level,notnull"`
+	LogsAccessLevel            string    `bun:"logs_access_level,notnull"`
+	Name                       string    `bun:"name,notnull"`
+	Username                   string    `bun:"username,notnull"`
+	SettingsAccessLevel        string    `bun:"settings_access_level,notnull"`
+	UsersAccessLevel           string    `bun:"users_access_level,notnull"`
+	NginxServerAccessLevel     string    `bun:"nginx_server_access_level,notnull"`
+	ExportDataAccessLevel      string    `bun:"export_data_access_level,notnull"`
+	TrafficStatsAccessLevel    string    `bun:"traffic_stats_access_level,notnull"`
+	AuditAccessLevel           string    `bun:"audit_access_level,notnull"`
+	StreamsAccessLevel         string    `bun:"streams_access_level,notnull"`
+	CertificatesAccessLevel    string    `bun:"certificates_access_level,notnull"`
+	ID                         uuid.UUID `bun:"id,pk"`
+	Enabled                    bool      `bun:"enabled,notnull"`
+	TotpValidated              bool      `bun:"totp_validated,notnull"`
 }
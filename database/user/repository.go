@@ -26,7 +26,7 @@ func New(db *database.Database) user.Repository {
 func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
 	var model userModel
 
-	err := r.database.Select().Model(&model).Where(constants.ByIDFilter, id).Scan(ctx)
+	err := r.database.Select(ctx).Model(&model).Where(constants.ByIDFilter, id).Scan(ctx)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
@@ -40,7 +40,7 @@ func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*user.User, er
 }
 
 func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -48,6 +48,16 @@ func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
 	//nolint:errcheck
 	defer transaction.Rollback()
 
+	for _, table := range []string{"api_token", "user_session"} {
+		_, err = transaction.NewDelete().
+			Table(table).
+			Where("user_id = ?", id).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
 	_, err = transaction.NewDelete().
 		Model((*userModel)(nil)).
 		Where(constants.ByIDFilter, id).
@@ -62,7 +72,7 @@ func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
 func (r *repository) FindByUsername(ctx context.Context, username string) (*user.User, error) {
 	var model userModel
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&model).
 		Where("username = ?", username).
 		Scan(ctx)
@@ -78,6 +88,28 @@ func (r *repository) FindByUsername(ctx context.Context, username string) (*user
 	return new(toDomain(&model)), nil
 }
 
+func (r *repository) FindByExternalSubject(
+	ctx context.Context,
+	subject string,
+) (*user.User, error) {
+	var model userModel
+
+	err := r.database.Select(ctx).
+		Model(&model).
+		Where("external_subject = ?", subject).
+		Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return new(toDomain(&model)), nil
+}
+
 func (r *repository) FindPage(
 	ctx context.Context,
 	pageSize, pageNumber int,
@@ -85,7 +117,7 @@ func (r *repository) FindPage(
 ) (*pagination.Page[user.User], error) {
 	models := make([]userModel, 0)
 
-	query := r.database.Select().Model(&models)
+	query := r.database.Select(ctx).Model(&models)
 	if searchTerms != nil {
 		query = query.Where(
 			"LOWER(name) LIKE LOWER(?) OR LOWER(username) LIKE LOWER(?)",
@@ -99,7 +131,7 @@ func (r *repository) FindPage(
 		return nil, err
 	}
 
-	query = r.database.Select().Model(&models)
+	query = r.database.Select(ctx).Model(&models)
 	if searchTerms != nil {
 		query = query.Where(
 			"LOWER(name) LIKE LOWER(?) OR LOWER(username) LIKE LOWER(?)",
@@ -128,7 +160,7 @@ func (r *repository) FindPage(
 func (r *repository) IsEnabledByID(ctx context.Context, id uuid.UUID) (bool, error) {
 	var model userModel
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&model).
 		Where(constants.ByIDFilter, id).
 		Scan(ctx)
@@ -145,7 +177,7 @@ func (r *repository) IsEnabledByID(ctx context.Context, id uuid.UUID) (bool, err
 }
 
 func (r *repository) Count(ctx context.Context) (int, error) {
-	count, err := r.database.Select().Model((*userModel)(nil)).Count(ctx)
+	count, err := r.database.Select(ctx).Model((*userModel)(nil)).Count(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -154,7 +186,7 @@ func (r *repository) Count(ctx context.Context) (int, error) {
 }
 
 func (r *repository) Save(ctx context.Context, u *user.User) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -189,7 +221,7 @@ func (r *repository) TryUpdateLastUsedTOTPCode(
 	id uuid.UUID,
 	code string,
 ) (bool, error) {
-	result, err := r.database.Update().
+	result, err := r.database.Update(ctx).
 		Model((*userModel)(nil)).
 		Set("totp_last_used_codes = SUBSTR(? || COALESCE(',' || totp_last_used_codes, ''), 1, 20)", code).
 		Where("id = ? AND (totp_last_used_codes IS NULL OR (',' || totp_last_used_codes || ',') NOT LIKE ?)", id, "%,"+code+",%").
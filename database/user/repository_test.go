@@ -74,6 +74,28 @@ func runRepositoryTests(t *testing.T, db *database.Database) {
 		})
 	})
 
+	t.Run("FindByExternalSubject", func(t *testing.T) {
+		t.Run("returns user linked to the subject", func(t *testing.T) {
+			cmd := newUser()
+			cmd.ID = uuid.New()
+			cmd.Username = uuid.New().String()
+			cmd.ExternalSubject = new(uuid.New().String())
+			require.NoError(t, repo.Save(t.Context(), cmd))
+
+			saved, err := repo.FindByExternalSubject(t.Context(), *cmd.ExternalSubject)
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, cmd.ID, saved.ID)
+			assert.Equal(t, cmd.ExternalSubject, saved.ExternalSubject)
+		})
+
+		t.Run("returns nil if not found", func(t *testing.T) {
+			saved, err := repo.FindByExternalSubject(t.Context(), "nonexistent")
+			require.NoError(t, err)
+			assert.Nil(t, saved)
+		})
+	})
+
 	t.Run("FindPage", func(t *testing.T) {
 		t.Run("returns a page of users filtered by name or username", func(t *testing.T) {
 			prefix := uuid.New().String()
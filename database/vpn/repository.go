@@ -26,7 +26,7 @@ func New(db *database.Database) vpn.Repository {
 func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*vpn.VPN, error) {
 	var model vpnModel
 
-	err := r.database.Select().
+	err := r.database.Select(ctx).
 		Model(&model).
 		Where(constants.ByIDFilter, id).
 		Scan(ctx)
@@ -43,7 +43,7 @@ func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*vpn.VPN, erro
 }
 
 func (r *repository) ExistsByName(ctx context.Context, name string) (*bool, error) {
-	count, err := r.database.Select().
+	count, err := r.database.Select(ctx).
 		Model((*vpnModel)(nil)).
 		Where("name = ?", name).
 		Count(ctx)
@@ -60,7 +60,7 @@ func (r *repository) ExistsByName(ctx context.Context, name string) (*bool, erro
 }
 
 func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (*bool, error) {
-	exists, err := r.database.Select().
+	exists, err := r.database.Select(ctx).
 		Model((*vpnModel)(nil)).
 		Where(constants.ByIDFilter, id).
 		Exists(ctx)
@@ -69,7 +69,7 @@ func (r *repository) ExistsByID(ctx context.Context, id uuid.UUID) (*bool, error
 }
 
 func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (*bool, error) {
-	count, err := r.database.Select().
+	count, err := r.database.Select(ctx).
 		Table("host_vpn").
 		Where("vpn_id = ?", id).
 		Count(ctx)
@@ -86,7 +86,7 @@ func (r *repository) InUseByID(ctx context.Context, id uuid.UUID) (*bool, error)
 }
 
 func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
-	tx, err := r.database.Begin()
+	tx, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -103,7 +103,7 @@ func (r *repository) DeleteByID(ctx context.Context, id uuid.UUID) error {
 }
 
 func (r *repository) Save(ctx context.Context, values *vpn.VPN) error {
-	transaction, err := r.database.Begin()
+	transaction, err := r.database.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -148,7 +148,7 @@ func (r *repository) FindPage(
 ) (*pagination.Page[vpn.VPN], error) {
 	models := make([]vpnModel, 0)
 
-	query := r.database.Select().Model(&models)
+	query := r.database.Select(ctx).Model(&models)
 	if searchTerms != nil && *searchTerms != "" {
 		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+*searchTerms+"%")
 	}
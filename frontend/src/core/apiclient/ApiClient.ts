@@ -70,6 +70,48 @@ export default class ApiClient {
         }
     }
 
+    async stream(
+        path: string | undefined,
+        queryParams: { [key: string]: any } | undefined,
+        onEvent: (event: string, data: string) => void,
+        signal: AbortSignal,
+    ): Promise<void> {
+        const request = await this.buildRequest("GET")
+        request.signal = signal
+        ApiClientEventDispatcher.notifyRequest(request)
+
+        const response = await fetch(this.buildFullPath(path, queryParams), request)
+        const headers: Header[] = Array.from(response.headers.entries()).map(([key, value]) => ({ key, value }))
+        ApiClientEventDispatcher.notifyResponse(request, { raw: response, statusCode: response.status, headers })
+
+        if (!response.ok || response.body === null)
+            throw new Error(`Unable to open the event stream (status ${response.status})`)
+
+        const reader = response.body.pipeThrough(new TextDecoderStream()).getReader()
+        let buffer = ""
+        while (true) {
+            const { value, done } = await reader.read()
+            if (done) return
+
+            buffer += value
+            const events = buffer.split("\n\n")
+            buffer = events.pop() ?? ""
+            events.forEach(event => this.dispatchStreamEvent(event, onEvent))
+        }
+    }
+
+    private dispatchStreamEvent(event: string, onEvent: (event: string, data: string) => void) {
+        let name = "message"
+        const data: string[] = []
+
+        for (const line of event.split("\n")) {
+            if (line.startsWith("event:")) name = line.substring(6).trim()
+            else if (line.startsWith("data:")) data.push(line.substring(5).trimStart())
+        }
+
+        if (data.length > 0) onEvent(name, data.join("\n"))
+    }
+
     private buildFullPath(path?: string, queryParams?: { [key: string]: any }): string {
         const queryStringValues = Array.from(Object.entries(queryParams ?? {}))
             .filter(([_, value]) => value !== undefined)
@@ -16,6 +16,10 @@ import {
     DownloadOutlined,
     ApartmentOutlined,
     RocketOutlined,
+    ClusterOutlined,
+    SafetyCertificateOutlined,
+    DashboardOutlined,
+    SecurityScanOutlined,
 } from "@ant-design/icons"
 import HostListPage from "./host/HostListPage"
 import HostFormPage from "./host/HostFormPage"
@@ -38,7 +42,15 @@ import VpnListPage from "./vpn/VpnListPage"
 import VpnFormPage from "./vpn/VpnFormPage"
 import CacheFormPage from "./cache/CacheFormPage"
 import CacheListPage from "./cache/CacheListPage"
+import UpstreamFormPage from "./upstream/UpstreamFormPage"
+import UpstreamListPage from "./upstream/UpstreamListPage"
 import TrafficStatsPage from "./trafficstats/TrafficStatsPage"
+import TlsProfileFormPage from "./tlsprofile/TlsProfileFormPage"
+import TlsProfileListPage from "./tlsprofile/TlsProfileListPage"
+import RateLimitFormPage from "./ratelimit/RateLimitFormPage"
+import RateLimitListPage from "./ratelimit/RateLimitListPage"
+import SecurityHeadersFormPage from "./securityheaders/SecurityHeadersFormPage"
+import SecurityHeadersListPage from "./securityheaders/SecurityHeadersListPage"
 import MessageKey from "../core/i18n/model/MessageKey.generated"
 
 const Routes: AppRoute[] = [
@@ -112,6 +124,23 @@ const Routes: AppRoute[] = [
             icon: <AuditOutlined />,
         },
     },
+    {
+        path: "/tls-profiles/:id",
+        requiresAuthentication: true,
+        fullPage: false,
+        component: <TlsProfileFormPage />,
+        activeMenuItemPath: "/tls-profiles",
+    },
+    {
+        path: "/tls-profiles",
+        requiresAuthentication: true,
+        fullPage: false,
+        component: <TlsProfileListPage />,
+        menuItem: {
+            description: MessageKey.CommonTlsProfiles,
+            icon: <SafetyCertificateOutlined />,
+        },
+    },
     {
         path: "/logs",
         requiresAuthentication: true,
@@ -183,6 +212,57 @@ const Routes: AppRoute[] = [
             icon: <RocketOutlined />,
         },
     },
+    {
+        path: "/rate-limits/:id",
+        requiresAuthentication: true,
+        fullPage: false,
+        component: <RateLimitFormPage />,
+        activeMenuItemPath: "/rate-limits",
+    },
+    {
+        path: "/rate-limits",
+        requiresAuthentication: true,
+        fullPage: false,
+        component: <RateLimitListPage />,
+        menuItem: {
+            description: MessageKey.CommonRateLimits,
+            icon: <DashboardOutlined />,
+        },
+    },
+    {
+        path: "/security-headers/:id",
+        requiresAuthentication: true,
+        fullPage: false,
+        component: <SecurityHeadersFormPage />,
+        activeMenuItemPath: "/security-headers",
+    },
+    {
+        path: "/security-headers",
+        requiresAuthentication: true,
+        fullPage: false,
+        component: <SecurityHeadersListPage />,
+        menuItem: {
+            description: MessageKey.CommonSecurityHeaders,
+            icon: <SecurityScanOutlined />,
+        },
+    },
+    {
+        path: "/upstreams/:id",
+        requiresAuthentication: true,
+        fullPage: false,
+        component: <UpstreamFormPage />,
+        activeMenuItemPath: "/upstreams",
+    },
+    {
+        path: "/upstreams",
+        requiresAuthentication: true,
+        fullPage: false,
+        component: <UpstreamListPage />,
+        menuItem: {
+            description: MessageKey.CommonUpstreams,
+            icon: <ClusterOutlined />,
+        },
+    },
     {
         path: "/access-lists/:id",
         requiresAuthentication: true,
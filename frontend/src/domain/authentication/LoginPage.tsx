@@ -1,5 +1,5 @@
 import React, { createRef } from "react"
-import { ArrowLeftOutlined, LockOutlined, SafetyOutlined, UserOutlined } from "@ant-design/icons"
+import { ArrowLeftOutlined, LockOutlined, LoginOutlined, SafetyOutlined, UserOutlined } from "@ant-design/icons"
 import { Navigate } from "react-router-dom"
 import { Button, Form, Input, Typography } from "antd"
 import type { OTPRef } from "antd/es/input/OTP"
@@ -19,6 +19,10 @@ import { I18n, i18n } from "../../core/i18n/I18n"
 import ThemeToggle from "../../core/components/theme/ThemeToggle"
 import I18nLanguagePicker from "../../core/i18n/I18nLanguagePicker"
 import ShellUserMenuQueue from "../user/components/ShellUserMenuQueue"
+import UserOidcStatusResponse from "../user/model/UserOidcStatusResponse"
+import AuthenticationService from "../../core/authentication/AuthenticationService"
+
+const OIDC_TOKEN_HASH_PREFIX = "#oidcToken="
 
 interface TotpState {
     failed: boolean
@@ -36,6 +40,7 @@ interface LoginPageState {
     backgroundImageUrl: string
     totp?: TotpState
     credentials?: Credentials
+    oidc?: UserOidcStatusResponse
 }
 
 export default class LoginPage extends React.Component<any, LoginPageState> {
@@ -70,6 +75,12 @@ export default class LoginPage extends React.Component<any, LoginPageState> {
         switch (outcome) {
             case LoginOutcome.SUCCESS:
                 return this.handleSuccessfulLogin()
+            case LoginOutcome.LOCKED:
+                Notification.error(
+                    MessageKey.FrontendAuthenticationLoginLockedTitle,
+                    MessageKey.FrontendAuthenticationLoginLockedMessage,
+                )
+                break
             case LoginOutcome.MISSING_TOTP:
                 this.setState(
                     {
@@ -99,7 +110,7 @@ export default class LoginPage extends React.Component<any, LoginPageState> {
         }
     }
 
-    private async handleSuccessfulLogin() {
+    private async handleSuccessfulLogin(singleSignOn?: boolean) {
         const { totp } = this.state
         const returnTo = queryParams().returnTo as string | undefined
 
@@ -107,7 +118,7 @@ export default class LoginPage extends React.Component<any, LoginPageState> {
             .container!!.reload()
             .then(() => {
                 if (returnTo) navigateTo(returnTo, true)
-                if (!totp)
+                if (!totp && !singleSignOn)
                     Notification.warning(
                         MessageKey.FrontendUserMenuTotpDisabledTitle,
                         MessageKey.FrontendUserMenuTotpDisabledWarningDescription,
@@ -134,6 +145,57 @@ export default class LoginPage extends React.Component<any, LoginPageState> {
         )
     }
 
+    private handleOidcRedirect() {
+        const { hash } = window.location
+        if (hash.startsWith(OIDC_TOKEN_HASH_PREFIX)) {
+            const token = decodeURIComponent(hash.substring(OIDC_TOKEN_HASH_PREFIX.length))
+            window.history.replaceState(null, "", window.location.pathname + window.location.search)
+            AuthenticationService.setToken(token)
+            this.setState({ loading: true })
+            this.handleSuccessfulLogin(true).then(() => this.setState({ loading: false }))
+            return
+        }
+
+        const oidcError = queryParams().oidcError as string | undefined
+        if (oidcError) {
+            const knownKeys = Object.values(MessageKey) as string[]
+            const message = knownKeys.includes(oidcError)
+                ? (oidcError as MessageKey)
+                : MessageKey.ApiUserOidcAuthenticationFailed
+            navigateTo("/login", true)
+            Notification.error(MessageKey.FrontendAuthenticationSsoFailedTitle, message)
+        }
+    }
+
+    private fetchOidcStatus() {
+        this.service
+            .oidcStatus()
+            .then(oidc => this.setState({ oidc }))
+            .catch(() => undefined)
+    }
+
+    private handleOidcLogin() {
+        const returnTo = queryParams().returnTo as string | undefined
+        window.location.href = this.service.oidcLoginUrl(returnTo)
+    }
+
+    private renderOidcAction() {
+        const { oidc, totp } = this.state
+        if (!oidc?.enabled || totp) return undefined
+
+        return (
+            <Button
+                block
+                size="large"
+                icon={<LoginOutlined />}
+                style={{ marginTop: oidc.localLoginEnabled ? 16 : 0 }}
+                onClick={this.handleOidcLogin.bind(this)}
+            >
+                <I18n id={MessageKey.FrontendAuthenticationSsoLoginButton} />
+            </Button>
+        )
+    }
+
     private handleTotpSubmit() {
         const { credentials, totp } = this.state
         if (!credentials || totp?.code.length !== 6) return
@@ -228,7 +290,8 @@ export default class LoginPage extends React.Component<any, LoginPageState> {
     }
 
     private renderForm() {
-        const { backgroundImageUrl, totp, loading } = this.state
+        const { backgroundImageUrl, totp, loading, oidc } = this.state
+        const localLoginEnabled = oidc?.localLoginEnabled ?? true
 
         return (
             <LoginFormPage
@@ -238,8 +301,9 @@ export default class LoginPage extends React.Component<any, LoginPageState> {
                 subTitle={this.renderSubtitle()}
                 onFinish={totp ? this.handleTotpSubmit.bind(this) : this.handleSubmit.bind(this)}
                 backgroundImageUrl={backgroundImageUrl}
+                actions={this.renderOidcAction()}
                 submitter={
-                    totp
+                    totp || !localLoginEnabled
                         ? { render: () => [] }
                         : {
                               searchConfig: {
@@ -266,7 +330,7 @@ export default class LoginPage extends React.Component<any, LoginPageState> {
                     width: 10,
                 }}
             >
-                {totp ? this.renderTotpFields() : this.renderCredentialFields()}
+                {totp ? this.renderTotpFields() : localLoginEnabled && this.renderCredentialFields()}
             </LoginFormPage>
         )
     }
@@ -278,6 +342,8 @@ export default class LoginPage extends React.Component<any, LoginPageState> {
 
     componentDidMount() {
         ThemeContext.register(this.handleThemeChange.bind(this))
+        this.fetchOidcStatus()
+        this.handleOidcRedirect()
     }
 
     componentWillUnmount() {
@@ -1,5 +1,6 @@
 import ApiClient from "../../core/apiclient/ApiClient"
 import ApiResponse from "../../core/apiclient/ApiResponse"
+import BackupRunResponse from "./model/BackupRunResponse"
 
 export default class BackupGateway {
     private readonly client: ApiClient
@@ -11,4 +12,8 @@ export default class BackupGateway {
     async download(): Promise<ApiResponse<any>> {
         return this.client.get("", undefined, undefined, true)
     }
+
+    async latestSuccessfulRun(): Promise<ApiResponse<BackupRunResponse>> {
+        return this.client.get("/runs/latest-successful")
+    }
 }
@@ -0,0 +1,15 @@
+import BackupGateway from "./BackupGateway"
+import BackupRunResponse from "./model/BackupRunResponse"
+import { requireNullablePayload } from "../../core/apiclient/ApiResponse"
+
+export default class BackupService {
+    private readonly gateway: BackupGateway
+
+    constructor() {
+        this.gateway = new BackupGateway()
+    }
+
+    async latestSuccessfulRun(): Promise<BackupRunResponse | undefined> {
+        return this.gateway.latestSuccessfulRun().then(requireNullablePayload)
+    }
+}
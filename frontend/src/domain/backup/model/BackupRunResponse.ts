@@ -0,0 +1,17 @@
+import { BackupDestination } from "../../settings/model/SettingsDto"
+
+export enum BackupRunStatus {
+    SUCCEEDED = "SUCCEEDED",
+    FAILED = "FAILED",
+}
+
+export default interface BackupRunResponse {
+    id: string
+    startedAt: string
+    finishedAt: string
+    status: BackupRunStatus
+    destination: BackupDestination
+    fileName?: string
+    sizeBytes: number
+    errorMessage?: string
+}
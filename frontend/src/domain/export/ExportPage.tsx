@@ -5,7 +5,13 @@ import { isAccessGranted } from "../../core/components/accesscontrol/IsAccessGra
 import { Button, Flex, Form, Input, Modal, Space } from "antd"
 import ExportService from "./ExportService"
 import AppShellContext from "../../core/components/shell/AppShellContext"
-import { DatabaseOutlined, DownloadOutlined, FileZipOutlined, QuestionCircleOutlined } from "@ant-design/icons"
+import {
+    DatabaseOutlined,
+    DownloadOutlined,
+    FileTextOutlined,
+    FileZipOutlined,
+    QuestionCircleOutlined,
+} from "@ant-design/icons"
 import Notification from "../../core/components/notification/Notification"
 import { themedModal } from "../../core/components/theme/ThemedResources"
 import "./ExportPage.css"
@@ -21,6 +27,7 @@ interface ExportPageState {
     nginxTempPath: string
     nginxLoading: boolean
     databaseLoading: boolean
+    stateLoading: boolean
 }
 
 export default class ExportPage extends React.Component<any, ExportPageState> {
@@ -39,6 +46,7 @@ export default class ExportPage extends React.Component<any, ExportPageState> {
             nginxCachePath: "",
             nginxTempPath: "",
             databaseLoading: false,
+            stateLoading: false,
         }
     }
 
@@ -59,6 +67,23 @@ export default class ExportPage extends React.Component<any, ExportPageState> {
         )
     }
 
+    private declarativeConfiguration() {
+        this.setState({ stateLoading: true }, () =>
+            this.service
+                .downloadDeclarativeConfiguration()
+                .catch(error => this.showErrorNotification(error))
+                .then(() => this.setState({ stateLoading: false })),
+        )
+    }
+
+    private openDeclarativeConfigurationHelpGuide() {
+        window.open(
+            "https://github.com/lucasdillmann/nginx-ignition/blob/main/docs/declarative-configuration.md",
+            "_blank",
+            "noopener",
+        )
+    }
+
     private openNginxModal() {
         this.setState({ nginxModalOpen: true })
     }
@@ -127,6 +152,46 @@ export default class ExportPage extends React.Component<any, ExportPageState> {
         )
     }
 
+    private renderDeclarativeConfiguration(): ReactNode {
+        const { stateLoading } = this.state
+        return (
+            <Flex className="export-guide-section">
+                <Flex className="export-guide-section-content" vertical>
+                    <Flex className="export-guide-section-title">
+                        <h2>
+                            <FileTextOutlined /> <I18n id={MessageKey.FrontendExportSectionStateTitle} />
+                        </h2>
+                        <div className="export-guide-section-action">
+                            <Button
+                                type="default"
+                                size="large"
+                                onClick={() => this.openDeclarativeConfigurationHelpGuide()}
+                                style={{ marginRight: 10 }}
+                            >
+                                <QuestionCircleOutlined />
+                            </Button>
+                            <Space />
+                            <Button
+                                type="primary"
+                                size="large"
+                                loading={stateLoading}
+                                onClick={() => this.declarativeConfiguration()}
+                            >
+                                <DownloadOutlined /> <I18n id={MessageKey.CommonDownload} />
+                            </Button>
+                        </div>
+                    </Flex>
+                    <p>
+                        <I18n id={MessageKey.FrontendExportSectionStateDescription1} />
+                    </p>
+                    <p>
+                        <I18n id={MessageKey.FrontendExportSectionStateDescription2} />
+                    </p>
+                </Flex>
+            </Flex>
+        )
+    }
+
     private renderNginxConfigurationFiles(): ReactNode {
         const { nginxLoading } = this.state
         return (
@@ -287,6 +352,7 @@ export default class ExportPage extends React.Component<any, ExportPageState> {
         return (
             <div className="export-guide-container">
                 {this.renderDatabaseBackup()}
+                {this.renderDeclarativeConfiguration()}
                 {this.renderNginxConfigurationFiles()}
                 {this.renderNginxConfigurationModal()}
             </div>
@@ -1,14 +1,17 @@
 import NginxGateway from "../nginx/NginxGateway"
 import BackupGateway from "../backup/BackupGateway"
+import StateGateway from "../state/StateGateway"
 import { requireSuccessRawResponse } from "../../core/apiclient/ApiResponse"
 
 export default class ExportService {
     private readonly nginxGateway: NginxGateway
     private readonly backupGateway: BackupGateway
+    private readonly stateGateway: StateGateway
 
     constructor() {
         this.nginxGateway = new NginxGateway()
         this.backupGateway = new BackupGateway()
+        this.stateGateway = new StateGateway()
     }
 
     async downloadNginxConfigurationFiles(
@@ -37,6 +40,14 @@ export default class ExportService {
             .then(data => this.sendBlob(data.blob, data.fileName))
     }
 
+    async downloadDeclarativeConfiguration(): Promise<void> {
+        return this.stateGateway
+            .export()
+            .then(requireSuccessRawResponse)
+            .then(response => response.raw.blob())
+            .then(blob => this.sendBlob(blob, "nginx-ignition.yaml"))
+    }
+
     private getFileName(response: Response): string {
         const fallbackName = "backup.bin"
 
@@ -8,30 +8,46 @@ import HostFormValues, {
 } from "./model/HostFormValues"
 import HostRequest, {
     HostBinding,
+    HostHeaderRule,
+    HostHeaderRuleAction,
+    HostRewriteRule,
     HostRoute,
     HostRouteIntegration,
+    HostRouteMatchType,
     HostRouteStaticResponse,
     HostRouteType,
     HostVpn,
 } from "./model/HostRequest"
 import CertificateService from "../certificate/CertificateService"
+import TlsProfileService from "../tlsprofile/TlsProfileService"
 import IntegrationService from "../integration/IntegrationService"
 import AccessListService from "../accesslist/AccessListService"
 import VpnService from "../vpn/VpnService"
 import CacheService from "../cache/CacheService"
+import RateLimitService from "../ratelimit/RateLimitService"
+import SecurityHeadersService from "../securityheaders/SecurityHeadersService"
+import UpstreamService from "../upstream/UpstreamService"
 
 class HostConverter {
     private readonly certificateService: CertificateService
+    private readonly tlsProfileService: TlsProfileService
     private readonly integrationService: IntegrationService
     private readonly accessListService: AccessListService
     private readonly cacheService: CacheService
+    private readonly rateLimitService: RateLimitService
+    private readonly securityHeadersService: SecurityHeadersService
+    private readonly upstreamService: UpstreamService
     private readonly vpnService: VpnService
 
     constructor() {
         this.certificateService = new CertificateService()
+        this.tlsProfileService = new TlsProfileService()
         this.integrationService = new IntegrationService()
         this.accessListService = new AccessListService()
         this.cacheService = new CacheService()
+        this.rateLimitService = new RateLimitService()
+        this.securityHeadersService = new SecurityHeadersService()
+        this.upstreamService = new UpstreamService()
         this.vpnService = new VpnService()
     }
 
@@ -77,6 +93,13 @@ class HostConverter {
         const cachePromise = this.notNull(route.cacheId)
             ? this.cacheService.getById(route.cacheId!!)
             : Promise.resolve(undefined)
+        const rateLimitPromise = this.notNull(route.rateLimitId)
+            ? this.rateLimitService.getById(route.rateLimitId!!)
+            : Promise.resolve(undefined)
+        const upstreamPromise =
+            this.notNull(route.upstreamId) && route.type === HostRouteType.PROXY
+                ? this.upstreamService.getById(route.upstreamId!!)
+                : Promise.resolve(undefined)
         const integrationPromise =
             this.notNull(route.integration) && route.type === HostRouteType.INTEGRATION
                 ? this.integrationToFormValues(route.integration!!)
@@ -84,9 +107,11 @@ class HostConverter {
 
         const response = this.notNull(route.response) ? this.staticResponseToFormValues(route.response!!) : undefined
 
-        const [accessList, cache, integration] = await Promise.all([
+        const [accessList, cache, rateLimit, upstream, integration] = await Promise.all([
             accessListPromise,
             cachePromise,
+            rateLimitPromise,
+            upstreamPromise,
             integrationPromise,
         ])
         return {
@@ -95,6 +120,11 @@ class HostConverter {
             integration,
             accessList,
             cache,
+            rateLimit,
+            upstream,
+            matchType: route.matchType ?? HostRouteMatchType.PREFIX,
+            headerRules: route.headerRules ?? [],
+            rewriteRules: route.rewriteRules ?? [],
         }
     }
 
@@ -102,10 +132,14 @@ class HostConverter {
         const certificate = this.notNull(binding.certificateId)
             ? await this.certificateService.getById(binding.certificateId!!)
             : undefined
+        const tlsProfile = this.notNull(binding.tlsProfileId)
+            ? await this.tlsProfileService.getById(binding.tlsProfileId!!)
+            : undefined
 
         return {
             ...binding,
             certificate,
+            tlsProfile,
         }
     }
 
@@ -163,11 +197,27 @@ class HostConverter {
         }
     }
 
+    private formValuesToHeaderRule(rule: HostHeaderRule): HostHeaderRule {
+        return {
+            ...rule,
+            value: rule.action === HostHeaderRuleAction.REMOVE ? undefined : rule.value,
+            always: rule.always ?? false,
+        }
+    }
+
+    private formValuesToRewriteRule(rule: HostRewriteRule): HostRewriteRule {
+        return {
+            ...rule,
+            flag: rule.flag ?? undefined,
+        }
+    }
+
     private formValuesToRoute(route: HostFormRoute): HostRoute {
         const {
             priority,
             enabled,
             type,
+            matchType,
             settings,
             targetUri,
             sourcePath,
@@ -175,6 +225,10 @@ class HostConverter {
             redirectCode,
             sourceCode,
             cache,
+            rateLimit,
+            upstream,
+            headerRules,
+            rewriteRules,
         } = route
         const response =
             type === HostRouteType.STATIC_RESPONSE && this.notNull(route.response)
@@ -197,6 +251,7 @@ class HostConverter {
                 : undefined
         const sourceCodeForType = type === HostRouteType.EXECUTE_CODE ? sourceCode : undefined
         const redirectCodeForType = type === HostRouteType.REDIRECT ? redirectCode : undefined
+        const upstreamIdForType = type === HostRouteType.PROXY ? upstream?.id : undefined
         const accessListIdForType =
             type === HostRouteType.INTEGRATION || type === HostRouteType.PROXY || type === HostRouteType.STATIC_FILES
                 ? accessList?.id
@@ -206,6 +261,7 @@ class HostConverter {
             priority,
             enabled,
             type,
+            matchType,
             settings,
             targetUri: targetUriForType,
             sourcePath,
@@ -215,6 +271,10 @@ class HostConverter {
             sourceCode: sourceCodeForType,
             accessListId: accessListIdForType,
             cacheId: cache?.id,
+            rateLimitId: rateLimit?.id,
+            upstreamId: upstreamIdForType,
+            headerRules: headerRules?.map(rule => this.formValuesToHeaderRule(rule)) ?? [],
+            rewriteRules: rewriteRules?.map(rule => this.formValuesToRewriteRule(rule)) ?? [],
         }
     }
 
@@ -222,9 +282,11 @@ class HostConverter {
         const output = {
             ...binding,
             certificateId: binding.certificate?.id,
+            tlsProfileId: binding.tlsProfile?.id,
         }
 
         delete output.certificate
+        delete output.tlsProfile
         return output
     }
 
@@ -245,7 +307,18 @@ class HostConverter {
     }
 
     async responseToFormValues(response: HostResponse): Promise<HostFormValues> {
-        const { enabled, domainNames, featureSet, defaultServer, useGlobalBindings, accessListId, cacheId } = response
+        const {
+            enabled,
+            domainNames,
+            featureSet,
+            defaultServer,
+            useGlobalBindings,
+            accessListId,
+            cacheId,
+            rateLimitId,
+            securityHeadersId,
+            headerRules,
+        } = response
 
         const routes = response.routes.map(route => this.routeToFormValues(route))
         const responseBindings = response.bindings ?? []
@@ -255,12 +328,20 @@ class HostConverter {
             ? this.accessListService.getById(accessListId!!)
             : Promise.resolve(undefined)
         const cachePromise = this.notNull(cacheId) ? this.cacheService.getById(cacheId!!) : Promise.resolve(undefined)
+        const rateLimitPromise = this.notNull(rateLimitId)
+            ? this.rateLimitService.getById(rateLimitId!!)
+            : Promise.resolve(undefined)
+        const securityHeadersPromise = this.notNull(securityHeadersId)
+            ? this.securityHeadersService.getById(securityHeadersId!!)
+            : Promise.resolve(undefined)
 
-        const [bindings, vpnsResolved, accessList, cache] = await Promise.all([
+        const [bindings, vpnsResolved, accessList, cache, rateLimit, securityHeaders] = await Promise.all([
             bindingsPromise,
             vpnsPromise,
             accessListPromise,
             cachePromise,
+            rateLimitPromise,
+            securityHeadersPromise,
         ])
         const vpns = vpnsResolved.filter((entry): entry is HostFormVpn => this.notNull(entry))
 
@@ -273,13 +354,27 @@ class HostConverter {
             useGlobalBindings,
             accessList,
             cache,
+            rateLimit,
+            securityHeaders,
+            headerRules: headerRules ?? [],
             domainNames: domainNames ?? [""],
             routes: await Promise.all(routes),
         }
     }
 
     formValuesToRequest(formValues: HostFormValues): HostRequest {
-        const { enabled, domainNames, featureSet, defaultServer, useGlobalBindings, accessList, cache } = formValues
+        const {
+            enabled,
+            domainNames,
+            featureSet,
+            defaultServer,
+            useGlobalBindings,
+            accessList,
+            cache,
+            rateLimit,
+            securityHeaders,
+            headerRules,
+        } = formValues
 
         const routes = formValues.routes.map(route => this.formValuesToRoute(route))
         const bindings = useGlobalBindings ? [] : formValues.bindings.map(binding => this.formValuesToBinding(binding))
@@ -295,6 +390,9 @@ class HostConverter {
             vpns,
             accessListId: accessList?.id,
             cacheId: cache?.id,
+            rateLimitId: rateLimit?.id,
+            securityHeadersId: securityHeaders?.id,
+            headerRules: headerRules?.map(rule => this.formValuesToHeaderRule(rule)) ?? [],
             domainNames: defaultServer ? [] : domainNames,
         }
     }
@@ -31,8 +31,13 @@ import AccessDeniedPage from "../../core/components/accesscontrol/AccessDeniedPa
 import { hostFormValuesDefaults } from "./model/HostFormValuesDefaults"
 import HostSupportWarning from "./components/HostSupportWarning"
 import HostVpns from "./components/HostVpns"
+import HostHeaderRules from "./components/HostHeaderRules"
 import CacheService from "../cache/CacheService"
 import CacheResponse from "../cache/model/CacheResponse"
+import RateLimitService from "../ratelimit/RateLimitService"
+import RateLimitResponse from "../ratelimit/model/RateLimitResponse"
+import SecurityHeadersService from "../securityheaders/SecurityHeadersService"
+import SecurityHeadersResponse from "../securityheaders/model/SecurityHeadersResponse"
 import MessageKey from "../../core/i18n/model/MessageKey.generated"
 import { I18n } from "../../core/i18n/I18n"
 import NginxService from "../nginx/NginxService"
@@ -52,6 +57,8 @@ export default class HostFormPage extends React.Component<any, HostFormPageState
     private readonly hostService: HostService
     private readonly accessListService: AccessListService
     private readonly cacheService: CacheService
+    private readonly rateLimitService: RateLimitService
+    private readonly securityHeadersService: SecurityHeadersService
     private readonly nginxService: NginxService
     private readonly saveModal: ModalPreloader
     private readonly formRef: React.RefObject<FormInstance | null>
@@ -65,6 +72,8 @@ export default class HostFormPage extends React.Component<any, HostFormPageState
         this.hostService = new HostService()
         this.accessListService = new AccessListService()
         this.cacheService = new CacheService()
+        this.rateLimitService = new RateLimitService()
+        this.securityHeadersService = new SecurityHeadersService()
         this.nginxService = new NginxService()
         this.saveModal = new ModalPreloader()
         this.formRef = React.createRef()
@@ -227,6 +236,22 @@ export default class HostFormPage extends React.Component<any, HostFormPageState
         return this.cacheService.list(pageSize, pageNumber, searchTerms)
     }
 
+    private fetchRateLimits(
+        pageSize: number,
+        pageNumber: number,
+        searchTerms?: string,
+    ): Promise<PageResponse<RateLimitResponse>> {
+        return this.rateLimitService.list(pageSize, pageNumber, searchTerms)
+    }
+
+    private fetchSecurityHeaders(
+        pageSize: number,
+        pageNumber: number,
+        searchTerms?: string,
+    ): Promise<PageResponse<SecurityHeadersResponse>> {
+        return this.securityHeadersService.list(pageSize, pageNumber, searchTerms)
+    }
+
     private renderStatsSwitch() {
         const { metadata, validationResult } = this.state
 
@@ -353,6 +378,44 @@ export default class HostFormPage extends React.Component<any, HostFormPageState
                                 allowEmpty
                             />
                         </Form.Item>
+                        <Form.Item
+                            name="rateLimit"
+                            validateStatus={validationResult.getStatus("rateLimitId")}
+                            help={
+                                validationResult.getMessage("rateLimitId") ?? (
+                                    <I18n id={MessageKey.FrontendRatelimitHostHelp} />
+                                )
+                            }
+                            label={<I18n id={MessageKey.CommonRateLimit} />}
+                        >
+                            <PaginatedSelect<RateLimitResponse>
+                                itemDescription={item => item?.name}
+                                itemKey={item => item?.id}
+                                pageProvider={(pageSize, pageNumber, searchTerms) =>
+                                    this.fetchRateLimits(pageSize, pageNumber, searchTerms)
+                                }
+                                allowEmpty
+                            />
+                        </Form.Item>
+                        <Form.Item
+                            name="securityHeaders"
+                            validateStatus={validationResult.getStatus("securityHeadersId")}
+                            help={
+                                validationResult.getMessage("securityHeadersId") ?? (
+                                    <I18n id={MessageKey.FrontendSecurityheadersHostHelp} />
+                                )
+                            }
+                            label={<I18n id={MessageKey.CommonSecurityHeaders} />}
+                        >
+                            <PaginatedSelect<SecurityHeadersResponse>
+                                itemDescription={item => item?.name}
+                                itemKey={item => item?.id}
+                                pageProvider={(pageSize, pageNumber, searchTerms) =>
+                                    this.fetchSecurityHeaders(pageSize, pageNumber, searchTerms)
+                                }
+                                allowEmpty
+                            />
+                        </Form.Item>
                         <Form.Item
                             name="accessList"
                             validateStatus={validationResult.getStatus("accessListId")}
@@ -436,6 +499,19 @@ export default class HostFormPage extends React.Component<any, HostFormPageState
                     validationResult={validationResult}
                     onChange={vpns => this.handleVpnsChange(vpns)}
                 />
+
+                <h2 className="hosts-form-section-name">
+                    <I18n id={MessageKey.FrontendHostFormSectionHeaderRules} />
+                </h2>
+                <p className="hosts-form-section-help-text">
+                    <I18n id={MessageKey.FrontendHostFormSectionHeaderRulesHelp} />
+                </p>
+                <HostHeaderRules
+                    name="headerRules"
+                    validationPath="headerRules"
+                    rules={formValues.headerRules}
+                    validationResult={validationResult}
+                />
             </Form>
         )
     }
@@ -5,6 +5,7 @@ import HostResponse from "./model/HostResponse"
 import HostRequest from "./model/HostRequest"
 import GenericCreateResponse from "../../core/common/GenericCreateResponse"
 import LogLine from "../logs/model/LogLine"
+import AccessLogFilter from "../logs/model/AccessLogFilter"
 
 export default class HostGateway {
     private readonly client: ApiClient
@@ -39,8 +40,35 @@ export default class HostGateway {
         lines: number,
         surroundingLines: number,
         searchTerms?: string,
+        before?: number,
+        filter?: AccessLogFilter,
     ): Promise<ApiResponse<LogLine[]>> {
-        return this.client.get(`/${id}/logs/${type}`, undefined, { lines, searchTerms, surroundingLines })
+        return this.client.get(`/${id}/logs/${type}`, undefined, {
+            ...filter,
+            lines,
+            searchTerms,
+            surroundingLines,
+            before,
+        })
+    }
+
+    async streamLogs(
+        id: string,
+        type: string,
+        surroundingLines: number,
+        searchTerms: string | undefined,
+        filter: AccessLogFilter | undefined,
+        onLines: (lines: LogLine[]) => void,
+        signal: AbortSignal,
+    ): Promise<void> {
+        return this.client.stream(
+            `/${id}/logs/${type}/stream`,
+            { ...filter, searchTerms, surroundingLines },
+            (event, data) => {
+                if (event === "lines") onLines(JSON.parse(data))
+            },
+            signal,
+        )
     }
 
     async putById(id: string, user: HostRequest): Promise<ApiResponse<void>> {
@@ -5,6 +5,7 @@ import { requireNullablePayload, requireSuccessPayload, requireSuccessResponse }
 import HostRequest from "./model/HostRequest"
 import GenericCreateResponse from "../../core/common/GenericCreateResponse"
 import LogLine from "../logs/model/LogLine"
+import AccessLogFilter from "../logs/model/AccessLogFilter"
 
 export default class HostService {
     private readonly gateway: HostGateway
@@ -35,8 +36,24 @@ export default class HostService {
         lines: number,
         surroundingLines: number,
         searchTerms?: string,
+        before?: number,
+        filter?: AccessLogFilter,
     ): Promise<LogLine[]> {
-        return this.gateway.getLogs(id, type, lines, surroundingLines, searchTerms).then(requireSuccessPayload)
+        return this.gateway
+            .getLogs(id, type, lines, surroundingLines, searchTerms, before, filter)
+            .then(requireSuccessPayload)
+    }
+
+    async streamLogs(
+        id: string,
+        type: string,
+        surroundingLines: number,
+        searchTerms: string | undefined,
+        filter: AccessLogFilter | undefined,
+        onLines: (lines: LogLine[]) => void,
+        signal: AbortSignal,
+    ): Promise<void> {
+        return this.gateway.streamLogs(id, type, surroundingLines, searchTerms, filter, onLines, signal)
     }
 
     async updateById(id: string, host: HostRequest): Promise<void> {
@@ -9,6 +9,8 @@ import PaginatedSelect from "../../../core/components/select/PaginatedSelect"
 import CertificateService from "../../certificate/CertificateService"
 import PageResponse from "../../../core/pagination/PageResponse"
 import { CertificateResponse } from "../../certificate/model/CertificateResponse"
+import TlsProfileService from "../../tlsprofile/TlsProfileService"
+import TlsProfileResponse from "../../tlsprofile/model/TlsProfileResponse"
 import "./HostBindings.css"
 import FormLayout from "../../../core/components/form/FormLayout"
 import TagGroup from "../../../core/components/taggroup/TagGroup"
@@ -35,10 +37,12 @@ export interface HostBindingsProps {
 
 export default class HostBindings extends React.Component<HostBindingsProps> {
     private readonly service: CertificateService
+    private readonly tlsProfileService: TlsProfileService
 
     constructor(props: HostBindingsProps) {
         super(props)
         this.service = new CertificateService()
+        this.tlsProfileService = new TlsProfileService()
     }
 
     private async fetchCertificates(
@@ -49,6 +53,14 @@ export default class HostBindings extends React.Component<HostBindingsProps> {
         return this.service.list(pageSize, pageNumber, searchTerms)
     }
 
+    private async fetchTlsProfiles(
+        pageSize: number,
+        pageNumber: number,
+        searchTerms?: string,
+    ): Promise<PageResponse<TlsProfileResponse>> {
+        return this.tlsProfileService.list(pageSize, pageNumber, searchTerms)
+    }
+
     private renderBinding(field: FormListFieldData, operations: FormListOperation, index: number, totalAmount: number) {
         const { validationResult, bindings, pathPrefix } = this.props
         const { name } = field
@@ -124,6 +136,26 @@ export default class HostBindings extends React.Component<HostBindingsProps> {
                         itemDescription={certificate => <TagGroup values={certificate.domainNames} maximumSize={1} />}
                     />
                 </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="host-form-binding-tls-profile"
+                    layout="vertical"
+                    name={[name, "tlsProfile"]}
+                    validateStatus={validationResult.getStatus(`${pathPrefix}[${index}].tlsProfileId`)}
+                    help={validationResult.getMessage(`${pathPrefix}[${index}].tlsProfileId`)}
+                    label={index === 0 ? <I18n id={MessageKey.CommonTlsProfile} /> : undefined}
+                >
+                    <PaginatedSelect<TlsProfileResponse>
+                        disabled={bindings[index].type === HostBindingType.HTTP}
+                        placeholder={MessageKey.FrontendHostComponentsHostbindingsDefaultTlsSettings}
+                        pageProvider={(pageSize, pageNumber, searchTerms) =>
+                            this.fetchTlsProfiles(pageSize, pageNumber, searchTerms)
+                        }
+                        itemKey={profile => profile.id}
+                        itemDescription={profile => profile.name}
+                        allowEmpty
+                    />
+                </Form.Item>
                 <If condition={totalAmount > 1}>
                     <DeleteOutlined
                         style={{
@@ -0,0 +1,160 @@
+import React from "react"
+import ValidationResult from "../../../core/validation/ValidationResult"
+import { Button, Flex, Form, FormListFieldData, FormListOperation, Input, Select, Switch } from "antd"
+import { DeleteOutlined, PlusOutlined } from "@ant-design/icons"
+import { HostHeaderRule, HostHeaderRuleAction, HostHeaderRuleTarget } from "../model/HostRequest"
+import FormLayout from "../../../core/components/form/FormLayout"
+import If from "../../../core/components/flowcontrol/If"
+import { I18n } from "../../../core/i18n/I18n"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import "./HostHeaderRules.css"
+
+const TARGET_OPTIONS_DATA = [
+    { value: HostHeaderRuleTarget.REQUEST, messageKey: MessageKey.FrontendHostComponentsHostheaderrulesTargetRequest },
+    {
+        value: HostHeaderRuleTarget.RESPONSE,
+        messageKey: MessageKey.FrontendHostComponentsHostheaderrulesTargetResponse,
+    },
+]
+
+const ACTION_OPTIONS_DATA = [
+    { value: HostHeaderRuleAction.SET, messageKey: MessageKey.FrontendHostComponentsHostheaderrulesActionSet },
+    { value: HostHeaderRuleAction.APPEND, messageKey: MessageKey.FrontendHostComponentsHostheaderrulesActionAppend },
+    { value: HostHeaderRuleAction.REMOVE, messageKey: MessageKey.CommonRemove },
+]
+
+export interface HostHeaderRulesProps {
+    name: any
+    validationPath: string
+    rules?: HostHeaderRule[]
+    validationResult: ValidationResult
+}
+
+export default class HostHeaderRules extends React.Component<HostHeaderRulesProps> {
+    private renderRule(field: FormListFieldData, operations: FormListOperation, index: number) {
+        const { validationResult, validationPath, rules } = this.props
+        const { name } = field
+        const path = `${validationPath}[${index}]`
+        const rule = rules?.[index]
+
+        return (
+            <Flex className="host-form-header-rule-container" key={field.key}>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="host-form-header-rule-target"
+                    layout="vertical"
+                    name={[name, "target"]}
+                    validateStatus={validationResult.getStatus(`${path}.target`)}
+                    help={validationResult.getMessage(`${path}.target`)}
+                    label={<I18n id={MessageKey.FrontendHostComponentsHostheaderrulesTarget} />}
+                    required
+                >
+                    <Select
+                        options={TARGET_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                    />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="host-form-header-rule-action"
+                    layout="vertical"
+                    name={[name, "action"]}
+                    validateStatus={validationResult.getStatus(`${path}.action`)}
+                    help={validationResult.getMessage(`${path}.action`)}
+                    label={<I18n id={MessageKey.FrontendHostComponentsHostheaderrulesAction} />}
+                    required
+                >
+                    <Select
+                        options={ACTION_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                    />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="host-form-header-rule-name"
+                    layout="vertical"
+                    name={[name, "name"]}
+                    validateStatus={validationResult.getStatus(`${path}.name`)}
+                    help={validationResult.getMessage(`${path}.name`)}
+                    label={<I18n id={MessageKey.FrontendHostComponentsHostheaderrulesHeader} />}
+                    required
+                >
+                    <Input />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="host-form-header-rule-value"
+                    layout="vertical"
+                    name={[name, "value"]}
+                    validateStatus={validationResult.getStatus(`${path}.value`)}
+                    help={validationResult.getMessage(`${path}.value`)}
+                    label={<I18n id={MessageKey.FrontendHostComponentsHostheaderrulesValue} />}
+                    required={rule?.action !== HostHeaderRuleAction.REMOVE}
+                >
+                    <Input disabled={rule?.action === HostHeaderRuleAction.REMOVE} />
+                </Form.Item>
+                <If
+                    condition={
+                        rule?.target === HostHeaderRuleTarget.RESPONSE && rule?.action !== HostHeaderRuleAction.REMOVE
+                    }
+                >
+                    <Form.Item
+                        {...FormLayout.ExpandedLabeledItem}
+                        className="host-form-header-rule-always"
+                        layout="vertical"
+                        name={[name, "always"]}
+                        validateStatus={validationResult.getStatus(`${path}.always`)}
+                        help={validationResult.getMessage(`${path}.always`)}
+                        label={<I18n id={MessageKey.FrontendHostComponentsHostheaderrulesAlways} />}
+                    >
+                        <Switch />
+                    </Form.Item>
+                </If>
+
+                <DeleteOutlined
+                    style={{
+                        marginLeft: 15,
+                        alignItems: "start",
+                        marginTop: 37,
+                    }}
+                    onClick={() => operations.remove(field.name)}
+                />
+            </Flex>
+        )
+    }
+
+    private renderRules(fields: FormListFieldData[], operations: FormListOperation) {
+        const rules = fields.map((field, index) => this.renderRule(field, operations, index))
+
+        const addAction = (
+            <Form.Item key="add">
+                <Button
+                    type="dashed"
+                    onClick={() =>
+                        operations.add({
+                            name: "",
+                            value: "",
+                            target: HostHeaderRuleTarget.REQUEST,
+                            action: HostHeaderRuleAction.SET,
+                            always: false,
+                        })
+                    }
+                    icon={<PlusOutlined />}
+                >
+                    <I18n id={MessageKey.FrontendHostComponentsHostheaderrulesAdd} />
+                </Button>
+            </Form.Item>
+        )
+
+        return [...rules, addAction]
+    }
+
+    render() {
+        const { name } = this.props
+        return <Form.List name={name}>{(fields, operations) => this.renderRules(fields, operations)}</Form.List>
+    }
+}
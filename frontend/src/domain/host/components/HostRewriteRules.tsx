@@ -0,0 +1,116 @@
+import React from "react"
+import ValidationResult from "../../../core/validation/ValidationResult"
+import { Button, Flex, Form, FormListFieldData, FormListOperation, Input, Select } from "antd"
+import { DeleteOutlined, PlusOutlined } from "@ant-design/icons"
+import { HostRewriteRuleFlag } from "../model/HostRequest"
+import FormLayout from "../../../core/components/form/FormLayout"
+import { I18n } from "../../../core/i18n/I18n"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import "./HostRewriteRules.css"
+
+const FLAG_OPTIONS_DATA = [
+    { value: HostRewriteRuleFlag.LAST, messageKey: MessageKey.FrontendHostComponentsHostrewriterulesFlagLast },
+    { value: HostRewriteRuleFlag.BREAK, messageKey: MessageKey.FrontendHostComponentsHostrewriterulesFlagBreak },
+    { value: HostRewriteRuleFlag.REDIRECT, messageKey: MessageKey.FrontendHostComponentsHostrewriterulesFlagRedirect },
+    {
+        value: HostRewriteRuleFlag.PERMANENT,
+        messageKey: MessageKey.FrontendHostComponentsHostrewriterulesFlagPermanent,
+    },
+]
+
+export interface HostRewriteRulesProps {
+    name: any
+    validationPath: string
+    validationResult: ValidationResult
+}
+
+export default class HostRewriteRules extends React.Component<HostRewriteRulesProps> {
+    private renderRule(field: FormListFieldData, operations: FormListOperation, index: number) {
+        const { validationResult, validationPath } = this.props
+        const { name } = field
+        const path = `${validationPath}[${index}]`
+
+        return (
+            <Flex className="host-form-rewrite-rule-container" key={field.key}>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="host-form-rewrite-rule-pattern"
+                    layout="vertical"
+                    name={[name, "pattern"]}
+                    validateStatus={validationResult.getStatus(`${path}.pattern`)}
+                    help={validationResult.getMessage(`${path}.pattern`)}
+                    label={<I18n id={MessageKey.FrontendHostComponentsHostrewriterulesPattern} />}
+                    required
+                >
+                    <Input />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="host-form-rewrite-rule-replacement"
+                    layout="vertical"
+                    name={[name, "replacement"]}
+                    validateStatus={validationResult.getStatus(`${path}.replacement`)}
+                    help={validationResult.getMessage(`${path}.replacement`)}
+                    label={<I18n id={MessageKey.FrontendHostComponentsHostrewriterulesReplacement} />}
+                    required
+                >
+                    <Input />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="host-form-rewrite-rule-flag"
+                    layout="vertical"
+                    name={[name, "flag"]}
+                    validateStatus={validationResult.getStatus(`${path}.flag`)}
+                    help={validationResult.getMessage(`${path}.flag`)}
+                    label={<I18n id={MessageKey.FrontendHostComponentsHostrewriterulesFlag} />}
+                >
+                    <Select
+                        options={FLAG_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                        allowClear
+                    />
+                </Form.Item>
+
+                <DeleteOutlined
+                    style={{
+                        marginLeft: 15,
+                        alignItems: "start",
+                        marginTop: 37,
+                    }}
+                    onClick={() => operations.remove(field.name)}
+                />
+            </Flex>
+        )
+    }
+
+    private renderRules(fields: FormListFieldData[], operations: FormListOperation) {
+        const rules = fields.map((field, index) => this.renderRule(field, operations, index))
+
+        const addAction = (
+            <Form.Item key="add">
+                <Button
+                    type="dashed"
+                    onClick={() =>
+                        operations.add({
+                            pattern: "",
+                            replacement: "",
+                        })
+                    }
+                    icon={<PlusOutlined />}
+                >
+                    <I18n id={MessageKey.FrontendHostComponentsHostrewriterulesAdd} />
+                </Button>
+            </Form.Item>
+        )
+
+        return [...rules, addAction]
+    }
+
+    render() {
+        const { name } = this.props
+        return <Form.List name={name}>{(fields, operations) => this.renderRules(fields, operations)}</Form.List>
+    }
+}
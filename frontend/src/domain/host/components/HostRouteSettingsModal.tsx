@@ -12,7 +12,11 @@ import { HostRouteType } from "../model/HostRequest"
 import { HostFormRoute } from "../model/HostFormValues"
 import CacheResponse from "../../cache/model/CacheResponse"
 import CacheService from "../../cache/CacheService"
+import RateLimitResponse from "../../ratelimit/model/RateLimitResponse"
+import RateLimitService from "../../ratelimit/RateLimitService"
 import HostRouteConditionalConfig from "./HostRouteConditionalConfig"
+import HostHeaderRules from "./HostHeaderRules"
+import HostRewriteRules from "./HostRewriteRules"
 import { I18n } from "../../../core/i18n/I18n"
 import MessageKey from "../../../core/i18n/model/MessageKey.generated"
 
@@ -45,11 +49,13 @@ export interface HostRouteSettingsProps {
 export default class HostRouteSettingsModal extends React.Component<HostRouteSettingsProps> {
     private readonly accessListService: AccessListService
     private readonly cacheService: CacheService
+    private readonly rateLimitService: RateLimitService
 
     constructor(props: HostRouteSettingsProps) {
         super(props)
         this.accessListService = new AccessListService()
         this.cacheService = new CacheService()
+        this.rateLimitService = new RateLimitService()
     }
 
     private renderAdvancedTab() {
@@ -87,6 +93,53 @@ export default class HostRouteSettingsModal extends React.Component<HostRouteSet
         )
     }
 
+    private renderHeadersTab() {
+        const { index, validationResult, fieldPath, route } = this.props
+        return (
+            <>
+                <p>
+                    <I18n id={MessageKey.FrontendHostComponentsHostroutesettingsHeaderRulesHelp} />
+                </p>
+                <HostHeaderRules
+                    name={[fieldPath, "headerRules"]}
+                    validationPath={`routes[${index}].headerRules`}
+                    rules={route.headerRules}
+                    validationResult={validationResult}
+                />
+            </>
+        )
+    }
+
+    private renderRewriteTab() {
+        const { index, validationResult, fieldPath } = this.props
+        return (
+            <>
+                <p>
+                    <I18n id={MessageKey.FrontendHostComponentsHostroutesettingsRewriteRulesHelp} />
+                </p>
+                <HostRewriteRules
+                    name={[fieldPath, "rewriteRules"]}
+                    validationPath={`routes[${index}].rewriteRules`}
+                    validationResult={validationResult}
+                />
+                <Form.Item
+                    {...ItemProps}
+                    name={[fieldPath, "settings", "preserveQueryString"]}
+                    label={<I18n id={MessageKey.FrontendHostComponentsHostroutesettingsPreserveQueryString} />}
+                    validateStatus={validationResult.getStatus(`routes[${index}].settings.preserveQueryString`)}
+                    help={
+                        validationResult.getMessage(`routes[${index}].settings.preserveQueryString`) ?? (
+                            <I18n id={MessageKey.FrontendHostComponentsHostroutesettingsPreserveQueryStringHelp} />
+                        )
+                    }
+                    required
+                >
+                    <Switch />
+                </Form.Item>
+            </>
+        )
+    }
+
     private fetchAccessLists(
         pageSize: number,
         pageNumber: number,
@@ -103,6 +156,14 @@ export default class HostRouteSettingsModal extends React.Component<HostRouteSet
         return this.cacheService.list(pageSize, pageNumber, searchTerms)
     }
 
+    private fetchRateLimits(
+        pageSize: number,
+        pageNumber: number,
+        searchTerms?: string,
+    ): Promise<PageResponse<RateLimitResponse>> {
+        return this.rateLimitService.list(pageSize, pageNumber, searchTerms)
+    }
+
     private renderMainTab() {
         const { index, validationResult, fieldPath, route } = this.props
 
@@ -124,6 +185,26 @@ export default class HostRouteSettingsModal extends React.Component<HostRouteSet
                         allowEmpty
                     />
                 </Form.Item>
+                <Form.Item
+                    {...ItemProps}
+                    name={[fieldPath, "rateLimit"]}
+                    validateStatus={validationResult.getStatus(`routes[${index}].rateLimitId`)}
+                    help={
+                        validationResult.getMessage(`routes[${index}].rateLimitId`) ?? (
+                            <I18n id={MessageKey.FrontendRatelimitRouteHelp} />
+                        )
+                    }
+                    label={<I18n id={MessageKey.CommonRateLimit} />}
+                >
+                    <PaginatedSelect<RateLimitResponse>
+                        itemDescription={item => item?.name}
+                        itemKey={item => item?.id}
+                        pageProvider={(pageSize, pageNumber, searchTerms) =>
+                            this.fetchRateLimits(pageSize, pageNumber, searchTerms)
+                        }
+                        allowEmpty
+                    />
+                </Form.Item>
                 <HostRouteConditionalConfig route={route} types={ACCESS_LIST_SUPPORTED_ROUTE_TYPES}>
                     <Form.Item
                         {...ItemProps}
@@ -270,6 +351,16 @@ export default class HostRouteSettingsModal extends React.Component<HostRouteSet
                 label: <I18n id={MessageKey.FrontendHostComponentsHostroutesettingsTabMain} />,
                 children: this.renderMainTab(),
             },
+            {
+                key: "headers",
+                label: <I18n id={MessageKey.FrontendHostComponentsHostroutesHeaders} />,
+                children: this.renderHeadersTab(),
+            },
+            {
+                key: "rewrite",
+                label: <I18n id={MessageKey.FrontendHostComponentsHostroutesettingsTabRewrite} />,
+                children: this.renderRewriteTab(),
+            },
             {
                 key: "advanced",
                 label: <I18n id={MessageKey.CommonAdvanced} />,
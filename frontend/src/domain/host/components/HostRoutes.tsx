@@ -11,7 +11,7 @@ import {
     SettingOutlined,
 } from "@ant-design/icons"
 import { HostFormRoute } from "../model/HostFormValues"
-import { HostRouteSourceCodeLanguage, HostRouteType } from "../model/HostRequest"
+import { HostRouteMatchType, HostRouteSourceCodeLanguage, HostRouteType } from "../model/HostRequest"
 import FormLayout from "../../../core/components/form/FormLayout"
 import "./HostRoutes.css"
 import TextArea from "antd/es/input/TextArea"
@@ -20,6 +20,8 @@ import PaginatedSelect from "../../../core/components/select/PaginatedSelect"
 import IntegrationOptionResponse from "../../integration/model/IntegrationOptionResponse"
 import PageResponse, { emptyPageResponse } from "../../../core/pagination/PageResponse"
 import IntegrationService from "../../integration/IntegrationService"
+import UpstreamService from "../../upstream/UpstreamService"
+import UpstreamResponse from "../../upstream/model/UpstreamResponse"
 import HostRouteSettingsModal from "./HostRouteSettingsModal"
 import { Link } from "react-router-dom"
 import CodeEditorModal from "../../../core/components/codeeditor/CodeEditorModal"
@@ -51,6 +53,16 @@ const HOST_ROUTE_TYPE_OPTIONS_DATA = [
     },
 ]
 
+const HOST_ROUTE_MATCH_TYPE_OPTIONS_DATA = [
+    { value: HostRouteMatchType.PREFIX, messageKey: MessageKey.FrontendHostComponentsHostroutesMatchTypePrefix },
+    { value: HostRouteMatchType.EXACT, messageKey: MessageKey.FrontendHostComponentsHostroutesMatchTypeExact },
+    { value: HostRouteMatchType.REGEX, messageKey: MessageKey.FrontendHostComponentsHostroutesMatchTypeRegex },
+    {
+        value: HostRouteMatchType.CASE_INSENSITIVE_REGEX,
+        messageKey: MessageKey.FrontendHostComponentsHostroutesMatchTypeCaseInsensitiveRegex,
+    },
+]
+
 const ACTION_ICON_STYLE = {
     marginLeft: 15,
     alignItems: "start",
@@ -81,16 +93,23 @@ interface HostRoutesState {
 
 export default class HostRoutes extends React.Component<HostRoutesProps, HostRoutesState> {
     private readonly integrationService: IntegrationService
+    private readonly upstreamService: UpstreamService
     private readonly optionsRef: React.RefObject<PaginatedSelect<IntegrationOptionResponse> | null>
 
     constructor(props: HostRoutesProps) {
         super(props)
         this.integrationService = new IntegrationService()
+        this.upstreamService = new UpstreamService()
         this.optionsRef = React.createRef()
         this.state = {}
     }
 
-    private renderTargetUriField(field: FormListFieldData, index: number, label: MessageKey): React.ReactNode {
+    private renderTargetUriField(
+        field: FormListFieldData,
+        index: number,
+        label: MessageKey,
+        required: boolean = true,
+    ): React.ReactNode {
         const { validationResult } = this.props
         const { name } = field
 
@@ -103,7 +122,7 @@ export default class HostRoutes extends React.Component<HostRoutesProps, HostRou
                 validateStatus={validationResult.getStatus(`routes[${index}].targetUri`)}
                 help={validationResult.getMessage(`routes[${index}].targetUri`)}
                 label={<I18n id={label} />}
-                required
+                required={required}
             >
                 <Input />
             </Form.Item>
@@ -111,7 +130,36 @@ export default class HostRoutes extends React.Component<HostRoutesProps, HostRou
     }
 
     private renderProxyRoute(field: FormListFieldData, index: number): React.ReactNode {
-        return this.renderTargetUriField(field, index, MessageKey.FrontendHostComponentsHostroutesDestinationUrl)
+        const { validationResult, routes } = this.props
+        const { name } = field
+        const upstreamSelected = routes[index]?.upstream != null
+        const targetUriLabel = upstreamSelected
+            ? MessageKey.FrontendHostComponentsHostroutesDestinationPath
+            : MessageKey.FrontendHostComponentsHostroutesDestinationUrl
+
+        return (
+            <>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="host-form-route-field host-form-route-upstream"
+                    layout="vertical"
+                    name={[name, "upstream"]}
+                    validateStatus={validationResult.getStatus(`routes[${index}].upstreamId`)}
+                    help={validationResult.getMessage(`routes[${index}].upstreamId`)}
+                    label={<I18n id={MessageKey.CommonUpstream} />}
+                >
+                    <PaginatedSelect<UpstreamResponse>
+                        itemDescription={item => item?.name}
+                        itemKey={item => item?.id}
+                        pageProvider={(pageSize, pageNumber, searchTerms) =>
+                            this.upstreamService.list(pageSize, pageNumber, searchTerms)
+                        }
+                        allowEmpty
+                    />
+                </Form.Item>
+                {this.renderTargetUriField(field, index, targetUriLabel, !upstreamSelected)}
+            </>
+        )
     }
 
     private renderStaticFilesRoute(field: FormListFieldData, index: number): React.ReactNode {
@@ -495,6 +543,27 @@ export default class HostRoutes extends React.Component<HostRoutesProps, HostRou
                         }))}
                     />
                 </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="host-form-route-field host-form-route-match-type"
+                    layout="vertical"
+                    name={[name, "matchType"]}
+                    validateStatus={validationResult.getStatus(`routes[${index}].matchType`)}
+                    help={validationResult.getMessage(`routes[${index}].matchType`)}
+                    label={<I18n id={MessageKey.FrontendHostComponentsHostroutesMatchType} />}
+                    tooltip={{
+                        title: <I18n id={MessageKey.FrontendHostComponentsHostroutesMatchTypeHelp} />,
+                        icon: <QuestionCircleFilled />,
+                    }}
+                    required
+                >
+                    <Select
+                        options={HOST_ROUTE_MATCH_TYPE_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                    />
+                </Form.Item>
                 <Form.Item
                     {...FormLayout.ExpandedLabeledItem}
                     className="host-form-route-field host-form-route-source-path"
@@ -1,16 +1,30 @@
 import { CertificateResponse } from "../../certificate/model/CertificateResponse"
-import { HostBindingType, HostFeatureSet, HostRouteSettings, HostRouteSourceCode, HostRouteType } from "./HostRequest"
+import {
+    HostBindingType,
+    HostFeatureSet,
+    HostHeaderRule,
+    HostRewriteRule,
+    HostRouteMatchType,
+    HostRouteSettings,
+    HostRouteSourceCode,
+    HostRouteType,
+} from "./HostRequest"
 import IntegrationOptionResponse from "../../integration/model/IntegrationOptionResponse"
 import AccessListResponse from "../../accesslist/model/AccessListResponse"
 import IntegrationResponse from "../../integration/model/IntegrationResponse"
 import VpnResponse from "../../vpn/model/VpnResponse"
 import CacheResponse from "../../cache/model/CacheResponse"
+import UpstreamResponse from "../../upstream/model/UpstreamResponse"
+import TlsProfileResponse from "../../tlsprofile/model/TlsProfileResponse"
+import RateLimitResponse from "../../ratelimit/model/RateLimitResponse"
+import SecurityHeadersResponse from "../../securityheaders/model/SecurityHeadersResponse"
 
 export interface HostFormBinding {
     type: HostBindingType
     ip: string
     port: number
     certificate?: CertificateResponse
+    tlsProfile?: TlsProfileResponse
 }
 
 export interface HostFormStaticResponse {
@@ -23,6 +37,7 @@ export interface HostFormRoute {
     priority: number
     enabled: boolean
     type: HostRouteType
+    matchType: HostRouteMatchType
     sourcePath: string
     settings: HostRouteSettings
     targetUri?: string
@@ -30,8 +45,12 @@ export interface HostFormRoute {
     integration?: HostFormRouteIntegration
     accessList?: AccessListResponse
     cache?: CacheResponse
+    rateLimit?: RateLimitResponse
+    upstream?: UpstreamResponse
     redirectCode?: number
     sourceCode?: HostRouteSourceCode
+    headerRules: HostHeaderRule[]
+    rewriteRules: HostRewriteRule[]
 }
 
 export interface HostFormRouteIntegration {
@@ -59,4 +78,7 @@ export default interface HostFormValues {
     featureSet: HostFeatureSet
     accessList?: AccessListResponse
     cache?: CacheResponse
+    rateLimit?: RateLimitResponse
+    securityHeaders?: SecurityHeadersResponse
+    headerRules: HostHeaderRule[]
 }
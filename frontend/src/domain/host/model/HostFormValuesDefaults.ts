@@ -1,5 +1,5 @@
 import HostFormValues from "./HostFormValues"
-import { HostBindingType, HostRouteType } from "./HostRequest"
+import { HostBindingType, HostRouteMatchType, HostRouteType } from "./HostRequest"
 
 export function hostFormValuesDefaults(): HostFormValues {
     return {
@@ -8,6 +8,7 @@ export function hostFormValuesDefaults(): HostFormValues {
         useGlobalBindings: true,
         domainNames: [""],
         vpns: [],
+        headerRules: [],
         bindings: [
             {
                 ip: "0.0.0.0",
@@ -20,6 +21,7 @@ export function hostFormValuesDefaults(): HostFormValues {
                 priority: 0,
                 enabled: true,
                 type: HostRouteType.PROXY,
+                matchType: HostRouteMatchType.PREFIX,
                 sourcePath: "/",
                 targetUri: "",
                 settings: {
@@ -28,7 +30,10 @@ export function hostFormValuesDefaults(): HostFormValues {
                     ignoreSslErrors: false,
                     includeForwardHeaders: true,
                     directoryListingEnabled: false,
+                    preserveQueryString: false,
                 },
+                headerRules: [],
+                rewriteRules: [],
             },
         ],
         featureSet: {
@@ -12,11 +12,36 @@ export enum HostRouteType {
     STATIC_FILES = "STATIC_FILES",
 }
 
+export enum HostRouteMatchType {
+    PREFIX = "PREFIX",
+    EXACT = "EXACT",
+    REGEX = "REGEX",
+    CASE_INSENSITIVE_REGEX = "CASE_INSENSITIVE_REGEX",
+}
+
+export enum HostRewriteRuleFlag {
+    LAST = "LAST",
+    BREAK = "BREAK",
+    REDIRECT = "REDIRECT",
+    PERMANENT = "PERMANENT",
+}
+
 export enum HostRouteSourceCodeLanguage {
     JAVASCRIPT = "JAVASCRIPT",
     LUA = "LUA",
 }
 
+export enum HostHeaderRuleTarget {
+    REQUEST = "REQUEST",
+    RESPONSE = "RESPONSE",
+}
+
+export enum HostHeaderRuleAction {
+    SET = "SET",
+    APPEND = "APPEND",
+    REMOVE = "REMOVE",
+}
+
 export interface HostFeatureSet {
     websocketsSupport: boolean
     http2Support: boolean
@@ -29,6 +54,7 @@ export interface HostBinding {
     ip: string
     port: number
     certificateId?: string
+    tlsProfileId?: string
 }
 
 export interface HostRouteStaticResponse {
@@ -44,6 +70,7 @@ export interface HostRouteSettings {
     keepOriginalDomainName: boolean
     directoryListingEnabled: boolean
     indexFile?: string
+    preserveQueryString: boolean
     custom?: string
 }
 
@@ -53,10 +80,25 @@ export interface HostRouteSourceCode {
     mainFunction?: string
 }
 
+export interface HostHeaderRule {
+    name: string
+    value?: string
+    target: HostHeaderRuleTarget
+    action: HostHeaderRuleAction
+    always: boolean
+}
+
+export interface HostRewriteRule {
+    pattern: string
+    replacement: string
+    flag?: HostRewriteRuleFlag
+}
+
 export interface HostRoute {
     priority: number
     enabled: boolean
     type: HostRouteType
+    matchType: HostRouteMatchType
     sourcePath: string
     settings: HostRouteSettings
     targetUri?: string
@@ -64,8 +106,12 @@ export interface HostRoute {
     integration?: HostRouteIntegration
     accessListId?: string
     cacheId?: string
+    rateLimitId?: string
+    upstreamId?: string
     redirectCode?: number
     sourceCode?: HostRouteSourceCode
+    headerRules?: HostHeaderRule[]
+    rewriteRules?: HostRewriteRule[]
 }
 
 export interface HostRouteIntegration {
@@ -93,4 +139,7 @@ export default interface HostRequest {
     featureSet: HostFeatureSet
     accessListId?: string
     cacheId?: string
+    rateLimitId?: string
+    securityHeadersId?: string
+    headerRules?: HostHeaderRule[]
 }
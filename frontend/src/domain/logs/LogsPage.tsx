@@ -2,13 +2,14 @@ import React from "react"
 import HostService from "../host/HostService"
 import HostResponse from "../host/model/HostResponse"
 import PaginatedSelect from "../../core/components/select/PaginatedSelect"
-import { AutoComplete, Empty, Flex, Form, Input, InputNumber, Segmented, Select } from "antd"
+import { AutoComplete, Button, Empty, Flex, Form, Input, InputNumber, Segmented, Select, Switch } from "antd"
 import {
     AuditOutlined,
     ClusterOutlined,
     ExclamationCircleOutlined,
     FileExcelOutlined,
     HddOutlined,
+    VerticalAlignTopOutlined,
 } from "@ant-design/icons"
 import If from "../../core/components/flowcontrol/If"
 import "./LogsPage.css"
@@ -27,27 +28,36 @@ import MessageKey from "../../core/i18n/model/MessageKey.generated"
 import { I18n, i18n, I18nMessage } from "../../core/i18n/I18n"
 import LogViewer from "./components/LogViewer"
 import LogLine from "./model/LogLine"
+import AccessLogFilter from "./model/AccessLogFilter"
 import debounce from "debounce"
 
 interface LogsPageState {
     settings?: SettingsDto
     hostMode: boolean
     autoRefreshSeconds?: number
+    liveTail: boolean
     selectedHost?: HostResponse
     lineCount: number
     logType: string
     loading: boolean
     logs: LogLine[]
+    hasOlderLogs: boolean
+    loadingOlderLogs: boolean
     error?: Error
     searchTerms?: string
     surroundingLines: number
+    accessLogFilter: AccessLogFilter
 }
 
+const STATUS_CLASSES = [1, 2, 3, 4, 5]
+const HTTP_METHODS = ["GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"]
+
 export default class LogsPage extends React.Component<any, LogsPageState> {
     private readonly hostService: HostService
     private readonly nginxService: NginxService
     private readonly settingsService: SettingsService
     private refreshIntervalId?: number
+    private liveTailController?: AbortController
 
     constructor(props: any) {
         super(props)
@@ -56,11 +66,15 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
         this.settingsService = new SettingsService()
         this.state = {
             hostMode: true,
+            liveTail: false,
             logType: "access",
             lineCount: 25,
             loading: true,
             logs: [],
+            hasOlderLogs: false,
+            loadingOlderLogs: false,
             surroundingLines: 0,
+            accessLogFilter: {},
         }
     }
 
@@ -81,6 +95,7 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
 
     componentWillUnmount() {
         this.stopAutoRefresh()
+        this.stopLiveTail()
         this.debounceApplyOptions.clear()
     }
 
@@ -91,10 +106,54 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
         }
     }
 
+    private stopLiveTail() {
+        this.liveTailController?.abort()
+        this.liveTailController = undefined
+    }
+
+    private startLiveTail() {
+        const { hostMode, selectedHost, logType, searchTerms, surroundingLines } = this.state
+        if (hostMode && selectedHost === undefined) return
+
+        const controller = new AbortController()
+        this.liveTailController = controller
+
+        const onLines = (lines: LogLine[]) => this.appendLiveTailLines(lines)
+        const stream = hostMode
+            ? this.hostService.streamLogs(
+                  selectedHost!!.id,
+                  logType,
+                  surroundingLines,
+                  searchTerms,
+                  this.activeAccessLogFilter(),
+                  onLines,
+                  controller.signal,
+              )
+            : this.nginxService.streamLogs(surroundingLines, searchTerms, onLines, controller.signal)
+
+        stream
+            .catch(() => {
+                if (!controller.signal.aborted) CommonNotifications.failedToFetch()
+            })
+            .finally(() => {
+                if (this.liveTailController === controller) this.liveTailController = undefined
+            })
+    }
+
+    private appendLiveTailLines(lines: LogLine[]) {
+        this.setState(({ logs, lineCount }) => ({
+            logs: [...logs, ...lines].slice(-Math.max(lineCount, logs.length)),
+        }))
+    }
+
     private configureShell() {
-        const { autoRefreshSeconds } = this.state
-        const disabled = autoRefreshSeconds !== undefined
-        const disabledReason = disabled ? MessageKey.FrontendLogsAutoRefreshDisabledReason : undefined
+        const { autoRefreshSeconds, liveTail } = this.state
+        const disabled = autoRefreshSeconds !== undefined || liveTail
+        const disabledReason = liveTail
+            ? MessageKey.FrontendLogsLiveTailDisabledReason
+            : disabled
+              ? MessageKey.FrontendLogsAutoRefreshDisabledReason
+              : undefined
 
         AppShellContext.get().updateConfig({
             title: MessageKey.CommonLogs,
@@ -111,10 +170,11 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
     }
 
     private applyOptions() {
-        const { autoRefreshSeconds } = this.state
+        const { autoRefreshSeconds, liveTail } = this.state
 
         this.stopAutoRefresh()
-        if (autoRefreshSeconds !== undefined) {
+        this.stopLiveTail()
+        if (autoRefreshSeconds !== undefined && !liveTail) {
             this.refreshIntervalId = window.setInterval(() => this.fetchLogs(true), autoRefreshSeconds * 1000)
         }
 
@@ -131,20 +191,37 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
 
     private readonly debounceApplyOptions = debounce(this.applyOptions.bind(this), 500)
 
-    private fetchLogs(omitNotifications?: boolean) {
+    private requestLogs(before?: number): Promise<LogLine[]> {
         const { hostMode, lineCount, selectedHost, logType, searchTerms, surroundingLines } = this.state
-        if (hostMode && selectedHost === undefined) return this.setState({ loading: false })
+        return hostMode
+            ? this.hostService.logs(
+                  selectedHost!!.id,
+                  logType,
+                  lineCount,
+                  surroundingLines,
+                  searchTerms,
+                  before,
+                  this.activeAccessLogFilter(),
+              )
+            : this.nginxService.logs(lineCount, surroundingLines, searchTerms, before)
+    }
 
-        const logs = hostMode
-            ? this.hostService.logs(selectedHost!!.id, logType, lineCount, surroundingLines, searchTerms)
-            : this.nginxService.logs(lineCount, surroundingLines, searchTerms)
+    private fetchLogs(omitNotifications?: boolean) {
+        const { hostMode, lineCount, selectedHost } = this.state
+        if (hostMode && selectedHost === undefined) return this.setState({ loading: false })
 
-        return logs
+        return this.requestLogs()
             .then(lines => {
-                this.setState({
-                    loading: false,
-                    logs: lines,
-                })
+                this.setState(
+                    {
+                        loading: false,
+                        logs: lines,
+                        hasOlderLogs: lines.length >= lineCount,
+                    },
+                    () => {
+                        if (this.state.liveTail && this.liveTailController === undefined) this.startLiveTail()
+                    },
+                )
             })
             .catch(error => {
                 if (!omitNotifications) CommonNotifications.failedToFetch()
@@ -152,6 +229,32 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
             })
     }
 
+    private loadOlderLogs() {
+        const { logs, lineCount, loadingOlderLogs } = this.state
+        if (loadingOlderLogs || logs.length === 0) return
+
+        const before = Math.min(...logs.map(line => line.offset))
+        this.setState({ loadingOlderLogs: true })
+
+        this.requestLogs(before)
+            .then(lines => {
+                this.setState(current => {
+                    const knownOffsets = new Set(current.logs.map(line => line.offset))
+                    const olderLines = lines.filter(line => !knownOffsets.has(line.offset))
+
+                    return {
+                        logs: [...olderLines, ...current.logs],
+                        hasOlderLogs: olderLines.length > 0 && lines.length >= lineCount,
+                        loadingOlderLogs: false,
+                    }
+                })
+            })
+            .catch(() => {
+                CommonNotifications.failedToFetch()
+                this.setState({ loadingOlderLogs: false })
+            })
+    }
+
     private handleHostChange(selectedHost?: HostResponse) {
         this.setState({ selectedHost: selectedHost }, () => this.applyOptions())
     }
@@ -172,6 +275,10 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
         this.setState({ autoRefreshSeconds }, () => this.applyOptions())
     }
 
+    private setLiveTail(liveTail: boolean) {
+        this.setState({ liveTail, autoRefreshSeconds: undefined }, () => this.applyOptions())
+    }
+
     private buildLineCountOptions() {
         return [10, 25, 50, 100, 250, 500, 1000].map(item => ({
             label: String(item),
@@ -203,6 +310,112 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
         this.setState({ searchTerms }, () => this.debounceApplyOptions())
     }
 
+    private activeAccessLogFilter(): AccessLogFilter | undefined {
+        const { hostMode, logType, accessLogFilter } = this.state
+        return hostMode && logType === "access" ? accessLogFilter : undefined
+    }
+
+    private updateAccessLogFilter(changes: Partial<AccessLogFilter>, debounced: boolean) {
+        this.setState(
+            ({ accessLogFilter }) => ({ accessLogFilter: { ...accessLogFilter, ...changes } }),
+            () => (debounced ? this.debounceApplyOptions() : this.applyOptions()),
+        )
+    }
+
+    private handleStatusClassChange(statusClass?: number) {
+        this.updateAccessLogFilter(
+            {
+                statusFrom: statusClass !== undefined ? statusClass * 100 : undefined,
+                statusTo: statusClass !== undefined ? statusClass * 100 + 99 : undefined,
+            },
+            false,
+        )
+    }
+
+    private handleFilterTextChange(field: "path" | "clientAddress", value: string) {
+        const normalized = value.trim() === "" ? undefined : value.trim()
+        this.updateAccessLogFilter(field === "path" ? { path: normalized } : { clientAddress: normalized }, true)
+    }
+
+    private renderAccessLogFilters() {
+        const { statusFrom, method, path, clientAddress, minimumLatencyMs } = this.state.accessLogFilter
+        const statusClass = statusFrom !== undefined ? Math.floor(statusFrom / 100) : undefined
+
+        return (
+            <Flex className="log-filter-container">
+                <Form.Item
+                    className="log-filter-select-input"
+                    label={<I18n id={MessageKey.FrontendLogsFilterStatus} />}
+                    layout="vertical"
+                    colon={false}
+                >
+                    <Select
+                        placeholder={i18n(MessageKey.FrontendLogsFilterAny)}
+                        options={STATUS_CLASSES.map(item => ({ label: `${item}xx`, value: item }))}
+                        value={statusClass}
+                        onSelect={value => this.handleStatusClassChange(value)}
+                        onClear={() => this.handleStatusClassChange()}
+                        allowClear
+                    />
+                </Form.Item>
+                <Form.Item
+                    className="log-filter-select-input"
+                    label={<I18n id={MessageKey.FrontendLogsFilterMethod} />}
+                    layout="vertical"
+                    colon={false}
+                >
+                    <Select
+                        placeholder={i18n(MessageKey.FrontendLogsFilterAny)}
+                        options={HTTP_METHODS.map(item => ({ label: item, value: item }))}
+                        value={method}
+                        onSelect={value => this.updateAccessLogFilter({ method: value }, false)}
+                        onClear={() => this.updateAccessLogFilter({ method: undefined }, false)}
+                        allowClear
+                    />
+                </Form.Item>
+                <Form.Item
+                    className="log-filter-text-input"
+                    label={<I18n id={MessageKey.FrontendLogsFilterPath} />}
+                    layout="vertical"
+                    colon={false}
+                >
+                    <Input
+                        value={path}
+                        placeholder="/"
+                        onChange={event => this.handleFilterTextChange("path", event.target.value)}
+                        allowClear
+                    />
+                </Form.Item>
+                <Form.Item
+                    className="log-filter-text-input"
+                    label={<I18n id={MessageKey.FrontendLogsFilterClientAddress} />}
+                    layout="vertical"
+                    colon={false}
+                >
+                    <Input
+                        value={clientAddress}
+                        placeholder="192.168.0.0/24"
+                        onChange={event => this.handleFilterTextChange("clientAddress", event.target.value)}
+                        allowClear
+                    />
+                </Form.Item>
+                <Form.Item
+                    className="log-filter-select-input"
+                    label={<I18n id={MessageKey.FrontendLogsFilterMinimumLatency} />}
+                    layout="vertical"
+                    colon={false}
+                >
+                    <InputNumber
+                        style={{ width: "100%" }}
+                        min={0}
+                        value={minimumLatencyMs}
+                        onChange={value => this.updateAccessLogFilter({ minimumLatencyMs: value ?? undefined }, true)}
+                    />
+                </Form.Item>
+            </Flex>
+        )
+    }
+
     private handleSurroundingLinesChange(surroundingLines: number | null) {
         surroundingLines ??= 0
         this.setState({ surroundingLines }, () => this.applyOptions())
@@ -246,7 +459,7 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
     }
 
     private renderSettings() {
-        const { selectedHost, hostMode, logType, lineCount, autoRefreshSeconds } = this.state
+        const { selectedHost, hostMode, logType, lineCount, autoRefreshSeconds, liveTail } = this.state
         return (
             <Flex className="log-settings-option-container">
                 <Flex className="log-settings-option" vertical>
@@ -292,9 +505,16 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
                         value={autoRefreshSeconds}
                         onSelect={value => this.setAutoRefreshSeconds(value)}
                         onClear={() => this.setAutoRefreshSeconds()}
+                        disabled={liveTail}
                         allowClear
                     />
                 </Flex>
+                <Flex className="log-settings-option" vertical>
+                    <p>
+                        <I18n id={MessageKey.FrontendLogsLiveTail} />
+                    </p>
+                    <Switch checked={liveTail} onChange={value => this.setLiveTail(value)} />
+                </Flex>
                 <If condition={hostMode}>
                     <Flex className="log-settings-option log-settings-host" vertical>
                         <p>
@@ -382,8 +602,23 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
         const emptyState = this.renderEmptyStateIfNeeded()
         if (emptyState !== undefined) return emptyState
 
-        const { logs } = this.state
-        return <LogViewer lines={logs} />
+        const { logs, hasOlderLogs, loadingOlderLogs } = this.state
+        return (
+            <Flex className="log-contents-viewer" vertical>
+                <If condition={hasOlderLogs}>
+                    <Button
+                        className="log-load-older-button"
+                        type="link"
+                        icon={<VerticalAlignTopOutlined />}
+                        loading={loadingOlderLogs}
+                        onClick={() => this.loadOlderLogs()}
+                    >
+                        <I18n id={MessageKey.FrontendLogsLoadOlder} />
+                    </Button>
+                </If>
+                <LogViewer lines={logs} />
+            </Flex>
+        )
     }
 
     render() {
@@ -391,7 +626,7 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
             return <AccessDeniedPage />
         }
 
-        const { loading, error } = this.state
+        const { loading, error, hostMode, logType } = this.state
         if (error !== undefined) return EmptyStates.FailedToFetch
 
         return (
@@ -399,6 +634,7 @@ export default class LogsPage extends React.Component<any, LogsPageState> {
                 <Preloader loading={loading}>
                     {this.renderSettings()}
                     {this.renderSearch()}
+                    <If condition={hostMode && logType === "access"}>{this.renderAccessLogFilters()}</If>
 
                     <Flex className="log-contents-container">{this.renderLogContents()}</Flex>
                 </Preloader>
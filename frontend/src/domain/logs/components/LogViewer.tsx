@@ -7,38 +7,25 @@ export interface LogViewerProps {
     lines: LogLine[]
 }
 
-interface LogViewerState {
-    sortedLines: LogLine[]
-}
+const textEncoder = new TextEncoder()
 
-export default class LogViewer extends React.Component<LogViewerProps, LogViewerState> {
+export default class LogViewer extends React.Component<LogViewerProps> {
     private readonly containerRef: React.RefObject<HTMLDivElement | null>
 
     constructor(props: LogViewerProps) {
         super(props)
         this.containerRef = React.createRef()
-        this.state = {
-            sortedLines: this.sortLines(props.lines),
-        }
-    }
-
-    private sortLines(lines: LogLine[]): LogLine[] {
-        return [...lines].sort((left, right) => left.lineNumber - right.lineNumber)
     }
 
-    componentDidUpdate(prevProps: Readonly<LogViewerProps>): void {
+    componentDidUpdate(): void {
         if (this.containerRef.current) this.containerRef.current.scrollTop = this.containerRef.current.scrollHeight
-
-        if (prevProps.lines !== this.props.lines) {
-            const { lines } = this.props
-            const sortedLines = this.sortLines(lines)
-            this.setState({ sortedLines })
-        }
     }
 
-    private renderWithPrefixSpaces(value: string, length: number): React.ReactNode {
-        const spaces = "&nbsp;".repeat(Math.max(0, length - value.length))
-        return <span dangerouslySetInnerHTML={{ __html: spaces + value }}></span>
+    // The lines are identified by their byte offset in the file. A line that doesn't start right after the previous
+    // one (allowing for a trimmed carriage return) means that some lines were skipped, like when searching.
+    private isGapBetween(previous: LogLine, line: LogLine): boolean {
+        const previousEnd = previous.offset + textEncoder.encode(previous.contents).length + 1
+        return line.offset < previous.offset || line.offset > previousEnd + 1
     }
 
     private renderLineContent(line: LogLine) {
@@ -62,35 +49,26 @@ export default class LogViewer extends React.Component<LogViewerProps, LogViewer
         )
     }
 
-    private renderGapIndicator(lineNumberWidth: number) {
+    private renderGapIndicator() {
         return (
             <Flex className="log-viewer-line log-viewer-gap">
-                <span className="log-viewer-line-number">{this.renderWithPrefixSpaces("...", lineNumberWidth)}</span>
-                <span className="log-viewer-line-text log-viewer-gap-text"></span>
+                <span className="log-viewer-line-text log-viewer-gap-text">...</span>
             </Flex>
         )
     }
 
-    private renderLines(sortedLines: LogLine[], lineNumberWidth: number) {
+    private renderLines(lines: LogLine[]) {
         const elements: React.ReactNode[] = []
 
-        sortedLines.forEach((line, index) => {
-            if (index > 0) {
-                const previousLineNumber = sortedLines[index - 1].lineNumber
-                if (line.lineNumber > previousLineNumber + 1) {
-                    elements.push(
-                        <React.Fragment key={`gap-${previousLineNumber}-${line.lineNumber}`}>
-                            {this.renderGapIndicator(lineNumberWidth)}
-                        </React.Fragment>,
-                    )
-                }
+        lines.forEach((line, index) => {
+            if (index > 0 && this.isGapBetween(lines[index - 1], line)) {
+                elements.push(
+                    <React.Fragment key={`gap-${index}-${line.offset}`}>{this.renderGapIndicator()}</React.Fragment>,
+                )
             }
 
             elements.push(
-                <Flex key={line.lineNumber} className="log-viewer-line">
-                    <span className="log-viewer-line-number">
-                        {this.renderWithPrefixSpaces(String(line.lineNumber + 1), lineNumberWidth)}
-                    </span>
+                <Flex key={`${index}-${line.offset}`} className="log-viewer-line">
                     {this.renderLineContent(line)}
                 </Flex>,
             )
@@ -100,14 +78,12 @@ export default class LogViewer extends React.Component<LogViewerProps, LogViewer
     }
 
     render() {
-        const { sortedLines } = this.state
-        const maxLineNumber = sortedLines.length > 0 ? sortedLines[sortedLines.length - 1].lineNumber : 0
-        const lineNumberWidth = Math.max(String(maxLineNumber).length, 5)
+        const { lines } = this.props
 
         return (
             <Flex ref={this.containerRef} className="log-viewer-container" vertical>
                 <Flex className="log-viewer-content" vertical>
-                    {this.renderLines(sortedLines, lineNumberWidth)}
+                    {this.renderLines(lines)}
                 </Flex>
             </Flex>
         )
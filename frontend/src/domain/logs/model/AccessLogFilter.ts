@@ -0,0 +1,8 @@
+export default interface AccessLogFilter {
+    statusFrom?: number
+    statusTo?: number
+    method?: string
+    path?: string
+    clientAddress?: string
+    minimumLatencyMs?: number
+}
@@ -1,5 +1,5 @@
 export default interface LogLine {
-    lineNumber: number
+    offset: number
     contents: string
     highlight?: {
         start: number
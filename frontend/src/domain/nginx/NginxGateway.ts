@@ -31,8 +31,29 @@ export default class NginxGateway {
         return this.client.get("/metadata")
     }
 
-    async getLogs(lines: number, surroundingLines: number, searchTerms?: string): Promise<ApiResponse<LogLine[]>> {
-        return this.client.get("/logs", undefined, { lines, surroundingLines, searchTerms })
+    async getLogs(
+        lines: number,
+        surroundingLines: number,
+        searchTerms?: string,
+        before?: number,
+    ): Promise<ApiResponse<LogLine[]>> {
+        return this.client.get("/logs", undefined, { lines, surroundingLines, searchTerms, before })
+    }
+
+    async streamLogs(
+        surroundingLines: number,
+        searchTerms: string | undefined,
+        onLines: (lines: LogLine[]) => void,
+        signal: AbortSignal,
+    ): Promise<void> {
+        return this.client.stream(
+            "/logs/stream",
+            { surroundingLines, searchTerms },
+            (event, data) => {
+                if (event === "lines") onLines(JSON.parse(data))
+            },
+            signal,
+        )
     }
 
     async configFiles(
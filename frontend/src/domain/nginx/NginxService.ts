@@ -53,7 +53,16 @@ export default class NginxService {
             })
     }
 
-    async logs(lines: number, surroundingLines: number, searchTerms?: string): Promise<LogLine[]> {
-        return this.gateway.getLogs(lines, surroundingLines, searchTerms).then(requireSuccessPayload)
+    async logs(lines: number, surroundingLines: number, searchTerms?: string, before?: number): Promise<LogLine[]> {
+        return this.gateway.getLogs(lines, surroundingLines, searchTerms, before).then(requireSuccessPayload)
+    }
+
+    async streamLogs(
+        surroundingLines: number,
+        searchTerms: string | undefined,
+        onLines: (lines: LogLine[]) => void,
+        signal: AbortSignal,
+    ): Promise<void> {
+        return this.gateway.streamLogs(surroundingLines, searchTerms, onLines, signal)
     }
 }
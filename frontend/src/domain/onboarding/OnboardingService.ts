@@ -30,7 +30,12 @@ export default class OnboardingService {
                 exportData: UserAccessLevel.READ_ONLY,
                 vpns: UserAccessLevel.READ_WRITE,
                 caches: UserAccessLevel.READ_WRITE,
+                upstreams: UserAccessLevel.READ_WRITE,
+                tlsProfiles: UserAccessLevel.READ_WRITE,
+                rateLimits: UserAccessLevel.READ_WRITE,
+                securityHeaders: UserAccessLevel.READ_WRITE,
                 trafficStats: UserAccessLevel.READ_ONLY,
+                audit: UserAccessLevel.READ_ONLY,
             },
         }
 
@@ -0,0 +1,17 @@
+import RateLimitRequest, { RateLimitKeyType, RateLimitRateUnit } from "./model/RateLimitRequest"
+
+export function rateLimitFormDefaults(): RateLimitRequest {
+    return {
+        name: "",
+        keyType: RateLimitKeyType.CLIENT_IP,
+        rate: 10,
+        rateUnit: RateLimitRateUnit.PER_SECOND,
+        burst: 20,
+        noDelay: false,
+        responseStatusCode: 429,
+        connectionLimit: {
+            enabled: false,
+            maximumConnections: 10,
+        },
+    }
+}
@@ -0,0 +1,367 @@
+import React from "react"
+import { navigateTo, routeParams } from "../../core/components/router/AppRouter"
+import RateLimitService from "./RateLimitService"
+import { Flex, Form, FormInstance, Input, InputNumber, Select, Space, Switch } from "antd"
+import Preloader from "../../core/components/preloader/Preloader"
+import FormLayout from "../../core/components/form/FormLayout"
+import ValidationResult from "../../core/validation/ValidationResult"
+import ModalPreloader from "../../core/components/preloader/ModalPreloader"
+import Notification from "../../core/components/notification/Notification"
+import { UnexpectedResponseError } from "../../core/apiclient/ApiResponse"
+import ValidationResultConverter from "../../core/validation/ValidationResultConverter"
+import AppShellContext, { ShellAction } from "../../core/components/shell/AppShellContext"
+import CommonNotifications from "../../core/components/notification/CommonNotifications"
+import EmptyStates from "../../core/components/emptystate/EmptyStates"
+import DeleteRateLimitAction from "./actions/DeleteRateLimitAction"
+import ReloadNginxAction from "../nginx/actions/ReloadNginxAction"
+import { UserAccessLevel } from "../user/model/UserAccessLevel"
+import AccessControl from "../../core/components/accesscontrol/AccessControl"
+import { isAccessGranted } from "../../core/components/accesscontrol/IsAccessGranted"
+import { rateLimitFormDefaults } from "./RateLimitFormDefaults"
+import RateLimitRequest, { RateLimitKeyType } from "./model/RateLimitRequest"
+import { RATE_LIMIT_KEY_TYPE_OPTIONS_DATA, RATE_LIMIT_RATE_UNIT_OPTIONS_DATA } from "./RateLimitOptions"
+import If from "../../core/components/flowcontrol/If"
+import "./RateLimitFormPage.css"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+import { I18n } from "../../core/i18n/I18n"
+
+interface RateLimitFormState {
+    formValues: RateLimitRequest
+    validationResult: ValidationResult
+    loading: boolean
+    notFound: boolean
+    error?: Error
+}
+
+export default class RateLimitFormPage extends React.Component<unknown, RateLimitFormState> {
+    private readonly service: RateLimitService
+    private readonly saveModal: ModalPreloader
+    private readonly formRef: React.RefObject<FormInstance | null>
+    private rateLimitId?: string
+
+    constructor(props: any) {
+        super(props)
+        const rateLimitId = routeParams().id
+        this.formRef = React.createRef()
+        this.rateLimitId = rateLimitId === "new" ? undefined : rateLimitId
+        this.service = new RateLimitService()
+        this.saveModal = new ModalPreloader()
+        this.state = {
+            formValues: rateLimitFormDefaults(),
+            validationResult: new ValidationResult(),
+            loading: true,
+            notFound: false,
+        }
+    }
+
+    private buildRequest(): RateLimitRequest {
+        const { formValues } = this.state
+        const keyName = formValues.keyType === RateLimitKeyType.CLIENT_IP ? undefined : formValues.keyName?.trim()
+
+        return {
+            ...formValues,
+            keyName: keyName ? keyName : undefined,
+        }
+    }
+
+    private submit() {
+        this.saveModal.show(MessageKey.CommonHangOnTight, {
+            id: MessageKey.CommonSavingType,
+            params: { type: MessageKey.CommonRateLimit },
+        })
+        this.setState({ validationResult: new ValidationResult() })
+
+        const request = this.buildRequest()
+        const action =
+            this.rateLimitId === undefined
+                ? this.service.create(request).then(response => this.updateId(response.id))
+                : this.service.updateById(this.rateLimitId, request)
+
+        action.then(() => this.handleSuccess()).catch(error => this.handleError(error))
+    }
+
+    private updateId(id: string) {
+        this.rateLimitId = id
+        navigateTo(`/rate-limits/${id}`, true)
+        this.updateShellConfig(true)
+    }
+
+    private handleSuccess() {
+        this.saveModal.close()
+        Notification.success(
+            { id: MessageKey.CommonTypeSaved, params: { type: MessageKey.CommonRateLimit } },
+            MessageKey.CommonSuccessMessage,
+        )
+        ReloadNginxAction.execute()
+    }
+
+    private handleError(error: Error) {
+        if (error instanceof UnexpectedResponseError) {
+            const validationResult = ValidationResultConverter.parse(error.response)
+            if (validationResult != null) this.setState({ validationResult })
+        }
+
+        this.saveModal.close()
+        Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonFormCheckMessage)
+    }
+
+    private handleChange(newValues: RateLimitRequest) {
+        this.setState({
+            formValues: {
+                ...newValues,
+            },
+        })
+    }
+
+    private renderKeyName() {
+        const { validationResult, formValues } = this.state
+        const label =
+            formValues.keyType === RateLimitKeyType.JWT_CLAIM
+                ? MessageKey.FrontendRatelimitClaimName
+                : MessageKey.FrontendRatelimitHeaderName
+
+        return (
+            <If condition={formValues.keyType !== RateLimitKeyType.CLIENT_IP}>
+                <Form.Item
+                    name="keyName"
+                    validateStatus={validationResult.getStatus("keyName")}
+                    help={validationResult.getMessage("keyName")}
+                    label={<I18n id={label} />}
+                    required
+                >
+                    <Input />
+                </Form.Item>
+            </If>
+        )
+    }
+
+    private renderGeneralSection() {
+        const { validationResult } = this.state
+
+        return (
+            <Flex className="rate-limit-form-inner-flex-container-column rate-limit-form-expanded-label-size">
+                <h2 className="rate-limit-form-section-name">
+                    <I18n id={MessageKey.CommonGeneral} />
+                </h2>
+                <Form.Item
+                    name="name"
+                    validateStatus={validationResult.getStatus("name")}
+                    help={validationResult.getMessage("name")}
+                    label={<I18n id={MessageKey.CommonName} />}
+                    required
+                >
+                    <Input />
+                </Form.Item>
+                <Form.Item
+                    name="keyType"
+                    validateStatus={validationResult.getStatus("keyType")}
+                    help={
+                        validationResult.getMessage("keyType") ?? <I18n id={MessageKey.FrontendRatelimitKeyTypeHelp} />
+                    }
+                    label={<I18n id={MessageKey.FrontendRatelimitKeyType} />}
+                    required
+                >
+                    <Select
+                        options={RATE_LIMIT_KEY_TYPE_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                    />
+                </Form.Item>
+                {this.renderKeyName()}
+                <Form.Item
+                    name="responseStatusCode"
+                    validateStatus={validationResult.getStatus("responseStatusCode")}
+                    help={validationResult.getMessage("responseStatusCode")}
+                    label={<I18n id={MessageKey.FrontendRatelimitResponseStatusCode} />}
+                    required
+                >
+                    <InputNumber min={400} max={599} style={{ width: "100%" }} />
+                </Form.Item>
+            </Flex>
+        )
+    }
+
+    private renderRequestRateSection() {
+        const { validationResult } = this.state
+
+        return (
+            <Flex className="rate-limit-form-inner-flex-container-column rate-limit-form-expanded-label-size">
+                <h2 className="rate-limit-form-section-name">
+                    <I18n id={MessageKey.FrontendRatelimitRequestRate} />
+                </h2>
+                <p className="rate-limit-form-section-help-text">
+                    <I18n id={MessageKey.FrontendRatelimitRequestRateHelp} />
+                </p>
+                <Form.Item
+                    label={<I18n id={MessageKey.FrontendRatelimitRate} />}
+                    validateStatus={validationResult.getStatus("rate") ?? validationResult.getStatus("rateUnit")}
+                    help={validationResult.getMessage("rate") ?? validationResult.getMessage("rateUnit")}
+                    required
+                >
+                    <Space.Compact style={{ width: "100%" }}>
+                        <Form.Item name="rate" noStyle>
+                            <InputNumber min={1} style={{ width: "100%" }} />
+                        </Form.Item>
+                        <Form.Item name="rateUnit" noStyle>
+                            <Select
+                                style={{ width: 200 }}
+                                options={RATE_LIMIT_RATE_UNIT_OPTIONS_DATA.map(item => ({
+                                    value: item.value,
+                                    label: <I18n id={item.messageKey} />,
+                                }))}
+                            />
+                        </Form.Item>
+                    </Space.Compact>
+                </Form.Item>
+                <Form.Item
+                    name="burst"
+                    validateStatus={validationResult.getStatus("burst")}
+                    help={validationResult.getMessage("burst") ?? <I18n id={MessageKey.FrontendRatelimitBurstHelp} />}
+                    label={<I18n id={MessageKey.FrontendRatelimitBurst} />}
+                    required
+                >
+                    <InputNumber min={0} max={100000} style={{ width: "100%" }} />
+                </Form.Item>
+                <Form.Item
+                    name="noDelay"
+                    help={<I18n id={MessageKey.FrontendRatelimitNoDelayHelp} />}
+                    label={<I18n id={MessageKey.FrontendRatelimitNoDelay} />}
+                    required
+                >
+                    <Switch />
+                </Form.Item>
+                {this.renderConnectionLimitSection()}
+            </Flex>
+        )
+    }
+
+    private renderConnectionLimitSection() {
+        const { validationResult, formValues } = this.state
+
+        return (
+            <>
+                <h2 className="rate-limit-form-section-name">
+                    <I18n id={MessageKey.FrontendRatelimitConnectionLimit} />
+                </h2>
+                <p className="rate-limit-form-section-help-text">
+                    <I18n id={MessageKey.FrontendRatelimitConnectionLimitHelp} />
+                </p>
+                <Form.Item
+                    name={["connectionLimit", "enabled"]}
+                    label={<I18n id={MessageKey.CommonEnabled} />}
+                    required
+                >
+                    <Switch />
+                </Form.Item>
+                <Form.Item
+                    name={["connectionLimit", "maximumConnections"]}
+                    validateStatus={validationResult.getStatus("connectionLimit.maximumConnections")}
+                    help={validationResult.getMessage("connectionLimit.maximumConnections")}
+                    label={<I18n id={MessageKey.FrontendRatelimitMaximumConnections} />}
+                    required
+                >
+                    <InputNumber
+                        min={1}
+                        max={100000}
+                        style={{ width: "100%" }}
+                        disabled={!formValues.connectionLimit.enabled}
+                    />
+                </Form.Item>
+            </>
+        )
+    }
+
+    private renderForm() {
+        const { formValues } = this.state
+
+        return (
+            <Form<RateLimitRequest>
+                {...FormLayout.FormDefaults}
+                ref={this.formRef}
+                onValuesChange={(_, formValues) => this.handleChange(formValues)}
+                initialValues={formValues}
+            >
+                <Flex className="rate-limit-form-inner-flex-container">
+                    {this.renderGeneralSection()}
+                    {this.renderRequestRateSection()}
+                </Flex>
+            </Form>
+        )
+    }
+
+    private async delete() {
+        if (this.rateLimitId === undefined) return
+
+        return DeleteRateLimitAction.execute(this.rateLimitId).then(() => navigateTo("/rate-limits"))
+    }
+
+    private updateShellConfig(enableActions: boolean) {
+        if (!isAccessGranted(UserAccessLevel.READ_WRITE, permissions => permissions.rateLimits)) {
+            enableActions = false
+        }
+
+        const actions: ShellAction[] = [
+            {
+                description: MessageKey.CommonSave,
+                disabled: !enableActions,
+                onClick: () => this.submit(),
+            },
+        ]
+
+        if (this.rateLimitId !== undefined)
+            actions.unshift({
+                description: MessageKey.CommonDelete,
+                disabled: !enableActions,
+                color: "danger",
+                onClick: () => this.delete(),
+            })
+
+        AppShellContext.get().updateConfig({
+            title: MessageKey.FrontendRatelimitFormTitle,
+            subtitle: MessageKey.FrontendRatelimitFormSubtitle,
+            actions,
+        })
+    }
+
+    componentDidMount() {
+        if (this.rateLimitId === undefined) {
+            this.setState({ loading: false })
+            this.updateShellConfig(true)
+            return
+        }
+
+        this.service
+            .getById(this.rateLimitId!!)
+            .then(rateLimit => {
+                if (rateLimit === undefined) this.setState({ loading: false, notFound: true })
+                else {
+                    this.setState({ loading: false, formValues: rateLimit })
+                    this.updateShellConfig(true)
+                }
+            })
+            .catch(error => {
+                CommonNotifications.failedToFetch()
+                this.setState({ loading: false, error })
+            })
+
+        this.updateShellConfig(false)
+    }
+
+    render() {
+        const { loading, notFound, error } = this.state
+
+        if (error !== undefined) return EmptyStates.FailedToFetch
+        if (notFound) return EmptyStates.NotFound
+        if (loading) return <Preloader loading />
+
+        return (
+            <AccessControl
+                requiredAccessLevel={UserAccessLevel.READ_ONLY}
+                permissionResolver={permissions => permissions.rateLimits}
+            >
+                {this.renderForm()}
+            </AccessControl>
+        )
+    }
+}
@@ -0,0 +1,38 @@
+import ApiClient from "../../core/apiclient/ApiClient"
+import ApiResponse from "../../core/apiclient/ApiResponse"
+import PageResponse from "../../core/pagination/PageResponse"
+import RateLimitResponse from "./model/RateLimitResponse"
+import RateLimitRequest from "./model/RateLimitRequest"
+import GenericCreateResponse from "../../core/common/GenericCreateResponse"
+
+export default class RateLimitGateway {
+    private readonly client: ApiClient
+
+    constructor() {
+        this.client = new ApiClient("/api/rate-limits")
+    }
+
+    async getPage(
+        pageSize?: number,
+        pageNumber?: number,
+        searchTerms?: string,
+    ): Promise<ApiResponse<PageResponse<RateLimitResponse>>> {
+        return this.client.get(undefined, undefined, { pageSize, pageNumber, searchTerms })
+    }
+
+    async getById(id: string): Promise<ApiResponse<RateLimitResponse>> {
+        return this.client.get(`/${id}`)
+    }
+
+    async putById(id: string, rateLimit: RateLimitRequest): Promise<ApiResponse<void>> {
+        return this.client.put(`/${id}`, rateLimit)
+    }
+
+    async deleteById(id: string): Promise<ApiResponse<void>> {
+        return this.client.delete(`/${id}`)
+    }
+
+    async post(rateLimit: RateLimitRequest): Promise<ApiResponse<GenericCreateResponse>> {
+        return this.client.post("", rateLimit)
+    }
+}
@@ -0,0 +1,142 @@
+import React from "react"
+import DataTable, { DataTableColumn } from "../../core/components/datatable/DataTable"
+import { Link } from "react-router-dom"
+import { DeleteOutlined, EditOutlined } from "@ant-design/icons"
+import PageResponse from "../../core/pagination/PageResponse"
+import RateLimitService from "./RateLimitService"
+import AppShellContext from "../../core/components/shell/AppShellContext"
+import RateLimitResponse from "./model/RateLimitResponse"
+import DeleteRateLimitAction from "./actions/DeleteRateLimitAction"
+import AccessControl from "../../core/components/accesscontrol/AccessControl"
+import { UserAccessLevel } from "../user/model/UserAccessLevel"
+import { isAccessGranted } from "../../core/components/accesscontrol/IsAccessGranted"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+import { I18n, raw } from "../../core/i18n/I18n"
+import { themedColors } from "../../core/components/theme/ThemedResources"
+import { RATE_LIMIT_KEY_TYPE_OPTIONS_DATA, RATE_LIMIT_RATE_UNIT_OPTIONS_DATA } from "./RateLimitOptions"
+
+export default class RateLimitListPage extends React.PureComponent {
+    private readonly service: RateLimitService
+    private readonly table: React.RefObject<DataTable<RateLimitResponse> | null>
+
+    constructor(props: any) {
+        super(props)
+        this.service = new RateLimitService()
+        this.table = React.createRef()
+    }
+
+    private renderKeyType(rateLimit: RateLimitResponse) {
+        const option = RATE_LIMIT_KEY_TYPE_OPTIONS_DATA.find(item => item.value === rateLimit.keyType)
+        const description = option ? <I18n id={option.messageKey} /> : rateLimit.keyType
+        if (!rateLimit.keyName) return description
+
+        return (
+            <>
+                {description} ({rateLimit.keyName})
+            </>
+        )
+    }
+
+    private renderRate(rateLimit: RateLimitResponse) {
+        const option = RATE_LIMIT_RATE_UNIT_OPTIONS_DATA.find(item => item.value === rateLimit.rateUnit)
+        return (
+            <>
+                {rateLimit.rate} {option ? <I18n id={option.messageKey} /> : rateLimit.rateUnit}
+            </>
+        )
+    }
+
+    private renderConnectionLimit(rateLimit: RateLimitResponse) {
+        const { enabled, maximumConnections } = rateLimit.connectionLimit
+        return enabled ? maximumConnections : <I18n id={MessageKey.CommonDisabled} />
+    }
+
+    private buildColumns(): DataTableColumn<RateLimitResponse>[] {
+        return [
+            {
+                id: "name",
+                description: MessageKey.CommonName,
+                renderer: item => item.name,
+            },
+            {
+                id: "keyType",
+                description: MessageKey.FrontendRatelimitKeyType,
+                renderer: item => this.renderKeyType(item),
+                width: 250,
+            },
+            {
+                id: "rate",
+                description: MessageKey.FrontendRatelimitRate,
+                renderer: item => this.renderRate(item),
+                width: 200,
+            },
+            {
+                id: "connectionLimit",
+                description: MessageKey.FrontendRatelimitConnectionLimit,
+                renderer: item => this.renderConnectionLimit(item),
+                width: 200,
+            },
+            {
+                id: "actions",
+                description: raw(""),
+                renderer: item => (
+                    <>
+                        <Link to={`/rate-limits/${item.id}`}>
+                            <EditOutlined className="action-icon" />
+                        </Link>
+
+                        <Link to="" onClick={() => this.deleteRateLimit(item)}>
+                            <DeleteOutlined style={{ color: themedColors().DANGER }} className="action-icon" />
+                        </Link>
+                    </>
+                ),
+                width: 120,
+            },
+        ]
+    }
+
+    private async deleteRateLimit(rateLimit: RateLimitResponse) {
+        return DeleteRateLimitAction.execute(rateLimit.id).then(() => this.table.current?.refresh())
+    }
+
+    private fetchData(
+        pageSize: number,
+        pageNumber: number,
+        searchTerms?: string,
+    ): Promise<PageResponse<RateLimitResponse>> {
+        return this.service.list(pageSize, pageNumber, searchTerms)
+    }
+
+    componentDidMount() {
+        AppShellContext.get().updateConfig({
+            title: MessageKey.CommonRateLimits,
+            subtitle: MessageKey.FrontendRatelimitListSubtitle,
+            actions: [
+                {
+                    description: MessageKey.FrontendRatelimitNewButton,
+                    onClick: "/rate-limits/new",
+                    disabled: !isAccessGranted(UserAccessLevel.READ_WRITE, permissions => permissions.rateLimits),
+                },
+            ],
+        })
+    }
+
+    render() {
+        return (
+            <AccessControl
+                requiredAccessLevel={UserAccessLevel.READ_ONLY}
+                permissionResolver={permissions => permissions.rateLimits}
+            >
+                <DataTable
+                    id="rate-limits"
+                    ref={this.table}
+                    columns={this.buildColumns()}
+                    dataProvider={(pageSize, pageNumber, searchTerms) =>
+                        this.fetchData(pageSize, pageNumber, searchTerms)
+                    }
+                    rowKey={item => item.id}
+                />
+            </AccessControl>
+        )
+    }
+}
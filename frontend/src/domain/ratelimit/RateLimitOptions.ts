@@ -0,0 +1,13 @@
+import { RateLimitKeyType, RateLimitRateUnit } from "./model/RateLimitRequest"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+
+export const RATE_LIMIT_KEY_TYPE_OPTIONS_DATA = [
+    { value: RateLimitKeyType.CLIENT_IP, messageKey: MessageKey.FrontendRatelimitKeyTypeClientIp },
+    { value: RateLimitKeyType.HEADER, messageKey: MessageKey.FrontendRatelimitKeyTypeHeader },
+    { value: RateLimitKeyType.JWT_CLAIM, messageKey: MessageKey.FrontendRatelimitKeyTypeJwtClaim },
+]
+
+export const RATE_LIMIT_RATE_UNIT_OPTIONS_DATA = [
+    { value: RateLimitRateUnit.PER_SECOND, messageKey: MessageKey.FrontendRatelimitRateUnitPerSecond },
+    { value: RateLimitRateUnit.PER_MINUTE, messageKey: MessageKey.FrontendRatelimitRateUnitPerMinute },
+]
@@ -0,0 +1,38 @@
+import RateLimitGateway from "./RateLimitGateway"
+import { requireNullablePayload, requireSuccessPayload, requireSuccessResponse } from "../../core/apiclient/ApiResponse"
+import PageResponse from "../../core/pagination/PageResponse"
+import RateLimitRequest from "./model/RateLimitRequest"
+import RateLimitResponse from "./model/RateLimitResponse"
+import GenericCreateResponse from "../../core/common/GenericCreateResponse"
+
+export default class RateLimitService {
+    private readonly gateway: RateLimitGateway
+
+    constructor() {
+        this.gateway = new RateLimitGateway()
+    }
+
+    async list(
+        pageSize?: number,
+        pageNumber?: number,
+        searchTerms?: string,
+    ): Promise<PageResponse<RateLimitResponse>> {
+        return this.gateway.getPage(pageSize, pageNumber, searchTerms).then(requireSuccessPayload)
+    }
+
+    async delete(id: string): Promise<void> {
+        return this.gateway.deleteById(id).then(requireSuccessResponse)
+    }
+
+    async getById(id: string): Promise<RateLimitResponse | undefined> {
+        return this.gateway.getById(id).then(requireNullablePayload)
+    }
+
+    async updateById(id: string, rateLimit: RateLimitRequest): Promise<void> {
+        return this.gateway.putById(id, rateLimit).then(requireSuccessResponse)
+    }
+
+    async create(rateLimit: RateLimitRequest): Promise<GenericCreateResponse> {
+        return this.gateway.post(rateLimit).then(requireSuccessPayload)
+    }
+}
@@ -0,0 +1,48 @@
+import RateLimitService from "../RateLimitService"
+import UserConfirmation from "../../../core/components/confirmation/UserConfirmation"
+import Notification from "../../../core/components/notification/Notification"
+import { UnexpectedResponseError } from "../../../core/apiclient/ApiResponse"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import { I18nMessage, raw } from "../../../core/i18n/I18n"
+
+class DeleteRateLimitAction {
+    private readonly service: RateLimitService
+
+    constructor() {
+        this.service = new RateLimitService()
+    }
+
+    private handleError(error: Error) {
+        const title = {
+            id: MessageKey.CommonUnableToDelete,
+            params: { type: MessageKey.CommonRateLimit },
+        }
+        let message: I18nMessage = MessageKey.CommonUnexpectedErrorTryAgain
+
+        if (error instanceof UnexpectedResponseError) {
+            const responseMessage = error.response?.body?.message
+            if (typeof responseMessage === "string") {
+                message = raw(responseMessage)
+            }
+        }
+
+        Notification.error(title, message)
+    }
+
+    async execute(rateLimitId: string): Promise<void> {
+        return UserConfirmation.ask(MessageKey.FrontendRatelimitDeleteConfirmation)
+            .then(() => this.service.delete(rateLimitId))
+            .then(() =>
+                Notification.success(
+                    {
+                        id: MessageKey.CommonTypeDeleted,
+                        params: { type: MessageKey.CommonRateLimit },
+                    },
+                    MessageKey.CommonSuccessMessage,
+                ),
+            )
+            .catch(error => this.handleError(error))
+    }
+}
+
+export default new DeleteRateLimitAction()
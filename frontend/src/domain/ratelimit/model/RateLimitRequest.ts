@@ -0,0 +1,27 @@
+export enum RateLimitKeyType {
+    CLIENT_IP = "CLIENT_IP",
+    HEADER = "HEADER",
+    JWT_CLAIM = "JWT_CLAIM",
+}
+
+export enum RateLimitRateUnit {
+    PER_SECOND = "PER_SECOND",
+    PER_MINUTE = "PER_MINUTE",
+}
+
+export interface RateLimitConnectionLimit {
+    enabled: boolean
+    maximumConnections?: number
+}
+
+export default interface RateLimitRequest {
+    name: string
+    keyType: RateLimitKeyType
+    keyName?: string
+    rate: number
+    rateUnit: RateLimitRateUnit
+    burst: number
+    noDelay: boolean
+    responseStatusCode: number
+    connectionLimit: RateLimitConnectionLimit
+}
@@ -0,0 +1,5 @@
+import RateLimitRequest from "./RateLimitRequest"
+
+export default interface RateLimitResponse extends RateLimitRequest {
+    id: string
+}
@@ -0,0 +1,19 @@
+import SecurityHeadersRequest, {
+    SecurityHeadersFrameOptions,
+    SecurityHeadersReferrerPolicy,
+} from "./model/SecurityHeadersRequest"
+
+export function securityHeadersFormDefaults(): SecurityHeadersRequest {
+    return {
+        name: "",
+        frameOptions: SecurityHeadersFrameOptions.SAMEORIGIN,
+        contentTypeNoSniff: true,
+        referrerPolicy: SecurityHeadersReferrerPolicy.STRICT_ORIGIN_WHEN_CROSS_ORIGIN,
+        contentSecurityPolicy: {
+            enabled: false,
+            reportOnly: true,
+            collectReports: false,
+            directives: [{ name: "default-src", sources: ["'self'"] }],
+        },
+    }
+}
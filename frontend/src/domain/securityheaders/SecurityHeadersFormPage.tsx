@@ -0,0 +1,528 @@
+import React from "react"
+import { navigateTo, routeParams } from "../../core/components/router/AppRouter"
+import SecurityHeadersService from "./SecurityHeadersService"
+import { Button, Flex, Form, FormInstance, FormListFieldData, FormListOperation, Input, Select, Switch } from "antd"
+import { DeleteOutlined, PlusOutlined } from "@ant-design/icons"
+import Preloader from "../../core/components/preloader/Preloader"
+import FormLayout from "../../core/components/form/FormLayout"
+import ValidationResult from "../../core/validation/ValidationResult"
+import ModalPreloader from "../../core/components/preloader/ModalPreloader"
+import Notification from "../../core/components/notification/Notification"
+import { UnexpectedResponseError } from "../../core/apiclient/ApiResponse"
+import ValidationResultConverter from "../../core/validation/ValidationResultConverter"
+import AppShellContext, { ShellAction } from "../../core/components/shell/AppShellContext"
+import CommonNotifications from "../../core/components/notification/CommonNotifications"
+import EmptyStates from "../../core/components/emptystate/EmptyStates"
+import DeleteSecurityHeadersAction from "./actions/DeleteSecurityHeadersAction"
+import ReloadNginxAction from "../nginx/actions/ReloadNginxAction"
+import { UserAccessLevel } from "../user/model/UserAccessLevel"
+import AccessControl from "../../core/components/accesscontrol/AccessControl"
+import { isAccessGranted } from "../../core/components/accesscontrol/IsAccessGranted"
+import { securityHeadersFormDefaults } from "./SecurityHeadersFormDefaults"
+import SecurityHeadersRequest from "./model/SecurityHeadersRequest"
+import SecurityHeadersReportResponse from "./model/SecurityHeadersReportResponse"
+import {
+    SECURITY_HEADERS_DIRECTIVE_NAMES,
+    SECURITY_HEADERS_FRAME_OPTIONS_DATA,
+    SECURITY_HEADERS_REFERRER_POLICY_DATA,
+    SECURITY_HEADERS_SOURCE_SUGGESTIONS,
+} from "./SecurityHeadersOptions"
+import DataTable, { DataTableColumn } from "../../core/components/datatable/DataTable"
+import PageResponse from "../../core/pagination/PageResponse"
+import UserConfirmation from "../../core/components/confirmation/UserConfirmation"
+import If from "../../core/components/flowcontrol/If"
+import "./SecurityHeadersFormPage.css"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+import { I18n } from "../../core/i18n/I18n"
+
+interface SecurityHeadersFormState {
+    formValues: SecurityHeadersRequest
+    validationResult: ValidationResult
+    loading: boolean
+    notFound: boolean
+    error?: Error
+}
+
+export default class SecurityHeadersFormPage extends React.Component<unknown, SecurityHeadersFormState> {
+    private readonly service: SecurityHeadersService
+    private readonly saveModal: ModalPreloader
+    private readonly formRef: React.RefObject<FormInstance | null>
+    private readonly reportsTable: React.RefObject<DataTable<SecurityHeadersReportResponse> | null>
+    private securityHeadersId?: string
+
+    constructor(props: any) {
+        super(props)
+        const securityHeadersId = routeParams().id
+        this.formRef = React.createRef()
+        this.reportsTable = React.createRef()
+        this.securityHeadersId = securityHeadersId === "new" ? undefined : securityHeadersId
+        this.service = new SecurityHeadersService()
+        this.saveModal = new ModalPreloader()
+        this.state = {
+            formValues: securityHeadersFormDefaults(),
+            validationResult: new ValidationResult(),
+            loading: true,
+            notFound: false,
+        }
+    }
+
+    private trimToUndefined(value?: string): string | undefined {
+        const trimmed = value?.trim()
+        return trimmed ? trimmed : undefined
+    }
+
+    private buildRequest(): SecurityHeadersRequest {
+        const { formValues } = this.state
+        const { contentSecurityPolicy } = formValues
+
+        return {
+            ...formValues,
+            permissionsPolicy: this.trimToUndefined(formValues.permissionsPolicy),
+            contentSecurityPolicy: {
+                ...contentSecurityPolicy,
+                reportUri: this.trimToUndefined(contentSecurityPolicy.reportUri),
+                reportTo: this.trimToUndefined(contentSecurityPolicy.reportTo),
+                directives: contentSecurityPolicy.directives?.map(directive => ({
+                    name: directive.name ?? "",
+                    sources: directive.sources ?? [],
+                })),
+            },
+        }
+    }
+
+    private submit() {
+        this.saveModal.show(MessageKey.CommonHangOnTight, {
+            id: MessageKey.CommonSavingType,
+            params: { type: MessageKey.CommonSecurityHeaders },
+        })
+        this.setState({ validationResult: new ValidationResult() })
+
+        const request = this.buildRequest()
+        const action =
+            this.securityHeadersId === undefined
+                ? this.service.create(request).then(response => this.updateId(response.id))
+                : this.service.updateById(this.securityHeadersId, request)
+
+        action.then(() => this.handleSuccess()).catch(error => this.handleError(error))
+    }
+
+    private updateId(id: string) {
+        this.securityHeadersId = id
+        navigateTo(`/security-headers/${id}`, true)
+        this.updateShellConfig(true)
+    }
+
+    private handleSuccess() {
+        this.saveModal.close()
+        Notification.success(
+            { id: MessageKey.CommonTypeSaved, params: { type: MessageKey.CommonSecurityHeaders } },
+            MessageKey.CommonSuccessMessage,
+        )
+        ReloadNginxAction.execute()
+    }
+
+    private handleError(error: Error) {
+        if (error instanceof UnexpectedResponseError) {
+            const validationResult = ValidationResultConverter.parse(error.response)
+            if (validationResult != null) this.setState({ validationResult })
+        }
+
+        this.saveModal.close()
+        Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonFormCheckMessage)
+    }
+
+    private handleChange(newValues: SecurityHeadersRequest) {
+        this.setState({
+            formValues: {
+                ...newValues,
+            },
+        })
+    }
+
+    private renderGeneralSection() {
+        const { validationResult } = this.state
+
+        return (
+            <Flex
+                className="security-headers-form-inner-flex-container-column security-headers-form-expanded-label-size"
+            >
+                <h2 className="security-headers-form-section-name">
+                    <I18n id={MessageKey.CommonGeneral} />
+                </h2>
+                <Form.Item
+                    name="name"
+                    validateStatus={validationResult.getStatus("name")}
+                    help={validationResult.getMessage("name")}
+                    label={<I18n id={MessageKey.CommonName} />}
+                    required
+                >
+                    <Input />
+                </Form.Item>
+                <Form.Item
+                    name="frameOptions"
+                    validateStatus={validationResult.getStatus("frameOptions")}
+                    help={
+                        validationResult.getMessage("frameOptions") ?? (
+                            <I18n id={MessageKey.FrontendSecurityheadersFrameOptionsHelp} />
+                        )
+                    }
+                    label={<I18n id={MessageKey.FrontendSecurityheadersFrameOptions} />}
+                >
+                    <Select
+                        allowClear
+                        options={SECURITY_HEADERS_FRAME_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                    />
+                </Form.Item>
+                <Form.Item
+                    name="contentTypeNoSniff"
+                    help={<I18n id={MessageKey.FrontendSecurityheadersContentTypeNoSniffHelp} />}
+                    label={<I18n id={MessageKey.FrontendSecurityheadersContentTypeNoSniff} />}
+                    required
+                >
+                    <Switch />
+                </Form.Item>
+                <Form.Item
+                    name="referrerPolicy"
+                    validateStatus={validationResult.getStatus("referrerPolicy")}
+                    help={validationResult.getMessage("referrerPolicy")}
+                    label={<I18n id={MessageKey.FrontendSecurityheadersReferrerPolicy} />}
+                >
+                    <Select allowClear options={SECURITY_HEADERS_REFERRER_POLICY_DATA} />
+                </Form.Item>
+                <Form.Item
+                    name="permissionsPolicy"
+                    validateStatus={validationResult.getStatus("permissionsPolicy")}
+                    help={
+                        validationResult.getMessage("permissionsPolicy") ?? (
+                            <I18n id={MessageKey.FrontendSecurityheadersPermissionsPolicyHelp} />
+                        )
+                    }
+                    label={<I18n id={MessageKey.FrontendSecurityheadersPermissionsPolicy} />}
+                >
+                    <Input placeholder="camera=(), geolocation=(self)" />
+                </Form.Item>
+            </Flex>
+        )
+    }
+
+    private renderContentSecurityPolicySection() {
+        const { validationResult, formValues } = this.state
+        const { enabled } = formValues.contentSecurityPolicy
+
+        return (
+            <Flex
+                className="security-headers-form-inner-flex-container-column security-headers-form-expanded-label-size"
+            >
+                <h2 className="security-headers-form-section-name">
+                    <I18n id={MessageKey.FrontendSecurityheadersContentSecurityPolicy} />
+                </h2>
+                <p className="security-headers-form-section-help-text">
+                    <I18n id={MessageKey.FrontendSecurityheadersContentSecurityPolicyHelp} />
+                </p>
+                <Form.Item
+                    name={["contentSecurityPolicy", "enabled"]}
+                    label={<I18n id={MessageKey.CommonEnabled} />}
+                    required
+                >
+                    <Switch />
+                </Form.Item>
+                <Form.Item
+                    name={["contentSecurityPolicy", "reportOnly"]}
+                    validateStatus={validationResult.getStatus("contentSecurityPolicy.reportOnly")}
+                    help={
+                        validationResult.getMessage("contentSecurityPolicy.reportOnly") ?? (
+                            <I18n id={MessageKey.FrontendSecurityheadersReportOnlyHelp} />
+                        )
+                    }
+                    label={<I18n id={MessageKey.FrontendSecurityheadersReportOnly} />}
+                    required
+                >
+                    <Switch disabled={!enabled} />
+                </Form.Item>
+                <Form.Item
+                    name={["contentSecurityPolicy", "collectReports"]}
+                    help={<I18n id={MessageKey.FrontendSecurityheadersCollectReportsHelp} />}
+                    label={<I18n id={MessageKey.FrontendSecurityheadersCollectReports} />}
+                    required
+                >
+                    <Switch disabled={!enabled} />
+                </Form.Item>
+                <Form.Item
+                    name={["contentSecurityPolicy", "reportUri"]}
+                    validateStatus={validationResult.getStatus("contentSecurityPolicy.reportUri")}
+                    help={validationResult.getMessage("contentSecurityPolicy.reportUri")}
+                    label={<I18n id={MessageKey.FrontendSecurityheadersReportUri} />}
+                >
+                    <Input disabled={!enabled} placeholder="https://reports.example.com/csp" />
+                </Form.Item>
+                <Form.Item
+                    name={["contentSecurityPolicy", "reportTo"]}
+                    validateStatus={validationResult.getStatus("contentSecurityPolicy.reportTo")}
+                    help={
+                        validationResult.getMessage("contentSecurityPolicy.reportTo") ?? (
+                            <I18n id={MessageKey.FrontendSecurityheadersReportToHelp} />
+                        )
+                    }
+                    label={<I18n id={MessageKey.FrontendSecurityheadersReportTo} />}
+                >
+                    <Input disabled={!enabled} placeholder="https://reports.example.com/csp" />
+                </Form.Item>
+            </Flex>
+        )
+    }
+
+    private renderDirective(field: FormListFieldData, operations: FormListOperation, index: number) {
+        const { validationResult, formValues } = this.state
+        const { enabled } = formValues.contentSecurityPolicy
+        const path = `contentSecurityPolicy.directives[${index}]`
+
+        return (
+            <Flex className="security-headers-form-directive-container" key={field.key}>
+                <Form.Item
+                    {...FormLayout.ExpandedUnlabeledItem}
+                    className="security-headers-form-directive-name"
+                    name={[field.name, "name"]}
+                    validateStatus={validationResult.getStatus(`${path}.name`)}
+                    help={validationResult.getMessage(`${path}.name`)}
+                >
+                    <Select
+                        showSearch
+                        disabled={!enabled}
+                        options={SECURITY_HEADERS_DIRECTIVE_NAMES.map(name => ({ value: name, label: name }))}
+                    />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedUnlabeledItem}
+                    className="security-headers-form-directive-sources"
+                    name={[field.name, "sources"]}
+                    validateStatus={validationResult.getStatus(`${path}.sources`)}
+                    help={validationResult.getMessage(`${path}.sources`)}
+                >
+                    <Select
+                        mode="tags"
+                        disabled={!enabled}
+                        tokenSeparators={[" "]}
+                        options={SECURITY_HEADERS_SOURCE_SUGGESTIONS.map(source => ({ value: source, label: source }))}
+                    />
+                </Form.Item>
+                <DeleteOutlined
+                    className="security-headers-form-directive-remove"
+                    onClick={() => enabled && operations.remove(field.name)}
+                />
+            </Flex>
+        )
+    }
+
+    private renderDirectives(fields: FormListFieldData[], operations: FormListOperation) {
+        const { validationResult, formValues } = this.state
+        const directives = fields.map((field, index) => this.renderDirective(field, operations, index))
+
+        const addAction = (
+            <Form.Item
+                key="add"
+                validateStatus={validationResult.getStatus("contentSecurityPolicy.directives")}
+                help={validationResult.getMessage("contentSecurityPolicy.directives")}
+            >
+                <Button
+                    type="dashed"
+                    disabled={!formValues.contentSecurityPolicy.enabled}
+                    onClick={() => operations.add({ name: undefined, sources: [] })}
+                    icon={<PlusOutlined />}
+                >
+                    <I18n id={MessageKey.FrontendSecurityheadersAddDirective} />
+                </Button>
+            </Form.Item>
+        )
+
+        return [...directives, addAction]
+    }
+
+    private renderDirectivesSection() {
+        return (
+            <Flex className="security-headers-form-inner-flex-container-column">
+                <h2 className="security-headers-form-section-name">
+                    <I18n id={MessageKey.FrontendSecurityheadersDirectives} />
+                </h2>
+                <p className="security-headers-form-section-help-text">
+                    <I18n id={MessageKey.FrontendSecurityheadersDirectivesHelp} />
+                </p>
+                <Form.List name={["contentSecurityPolicy", "directives"]}>
+                    {(fields, operations) => this.renderDirectives(fields, operations)}
+                </Form.List>
+            </Flex>
+        )
+    }
+
+    private buildReportColumns(): DataTableColumn<SecurityHeadersReportResponse>[] {
+        return [
+            {
+                id: "createdAt",
+                description: MessageKey.FrontendSecurityheadersReportedAt,
+                renderer: item => new Date(item.createdAt).toLocaleString(),
+                width: 200,
+            },
+            {
+                id: "effectiveDirective",
+                description: MessageKey.FrontendSecurityheadersEffectiveDirective,
+                renderer: item => item.effectiveDirective ?? "-",
+                width: 200,
+            },
+            {
+                id: "blockedUri",
+                description: MessageKey.FrontendSecurityheadersBlockedUri,
+                renderer: item => item.blockedUri ?? "-",
+            },
+            {
+                id: "documentUri",
+                description: MessageKey.FrontendSecurityheadersDocumentUri,
+                renderer: item => item.documentUri ?? "-",
+            },
+        ]
+    }
+
+    private fetchReports(pageSize: number, pageNumber: number): Promise<PageResponse<SecurityHeadersReportResponse>> {
+        return this.service.listReports(this.securityHeadersId!!, pageSize, pageNumber)
+    }
+
+    private async clearReports() {
+        if (this.securityHeadersId === undefined) return
+
+        return UserConfirmation.ask(MessageKey.FrontendSecurityheadersClearReportsConfirmation)
+            .then(() => this.service.deleteReports(this.securityHeadersId!!))
+            .then(() => this.reportsTable.current?.refresh())
+            .catch(() => Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonUnexpectedErrorTryAgain))
+    }
+
+    private renderReportsSection() {
+        const canClear = isAccessGranted(UserAccessLevel.READ_WRITE, permissions => permissions.securityHeaders)
+
+        return (
+            <If condition={this.securityHeadersId !== undefined}>
+                <Flex className="security-headers-form-inner-flex-container-column">
+                    <h2 className="security-headers-form-section-name">
+                        <I18n id={MessageKey.FrontendSecurityheadersReports} />
+                    </h2>
+                    <p className="security-headers-form-section-help-text">
+                        <I18n id={MessageKey.FrontendSecurityheadersReportsHelp} />
+                    </p>
+                    <DataTable
+                        id="security-headers-reports"
+                        ref={this.reportsTable}
+                        columns={this.buildReportColumns()}
+                        dataProvider={(pageSize, pageNumber) => this.fetchReports(pageSize, pageNumber)}
+                        rowKey={item => item.id}
+                        disableSearch
+                    />
+                    <Button
+                        className="security-headers-form-clear-reports"
+                        color="danger"
+                        variant="outlined"
+                        disabled={!canClear}
+                        icon={<DeleteOutlined />}
+                        onClick={() => this.clearReports()}
+                    >
+                        <I18n id={MessageKey.FrontendSecurityheadersClearReports} />
+                    </Button>
+                </Flex>
+            </If>
+        )
+    }
+
+    private renderForm() {
+        const { formValues } = this.state
+
+        return (
+            <Form<SecurityHeadersRequest>
+                {...FormLayout.FormDefaults}
+                ref={this.formRef}
+                onValuesChange={(_, formValues) => this.handleChange(formValues)}
+                initialValues={formValues}
+            >
+                <Flex className="security-headers-form-inner-flex-container">
+                    {this.renderGeneralSection()}
+                    {this.renderContentSecurityPolicySection()}
+                </Flex>
+                <Flex className="security-headers-form-inner-flex-container">{this.renderDirectivesSection()}</Flex>
+                <Flex className="security-headers-form-inner-flex-container">{this.renderReportsSection()}</Flex>
+            </Form>
+        )
+    }
+
+    private async delete() {
+        if (this.securityHeadersId === undefined) return
+
+        return DeleteSecurityHeadersAction.execute(this.securityHeadersId).then(() => navigateTo("/security-headers"))
+    }
+
+    private updateShellConfig(enableActions: boolean) {
+        if (!isAccessGranted(UserAccessLevel.READ_WRITE, permissions => permissions.securityHeaders)) {
+            enableActions = false
+        }
+
+        const actions: ShellAction[] = [
+            {
+                description: MessageKey.CommonSave,
+                disabled: !enableActions,
+                onClick: () => this.submit(),
+            },
+        ]
+
+        if (this.securityHeadersId !== undefined)
+            actions.unshift({
+                description: MessageKey.CommonDelete,
+                disabled: !enableActions,
+                color: "danger",
+                onClick: () => this.delete(),
+            })
+
+        AppShellContext.get().updateConfig({
+            title: MessageKey.FrontendSecurityheadersFormTitle,
+            subtitle: MessageKey.FrontendSecurityheadersFormSubtitle,
+            actions,
+        })
+    }
+
+    componentDidMount() {
+        if (this.securityHeadersId === undefined) {
+            this.setState({ loading: false })
+            this.updateShellConfig(true)
+            return
+        }
+
+        this.service
+            .getById(this.securityHeadersId!!)
+            .then(securityHeaders => {
+                if (securityHeaders === undefined) this.setState({ loading: false, notFound: true })
+                else {
+                    this.setState({ loading: false, formValues: securityHeaders })
+                    this.updateShellConfig(true)
+                }
+            })
+            .catch(error => {
+                CommonNotifications.failedToFetch()
+                this.setState({ loading: false, error })
+            })
+
+        this.updateShellConfig(false)
+    }
+
+    render() {
+        const { loading, notFound, error } = this.state
+
+        if (error !== undefined) return EmptyStates.FailedToFetch
+        if (notFound) return EmptyStates.NotFound
+        if (loading) return <Preloader loading />
+
+        return (
+            <AccessControl
+                requiredAccessLevel={UserAccessLevel.READ_ONLY}
+                permissionResolver={permissions => permissions.securityHeaders}
+            >
+                {this.renderForm()}
+            </AccessControl>
+        )
+    }
+}
@@ -0,0 +1,51 @@
+import ApiClient from "../../core/apiclient/ApiClient"
+import ApiResponse from "../../core/apiclient/ApiResponse"
+import PageResponse from "../../core/pagination/PageResponse"
+import SecurityHeadersResponse from "./model/SecurityHeadersResponse"
+import SecurityHeadersRequest from "./model/SecurityHeadersRequest"
+import SecurityHeadersReportResponse from "./model/SecurityHeadersReportResponse"
+import GenericCreateResponse from "../../core/common/GenericCreateResponse"
+
+export default class SecurityHeadersGateway {
+    private readonly client: ApiClient
+
+    constructor() {
+        this.client = new ApiClient("/api/security-headers")
+    }
+
+    async getPage(
+        pageSize?: number,
+        pageNumber?: number,
+        searchTerms?: string,
+    ): Promise<ApiResponse<PageResponse<SecurityHeadersResponse>>> {
+        return this.client.get(undefined, undefined, { pageSize, pageNumber, searchTerms })
+    }
+
+    async getById(id: string): Promise<ApiResponse<SecurityHeadersResponse>> {
+        return this.client.get(`/${id}`)
+    }
+
+    async putById(id: string, securityHeaders: SecurityHeadersRequest): Promise<ApiResponse<void>> {
+        return this.client.put(`/${id}`, securityHeaders)
+    }
+
+    async deleteById(id: string): Promise<ApiResponse<void>> {
+        return this.client.delete(`/${id}`)
+    }
+
+    async post(securityHeaders: SecurityHeadersRequest): Promise<ApiResponse<GenericCreateResponse>> {
+        return this.client.post("", securityHeaders)
+    }
+
+    async getReports(
+        id: string,
+        pageSize?: number,
+        pageNumber?: number,
+    ): Promise<ApiResponse<PageResponse<SecurityHeadersReportResponse>>> {
+        return this.client.get(`/${id}/reports`, undefined, { pageSize, pageNumber })
+    }
+
+    async deleteReports(id: string): Promise<ApiResponse<void>> {
+        return this.client.delete(`/${id}/reports`)
+    }
+}
@@ -0,0 +1,122 @@
+import React from "react"
+import DataTable, { DataTableColumn } from "../../core/components/datatable/DataTable"
+import { Link } from "react-router-dom"
+import { DeleteOutlined, EditOutlined } from "@ant-design/icons"
+import PageResponse from "../../core/pagination/PageResponse"
+import SecurityHeadersService from "./SecurityHeadersService"
+import AppShellContext from "../../core/components/shell/AppShellContext"
+import SecurityHeadersResponse from "./model/SecurityHeadersResponse"
+import DeleteSecurityHeadersAction from "./actions/DeleteSecurityHeadersAction"
+import AccessControl from "../../core/components/accesscontrol/AccessControl"
+import { UserAccessLevel } from "../user/model/UserAccessLevel"
+import { isAccessGranted } from "../../core/components/accesscontrol/IsAccessGranted"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+import { I18n, raw } from "../../core/i18n/I18n"
+import { themedColors } from "../../core/components/theme/ThemedResources"
+import { SECURITY_HEADERS_FRAME_OPTIONS_DATA } from "./SecurityHeadersOptions"
+
+export default class SecurityHeadersListPage extends React.PureComponent {
+    private readonly service: SecurityHeadersService
+    private readonly table: React.RefObject<DataTable<SecurityHeadersResponse> | null>
+
+    constructor(props: any) {
+        super(props)
+        this.service = new SecurityHeadersService()
+        this.table = React.createRef()
+    }
+
+    private renderFrameOptions(securityHeaders: SecurityHeadersResponse) {
+        const option = SECURITY_HEADERS_FRAME_OPTIONS_DATA.find(item => item.value === securityHeaders.frameOptions)
+        return option ? <I18n id={option.messageKey} /> : <I18n id={MessageKey.CommonDisabled} />
+    }
+
+    private renderContentSecurityPolicy(securityHeaders: SecurityHeadersResponse) {
+        const { enabled, reportOnly } = securityHeaders.contentSecurityPolicy
+        if (!enabled) return <I18n id={MessageKey.CommonDisabled} />
+        if (reportOnly) return <I18n id={MessageKey.FrontendSecurityheadersReportOnly} />
+        return <I18n id={MessageKey.FrontendSecurityheadersEnforced} />
+    }
+
+    private buildColumns(): DataTableColumn<SecurityHeadersResponse>[] {
+        return [
+            {
+                id: "name",
+                description: MessageKey.CommonName,
+                renderer: item => item.name,
+            },
+            {
+                id: "frameOptions",
+                description: MessageKey.FrontendSecurityheadersFrameOptions,
+                renderer: item => this.renderFrameOptions(item),
+                width: 250,
+            },
+            {
+                id: "contentSecurityPolicy",
+                description: MessageKey.FrontendSecurityheadersContentSecurityPolicy,
+                renderer: item => this.renderContentSecurityPolicy(item),
+                width: 250,
+            },
+            {
+                id: "actions",
+                description: raw(""),
+                renderer: item => (
+                    <>
+                        <Link to={`/security-headers/${item.id}`}>
+                            <EditOutlined className="action-icon" />
+                        </Link>
+
+                        <Link to="" onClick={() => this.deleteSecurityHeaders(item)}>
+                            <DeleteOutlined style={{ color: themedColors().DANGER }} className="action-icon" />
+                        </Link>
+                    </>
+                ),
+                width: 120,
+            },
+        ]
+    }
+
+    private async deleteSecurityHeaders(securityHeaders: SecurityHeadersResponse) {
+        return DeleteSecurityHeadersAction.execute(securityHeaders.id).then(() => this.table.current?.refresh())
+    }
+
+    private fetchData(
+        pageSize: number,
+        pageNumber: number,
+        searchTerms?: string,
+    ): Promise<PageResponse<SecurityHeadersResponse>> {
+        return this.service.list(pageSize, pageNumber, searchTerms)
+    }
+
+    componentDidMount() {
+        AppShellContext.get().updateConfig({
+            title: MessageKey.CommonSecurityHeaders,
+            subtitle: MessageKey.FrontendSecurityheadersListSubtitle,
+            actions: [
+                {
+                    description: MessageKey.FrontendSecurityheadersNewButton,
+                    onClick: "/security-headers/new",
+                    disabled: !isAccessGranted(UserAccessLevel.READ_WRITE, permissions => permissions.securityHeaders),
+                },
+            ],
+        })
+    }
+
+    render() {
+        return (
+            <AccessControl
+                requiredAccessLevel={UserAccessLevel.READ_ONLY}
+                permissionResolver={permissions => permissions.securityHeaders}
+            >
+                <DataTable
+                    id="security-headers"
+                    ref={this.table}
+                    columns={this.buildColumns()}
+                    dataProvider={(pageSize, pageNumber, searchTerms) =>
+                        this.fetchData(pageSize, pageNumber, searchTerms)
+                    }
+                    rowKey={item => item.id}
+                />
+            </AccessControl>
+        )
+    }
+}
@@ -0,0 +1,58 @@
+import { SecurityHeadersFrameOptions, SecurityHeadersReferrerPolicy } from "./model/SecurityHeadersRequest"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+
+export const SECURITY_HEADERS_FRAME_OPTIONS_DATA = [
+    { value: SecurityHeadersFrameOptions.DENY, messageKey: MessageKey.FrontendSecurityheadersFrameOptionsDeny },
+    {
+        value: SecurityHeadersFrameOptions.SAMEORIGIN,
+        messageKey: MessageKey.FrontendSecurityheadersFrameOptionsSameOrigin,
+    },
+]
+
+export const SECURITY_HEADERS_REFERRER_POLICY_DATA = [
+    { value: SecurityHeadersReferrerPolicy.NO_REFERRER, label: "no-referrer" },
+    { value: SecurityHeadersReferrerPolicy.NO_REFERRER_WHEN_DOWNGRADE, label: "no-referrer-when-downgrade" },
+    { value: SecurityHeadersReferrerPolicy.ORIGIN, label: "origin" },
+    { value: SecurityHeadersReferrerPolicy.ORIGIN_WHEN_CROSS_ORIGIN, label: "origin-when-cross-origin" },
+    { value: SecurityHeadersReferrerPolicy.SAME_ORIGIN, label: "same-origin" },
+    { value: SecurityHeadersReferrerPolicy.STRICT_ORIGIN, label: "strict-origin" },
+    { value: SecurityHeadersReferrerPolicy.STRICT_ORIGIN_WHEN_CROSS_ORIGIN, label: "strict-origin-when-cross-origin" },
+    { value: SecurityHeadersReferrerPolicy.UNSAFE_URL, label: "unsafe-url" },
+]
+
+export const SECURITY_HEADERS_DIRECTIVE_NAMES = [
+    "default-src",
+    "base-uri",
+    "child-src",
+    "connect-src",
+    "font-src",
+    "form-action",
+    "frame-ancestors",
+    "frame-src",
+    "img-src",
+    "manifest-src",
+    "media-src",
+    "object-src",
+    "require-trusted-types-for",
+    "sandbox",
+    "script-src",
+    "script-src-attr",
+    "script-src-elem",
+    "style-src",
+    "style-src-attr",
+    "style-src-elem",
+    "trusted-types",
+    "upgrade-insecure-requests",
+    "worker-src",
+]
+
+export const SECURITY_HEADERS_SOURCE_SUGGESTIONS = [
+    "'self'",
+    "'none'",
+    "'unsafe-inline'",
+    "'unsafe-eval'",
+    "'strict-dynamic'",
+    "https:",
+    "data:",
+    "blob:",
+]
@@ -0,0 +1,51 @@
+import SecurityHeadersGateway from "./SecurityHeadersGateway"
+import { requireNullablePayload, requireSuccessPayload, requireSuccessResponse } from "../../core/apiclient/ApiResponse"
+import PageResponse from "../../core/pagination/PageResponse"
+import SecurityHeadersRequest from "./model/SecurityHeadersRequest"
+import SecurityHeadersResponse from "./model/SecurityHeadersResponse"
+import SecurityHeadersReportResponse from "./model/SecurityHeadersReportResponse"
+import GenericCreateResponse from "../../core/common/GenericCreateResponse"
+
+export default class SecurityHeadersService {
+    private readonly gateway: SecurityHeadersGateway
+
+    constructor() {
+        this.gateway = new SecurityHeadersGateway()
+    }
+
+    async list(
+        pageSize?: number,
+        pageNumber?: number,
+        searchTerms?: string,
+    ): Promise<PageResponse<SecurityHeadersResponse>> {
+        return this.gateway.getPage(pageSize, pageNumber, searchTerms).then(requireSuccessPayload)
+    }
+
+    async delete(id: string): Promise<void> {
+        return this.gateway.deleteById(id).then(requireSuccessResponse)
+    }
+
+    async getById(id: string): Promise<SecurityHeadersResponse | undefined> {
+        return this.gateway.getById(id).then(requireNullablePayload)
+    }
+
+    async updateById(id: string, securityHeaders: SecurityHeadersRequest): Promise<void> {
+        return this.gateway.putById(id, securityHeaders).then(requireSuccessResponse)
+    }
+
+    async create(securityHeaders: SecurityHeadersRequest): Promise<GenericCreateResponse> {
+        return this.gateway.post(securityHeaders).then(requireSuccessPayload)
+    }
+
+    async listReports(
+        id: string,
+        pageSize?: number,
+        pageNumber?: number,
+    ): Promise<PageResponse<SecurityHeadersReportResponse>> {
+        return this.gateway.getReports(id, pageSize, pageNumber).then(requireSuccessPayload)
+    }
+
+    async deleteReports(id: string): Promise<void> {
+        return this.gateway.deleteReports(id).then(requireSuccessResponse)
+    }
+}
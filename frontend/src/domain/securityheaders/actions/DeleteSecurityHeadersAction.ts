@@ -0,0 +1,48 @@
+import SecurityHeadersService from "../SecurityHeadersService"
+import UserConfirmation from "../../../core/components/confirmation/UserConfirmation"
+import Notification from "../../../core/components/notification/Notification"
+import { UnexpectedResponseError } from "../../../core/apiclient/ApiResponse"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import { I18nMessage, raw } from "../../../core/i18n/I18n"
+
+class DeleteSecurityHeadersAction {
+    private readonly service: SecurityHeadersService
+
+    constructor() {
+        this.service = new SecurityHeadersService()
+    }
+
+    private handleError(error: Error) {
+        const title = {
+            id: MessageKey.CommonUnableToDelete,
+            params: { type: MessageKey.CommonSecurityHeaders },
+        }
+        let message: I18nMessage = MessageKey.CommonUnexpectedErrorTryAgain
+
+        if (error instanceof UnexpectedResponseError) {
+            const responseMessage = error.response?.body?.message
+            if (typeof responseMessage === "string") {
+                message = raw(responseMessage)
+            }
+        }
+
+        Notification.error(title, message)
+    }
+
+    async execute(securityHeadersId: string): Promise<void> {
+        return UserConfirmation.ask(MessageKey.FrontendSecurityheadersDeleteConfirmation)
+            .then(() => this.service.delete(securityHeadersId))
+            .then(() =>
+                Notification.success(
+                    {
+                        id: MessageKey.CommonTypeDeleted,
+                        params: { type: MessageKey.CommonSecurityHeaders },
+                    },
+                    MessageKey.CommonSuccessMessage,
+                ),
+            )
+            .catch(error => this.handleError(error))
+    }
+}
+
+export default new DeleteSecurityHeadersAction()
@@ -0,0 +1,12 @@
+export default interface SecurityHeadersReportResponse {
+    id: string
+    createdAt: string
+    documentUri?: string
+    blockedUri?: string
+    effectiveDirective?: string
+    originalPolicy?: string
+    disposition?: string
+    sourceFile?: string
+    lineNumber?: number
+    userAgent?: string
+}
@@ -0,0 +1,38 @@
+export enum SecurityHeadersFrameOptions {
+    DENY = "DENY",
+    SAMEORIGIN = "SAMEORIGIN",
+}
+
+export enum SecurityHeadersReferrerPolicy {
+    NO_REFERRER = "NO_REFERRER",
+    NO_REFERRER_WHEN_DOWNGRADE = "NO_REFERRER_WHEN_DOWNGRADE",
+    ORIGIN = "ORIGIN",
+    ORIGIN_WHEN_CROSS_ORIGIN = "ORIGIN_WHEN_CROSS_ORIGIN",
+    SAME_ORIGIN = "SAME_ORIGIN",
+    STRICT_ORIGIN = "STRICT_ORIGIN",
+    STRICT_ORIGIN_WHEN_CROSS_ORIGIN = "STRICT_ORIGIN_WHEN_CROSS_ORIGIN",
+    UNSAFE_URL = "UNSAFE_URL",
+}
+
+export interface SecurityHeadersDirective {
+    name: string
+    sources: string[]
+}
+
+export interface SecurityHeadersContentSecurityPolicy {
+    enabled: boolean
+    reportOnly: boolean
+    collectReports: boolean
+    reportUri?: string
+    reportTo?: string
+    directives: SecurityHeadersDirective[]
+}
+
+export default interface SecurityHeadersRequest {
+    name: string
+    frameOptions?: SecurityHeadersFrameOptions
+    contentTypeNoSniff: boolean
+    referrerPolicy?: SecurityHeadersReferrerPolicy
+    permissionsPolicy?: string
+    contentSecurityPolicy: SecurityHeadersContentSecurityPolicy
+}
@@ -0,0 +1,5 @@
+import SecurityHeadersRequest from "./SecurityHeadersRequest"
+
+export default interface SecurityHeadersResponse extends SecurityHeadersRequest {
+    id: string
+}
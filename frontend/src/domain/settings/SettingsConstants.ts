@@ -1 +1,28 @@
 export const INTEGER_MAX = 2147483647
+
+export const SYSLOG_FACILITIES = [
+    "kern",
+    "user",
+    "mail",
+    "daemon",
+    "auth",
+    "intern",
+    "lpr",
+    "news",
+    "uucp",
+    "clock",
+    "authpriv",
+    "ftp",
+    "ntp",
+    "audit",
+    "alert",
+    "cron",
+    "local0",
+    "local1",
+    "local2",
+    "local3",
+    "local4",
+    "local5",
+    "local6",
+    "local7",
+]
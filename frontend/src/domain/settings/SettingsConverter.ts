@@ -1,14 +1,25 @@
 import CertificateService from "../certificate/CertificateService"
-import SettingsDto from "./model/SettingsDto"
-import SettingsFormValues from "./model/SettingsFormValues"
+import TlsProfileService from "../tlsprofile/TlsProfileService"
+import HostService from "../host/HostService"
+import SettingsDto, {
+    LogDestinationDto,
+    LogDestinationProtocol,
+    LogDestinationType,
+    NginxSettingsDto,
+} from "./model/SettingsDto"
+import SettingsFormValues, { LogDestinationFormValues, NginxSettingsFormValues } from "./model/SettingsFormValues"
 import { HostBinding } from "../host/model/HostRequest"
 import { HostFormBinding } from "../host/model/HostFormValues"
 
 class SettingsConverter {
     private readonly certificateService: CertificateService
+    private readonly tlsProfileService: TlsProfileService
+    private readonly hostService: HostService
 
     constructor() {
         this.certificateService = new CertificateService()
+        this.tlsProfileService = new TlsProfileService()
+        this.hostService = new HostService()
     }
 
     private notNull(value?: any) {
@@ -19,10 +30,14 @@ class SettingsConverter {
         const certificate = this.notNull(binding.certificateId)
             ? await this.certificateService.getById(binding.certificateId!!)
             : undefined
+        const tlsProfile = this.notNull(binding.tlsProfileId)
+            ? await this.tlsProfileService.getById(binding.tlsProfileId!!)
+            : undefined
 
         return {
             ...binding,
             certificate,
+            tlsProfile,
         }
     }
 
@@ -30,36 +45,92 @@ class SettingsConverter {
         const output = {
             ...binding,
             certificateId: binding.certificate?.id,
+            tlsProfileId: binding.tlsProfile?.id,
         }
 
         delete output.certificate
+        delete output.tlsProfile
+        return output
+    }
+
+    private async logDestinationToFormValues(destination: LogDestinationDto): Promise<LogDestinationFormValues> {
+        const host = this.notNull(destination.hostId) ? await this.hostService.getById(destination.hostId!!) : undefined
+        const output = {
+            ...destination,
+            host,
+        }
+
+        delete output.hostId
+        return output
+    }
+
+    private formValuesToLogDestination(destination: LogDestinationFormValues): LogDestinationDto {
+        let { protocol } = destination
+        if (destination.type === LogDestinationType.OTLP) protocol = LogDestinationProtocol.HTTP
+        else if (protocol === LogDestinationProtocol.HTTP) protocol = LogDestinationProtocol.UDP
+
+        const output = {
+            ...destination,
+            protocol,
+            hostId: destination.host?.id,
+        }
+
+        delete output.host
         return output
     }
 
+    private async nginxToFormValues(nginx: NginxSettingsDto): Promise<NginxSettingsFormValues> {
+        const destinations = await Promise.all(
+            (nginx.logs.destinations ?? []).map(destination => this.logDestinationToFormValues(destination)),
+        )
+
+        return {
+            ...nginx,
+            logs: {
+                ...nginx.logs,
+                destinations,
+            },
+        }
+    }
+
+    private formValuesToNginx(nginx: NginxSettingsFormValues): NginxSettingsDto {
+        return {
+            ...nginx,
+            logs: {
+                ...nginx.logs,
+                destinations: nginx.logs.destinations.map(destination => this.formValuesToLogDestination(destination)),
+            },
+        }
+    }
+
     async settingsToFormValues(settings: SettingsDto): Promise<SettingsFormValues> {
-        const { nginx, certificateAutoRenew, logRotation } = settings
+        const { certificateAutoRenew, logRotation, backup } = settings
 
         const globalBindings = await Promise.all(
             settings.globalBindings.map(binding => this.bindingToFormValues(binding)),
         )
+        const nginx = await this.nginxToFormValues(settings.nginx)
 
         return {
             nginx,
             certificateAutoRenew,
             logRotation,
+            backup,
             globalBindings,
         }
     }
 
     formValuesToSettings(formValues: SettingsFormValues): SettingsDto {
-        const { nginx, certificateAutoRenew, logRotation } = formValues
+        const { certificateAutoRenew, logRotation, backup } = formValues
 
         const globalBindings = formValues.globalBindings.map(binding => this.formValuesToBinding(binding))
+        const nginx = this.formValuesToNginx(formValues.nginx)
 
         return {
             nginx,
             certificateAutoRenew,
             logRotation,
+            backup,
             globalBindings,
         }
     }
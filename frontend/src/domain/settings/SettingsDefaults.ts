@@ -1,5 +1,23 @@
-import SettingsFormValues from "./model/SettingsFormValues"
-import { LogLevel, TimeUnit } from "./model/SettingsDto"
+import SettingsFormValues, { LogDestinationFormValues } from "./model/SettingsFormValues"
+import {
+    AccessLogFormat,
+    BackupDestination,
+    LogDestinationProtocol,
+    LogDestinationType,
+    LogLevel,
+    TimeUnit,
+} from "./model/SettingsDto"
+
+export function logDestinationDefaults(): LogDestinationFormValues {
+    return {
+        type: LogDestinationType.SYSLOG,
+        protocol: LogDestinationProtocol.UDP,
+        address: "",
+        facility: "local7",
+        accessLogs: true,
+        errorLogs: true,
+    }
+}
 
 export function settingsDefaults(): SettingsFormValues {
     return {
@@ -8,8 +26,10 @@ export function settingsDefaults(): SettingsFormValues {
                 serverLogsEnabled: true,
                 serverLogsLevel: LogLevel.ERROR,
                 accessLogsEnabled: true,
+                accessLogsFormat: AccessLogFormat.COMBINED,
                 errorLogsEnabled: true,
                 errorLogsLevel: LogLevel.ERROR,
+                destinations: [],
             },
             timeouts: {
                 connect: 5,
@@ -58,6 +78,14 @@ export function settingsDefaults(): SettingsFormValues {
             intervalUnit: TimeUnit.HOURS,
             intervalUnitCount: 1,
         },
+        backup: {
+            enabled: false,
+            intervalUnit: TimeUnit.DAYS,
+            intervalUnitCount: 1,
+            maximumCount: 7,
+            maximumAgeDays: 0,
+            destination: BackupDestination.LOCAL,
+        },
         globalBindings: [],
     }
 }
@@ -49,8 +49,8 @@ export default class SettingsPage extends React.Component<any, SettingsPageState
     }
 
     private resetToDefaultValues() {
-        const { nginx, logRotation, certificateAutoRenew } = settingsDefaults()
-        const newValues = { nginx, logRotation, certificateAutoRenew }
+        const { nginx, logRotation, certificateAutoRenew, backup } = settingsDefaults()
+        const newValues = { nginx, logRotation, certificateAutoRenew, backup }
 
         this.formRef.current?.setFieldsValue(newValues)
         this.setState(current => ({
@@ -114,7 +114,7 @@ export default class SettingsPage extends React.Component<any, SettingsPageState
                 key: "nginx-ignition-settings",
                 label: <I18n id={MessageKey.CommonAppName} />,
                 forceRender: true,
-                children: <NginxIgnitionSettingsTab validationResult={validationResult} />,
+                children: <NginxIgnitionSettingsTab formValues={formValues!!} validationResult={validationResult} />,
             },
         ]
     }
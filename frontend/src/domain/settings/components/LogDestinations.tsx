@@ -0,0 +1,222 @@
+import React from "react"
+import { Button, Flex, Form, FormListFieldData, FormListOperation, Input, Select, Switch } from "antd"
+import { DeleteOutlined, PlusOutlined } from "@ant-design/icons"
+import ValidationResult from "../../../core/validation/ValidationResult"
+import { LogDestinationFormValues } from "../model/SettingsFormValues"
+import { LogDestinationProtocol, LogDestinationType } from "../model/SettingsDto"
+import { logDestinationDefaults } from "../SettingsDefaults"
+import { SYSLOG_FACILITIES } from "../SettingsConstants"
+import PaginatedSelect from "../../../core/components/select/PaginatedSelect"
+import FormLayout from "../../../core/components/form/FormLayout"
+import If from "../../../core/components/flowcontrol/If"
+import TagGroup from "../../../core/components/taggroup/TagGroup"
+import HostResponse from "../../host/model/HostResponse"
+import HostService from "../../host/HostService"
+import { I18n } from "../../../core/i18n/I18n"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import "./LogDestinations.css"
+
+const TYPE_OPTIONS_DATA = [
+    { value: LogDestinationType.SYSLOG, messageKey: MessageKey.FrontendSettingsTabsNginxLogDestinationSyslog },
+    { value: LogDestinationType.OTLP, messageKey: MessageKey.FrontendSettingsTabsNginxLogDestinationOtlp },
+]
+
+const SYSLOG_PROTOCOL_OPTIONS_DATA = [
+    { value: LogDestinationProtocol.UDP, messageKey: MessageKey.FrontendStreamComponentsAddressinputProtocolUdp },
+    { value: LogDestinationProtocol.TCP, messageKey: MessageKey.CommonProtocolTcp },
+    { value: LogDestinationProtocol.UNIX, messageKey: MessageKey.FrontendStreamComponentsAddressinputProtocolSocket },
+]
+
+export interface LogDestinationsProps {
+    destinations: LogDestinationFormValues[]
+    validationResult: ValidationResult
+}
+
+export default class LogDestinations extends React.Component<LogDestinationsProps> {
+    private readonly hostService: HostService
+
+    constructor(props: LogDestinationsProps) {
+        super(props)
+        this.hostService = new HostService()
+    }
+
+    private renderHostDescription(host: HostResponse) {
+        if (host.defaultServer)
+            return (
+                <span style={{ fontStyle: "italic", color: "grey" }}>
+                    <I18n id={MessageKey.CommonDefaultServerLabel} />
+                </span>
+            )
+
+        return <TagGroup values={host.domainNames ?? []} maximumSize={1} />
+    }
+
+    private renderSyslogFields(name: number, index: number) {
+        const { validationResult } = this.props
+        return (
+            <>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="settings-log-destination-protocol"
+                    layout="vertical"
+                    name={[name, "protocol"]}
+                    validateStatus={validationResult.getStatus(`nginx.logs.destinations[${index}].protocol`)}
+                    help={validationResult.getMessage(`nginx.logs.destinations[${index}].protocol`)}
+                    label={<I18n id={MessageKey.FrontendHostComponentsHostbindingsProtocol} />}
+                    required
+                >
+                    <Select
+                        options={SYSLOG_PROTOCOL_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                    />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="settings-log-destination-facility"
+                    layout="vertical"
+                    name={[name, "facility"]}
+                    validateStatus={validationResult.getStatus(`nginx.logs.destinations[${index}].facility`)}
+                    help={validationResult.getMessage(`nginx.logs.destinations[${index}].facility`)}
+                    label={<I18n id={MessageKey.FrontendSettingsTabsNginxLogDestinationFacility} />}
+                    required
+                >
+                    <Select options={SYSLOG_FACILITIES.map(facility => ({ value: facility, label: facility }))} />
+                </Form.Item>
+            </>
+        )
+    }
+
+    private renderDestination(field: FormListFieldData, operations: FormListOperation, index: number) {
+        const { validationResult, destinations } = this.props
+        const { name } = field
+        const syslog = destinations[index]?.type !== LogDestinationType.OTLP
+
+        return (
+            <Flex className="settings-log-destination-container" key={field.key}>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="settings-log-destination-host"
+                    layout="vertical"
+                    name={[name, "host"]}
+                    validateStatus={validationResult.getStatus(`nginx.logs.destinations[${index}].hostId`)}
+                    help={validationResult.getMessage(`nginx.logs.destinations[${index}].hostId`)}
+                    label={<I18n id={MessageKey.CommonHost} />}
+                >
+                    <PaginatedSelect<HostResponse>
+                        placeholder={MessageKey.FrontendSettingsTabsNginxLogDestinationAllHosts}
+                        itemDescription={item => this.renderHostDescription(item)}
+                        itemKey={item => item?.id}
+                        pageProvider={(pageSize, pageNumber, searchTerms) =>
+                            this.hostService.list(pageSize, pageNumber, searchTerms)
+                        }
+                        allowEmpty
+                    />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="settings-log-destination-type"
+                    layout="vertical"
+                    name={[name, "type"]}
+                    validateStatus={validationResult.getStatus(`nginx.logs.destinations[${index}].type`)}
+                    help={validationResult.getMessage(`nginx.logs.destinations[${index}].type`)}
+                    label={<I18n id={MessageKey.CommonType} />}
+                    required
+                >
+                    <Select
+                        options={TYPE_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                    />
+                </Form.Item>
+                <If condition={syslog}>{this.renderSyslogFields(name, index)}</If>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="settings-log-destination-address"
+                    layout="vertical"
+                    name={[name, "address"]}
+                    validateStatus={validationResult.getStatus(`nginx.logs.destinations[${index}].address`)}
+                    help={
+                        validationResult.getMessage(`nginx.logs.destinations[${index}].address`) ?? (
+                            <I18n id={MessageKey.FrontendSettingsTabsNginxLogDestinationAddressHelp} />
+                        )
+                    }
+                    label={<I18n id={MessageKey.FrontendStreamComponentsAddressinputAddressPlaceholder} />}
+                    required
+                >
+                    <Input maxLength={512} />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="settings-log-destination-tag"
+                    layout="vertical"
+                    name={[name, "tag"]}
+                    validateStatus={validationResult.getStatus(`nginx.logs.destinations[${index}].tag`)}
+                    help={validationResult.getMessage(`nginx.logs.destinations[${index}].tag`)}
+                    label={<I18n id={MessageKey.FrontendSettingsTabsNginxLogDestinationTag} />}
+                >
+                    <Input maxLength={32} />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="settings-log-destination-switch"
+                    layout="vertical"
+                    name={[name, "accessLogs"]}
+                    valuePropName="checked"
+                    validateStatus={validationResult.getStatus(`nginx.logs.destinations[${index}].accessLogs`)}
+                    help={validationResult.getMessage(`nginx.logs.destinations[${index}].accessLogs`)}
+                    label={<I18n id={MessageKey.FrontendLogsAccessLogs} />}
+                    required
+                >
+                    <Switch />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    className="settings-log-destination-switch"
+                    layout="vertical"
+                    name={[name, "errorLogs"]}
+                    valuePropName="checked"
+                    validateStatus={validationResult.getStatus(`nginx.logs.destinations[${index}].errorLogs`)}
+                    help={validationResult.getMessage(`nginx.logs.destinations[${index}].errorLogs`)}
+                    label={<I18n id={MessageKey.FrontendLogsErrorLogs} />}
+                    required
+                >
+                    <Switch />
+                </Form.Item>
+
+                <DeleteOutlined
+                    style={{
+                        marginLeft: 15,
+                        alignItems: "start",
+                        marginTop: 37,
+                    }}
+                    onClick={() => operations.remove(field.name)}
+                />
+            </Flex>
+        )
+    }
+
+    private renderDestinations(fields: FormListFieldData[], operations: FormListOperation) {
+        const destinations = fields.map((field, index) => this.renderDestination(field, operations, index))
+
+        const addAction = (
+            <Form.Item key="add">
+                <Button type="dashed" onClick={() => operations.add(logDestinationDefaults())} icon={<PlusOutlined />}>
+                    <I18n id={MessageKey.FrontendSettingsTabsNginxLogDestinationAdd} />
+                </Button>
+            </Form.Item>
+        )
+
+        return [...destinations, addAction]
+    }
+
+    render() {
+        return (
+            <Form.List name={["nginx", "logs", "destinations"]}>
+                {(fields, operations) => this.renderDestinations(fields, operations)}
+            </Form.List>
+        )
+    }
+}
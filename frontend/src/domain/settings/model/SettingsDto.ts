@@ -14,6 +14,28 @@ export enum LogLevel {
     EMERG = "EMERG",
 }
 
+export enum AccessLogFormat {
+    COMBINED = "COMBINED",
+    JSON = "JSON",
+}
+
+export enum LogDestinationType {
+    SYSLOG = "SYSLOG",
+    OTLP = "OTLP",
+}
+
+export enum LogDestinationProtocol {
+    UDP = "UDP",
+    TCP = "TCP",
+    UNIX = "UNIX",
+    HTTP = "HTTP",
+}
+
+export enum BackupDestination {
+    LOCAL = "LOCAL",
+    S3 = "S3",
+}
+
 export interface CertificateAutoRenewSettingsDto {
     enabled: boolean
     intervalUnit: TimeUnit
@@ -27,6 +49,26 @@ export interface LogRotationSettingsDto {
     intervalUnitCount: number
 }
 
+export interface BackupS3SettingsDto {
+    endpoint: string
+    region: string
+    bucket: string
+    prefix?: string
+    accessKey: string
+    secretKey?: string
+}
+
+export interface BackupSettingsDto {
+    enabled: boolean
+    intervalUnit: TimeUnit
+    intervalUnitCount: number
+    maximumCount: number
+    maximumAgeDays: number
+    destination: BackupDestination
+    localPath?: string
+    s3?: BackupS3SettingsDto
+}
+
 export interface NginxTimeoutsSettingsDto {
     read: number
     connect: number
@@ -35,12 +77,25 @@ export interface NginxTimeoutsSettingsDto {
     clientBody: number
 }
 
+export interface LogDestinationDto {
+    hostId?: string
+    type: LogDestinationType
+    protocol: LogDestinationProtocol
+    address: string
+    facility: string
+    tag?: string
+    accessLogs: boolean
+    errorLogs: boolean
+}
+
 export interface NginxLogsSettingsDto {
     serverLogsEnabled: boolean
     serverLogsLevel: LogLevel
     accessLogsEnabled: boolean
+    accessLogsFormat: AccessLogFormat
     errorLogsEnabled: boolean
     errorLogsLevel: LogLevel
+    destinations: LogDestinationDto[]
 }
 
 export interface NginxBufferSizeDto {
@@ -84,5 +139,6 @@ export default interface SettingsDto {
     nginx: NginxSettingsDto
     logRotation: LogRotationSettingsDto
     certificateAutoRenew: CertificateAutoRenewSettingsDto
+    backup: BackupSettingsDto
     globalBindings: HostBinding[]
 }
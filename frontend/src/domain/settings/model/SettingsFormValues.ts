@@ -1,9 +1,30 @@
-import { CertificateAutoRenewSettingsDto, LogRotationSettingsDto, NginxSettingsDto } from "./SettingsDto"
+import {
+    BackupSettingsDto,
+    CertificateAutoRenewSettingsDto,
+    LogDestinationDto,
+    LogRotationSettingsDto,
+    NginxLogsSettingsDto,
+    NginxSettingsDto,
+} from "./SettingsDto"
 import { HostFormBinding } from "../../host/model/HostFormValues"
+import HostResponse from "../../host/model/HostResponse"
+
+export interface LogDestinationFormValues extends Omit<LogDestinationDto, "hostId"> {
+    host?: HostResponse
+}
+
+export interface NginxLogsSettingsFormValues extends Omit<NginxLogsSettingsDto, "destinations"> {
+    destinations: LogDestinationFormValues[]
+}
+
+export interface NginxSettingsFormValues extends Omit<NginxSettingsDto, "logs"> {
+    logs: NginxLogsSettingsFormValues
+}
 
 export default interface SettingsFormValues {
-    nginx: NginxSettingsDto
+    nginx: NginxSettingsFormValues
     logRotation: LogRotationSettingsDto
     certificateAutoRenew: CertificateAutoRenewSettingsDto
+    backup: BackupSettingsDto
     globalBindings: HostFormBinding[]
 }
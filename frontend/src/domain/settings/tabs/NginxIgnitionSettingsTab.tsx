@@ -1,10 +1,13 @@
 import React from "react"
-import { Flex, Form, InputNumber, Select, Space, Switch } from "antd"
+import { Flex, Form, Input, InputNumber, Select, Space, Switch } from "antd"
 import ValidationResult from "../../../core/validation/ValidationResult"
 import { INTEGER_MAX } from "../SettingsConstants"
-import { TimeUnit } from "../model/SettingsDto"
+import { BackupDestination, TimeUnit } from "../model/SettingsDto"
 import { I18n } from "../../../core/i18n/I18n"
 import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import SettingsFormValues from "../model/SettingsFormValues"
+import BackupService from "../../backup/BackupService"
+import BackupRunResponse from "../../backup/model/BackupRunResponse"
 
 const IGNITION_TIME_UNIT_OPTIONS_DATA = [
     { value: TimeUnit.DAYS, messageKey: MessageKey.FrontendSettingsTabsIgnitionTimeUnitDays },
@@ -12,11 +15,42 @@ const IGNITION_TIME_UNIT_OPTIONS_DATA = [
     { value: TimeUnit.MINUTES, messageKey: MessageKey.FrontendSettingsTabsIgnitionTimeUnitMinutes },
 ]
 
+const BACKUP_DESTINATION_OPTIONS_DATA = [
+    { value: BackupDestination.LOCAL, messageKey: MessageKey.FrontendSettingsTabsIgnitionBackupDestinationLocal },
+    { value: BackupDestination.S3, messageKey: MessageKey.FrontendSettingsTabsIgnitionBackupDestinationS3 },
+]
+
 export interface NginxIgnitionSettingsTabProps {
+    formValues: SettingsFormValues
     validationResult: ValidationResult
 }
 
-export default class NginxIgnitionSettingsTab extends React.Component<NginxIgnitionSettingsTabProps> {
+interface NginxIgnitionSettingsTabState {
+    latestBackup?: BackupRunResponse
+    latestBackupLoaded: boolean
+}
+
+export default class NginxIgnitionSettingsTab extends React.Component<
+    NginxIgnitionSettingsTabProps,
+    NginxIgnitionSettingsTabState
+> {
+    private readonly backupService: BackupService
+
+    constructor(props: NginxIgnitionSettingsTabProps) {
+        super(props)
+        this.backupService = new BackupService()
+        this.state = {
+            latestBackupLoaded: false,
+        }
+    }
+
+    componentDidMount() {
+        this.backupService
+            .latestSuccessfulRun()
+            .then(latestBackup => this.setState({ latestBackup, latestBackupLoaded: true }))
+            .catch(() => this.setState({ latestBackup: undefined, latestBackupLoaded: false }))
+    }
+
     private renderExecutionIntervalFieldset(pathPrefix: string) {
         const { validationResult } = this.props
         return (
@@ -48,6 +82,169 @@ export default class NginxIgnitionSettingsTab extends React.Component<NginxIgnit
         )
     }
 
+    private renderTextField(path: string[], labelKey: MessageKey, required: boolean) {
+        const { validationResult } = this.props
+        const fieldPath = path.join(".")
+        return (
+            <Form.Item
+                name={path}
+                validateStatus={validationResult.getStatus(fieldPath)}
+                help={validationResult.getMessage(fieldPath)}
+                label={<I18n id={labelKey} />}
+                required={required}
+            >
+                <Input maxLength={256} />
+            </Form.Item>
+        )
+    }
+
+    private renderLatestBackup() {
+        const { latestBackup, latestBackupLoaded } = this.state
+        if (!latestBackupLoaded) return undefined
+
+        return (
+            <p className="settings-form-section-help-text">
+                {latestBackup === undefined ? (
+                    <I18n id={MessageKey.FrontendSettingsTabsIgnitionBackupNoSuccess} />
+                ) : (
+                    <I18n
+                        id={MessageKey.FrontendSettingsTabsIgnitionBackupLastSuccess}
+                        params={{ date: new Date(latestBackup.finishedAt).toLocaleString() }}
+                    />
+                )}
+            </p>
+        )
+    }
+
+    private renderBackupDestination() {
+        const { formValues, validationResult } = this.props
+        const { destination } = formValues.backup
+
+        if (destination === BackupDestination.LOCAL)
+            return this.renderTextField(
+                ["backup", "localPath"],
+                MessageKey.FrontendSettingsTabsIgnitionBackupLocalPath,
+                true,
+            )
+
+        return (
+            <>
+                {this.renderTextField(
+                    ["backup", "s3", "endpoint"],
+                    MessageKey.FrontendSettingsTabsIgnitionBackupS3Endpoint,
+                    true,
+                )}
+                {this.renderTextField(
+                    ["backup", "s3", "region"],
+                    MessageKey.FrontendSettingsTabsIgnitionBackupS3Region,
+                    true,
+                )}
+                {this.renderTextField(
+                    ["backup", "s3", "bucket"],
+                    MessageKey.FrontendSettingsTabsIgnitionBackupS3Bucket,
+                    true,
+                )}
+                {this.renderTextField(
+                    ["backup", "s3", "prefix"],
+                    MessageKey.FrontendSettingsTabsIgnitionBackupS3Prefix,
+                    false,
+                )}
+                {this.renderTextField(
+                    ["backup", "s3", "accessKey"],
+                    MessageKey.FrontendSettingsTabsIgnitionBackupS3AccessKey,
+                    true,
+                )}
+                <Form.Item
+                    name={["backup", "s3", "secretKey"]}
+                    validateStatus={validationResult.getStatus("backup.s3.secretKey")}
+                    help={
+                        validationResult.getMessage("backup.s3.secretKey") ?? (
+                            <I18n id={MessageKey.FrontendSettingsTabsIgnitionBackupS3SecretKeyHelp} />
+                        )
+                    }
+                    label={<I18n id={MessageKey.FrontendSettingsTabsIgnitionBackupS3SecretKey} />}
+                >
+                    <Input.Password autoComplete="new-password" />
+                </Form.Item>
+            </>
+        )
+    }
+
+    private renderBackup() {
+        const { validationResult } = this.props
+        return (
+            <>
+                <h3 className="settings-form-subsection-name">
+                    <I18n id={MessageKey.FrontendSettingsTabsIgnitionBackup} />
+                </h3>
+                {this.renderLatestBackup()}
+                <Flex className="settings-form-inner-flex-container">
+                    <Flex className="settings-form-inner-flex-container-column settings-form-expanded-label-size">
+                        <Form.Item
+                            name={["backup", "enabled"]}
+                            validateStatus={validationResult.getStatus("backup.enabled")}
+                            help={validationResult.getMessage("backup.enabled")}
+                            label={<I18n id={MessageKey.FrontendSettingsTabsIgnitionBackupEnabled} />}
+                            required
+                        >
+                            <Switch />
+                        </Form.Item>
+                        {this.renderExecutionIntervalFieldset("backup")}
+                        <Form.Item
+                            name={["backup", "maximumCount"]}
+                            validateStatus={validationResult.getStatus("backup.maximumCount")}
+                            help={
+                                validationResult.getMessage("backup.maximumCount") ?? (
+                                    <I18n id={MessageKey.FrontendSettingsTabsIgnitionBackupRetentionHelp} />
+                                )
+                            }
+                            label={<I18n id={MessageKey.FrontendSettingsTabsIgnitionBackupMaximumCount} />}
+                            required
+                        >
+                            <InputNumber min={0} max={99_999} className="settings-form-input-wide" />
+                        </Form.Item>
+                        <Form.Item
+                            label={<I18n id={MessageKey.FrontendSettingsTabsIgnitionBackupMaximumAge} />}
+                            help={<I18n id={MessageKey.FrontendSettingsTabsIgnitionBackupRetentionHelp} />}
+                            required
+                        >
+                            <Space.Compact className="settings-form-input-wide">
+                                <Form.Item
+                                    name={["backup", "maximumAgeDays"]}
+                                    validateStatus={validationResult.getStatus("backup.maximumAgeDays")}
+                                    help={validationResult.getMessage("backup.maximumAgeDays")}
+                                    noStyle
+                                >
+                                    <InputNumber min={0} max={99_999} className="settings-form-input-wide" />
+                                </Form.Item>
+                                <Space.Addon>
+                                    <I18n id={MessageKey.FrontendSettingsTabsIgnitionTimeUnitDays} />
+                                </Space.Addon>
+                            </Space.Compact>
+                        </Form.Item>
+                    </Flex>
+                    <Flex className="settings-form-inner-flex-container-column settings-form-expanded-label-size">
+                        <Form.Item
+                            name={["backup", "destination"]}
+                            validateStatus={validationResult.getStatus("backup.destination")}
+                            help={validationResult.getMessage("backup.destination")}
+                            label={<I18n id={MessageKey.FrontendSettingsTabsIgnitionBackupDestination} />}
+                            required
+                        >
+                            <Select
+                                options={BACKUP_DESTINATION_OPTIONS_DATA.map(item => ({
+                                    value: item.value,
+                                    label: <I18n id={item.messageKey} />,
+                                }))}
+                            />
+                        </Form.Item>
+                        {this.renderBackupDestination()}
+                    </Flex>
+                </Flex>
+            </>
+        )
+    }
+
     render() {
         const { validationResult } = this.props
         return (
@@ -100,6 +297,7 @@ export default class NginxIgnitionSettingsTab extends React.Component<NginxIgnit
                         {this.renderExecutionIntervalFieldset("certificateAutoRenew")}
                     </Flex>
                 </Flex>
+                {this.renderBackup()}
             </>
         )
     }
@@ -3,11 +3,12 @@ import { Flex, Form, Input, InputNumber, Select, Space, Switch } from "antd"
 import HostBindings from "../../host/components/HostBindings"
 import ValidationResult from "../../../core/validation/ValidationResult"
 import SettingsFormValues from "../model/SettingsFormValues"
-import { LogLevel } from "../model/SettingsDto"
+import { AccessLogFormat, LogLevel } from "../model/SettingsDto"
 import { INTEGER_MAX } from "../SettingsConstants"
 import { I18n } from "../../../core/i18n/I18n"
 import MessageKey from "../../../core/i18n/model/MessageKey.generated"
 import { QuestionCircleFilled } from "@ant-design/icons"
+import LogDestinations from "../components/LogDestinations"
 
 const NGINX_LOG_LEVEL_OPTIONS_DATA = [
     { value: LogLevel.WARN, messageKey: MessageKey.FrontendSettingsTabsNginxLogLevelWarn },
@@ -17,6 +18,11 @@ const NGINX_LOG_LEVEL_OPTIONS_DATA = [
     { value: LogLevel.EMERG, messageKey: MessageKey.FrontendSettingsTabsNginxLogLevelEmerg },
 ]
 
+const ACCESS_LOG_FORMAT_OPTIONS_DATA = [
+    { value: AccessLogFormat.COMBINED, messageKey: MessageKey.FrontendSettingsTabsNginxAccessLogsFormatCombined },
+    { value: AccessLogFormat.JSON, messageKey: MessageKey.FrontendSettingsTabsNginxAccessLogsFormatJson },
+]
+
 export interface NginxSettingsTabProps {
     formValues: SettingsFormValues
     validationResult: ValidationResult
@@ -146,6 +152,24 @@ export default class NginxSettingsTab extends React.Component<NginxSettingsTabPr
                 >
                     <Switch />
                 </Form.Item>
+                <Form.Item
+                    name={["nginx", "logs", "accessLogsFormat"]}
+                    validateStatus={validationResult.getStatus("nginx.logs.accessLogsFormat")}
+                    help={validationResult.getMessage("nginx.logs.accessLogsFormat")}
+                    label={<I18n id={MessageKey.FrontendSettingsTabsNginxAccessLogsFormat} />}
+                    tooltip={{
+                        title: <I18n id={MessageKey.FrontendSettingsTabsNginxAccessLogsFormatHelp} />,
+                        icon: <QuestionCircleFilled />,
+                    }}
+                    required
+                >
+                    <Select
+                        options={ACCESS_LOG_FORMAT_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                    />
+                </Form.Item>
                 {this.renderErrorLogFieldset("errorLogsEnabled", "errorLogsLevel")}
                 <h3 className="settings-form-subsection-name">
                     <I18n id={MessageKey.FrontendSettingsTabsNginxLogsServer} />
@@ -350,6 +374,25 @@ export default class NginxSettingsTab extends React.Component<NginxSettingsTabPr
         )
     }
 
+    private renderLogDestinationsFormPortion() {
+        const { validationResult, formValues } = this.props
+
+        return (
+            <>
+                <h2 className="settings-form-section-name">
+                    <I18n id={MessageKey.FrontendSettingsTabsNginxLogDestinations} />
+                </h2>
+                <p className="settings-form-section-help-text">
+                    <I18n id={MessageKey.FrontendSettingsTabsNginxLogDestinationsHelp} />
+                </p>
+                <LogDestinations
+                    destinations={formValues.nginx.logs.destinations}
+                    validationResult={validationResult}
+                />
+            </>
+        )
+    }
+
     private renderBindingsFormPortion() {
         const { validationResult, formValues } = this.props
 
@@ -378,6 +421,7 @@ export default class NginxSettingsTab extends React.Component<NginxSettingsTabPr
                     {this.renderLogsColumn()}
                     {this.renderStatsColumn()}
                 </Flex>
+                {this.renderLogDestinationsFormPortion()}
                 {this.renderBindingsFormPortion()}
             </>
         )
@@ -0,0 +1,14 @@
+import ApiClient from "../../core/apiclient/ApiClient"
+import ApiResponse from "../../core/apiclient/ApiResponse"
+
+export default class StateGateway {
+    private readonly client: ApiClient
+
+    constructor() {
+        this.client = new ApiClient("/api/state")
+    }
+
+    async export(): Promise<ApiResponse<any>> {
+        return this.client.get("/export", undefined, { format: "yaml" }, true)
+    }
+}
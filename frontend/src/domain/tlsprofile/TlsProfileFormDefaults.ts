@@ -0,0 +1,28 @@
+import TlsProfileRequest, { ClientVerificationMode, TlsProfilePreset, TlsProtocol } from "./model/TlsProfileRequest"
+
+export function tlsProfileFormDefaults(): TlsProfileRequest {
+    return {
+        name: "",
+        preset: TlsProfilePreset.INTERMEDIATE,
+        protocols: [TlsProtocol.TLS_V1_2, TlsProtocol.TLS_V1_3],
+        preferServerCiphers: false,
+        ocspStapling: false,
+        hsts: {
+            enabled: false,
+            maxAgeSeconds: 31536000,
+            includeSubdomains: false,
+            preload: false,
+        },
+        session: {
+            cacheEnabled: true,
+            cacheSizeMb: 10,
+            timeoutMinutes: 60,
+            ticketsEnabled: false,
+        },
+        clientVerification: {
+            enabled: false,
+            mode: ClientVerificationMode.REQUIRED,
+            depth: 1,
+        },
+    }
+}
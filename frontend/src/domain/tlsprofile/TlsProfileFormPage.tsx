@@ -0,0 +1,472 @@
+import React from "react"
+import { navigateTo, routeParams } from "../../core/components/router/AppRouter"
+import TlsProfileService from "./TlsProfileService"
+import { Flex, Form, FormInstance, Input, InputNumber, Select, Space, Switch } from "antd"
+import TextArea from "antd/es/input/TextArea"
+import Preloader from "../../core/components/preloader/Preloader"
+import FormLayout from "../../core/components/form/FormLayout"
+import ValidationResult from "../../core/validation/ValidationResult"
+import ModalPreloader from "../../core/components/preloader/ModalPreloader"
+import Notification from "../../core/components/notification/Notification"
+import { UnexpectedResponseError } from "../../core/apiclient/ApiResponse"
+import ValidationResultConverter from "../../core/validation/ValidationResultConverter"
+import AppShellContext, { ShellAction } from "../../core/components/shell/AppShellContext"
+import CommonNotifications from "../../core/components/notification/CommonNotifications"
+import EmptyStates from "../../core/components/emptystate/EmptyStates"
+import DeleteTlsProfileAction from "./actions/DeleteTlsProfileAction"
+import ReloadNginxAction from "../nginx/actions/ReloadNginxAction"
+import { UserAccessLevel } from "../user/model/UserAccessLevel"
+import AccessControl from "../../core/components/accesscontrol/AccessControl"
+import { isAccessGranted } from "../../core/components/accesscontrol/IsAccessGranted"
+import { tlsProfileFormDefaults } from "./TlsProfileFormDefaults"
+import TlsProfileRequest, { TlsProfilePreset } from "./model/TlsProfileRequest"
+import {
+    CLIENT_VERIFICATION_MODE_OPTIONS_DATA,
+    TLS_PROFILE_PRESET_OPTIONS_DATA,
+    TLS_PROTOCOL_OPTIONS,
+} from "./TlsProfileOptions"
+import If from "../../core/components/flowcontrol/If"
+import "./TlsProfileFormPage.css"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+import { I18n } from "../../core/i18n/I18n"
+
+interface TlsProfileFormState {
+    formValues: TlsProfileRequest
+    validationResult: ValidationResult
+    loading: boolean
+    notFound: boolean
+    error?: Error
+}
+
+export default class TlsProfileFormPage extends React.Component<unknown, TlsProfileFormState> {
+    private readonly service: TlsProfileService
+    private readonly saveModal: ModalPreloader
+    private readonly formRef: React.RefObject<FormInstance | null>
+    private profileId?: string
+
+    constructor(props: any) {
+        super(props)
+        const profileId = routeParams().id
+        this.formRef = React.createRef()
+        this.profileId = profileId === "new" ? undefined : profileId
+        this.service = new TlsProfileService()
+        this.saveModal = new ModalPreloader()
+        this.state = {
+            formValues: tlsProfileFormDefaults(),
+            validationResult: new ValidationResult(),
+            loading: true,
+            notFound: false,
+        }
+    }
+
+    private emptyToUndefined(value?: string): string | undefined {
+        return value?.trim() ? value : undefined
+    }
+
+    private buildRequest(): TlsProfileRequest {
+        const { formValues } = this.state
+        return {
+            ...formValues,
+            ciphers: this.emptyToUndefined(formValues.ciphers),
+            clientVerification: {
+                ...formValues.clientVerification,
+                certificateAuthorities: this.emptyToUndefined(formValues.clientVerification.certificateAuthorities),
+            },
+        }
+    }
+
+    private submit() {
+        this.saveModal.show(MessageKey.CommonHangOnTight, {
+            id: MessageKey.CommonSavingType,
+            params: { type: MessageKey.CommonTlsProfile },
+        })
+        this.setState({ validationResult: new ValidationResult() })
+
+        const request = this.buildRequest()
+        const action =
+            this.profileId === undefined
+                ? this.service.create(request).then(response => this.updateId(response.id))
+                : this.service.updateById(this.profileId, request)
+
+        action.then(() => this.handleSuccess()).catch(error => this.handleError(error))
+    }
+
+    private updateId(id: string) {
+        this.profileId = id
+        navigateTo(`/tls-profiles/${id}`, true)
+        this.updateShellConfig(true)
+    }
+
+    private handleSuccess() {
+        this.saveModal.close()
+        Notification.success(
+            { id: MessageKey.CommonTypeSaved, params: { type: MessageKey.CommonTlsProfile } },
+            MessageKey.CommonSuccessMessage,
+        )
+        ReloadNginxAction.execute()
+    }
+
+    private handleError(error: Error) {
+        if (error instanceof UnexpectedResponseError) {
+            const validationResult = ValidationResultConverter.parse(error.response)
+            if (validationResult != null) this.setState({ validationResult })
+        }
+
+        this.saveModal.close()
+        Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonFormCheckMessage)
+    }
+
+    private handleChange(newValues: TlsProfileRequest) {
+        this.setState({
+            formValues: {
+                ...newValues,
+            },
+        })
+    }
+
+    private renderGeneralSection() {
+        const { validationResult, formValues } = this.state
+
+        return (
+            <Flex className="tls-profile-form-inner-flex-container-column tls-profile-form-expanded-label-size">
+                <h2 className="tls-profile-form-section-name">
+                    <I18n id={MessageKey.CommonGeneral} />
+                </h2>
+                <Form.Item
+                    name="name"
+                    validateStatus={validationResult.getStatus("name")}
+                    help={validationResult.getMessage("name")}
+                    label={<I18n id={MessageKey.CommonName} />}
+                    required
+                >
+                    <Input />
+                </Form.Item>
+                <Form.Item
+                    name="preset"
+                    validateStatus={validationResult.getStatus("preset")}
+                    help={
+                        validationResult.getMessage("preset") ?? <I18n id={MessageKey.FrontendTlsprofilePresetHelp} />
+                    }
+                    label={<I18n id={MessageKey.FrontendTlsprofilePreset} />}
+                    required
+                >
+                    <Select
+                        options={TLS_PROFILE_PRESET_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                    />
+                </Form.Item>
+                <If condition={formValues.preset === TlsProfilePreset.CUSTOM}>
+                    <Form.Item
+                        name="protocols"
+                        validateStatus={validationResult.getStatus("protocols")}
+                        help={validationResult.getMessage("protocols")}
+                        label={<I18n id={MessageKey.FrontendTlsprofileProtocols} />}
+                        required
+                    >
+                        <Select mode="multiple" options={TLS_PROTOCOL_OPTIONS} />
+                    </Form.Item>
+                    <Form.Item
+                        name="ciphers"
+                        validateStatus={validationResult.getStatus("ciphers")}
+                        help={
+                            validationResult.getMessage("ciphers") ?? (
+                                <I18n id={MessageKey.FrontendTlsprofileCiphersHelp} />
+                            )
+                        }
+                        label={<I18n id={MessageKey.FrontendTlsprofileCiphers} />}
+                    >
+                        <Input />
+                    </Form.Item>
+                    <Form.Item
+                        name="preferServerCiphers"
+                        label={<I18n id={MessageKey.FrontendTlsprofilePreferServerCiphers} />}
+                        required
+                    >
+                        <Switch />
+                    </Form.Item>
+                </If>
+                <Form.Item
+                    name="ocspStapling"
+                    help={<I18n id={MessageKey.FrontendTlsprofileOcspStaplingHelp} />}
+                    label={<I18n id={MessageKey.FrontendTlsprofileOcspStapling} />}
+                    required
+                >
+                    <Switch />
+                </Form.Item>
+            </Flex>
+        )
+    }
+
+    private renderHstsSection() {
+        const { validationResult, formValues } = this.state
+        const disabled = !formValues.hsts.enabled
+
+        return (
+            <Flex className="tls-profile-form-inner-flex-container-column tls-profile-form-expanded-label-size">
+                <h2 className="tls-profile-form-section-name">
+                    <I18n id={MessageKey.FrontendTlsprofileHsts} />
+                </h2>
+                <p className="tls-profile-form-section-help-text">
+                    <I18n id={MessageKey.FrontendTlsprofileHstsHelp} />
+                </p>
+                <Form.Item name={["hsts", "enabled"]} label={<I18n id={MessageKey.CommonEnabled} />} required>
+                    <Switch />
+                </Form.Item>
+                <Form.Item
+                    label={<I18n id={MessageKey.FrontendTlsprofileHstsMaxAge} />}
+                    validateStatus={validationResult.getStatus("hsts.maxAgeSeconds")}
+                    help={validationResult.getMessage("hsts.maxAgeSeconds")}
+                    required
+                >
+                    <Space.Compact style={{ width: "100%" }}>
+                        <Form.Item name={["hsts", "maxAgeSeconds"]} noStyle>
+                            <InputNumber min={1} style={{ width: "100%" }} disabled={disabled} />
+                        </Form.Item>
+                        <Space.Addon>
+                            <I18n id={MessageKey.CommonUnitSeconds} />
+                        </Space.Addon>
+                    </Space.Compact>
+                </Form.Item>
+                <Form.Item
+                    name={["hsts", "includeSubdomains"]}
+                    label={<I18n id={MessageKey.FrontendTlsprofileHstsIncludeSubdomains} />}
+                    required
+                >
+                    <Switch disabled={disabled} />
+                </Form.Item>
+                <Form.Item
+                    name={["hsts", "preload"]}
+                    validateStatus={validationResult.getStatus("hsts.preload")}
+                    help={validationResult.getMessage("hsts.preload")}
+                    label={<I18n id={MessageKey.FrontendTlsprofileHstsPreload} />}
+                    required
+                >
+                    <Switch disabled={disabled} />
+                </Form.Item>
+            </Flex>
+        )
+    }
+
+    private renderSessionSection() {
+        const { validationResult, formValues } = this.state
+
+        return (
+            <Flex className="tls-profile-form-inner-flex-container-column tls-profile-form-expanded-label-size">
+                <h2 className="tls-profile-form-section-name">
+                    <I18n id={MessageKey.FrontendTlsprofileSession} />
+                </h2>
+                <p className="tls-profile-form-section-help-text">
+                    <I18n id={MessageKey.FrontendTlsprofileSessionHelp} />
+                </p>
+                <Form.Item
+                    name={["session", "cacheEnabled"]}
+                    label={<I18n id={MessageKey.FrontendTlsprofileSessionCache} />}
+                    required
+                >
+                    <Switch />
+                </Form.Item>
+                <Form.Item
+                    label={<I18n id={MessageKey.FrontendTlsprofileSessionCacheSize} />}
+                    validateStatus={validationResult.getStatus("session.cacheSizeMb")}
+                    help={validationResult.getMessage("session.cacheSizeMb")}
+                    required
+                >
+                    <Space.Compact style={{ width: "100%" }}>
+                        <Form.Item name={["session", "cacheSizeMb"]} noStyle>
+                            <InputNumber
+                                min={1}
+                                max={1024}
+                                style={{ width: "100%" }}
+                                disabled={!formValues.session.cacheEnabled}
+                            />
+                        </Form.Item>
+                        <Space.Addon>
+                            <I18n id={MessageKey.CommonUnitMb} />
+                        </Space.Addon>
+                    </Space.Compact>
+                </Form.Item>
+                <Form.Item
+                    label={<I18n id={MessageKey.FrontendTlsprofileSessionTimeout} />}
+                    validateStatus={validationResult.getStatus("session.timeoutMinutes")}
+                    help={validationResult.getMessage("session.timeoutMinutes")}
+                    required
+                >
+                    <Space.Compact style={{ width: "100%" }}>
+                        <Form.Item name={["session", "timeoutMinutes"]} noStyle>
+                            <InputNumber min={1} max={1440} style={{ width: "100%" }} />
+                        </Form.Item>
+                        <Space.Addon>
+                            <I18n id={MessageKey.FrontendSettingsTabsIgnitionTimeUnitMinutes} />
+                        </Space.Addon>
+                    </Space.Compact>
+                </Form.Item>
+                <Form.Item
+                    name={["session", "ticketsEnabled"]}
+                    label={<I18n id={MessageKey.FrontendTlsprofileSessionTickets} />}
+                    required
+                >
+                    <Switch />
+                </Form.Item>
+            </Flex>
+        )
+    }
+
+    private renderClientVerificationSection() {
+        const { validationResult, formValues } = this.state
+        const disabled = !formValues.clientVerification.enabled
+
+        return (
+            <Flex className="tls-profile-form-inner-flex-container-column tls-profile-form-expanded-label-size">
+                <h2 className="tls-profile-form-section-name">
+                    <I18n id={MessageKey.FrontendTlsprofileClientVerification} />
+                </h2>
+                <p className="tls-profile-form-section-help-text">
+                    <I18n id={MessageKey.FrontendTlsprofileClientVerificationHelp} />
+                </p>
+                <Form.Item
+                    name={["clientVerification", "enabled"]}
+                    label={<I18n id={MessageKey.CommonEnabled} />}
+                    required
+                >
+                    <Switch />
+                </Form.Item>
+                <Form.Item
+                    name={["clientVerification", "mode"]}
+                    validateStatus={validationResult.getStatus("clientVerification.mode")}
+                    help={validationResult.getMessage("clientVerification.mode")}
+                    label={<I18n id={MessageKey.CommonMode} />}
+                    required
+                >
+                    <Select
+                        disabled={disabled}
+                        options={CLIENT_VERIFICATION_MODE_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                    />
+                </Form.Item>
+                <Form.Item
+                    name={["clientVerification", "depth"]}
+                    validateStatus={validationResult.getStatus("clientVerification.depth")}
+                    help={validationResult.getMessage("clientVerification.depth")}
+                    label={<I18n id={MessageKey.FrontendTlsprofileClientVerificationDepth} />}
+                    required
+                >
+                    <InputNumber min={1} max={10} style={{ width: "100%" }} disabled={disabled} />
+                </Form.Item>
+                <Form.Item
+                    name={["clientVerification", "certificateAuthorities"]}
+                    validateStatus={validationResult.getStatus("clientVerification.certificateAuthorities")}
+                    help={
+                        validationResult.getMessage("clientVerification.certificateAuthorities") ?? (
+                            <I18n id={MessageKey.FrontendTlsprofileCertificateAuthoritiesHelp} />
+                        )
+                    }
+                    label={<I18n id={MessageKey.FrontendTlsprofileCertificateAuthorities} />}
+                    required
+                >
+                    <TextArea rows={6} disabled={disabled} />
+                </Form.Item>
+            </Flex>
+        )
+    }
+
+    private renderForm() {
+        const { formValues } = this.state
+
+        return (
+            <Form<TlsProfileRequest>
+                {...FormLayout.FormDefaults}
+                ref={this.formRef}
+                onValuesChange={(_, formValues) => this.handleChange(formValues)}
+                initialValues={formValues}
+            >
+                <Flex className="tls-profile-form-inner-flex-container">
+                    {this.renderGeneralSection()}
+                    {this.renderHstsSection()}
+                </Flex>
+                <Flex className="tls-profile-form-inner-flex-container">
+                    {this.renderSessionSection()}
+                    {this.renderClientVerificationSection()}
+                </Flex>
+            </Form>
+        )
+    }
+
+    private async delete() {
+        if (this.profileId === undefined) return
+
+        return DeleteTlsProfileAction.execute(this.profileId).then(() => navigateTo("/tls-profiles"))
+    }
+
+    private updateShellConfig(enableActions: boolean) {
+        if (!isAccessGranted(UserAccessLevel.READ_WRITE, permissions => permissions.tlsProfiles)) {
+            enableActions = false
+        }
+
+        const actions: ShellAction[] = [
+            {
+                description: MessageKey.CommonSave,
+                disabled: !enableActions,
+                onClick: () => this.submit(),
+            },
+        ]
+
+        if (this.profileId !== undefined)
+            actions.unshift({
+                description: MessageKey.CommonDelete,
+                disabled: !enableActions,
+                color: "danger",
+                onClick: () => this.delete(),
+            })
+
+        AppShellContext.get().updateConfig({
+            title: MessageKey.FrontendTlsprofileFormTitle,
+            subtitle: MessageKey.FrontendTlsprofileFormSubtitle,
+            actions,
+        })
+    }
+
+    componentDidMount() {
+        if (this.profileId === undefined) {
+            this.setState({ loading: false })
+            this.updateShellConfig(true)
+            return
+        }
+
+        this.service
+            .getById(this.profileId!!)
+            .then(profile => {
+                if (profile === undefined) this.setState({ loading: false, notFound: true })
+                else {
+                    this.setState({ loading: false, formValues: profile })
+                    this.updateShellConfig(true)
+                }
+            })
+            .catch(error => {
+                CommonNotifications.failedToFetch()
+                this.setState({ loading: false, error })
+            })
+
+        this.updateShellConfig(false)
+    }
+
+    render() {
+        const { loading, notFound, error } = this.state
+
+        if (error !== undefined) return EmptyStates.FailedToFetch
+        if (notFound) return EmptyStates.NotFound
+        if (loading) return <Preloader loading />
+
+        return (
+            <AccessControl
+                requiredAccessLevel={UserAccessLevel.READ_ONLY}
+                permissionResolver={permissions => permissions.tlsProfiles}
+            >
+                {this.renderForm()}
+            </AccessControl>
+        )
+    }
+}
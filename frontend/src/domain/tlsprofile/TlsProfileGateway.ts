@@ -0,0 +1,38 @@
+import ApiClient from "../../core/apiclient/ApiClient"
+import ApiResponse from "../../core/apiclient/ApiResponse"
+import PageResponse from "../../core/pagination/PageResponse"
+import TlsProfileResponse from "./model/TlsProfileResponse"
+import TlsProfileRequest from "./model/TlsProfileRequest"
+import GenericCreateResponse from "../../core/common/GenericCreateResponse"
+
+export default class TlsProfileGateway {
+    private readonly client: ApiClient
+
+    constructor() {
+        this.client = new ApiClient("/api/tls-profiles")
+    }
+
+    async getPage(
+        pageSize?: number,
+        pageNumber?: number,
+        searchTerms?: string,
+    ): Promise<ApiResponse<PageResponse<TlsProfileResponse>>> {
+        return this.client.get(undefined, undefined, { pageSize, pageNumber, searchTerms })
+    }
+
+    async getById(id: string): Promise<ApiResponse<TlsProfileResponse>> {
+        return this.client.get(`/${id}`)
+    }
+
+    async putById(id: string, profile: TlsProfileRequest): Promise<ApiResponse<void>> {
+        return this.client.put(`/${id}`, profile)
+    }
+
+    async deleteById(id: string): Promise<ApiResponse<void>> {
+        return this.client.delete(`/${id}`)
+    }
+
+    async post(profile: TlsProfileRequest): Promise<ApiResponse<GenericCreateResponse>> {
+        return this.client.post("", profile)
+    }
+}
@@ -0,0 +1,129 @@
+import React from "react"
+import DataTable, { DataTableColumn } from "../../core/components/datatable/DataTable"
+import { Link } from "react-router-dom"
+import { DeleteOutlined, EditOutlined } from "@ant-design/icons"
+import PageResponse from "../../core/pagination/PageResponse"
+import TlsProfileService from "./TlsProfileService"
+import AppShellContext from "../../core/components/shell/AppShellContext"
+import TlsProfileResponse from "./model/TlsProfileResponse"
+import DeleteTlsProfileAction from "./actions/DeleteTlsProfileAction"
+import AccessControl from "../../core/components/accesscontrol/AccessControl"
+import { UserAccessLevel } from "../user/model/UserAccessLevel"
+import { isAccessGranted } from "../../core/components/accesscontrol/IsAccessGranted"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+import { I18n, raw } from "../../core/i18n/I18n"
+import { themedColors } from "../../core/components/theme/ThemedResources"
+import { CLIENT_VERIFICATION_MODE_OPTIONS_DATA, TLS_PROFILE_PRESET_OPTIONS_DATA } from "./TlsProfileOptions"
+
+export default class TlsProfileListPage extends React.PureComponent {
+    private readonly service: TlsProfileService
+    private readonly table: React.RefObject<DataTable<TlsProfileResponse> | null>
+
+    constructor(props: any) {
+        super(props)
+        this.service = new TlsProfileService()
+        this.table = React.createRef()
+    }
+
+    private renderPreset(profile: TlsProfileResponse) {
+        const option = TLS_PROFILE_PRESET_OPTIONS_DATA.find(item => item.value === profile.preset)
+        return option ? <I18n id={option.messageKey} /> : profile.preset
+    }
+
+    private renderClientVerification(profile: TlsProfileResponse) {
+        const { enabled, mode } = profile.clientVerification
+        if (!enabled) return <I18n id={MessageKey.CommonDisabled} />
+
+        const option = CLIENT_VERIFICATION_MODE_OPTIONS_DATA.find(item => item.value === mode)
+        return option ? <I18n id={option.messageKey} /> : mode
+    }
+
+    private buildColumns(): DataTableColumn<TlsProfileResponse>[] {
+        return [
+            {
+                id: "name",
+                description: MessageKey.CommonName,
+                renderer: item => item.name,
+            },
+            {
+                id: "preset",
+                description: MessageKey.FrontendTlsprofilePreset,
+                renderer: item => this.renderPreset(item),
+                width: 150,
+            },
+            {
+                id: "hsts",
+                description: MessageKey.FrontendTlsprofileHsts,
+                renderer: item => <I18n id={item.hsts.enabled ? MessageKey.CommonYes : MessageKey.CommonNo} />,
+                width: 250,
+            },
+            {
+                id: "clientVerification",
+                description: MessageKey.FrontendTlsprofileClientVerification,
+                renderer: item => this.renderClientVerification(item),
+                width: 250,
+            },
+            {
+                id: "actions",
+                description: raw(""),
+                renderer: item => (
+                    <>
+                        <Link to={`/tls-profiles/${item.id}`}>
+                            <EditOutlined className="action-icon" />
+                        </Link>
+
+                        <Link to="" onClick={() => this.deleteTlsProfile(item)}>
+                            <DeleteOutlined style={{ color: themedColors().DANGER }} className="action-icon" />
+                        </Link>
+                    </>
+                ),
+                width: 120,
+            },
+        ]
+    }
+
+    private async deleteTlsProfile(profile: TlsProfileResponse) {
+        return DeleteTlsProfileAction.execute(profile.id).then(() => this.table.current?.refresh())
+    }
+
+    private fetchData(
+        pageSize: number,
+        pageNumber: number,
+        searchTerms?: string,
+    ): Promise<PageResponse<TlsProfileResponse>> {
+        return this.service.list(pageSize, pageNumber, searchTerms)
+    }
+
+    componentDidMount() {
+        AppShellContext.get().updateConfig({
+            title: MessageKey.CommonTlsProfiles,
+            subtitle: MessageKey.FrontendTlsprofileListSubtitle,
+            actions: [
+                {
+                    description: MessageKey.FrontendTlsprofileNewButton,
+                    onClick: "/tls-profiles/new",
+                    disabled: !isAccessGranted(UserAccessLevel.READ_WRITE, permissions => permissions.tlsProfiles),
+                },
+            ],
+        })
+    }
+
+    render() {
+        return (
+            <AccessControl
+                requiredAccessLevel={UserAccessLevel.READ_ONLY}
+                permissionResolver={permissions => permissions.tlsProfiles}
+            >
+                <DataTable
+                    id="tls-profiles"
+                    ref={this.table}
+                    columns={this.buildColumns()}
+                    dataProvider={(pageSize, pageNumber, searchTerms) =>
+                        this.fetchData(pageSize, pageNumber, searchTerms)
+                    }
+                    rowKey={item => item.id}
+                />
+            </AccessControl>
+        )
+    }
+}
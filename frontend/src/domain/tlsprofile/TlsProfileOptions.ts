@@ -0,0 +1,25 @@
+import { ClientVerificationMode, TlsProfilePreset, TlsProtocol } from "./model/TlsProfileRequest"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+
+export const TLS_PROFILE_PRESET_OPTIONS_DATA = [
+    { value: TlsProfilePreset.MODERN, messageKey: MessageKey.FrontendTlsprofilePresetModern },
+    { value: TlsProfilePreset.INTERMEDIATE, messageKey: MessageKey.FrontendTlsprofilePresetIntermediate },
+    { value: TlsProfilePreset.LEGACY, messageKey: MessageKey.FrontendTlsprofilePresetLegacy },
+    { value: TlsProfilePreset.CUSTOM, messageKey: MessageKey.FrontendTlsprofilePresetCustom },
+]
+
+export const CLIENT_VERIFICATION_MODE_OPTIONS_DATA = [
+    {
+        value: ClientVerificationMode.REQUIRED,
+        messageKey: MessageKey.FrontendTlsprofileClientVerificationModeRequired,
+    },
+    {
+        value: ClientVerificationMode.OPTIONAL,
+        messageKey: MessageKey.FrontendTlsprofileClientVerificationModeOptional,
+    },
+]
+
+export const TLS_PROTOCOL_OPTIONS = Object.values(TlsProtocol).map(protocol => ({
+    value: protocol,
+    label: protocol,
+}))
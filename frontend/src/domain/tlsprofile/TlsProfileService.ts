@@ -0,0 +1,38 @@
+import TlsProfileGateway from "./TlsProfileGateway"
+import { requireNullablePayload, requireSuccessPayload, requireSuccessResponse } from "../../core/apiclient/ApiResponse"
+import PageResponse from "../../core/pagination/PageResponse"
+import TlsProfileRequest from "./model/TlsProfileRequest"
+import TlsProfileResponse from "./model/TlsProfileResponse"
+import GenericCreateResponse from "../../core/common/GenericCreateResponse"
+
+export default class TlsProfileService {
+    private readonly gateway: TlsProfileGateway
+
+    constructor() {
+        this.gateway = new TlsProfileGateway()
+    }
+
+    async list(
+        pageSize?: number,
+        pageNumber?: number,
+        searchTerms?: string,
+    ): Promise<PageResponse<TlsProfileResponse>> {
+        return this.gateway.getPage(pageSize, pageNumber, searchTerms).then(requireSuccessPayload)
+    }
+
+    async delete(id: string): Promise<void> {
+        return this.gateway.deleteById(id).then(requireSuccessResponse)
+    }
+
+    async getById(id: string): Promise<TlsProfileResponse | undefined> {
+        return this.gateway.getById(id).then(requireNullablePayload)
+    }
+
+    async updateById(id: string, profile: TlsProfileRequest): Promise<void> {
+        return this.gateway.putById(id, profile).then(requireSuccessResponse)
+    }
+
+    async create(profile: TlsProfileRequest): Promise<GenericCreateResponse> {
+        return this.gateway.post(profile).then(requireSuccessPayload)
+    }
+}
@@ -0,0 +1,48 @@
+import TlsProfileService from "../TlsProfileService"
+import UserConfirmation from "../../../core/components/confirmation/UserConfirmation"
+import Notification from "../../../core/components/notification/Notification"
+import { UnexpectedResponseError } from "../../../core/apiclient/ApiResponse"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import { I18nMessage, raw } from "../../../core/i18n/I18n"
+
+class DeleteTlsProfileAction {
+    private readonly service: TlsProfileService
+
+    constructor() {
+        this.service = new TlsProfileService()
+    }
+
+    private handleError(error: Error) {
+        const title = {
+            id: MessageKey.CommonUnableToDelete,
+            params: { type: MessageKey.CommonTlsProfile },
+        }
+        let message: I18nMessage = MessageKey.CommonUnexpectedErrorTryAgain
+
+        if (error instanceof UnexpectedResponseError) {
+            const responseMessage = error.response?.body?.message
+            if (typeof responseMessage === "string") {
+                message = raw(responseMessage)
+            }
+        }
+
+        Notification.error(title, message)
+    }
+
+    async execute(profileId: string): Promise<void> {
+        return UserConfirmation.ask(MessageKey.FrontendTlsprofileDeleteConfirmation)
+            .then(() => this.service.delete(profileId))
+            .then(() =>
+                Notification.success(
+                    {
+                        id: MessageKey.CommonTypeDeleted,
+                        params: { type: MessageKey.CommonTlsProfile },
+                    },
+                    MessageKey.CommonSuccessMessage,
+                ),
+            )
+            .catch(error => this.handleError(error))
+    }
+}
+
+export default new DeleteTlsProfileAction()
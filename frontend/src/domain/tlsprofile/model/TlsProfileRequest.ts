@@ -0,0 +1,51 @@
+export enum TlsProfilePreset {
+    MODERN = "MODERN",
+    INTERMEDIATE = "INTERMEDIATE",
+    LEGACY = "LEGACY",
+    CUSTOM = "CUSTOM",
+}
+
+export enum TlsProtocol {
+    TLS_V1 = "TLSv1",
+    TLS_V1_1 = "TLSv1.1",
+    TLS_V1_2 = "TLSv1.2",
+    TLS_V1_3 = "TLSv1.3",
+}
+
+export enum ClientVerificationMode {
+    REQUIRED = "REQUIRED",
+    OPTIONAL = "OPTIONAL",
+}
+
+export interface TlsProfileHsts {
+    enabled: boolean
+    maxAgeSeconds: number
+    includeSubdomains: boolean
+    preload: boolean
+}
+
+export interface TlsProfileSession {
+    cacheEnabled: boolean
+    cacheSizeMb: number
+    timeoutMinutes: number
+    ticketsEnabled: boolean
+}
+
+export interface TlsProfileClientVerification {
+    enabled: boolean
+    mode: ClientVerificationMode
+    depth: number
+    certificateAuthorities?: string
+}
+
+export default interface TlsProfileRequest {
+    name: string
+    preset: TlsProfilePreset
+    protocols: TlsProtocol[]
+    ciphers?: string
+    preferServerCiphers: boolean
+    ocspStapling: boolean
+    hsts: TlsProfileHsts
+    session: TlsProfileSession
+    clientVerification: TlsProfileClientVerification
+}
@@ -0,0 +1,5 @@
+import TlsProfileRequest from "./TlsProfileRequest"
+
+export default interface TlsProfileResponse extends TlsProfileRequest {
+    id: string
+}
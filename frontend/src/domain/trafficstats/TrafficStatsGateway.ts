@@ -1,6 +1,10 @@
 import ApiClient from "../../core/apiclient/ApiClient"
 import ApiResponse from "../../core/apiclient/ApiResponse"
 import TrafficStatsResponse from "./model/TrafficStatsResponse"
+import TrafficStatsHistoryResponse, {
+    TrafficStatsHistoryResolution,
+    TrafficStatsHistoryScope,
+} from "./model/TrafficStatsHistoryResponse"
 
 export default class TrafficStatsGateway {
     private readonly client: ApiClient
@@ -12,4 +16,20 @@ export default class TrafficStatsGateway {
     async getStats(): Promise<ApiResponse<TrafficStatsResponse>> {
         return this.client.get("")
     }
+
+    async getHistory(
+        resolution: TrafficStatsHistoryResolution,
+        scope: TrafficStatsHistoryScope,
+        from: Date,
+        to: Date,
+        key?: string,
+    ): Promise<ApiResponse<TrafficStatsHistoryResponse>> {
+        return this.client.get("/history", undefined, {
+            resolution,
+            scope,
+            from: from.toISOString(),
+            to: to.toISOString(),
+            key: key !== undefined ? encodeURIComponent(key) : undefined,
+        })
+    }
 }
@@ -12,6 +12,7 @@ import GlobalTab from "./tabs/GlobalTab"
 import ByHostTab from "./tabs/ByHostTab"
 import ByDomainTab from "./tabs/ByDomainTab"
 import ByUpstreamTab from "./tabs/ByUpstreamTab"
+import HistoryTab from "./tabs/HistoryTab"
 import MessageKey from "../../core/i18n/model/MessageKey.generated"
 import { i18n, I18n } from "../../core/i18n/I18n"
 import ThemeContext from "../../core/components/context/ThemeContext"
@@ -242,6 +243,11 @@ export default class TrafficStatsPage extends React.Component<object, TrafficSta
                     />
                 ),
             },
+            {
+                key: "history",
+                label: <I18n id={MessageKey.FrontendTrafficStatsHistoryTab} />,
+                children: <HistoryTab stats={stats} theme={theme} disableAnimation={disableAnimation} />,
+            },
         ]
 
         return (
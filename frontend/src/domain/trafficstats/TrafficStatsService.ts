@@ -1,6 +1,10 @@
 import TrafficStatsGateway from "./TrafficStatsGateway"
 import { requireSuccessPayload } from "../../core/apiclient/ApiResponse"
 import TrafficStatsResponse from "./model/TrafficStatsResponse"
+import TrafficStatsHistoryResponse, {
+    TrafficStatsHistoryResolution,
+    TrafficStatsHistoryScope,
+} from "./model/TrafficStatsHistoryResponse"
 
 export default class TrafficStatsService {
     private readonly gateway: TrafficStatsGateway
@@ -12,4 +16,14 @@ export default class TrafficStatsService {
     async getStats(): Promise<TrafficStatsResponse> {
         return this.gateway.getStats().then(requireSuccessPayload)
     }
+
+    async getHistory(
+        resolution: TrafficStatsHistoryResolution,
+        scope: TrafficStatsHistoryScope,
+        from: Date,
+        to: Date,
+        key?: string,
+    ): Promise<TrafficStatsHistoryResponse> {
+        return this.gateway.getHistory(resolution, scope, from, to, key).then(requireSuccessPayload)
+    }
 }
@@ -0,0 +1,45 @@
+import React from "react"
+import { Line } from "@ant-design/charts"
+import { I18n } from "../../../core/i18n/I18n"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import { HistoryDataItem } from "../utils/StatsChartUtils"
+
+export interface HistoryChartProps {
+    title: MessageKey
+    data: HistoryDataItem[]
+    theme: "light" | "dark"
+    colors?: string[]
+    valueFormatter?: (value: number) => string
+    disableAnimation?: boolean
+}
+
+export default class HistoryChart extends React.PureComponent<HistoryChartProps> {
+    render() {
+        const { title, data, theme, colors, valueFormatter, disableAnimation } = this.props
+
+        return (
+            <div className="traffic-stats-chart-container">
+                <p className="traffic-stats-chart-title">
+                    <I18n id={title} />
+                </p>
+                <Line
+                    data={data}
+                    xField="time"
+                    yField="value"
+                    colorField="series"
+                    height={300}
+                    axis={{
+                        x: { labelAutoHide: true },
+                        y: valueFormatter ? { labelFormatter: valueFormatter } : undefined,
+                    }}
+                    legend={{ color: { position: "bottom" } }}
+                    scale={colors ? { color: { range: colors } } : undefined}
+                    tooltip={valueFormatter ? { items: [{ channel: "y", valueFormatter }] } : undefined}
+                    theme={theme}
+                    // @ts-expect-error attribute not mapped in the TS contract
+                    animation={!disableAnimation}
+                />
+            </div>
+        )
+    }
+}
@@ -0,0 +1,34 @@
+import { UpstreamResponses } from "./TrafficStatsResponse"
+
+export enum TrafficStatsHistoryResolution {
+    MINUTE = "MINUTE",
+    HOUR = "HOUR",
+    DAY = "DAY",
+}
+
+export enum TrafficStatsHistoryScope {
+    GLOBAL = "GLOBAL",
+    HOST = "HOST",
+    DOMAIN = "DOMAIN",
+    UPSTREAM = "UPSTREAM",
+}
+
+export default interface TrafficStatsHistoryResponse {
+    resolution: TrafficStatsHistoryResolution
+    scope: TrafficStatsHistoryScope
+    series: TrafficStatsHistorySeries[]
+}
+
+export interface TrafficStatsHistorySeries {
+    key: string
+    points: TrafficStatsHistoryPoint[]
+}
+
+export interface TrafficStatsHistoryPoint {
+    timestamp: string
+    responses: UpstreamResponses
+    requests: number
+    inBytes: number
+    outBytes: number
+    averageRequestMsecs: number
+}
@@ -0,0 +1,348 @@
+import React from "react"
+import { Empty, Flex, Select } from "antd"
+import { ExclamationCircleOutlined } from "@ant-design/icons"
+import TrafficStatsResponse from "../model/TrafficStatsResponse"
+import TrafficStatsHistoryResponse, {
+    TrafficStatsHistoryPoint,
+    TrafficStatsHistoryResolution,
+    TrafficStatsHistoryScope,
+} from "../model/TrafficStatsHistoryResponse"
+import TrafficStatsService from "../TrafficStatsService"
+import HostService from "../../host/HostService"
+import HostResponse from "../../host/model/HostResponse"
+import { buildHistoryData, STATUS_COLORS } from "../utils/StatsChartUtils"
+import { formatBytes, formatMs, formatNumber } from "../utils/StatsFormatters"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import { i18n, I18n } from "../../../core/i18n/I18n"
+import HistoryChart from "../components/HistoryChart"
+import TagGroup from "../../../core/components/taggroup/TagGroup"
+import PaginatedSelect from "../../../core/components/select/PaginatedSelect"
+import Preloader from "../../../core/components/preloader/Preloader"
+import EmptyStates from "../../../core/components/emptystate/EmptyStates"
+
+interface HistoryPeriod {
+    id: string
+    description: MessageKey
+    durationMillis: number
+    resolution: TrafficStatsHistoryResolution
+}
+
+const HISTORY_PERIODS: HistoryPeriod[] = [
+    {
+        id: "lastHour",
+        description: MessageKey.FrontendTrafficStatsHistoryLastHour,
+        durationMillis: 3_600_000,
+        resolution: TrafficStatsHistoryResolution.MINUTE,
+    },
+    {
+        id: "lastDay",
+        description: MessageKey.FrontendTrafficStatsHistoryLastDay,
+        durationMillis: 86_400_000,
+        resolution: TrafficStatsHistoryResolution.MINUTE,
+    },
+    {
+        id: "lastWeek",
+        description: MessageKey.FrontendTrafficStatsHistoryLastWeek,
+        durationMillis: 7 * 86_400_000,
+        resolution: TrafficStatsHistoryResolution.HOUR,
+    },
+    {
+        id: "lastMonth",
+        description: MessageKey.FrontendTrafficStatsHistoryLastMonth,
+        durationMillis: 30 * 86_400_000,
+        resolution: TrafficStatsHistoryResolution.HOUR,
+    },
+    {
+        id: "lastYear",
+        description: MessageKey.FrontendTrafficStatsHistoryLastYear,
+        durationMillis: 365 * 86_400_000,
+        resolution: TrafficStatsHistoryResolution.DAY,
+    },
+]
+
+const SCOPE_DESCRIPTIONS: Record<TrafficStatsHistoryScope, MessageKey> = {
+    [TrafficStatsHistoryScope.GLOBAL]: MessageKey.FrontendTrafficStatsGlobalTab,
+    [TrafficStatsHistoryScope.HOST]: MessageKey.CommonHost,
+    [TrafficStatsHistoryScope.DOMAIN]: MessageKey.CommonDomain,
+    [TrafficStatsHistoryScope.UPSTREAM]: MessageKey.CommonUpstream,
+}
+
+const SELECT_KEY_MESSAGES: Record<TrafficStatsHistoryScope, MessageKey> = {
+    [TrafficStatsHistoryScope.GLOBAL]: MessageKey.FrontendTrafficStatsHistoryNoData,
+    [TrafficStatsHistoryScope.HOST]: MessageKey.FrontendTrafficStatsSelectHost,
+    [TrafficStatsHistoryScope.DOMAIN]: MessageKey.FrontendTrafficStatsSelectDomain,
+    [TrafficStatsHistoryScope.UPSTREAM]: MessageKey.FrontendTrafficStatsSelectUpstream,
+}
+
+interface HistoryTabProps {
+    stats: TrafficStatsResponse
+    theme: "light" | "dark"
+    disableAnimation?: boolean
+}
+
+interface HistoryTabState {
+    loading: boolean
+    scope: TrafficStatsHistoryScope
+    period: HistoryPeriod
+    selectedKey?: string
+    selectedHost?: HostResponse
+    history?: TrafficStatsHistoryResponse
+    from?: Date
+    to?: Date
+    error?: Error
+}
+
+export default class HistoryTab extends React.Component<HistoryTabProps, HistoryTabState> {
+    private readonly service: TrafficStatsService
+    private readonly hostService: HostService
+
+    constructor(props: HistoryTabProps) {
+        super(props)
+        this.service = new TrafficStatsService()
+        this.hostService = new HostService()
+        this.state = {
+            loading: false,
+            scope: TrafficStatsHistoryScope.GLOBAL,
+            period: HISTORY_PERIODS[0],
+        }
+    }
+
+    componentDidMount() {
+        this.fetchHistory()
+    }
+
+    componentDidUpdate(prevProps: HistoryTabProps) {
+        if (prevProps.stats !== this.props.stats) this.fetchHistory()
+    }
+
+    private async fetchHistory() {
+        const { scope, period, selectedKey } = this.state
+        if (scope !== TrafficStatsHistoryScope.GLOBAL && selectedKey === undefined) {
+            this.setState({ history: undefined, error: undefined })
+            return
+        }
+
+        const to = new Date()
+        const from = new Date(to.getTime() - period.durationMillis)
+        this.setState({ loading: true })
+
+        try {
+            const history = await this.service.getHistory(period.resolution, scope, from, to, selectedKey)
+            this.setState({ history, from, to, loading: false, error: undefined })
+        } catch (error) {
+            this.setState({ loading: false, error: error as Error })
+        }
+    }
+
+    private changeScope(scope: TrafficStatsHistoryScope) {
+        this.setState(
+            { scope, selectedKey: undefined, selectedHost: undefined, history: undefined },
+            () => this.fetchHistory(),
+        )
+    }
+
+    private changePeriod(id: string) {
+        const period = HISTORY_PERIODS.find(item => item.id === id) ?? HISTORY_PERIODS[0]
+        this.setState({ period }, () => this.fetchHistory())
+    }
+
+    private changeKey(selectedKey?: string, selectedHost?: HostResponse) {
+        this.setState({ selectedKey, selectedHost }, () => this.fetchHistory())
+    }
+
+    private buildKeyOptions() {
+        const { serverZones, upstreamZones } = this.props.stats
+        const { scope } = this.state
+
+        const keys =
+            scope === TrafficStatsHistoryScope.DOMAIN
+                ? Object.keys(serverZones ?? {}).filter(domain => domain !== "*")
+                : Object.keys(upstreamZones ?? {})
+
+        return keys.map(key => ({ value: key, label: key }))
+    }
+
+    private renderScopeSelector() {
+        const { scope } = this.state
+        const options = Object.values(TrafficStatsHistoryScope).map(value => ({
+            value,
+            label: <I18n id={SCOPE_DESCRIPTIONS[value]} />,
+        }))
+
+        return (
+            <Flex className="traffic-stats-settings-option">
+                <p>
+                    <I18n id={MessageKey.FrontendTrafficStatsHistoryScope} />
+                </p>
+                <Select
+                    className="traffic-stats-auto-refresh"
+                    options={options}
+                    value={scope}
+                    onChange={value => this.changeScope(value)}
+                />
+            </Flex>
+        )
+    }
+
+    private renderKeySelector() {
+        const { scope, selectedKey, selectedHost } = this.state
+        if (scope === TrafficStatsHistoryScope.GLOBAL) return null
+
+        if (scope === TrafficStatsHistoryScope.HOST)
+            return (
+                <Flex className="traffic-stats-settings-option">
+                    <p>
+                        <I18n id={MessageKey.CommonHost} />
+                    </p>
+                    <PaginatedSelect<HostResponse>
+                        placeholder={MessageKey.CommonSelectOne}
+                        onChange={host => this.changeKey(host?.id, host)}
+                        pageProvider={(pageSize, pageNumber, searchTerms) =>
+                            this.hostService.list(pageSize, pageNumber, searchTerms)
+                        }
+                        value={selectedHost}
+                        itemDescription={item =>
+                            item.defaultServer ? (
+                                <span style={{ fontStyle: "italic", color: "grey" }}>
+                                    <I18n id={MessageKey.CommonDefaultServerLabel} />
+                                </span>
+                            ) : (
+                                <TagGroup values={item.domainNames ?? []} />
+                            )
+                        }
+                        itemKey={item => item.id}
+                    />
+                </Flex>
+            )
+
+        return (
+            <Flex className="traffic-stats-settings-option">
+                <p>
+                    <I18n id={SCOPE_DESCRIPTIONS[scope]} />
+                </p>
+                <Select
+                    className="traffic-stats-selector"
+                    placeholder={<I18n id={SELECT_KEY_MESSAGES[scope]} />}
+                    options={this.buildKeyOptions()}
+                    value={selectedKey}
+                    onChange={value => this.changeKey(value)}
+                    showSearch
+                    filterOption={(input, option) =>
+                        (option?.label?.toString() ?? "").toLowerCase().includes(input.toLowerCase())
+                    }
+                />
+            </Flex>
+        )
+    }
+
+    private renderPeriodSelector() {
+        const { period } = this.state
+        const options = HISTORY_PERIODS.map(item => ({
+            value: item.id,
+            label: <I18n id={item.description} />,
+        }))
+
+        return (
+            <Flex className="traffic-stats-settings-option">
+                <p>
+                    <I18n id={MessageKey.FrontendTrafficStatsHistoryPeriod} />
+                </p>
+                <Select
+                    className="traffic-stats-auto-refresh"
+                    options={options}
+                    value={period.id}
+                    onChange={value => this.changePeriod(value)}
+                />
+            </Flex>
+        )
+    }
+
+    private renderCharts(points: TrafficStatsHistoryPoint[]) {
+        const { theme, disableAnimation } = this.props
+        const { history, from, to } = this.state
+        if (!history || !from || !to) return null
+
+        const build = (extractor: (point: TrafficStatsHistoryPoint) => Record<string, number>) =>
+            buildHistoryData(points, history.resolution, from, to, extractor)
+
+        return (
+            <>
+                <Flex className="traffic-stats-charts-row">
+                    <HistoryChart
+                        title={MessageKey.FrontendTrafficStatsHistoryRequests}
+                        data={build(point => ({ [i18n(MessageKey.FrontendTrafficStatsRequests)]: point.requests }))}
+                        valueFormatter={formatNumber}
+                        theme={theme}
+                        disableAnimation={disableAnimation}
+                    />
+                    <HistoryChart
+                        title={MessageKey.FrontendTrafficStatsHistoryResponses}
+                        data={build(point => ({ ...point.responses }))}
+                        colors={Object.values(STATUS_COLORS)}
+                        valueFormatter={formatNumber}
+                        theme={theme}
+                        disableAnimation={disableAnimation}
+                    />
+                </Flex>
+                <Flex className="traffic-stats-charts-row">
+                    <HistoryChart
+                        title={MessageKey.FrontendTrafficStatsHistoryTraffic}
+                        data={build(point => ({
+                            [i18n(MessageKey.FrontendTrafficStatsBytesReceived)]: point.inBytes,
+                            [i18n(MessageKey.FrontendTrafficStatsBytesSent)]: point.outBytes,
+                        }))}
+                        valueFormatter={formatBytes}
+                        theme={theme}
+                        disableAnimation={disableAnimation}
+                    />
+                    <HistoryChart
+                        title={MessageKey.FrontendTrafficStatsHistoryResponseTime}
+                        data={build(point => ({
+                            [i18n(MessageKey.FrontendTrafficStatsAverageResponseTime)]: point.averageRequestMsecs,
+                        }))}
+                        valueFormatter={formatMs}
+                        theme={theme}
+                        disableAnimation={disableAnimation}
+                    />
+                </Flex>
+            </>
+        )
+    }
+
+    private renderMainContents() {
+        const { scope, selectedKey, history, error } = this.state
+
+        if (error) return EmptyStates.FailedToFetch
+
+        if (scope !== TrafficStatsHistoryScope.GLOBAL && selectedKey === undefined) {
+            const icon = (
+                <ExclamationCircleOutlined style={{ fontSize: 70, color: "var(--nginxIgnition-colorTextDisabled)" }} />
+            )
+
+            return <Empty image={icon} description={<I18n id={SELECT_KEY_MESSAGES[scope]} />} />
+        }
+
+        if (!history) return null
+
+        const series = history.series.find(item => selectedKey === undefined || item.key === selectedKey)
+        if (!series || series.points.length === 0)
+            return <Empty description={<I18n id={MessageKey.FrontendTrafficStatsHistoryNoData} />} />
+
+        return this.renderCharts(series.points)
+    }
+
+    render() {
+        const { loading } = this.state
+
+        return (
+            <div className="traffic-stats-tab-content">
+                <Flex className="traffic-stats-history-selectors">
+                    {this.renderScopeSelector()}
+                    {this.renderKeySelector()}
+                    {this.renderPeriodSelector()}
+                </Flex>
+                <Preloader loading={loading}>{this.renderMainContents()}</Preloader>
+            </div>
+        )
+    }
+}
@@ -1,4 +1,5 @@
 import { ZoneData } from "../model/TrafficStatsResponse"
+import { TrafficStatsHistoryPoint, TrafficStatsHistoryResolution } from "../model/TrafficStatsHistoryResponse"
 
 export const STATUS_COLORS = {
     "1xx": "#1677ff",
@@ -137,3 +138,54 @@ export function buildCityData(cities: Record<string, ZoneData>) {
         .filter(item => item.value > 0)
         .sort((a, b) => b.value - a.value)
 }
+
+export interface HistoryDataItem {
+    time: string
+    series: string
+    value: number
+}
+
+const HISTORY_RESOLUTION_MILLIS: Record<TrafficStatsHistoryResolution, number> = {
+    [TrafficStatsHistoryResolution.MINUTE]: 60_000,
+    [TrafficStatsHistoryResolution.HOUR]: 3_600_000,
+    [TrafficStatsHistoryResolution.DAY]: 86_400_000,
+}
+
+const EMPTY_HISTORY_POINT: TrafficStatsHistoryPoint = {
+    timestamp: "",
+    responses: { "1xx": 0, "2xx": 0, "3xx": 0, "4xx": 0, "5xx": 0 },
+    requests: 0,
+    inBytes: 0,
+    outBytes: 0,
+    averageRequestMsecs: 0,
+}
+
+function formatHistoryTime(timestamp: number, resolution: TrafficStatsHistoryResolution): string {
+    const date = new Date(timestamp)
+    if (resolution === TrafficStatsHistoryResolution.DAY) return date.toLocaleDateString()
+    return date.toLocaleString([], { dateStyle: "short", timeStyle: "short" })
+}
+
+export function buildHistoryData(
+    points: TrafficStatsHistoryPoint[],
+    resolution: TrafficStatsHistoryResolution,
+    from: Date,
+    to: Date,
+    extractor: (point: TrafficStatsHistoryPoint) => Record<string, number>,
+): HistoryDataItem[] {
+    const step = HISTORY_RESOLUTION_MILLIS[resolution]
+    const pointsByTimestamp = new Map(points.map(point => [new Date(point.timestamp).getTime(), point]))
+    const output: HistoryDataItem[] = []
+
+    for (let timestamp = Math.floor(from.getTime() / step) * step; timestamp < to.getTime(); timestamp += step) {
+        const point = pointsByTimestamp.get(timestamp)
+        const values = extractor(point ?? EMPTY_HISTORY_POINT)
+        const time = formatHistoryTime(timestamp, resolution)
+
+        for (const [series, value] of Object.entries(values)) {
+            output.push({ time, series, value })
+        }
+    }
+
+    return output
+}
@@ -0,0 +1,77 @@
+import UpstreamResponse from "./model/UpstreamResponse"
+import UpstreamFormValues, { UpstreamServerFormValues } from "./model/UpstreamFormValues"
+import UpstreamRequest, { UpstreamBalancingMethod, UpstreamServer } from "./model/UpstreamRequest"
+import { upstreamFormDefaults } from "./UpstreamFormDefaults"
+
+class UpstreamConverter {
+    private toServerFormValues(input: UpstreamServer): UpstreamServerFormValues {
+        const { address, port, weight, backup, circuitBreaker } = input
+
+        return {
+            address,
+            port,
+            weight,
+            backup,
+            maxFailures: circuitBreaker?.maxFailures,
+            openSeconds: circuitBreaker?.openSeconds,
+        }
+    }
+
+    private toServerRequest(input: UpstreamServerFormValues): UpstreamServer {
+        const { address, port, weight, backup, maxFailures, openSeconds } = input
+        const circuitBreakerEnabled = maxFailures != null || openSeconds != null
+
+        return {
+            address,
+            port,
+            weight: weight ?? undefined,
+            backup,
+            circuitBreaker: circuitBreakerEnabled
+                ? {
+                      maxFailures: maxFailures ?? 0,
+                      openSeconds: openSeconds ?? 0,
+                  }
+                : undefined,
+        }
+    }
+
+    toRequest(input: UpstreamFormValues): UpstreamRequest {
+        const { name, protocol, method, hashKey, keepalive } = input
+        const servers = input.servers.map(server => this.toServerRequest(server))
+
+        return {
+            name,
+            protocol,
+            method,
+            hashKey: method === UpstreamBalancingMethod.HASH ? hashKey : undefined,
+            keepalive: keepalive.enabled
+                ? {
+                      connections: keepalive.connections,
+                      timeoutSeconds: keepalive.timeoutSeconds,
+                  }
+                : undefined,
+            servers,
+        }
+    }
+
+    toFormValues(input: UpstreamResponse): UpstreamFormValues {
+        const { name, protocol, method, hashKey, keepalive } = input
+        const defaults = upstreamFormDefaults()
+        const servers = input.servers?.map(server => this.toServerFormValues(server)) ?? []
+
+        return {
+            name,
+            protocol,
+            method,
+            hashKey,
+            keepalive: {
+                enabled: keepalive != null,
+                connections: keepalive?.connections ?? defaults.keepalive.connections,
+                timeoutSeconds: keepalive?.timeoutSeconds ?? defaults.keepalive.timeoutSeconds,
+            },
+            servers,
+        }
+    }
+}
+
+export default new UpstreamConverter()
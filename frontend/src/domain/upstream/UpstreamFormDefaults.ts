@@ -0,0 +1,24 @@
+import { UpstreamBalancingMethod, UpstreamProtocol } from "./model/UpstreamRequest"
+import UpstreamFormValues, { UpstreamServerFormValues } from "./model/UpstreamFormValues"
+
+export function upstreamServerFormDefaults(): UpstreamServerFormValues {
+    return {
+        address: "",
+        port: 80,
+        backup: false,
+    }
+}
+
+export function upstreamFormDefaults(): UpstreamFormValues {
+    return {
+        name: "",
+        protocol: UpstreamProtocol.HTTP,
+        method: UpstreamBalancingMethod.ROUND_ROBIN,
+        keepalive: {
+            enabled: false,
+            connections: 16,
+            timeoutSeconds: 60,
+        },
+        servers: [upstreamServerFormDefaults()],
+    }
+}
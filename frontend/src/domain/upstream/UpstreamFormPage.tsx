@@ -0,0 +1,320 @@
+import React from "react"
+import { navigateTo, routeParams } from "../../core/components/router/AppRouter"
+import UpstreamService from "./UpstreamService"
+import { Flex, Form, FormInstance, Input, InputNumber, Select, Space, Switch } from "antd"
+import Preloader from "../../core/components/preloader/Preloader"
+import FormLayout from "../../core/components/form/FormLayout"
+import ValidationResult from "../../core/validation/ValidationResult"
+import ModalPreloader from "../../core/components/preloader/ModalPreloader"
+import Notification from "../../core/components/notification/Notification"
+import { UnexpectedResponseError } from "../../core/apiclient/ApiResponse"
+import ValidationResultConverter from "../../core/validation/ValidationResultConverter"
+import AppShellContext, { ShellAction } from "../../core/components/shell/AppShellContext"
+import CommonNotifications from "../../core/components/notification/CommonNotifications"
+import EmptyStates from "../../core/components/emptystate/EmptyStates"
+import DeleteUpstreamAction from "./actions/DeleteUpstreamAction"
+import ReloadNginxAction from "../nginx/actions/ReloadNginxAction"
+import { UserAccessLevel } from "../user/model/UserAccessLevel"
+import AccessControl from "../../core/components/accesscontrol/AccessControl"
+import { isAccessGranted } from "../../core/components/accesscontrol/IsAccessGranted"
+import { upstreamFormDefaults } from "./UpstreamFormDefaults"
+import UpstreamFormValues from "./model/UpstreamFormValues"
+import { UpstreamBalancingMethod } from "./model/UpstreamRequest"
+import UpstreamConverter from "./UpstreamConverter"
+import UpstreamServers from "./components/UpstreamServers"
+import { UPSTREAM_BALANCING_METHOD_OPTIONS_DATA, UPSTREAM_PROTOCOL_OPTIONS } from "./UpstreamOptions"
+import If from "../../core/components/flowcontrol/If"
+import "./UpstreamFormPage.css"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+import { I18n } from "../../core/i18n/I18n"
+
+interface UpstreamFormState {
+    formValues: UpstreamFormValues
+    validationResult: ValidationResult
+    loading: boolean
+    notFound: boolean
+    error?: Error
+}
+
+export default class UpstreamFormPage extends React.Component<unknown, UpstreamFormState> {
+    private readonly service: UpstreamService
+    private readonly saveModal: ModalPreloader
+    private readonly formRef: React.RefObject<FormInstance | null>
+    private upstreamId?: string
+
+    constructor(props: any) {
+        super(props)
+        const upstreamId = routeParams().id
+        this.formRef = React.createRef()
+        this.upstreamId = upstreamId === "new" ? undefined : upstreamId
+        this.service = new UpstreamService()
+        this.saveModal = new ModalPreloader()
+        this.state = {
+            formValues: upstreamFormDefaults(),
+            validationResult: new ValidationResult(),
+            loading: true,
+            notFound: false,
+        }
+    }
+
+    private submit() {
+        const { formValues } = this.state
+        this.saveModal.show(MessageKey.CommonHangOnTight, {
+            id: MessageKey.CommonSavingType,
+            params: { type: MessageKey.CommonUpstream },
+        })
+        this.setState({ validationResult: new ValidationResult() })
+
+        const request = UpstreamConverter.toRequest(formValues)
+        const action =
+            this.upstreamId === undefined
+                ? this.service.create(request).then(response => this.updateId(response.id))
+                : this.service.updateById(this.upstreamId, request)
+
+        action.then(() => this.handleSuccess()).catch(error => this.handleError(error))
+    }
+
+    private updateId(id: string) {
+        this.upstreamId = id
+        navigateTo(`/upstreams/${id}`, true)
+        this.updateShellConfig(true)
+    }
+
+    private handleSuccess() {
+        this.saveModal.close()
+        Notification.success(
+            { id: MessageKey.CommonTypeSaved, params: { type: MessageKey.CommonUpstream } },
+            MessageKey.CommonSuccessMessage,
+        )
+        ReloadNginxAction.execute()
+    }
+
+    private handleError(error: Error) {
+        if (error instanceof UnexpectedResponseError) {
+            const validationResult = ValidationResultConverter.parse(error.response)
+            if (validationResult != null) this.setState({ validationResult })
+        }
+
+        this.saveModal.close()
+        Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonFormCheckMessage)
+    }
+
+    private handleChange(newValues: UpstreamFormValues) {
+        this.setState({
+            formValues: {
+                ...newValues,
+            },
+        })
+    }
+
+    private renderGeneralSection() {
+        const { validationResult, formValues } = this.state
+
+        return (
+            <Flex className="upstream-form-inner-flex-container-column upstream-form-expanded-label-size">
+                <h2 className="upstream-form-section-name">
+                    <I18n id={MessageKey.CommonGeneral} />
+                </h2>
+                <Form.Item
+                    name="name"
+                    validateStatus={validationResult.getStatus("name")}
+                    help={validationResult.getMessage("name")}
+                    label={<I18n id={MessageKey.CommonName} />}
+                    required
+                >
+                    <Input />
+                </Form.Item>
+                <Form.Item
+                    name="protocol"
+                    validateStatus={validationResult.getStatus("protocol")}
+                    help={validationResult.getMessage("protocol")}
+                    label={<I18n id={MessageKey.FrontendUpstreamProtocol} />}
+                    required
+                >
+                    <Select options={UPSTREAM_PROTOCOL_OPTIONS} />
+                </Form.Item>
+                <Form.Item
+                    name="method"
+                    validateStatus={validationResult.getStatus("method")}
+                    help={validationResult.getMessage("method")}
+                    label={<I18n id={MessageKey.FrontendUpstreamBalancingMethod} />}
+                    required
+                >
+                    <Select
+                        options={UPSTREAM_BALANCING_METHOD_OPTIONS_DATA.map(item => ({
+                            value: item.value,
+                            label: <I18n id={item.messageKey} />,
+                        }))}
+                    />
+                </Form.Item>
+                <If condition={formValues.method === UpstreamBalancingMethod.HASH}>
+                    <Form.Item
+                        name="hashKey"
+                        validateStatus={validationResult.getStatus("hashKey")}
+                        help={
+                            validationResult.getMessage("hashKey") ?? (
+                                <I18n id={MessageKey.FrontendUpstreamHashKeyHelp} />
+                            )
+                        }
+                        label={<I18n id={MessageKey.FrontendUpstreamHashKey} />}
+                        required
+                    >
+                        <Input />
+                    </Form.Item>
+                </If>
+            </Flex>
+        )
+    }
+
+    private renderKeepaliveSection() {
+        const { validationResult, formValues } = this.state
+        const disabled = !formValues.keepalive.enabled
+
+        return (
+            <Flex className="upstream-form-inner-flex-container-column upstream-form-expanded-label-size">
+                <h2 className="upstream-form-section-name">
+                    <I18n id={MessageKey.FrontendUpstreamKeepalive} />
+                </h2>
+                <p className="upstream-form-section-help-text">
+                    <I18n id={MessageKey.FrontendUpstreamKeepaliveHelp} />
+                </p>
+                <Form.Item name={["keepalive", "enabled"]} label={<I18n id={MessageKey.CommonEnabled} />} required>
+                    <Switch />
+                </Form.Item>
+                <Form.Item
+                    name={["keepalive", "connections"]}
+                    validateStatus={validationResult.getStatus("keepalive.connections")}
+                    help={validationResult.getMessage("keepalive.connections")}
+                    label={<I18n id={MessageKey.FrontendUpstreamKeepaliveConnections} />}
+                    required
+                >
+                    <InputNumber min={1} style={{ width: "100%" }} disabled={disabled} />
+                </Form.Item>
+                <Form.Item
+                    label={<I18n id={MessageKey.FrontendUpstreamKeepaliveTimeout} />}
+                    validateStatus={validationResult.getStatus("keepalive.timeoutSeconds")}
+                    help={validationResult.getMessage("keepalive.timeoutSeconds")}
+                    required
+                >
+                    <Space.Compact style={{ width: "100%" }}>
+                        <Form.Item name={["keepalive", "timeoutSeconds"]} noStyle>
+                            <InputNumber min={1} style={{ width: "100%" }} disabled={disabled} />
+                        </Form.Item>
+                        <Space.Addon>
+                            <I18n id={MessageKey.CommonUnitSeconds} />
+                        </Space.Addon>
+                    </Space.Compact>
+                </Form.Item>
+            </Flex>
+        )
+    }
+
+    private renderForm() {
+        const { formValues, validationResult } = this.state
+        const backupSupported =
+            formValues.method !== UpstreamBalancingMethod.IP_HASH && formValues.method !== UpstreamBalancingMethod.HASH
+
+        return (
+            <Form<UpstreamFormValues>
+                {...FormLayout.FormDefaults}
+                ref={this.formRef}
+                onValuesChange={(_, formValues) => this.handleChange(formValues)}
+                initialValues={formValues}
+            >
+                <Flex className="upstream-form-inner-flex-container">
+                    {this.renderGeneralSection()}
+                    {this.renderKeepaliveSection()}
+                </Flex>
+
+                <h2 className="upstream-form-section-name">
+                    <I18n id={MessageKey.FrontendUpstreamServers} />
+                </h2>
+                <p className="upstream-form-section-help-text">
+                    <I18n id={MessageKey.FrontendUpstreamServersHelp} />
+                </p>
+                <Form.Item
+                    {...FormLayout.ExpandedUnlabeledItem}
+                    validateStatus={validationResult.getStatus("servers")}
+                    help={validationResult.getMessage("servers")}
+                >
+                    <UpstreamServers validationResult={validationResult} backupSupported={backupSupported} />
+                </Form.Item>
+            </Form>
+        )
+    }
+
+    private async delete() {
+        if (this.upstreamId === undefined) return
+
+        return DeleteUpstreamAction.execute(this.upstreamId).then(() => navigateTo("/upstreams"))
+    }
+
+    private updateShellConfig(enableActions: boolean) {
+        if (!isAccessGranted(UserAccessLevel.READ_WRITE, permissions => permissions.upstreams)) {
+            enableActions = false
+        }
+
+        const actions: ShellAction[] = [
+            {
+                description: MessageKey.CommonSave,
+                disabled: !enableActions,
+                onClick: () => this.submit(),
+            },
+        ]
+
+        if (this.upstreamId !== undefined)
+            actions.unshift({
+                description: MessageKey.CommonDelete,
+                disabled: !enableActions,
+                color: "danger",
+                onClick: () => this.delete(),
+            })
+
+        AppShellContext.get().updateConfig({
+            title: MessageKey.FrontendUpstreamFormTitle,
+            subtitle: MessageKey.FrontendUpstreamFormSubtitle,
+            actions,
+        })
+    }
+
+    componentDidMount() {
+        if (this.upstreamId === undefined) {
+            this.setState({ loading: false })
+            this.updateShellConfig(true)
+            return
+        }
+
+        this.service
+            .getById(this.upstreamId!!)
+            .then(upstream => {
+                if (upstream === undefined) this.setState({ loading: false, notFound: true })
+                else {
+                    this.setState({ loading: false, formValues: UpstreamConverter.toFormValues(upstream) })
+                    this.updateShellConfig(true)
+                }
+            })
+            .catch(error => {
+                CommonNotifications.failedToFetch()
+                this.setState({ loading: false, error })
+            })
+
+        this.updateShellConfig(false)
+    }
+
+    render() {
+        const { loading, notFound, error } = this.state
+
+        if (error !== undefined) return EmptyStates.FailedToFetch
+        if (notFound) return EmptyStates.NotFound
+        if (loading) return <Preloader loading />
+
+        return (
+            <AccessControl
+                requiredAccessLevel={UserAccessLevel.READ_ONLY}
+                permissionResolver={permissions => permissions.upstreams}
+            >
+                {this.renderForm()}
+            </AccessControl>
+        )
+    }
+}
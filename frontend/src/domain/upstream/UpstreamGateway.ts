@@ -0,0 +1,38 @@
+import ApiClient from "../../core/apiclient/ApiClient"
+import ApiResponse from "../../core/apiclient/ApiResponse"
+import PageResponse from "../../core/pagination/PageResponse"
+import UpstreamResponse from "./model/UpstreamResponse"
+import UpstreamRequest from "./model/UpstreamRequest"
+import GenericCreateResponse from "../../core/common/GenericCreateResponse"
+
+export default class UpstreamGateway {
+    private readonly client: ApiClient
+
+    constructor() {
+        this.client = new ApiClient("/api/upstreams")
+    }
+
+    async getPage(
+        pageSize?: number,
+        pageNumber?: number,
+        searchTerms?: string,
+    ): Promise<ApiResponse<PageResponse<UpstreamResponse>>> {
+        return this.client.get(undefined, undefined, { pageSize, pageNumber, searchTerms })
+    }
+
+    async getById(id: string): Promise<ApiResponse<UpstreamResponse>> {
+        return this.client.get(`/${id}`)
+    }
+
+    async putById(id: string, upstream: UpstreamRequest): Promise<ApiResponse<void>> {
+        return this.client.put(`/${id}`, upstream)
+    }
+
+    async deleteById(id: string): Promise<ApiResponse<void>> {
+        return this.client.delete(`/${id}`)
+    }
+
+    async post(upstream: UpstreamRequest): Promise<ApiResponse<GenericCreateResponse>> {
+        return this.client.post("", upstream)
+    }
+}
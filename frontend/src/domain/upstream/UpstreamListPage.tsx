@@ -0,0 +1,118 @@
+import React from "react"
+import DataTable, { DataTableColumn } from "../../core/components/datatable/DataTable"
+import { Link } from "react-router-dom"
+import { DeleteOutlined, EditOutlined } from "@ant-design/icons"
+import PageResponse from "../../core/pagination/PageResponse"
+import UpstreamService from "./UpstreamService"
+import AppShellContext from "../../core/components/shell/AppShellContext"
+import UpstreamResponse from "./model/UpstreamResponse"
+import DeleteUpstreamAction from "./actions/DeleteUpstreamAction"
+import AccessControl from "../../core/components/accesscontrol/AccessControl"
+import { UserAccessLevel } from "../user/model/UserAccessLevel"
+import { isAccessGranted } from "../../core/components/accesscontrol/IsAccessGranted"
+import TagGroup from "../../core/components/taggroup/TagGroup"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+import { I18n, raw } from "../../core/i18n/I18n"
+import { themedColors } from "../../core/components/theme/ThemedResources"
+import { UPSTREAM_BALANCING_METHOD_OPTIONS_DATA } from "./UpstreamOptions"
+
+export default class UpstreamListPage extends React.PureComponent {
+    private readonly service: UpstreamService
+    private readonly table: React.RefObject<DataTable<UpstreamResponse> | null>
+
+    constructor(props: any) {
+        super(props)
+        this.service = new UpstreamService()
+        this.table = React.createRef()
+    }
+
+    private renderMethod(upstream: UpstreamResponse) {
+        const option = UPSTREAM_BALANCING_METHOD_OPTIONS_DATA.find(item => item.value === upstream.method)
+        return option ? <I18n id={option.messageKey} /> : upstream.method
+    }
+
+    private buildColumns(): DataTableColumn<UpstreamResponse>[] {
+        return [
+            {
+                id: "name",
+                description: MessageKey.CommonName,
+                renderer: item => item.name,
+            },
+            {
+                id: "method",
+                description: MessageKey.FrontendUpstreamBalancingMethod,
+                renderer: item => this.renderMethod(item),
+                width: 200,
+            },
+            {
+                id: "servers",
+                description: MessageKey.FrontendUpstreamServers,
+                renderer: item => (
+                    <TagGroup values={item.servers.map(server => `${server.address}:${server.port}`)} maximumSize={2} />
+                ),
+                width: 350,
+            },
+            {
+                id: "actions",
+                description: raw(""),
+                renderer: item => (
+                    <>
+                        <Link to={`/upstreams/${item.id}`}>
+                            <EditOutlined className="action-icon" />
+                        </Link>
+
+                        <Link to="" onClick={() => this.deleteUpstream(item)}>
+                            <DeleteOutlined style={{ color: themedColors().DANGER }} className="action-icon" />
+                        </Link>
+                    </>
+                ),
+                width: 120,
+            },
+        ]
+    }
+
+    private async deleteUpstream(upstream: UpstreamResponse) {
+        return DeleteUpstreamAction.execute(upstream.id).then(() => this.table.current?.refresh())
+    }
+
+    private fetchData(
+        pageSize: number,
+        pageNumber: number,
+        searchTerms?: string,
+    ): Promise<PageResponse<UpstreamResponse>> {
+        return this.service.list(pageSize, pageNumber, searchTerms)
+    }
+
+    componentDidMount() {
+        AppShellContext.get().updateConfig({
+            title: MessageKey.CommonUpstreams,
+            subtitle: MessageKey.FrontendUpstreamListSubtitle,
+            actions: [
+                {
+                    description: MessageKey.FrontendUpstreamNewButton,
+                    onClick: "/upstreams/new",
+                    disabled: !isAccessGranted(UserAccessLevel.READ_WRITE, permissions => permissions.upstreams),
+                },
+            ],
+        })
+    }
+
+    render() {
+        return (
+            <AccessControl
+                requiredAccessLevel={UserAccessLevel.READ_ONLY}
+                permissionResolver={permissions => permissions.upstreams}
+            >
+                <DataTable
+                    id="upstreams"
+                    ref={this.table}
+                    columns={this.buildColumns()}
+                    dataProvider={(pageSize, pageNumber, searchTerms) =>
+                        this.fetchData(pageSize, pageNumber, searchTerms)
+                    }
+                    rowKey={item => item.id}
+                />
+            </AccessControl>
+        )
+    }
+}
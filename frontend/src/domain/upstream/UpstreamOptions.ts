@@ -0,0 +1,17 @@
+import { UpstreamBalancingMethod, UpstreamProtocol } from "./model/UpstreamRequest"
+import MessageKey from "../../core/i18n/model/MessageKey.generated"
+
+export const UPSTREAM_BALANCING_METHOD_OPTIONS_DATA = [
+    { value: UpstreamBalancingMethod.ROUND_ROBIN, messageKey: MessageKey.FrontendUpstreamMethodRoundRobin },
+    {
+        value: UpstreamBalancingMethod.LEAST_CONNECTIONS,
+        messageKey: MessageKey.FrontendUpstreamMethodLeastConnections,
+    },
+    { value: UpstreamBalancingMethod.IP_HASH, messageKey: MessageKey.FrontendUpstreamMethodIpHash },
+    { value: UpstreamBalancingMethod.HASH, messageKey: MessageKey.FrontendUpstreamMethodHash },
+]
+
+export const UPSTREAM_PROTOCOL_OPTIONS = Object.values(UpstreamProtocol).map(protocol => ({
+    value: protocol,
+    label: protocol,
+}))
@@ -0,0 +1,34 @@
+import UpstreamGateway from "./UpstreamGateway"
+import { requireNullablePayload, requireSuccessPayload, requireSuccessResponse } from "../../core/apiclient/ApiResponse"
+import PageResponse from "../../core/pagination/PageResponse"
+import UpstreamRequest from "./model/UpstreamRequest"
+import UpstreamResponse from "./model/UpstreamResponse"
+import GenericCreateResponse from "../../core/common/GenericCreateResponse"
+
+export default class UpstreamService {
+    private readonly gateway: UpstreamGateway
+
+    constructor() {
+        this.gateway = new UpstreamGateway()
+    }
+
+    async list(pageSize?: number, pageNumber?: number, searchTerms?: string): Promise<PageResponse<UpstreamResponse>> {
+        return this.gateway.getPage(pageSize, pageNumber, searchTerms).then(requireSuccessPayload)
+    }
+
+    async delete(id: string): Promise<void> {
+        return this.gateway.deleteById(id).then(requireSuccessResponse)
+    }
+
+    async getById(id: string): Promise<UpstreamResponse | undefined> {
+        return this.gateway.getById(id).then(requireNullablePayload)
+    }
+
+    async updateById(id: string, user: UpstreamRequest): Promise<void> {
+        return this.gateway.putById(id, user).then(requireSuccessResponse)
+    }
+
+    async create(user: UpstreamRequest): Promise<GenericCreateResponse> {
+        return this.gateway.post(user).then(requireSuccessPayload)
+    }
+}
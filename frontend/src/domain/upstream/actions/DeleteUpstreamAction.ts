@@ -0,0 +1,48 @@
+import UpstreamService from "../UpstreamService"
+import UserConfirmation from "../../../core/components/confirmation/UserConfirmation"
+import Notification from "../../../core/components/notification/Notification"
+import { UnexpectedResponseError } from "../../../core/apiclient/ApiResponse"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import { I18nMessage, raw } from "../../../core/i18n/I18n"
+
+class DeleteUpstreamAction {
+    private readonly service: UpstreamService
+
+    constructor() {
+        this.service = new UpstreamService()
+    }
+
+    private handleError(error: Error) {
+        const title = {
+            id: MessageKey.CommonUnableToDelete,
+            params: { type: MessageKey.CommonUpstream },
+        }
+        let message: I18nMessage = MessageKey.CommonUnexpectedErrorTryAgain
+
+        if (error instanceof UnexpectedResponseError) {
+            const responseMessage = error.response?.body?.message
+            if (typeof responseMessage === "string") {
+                message = raw(responseMessage)
+            }
+        }
+
+        Notification.error(title, message)
+    }
+
+    async execute(userId: string): Promise<void> {
+        return UserConfirmation.ask(MessageKey.FrontendUpstreamDeleteConfirmation)
+            .then(() => this.service.delete(userId))
+            .then(() =>
+                Notification.success(
+                    {
+                        id: MessageKey.CommonTypeDeleted,
+                        params: { type: MessageKey.CommonUpstream },
+                    },
+                    MessageKey.CommonSuccessMessage,
+                ),
+            )
+            .catch(error => this.handleError(error))
+    }
+}
+
+export default new DeleteUpstreamAction()
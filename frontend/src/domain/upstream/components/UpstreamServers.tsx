@@ -0,0 +1,145 @@
+import React from "react"
+import { Button, Flex, Form, FormListFieldData, FormListOperation, Input, InputNumber, Switch } from "antd"
+import { DeleteOutlined, PlusOutlined } from "@ant-design/icons"
+import FormLayout from "../../../core/components/form/FormLayout"
+import ValidationResult from "../../../core/validation/ValidationResult"
+import If from "../../../core/components/flowcontrol/If"
+import { upstreamServerFormDefaults } from "../UpstreamFormDefaults"
+import { I18n } from "../../../core/i18n/I18n"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+
+const SECOND_ONWARDS_ACTION_ICON_STYLE = {
+    marginLeft: 15,
+    marginTop: 9,
+}
+
+const FIRST_ACTION_ICON_STYLE = {
+    marginLeft: 15,
+    alignItems: "start",
+    marginTop: 40,
+}
+
+export interface UpstreamServersProps {
+    validationResult: ValidationResult
+    backupSupported: boolean
+}
+
+export default class UpstreamServers extends React.Component<UpstreamServersProps> {
+    private renderEntry(field: FormListFieldData, operations: FormListOperation, index: number, total: number) {
+        const { validationResult, backupSupported } = this.props
+        const { name } = field
+        const basePath = `servers[${index}]`
+
+        return (
+            <Flex key={field.key} align="start">
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    layout="vertical"
+                    name={[name, "address"]}
+                    validateStatus={validationResult.getStatus(`${basePath}.address`)}
+                    help={validationResult.getMessage(`${basePath}.address`)}
+                    label={index === 0 ? <I18n id={MessageKey.FrontendUpstreamAddress} /> : undefined}
+                    required
+                    style={{ flex: 3 }}
+                >
+                    <Input />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    layout="vertical"
+                    name={[name, "port"]}
+                    validateStatus={validationResult.getStatus(`${basePath}.port`)}
+                    help={validationResult.getMessage(`${basePath}.port`)}
+                    label={index === 0 ? <I18n id={MessageKey.CommonPort} /> : undefined}
+                    required
+                    style={{ flex: 1, marginLeft: 10 }}
+                >
+                    <InputNumber min={1} max={65535} style={{ width: "100%" }} />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    layout="vertical"
+                    name={[name, "weight"]}
+                    validateStatus={validationResult.getStatus(`${basePath}.weight`)}
+                    help={validationResult.getMessage(`${basePath}.weight`)}
+                    label={
+                        index === 0 ? <I18n id={MessageKey.FrontendStreamComponentsBackendsettingsWeight} /> : undefined
+                    }
+                    style={{ flex: 1, marginLeft: 10 }}
+                >
+                    <InputNumber min={1} max={9999} style={{ width: "100%" }} />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    layout="vertical"
+                    name={[name, "maxFailures"]}
+                    validateStatus={validationResult.getStatus(`${basePath}.circuitBreaker.maxFailures`)}
+                    help={validationResult.getMessage(`${basePath}.circuitBreaker.maxFailures`)}
+                    label={
+                        index === 0 ? (
+                            <I18n id={MessageKey.FrontendStreamComponentsBackendsettingsMaxFailures} />
+                        ) : undefined
+                    }
+                    style={{ flex: 1, marginLeft: 10 }}
+                >
+                    <InputNumber min={1} max={9999} style={{ width: "100%" }} />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    layout="vertical"
+                    name={[name, "openSeconds"]}
+                    validateStatus={validationResult.getStatus(`${basePath}.circuitBreaker.openSeconds`)}
+                    help={validationResult.getMessage(`${basePath}.circuitBreaker.openSeconds`)}
+                    label={
+                        index === 0 ? (
+                            <I18n id={MessageKey.FrontendStreamComponentsBackendsettingsOpenSeconds} />
+                        ) : undefined
+                    }
+                    style={{ flex: 1, marginLeft: 10 }}
+                >
+                    <InputNumber min={1} max={9999} style={{ width: "100%" }} />
+                </Form.Item>
+                <Form.Item
+                    {...FormLayout.ExpandedLabeledItem}
+                    layout="vertical"
+                    name={[name, "backup"]}
+                    validateStatus={validationResult.getStatus(`${basePath}.backup`)}
+                    help={validationResult.getMessage(`${basePath}.backup`)}
+                    label={index === 0 ? <I18n id={MessageKey.FrontendUpstreamBackup} /> : undefined}
+                    style={{ flex: 1, marginLeft: 10 }}
+                >
+                    <Switch disabled={!backupSupported} />
+                </Form.Item>
+
+                <If condition={total > 1}>
+                    <DeleteOutlined
+                        onClick={() => operations.remove(index)}
+                        style={index === 0 ? FIRST_ACTION_ICON_STYLE : SECOND_ONWARDS_ACTION_ICON_STYLE}
+                    />
+                </If>
+            </Flex>
+        )
+    }
+
+    private renderServers(fields: FormListFieldData[], operations: FormListOperation) {
+        const entries = fields.map((field, index) => this.renderEntry(field, operations, index, fields.length))
+
+        const addAction = (
+            <Form.Item>
+                <Button
+                    type="dashed"
+                    onClick={() => operations.add(upstreamServerFormDefaults())}
+                    icon={<PlusOutlined />}
+                >
+                    <I18n id={MessageKey.FrontendUpstreamAddServer} />
+                </Button>
+            </Form.Item>
+        )
+
+        return [...entries, addAction]
+    }
+
+    render() {
+        return <Form.List name="servers">{(fields, operations) => this.renderServers(fields, operations)}</Form.List>
+    }
+}
@@ -0,0 +1,25 @@
+import { UpstreamBalancingMethod, UpstreamProtocol } from "./UpstreamRequest"
+
+export interface UpstreamServerFormValues {
+    address: string
+    port: number
+    weight?: number
+    maxFailures?: number
+    openSeconds?: number
+    backup: boolean
+}
+
+export interface UpstreamKeepaliveFormValues {
+    enabled: boolean
+    connections: number
+    timeoutSeconds: number
+}
+
+export default interface UpstreamFormValues {
+    name: string
+    protocol: UpstreamProtocol
+    method: UpstreamBalancingMethod
+    hashKey?: string
+    keepalive: UpstreamKeepaliveFormValues
+    servers: UpstreamServerFormValues[]
+}
@@ -0,0 +1,38 @@
+export enum UpstreamBalancingMethod {
+    ROUND_ROBIN = "ROUND_ROBIN",
+    LEAST_CONNECTIONS = "LEAST_CONNECTIONS",
+    IP_HASH = "IP_HASH",
+    HASH = "HASH",
+}
+
+export enum UpstreamProtocol {
+    HTTP = "HTTP",
+    HTTPS = "HTTPS",
+}
+
+export interface UpstreamCircuitBreaker {
+    maxFailures: number
+    openSeconds: number
+}
+
+export interface UpstreamKeepalive {
+    connections: number
+    timeoutSeconds: number
+}
+
+export interface UpstreamServer {
+    address: string
+    port: number
+    weight?: number
+    circuitBreaker?: UpstreamCircuitBreaker
+    backup: boolean
+}
+
+export default interface UpstreamRequest {
+    name: string
+    protocol: UpstreamProtocol
+    method: UpstreamBalancingMethod
+    hashKey?: string
+    keepalive?: UpstreamKeepalive
+    servers: UpstreamServer[]
+}
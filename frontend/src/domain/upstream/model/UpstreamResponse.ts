@@ -0,0 +1,5 @@
+import UpstreamRequest from "./UpstreamRequest"
+
+export default interface UpstreamResponse extends UpstreamRequest {
+    id: string
+}
@@ -2,7 +2,7 @@ import React from "react"
 import { navigateTo, routeParams } from "../../core/components/router/AppRouter"
 import UserRequest from "./model/UserRequest"
 import UserService from "./UserService"
-import { Form, Input, Segmented, Switch } from "antd"
+import { Alert, Button, Form, Input, Segmented, Switch } from "antd"
 import Preloader from "../../core/components/preloader/Preloader"
 import FormLayout from "../../core/components/form/FormLayout"
 import ValidationResult from "../../core/validation/ValidationResult"
@@ -31,6 +31,7 @@ interface UserFormState {
     validationResult: ValidationResult
     loading: boolean
     notFound: boolean
+    lockedUntil?: string
     error?: Error
 }
 
@@ -63,7 +64,12 @@ export default class UserFormPage extends React.Component<unknown, UserFormState
                     exportData: UserAccessLevel.READ_ONLY,
                     vpns: UserAccessLevel.READ_WRITE,
                     caches: UserAccessLevel.READ_WRITE,
+                    upstreams: UserAccessLevel.READ_WRITE,
+                    tlsProfiles: UserAccessLevel.READ_WRITE,
+                    rateLimits: UserAccessLevel.READ_WRITE,
+                    securityHeaders: UserAccessLevel.READ_WRITE,
                     trafficStats: UserAccessLevel.READ_ONLY,
+                    audit: UserAccessLevel.NO_ACCESS,
                 },
             },
             validationResult: new ValidationResult(),
@@ -113,6 +119,57 @@ export default class UserFormPage extends React.Component<unknown, UserFormState
         Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonFormCheckMessage)
     }
 
+    private fetchLockout() {
+        if (this.userId === undefined) return
+
+        this.service
+            .getLockout(this.userId)
+            .then(lockout => this.setState({ lockedUntil: lockout.lockedUntil }))
+            .catch(() => this.setState({ lockedUntil: undefined }))
+    }
+
+    private unlock() {
+        if (this.userId === undefined) return
+
+        this.service
+            .unlock(this.userId)
+            .then(() => {
+                this.setState({ lockedUntil: undefined })
+                Notification.success(
+                    MessageKey.FrontendUserFormUnlockedTitle,
+                    MessageKey.FrontendUserFormUnlockedDescription,
+                )
+            })
+            .catch(() => Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonTryAgainLater))
+    }
+
+    private renderLockoutAlert() {
+        const { lockedUntil } = this.state
+        if (lockedUntil === undefined) return null
+
+        const canUnlock = isAccessGranted(UserAccessLevel.READ_WRITE, permissions => permissions.users)
+        return (
+            <Alert
+                type="warning"
+                showIcon
+                style={{ marginBottom: 24 }}
+                message={
+                    <I18n
+                        id={MessageKey.FrontendUserFormLocked}
+                        params={{ lockedUntil: new Date(lockedUntil).toLocaleString() }}
+                    />
+                }
+                action={
+                    <If condition={canUnlock}>
+                        <Button size="small" onClick={() => this.unlock()}>
+                            <I18n id={MessageKey.FrontendUserFormUnlock} />
+                        </Button>
+                    </If>
+                }
+            />
+        )
+    }
+
     private passwordHelpText() {
         return this.userId === undefined ? undefined : <I18n id={MessageKey.FrontendUserFormPasswordHelp} />
     }
@@ -194,12 +251,17 @@ export default class UserFormPage extends React.Component<unknown, UserFormState
                     <UserPermissionToggle id="integrations" label={MessageKey.CommonIntegrations} />
                     <UserPermissionToggle id="vpns" label={MessageKey.CommonVpns} />
                     <UserPermissionToggle id="caches" label={MessageKey.CommonCacheConfigurations} />
+                    <UserPermissionToggle id="upstreams" label={MessageKey.CommonUpstreams} />
+                    <UserPermissionToggle id="tlsProfiles" label={MessageKey.CommonTlsProfiles} />
+                    <UserPermissionToggle id="rateLimits" label={MessageKey.CommonRateLimits} />
+                    <UserPermissionToggle id="securityHeaders" label={MessageKey.CommonSecurityHeaders} />
                     <UserPermissionToggle id="accessLists" label={MessageKey.CommonAccessLists} />
                     <UserPermissionToggle id="settings" label={MessageKey.CommonSettings} />
                     <UserPermissionToggle id="users" label={MessageKey.CommonUsers} />
                     <UserPermissionToggle id="logs" label={MessageKey.CommonLogs} disableReadWrite />
                     <UserPermissionToggle id="trafficStats" label={MessageKey.CommonTrafficStats} disableReadWrite />
                     <UserPermissionToggle id="exportData" label={MessageKey.CommonExportAndBackup} disableReadWrite />
+                    <UserPermissionToggle id="audit" label={MessageKey.CommonAuditLog} disableReadWrite />
                     <UserPermissionToggle
                         id="nginxServer"
                         label={MessageKey.FrontendUserFormPermissionsNginxServer}
@@ -271,6 +333,7 @@ export default class UserFormPage extends React.Component<unknown, UserFormState
                 else {
                     this.setState({ loading: false, formValues: this.convertToUserRequest(userDetails) })
                     this.updateShellConfig(true)
+                    this.fetchLockout()
                 }
             })
             .catch(error => {
@@ -292,6 +355,11 @@ export default class UserFormPage extends React.Component<unknown, UserFormState
         if (notFound) return EmptyStates.NotFound
         if (loading) return <Preloader loading />
 
-        return this.renderForm()
+        return (
+            <>
+                {this.renderLockoutAlert()}
+                {this.renderForm()}
+            </>
+        )
     }
 }
@@ -10,6 +10,12 @@ import UserUpdatePasswordRequest from "./model/UserUpdatePasswordRequest"
 import GenericCreateResponse from "../../core/common/GenericCreateResponse"
 import UserTotpEnableResponse from "./model/UserTotpEnableResponse"
 import TotpStatusResponse from "./model/TotpStatusResponse"
+import UserOidcStatusResponse from "./model/UserOidcStatusResponse"
+import ApiTokenResponse from "./model/ApiTokenResponse"
+import ApiTokenRequest from "./model/ApiTokenRequest"
+import ApiTokenCreateResponse from "./model/ApiTokenCreateResponse"
+import SessionResponse from "./model/SessionResponse"
+import UserLockoutResponse from "./model/UserLockoutResponse"
 
 export default class UserGateway {
     private readonly client: ApiClient
@@ -34,6 +40,10 @@ export default class UserGateway {
         return this.client.post("/login", request)
     }
 
+    async getOidcStatus(): Promise<ApiResponse<UserOidcStatusResponse>> {
+        return this.client.get<UserOidcStatusResponse>("/oidc")
+    }
+
     async logout(): Promise<ApiResponse<void>> {
         return this.client.post("/logout")
     }
@@ -58,6 +68,14 @@ export default class UserGateway {
         return this.client.delete(`/${id}`)
     }
 
+    async getLockout(id: string): Promise<ApiResponse<UserLockoutResponse>> {
+        return this.client.get(`/${id}/lockout`)
+    }
+
+    async deleteLockout(id: string): Promise<ApiResponse<void>> {
+        return this.client.delete(`/${id}/lockout`)
+    }
+
     async post(user: UserRequest): Promise<ApiResponse<GenericCreateResponse>> {
         return this.client.post("", user)
     }
@@ -81,4 +99,28 @@ export default class UserGateway {
     async disableTotp(): Promise<ApiResponse<void>> {
         return this.client.delete("/current/totp")
     }
+
+    async getApiTokens(): Promise<ApiResponse<ApiTokenResponse[]>> {
+        return this.client.get("/current/api-tokens")
+    }
+
+    async postApiToken(request: ApiTokenRequest): Promise<ApiResponse<ApiTokenCreateResponse>> {
+        return this.client.post("/current/api-tokens", request)
+    }
+
+    async deleteApiToken(id: string): Promise<ApiResponse<void>> {
+        return this.client.delete(`/current/api-tokens/${id}`)
+    }
+
+    async getSessions(): Promise<ApiResponse<SessionResponse[]>> {
+        return this.client.get("/current/sessions")
+    }
+
+    async deleteSession(id: string): Promise<ApiResponse<void>> {
+        return this.client.delete(`/current/sessions/${id}`)
+    }
+
+    async deleteOtherSessions(): Promise<ApiResponse<void>> {
+        return this.client.delete("/current/sessions")
+    }
 }
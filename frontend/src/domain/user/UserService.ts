@@ -10,6 +10,12 @@ import AuthenticationService from "../../core/authentication/AuthenticationServi
 import UserUpdatePasswordRequest from "./model/UserUpdatePasswordRequest"
 import GenericCreateResponse from "../../core/common/GenericCreateResponse"
 import UserTotpEnableResponse from "./model/UserTotpEnableResponse"
+import UserOidcStatusResponse from "./model/UserOidcStatusResponse"
+import ApiTokenResponse from "./model/ApiTokenResponse"
+import ApiTokenRequest from "./model/ApiTokenRequest"
+import ApiTokenCreateResponse from "./model/ApiTokenCreateResponse"
+import SessionResponse from "./model/SessionResponse"
+import UserLockoutResponse from "./model/UserLockoutResponse"
 
 export default class UserService {
     private readonly gateway: UserGateway
@@ -30,6 +36,15 @@ export default class UserService {
         return (response.body as any)?.reason ?? LoginOutcome.FAILURE
     }
 
+    async oidcStatus(): Promise<UserOidcStatusResponse> {
+        return this.gateway.getOidcStatus().then(requireSuccessPayload)
+    }
+
+    oidcLoginUrl(returnTo?: string): string {
+        const base = "/api/users/oidc/login"
+        return returnTo ? `${base}?returnTo=${encodeURIComponent(returnTo)}` : base
+    }
+
     async logout(): Promise<void> {
         return this.gateway
             .logout()
@@ -64,6 +79,14 @@ export default class UserService {
         return this.gateway.putById(id, user).then(requireSuccessResponse)
     }
 
+    async getLockout(id: string): Promise<UserLockoutResponse> {
+        return this.gateway.getLockout(id).then(requireSuccessPayload)
+    }
+
+    async unlock(id: string): Promise<void> {
+        return this.gateway.deleteLockout(id).then(requireSuccessResponse)
+    }
+
     async create(user: UserRequest): Promise<GenericCreateResponse> {
         return this.gateway.post(user).then(requireSuccessPayload)
     }
@@ -87,4 +110,28 @@ export default class UserService {
     async disableTotp(): Promise<void> {
         return this.gateway.disableTotp().then(requireSuccessResponse)
     }
+
+    async listApiTokens(): Promise<ApiTokenResponse[]> {
+        return this.gateway.getApiTokens().then(requireSuccessPayload)
+    }
+
+    async createApiToken(request: ApiTokenRequest): Promise<ApiTokenCreateResponse> {
+        return this.gateway.postApiToken(request).then(requireSuccessPayload)
+    }
+
+    async deleteApiToken(id: string): Promise<void> {
+        return this.gateway.deleteApiToken(id).then(requireSuccessResponse)
+    }
+
+    async listSessions(): Promise<SessionResponse[]> {
+        return this.gateway.getSessions().then(requireSuccessPayload)
+    }
+
+    async revokeSession(id: string): Promise<void> {
+        return this.gateway.deleteSession(id).then(requireSuccessResponse)
+    }
+
+    async revokeOtherSessions(): Promise<void> {
+        return this.gateway.deleteOtherSessions().then(requireSuccessResponse)
+    }
 }
@@ -0,0 +1,345 @@
+import React from "react"
+import { Alert, Button, Empty, Flex, Form, Input, List, Select, Tag, Typography } from "antd"
+import { CopyOutlined, DeleteOutlined, PlusOutlined } from "@ant-design/icons"
+import UserService from "../UserService"
+import ApiTokenResponse from "../model/ApiTokenResponse"
+import ApiTokenRequest from "../model/ApiTokenRequest"
+import { UserAccessLevel } from "../model/UserAccessLevel"
+import { UserPermissionToggle } from "./UserPermissionToggle"
+import Notification from "../../../core/components/notification/Notification"
+import ValidationResult from "../../../core/validation/ValidationResult"
+import ValidationResultConverter from "../../../core/validation/ValidationResultConverter"
+import { UnexpectedResponseError } from "../../../core/apiclient/ApiResponse"
+import Preloader from "../../../core/components/preloader/Preloader"
+import UserConfirmation from "../../../core/components/confirmation/UserConfirmation"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import { I18n, i18n } from "../../../core/i18n/I18n"
+import If from "../../../core/components/flowcontrol/If"
+import "./ApiTokenManager.css"
+
+interface ApiTokenFormValues {
+    name: string
+    expiresInDays: number
+    allowedSourceAddresses: string[]
+    permissions: ApiTokenRequest["permissions"]
+}
+
+interface ApiTokenManagerState {
+    loading: boolean
+    tokens: ApiTokenResponse[]
+    formOpen: boolean
+    validationResult: ValidationResult
+    formValues: ApiTokenFormValues
+    createdToken?: string
+}
+
+const EXPIRATION_OPTIONS = [0, 30, 90, 180, 365]
+
+const DEFAULT_FORM_VALUES: ApiTokenFormValues = {
+    name: "",
+    expiresInDays: 90,
+    allowedSourceAddresses: [],
+    permissions: {
+        hosts: UserAccessLevel.READ_ONLY,
+        streams: UserAccessLevel.READ_ONLY,
+        certificates: UserAccessLevel.READ_ONLY,
+        logs: UserAccessLevel.NO_ACCESS,
+        integrations: UserAccessLevel.READ_ONLY,
+        accessLists: UserAccessLevel.READ_ONLY,
+        settings: UserAccessLevel.NO_ACCESS,
+        users: UserAccessLevel.NO_ACCESS,
+        nginxServer: UserAccessLevel.READ_ONLY,
+        exportData: UserAccessLevel.NO_ACCESS,
+        vpns: UserAccessLevel.READ_ONLY,
+        caches: UserAccessLevel.READ_ONLY,
+        upstreams: UserAccessLevel.READ_ONLY,
+        tlsProfiles: UserAccessLevel.READ_ONLY,
+        rateLimits: UserAccessLevel.READ_ONLY,
+        securityHeaders: UserAccessLevel.READ_ONLY,
+        trafficStats: UserAccessLevel.NO_ACCESS,
+        audit: UserAccessLevel.NO_ACCESS,
+    },
+}
+
+export default class ApiTokenManager extends React.Component<unknown, ApiTokenManagerState> {
+    private readonly service: UserService
+
+    constructor(props: unknown) {
+        super(props)
+        this.service = new UserService()
+        this.state = {
+            loading: true,
+            tokens: [],
+            formOpen: false,
+            validationResult: new ValidationResult(),
+            formValues: DEFAULT_FORM_VALUES,
+        }
+    }
+
+    componentDidMount() {
+        this.fetchTokens()
+    }
+
+    private fetchTokens() {
+        this.setState({ loading: true })
+        this.service
+            .listApiTokens()
+            .then(tokens => this.setState({ tokens, loading: false }))
+            .catch(() => {
+                this.setState({ loading: false })
+                Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonTryAgainLater)
+            })
+    }
+
+    private buildRequest(values: ApiTokenFormValues): ApiTokenRequest {
+        const { name, expiresInDays, allowedSourceAddresses, permissions } = values
+        const expiresAt =
+            expiresInDays > 0 ? new Date(Date.now() + expiresInDays * 24 * 60 * 60 * 1000).toISOString() : undefined
+
+        return { name, expiresAt, allowedSourceAddresses, permissions }
+    }
+
+    private handleCreate() {
+        const { formValues } = this.state
+        this.setState({ loading: true, validationResult: new ValidationResult() })
+
+        this.service
+            .createApiToken(this.buildRequest(formValues))
+            .then(response =>
+                this.setState({ createdToken: response.token, formOpen: false, formValues: DEFAULT_FORM_VALUES }),
+            )
+            .then(() => this.fetchTokens())
+            .catch(error => this.handleErrorResponse(error))
+    }
+
+    private closeForm() {
+        this.setState({
+            formOpen: false,
+            formValues: DEFAULT_FORM_VALUES,
+            validationResult: new ValidationResult(),
+        })
+    }
+
+    private handleErrorResponse(error: Error) {
+        if (error instanceof UnexpectedResponseError) {
+            const validationResult = ValidationResultConverter.parse(error.response)
+            if (validationResult != null) this.setState({ validationResult })
+        }
+
+        this.setState({ loading: false })
+        Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonFormCheckMessage)
+    }
+
+    private handleDelete(token: ApiTokenResponse) {
+        UserConfirmation.ask(MessageKey.FrontendUserApiTokensDeleteConfirmation)
+            .then(() => this.setState({ loading: true }))
+            .then(() => this.service.deleteApiToken(token.id))
+            .then(() =>
+                Notification.success(
+                    MessageKey.FrontendUserApiTokensDeletedTitle,
+                    MessageKey.FrontendUserApiTokensDeletedDescription,
+                ),
+            )
+            .catch(() => Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonTryAgainLater))
+            .then(() => this.fetchTokens())
+    }
+
+    private async handleCopyToken() {
+        const { createdToken } = this.state
+        if (!createdToken) return
+
+        try {
+            await navigator.clipboard.writeText(createdToken)
+            Notification.success(MessageKey.CommonCopy, MessageKey.FrontendUserApiTokensCopied)
+        } catch {
+            // NO-OP
+        }
+    }
+
+    private formatDate(value?: string): string {
+        return value ? new Date(value).toLocaleString() : i18n(MessageKey.FrontendUserApiTokensNever)
+    }
+
+    private renderCreatedToken() {
+        const { createdToken } = this.state
+
+        return (
+            <Flex vertical className="api-token-created-container">
+                <Alert type="warning" showIcon message={<I18n id={MessageKey.FrontendUserApiTokensCreatedWarning} />} />
+                <Button
+                    className="api-token-created-value"
+                    onClick={this.handleCopyToken.bind(this)}
+                    title={i18n(MessageKey.CommonCopy)}
+                >
+                    <code>{createdToken}</code>
+                    <CopyOutlined />
+                </Button>
+                <Flex justify="end">
+                    <Button type="primary" onClick={() => this.setState({ createdToken: undefined })}>
+                        <I18n id={MessageKey.FrontendUserApiTokensDone} />
+                    </Button>
+                </Flex>
+            </Flex>
+        )
+    }
+
+    private renderForm() {
+        const { validationResult, formValues } = this.state
+
+        return (
+            <Form<ApiTokenFormValues>
+                layout="vertical"
+                onValuesChange={(_, formValues) => this.setState({ formValues })}
+                initialValues={formValues}
+                className="api-token-form"
+            >
+                <Form.Item
+                    name="name"
+                    validateStatus={validationResult.getStatus("name")}
+                    help={validationResult.getMessage("name")}
+                    label={<I18n id={MessageKey.CommonName} />}
+                    required
+                >
+                    <Input />
+                </Form.Item>
+                <Form.Item
+                    name="expiresInDays"
+                    validateStatus={validationResult.getStatus("expiresAt")}
+                    help={validationResult.getMessage("expiresAt")}
+                    label={<I18n id={MessageKey.FrontendUserApiTokensExpiration} />}
+                >
+                    <Select
+                        options={EXPIRATION_OPTIONS.map(days => ({
+                            value: days,
+                            label:
+                                days === 0 ? (
+                                    <I18n id={MessageKey.FrontendUserApiTokensNever} />
+                                ) : (
+                                    <I18n id={MessageKey.FrontendUserApiTokensExpiresInDays} params={{ days }} />
+                                ),
+                        }))}
+                    />
+                </Form.Item>
+                <Form.Item
+                    name="allowedSourceAddresses"
+                    validateStatus={validationResult.getStatus("allowedSourceAddresses")}
+                    help={
+                        validationResult.getMessage("allowedSourceAddresses") ?? (
+                            <I18n id={MessageKey.FrontendUserApiTokensAllowedSourceAddressesHelp} />
+                        )
+                    }
+                    label={<I18n id={MessageKey.FrontendUserApiTokensAllowedSourceAddresses} />}
+                >
+                    <Select mode="tags" />
+                </Form.Item>
+                <Form.Item label={<I18n id={MessageKey.FrontendUserFormPermissions} />}>
+                    <Typography.Text type="secondary">
+                        <I18n id={MessageKey.FrontendUserApiTokensPermissionsHelp} />
+                    </Typography.Text>
+                    <UserPermissionToggle id="hosts" label={MessageKey.CommonHosts} />
+                    <UserPermissionToggle id="streams" label={MessageKey.CommonStreams} />
+                    <UserPermissionToggle id="certificates" label={MessageKey.CommonSslCertificates} />
+                    <UserPermissionToggle id="integrations" label={MessageKey.CommonIntegrations} />
+                    <UserPermissionToggle id="vpns" label={MessageKey.CommonVpns} />
+                    <UserPermissionToggle id="caches" label={MessageKey.CommonCacheConfigurations} />
+                    <UserPermissionToggle id="upstreams" label={MessageKey.CommonUpstreams} />
+                    <UserPermissionToggle id="tlsProfiles" label={MessageKey.CommonTlsProfiles} />
+                    <UserPermissionToggle id="rateLimits" label={MessageKey.CommonRateLimits} />
+                    <UserPermissionToggle id="securityHeaders" label={MessageKey.CommonSecurityHeaders} />
+                    <UserPermissionToggle id="accessLists" label={MessageKey.CommonAccessLists} />
+                    <UserPermissionToggle id="settings" label={MessageKey.CommonSettings} />
+                    <UserPermissionToggle id="users" label={MessageKey.CommonUsers} />
+                    <UserPermissionToggle id="logs" label={MessageKey.CommonLogs} disableReadWrite />
+                    <UserPermissionToggle id="trafficStats" label={MessageKey.CommonTrafficStats} disableReadWrite />
+                    <UserPermissionToggle id="exportData" label={MessageKey.CommonExportAndBackup} disableReadWrite />
+                    <UserPermissionToggle id="audit" label={MessageKey.CommonAuditLog} disableReadWrite />
+                    <UserPermissionToggle
+                        id="nginxServer"
+                        label={MessageKey.FrontendUserFormPermissionsNginxServer}
+                    />
+                </Form.Item>
+                <Flex justify="end" gap={8}>
+                    <Button onClick={() => this.closeForm()}>
+                        <I18n id={MessageKey.CommonCancel} />
+                    </Button>
+                    <Button type="primary" onClick={() => this.handleCreate()}>
+                        <I18n id={MessageKey.FrontendUserApiTokensCreate} />
+                    </Button>
+                </Flex>
+            </Form>
+        )
+    }
+
+    private renderToken(token: ApiTokenResponse) {
+        return (
+            <List.Item
+                actions={[
+                    <Button
+                        key="delete"
+                        danger
+                        type="text"
+                        icon={<DeleteOutlined />}
+                        title={i18n(MessageKey.CommonDelete)}
+                        onClick={() => this.handleDelete(token)}
+                    />,
+                ]}
+            >
+                <List.Item.Meta
+                    title={
+                        <Flex gap={8} align="center">
+                            {token.name}
+                            <Tag>{token.tokenPrefix}…</Tag>
+                        </Flex>
+                    }
+                    description={
+                        <I18n
+                            id={MessageKey.FrontendUserApiTokensDetails}
+                            params={{
+                                expiresAt: this.formatDate(token.expiresAt),
+                                lastUsedAt: this.formatDate(token.lastUsedAt),
+                            }}
+                        />
+                    }
+                />
+            </List.Item>
+        )
+    }
+
+    private renderList() {
+        const { tokens } = this.state
+
+        return (
+            <>
+                <Typography.Paragraph type="secondary">
+                    <I18n id={MessageKey.FrontendUserApiTokensDescription} />
+                </Typography.Paragraph>
+                <If condition={tokens.length === 0}>
+                    <Empty description={<I18n id={MessageKey.FrontendUserApiTokensEmpty} />} />
+                </If>
+                <If condition={tokens.length > 0}>
+                    <List dataSource={tokens} renderItem={token => this.renderToken(token)} />
+                </If>
+                <Flex justify="end" style={{ marginTop: 16 }}>
+                    <Button type="primary" icon={<PlusOutlined />} onClick={() => this.setState({ formOpen: true })}>
+                        <I18n id={MessageKey.FrontendUserApiTokensNew} />
+                    </Button>
+                </Flex>
+            </>
+        )
+    }
+
+    render() {
+        const { loading, formOpen, createdToken } = this.state
+
+        let content: React.ReactNode
+        if (createdToken) content = this.renderCreatedToken()
+        else if (formOpen) content = this.renderForm()
+        else content = this.renderList()
+
+        return (
+            <Preloader loading={loading}>
+                <div className="api-token-manager">{content}</div>
+            </Preloader>
+        )
+    }
+}
@@ -0,0 +1,142 @@
+import React from "react"
+import { Button, Flex, List, Tag, Typography } from "antd"
+import { DeleteOutlined, LogoutOutlined } from "@ant-design/icons"
+import UserService from "../UserService"
+import SessionResponse from "../model/SessionResponse"
+import Notification from "../../../core/components/notification/Notification"
+import Preloader from "../../../core/components/preloader/Preloader"
+import UserConfirmation from "../../../core/components/confirmation/UserConfirmation"
+import MessageKey from "../../../core/i18n/model/MessageKey.generated"
+import { I18n, i18n } from "../../../core/i18n/I18n"
+import If from "../../../core/components/flowcontrol/If"
+
+interface SessionManagerState {
+    loading: boolean
+    sessions: SessionResponse[]
+}
+
+export default class SessionManager extends React.Component<unknown, SessionManagerState> {
+    private readonly service: UserService
+
+    constructor(props: unknown) {
+        super(props)
+        this.service = new UserService()
+        this.state = {
+            loading: true,
+            sessions: [],
+        }
+    }
+
+    componentDidMount() {
+        this.fetchSessions()
+    }
+
+    private fetchSessions() {
+        this.setState({ loading: true })
+        this.service
+            .listSessions()
+            .then(sessions => this.setState({ sessions, loading: false }))
+            .catch(() => {
+                this.setState({ loading: false })
+                Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonTryAgainLater)
+            })
+    }
+
+    private handleRevoke(session: SessionResponse) {
+        UserConfirmation.ask(MessageKey.FrontendUserSessionsRevokeConfirmation)
+            .then(() => this.setState({ loading: true }))
+            .then(() => this.service.revokeSession(session.id))
+            .then(() =>
+                Notification.success(
+                    MessageKey.FrontendUserSessionsRevokedTitle,
+                    MessageKey.FrontendUserSessionsRevokedDescription,
+                ),
+            )
+            .catch(() => Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonTryAgainLater))
+            .then(() => this.fetchSessions())
+    }
+
+    private handleRevokeOthers() {
+        UserConfirmation.ask(MessageKey.FrontendUserSessionsRevokeOthersConfirmation)
+            .then(() => this.setState({ loading: true }))
+            .then(() => this.service.revokeOtherSessions())
+            .then(() =>
+                Notification.success(
+                    MessageKey.FrontendUserSessionsRevokedTitle,
+                    MessageKey.FrontendUserSessionsRevokedOthersDescription,
+                ),
+            )
+            .catch(() => Notification.error(MessageKey.CommonThatDidntWork, MessageKey.CommonTryAgainLater))
+            .then(() => this.fetchSessions())
+    }
+
+    private renderSession(session: SessionResponse) {
+        const actions = session.current
+            ? []
+            : [
+                  <Button
+                      key="revoke"
+                      danger
+                      type="text"
+                      icon={<DeleteOutlined />}
+                      title={i18n(MessageKey.FrontendUserSessionsRevoke)}
+                      onClick={() => this.handleRevoke(session)}
+                  />,
+              ]
+
+        return (
+            <List.Item actions={actions}>
+                <List.Item.Meta
+                    title={
+                        <Flex gap={8} align="center">
+                            {session.sourceAddress}
+                            <If condition={session.current}>
+                                <Tag color="green">
+                                    <I18n id={MessageKey.FrontendUserSessionsCurrent} />
+                                </Tag>
+                            </If>
+                        </Flex>
+                    }
+                    description={
+                        <Flex vertical>
+                            <Typography.Text type="secondary" ellipsis={{ tooltip: session.userAgent }}>
+                                {session.userAgent}
+                            </Typography.Text>
+                            <I18n
+                                id={MessageKey.FrontendUserSessionsDetails}
+                                params={{
+                                    createdAt: new Date(session.createdAt).toLocaleString(),
+                                    expiresAt: new Date(session.expiresAt).toLocaleString(),
+                                }}
+                            />
+                        </Flex>
+                    }
+                />
+            </List.Item>
+        )
+    }
+
+    render() {
+        const { loading, sessions } = this.state
+        const hasOtherSessions = sessions.some(session => !session.current)
+
+        return (
+            <Preloader loading={loading}>
+                <Typography.Paragraph type="secondary">
+                    <I18n id={MessageKey.FrontendUserSessionsDescription} />
+                </Typography.Paragraph>
+                <List dataSource={sessions} renderItem={session => this.renderSession(session)} />
+                <Flex justify="end" style={{ marginTop: 16 }}>
+                    <Button
+                        danger
+                        icon={<LogoutOutlined />}
+                        disabled={!hasOtherSessions}
+                        onClick={() => this.handleRevokeOthers()}
+                    >
+                        <I18n id={MessageKey.FrontendUserSessionsRevokeOthers} />
+                    </Button>
+                </Flex>
+            </Preloader>
+        )
+    }
+}
@@ -1,6 +1,6 @@
 import React from "react"
 import { Button, Flex, Form, FormInstance, Modal, Tabs, Typography } from "antd"
-import { LockOutlined, SafetyOutlined } from "@ant-design/icons"
+import { ApiOutlined, DesktopOutlined, LockOutlined, SafetyOutlined } from "@ant-design/icons"
 import UserService from "../UserService"
 import Notification from "../../../core/components/notification/Notification"
 import ValidationResult from "../../../core/validation/ValidationResult"
@@ -13,6 +13,8 @@ import ValidationResultConverter from "../../../core/validation/ValidationResult
 import MessageKey from "../../../core/i18n/model/MessageKey.generated"
 import { I18n } from "../../../core/i18n/I18n"
 import TotpSetup from "./TotpSetup"
+import ApiTokenManager from "./ApiTokenManager"
+import SessionManager from "./SessionManager"
 import UserConfirmation from "../../../core/components/confirmation/UserConfirmation"
 import "./UserSecuritySettingsModal.css"
 
@@ -196,6 +198,7 @@ export default class UserSecuritySettingsModal extends React.Component<
                 onCancel={onCancel}
                 footer={null}
                 open={open}
+                width={680}
                 destroyOnHidden
             >
                 <Preloader loading={loading}>
@@ -214,6 +217,18 @@ export default class UserSecuritySettingsModal extends React.Component<
                                 children: this.renderTotpTab(),
                                 icon: <SafetyOutlined />,
                             },
+                            {
+                                key: "sessions",
+                                label: <I18n id={MessageKey.FrontendUserSessionsTitle} />,
+                                children: <SessionManager />,
+                                icon: <DesktopOutlined />,
+                            },
+                            {
+                                key: "apiTokens",
+                                label: <I18n id={MessageKey.FrontendUserApiTokensTitle} />,
+                                children: <ApiTokenManager />,
+                                icon: <ApiOutlined />,
+                            },
                         ]}
                     />
                 </Preloader>
@@ -0,0 +1,4 @@
+export default interface ApiTokenCreateResponse {
+    id: string
+    token: string
+}
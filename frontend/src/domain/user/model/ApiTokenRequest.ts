@@ -0,0 +1,8 @@
+import UserPermissions from "./UserPermissions"
+
+export default interface ApiTokenRequest {
+    name: string
+    expiresAt?: string
+    allowedSourceAddresses: string[]
+    permissions: UserPermissions
+}
@@ -0,0 +1,12 @@
+import UserPermissions from "./UserPermissions"
+
+export default interface ApiTokenResponse {
+    id: string
+    name: string
+    tokenPrefix: string
+    expiresAt?: string
+    lastUsedAt?: string
+    createdAt: string
+    allowedSourceAddresses: string[]
+    permissions: UserPermissions
+}
@@ -2,6 +2,7 @@ enum LoginOutcome {
     SUCCESS = "SUCCESS",
     FAILURE = "FAILURE",
     MISSING_TOTP = "MISSING_TOTP",
+    LOCKED = "LOCKED",
 }
 
 export default LoginOutcome
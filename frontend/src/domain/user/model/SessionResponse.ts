@@ -0,0 +1,8 @@
+export default interface SessionResponse {
+    id: string
+    userAgent: string
+    sourceAddress: string
+    createdAt: string
+    expiresAt: string
+    current: boolean
+}
@@ -0,0 +1,4 @@
+export default interface UserLockoutResponse {
+    locked: boolean
+    lockedUntil?: string
+}
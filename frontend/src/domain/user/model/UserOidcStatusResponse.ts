@@ -0,0 +1,4 @@
+export default interface UserOidcStatusResponse {
+    enabled: boolean
+    localLoginEnabled: boolean
+}
@@ -13,5 +13,10 @@ export default interface UserPermissions {
     exportData: UserAccessLevel
     vpns: UserAccessLevel
     caches: UserAccessLevel
+    upstreams: UserAccessLevel
+    tlsProfiles: UserAccessLevel
+    rateLimits: UserAccessLevel
+    securityHeaders: UserAccessLevel
     trafficStats: UserAccessLevel
+    audit: UserAccessLevel
 }